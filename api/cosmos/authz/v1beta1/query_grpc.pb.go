@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.43
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -21,9 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Grants_FullMethodName        = "/cosmos.authz.v1beta1.Query/Grants"
-	Query_GranterGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranterGrants"
-	Query_GranteeGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_Grants_FullMethodName         = "/cosmos.authz.v1beta1.Query/Grants"
+	Query_GranterGrants_FullMethodName  = "/cosmos.authz.v1beta1.Query/GranterGrants"
+	Query_GranteeGrants_FullMethodName  = "/cosmos.authz.v1beta1.Query/GranteeGrants"
+	Query_ExpiringGrants_FullMethodName = "/cosmos.authz.v1beta1.Query/ExpiringGrants"
+	Query_SpendAllowance_FullMethodName = "/cosmos.authz.v1beta1.Query/SpendAllowance"
 )
 
 // QueryClient is the client API for Query service.
@@ -40,6 +40,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` expiring within the
+	// given window after the current block time.
+	ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error)
+	// SpendAllowance returns the coins the grantee can still spend under a grant
+	// which authorization limits spending, and the time its period resets.
+	SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error)
 }
 
 type queryClient struct {
@@ -77,6 +83,24 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error) {
+	out := new(QueryExpiringGrantsResponse)
+	err := c.cc.Invoke(ctx, Query_ExpiringGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error) {
+	out := new(QuerySpendAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_SpendAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -91,6 +115,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` expiring within the
+	// given window after the current block time.
+	ExpiringGrants(context.Context, *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error)
+	// SpendAllowance returns the coins the grantee can still spend under a grant
+	// which authorization limits spending, and the time its period resets.
+	SpendAllowance(context.Context, *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +137,12 @@ func (UnimplementedQueryServer) GranterGrants(context.Context, *QueryGranterGran
 func (UnimplementedQueryServer) GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (UnimplementedQueryServer) ExpiringGrants(context.Context, *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringGrants not implemented")
+}
+func (UnimplementedQueryServer) SpendAllowance(context.Context, *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendAllowance not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +210,42 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiringGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExpiringGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringGrants(ctx, req.(*QueryExpiringGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SpendAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendAllowance(ctx, req.(*QuerySpendAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +265,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "ExpiringGrants",
+			Handler:    _Query_ExpiringGrants_Handler,
+		},
+		{
+			MethodName: "SpendAllowance",
+			Handler:    _Query_SpendAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
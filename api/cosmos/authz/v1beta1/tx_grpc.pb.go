@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.43
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -21,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Grant_FullMethodName  = "/cosmos.authz.v1beta1.Msg/Grant"
-	Msg_Exec_FullMethodName   = "/cosmos.authz.v1beta1.Msg/Exec"
-	Msg_Revoke_FullMethodName = "/cosmos.authz.v1beta1.Msg/Revoke"
+	Msg_Grant_FullMethodName     = "/cosmos.authz.v1beta1.Msg/Grant"
+	Msg_Exec_FullMethodName      = "/cosmos.authz.v1beta1.Msg/Exec"
+	Msg_Revoke_FullMethodName    = "/cosmos.authz.v1beta1.Msg/Revoke"
+	Msg_RevokeAll_FullMethodName = "/cosmos.authz.v1beta1.Msg/RevokeAll"
 )
 
 // MsgClient is the client API for Msg service.
@@ -42,6 +41,8 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter.
+	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
 }
 
 type msgClient struct {
@@ -79,6 +80,15 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error) {
+	out := new(MsgRevokeAllResponse)
+	err := c.cc.Invoke(ctx, Msg_RevokeAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -95,6 +105,8 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter.
+	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -111,6 +123,9 @@ func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse,
 func (UnimplementedMsgServer) Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (UnimplementedMsgServer) RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAll not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -178,6 +193,24 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAll(ctx, req.(*MsgRevokeAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +230,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _Msg_RevokeAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -0,0 +1,152 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/capability/v1beta1/query.proto
+
+package capabilityv1beta1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_CapabilityOwners_FullMethodName   = "/cosmos.capability.v1beta1.Query/CapabilityOwners"
+	Query_ModuleCapabilities_FullMethodName = "/cosmos.capability.v1beta1.Query/ModuleCapabilities"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// CapabilityOwners returns the owners of the capability with the given index.
+	CapabilityOwners(ctx context.Context, in *QueryCapabilityOwnersRequest, opts ...grpc.CallOption) (*QueryCapabilityOwnersResponse, error)
+	// ModuleCapabilities returns all the capabilities owned by a module, keyed
+	// by the name under which the module owns them.
+	ModuleCapabilities(ctx context.Context, in *QueryModuleCapabilitiesRequest, opts ...grpc.CallOption) (*QueryModuleCapabilitiesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) CapabilityOwners(ctx context.Context, in *QueryCapabilityOwnersRequest, opts ...grpc.CallOption) (*QueryCapabilityOwnersResponse, error) {
+	out := new(QueryCapabilityOwnersResponse)
+	err := c.cc.Invoke(ctx, Query_CapabilityOwners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleCapabilities(ctx context.Context, in *QueryModuleCapabilitiesRequest, opts ...grpc.CallOption) (*QueryModuleCapabilitiesResponse, error) {
+	out := new(QueryModuleCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleCapabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// CapabilityOwners returns the owners of the capability with the given index.
+	CapabilityOwners(context.Context, *QueryCapabilityOwnersRequest) (*QueryCapabilityOwnersResponse, error)
+	// ModuleCapabilities returns all the capabilities owned by a module, keyed
+	// by the name under which the module owns them.
+	ModuleCapabilities(context.Context, *QueryModuleCapabilitiesRequest) (*QueryModuleCapabilitiesResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) CapabilityOwners(context.Context, *QueryCapabilityOwnersRequest) (*QueryCapabilityOwnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapabilityOwners not implemented")
+}
+func (UnimplementedQueryServer) ModuleCapabilities(context.Context, *QueryModuleCapabilitiesRequest) (*QueryModuleCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleCapabilities not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_CapabilityOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCapabilityOwnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CapabilityOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CapabilityOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CapabilityOwners(ctx, req.(*QueryCapabilityOwnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleCapabilitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleCapabilities(ctx, req.(*QueryModuleCapabilitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.capability.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CapabilityOwners",
+			Handler:    _Query_CapabilityOwners_Handler,
+		},
+		{
+			MethodName: "ModuleCapabilities",
+			Handler:    _Query_ModuleCapabilities_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/capability/v1beta1/query.proto",
+}
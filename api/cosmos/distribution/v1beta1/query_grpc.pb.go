@@ -29,6 +29,9 @@ const (
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
 	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_AutoCompound_FullMethodName                = "/cosmos.distribution.v1beta1.Query/AutoCompound"
+	Query_ContinuousFund_FullMethodName              = "/cosmos.distribution.v1beta1.Query/ContinuousFund"
+	Query_ContinuousFunds_FullMethodName             = "/cosmos.distribution.v1beta1.Query/ContinuousFunds"
 )
 
 // QueryClient is the client API for Query service.
@@ -56,6 +59,13 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries the auto-compounding setting of a delegator and the
+	// height at which its rewards are compounded next.
+	AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error)
+	// ContinuousFund queries a continuous fund by its id.
+	ContinuousFund(ctx context.Context, in *QueryContinuousFundRequest, opts ...grpc.CallOption) (*QueryContinuousFundResponse, error)
+	// ContinuousFunds queries all active continuous funds.
+	ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error)
 }
 
 type queryClient struct {
@@ -156,6 +166,33 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error) {
+	out := new(QueryAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Query_AutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContinuousFund(ctx context.Context, in *QueryContinuousFundRequest, opts ...grpc.CallOption) (*QueryContinuousFundResponse, error) {
+	out := new(QueryContinuousFundResponse)
+	err := c.cc.Invoke(ctx, Query_ContinuousFund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error) {
+	out := new(QueryContinuousFundsResponse)
+	err := c.cc.Invoke(ctx, Query_ContinuousFunds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -181,6 +218,13 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries the auto-compounding setting of a delegator and the
+	// height at which its rewards are compounded next.
+	AutoCompound(context.Context, *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error)
+	// ContinuousFund queries a continuous fund by its id.
+	ContinuousFund(context.Context, *QueryContinuousFundRequest) (*QueryContinuousFundResponse, error)
+	// ContinuousFunds queries all active continuous funds.
+	ContinuousFunds(context.Context, *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -218,6 +262,15 @@ func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *Query
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (UnimplementedQueryServer) AutoCompound(context.Context, *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompound not implemented")
+}
+func (UnimplementedQueryServer) ContinuousFund(context.Context, *QueryContinuousFundRequest) (*QueryContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFund not implemented")
+}
+func (UnimplementedQueryServer) ContinuousFunds(context.Context, *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFunds not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -411,6 +464,60 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompound(ctx, req.(*QueryAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContinuousFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContinuousFund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContinuousFund(ctx, req.(*QueryContinuousFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContinuousFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContinuousFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContinuousFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ContinuousFunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContinuousFunds(ctx, req.(*QueryContinuousFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -458,6 +565,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCompound",
+			Handler:    _Query_AutoCompound_Handler,
+		},
+		{
+			MethodName: "ContinuousFund",
+			Handler:    _Query_ContinuousFund_Handler,
+		},
+		{
+			MethodName: "ContinuousFunds",
+			Handler:    _Query_ContinuousFunds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -25,6 +25,9 @@ const (
 	Msg_FundCommunityPool_FullMethodName           = "/cosmos.distribution.v1beta1.Msg/FundCommunityPool"
 	Msg_UpdateParams_FullMethodName                = "/cosmos.distribution.v1beta1.Msg/UpdateParams"
 	Msg_CommunityPoolSpend_FullMethodName          = "/cosmos.distribution.v1beta1.Msg/CommunityPoolSpend"
+	Msg_SetAutoCompound_FullMethodName             = "/cosmos.distribution.v1beta1.Msg/SetAutoCompound"
+	Msg_CreateContinuousFund_FullMethodName        = "/cosmos.distribution.v1beta1.Msg/CreateContinuousFund"
+	Msg_CancelContinuousFund_FullMethodName        = "/cosmos.distribution.v1beta1.Msg/CancelContinuousFund"
 )
 
 // MsgClient is the client API for Msg service.
@@ -55,6 +58,16 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	CommunityPoolSpend(ctx context.Context, in *MsgCommunityPoolSpend, opts ...grpc.CallOption) (*MsgCommunityPoolSpendResponse, error)
+	// SetAutoCompound defines a method to enable or disable restaking the
+	// rewards of a delegator into the validators they were earned from.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	// CreateContinuousFund defines a governance operation for creating a stream
+	// of payments from the community pool to a recipient. The authority is
+	// defined in the keeper.
+	CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error)
+	// CancelContinuousFund defines a governance operation for stopping a
+	// continuous fund. The authority is defined in the keeper.
+	CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error)
 }
 
 type msgClient struct {
@@ -119,6 +132,33 @@ func (c *msgClient) CommunityPoolSpend(ctx context.Context, in *MsgCommunityPool
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAutoCompound_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error) {
+	out := new(MsgCreateContinuousFundResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateContinuousFund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelContinuousFund(ctx context.Context, in *MsgCancelContinuousFund, opts ...grpc.CallOption) (*MsgCancelContinuousFundResponse, error) {
+	out := new(MsgCancelContinuousFundResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelContinuousFund_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -147,6 +187,16 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	CommunityPoolSpend(context.Context, *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error)
+	// SetAutoCompound defines a method to enable or disable restaking the
+	// rewards of a delegator into the validators they were earned from.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	// CreateContinuousFund defines a governance operation for creating a stream
+	// of payments from the community pool to a recipient. The authority is
+	// defined in the keeper.
+	CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error)
+	// CancelContinuousFund defines a governance operation for stopping a
+	// continuous fund. The authority is defined in the keeper.
+	CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -172,6 +222,15 @@ func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*
 func (UnimplementedMsgServer) CommunityPoolSpend(context.Context, *MsgCommunityPoolSpend) (*MsgCommunityPoolSpendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolSpend not implemented")
 }
+func (UnimplementedMsgServer) SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (UnimplementedMsgServer) CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContinuousFund not implemented")
+}
+func (UnimplementedMsgServer) CancelContinuousFund(context.Context, *MsgCancelContinuousFund) (*MsgCancelContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelContinuousFund not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -293,6 +352,60 @@ func _Msg_CommunityPoolSpend_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAutoCompound_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateContinuousFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateContinuousFund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateContinuousFund(ctx, req.(*MsgCreateContinuousFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelContinuousFund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelContinuousFund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelContinuousFund(ctx, req.(*MsgCancelContinuousFund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -324,6 +437,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPoolSpend",
 			Handler:    _Msg_CommunityPoolSpend_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "CreateContinuousFund",
+			Handler:    _Msg_CreateContinuousFund_Handler,
+		},
+		{
+			MethodName: "CancelContinuousFund",
+			Handler:    _Msg_CancelContinuousFund_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
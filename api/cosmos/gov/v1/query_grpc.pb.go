@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.46
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -21,14 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Proposal_FullMethodName    = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName   = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName        = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName       = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName      = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName     = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName    = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Proposal_FullMethodName                   = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName                  = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                       = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName                      = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName                     = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName                    = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName                   = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName                = "/cosmos.gov.v1.Query/TallyResult"
+	Query_MessageBasedParams_FullMethodName         = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_ProposalMessageBasedParams_FullMethodName = "/cosmos.gov.v1.Query/ProposalMessageBasedParams"
+	Query_Governor_FullMethodName                   = "/cosmos.gov.v1.Query/Governor"
+	Query_Governors_FullMethodName                  = "/cosmos.gov.v1.Query/Governors"
+	Query_GovernanceDelegation_FullMethodName       = "/cosmos.gov.v1.Query/GovernanceDelegation"
+	Query_GovernanceDelegations_FullMethodName      = "/cosmos.gov.v1.Query/GovernanceDelegations"
+	Query_ScheduledParamsUpdate_FullMethodName      = "/cosmos.gov.v1.Query/ScheduledParamsUpdate"
+	Query_ScheduledParamsUpdates_FullMethodName     = "/cosmos.gov.v1.Query/ScheduledParamsUpdates"
 )
 
 // QueryClient is the client API for Query service.
@@ -51,6 +57,24 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// MessageBasedParams queries the voting period and tally params that apply to
+	// proposals containing a given message type.
+	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// ProposalMessageBasedParams queries the voting period and tally params that
+	// apply to a given proposal, given the messages it contains.
+	ProposalMessageBasedParams(ctx context.Context, in *QueryProposalMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryProposalMessageBasedParamsResponse, error)
+	// Governor queries a governor and the voting power delegated to it.
+	Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error)
+	// Governors queries all governors.
+	Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error)
+	// GovernanceDelegation queries the governance delegation of a delegator.
+	GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error)
+	// GovernanceDelegations queries the governance delegations to a governor.
+	GovernanceDelegations(ctx context.Context, in *QueryGovernanceDelegationsRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationsResponse, error)
+	// ScheduledParamsUpdate queries a scheduled params update by its id.
+	ScheduledParamsUpdate(ctx context.Context, in *QueryScheduledParamsUpdateRequest, opts ...grpc.CallOption) (*QueryScheduledParamsUpdateResponse, error)
+	// ScheduledParamsUpdates queries all scheduled params updates.
+	ScheduledParamsUpdates(ctx context.Context, in *QueryScheduledParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -133,6 +157,78 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error) {
+	out := new(QueryMessageBasedParamsResponse)
+	err := c.cc.Invoke(ctx, Query_MessageBasedParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalMessageBasedParams(ctx context.Context, in *QueryProposalMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryProposalMessageBasedParamsResponse, error) {
+	out := new(QueryProposalMessageBasedParamsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalMessageBasedParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error) {
+	out := new(QueryGovernorResponse)
+	err := c.cc.Invoke(ctx, Query_Governor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error) {
+	out := new(QueryGovernorsResponse)
+	err := c.cc.Invoke(ctx, Query_Governors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernanceDelegation(ctx context.Context, in *QueryGovernanceDelegationRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationResponse, error) {
+	out := new(QueryGovernanceDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_GovernanceDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernanceDelegations(ctx context.Context, in *QueryGovernanceDelegationsRequest, opts ...grpc.CallOption) (*QueryGovernanceDelegationsResponse, error) {
+	out := new(QueryGovernanceDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_GovernanceDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledParamsUpdate(ctx context.Context, in *QueryScheduledParamsUpdateRequest, opts ...grpc.CallOption) (*QueryScheduledParamsUpdateResponse, error) {
+	out := new(QueryScheduledParamsUpdateResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledParamsUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduledParamsUpdates(ctx context.Context, in *QueryScheduledParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryScheduledParamsUpdatesResponse, error) {
+	out := new(QueryScheduledParamsUpdatesResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduledParamsUpdates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -153,6 +249,24 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// MessageBasedParams queries the voting period and tally params that apply to
+	// proposals containing a given message type.
+	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// ProposalMessageBasedParams queries the voting period and tally params that
+	// apply to a given proposal, given the messages it contains.
+	ProposalMessageBasedParams(context.Context, *QueryProposalMessageBasedParamsRequest) (*QueryProposalMessageBasedParamsResponse, error)
+	// Governor queries a governor and the voting power delegated to it.
+	Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error)
+	// Governors queries all governors.
+	Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error)
+	// GovernanceDelegation queries the governance delegation of a delegator.
+	GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error)
+	// GovernanceDelegations queries the governance delegations to a governor.
+	GovernanceDelegations(context.Context, *QueryGovernanceDelegationsRequest) (*QueryGovernanceDelegationsResponse, error)
+	// ScheduledParamsUpdate queries a scheduled params update by its id.
+	ScheduledParamsUpdate(context.Context, *QueryScheduledParamsUpdateRequest) (*QueryScheduledParamsUpdateResponse, error)
+	// ScheduledParamsUpdates queries all scheduled params updates.
+	ScheduledParamsUpdates(context.Context, *QueryScheduledParamsUpdatesRequest) (*QueryScheduledParamsUpdatesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -184,6 +298,30 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
+}
+func (UnimplementedQueryServer) ProposalMessageBasedParams(context.Context, *QueryProposalMessageBasedParamsRequest) (*QueryProposalMessageBasedParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalMessageBasedParams not implemented")
+}
+func (UnimplementedQueryServer) Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governor not implemented")
+}
+func (UnimplementedQueryServer) Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governors not implemented")
+}
+func (UnimplementedQueryServer) GovernanceDelegation(context.Context, *QueryGovernanceDelegationRequest) (*QueryGovernanceDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceDelegation not implemented")
+}
+func (UnimplementedQueryServer) GovernanceDelegations(context.Context, *QueryGovernanceDelegationsRequest) (*QueryGovernanceDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernanceDelegations not implemented")
+}
+func (UnimplementedQueryServer) ScheduledParamsUpdate(context.Context, *QueryScheduledParamsUpdateRequest) (*QueryScheduledParamsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParamsUpdate not implemented")
+}
+func (UnimplementedQueryServer) ScheduledParamsUpdates(context.Context, *QueryScheduledParamsUpdatesRequest) (*QueryScheduledParamsUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledParamsUpdates not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -341,6 +479,150 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessageBasedParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageBasedParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MessageBasedParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageBasedParams(ctx, req.(*QueryMessageBasedParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalMessageBasedParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalMessageBasedParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalMessageBasedParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalMessageBasedParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalMessageBasedParams(ctx, req.(*QueryProposalMessageBasedParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governor(ctx, req.(*QueryGovernorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governors(ctx, req.(*QueryGovernorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernanceDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernanceDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceDelegation(ctx, req.(*QueryGovernanceDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernanceDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernanceDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernanceDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernanceDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernanceDelegations(ctx, req.(*QueryGovernanceDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParamsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParamsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledParamsUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParamsUpdate(ctx, req.(*QueryScheduledParamsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduledParamsUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduledParamsUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduledParamsUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduledParamsUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduledParamsUpdates(ctx, req.(*QueryScheduledParamsUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -380,6 +662,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "MessageBasedParams",
+			Handler:    _Query_MessageBasedParams_Handler,
+		},
+		{
+			MethodName: "ProposalMessageBasedParams",
+			Handler:    _Query_ProposalMessageBasedParams_Handler,
+		},
+		{
+			MethodName: "Governor",
+			Handler:    _Query_Governor_Handler,
+		},
+		{
+			MethodName: "Governors",
+			Handler:    _Query_Governors_Handler,
+		},
+		{
+			MethodName: "GovernanceDelegation",
+			Handler:    _Query_GovernanceDelegation_Handler,
+		},
+		{
+			MethodName: "GovernanceDelegations",
+			Handler:    _Query_GovernanceDelegations_Handler,
+		},
+		{
+			MethodName: "ScheduledParamsUpdate",
+			Handler:    _Query_ScheduledParamsUpdate_Handler,
+		},
+		{
+			MethodName: "ScheduledParamsUpdates",
+			Handler:    _Query_ScheduledParamsUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
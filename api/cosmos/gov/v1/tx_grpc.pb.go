@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.46
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -21,12 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_SubmitProposal_FullMethodName    = "/cosmos.gov.v1.Msg/SubmitProposal"
-	Msg_ExecLegacyContent_FullMethodName = "/cosmos.gov.v1.Msg/ExecLegacyContent"
-	Msg_Vote_FullMethodName              = "/cosmos.gov.v1.Msg/Vote"
-	Msg_VoteWeighted_FullMethodName      = "/cosmos.gov.v1.Msg/VoteWeighted"
-	Msg_Deposit_FullMethodName           = "/cosmos.gov.v1.Msg/Deposit"
-	Msg_UpdateParams_FullMethodName      = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_SubmitProposal_FullMethodName              = "/cosmos.gov.v1.Msg/SubmitProposal"
+	Msg_ExecLegacyContent_FullMethodName           = "/cosmos.gov.v1.Msg/ExecLegacyContent"
+	Msg_Vote_FullMethodName                        = "/cosmos.gov.v1.Msg/Vote"
+	Msg_VoteWeighted_FullMethodName                = "/cosmos.gov.v1.Msg/VoteWeighted"
+	Msg_Deposit_FullMethodName                     = "/cosmos.gov.v1.Msg/Deposit"
+	Msg_UpdateParams_FullMethodName                = "/cosmos.gov.v1.Msg/UpdateParams"
+	Msg_CancelProposal_FullMethodName              = "/cosmos.gov.v1.Msg/CancelProposal"
+	Msg_UpdateMessageParams_FullMethodName         = "/cosmos.gov.v1.Msg/UpdateMessageParams"
+	Msg_CreateGovernor_FullMethodName              = "/cosmos.gov.v1.Msg/CreateGovernor"
+	Msg_EditGovernor_FullMethodName                = "/cosmos.gov.v1.Msg/EditGovernor"
+	Msg_RemoveGovernor_FullMethodName              = "/cosmos.gov.v1.Msg/RemoveGovernor"
+	Msg_DelegateGovernance_FullMethodName          = "/cosmos.gov.v1.Msg/DelegateGovernance"
+	Msg_UndelegateGovernance_FullMethodName        = "/cosmos.gov.v1.Msg/UndelegateGovernance"
+	Msg_ScheduleParamsUpdate_FullMethodName        = "/cosmos.gov.v1.Msg/ScheduleParamsUpdate"
+	Msg_CancelScheduledParamsUpdate_FullMethodName = "/cosmos.gov.v1.Msg/CancelScheduledParamsUpdate"
 )
 
 // MsgClient is the client API for Msg service.
@@ -49,6 +56,34 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CancelProposal defines a method to cancel a proposal in its voting period.
+	// Only the proposer can cancel a proposal.
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// UpdateMessageParams defines a governance operation for setting or removing
+	// the voting period and tally params of a message type. The authority is
+	// defined in the keeper.
+	UpdateMessageParams(ctx context.Context, in *MsgUpdateMessageParams, opts ...grpc.CallOption) (*MsgUpdateMessageParamsResponse, error)
+	// CreateGovernor defines a method to register the signer as a governor.
+	CreateGovernor(ctx context.Context, in *MsgCreateGovernor, opts ...grpc.CallOption) (*MsgCreateGovernorResponse, error)
+	// EditGovernor defines a method to edit the metadata of a governor.
+	EditGovernor(ctx context.Context, in *MsgEditGovernor, opts ...grpc.CallOption) (*MsgEditGovernorResponse, error)
+	// RemoveGovernor defines a method to unregister the signer as a governor,
+	// removing the governance delegations to it.
+	RemoveGovernor(ctx context.Context, in *MsgRemoveGovernor, opts ...grpc.CallOption) (*MsgRemoveGovernorResponse, error)
+	// DelegateGovernance defines a method to delegate the governance voting power
+	// of the signer to a governor.
+	DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to remove the governance delegation
+	// of the signer.
+	UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error)
+	// ScheduleParamsUpdate defines a governance operation for scheduling the
+	// execution of the MsgUpdateParams message of a module at a future block
+	// height. The authority is defined in the keeper.
+	ScheduleParamsUpdate(ctx context.Context, in *MsgScheduleParamsUpdate, opts ...grpc.CallOption) (*MsgScheduleParamsUpdateResponse, error)
+	// CancelScheduledParamsUpdate defines a governance operation for cancelling a
+	// scheduled params update before its execution. The authority is defined in
+	// the keeper.
+	CancelScheduledParamsUpdate(ctx context.Context, in *MsgCancelScheduledParamsUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledParamsUpdateResponse, error)
 }
 
 type msgClient struct {
@@ -113,6 +148,87 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMessageParams(ctx context.Context, in *MsgUpdateMessageParams, opts ...grpc.CallOption) (*MsgUpdateMessageParamsResponse, error) {
+	out := new(MsgUpdateMessageParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateMessageParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateGovernor(ctx context.Context, in *MsgCreateGovernor, opts ...grpc.CallOption) (*MsgCreateGovernorResponse, error) {
+	out := new(MsgCreateGovernorResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateGovernor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EditGovernor(ctx context.Context, in *MsgEditGovernor, opts ...grpc.CallOption) (*MsgEditGovernorResponse, error) {
+	out := new(MsgEditGovernorResponse)
+	err := c.cc.Invoke(ctx, Msg_EditGovernor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveGovernor(ctx context.Context, in *MsgRemoveGovernor, opts ...grpc.CallOption) (*MsgRemoveGovernorResponse, error) {
+	out := new(MsgRemoveGovernorResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveGovernor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error) {
+	out := new(MsgDelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateGovernance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error) {
+	out := new(MsgUndelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, Msg_UndelegateGovernance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleParamsUpdate(ctx context.Context, in *MsgScheduleParamsUpdate, opts ...grpc.CallOption) (*MsgScheduleParamsUpdateResponse, error) {
+	out := new(MsgScheduleParamsUpdateResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleParamsUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledParamsUpdate(ctx context.Context, in *MsgCancelScheduledParamsUpdate, opts ...grpc.CallOption) (*MsgCancelScheduledParamsUpdateResponse, error) {
+	out := new(MsgCancelScheduledParamsUpdateResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelScheduledParamsUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -133,6 +249,34 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CancelProposal defines a method to cancel a proposal in its voting period.
+	// Only the proposer can cancel a proposal.
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// UpdateMessageParams defines a governance operation for setting or removing
+	// the voting period and tally params of a message type. The authority is
+	// defined in the keeper.
+	UpdateMessageParams(context.Context, *MsgUpdateMessageParams) (*MsgUpdateMessageParamsResponse, error)
+	// CreateGovernor defines a method to register the signer as a governor.
+	CreateGovernor(context.Context, *MsgCreateGovernor) (*MsgCreateGovernorResponse, error)
+	// EditGovernor defines a method to edit the metadata of a governor.
+	EditGovernor(context.Context, *MsgEditGovernor) (*MsgEditGovernorResponse, error)
+	// RemoveGovernor defines a method to unregister the signer as a governor,
+	// removing the governance delegations to it.
+	RemoveGovernor(context.Context, *MsgRemoveGovernor) (*MsgRemoveGovernorResponse, error)
+	// DelegateGovernance defines a method to delegate the governance voting power
+	// of the signer to a governor.
+	DelegateGovernance(context.Context, *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to remove the governance delegation
+	// of the signer.
+	UndelegateGovernance(context.Context, *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error)
+	// ScheduleParamsUpdate defines a governance operation for scheduling the
+	// execution of the MsgUpdateParams message of a module at a future block
+	// height. The authority is defined in the keeper.
+	ScheduleParamsUpdate(context.Context, *MsgScheduleParamsUpdate) (*MsgScheduleParamsUpdateResponse, error)
+	// CancelScheduledParamsUpdate defines a governance operation for cancelling a
+	// scheduled params update before its execution. The authority is defined in
+	// the keeper.
+	CancelScheduledParamsUpdate(context.Context, *MsgCancelScheduledParamsUpdate) (*MsgCancelScheduledParamsUpdateResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -158,6 +302,33 @@ func (UnimplementedMsgServer) Deposit(context.Context, *MsgDeposit) (*MsgDeposit
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
+func (UnimplementedMsgServer) UpdateMessageParams(context.Context, *MsgUpdateMessageParams) (*MsgUpdateMessageParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMessageParams not implemented")
+}
+func (UnimplementedMsgServer) CreateGovernor(context.Context, *MsgCreateGovernor) (*MsgCreateGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGovernor not implemented")
+}
+func (UnimplementedMsgServer) EditGovernor(context.Context, *MsgEditGovernor) (*MsgEditGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditGovernor not implemented")
+}
+func (UnimplementedMsgServer) RemoveGovernor(context.Context, *MsgRemoveGovernor) (*MsgRemoveGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGovernor not implemented")
+}
+func (UnimplementedMsgServer) DelegateGovernance(context.Context, *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGovernance not implemented")
+}
+func (UnimplementedMsgServer) UndelegateGovernance(context.Context, *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGovernance not implemented")
+}
+func (UnimplementedMsgServer) ScheduleParamsUpdate(context.Context, *MsgScheduleParamsUpdate) (*MsgScheduleParamsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleParamsUpdate not implemented")
+}
+func (UnimplementedMsgServer) CancelScheduledParamsUpdate(context.Context, *MsgCancelScheduledParamsUpdate) (*MsgCancelScheduledParamsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledParamsUpdate not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -279,6 +450,168 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMessageParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMessageParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMessageParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMessageParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMessageParams(ctx, req.(*MsgUpdateMessageParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGovernor(ctx, req.(*MsgCreateGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EditGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditGovernor(ctx, req.(*MsgEditGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveGovernor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveGovernor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveGovernor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveGovernor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveGovernor(ctx, req.(*MsgRemoveGovernor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateGovernance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGovernance(ctx, req.(*MsgDelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UndelegateGovernance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGovernance(ctx, req.(*MsgUndelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleParamsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleParamsUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleParamsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleParamsUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleParamsUpdate(ctx, req.(*MsgScheduleParamsUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledParamsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelScheduledParamsUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledParamsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelScheduledParamsUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledParamsUpdate(ctx, req.(*MsgCancelScheduledParamsUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -310,6 +643,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
+		{
+			MethodName: "UpdateMessageParams",
+			Handler:    _Msg_UpdateMessageParams_Handler,
+		},
+		{
+			MethodName: "CreateGovernor",
+			Handler:    _Msg_CreateGovernor_Handler,
+		},
+		{
+			MethodName: "EditGovernor",
+			Handler:    _Msg_EditGovernor_Handler,
+		},
+		{
+			MethodName: "RemoveGovernor",
+			Handler:    _Msg_RemoveGovernor_Handler,
+		},
+		{
+			MethodName: "DelegateGovernance",
+			Handler:    _Msg_DelegateGovernance_Handler,
+		},
+		{
+			MethodName: "UndelegateGovernance",
+			Handler:    _Msg_UndelegateGovernance_Handler,
+		},
+		{
+			MethodName: "ScheduleParamsUpdate",
+			Handler:    _Msg_ScheduleParamsUpdate_Handler,
+		},
+		{
+			MethodName: "CancelScheduledParamsUpdate",
+			Handler:    _Msg_CancelScheduledParamsUpdate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
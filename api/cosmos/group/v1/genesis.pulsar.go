@@ -267,16 +267,120 @@ func (x *_GenesisState_8_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*VotingPowerSnapshot
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingPowerSnapshot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingPowerSnapshot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(VotingPowerSnapshot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(VotingPowerSnapshot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ExecutionLog
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExecutionLog)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExecutionLog)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ExecutionLog)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ExecutionLog)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                  protoreflect.MessageDescriptor
-	fd_GenesisState_group_seq        protoreflect.FieldDescriptor
-	fd_GenesisState_groups           protoreflect.FieldDescriptor
-	fd_GenesisState_group_members    protoreflect.FieldDescriptor
-	fd_GenesisState_group_policy_seq protoreflect.FieldDescriptor
-	fd_GenesisState_group_policies   protoreflect.FieldDescriptor
-	fd_GenesisState_proposal_seq     protoreflect.FieldDescriptor
-	fd_GenesisState_proposals        protoreflect.FieldDescriptor
-	fd_GenesisState_votes            protoreflect.FieldDescriptor
+	md_GenesisState                        protoreflect.MessageDescriptor
+	fd_GenesisState_group_seq              protoreflect.FieldDescriptor
+	fd_GenesisState_groups                 protoreflect.FieldDescriptor
+	fd_GenesisState_group_members          protoreflect.FieldDescriptor
+	fd_GenesisState_group_policy_seq       protoreflect.FieldDescriptor
+	fd_GenesisState_group_policies         protoreflect.FieldDescriptor
+	fd_GenesisState_proposal_seq           protoreflect.FieldDescriptor
+	fd_GenesisState_proposals              protoreflect.FieldDescriptor
+	fd_GenesisState_votes                  protoreflect.FieldDescriptor
+	fd_GenesisState_voting_power_snapshots protoreflect.FieldDescriptor
+	fd_GenesisState_execution_logs         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -290,6 +394,8 @@ func init() {
 	fd_GenesisState_proposal_seq = md_GenesisState.Fields().ByName("proposal_seq")
 	fd_GenesisState_proposals = md_GenesisState.Fields().ByName("proposals")
 	fd_GenesisState_votes = md_GenesisState.Fields().ByName("votes")
+	fd_GenesisState_voting_power_snapshots = md_GenesisState.Fields().ByName("voting_power_snapshots")
+	fd_GenesisState_execution_logs = md_GenesisState.Fields().ByName("execution_logs")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -405,6 +511,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.VotingPowerSnapshots) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.VotingPowerSnapshots})
+		if !f(fd_GenesisState_voting_power_snapshots, value) {
+			return
+		}
+	}
+	if len(x.ExecutionLogs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.ExecutionLogs})
+		if !f(fd_GenesisState_execution_logs, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -436,6 +554,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.Proposals) != 0
 	case "cosmos.group.v1.GenesisState.votes":
 		return len(x.Votes) != 0
+	case "cosmos.group.v1.GenesisState.voting_power_snapshots":
+		return len(x.VotingPowerSnapshots) != 0
+	case "cosmos.group.v1.GenesisState.execution_logs":
+		return len(x.ExecutionLogs) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GenesisState"))
@@ -468,6 +590,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Proposals = nil
 	case "cosmos.group.v1.GenesisState.votes":
 		x.Votes = nil
+	case "cosmos.group.v1.GenesisState.voting_power_snapshots":
+		x.VotingPowerSnapshots = nil
+	case "cosmos.group.v1.GenesisState.execution_logs":
+		x.ExecutionLogs = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GenesisState"))
@@ -523,6 +649,18 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_8_list{list: &x.Votes}
 		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.GenesisState.voting_power_snapshots":
+		if len(x.VotingPowerSnapshots) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.VotingPowerSnapshots}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.GenesisState.execution_logs":
+		if len(x.ExecutionLogs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.ExecutionLogs}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GenesisState"))
@@ -569,6 +707,14 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_8_list)
 		x.Votes = *clv.list
+	case "cosmos.group.v1.GenesisState.voting_power_snapshots":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.VotingPowerSnapshots = *clv.list
+	case "cosmos.group.v1.GenesisState.execution_logs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.ExecutionLogs = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GenesisState"))
@@ -619,6 +765,18 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_8_list{list: &x.Votes}
 		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.GenesisState.voting_power_snapshots":
+		if x.VotingPowerSnapshots == nil {
+			x.VotingPowerSnapshots = []*VotingPowerSnapshot{}
+		}
+		value := &_GenesisState_9_list{list: &x.VotingPowerSnapshots}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.GenesisState.execution_logs":
+		if x.ExecutionLogs == nil {
+			x.ExecutionLogs = []*ExecutionLog{}
+		}
+		value := &_GenesisState_10_list{list: &x.ExecutionLogs}
+		return protoreflect.ValueOfList(value)
 	case "cosmos.group.v1.GenesisState.group_seq":
 		panic(fmt.Errorf("field group_seq of message cosmos.group.v1.GenesisState is not mutable"))
 	case "cosmos.group.v1.GenesisState.group_policy_seq":
@@ -659,6 +817,12 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "cosmos.group.v1.GenesisState.votes":
 		list := []*Vote{}
 		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "cosmos.group.v1.GenesisState.voting_power_snapshots":
+		list := []*VotingPowerSnapshot{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "cosmos.group.v1.GenesisState.execution_logs":
+		list := []*ExecutionLog{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GenesisState"))
@@ -767,6 +931,18 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.VotingPowerSnapshots) > 0 {
+			for _, e := range x.VotingPowerSnapshots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExecutionLogs) > 0 {
+			for _, e := range x.ExecutionLogs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -796,6 +972,38 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ExecutionLogs) > 0 {
+			for iNdEx := len(x.ExecutionLogs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExecutionLogs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.VotingPowerSnapshots) > 0 {
+			for iNdEx := len(x.VotingPowerSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingPowerSnapshots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
 		if len(x.Votes) > 0 {
 			for iNdEx := len(x.Votes) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Votes[iNdEx])
@@ -1167,6 +1375,74 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshots", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingPowerSnapshots = append(x.VotingPowerSnapshots, &VotingPowerSnapshot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPowerSnapshots[len(x.VotingPowerSnapshots)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionLogs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionLogs = append(x.ExecutionLogs, &ExecutionLog{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionLogs[len(x.ExecutionLogs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1242,6 +1518,11 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// voting_power_snapshots is the list of voting power snapshots of proposals
+	// with a token weighted decision policy.
+	VotingPowerSnapshots []*VotingPowerSnapshot `protobuf:"bytes,9,rep,name=voting_power_snapshots,json=votingPowerSnapshots,proto3" json:"voting_power_snapshots,omitempty"`
+	// execution_logs is the list of execution logs of proposals.
+	ExecutionLogs []*ExecutionLog `protobuf:"bytes,10,rep,name=execution_logs,json=executionLogs,proto3" json:"execution_logs,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1320,6 +1601,20 @@ func (x *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (x *GenesisState) GetVotingPowerSnapshots() []*VotingPowerSnapshot {
+	if x != nil {
+		return x.VotingPowerSnapshots
+	}
+	return nil
+}
+
+func (x *GenesisState) GetExecutionLogs() []*ExecutionLog {
+	if x != nil {
+		return x.ExecutionLogs
+	}
+	return nil
+}
+
 var File_cosmos_group_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_cosmos_group_v1_genesis_proto_rawDesc = []byte{
@@ -1327,7 +1622,7 @@ var file_cosmos_group_v1_genesis_proto_rawDesc = []byte{
 	0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
 	0x1a, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76,
-	0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x03,
+	0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x04,
 	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b,
 	0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x04, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x71, 0x12, 0x32, 0x0a, 0x06, 0x67,
@@ -1353,19 +1648,29 @@ var file_cosmos_group_v1_genesis_proto_rawDesc = []byte{
 	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
 	0x73, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x42, 0xab,
-	0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x5a,
+	0x0a, 0x16, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x14, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65,
+	0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0e, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
+	0x67, 0x52, 0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73,
+	0x42, 0xab, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1382,12 +1687,14 @@ func file_cosmos_group_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_cosmos_group_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_cosmos_group_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: cosmos.group.v1.GenesisState
-	(*GroupInfo)(nil),       // 1: cosmos.group.v1.GroupInfo
-	(*GroupMember)(nil),     // 2: cosmos.group.v1.GroupMember
-	(*GroupPolicyInfo)(nil), // 3: cosmos.group.v1.GroupPolicyInfo
-	(*Proposal)(nil),        // 4: cosmos.group.v1.Proposal
-	(*Vote)(nil),            // 5: cosmos.group.v1.Vote
+	(*GenesisState)(nil),        // 0: cosmos.group.v1.GenesisState
+	(*GroupInfo)(nil),           // 1: cosmos.group.v1.GroupInfo
+	(*GroupMember)(nil),         // 2: cosmos.group.v1.GroupMember
+	(*GroupPolicyInfo)(nil),     // 3: cosmos.group.v1.GroupPolicyInfo
+	(*Proposal)(nil),            // 4: cosmos.group.v1.Proposal
+	(*Vote)(nil),                // 5: cosmos.group.v1.Vote
+	(*VotingPowerSnapshot)(nil), // 6: cosmos.group.v1.VotingPowerSnapshot
+	(*ExecutionLog)(nil),        // 7: cosmos.group.v1.ExecutionLog
 }
 var file_cosmos_group_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: cosmos.group.v1.GenesisState.groups:type_name -> cosmos.group.v1.GroupInfo
@@ -1395,11 +1702,13 @@ var file_cosmos_group_v1_genesis_proto_depIdxs = []int32{
 	3, // 2: cosmos.group.v1.GenesisState.group_policies:type_name -> cosmos.group.v1.GroupPolicyInfo
 	4, // 3: cosmos.group.v1.GenesisState.proposals:type_name -> cosmos.group.v1.Proposal
 	5, // 4: cosmos.group.v1.GenesisState.votes:type_name -> cosmos.group.v1.Vote
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	6, // 5: cosmos.group.v1.GenesisState.voting_power_snapshots:type_name -> cosmos.group.v1.VotingPowerSnapshot
+	7, // 6: cosmos.group.v1.GenesisState.execution_logs:type_name -> cosmos.group.v1.ExecutionLog
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_group_v1_genesis_proto_init() }
@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.46
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -21,20 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_GroupInfo_FullMethodName              = "/cosmos.group.v1.Query/GroupInfo"
-	Query_GroupPolicyInfo_FullMethodName        = "/cosmos.group.v1.Query/GroupPolicyInfo"
-	Query_GroupMembers_FullMethodName           = "/cosmos.group.v1.Query/GroupMembers"
-	Query_GroupsByAdmin_FullMethodName          = "/cosmos.group.v1.Query/GroupsByAdmin"
-	Query_GroupPoliciesByGroup_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
-	Query_GroupPoliciesByAdmin_FullMethodName   = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
-	Query_Proposal_FullMethodName               = "/cosmos.group.v1.Query/Proposal"
-	Query_ProposalsByGroupPolicy_FullMethodName = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
-	Query_VoteByProposalVoter_FullMethodName    = "/cosmos.group.v1.Query/VoteByProposalVoter"
-	Query_VotesByProposal_FullMethodName        = "/cosmos.group.v1.Query/VotesByProposal"
-	Query_VotesByVoter_FullMethodName           = "/cosmos.group.v1.Query/VotesByVoter"
-	Query_GroupsByMember_FullMethodName         = "/cosmos.group.v1.Query/GroupsByMember"
-	Query_TallyResult_FullMethodName            = "/cosmos.group.v1.Query/TallyResult"
-	Query_Groups_FullMethodName                 = "/cosmos.group.v1.Query/Groups"
+	Query_GroupInfo_FullMethodName               = "/cosmos.group.v1.Query/GroupInfo"
+	Query_GroupPolicyInfo_FullMethodName         = "/cosmos.group.v1.Query/GroupPolicyInfo"
+	Query_GroupMembers_FullMethodName            = "/cosmos.group.v1.Query/GroupMembers"
+	Query_GroupsByAdmin_FullMethodName           = "/cosmos.group.v1.Query/GroupsByAdmin"
+	Query_GroupPoliciesByGroup_FullMethodName    = "/cosmos.group.v1.Query/GroupPoliciesByGroup"
+	Query_GroupPoliciesByAdmin_FullMethodName    = "/cosmos.group.v1.Query/GroupPoliciesByAdmin"
+	Query_Proposal_FullMethodName                = "/cosmos.group.v1.Query/Proposal"
+	Query_ProposalsByGroupPolicy_FullMethodName  = "/cosmos.group.v1.Query/ProposalsByGroupPolicy"
+	Query_VoteByProposalVoter_FullMethodName     = "/cosmos.group.v1.Query/VoteByProposalVoter"
+	Query_VotesByProposal_FullMethodName         = "/cosmos.group.v1.Query/VotesByProposal"
+	Query_VotesByVoter_FullMethodName            = "/cosmos.group.v1.Query/VotesByVoter"
+	Query_GroupsByMember_FullMethodName          = "/cosmos.group.v1.Query/GroupsByMember"
+	Query_TallyResult_FullMethodName             = "/cosmos.group.v1.Query/TallyResult"
+	Query_Groups_FullMethodName                  = "/cosmos.group.v1.Query/Groups"
+	Query_ExecutionLogsByProposal_FullMethodName = "/cosmos.group.v1.Query/ExecutionLogsByProposal"
 )
 
 // QueryClient is the client API for Query service.
@@ -75,6 +74,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ExecutionLogsByProposal queries the execution logs of a proposal.
+	ExecutionLogsByProposal(ctx context.Context, in *QueryExecutionLogsByProposalRequest, opts ...grpc.CallOption) (*QueryExecutionLogsByProposalResponse, error)
 }
 
 type queryClient struct {
@@ -211,6 +212,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ExecutionLogsByProposal(ctx context.Context, in *QueryExecutionLogsByProposalRequest, opts ...grpc.CallOption) (*QueryExecutionLogsByProposalResponse, error) {
+	out := new(QueryExecutionLogsByProposalResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutionLogsByProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -249,6 +259,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ExecutionLogsByProposal queries the execution logs of a proposal.
+	ExecutionLogsByProposal(context.Context, *QueryExecutionLogsByProposalRequest) (*QueryExecutionLogsByProposalResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -298,6 +310,9 @@ func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRe
 func (UnimplementedQueryServer) Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (UnimplementedQueryServer) ExecutionLogsByProposal(context.Context, *QueryExecutionLogsByProposalRequest) (*QueryExecutionLogsByProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionLogsByProposal not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -563,6 +578,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExecutionLogsByProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionLogsByProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionLogsByProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutionLogsByProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionLogsByProposal(ctx, req.(*QueryExecutionLogsByProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -626,6 +659,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ExecutionLogsByProposal",
+			Handler:    _Query_ExecutionLogsByProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -8826,135 +8826,30 @@ func (x *fastReflection_MsgUpdateGroupPolicyMetadataResponse) ProtoMethods() *pr
 	}
 }
 
-var _ protoreflect.List = (*_MsgSubmitProposal_2_list)(nil)
-
-type _MsgSubmitProposal_2_list struct {
-	list *[]string
-}
-
-func (x *_MsgSubmitProposal_2_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgSubmitProposal_2_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_MsgSubmitProposal_2_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgSubmitProposal_2_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgSubmitProposal_2_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitProposal at list field Proposers as it is not of Message kind"))
-}
-
-func (x *_MsgSubmitProposal_2_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgSubmitProposal_2_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_MsgSubmitProposal_2_list) IsValid() bool {
-	return x.list != nil
-}
-
-var _ protoreflect.List = (*_MsgSubmitProposal_4_list)(nil)
-
-type _MsgSubmitProposal_4_list struct {
-	list *[]*anypb.Any
-}
-
-func (x *_MsgSubmitProposal_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgSubmitProposal_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgSubmitProposal_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgSubmitProposal_4_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_4_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgSubmitProposal_4_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_MsgSubmitProposal_4_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgSubmitProposal                      protoreflect.MessageDescriptor
-	fd_MsgSubmitProposal_group_policy_address protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_proposers            protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_metadata             protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_messages             protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_exec                 protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_title                protoreflect.FieldDescriptor
-	fd_MsgSubmitProposal_summary              protoreflect.FieldDescriptor
+	md_MsgUpdateGroupPolicyExecutionSettings                      protoreflect.MessageDescriptor
+	fd_MsgUpdateGroupPolicyExecutionSettings_admin                protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupPolicyExecutionSettings_group_policy_address protoreflect.FieldDescriptor
+	fd_MsgUpdateGroupPolicyExecutionSettings_execution_settings   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgSubmitProposal = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitProposal")
-	fd_MsgSubmitProposal_group_policy_address = md_MsgSubmitProposal.Fields().ByName("group_policy_address")
-	fd_MsgSubmitProposal_proposers = md_MsgSubmitProposal.Fields().ByName("proposers")
-	fd_MsgSubmitProposal_metadata = md_MsgSubmitProposal.Fields().ByName("metadata")
-	fd_MsgSubmitProposal_messages = md_MsgSubmitProposal.Fields().ByName("messages")
-	fd_MsgSubmitProposal_exec = md_MsgSubmitProposal.Fields().ByName("exec")
-	fd_MsgSubmitProposal_title = md_MsgSubmitProposal.Fields().ByName("title")
-	fd_MsgSubmitProposal_summary = md_MsgSubmitProposal.Fields().ByName("summary")
+	md_MsgUpdateGroupPolicyExecutionSettings = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgUpdateGroupPolicyExecutionSettings")
+	fd_MsgUpdateGroupPolicyExecutionSettings_admin = md_MsgUpdateGroupPolicyExecutionSettings.Fields().ByName("admin")
+	fd_MsgUpdateGroupPolicyExecutionSettings_group_policy_address = md_MsgUpdateGroupPolicyExecutionSettings.Fields().ByName("group_policy_address")
+	fd_MsgUpdateGroupPolicyExecutionSettings_execution_settings = md_MsgUpdateGroupPolicyExecutionSettings.Fields().ByName("execution_settings")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitProposal)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupPolicyExecutionSettings)(nil)
 
-type fastReflection_MsgSubmitProposal MsgSubmitProposal
+type fastReflection_MsgUpdateGroupPolicyExecutionSettings MsgUpdateGroupPolicyExecutionSettings
 
-func (x *MsgSubmitProposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposal)(x)
+func (x *MsgUpdateGroupPolicyExecutionSettings) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupPolicyExecutionSettings)(x)
 }
 
-func (x *MsgSubmitProposal) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateGroupPolicyExecutionSettings) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_tx_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8966,43 +8861,43 @@ func (x *MsgSubmitProposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitProposal_messageType fastReflection_MsgSubmitProposal_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitProposal_messageType{}
+var _fastReflection_MsgUpdateGroupPolicyExecutionSettings_messageType fastReflection_MsgUpdateGroupPolicyExecutionSettings_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupPolicyExecutionSettings_messageType{}
 
-type fastReflection_MsgSubmitProposal_messageType struct{}
+type fastReflection_MsgUpdateGroupPolicyExecutionSettings_messageType struct{}
 
-func (x fastReflection_MsgSubmitProposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposal)(nil)
+func (x fastReflection_MsgUpdateGroupPolicyExecutionSettings_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupPolicyExecutionSettings)(nil)
 }
-func (x fastReflection_MsgSubmitProposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposal)
+func (x fastReflection_MsgUpdateGroupPolicyExecutionSettings_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupPolicyExecutionSettings)
 }
-func (x fastReflection_MsgSubmitProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposal
+func (x fastReflection_MsgUpdateGroupPolicyExecutionSettings_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupPolicyExecutionSettings
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitProposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposal
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupPolicyExecutionSettings
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitProposal) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitProposal_messageType
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupPolicyExecutionSettings_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitProposal) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposal)
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupPolicyExecutionSettings)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitProposal) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitProposal)(x)
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupPolicyExecutionSettings)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9010,46 +8905,22 @@ func (x *fastReflection_MsgSubmitProposal) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.GroupPolicyAddress != "" {
-		value := protoreflect.ValueOfString(x.GroupPolicyAddress)
-		if !f(fd_MsgSubmitProposal_group_policy_address, value) {
-			return
-		}
-	}
-	if len(x.Proposers) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{list: &x.Proposers})
-		if !f(fd_MsgSubmitProposal_proposers, value) {
-			return
-		}
-	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_MsgSubmitProposal_metadata, value) {
-			return
-		}
-	}
-	if len(x.Messages) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &x.Messages})
-		if !f(fd_MsgSubmitProposal_messages, value) {
-			return
-		}
-	}
-	if x.Exec != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Exec))
-		if !f(fd_MsgSubmitProposal_exec, value) {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_MsgUpdateGroupPolicyExecutionSettings_admin, value) {
 			return
 		}
 	}
-	if x.Title != "" {
-		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_MsgSubmitProposal_title, value) {
+	if x.GroupPolicyAddress != "" {
+		value := protoreflect.ValueOfString(x.GroupPolicyAddress)
+		if !f(fd_MsgUpdateGroupPolicyExecutionSettings_group_policy_address, value) {
 			return
 		}
 	}
-	if x.Summary != "" {
-		value := protoreflect.ValueOfString(x.Summary)
-		if !f(fd_MsgSubmitProposal_summary, value) {
+	if x.ExecutionSettings != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutionSettings.ProtoReflect())
+		if !f(fd_MsgUpdateGroupPolicyExecutionSettings_execution_settings, value) {
 			return
 		}
 	}
@@ -9066,27 +8937,19 @@ func (x *fastReflection_MsgSubmitProposal) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitProposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.admin":
+		return x.Admin != ""
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.group_policy_address":
 		return x.GroupPolicyAddress != ""
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
-		return len(x.Proposers) != 0
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
-		return x.Metadata != ""
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		return len(x.Messages) != 0
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
-		return x.Exec != 0
-	case "cosmos.group.v1.MsgSubmitProposal.title":
-		return x.Title != ""
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
-		return x.Summary != ""
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.execution_settings":
+		return x.ExecutionSettings != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9096,27 +8959,19 @@ func (x *fastReflection_MsgSubmitProposal) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.admin":
+		x.Admin = ""
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.group_policy_address":
 		x.GroupPolicyAddress = ""
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
-		x.Proposers = nil
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
-		x.Metadata = ""
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		x.Messages = nil
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
-		x.Exec = 0
-	case "cosmos.group.v1.MsgSubmitProposal.title":
-		x.Title = ""
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
-		x.Summary = ""
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.execution_settings":
+		x.ExecutionSettings = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9126,40 +8981,22 @@ func (x *fastReflection_MsgSubmitProposal) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
-		value := x.GroupPolicyAddress
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
-		if len(x.Proposers) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{})
-		}
-		listValue := &_MsgSubmitProposal_2_list{list: &x.Proposers}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		if len(x.Messages) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{})
-		}
-		listValue := &_MsgSubmitProposal_4_list{list: &x.Messages}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
-		value := x.Exec
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.group.v1.MsgSubmitProposal.title":
-		value := x.Title
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.admin":
+		value := x.Admin
 		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
-		value := x.Summary
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.group_policy_address":
+		value := x.GroupPolicyAddress
 		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.execution_settings":
+		value := x.ExecutionSettings
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9173,31 +9010,19 @@ func (x *fastReflection_MsgSubmitProposal) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.admin":
+		x.Admin = value.Interface().(string)
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.group_policy_address":
 		x.GroupPolicyAddress = value.Interface().(string)
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
-		lv := value.List()
-		clv := lv.(*_MsgSubmitProposal_2_list)
-		x.Proposers = *clv.list
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
-		x.Metadata = value.Interface().(string)
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		lv := value.List()
-		clv := lv.(*_MsgSubmitProposal_4_list)
-		x.Messages = *clv.list
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
-		x.Exec = (Exec)(value.Enum())
-	case "cosmos.group.v1.MsgSubmitProposal.title":
-		x.Title = value.Interface().(string)
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
-		x.Summary = value.Interface().(string)
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.execution_settings":
+		x.ExecutionSettings = value.Message().Interface().(*ExecutionSettings)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9211,74 +9036,52 @@ func (x *fastReflection_MsgSubmitProposal) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
-		if x.Proposers == nil {
-			x.Proposers = []string{}
-		}
-		value := &_MsgSubmitProposal_2_list{list: &x.Proposers}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		if x.Messages == nil {
-			x.Messages = []*anypb.Any{}
-		}
-		value := &_MsgSubmitProposal_4_list{list: &x.Messages}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
-		panic(fmt.Errorf("field group_policy_address of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
-		panic(fmt.Errorf("field exec of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
-	case "cosmos.group.v1.MsgSubmitProposal.title":
-		panic(fmt.Errorf("field title of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
-		panic(fmt.Errorf("field summary of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.execution_settings":
+		if x.ExecutionSettings == nil {
+			x.ExecutionSettings = new(ExecutionSettings)
+		}
+		return protoreflect.ValueOfMessage(x.ExecutionSettings.ProtoReflect())
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.admin":
+		panic(fmt.Errorf("field admin of message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings is not mutable"))
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.group_policy_address":
+		panic(fmt.Errorf("field group_policy_address of message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.MsgSubmitProposal.proposers":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{list: &list})
-	case "cosmos.group.v1.MsgSubmitProposal.metadata":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.MsgSubmitProposal.messages":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &list})
-	case "cosmos.group.v1.MsgSubmitProposal.exec":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.group.v1.MsgSubmitProposal.title":
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.admin":
 		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.MsgSubmitProposal.summary":
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.group_policy_address":
 		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.execution_settings":
+		m := new(ExecutionSettings)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitProposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9286,7 +9089,7 @@ func (x *fastReflection_MsgSubmitProposal) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitProposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9297,7 +9100,7 @@ func (x *fastReflection_MsgSubmitProposal) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9309,7 +9112,7 @@ func (x *fastReflection_MsgSubmitProposal) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitProposal) IsValid() bool {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) IsValid() bool {
 	return x != nil
 }
 
@@ -9319,9 +9122,9 @@ func (x *fastReflection_MsgSubmitProposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettings) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitProposal)
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyExecutionSettings)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9333,35 +9136,16 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.GroupPolicyAddress)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Proposers) > 0 {
-			for _, s := range x.Proposers {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		l = len(x.Metadata)
+		l = len(x.Admin)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Messages) > 0 {
-			for _, e := range x.Messages {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.Exec != 0 {
-			n += 1 + runtime.Sov(uint64(x.Exec))
-		}
-		l = len(x.Title)
+		l = len(x.GroupPolicyAddress)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Summary)
-		if l > 0 {
+		if x.ExecutionSettings != nil {
+			l = options.Size(x.ExecutionSettings)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -9374,7 +9158,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposal)
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyExecutionSettings)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9393,62 +9177,32 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Summary) > 0 {
-			i -= len(x.Summary)
-			copy(dAtA[i:], x.Summary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.Title) > 0 {
-			i -= len(x.Title)
-			copy(dAtA[i:], x.Title)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if x.Exec != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exec))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Messages) > 0 {
-			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Messages[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
+		if x.ExecutionSettings != nil {
+			encoded, err := options.Marshal(x.ExecutionSettings)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Proposers) > 0 {
-			for iNdEx := len(x.Proposers) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Proposers[iNdEx])
-				copy(dAtA[i:], x.Proposers[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposers[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
 		if len(x.GroupPolicyAddress) > 0 {
 			i -= len(x.GroupPolicyAddress)
 			copy(dAtA[i:], x.GroupPolicyAddress)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPolicyAddress)))
 			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -9462,7 +9216,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposal)
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyExecutionSettings)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9494,15 +9248,15 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionSettings: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9530,11 +9284,11 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+				x.Admin = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9562,43 +9316,11 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Proposers = append(x.Proposers, string(dAtA[iNdEx:postIndex]))
+				x.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionSettings", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -9625,35 +9347,2117 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Messages = append(x.Messages, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+				if x.ExecutionSettings == nil {
+					x.ExecutionSettings = &ExecutionSettings{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionSettings); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Exec = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Exec |= Exec(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateGroupPolicyExecutionSettingsResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_tx_proto_init()
+	md_MsgUpdateGroupPolicyExecutionSettingsResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgUpdateGroupPolicyExecutionSettingsResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse)(nil)
+
+type fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse MsgUpdateGroupPolicyExecutionSettingsResponse
+
+func (x *MsgUpdateGroupPolicyExecutionSettingsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse)(x)
+}
+
+func (x *MsgUpdateGroupPolicyExecutionSettingsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse_messageType fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse_messageType{}
+
+type fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse_messageType struct{}
+
+func (x fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse)(nil)
+}
+func (x fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse)
+}
+func (x fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupPolicyExecutionSettingsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateGroupPolicyExecutionSettingsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateGroupPolicyExecutionSettingsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateGroupPolicyExecutionSettingsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyExecutionSettingsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyExecutionSettingsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateGroupPolicyExecutionSettingsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionSettingsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSubmitProposal_2_list)(nil)
+
+type _MsgSubmitProposal_2_list struct {
+	list *[]string
+}
+
+func (x *_MsgSubmitProposal_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitProposal_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSubmitProposal_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitProposal_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitProposal_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitProposal at list field Proposers as it is not of Message kind"))
+}
+
+func (x *_MsgSubmitProposal_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitProposal_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSubmitProposal_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgSubmitProposal_4_list)(nil)
+
+type _MsgSubmitProposal_4_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_MsgSubmitProposal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitProposal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitProposal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitProposal_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitProposal_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_MsgSubmitProposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSubmitProposal                      protoreflect.MessageDescriptor
+	fd_MsgSubmitProposal_group_policy_address protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_proposers            protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_metadata             protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_messages             protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_exec                 protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_title                protoreflect.FieldDescriptor
+	fd_MsgSubmitProposal_summary              protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_tx_proto_init()
+	md_MsgSubmitProposal = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitProposal")
+	fd_MsgSubmitProposal_group_policy_address = md_MsgSubmitProposal.Fields().ByName("group_policy_address")
+	fd_MsgSubmitProposal_proposers = md_MsgSubmitProposal.Fields().ByName("proposers")
+	fd_MsgSubmitProposal_metadata = md_MsgSubmitProposal.Fields().ByName("metadata")
+	fd_MsgSubmitProposal_messages = md_MsgSubmitProposal.Fields().ByName("messages")
+	fd_MsgSubmitProposal_exec = md_MsgSubmitProposal.Fields().ByName("exec")
+	fd_MsgSubmitProposal_title = md_MsgSubmitProposal.Fields().ByName("title")
+	fd_MsgSubmitProposal_summary = md_MsgSubmitProposal.Fields().ByName("summary")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitProposal)(nil)
+
+type fastReflection_MsgSubmitProposal MsgSubmitProposal
+
+func (x *MsgSubmitProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposal)(x)
+}
+
+func (x *MsgSubmitProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitProposal_messageType fastReflection_MsgSubmitProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitProposal_messageType{}
+
+type fastReflection_MsgSubmitProposal_messageType struct{}
+
+func (x fastReflection_MsgSubmitProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposal)(nil)
+}
+func (x fastReflection_MsgSubmitProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposal)
+}
+func (x fastReflection_MsgSubmitProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitProposal) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitProposal) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.GroupPolicyAddress != "" {
+		value := protoreflect.ValueOfString(x.GroupPolicyAddress)
+		if !f(fd_MsgSubmitProposal_group_policy_address, value) {
+			return
+		}
+	}
+	if len(x.Proposers) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{list: &x.Proposers})
+		if !f(fd_MsgSubmitProposal_proposers, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_MsgSubmitProposal_metadata, value) {
+			return
+		}
+	}
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &x.Messages})
+		if !f(fd_MsgSubmitProposal_messages, value) {
+			return
+		}
+	}
+	if x.Exec != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Exec))
+		if !f(fd_MsgSubmitProposal_exec, value) {
+			return
+		}
+	}
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_MsgSubmitProposal_title, value) {
+			return
+		}
+	}
+	if x.Summary != "" {
+		value := protoreflect.ValueOfString(x.Summary)
+		if !f(fd_MsgSubmitProposal_summary, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		return x.GroupPolicyAddress != ""
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		return len(x.Proposers) != 0
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		return x.Metadata != ""
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		return len(x.Messages) != 0
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		return x.Exec != 0
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		return x.Title != ""
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		return x.Summary != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		x.GroupPolicyAddress = ""
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		x.Proposers = nil
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		x.Metadata = ""
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		x.Messages = nil
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		x.Exec = 0
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		x.Title = ""
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		x.Summary = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		value := x.GroupPolicyAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		if len(x.Proposers) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{})
+		}
+		listValue := &_MsgSubmitProposal_2_list{list: &x.Proposers}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{})
+		}
+		listValue := &_MsgSubmitProposal_4_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		value := x.Exec
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		value := x.Summary
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		x.GroupPolicyAddress = value.Interface().(string)
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitProposal_2_list)
+		x.Proposers = *clv.list
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitProposal_4_list)
+		x.Messages = *clv.list
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		x.Exec = (Exec)(value.Enum())
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		x.Title = value.Interface().(string)
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		x.Summary = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		if x.Proposers == nil {
+			x.Proposers = []string{}
+		}
+		value := &_MsgSubmitProposal_2_list{list: &x.Proposers}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
+		}
+		value := &_MsgSubmitProposal_4_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		panic(fmt.Errorf("field group_policy_address of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		panic(fmt.Errorf("field exec of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		panic(fmt.Errorf("field title of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		panic(fmt.Errorf("field summary of message cosmos.group.v1.MsgSubmitProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposal.group_policy_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgSubmitProposal.proposers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSubmitProposal_2_list{list: &list})
+	case "cosmos.group.v1.MsgSubmitProposal.metadata":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgSubmitProposal.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_MsgSubmitProposal_4_list{list: &list})
+	case "cosmos.group.v1.MsgSubmitProposal.exec":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.MsgSubmitProposal.title":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgSubmitProposal.summary":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.GroupPolicyAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Proposers) > 0 {
+			for _, s := range x.Proposers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Exec != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exec))
+		}
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Summary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Summary) > 0 {
+			i -= len(x.Summary)
+			copy(dAtA[i:], x.Summary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Exec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exec))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Proposers) > 0 {
+			for iNdEx := len(x.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proposers[iNdEx])
+				copy(dAtA[i:], x.Proposers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposers[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.GroupPolicyAddress) > 0 {
+			i -= len(x.GroupPolicyAddress)
+			copy(dAtA[i:], x.GroupPolicyAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPolicyAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposers = append(x.Proposers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+				}
+				x.Exec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exec |= Exec(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Summary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitProposalResponse             protoreflect.MessageDescriptor
+	fd_MsgSubmitProposalResponse_proposal_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_tx_proto_init()
+	md_MsgSubmitProposalResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitProposalResponse")
+	fd_MsgSubmitProposalResponse_proposal_id = md_MsgSubmitProposalResponse.Fields().ByName("proposal_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitProposalResponse)(nil)
+
+type fastReflection_MsgSubmitProposalResponse MsgSubmitProposalResponse
+
+func (x *MsgSubmitProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposalResponse)(x)
+}
+
+func (x *MsgSubmitProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitProposalResponse_messageType fastReflection_MsgSubmitProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitProposalResponse_messageType{}
+
+type fastReflection_MsgSubmitProposalResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitProposalResponse)(nil)
+}
+func (x fastReflection_MsgSubmitProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposalResponse)
+}
+func (x fastReflection_MsgSubmitProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposalResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitProposalResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitProposalResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitProposalResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitProposalResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_MsgSubmitProposalResponse_proposal_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		return x.ProposalId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		x.ProposalId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		x.ProposalId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgSubmitProposalResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitProposalResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitProposalResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitProposalResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgWithdrawProposal             protoreflect.MessageDescriptor
+	fd_MsgWithdrawProposal_proposal_id protoreflect.FieldDescriptor
+	fd_MsgWithdrawProposal_address     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_tx_proto_init()
+	md_MsgWithdrawProposal = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgWithdrawProposal")
+	fd_MsgWithdrawProposal_proposal_id = md_MsgWithdrawProposal.Fields().ByName("proposal_id")
+	fd_MsgWithdrawProposal_address = md_MsgWithdrawProposal.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgWithdrawProposal)(nil)
+
+type fastReflection_MsgWithdrawProposal MsgWithdrawProposal
+
+func (x *MsgWithdrawProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawProposal)(x)
+}
+
+func (x *MsgWithdrawProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgWithdrawProposal_messageType fastReflection_MsgWithdrawProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdrawProposal_messageType{}
+
+type fastReflection_MsgWithdrawProposal_messageType struct{}
+
+func (x fastReflection_MsgWithdrawProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawProposal)(nil)
+}
+func (x fastReflection_MsgWithdrawProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawProposal)
+}
+func (x fastReflection_MsgWithdrawProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawProposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgWithdrawProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawProposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgWithdrawProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdrawProposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgWithdrawProposal) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawProposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgWithdrawProposal) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdrawProposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgWithdrawProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_MsgWithdrawProposal_proposal_id, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_MsgWithdrawProposal_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgWithdrawProposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.MsgWithdrawProposal.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawProposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.MsgWithdrawProposal.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgWithdrawProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.MsgWithdrawProposal.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.MsgWithdrawProposal.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgWithdrawProposal is not mutable"))
+	case "cosmos.group.v1.MsgWithdrawProposal.address":
+		panic(fmt.Errorf("field address of message cosmos.group.v1.MsgWithdrawProposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgWithdrawProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.MsgWithdrawProposal.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgWithdrawProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgWithdrawProposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgWithdrawProposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgWithdrawProposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgWithdrawProposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgWithdrawProposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawProposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgWithdrawProposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				var stringLen uint64
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9663,27 +11467,14 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Title = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 7:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9711,7 +11502,7 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Summary = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -9749,26 +11540,24 @@ func (x *fastReflection_MsgSubmitProposal) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSubmitProposalResponse             protoreflect.MessageDescriptor
-	fd_MsgSubmitProposalResponse_proposal_id protoreflect.FieldDescriptor
+	md_MsgWithdrawProposalResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgSubmitProposalResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgSubmitProposalResponse")
-	fd_MsgSubmitProposalResponse_proposal_id = md_MsgSubmitProposalResponse.Fields().ByName("proposal_id")
+	md_MsgWithdrawProposalResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgWithdrawProposalResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgWithdrawProposalResponse)(nil)
 
-type fastReflection_MsgSubmitProposalResponse MsgSubmitProposalResponse
+type fastReflection_MsgWithdrawProposalResponse MsgWithdrawProposalResponse
 
-func (x *MsgSubmitProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposalResponse)(x)
+func (x *MsgWithdrawProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawProposalResponse)(x)
 }
 
-func (x *MsgSubmitProposalResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[19]
+func (x *MsgWithdrawProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9779,43 +11568,43 @@ func (x *MsgSubmitProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitProposalResponse_messageType fastReflection_MsgSubmitProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitProposalResponse_messageType{}
+var _fastReflection_MsgWithdrawProposalResponse_messageType fastReflection_MsgWithdrawProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWithdrawProposalResponse_messageType{}
 
-type fastReflection_MsgSubmitProposalResponse_messageType struct{}
+type fastReflection_MsgWithdrawProposalResponse_messageType struct{}
 
-func (x fastReflection_MsgSubmitProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitProposalResponse)(nil)
+func (x fastReflection_MsgWithdrawProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWithdrawProposalResponse)(nil)
 }
-func (x fastReflection_MsgSubmitProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposalResponse)
+func (x fastReflection_MsgWithdrawProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawProposalResponse)
 }
-func (x fastReflection_MsgSubmitProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposalResponse
+func (x fastReflection_MsgWithdrawProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitProposalResponse
+func (x *fastReflection_MsgWithdrawProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWithdrawProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitProposalResponse_messageType
+func (x *fastReflection_MsgWithdrawProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWithdrawProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitProposalResponse)
+func (x *fastReflection_MsgWithdrawProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgWithdrawProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitProposalResponse)(x)
+func (x *fastReflection_MsgWithdrawProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgWithdrawProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9823,13 +11612,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_MsgSubmitProposalResponse_proposal_id, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgWithdrawProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -9843,15 +11626,13 @@ func (x *fastReflection_MsgSubmitProposalResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgWithdrawProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
-		return x.ProposalId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9861,15 +11642,13 @@ func (x *fastReflection_MsgSubmitProposalResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgWithdrawProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
-		x.ProposalId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9879,16 +11658,13 @@ func (x *fastReflection_MsgSubmitProposalResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWithdrawProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9902,15 +11678,13 @@ func (x *fastReflection_MsgSubmitProposalResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgWithdrawProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
-		x.ProposalId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9924,40 +11698,36 @@ func (x *fastReflection_MsgSubmitProposalResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWithdrawProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgSubmitProposalResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWithdrawProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgSubmitProposalResponse.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgSubmitProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgSubmitProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgWithdrawProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgSubmitProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgWithdrawProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9965,7 +11735,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgWithdrawProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9976,7 +11746,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgWithdrawProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9988,7 +11758,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitProposalResponse) IsValid() bool {
+func (x *fastReflection_MsgWithdrawProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -9998,9 +11768,9 @@ func (x *fastReflection_MsgSubmitProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgWithdrawProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		x := input.Message.Interface().(*MsgWithdrawProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10012,9 +11782,6 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10025,7 +11792,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		x := input.Message.Interface().(*MsgWithdrawProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10044,11 +11811,6 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -10060,7 +11822,7 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitProposalResponse)
+		x := input.Message.Interface().(*MsgWithdrawProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10092,31 +11854,12 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-				}
-				x.ProposalId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10153,28 +11896,34 @@ func (x *fastReflection_MsgSubmitProposalResponse) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_MsgWithdrawProposal             protoreflect.MessageDescriptor
-	fd_MsgWithdrawProposal_proposal_id protoreflect.FieldDescriptor
-	fd_MsgWithdrawProposal_address     protoreflect.FieldDescriptor
+	md_MsgVote             protoreflect.MessageDescriptor
+	fd_MsgVote_proposal_id protoreflect.FieldDescriptor
+	fd_MsgVote_voter       protoreflect.FieldDescriptor
+	fd_MsgVote_option      protoreflect.FieldDescriptor
+	fd_MsgVote_metadata    protoreflect.FieldDescriptor
+	fd_MsgVote_exec        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgWithdrawProposal = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgWithdrawProposal")
-	fd_MsgWithdrawProposal_proposal_id = md_MsgWithdrawProposal.Fields().ByName("proposal_id")
-	fd_MsgWithdrawProposal_address = md_MsgWithdrawProposal.Fields().ByName("address")
+	md_MsgVote = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgVote")
+	fd_MsgVote_proposal_id = md_MsgVote.Fields().ByName("proposal_id")
+	fd_MsgVote_voter = md_MsgVote.Fields().ByName("voter")
+	fd_MsgVote_option = md_MsgVote.Fields().ByName("option")
+	fd_MsgVote_metadata = md_MsgVote.Fields().ByName("metadata")
+	fd_MsgVote_exec = md_MsgVote.Fields().ByName("exec")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgWithdrawProposal)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVote)(nil)
 
-type fastReflection_MsgWithdrawProposal MsgWithdrawProposal
+type fastReflection_MsgVote MsgVote
 
-func (x *MsgWithdrawProposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawProposal)(x)
+func (x *MsgVote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVote)(x)
 }
 
-func (x *MsgWithdrawProposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[20]
+func (x *MsgVote) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10185,43 +11934,43 @@ func (x *MsgWithdrawProposal) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgWithdrawProposal_messageType fastReflection_MsgWithdrawProposal_messageType
-var _ protoreflect.MessageType = fastReflection_MsgWithdrawProposal_messageType{}
+var _fastReflection_MsgVote_messageType fastReflection_MsgVote_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVote_messageType{}
 
-type fastReflection_MsgWithdrawProposal_messageType struct{}
+type fastReflection_MsgVote_messageType struct{}
 
-func (x fastReflection_MsgWithdrawProposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawProposal)(nil)
+func (x fastReflection_MsgVote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVote)(nil)
 }
-func (x fastReflection_MsgWithdrawProposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawProposal)
+func (x fastReflection_MsgVote_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVote)
 }
-func (x fastReflection_MsgWithdrawProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawProposal
+func (x fastReflection_MsgVote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVote
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgWithdrawProposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawProposal
+func (x *fastReflection_MsgVote) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVote
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgWithdrawProposal) Type() protoreflect.MessageType {
-	return _fastReflection_MsgWithdrawProposal_messageType
+func (x *fastReflection_MsgVote) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVote_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgWithdrawProposal) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawProposal)
+func (x *fastReflection_MsgVote) New() protoreflect.Message {
+	return new(fastReflection_MsgVote)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgWithdrawProposal) Interface() protoreflect.ProtoMessage {
-	return (*MsgWithdrawProposal)(x)
+func (x *fastReflection_MsgVote) Interface() protoreflect.ProtoMessage {
+	return (*MsgVote)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10229,16 +11978,34 @@ func (x *fastReflection_MsgWithdrawProposal) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgWithdrawProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_MsgWithdrawProposal_proposal_id, value) {
+		if !f(fd_MsgVote_proposal_id, value) {
 			return
 		}
 	}
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_MsgWithdrawProposal_address, value) {
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_MsgVote_voter, value) {
+			return
+		}
+	}
+	if x.Option != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Option))
+		if !f(fd_MsgVote_option, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_MsgVote_metadata, value) {
+			return
+		}
+	}
+	if x.Exec != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Exec))
+		if !f(fd_MsgVote_exec, value) {
 			return
 		}
 	}
@@ -10255,17 +12022,23 @@ func (x *fastReflection_MsgWithdrawProposal) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgWithdrawProposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVote) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+	case "cosmos.group.v1.MsgVote.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "cosmos.group.v1.MsgWithdrawProposal.address":
-		return x.Address != ""
+	case "cosmos.group.v1.MsgVote.voter":
+		return x.Voter != ""
+	case "cosmos.group.v1.MsgVote.option":
+		return x.Option != 0
+	case "cosmos.group.v1.MsgVote.metadata":
+		return x.Metadata != ""
+	case "cosmos.group.v1.MsgVote.exec":
+		return x.Exec != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10275,17 +12048,23 @@ func (x *fastReflection_MsgWithdrawProposal) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawProposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVote) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+	case "cosmos.group.v1.MsgVote.proposal_id":
 		x.ProposalId = uint64(0)
-	case "cosmos.group.v1.MsgWithdrawProposal.address":
-		x.Address = ""
+	case "cosmos.group.v1.MsgVote.voter":
+		x.Voter = ""
+	case "cosmos.group.v1.MsgVote.option":
+		x.Option = 0
+	case "cosmos.group.v1.MsgVote.metadata":
+		x.Metadata = ""
+	case "cosmos.group.v1.MsgVote.exec":
+		x.Exec = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10295,19 +12074,28 @@ func (x *fastReflection_MsgWithdrawProposal) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgWithdrawProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+	case "cosmos.group.v1.MsgVote.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.MsgWithdrawProposal.address":
-		value := x.Address
+	case "cosmos.group.v1.MsgVote.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgVote.option":
+		value := x.Option
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.MsgVote.metadata":
+		value := x.Metadata
 		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgVote.exec":
+		value := x.Exec
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10321,17 +12109,23 @@ func (x *fastReflection_MsgWithdrawProposal) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+	case "cosmos.group.v1.MsgVote.proposal_id":
 		x.ProposalId = value.Uint()
-	case "cosmos.group.v1.MsgWithdrawProposal.address":
-		x.Address = value.Interface().(string)
+	case "cosmos.group.v1.MsgVote.voter":
+		x.Voter = value.Interface().(string)
+	case "cosmos.group.v1.MsgVote.option":
+		x.Option = (VoteOption)(value.Enum())
+	case "cosmos.group.v1.MsgVote.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.group.v1.MsgVote.exec":
+		x.Exec = (Exec)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10345,44 +12139,56 @@ func (x *fastReflection_MsgWithdrawProposal) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgWithdrawProposal is not mutable"))
-	case "cosmos.group.v1.MsgWithdrawProposal.address":
-		panic(fmt.Errorf("field address of message cosmos.group.v1.MsgWithdrawProposal is not mutable"))
+	case "cosmos.group.v1.MsgVote.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgVote is not mutable"))
+	case "cosmos.group.v1.MsgVote.voter":
+		panic(fmt.Errorf("field voter of message cosmos.group.v1.MsgVote is not mutable"))
+	case "cosmos.group.v1.MsgVote.option":
+		panic(fmt.Errorf("field option of message cosmos.group.v1.MsgVote is not mutable"))
+	case "cosmos.group.v1.MsgVote.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.group.v1.MsgVote is not mutable"))
+	case "cosmos.group.v1.MsgVote.exec":
+		panic(fmt.Errorf("field exec of message cosmos.group.v1.MsgVote is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgWithdrawProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgWithdrawProposal.proposal_id":
+	case "cosmos.group.v1.MsgVote.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.MsgWithdrawProposal.address":
+	case "cosmos.group.v1.MsgVote.voter":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgVote.option":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.MsgVote.metadata":
 		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgVote.exec":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgWithdrawProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgWithdrawProposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgVote", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10390,7 +12196,7 @@ func (x *fastReflection_MsgWithdrawProposal) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgWithdrawProposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVote) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10401,7 +12207,7 @@ func (x *fastReflection_MsgWithdrawProposal) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawProposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVote) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10413,7 +12219,7 @@ func (x *fastReflection_MsgWithdrawProposal) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgWithdrawProposal) IsValid() bool {
+func (x *fastReflection_MsgVote) IsValid() bool {
 	return x != nil
 }
 
@@ -10423,9 +12229,9 @@ func (x *fastReflection_MsgWithdrawProposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgWithdrawProposal)
+		x := input.Message.Interface().(*MsgVote)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10440,10 +12246,20 @@ func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.Address)
+		l = len(x.Voter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Option != 0 {
+			n += 1 + runtime.Sov(uint64(x.Option))
+		}
+		l = len(x.Metadata)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Exec != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exec))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -10454,7 +12270,7 @@ func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawProposal)
+		x := input.Message.Interface().(*MsgVote)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10473,10 +12289,27 @@ func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.Exec != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exec))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Option != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Option))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -10496,7 +12329,7 @@ func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawProposal)
+		x := input.Message.Interface().(*MsgVote)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10515,30 +12348,81 @@ func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawProposal: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				x.Voter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 				}
-				x.ProposalId = 0
+				x.Option = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10548,14 +12432,14 @@ func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
+					x.Option |= VoteOption(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 2:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -10583,8 +12467,27 @@ func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Metadata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+				}
+				x.Exec = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exec |= Exec(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10621,24 +12524,24 @@ func (x *fastReflection_MsgWithdrawProposal) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgWithdrawProposalResponse protoreflect.MessageDescriptor
+	md_MsgVoteResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgWithdrawProposalResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgWithdrawProposalResponse")
+	md_MsgVoteResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgVoteResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgWithdrawProposalResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVoteResponse)(nil)
 
-type fastReflection_MsgWithdrawProposalResponse MsgWithdrawProposalResponse
+type fastReflection_MsgVoteResponse MsgVoteResponse
 
-func (x *MsgWithdrawProposalResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawProposalResponse)(x)
+func (x *MsgVoteResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVoteResponse)(x)
 }
 
-func (x *MsgWithdrawProposalResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[21]
+func (x *MsgVoteResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10649,43 +12552,43 @@ func (x *MsgWithdrawProposalResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgWithdrawProposalResponse_messageType fastReflection_MsgWithdrawProposalResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgWithdrawProposalResponse_messageType{}
+var _fastReflection_MsgVoteResponse_messageType fastReflection_MsgVoteResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVoteResponse_messageType{}
 
-type fastReflection_MsgWithdrawProposalResponse_messageType struct{}
+type fastReflection_MsgVoteResponse_messageType struct{}
 
-func (x fastReflection_MsgWithdrawProposalResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgWithdrawProposalResponse)(nil)
+func (x fastReflection_MsgVoteResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVoteResponse)(nil)
 }
-func (x fastReflection_MsgWithdrawProposalResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawProposalResponse)
+func (x fastReflection_MsgVoteResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVoteResponse)
 }
-func (x fastReflection_MsgWithdrawProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawProposalResponse
+func (x fastReflection_MsgVoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVoteResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgWithdrawProposalResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgWithdrawProposalResponse
+func (x *fastReflection_MsgVoteResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVoteResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgWithdrawProposalResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgWithdrawProposalResponse_messageType
+func (x *fastReflection_MsgVoteResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVoteResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgWithdrawProposalResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgWithdrawProposalResponse)
+func (x *fastReflection_MsgVoteResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgVoteResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgWithdrawProposalResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgWithdrawProposalResponse)(x)
+func (x *fastReflection_MsgVoteResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgVoteResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10693,7 +12596,7 @@ func (x *fastReflection_MsgWithdrawProposalResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgWithdrawProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgVoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -10707,13 +12610,13 @@ func (x *fastReflection_MsgWithdrawProposalResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgWithdrawProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10723,13 +12626,13 @@ func (x *fastReflection_MsgWithdrawProposalResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVoteResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10739,13 +12642,13 @@ func (x *fastReflection_MsgWithdrawProposalResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgWithdrawProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10759,13 +12662,13 @@ func (x *fastReflection_MsgWithdrawProposalResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10779,36 +12682,36 @@ func (x *fastReflection_MsgWithdrawProposalResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgWithdrawProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgWithdrawProposalResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgWithdrawProposalResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgWithdrawProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgWithdrawProposalResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgVoteResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10816,7 +12719,7 @@ func (x *fastReflection_MsgWithdrawProposalResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgWithdrawProposalResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVoteResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10827,7 +12730,7 @@ func (x *fastReflection_MsgWithdrawProposalResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgWithdrawProposalResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVoteResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10839,7 +12742,7 @@ func (x *fastReflection_MsgWithdrawProposalResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgWithdrawProposalResponse) IsValid() bool {
+func (x *fastReflection_MsgVoteResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -10849,9 +12752,9 @@ func (x *fastReflection_MsgWithdrawProposalResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgWithdrawProposalResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVoteResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgWithdrawProposalResponse)
+		x := input.Message.Interface().(*MsgVoteResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10873,7 +12776,7 @@ func (x *fastReflection_MsgWithdrawProposalResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawProposalResponse)
+		x := input.Message.Interface().(*MsgVoteResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10903,7 +12806,7 @@ func (x *fastReflection_MsgWithdrawProposalResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgWithdrawProposalResponse)
+		x := input.Message.Interface().(*MsgVoteResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10935,10 +12838,10 @@ func (x *fastReflection_MsgWithdrawProposalResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawProposalResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWithdrawProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -10977,34 +12880,28 @@ func (x *fastReflection_MsgWithdrawProposalResponse) ProtoMethods() *protoiface.
 }
 
 var (
-	md_MsgVote             protoreflect.MessageDescriptor
-	fd_MsgVote_proposal_id protoreflect.FieldDescriptor
-	fd_MsgVote_voter       protoreflect.FieldDescriptor
-	fd_MsgVote_option      protoreflect.FieldDescriptor
-	fd_MsgVote_metadata    protoreflect.FieldDescriptor
-	fd_MsgVote_exec        protoreflect.FieldDescriptor
+	md_MsgExec             protoreflect.MessageDescriptor
+	fd_MsgExec_proposal_id protoreflect.FieldDescriptor
+	fd_MsgExec_executor    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgVote = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgVote")
-	fd_MsgVote_proposal_id = md_MsgVote.Fields().ByName("proposal_id")
-	fd_MsgVote_voter = md_MsgVote.Fields().ByName("voter")
-	fd_MsgVote_option = md_MsgVote.Fields().ByName("option")
-	fd_MsgVote_metadata = md_MsgVote.Fields().ByName("metadata")
-	fd_MsgVote_exec = md_MsgVote.Fields().ByName("exec")
+	md_MsgExec = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgExec")
+	fd_MsgExec_proposal_id = md_MsgExec.Fields().ByName("proposal_id")
+	fd_MsgExec_executor = md_MsgExec.Fields().ByName("executor")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgVote)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgExec)(nil)
 
-type fastReflection_MsgVote MsgVote
+type fastReflection_MsgExec MsgExec
 
-func (x *MsgVote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgVote)(x)
+func (x *MsgExec) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExec)(x)
 }
 
-func (x *MsgVote) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[22]
+func (x *MsgExec) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11015,43 +12912,43 @@ func (x *MsgVote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgVote_messageType fastReflection_MsgVote_messageType
-var _ protoreflect.MessageType = fastReflection_MsgVote_messageType{}
+var _fastReflection_MsgExec_messageType fastReflection_MsgExec_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExec_messageType{}
 
-type fastReflection_MsgVote_messageType struct{}
+type fastReflection_MsgExec_messageType struct{}
 
-func (x fastReflection_MsgVote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgVote)(nil)
+func (x fastReflection_MsgExec_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExec)(nil)
 }
-func (x fastReflection_MsgVote_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgVote)
+func (x fastReflection_MsgExec_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExec)
 }
-func (x fastReflection_MsgVote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgVote
+func (x fastReflection_MsgExec_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExec
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgVote) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgVote
+func (x *fastReflection_MsgExec) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExec
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgVote) Type() protoreflect.MessageType {
-	return _fastReflection_MsgVote_messageType
+func (x *fastReflection_MsgExec) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExec_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgVote) New() protoreflect.Message {
-	return new(fastReflection_MsgVote)
+func (x *fastReflection_MsgExec) New() protoreflect.Message {
+	return new(fastReflection_MsgExec)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgVote) Interface() protoreflect.ProtoMessage {
-	return (*MsgVote)(x)
+func (x *fastReflection_MsgExec) Interface() protoreflect.ProtoMessage {
+	return (*MsgExec)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11059,34 +12956,16 @@ func (x *fastReflection_MsgVote) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgVote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgExec) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_MsgVote_proposal_id, value) {
-			return
-		}
-	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_MsgVote_voter, value) {
-			return
-		}
-	}
-	if x.Option != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Option))
-		if !f(fd_MsgVote_option, value) {
-			return
-		}
-	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_MsgVote_metadata, value) {
+		if !f(fd_MsgExec_proposal_id, value) {
 			return
 		}
 	}
-	if x.Exec != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Exec))
-		if !f(fd_MsgVote_exec, value) {
+	if x.Executor != "" {
+		value := protoreflect.ValueOfString(x.Executor)
+		if !f(fd_MsgExec_executor, value) {
 			return
 		}
 	}
@@ -11103,23 +12982,17 @@ func (x *fastReflection_MsgVote) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgVote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgExec) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgVote.proposal_id":
+	case "cosmos.group.v1.MsgExec.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "cosmos.group.v1.MsgVote.voter":
-		return x.Voter != ""
-	case "cosmos.group.v1.MsgVote.option":
-		return x.Option != 0
-	case "cosmos.group.v1.MsgVote.metadata":
-		return x.Metadata != ""
-	case "cosmos.group.v1.MsgVote.exec":
-		return x.Exec != 0
+	case "cosmos.group.v1.MsgExec.executor":
+		return x.Executor != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11129,23 +13002,17 @@ func (x *fastReflection_MsgVote) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgExec) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgVote.proposal_id":
+	case "cosmos.group.v1.MsgExec.proposal_id":
 		x.ProposalId = uint64(0)
-	case "cosmos.group.v1.MsgVote.voter":
-		x.Voter = ""
-	case "cosmos.group.v1.MsgVote.option":
-		x.Option = 0
-	case "cosmos.group.v1.MsgVote.metadata":
-		x.Metadata = ""
-	case "cosmos.group.v1.MsgVote.exec":
-		x.Exec = 0
+	case "cosmos.group.v1.MsgExec.executor":
+		x.Executor = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11155,28 +13022,19 @@ func (x *fastReflection_MsgVote) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgVote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExec) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.MsgVote.proposal_id":
+	case "cosmos.group.v1.MsgExec.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.MsgVote.voter":
-		value := x.Voter
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.MsgVote.option":
-		value := x.Option
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.group.v1.MsgVote.metadata":
-		value := x.Metadata
+	case "cosmos.group.v1.MsgExec.executor":
+		value := x.Executor
 		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.MsgVote.exec":
-		value := x.Exec
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11190,23 +13048,17 @@ func (x *fastReflection_MsgVote) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgExec) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgVote.proposal_id":
+	case "cosmos.group.v1.MsgExec.proposal_id":
 		x.ProposalId = value.Uint()
-	case "cosmos.group.v1.MsgVote.voter":
-		x.Voter = value.Interface().(string)
-	case "cosmos.group.v1.MsgVote.option":
-		x.Option = (VoteOption)(value.Enum())
-	case "cosmos.group.v1.MsgVote.metadata":
-		x.Metadata = value.Interface().(string)
-	case "cosmos.group.v1.MsgVote.exec":
-		x.Exec = (Exec)(value.Enum())
+	case "cosmos.group.v1.MsgExec.executor":
+		x.Executor = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11220,56 +13072,44 @@ func (x *fastReflection_MsgVote) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExec) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgVote.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgVote is not mutable"))
-	case "cosmos.group.v1.MsgVote.voter":
-		panic(fmt.Errorf("field voter of message cosmos.group.v1.MsgVote is not mutable"))
-	case "cosmos.group.v1.MsgVote.option":
-		panic(fmt.Errorf("field option of message cosmos.group.v1.MsgVote is not mutable"))
-	case "cosmos.group.v1.MsgVote.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.group.v1.MsgVote is not mutable"))
-	case "cosmos.group.v1.MsgVote.exec":
-		panic(fmt.Errorf("field exec of message cosmos.group.v1.MsgVote is not mutable"))
+	case "cosmos.group.v1.MsgExec.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgExec is not mutable"))
+	case "cosmos.group.v1.MsgExec.executor":
+		panic(fmt.Errorf("field executor of message cosmos.group.v1.MsgExec is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgVote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExec) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgVote.proposal_id":
+	case "cosmos.group.v1.MsgExec.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.MsgVote.voter":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.MsgVote.option":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.group.v1.MsgVote.metadata":
+	case "cosmos.group.v1.MsgExec.executor":
 		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.MsgVote.exec":
-		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgVote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgExec) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgVote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgExec", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11277,7 +13117,7 @@ func (x *fastReflection_MsgVote) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgVote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgExec) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11288,7 +13128,7 @@ func (x *fastReflection_MsgVote) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgExec) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11300,7 +13140,7 @@ func (x *fastReflection_MsgVote) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgVote) IsValid() bool {
+func (x *fastReflection_MsgExec) IsValid() bool {
 	return x != nil
 }
 
@@ -11310,9 +13150,9 @@ func (x *fastReflection_MsgVote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgVote)
+		x := input.Message.Interface().(*MsgExec)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11327,20 +13167,10 @@ func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.Voter)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Option != 0 {
-			n += 1 + runtime.Sov(uint64(x.Option))
-		}
-		l = len(x.Metadata)
+		l = len(x.Executor)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Exec != 0 {
-			n += 1 + runtime.Sov(uint64(x.Exec))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11351,7 +13181,7 @@ func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgVote)
+		x := input.Message.Interface().(*MsgExec)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11370,27 +13200,10 @@ func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Exec != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exec))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Option != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Option))
-			i--
-			dAtA[i] = 0x18
-		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if len(x.Executor) > 0 {
+			i -= len(x.Executor)
+			copy(dAtA[i:], x.Executor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -11410,7 +13223,7 @@ func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgVote)
+		x := input.Message.Interface().(*MsgExec)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11442,10 +13255,10 @@ func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -11467,94 +13280,11 @@ func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
-				}
-				x.Option = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Option |= VoteOption(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exec", wireType)
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 				}
-				x.Exec = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -11564,11 +13294,24 @@ func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Exec |= Exec(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Executor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11605,24 +13348,26 @@ func (x *fastReflection_MsgVote) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgVoteResponse protoreflect.MessageDescriptor
+	md_MsgExecResponse        protoreflect.MessageDescriptor
+	fd_MsgExecResponse_result protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgVoteResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgVoteResponse")
+	md_MsgExecResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgExecResponse")
+	fd_MsgExecResponse_result = md_MsgExecResponse.Fields().ByName("result")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgVoteResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgExecResponse)(nil)
 
-type fastReflection_MsgVoteResponse MsgVoteResponse
+type fastReflection_MsgExecResponse MsgExecResponse
 
-func (x *MsgVoteResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgVoteResponse)(x)
+func (x *MsgExecResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgExecResponse)(x)
 }
 
-func (x *MsgVoteResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[23]
+func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11633,43 +13378,43 @@ func (x *MsgVoteResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgVoteResponse_messageType fastReflection_MsgVoteResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgVoteResponse_messageType{}
+var _fastReflection_MsgExecResponse_messageType fastReflection_MsgExecResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgExecResponse_messageType{}
 
-type fastReflection_MsgVoteResponse_messageType struct{}
+type fastReflection_MsgExecResponse_messageType struct{}
 
-func (x fastReflection_MsgVoteResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgVoteResponse)(nil)
+func (x fastReflection_MsgExecResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgExecResponse)(nil)
 }
-func (x fastReflection_MsgVoteResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgVoteResponse)
+func (x fastReflection_MsgExecResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResponse)
 }
-func (x fastReflection_MsgVoteResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgVoteResponse
+func (x fastReflection_MsgExecResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgVoteResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgVoteResponse
+func (x *fastReflection_MsgExecResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgExecResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgVoteResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgVoteResponse_messageType
+func (x *fastReflection_MsgExecResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgExecResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgVoteResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgVoteResponse)
+func (x *fastReflection_MsgExecResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgExecResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgVoteResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgVoteResponse)(x)
+func (x *fastReflection_MsgExecResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgExecResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -11677,7 +13422,13 @@ func (x *fastReflection_MsgVoteResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgVoteResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgExecResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Result != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Result))
+		if !f(fd_MsgExecResponse_result, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -11691,13 +13442,15 @@ func (x *fastReflection_MsgVoteResponse) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgVoteResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgExecResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "cosmos.group.v1.MsgExecResponse.result":
+		return x.Result != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11707,13 +13460,15 @@ func (x *fastReflection_MsgVoteResponse) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgExecResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "cosmos.group.v1.MsgExecResponse.result":
+		x.Result = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11723,13 +13478,16 @@ func (x *fastReflection_MsgVoteResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgVoteResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "cosmos.group.v1.MsgExecResponse.result":
+		value := x.Result
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -11743,13 +13501,15 @@ func (x *fastReflection_MsgVoteResponse) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgExecResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "cosmos.group.v1.MsgExecResponse.result":
+		x.Result = (ProposalExecutorResult)(value.Enum())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -11763,36 +13523,40 @@ func (x *fastReflection_MsgVoteResponse) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.group.v1.MsgExecResponse.result":
+		panic(fmt.Errorf("field result of message cosmos.group.v1.MsgExecResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgVoteResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgExecResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "cosmos.group.v1.MsgExecResponse.result":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVoteResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgVoteResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgVoteResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgExecResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgVoteResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgExecResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -11800,7 +13564,7 @@ func (x *fastReflection_MsgVoteResponse) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgVoteResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgExecResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -11811,7 +13575,7 @@ func (x *fastReflection_MsgVoteResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgVoteResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgExecResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -11823,7 +13587,7 @@ func (x *fastReflection_MsgVoteResponse) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgVoteResponse) IsValid() bool {
+func (x *fastReflection_MsgExecResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -11833,9 +13597,9 @@ func (x *fastReflection_MsgVoteResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgVoteResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgVoteResponse)
+		x := input.Message.Interface().(*MsgExecResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11847,6 +13611,9 @@ func (x *fastReflection_MsgVoteResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.Result != 0 {
+			n += 1 + runtime.Sov(uint64(x.Result))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -11857,7 +13624,7 @@ func (x *fastReflection_MsgVoteResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgVoteResponse)
+		x := input.Message.Interface().(*MsgExecResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11876,6 +13643,11 @@ func (x *fastReflection_MsgVoteResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Result != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Result))
+			i--
+			dAtA[i] = 0x10
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -11887,7 +13659,7 @@ func (x *fastReflection_MsgVoteResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgVoteResponse)
+		x := input.Message.Interface().(*MsgExecResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -11919,12 +13691,31 @@ func (x *fastReflection_MsgVoteResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				}
+				x.Result = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Result |= ProposalExecutorResult(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -11961,28 +13752,28 @@ func (x *fastReflection_MsgVoteResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgExec             protoreflect.MessageDescriptor
-	fd_MsgExec_proposal_id protoreflect.FieldDescriptor
-	fd_MsgExec_executor    protoreflect.FieldDescriptor
+	md_MsgLeaveGroup          protoreflect.MessageDescriptor
+	fd_MsgLeaveGroup_address  protoreflect.FieldDescriptor
+	fd_MsgLeaveGroup_group_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgExec = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgExec")
-	fd_MsgExec_proposal_id = md_MsgExec.Fields().ByName("proposal_id")
-	fd_MsgExec_executor = md_MsgExec.Fields().ByName("executor")
+	md_MsgLeaveGroup = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgLeaveGroup")
+	fd_MsgLeaveGroup_address = md_MsgLeaveGroup.Fields().ByName("address")
+	fd_MsgLeaveGroup_group_id = md_MsgLeaveGroup.Fields().ByName("group_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgExec)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgLeaveGroup)(nil)
 
-type fastReflection_MsgExec MsgExec
+type fastReflection_MsgLeaveGroup MsgLeaveGroup
 
-func (x *MsgExec) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgExec)(x)
+func (x *MsgLeaveGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgLeaveGroup)(x)
 }
 
-func (x *MsgExec) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[24]
+func (x *MsgLeaveGroup) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11993,43 +13784,43 @@ func (x *MsgExec) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgExec_messageType fastReflection_MsgExec_messageType
-var _ protoreflect.MessageType = fastReflection_MsgExec_messageType{}
+var _fastReflection_MsgLeaveGroup_messageType fastReflection_MsgLeaveGroup_messageType
+var _ protoreflect.MessageType = fastReflection_MsgLeaveGroup_messageType{}
 
-type fastReflection_MsgExec_messageType struct{}
+type fastReflection_MsgLeaveGroup_messageType struct{}
 
-func (x fastReflection_MsgExec_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgExec)(nil)
+func (x fastReflection_MsgLeaveGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgLeaveGroup)(nil)
 }
-func (x fastReflection_MsgExec_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgExec)
+func (x fastReflection_MsgLeaveGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveGroup)
 }
-func (x fastReflection_MsgExec_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExec
+func (x fastReflection_MsgLeaveGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveGroup
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgExec) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExec
+func (x *fastReflection_MsgLeaveGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveGroup
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgExec) Type() protoreflect.MessageType {
-	return _fastReflection_MsgExec_messageType
+func (x *fastReflection_MsgLeaveGroup) Type() protoreflect.MessageType {
+	return _fastReflection_MsgLeaveGroup_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgExec) New() protoreflect.Message {
-	return new(fastReflection_MsgExec)
+func (x *fastReflection_MsgLeaveGroup) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveGroup)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgExec) Interface() protoreflect.ProtoMessage {
-	return (*MsgExec)(x)
+func (x *fastReflection_MsgLeaveGroup) Interface() protoreflect.ProtoMessage {
+	return (*MsgLeaveGroup)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12037,16 +13828,16 @@ func (x *fastReflection_MsgExec) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgExec) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProposalId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_MsgExec_proposal_id, value) {
+func (x *fastReflection_MsgLeaveGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_MsgLeaveGroup_address, value) {
 			return
 		}
 	}
-	if x.Executor != "" {
-		value := protoreflect.ValueOfString(x.Executor)
-		if !f(fd_MsgExec_executor, value) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_MsgLeaveGroup_group_id, value) {
 			return
 		}
 	}
@@ -12063,17 +13854,17 @@ func (x *fastReflection_MsgExec) Range(f func(protoreflect.FieldDescriptor, prot
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgExec) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExec.proposal_id":
-		return x.ProposalId != uint64(0)
-	case "cosmos.group.v1.MsgExec.executor":
-		return x.Executor != ""
+func (x *fastReflection_MsgLeaveGroup) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.MsgLeaveGroup.address":
+		return x.Address != ""
+	case "cosmos.group.v1.MsgLeaveGroup.group_id":
+		return x.GroupId != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12083,17 +13874,17 @@ func (x *fastReflection_MsgExec) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExec) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgLeaveGroup) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExec.proposal_id":
-		x.ProposalId = uint64(0)
-	case "cosmos.group.v1.MsgExec.executor":
-		x.Executor = ""
+	case "cosmos.group.v1.MsgLeaveGroup.address":
+		x.Address = ""
+	case "cosmos.group.v1.MsgLeaveGroup.group_id":
+		x.GroupId = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12103,19 +13894,19 @@ func (x *fastReflection_MsgExec) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgExec) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLeaveGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.MsgExec.proposal_id":
-		value := x.ProposalId
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.MsgExec.executor":
-		value := x.Executor
+	case "cosmos.group.v1.MsgLeaveGroup.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MsgLeaveGroup.group_id":
+		value := x.GroupId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12129,17 +13920,17 @@ func (x *fastReflection_MsgExec) Get(descriptor protoreflect.FieldDescriptor) pr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExec) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgLeaveGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExec.proposal_id":
-		x.ProposalId = value.Uint()
-	case "cosmos.group.v1.MsgExec.executor":
-		x.Executor = value.Interface().(string)
+	case "cosmos.group.v1.MsgLeaveGroup.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.group.v1.MsgLeaveGroup.group_id":
+		x.GroupId = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12153,44 +13944,44 @@ func (x *fastReflection_MsgExec) Set(fd protoreflect.FieldDescriptor, value prot
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExec) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLeaveGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExec.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgExec is not mutable"))
-	case "cosmos.group.v1.MsgExec.executor":
-		panic(fmt.Errorf("field executor of message cosmos.group.v1.MsgExec is not mutable"))
+	case "cosmos.group.v1.MsgLeaveGroup.address":
+		panic(fmt.Errorf("field address of message cosmos.group.v1.MsgLeaveGroup is not mutable"))
+	case "cosmos.group.v1.MsgLeaveGroup.group_id":
+		panic(fmt.Errorf("field group_id of message cosmos.group.v1.MsgLeaveGroup is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgExec) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLeaveGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExec.proposal_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.MsgExec.executor":
+	case "cosmos.group.v1.MsgLeaveGroup.address":
 		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.MsgLeaveGroup.group_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExec"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExec does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgExec) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgLeaveGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgExec", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgLeaveGroup", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12198,7 +13989,7 @@ func (x *fastReflection_MsgExec) WhichOneof(d protoreflect.OneofDescriptor) prot
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgExec) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgLeaveGroup) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12209,7 +14000,7 @@ func (x *fastReflection_MsgExec) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExec) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgLeaveGroup) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12221,7 +14012,7 @@ func (x *fastReflection_MsgExec) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgExec) IsValid() bool {
+func (x *fastReflection_MsgLeaveGroup) IsValid() bool {
 	return x != nil
 }
 
@@ -12231,9 +14022,9 @@ func (x *fastReflection_MsgExec) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgExec)
+		x := input.Message.Interface().(*MsgLeaveGroup)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12245,13 +14036,13 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.ProposalId != 0 {
-			n += 1 + runtime.Sov(uint64(x.ProposalId))
-		}
-		l = len(x.Executor)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -12262,7 +14053,7 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExec)
+		x := input.Message.Interface().(*MsgLeaveGroup)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12281,17 +14072,17 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Executor) > 0 {
-			i -= len(x.Executor)
-			copy(dAtA[i:], x.Executor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.ProposalId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -12304,7 +14095,7 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExec)
+		x := input.Message.Interface().(*MsgLeaveGroup)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12336,34 +14127,15 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
-				}
-				x.ProposalId = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ProposalId |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -12391,8 +14163,27 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Executor = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -12429,26 +14220,24 @@ func (x *fastReflection_MsgExec) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgExecResponse        protoreflect.MessageDescriptor
-	fd_MsgExecResponse_result protoreflect.FieldDescriptor
+	md_MsgLeaveGroupResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgExecResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgExecResponse")
-	fd_MsgExecResponse_result = md_MsgExecResponse.Fields().ByName("result")
+	md_MsgLeaveGroupResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgLeaveGroupResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgExecResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgLeaveGroupResponse)(nil)
 
-type fastReflection_MsgExecResponse MsgExecResponse
+type fastReflection_MsgLeaveGroupResponse MsgLeaveGroupResponse
 
-func (x *MsgExecResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgExecResponse)(x)
+func (x *MsgLeaveGroupResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgLeaveGroupResponse)(x)
 }
 
-func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[25]
+func (x *MsgLeaveGroupResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12459,43 +14248,43 @@ func (x *MsgExecResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgExecResponse_messageType fastReflection_MsgExecResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgExecResponse_messageType{}
+var _fastReflection_MsgLeaveGroupResponse_messageType fastReflection_MsgLeaveGroupResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgLeaveGroupResponse_messageType{}
 
-type fastReflection_MsgExecResponse_messageType struct{}
+type fastReflection_MsgLeaveGroupResponse_messageType struct{}
 
-func (x fastReflection_MsgExecResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgExecResponse)(nil)
+func (x fastReflection_MsgLeaveGroupResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgLeaveGroupResponse)(nil)
 }
-func (x fastReflection_MsgExecResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgExecResponse)
+func (x fastReflection_MsgLeaveGroupResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveGroupResponse)
 }
-func (x fastReflection_MsgExecResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecResponse
+func (x fastReflection_MsgLeaveGroupResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveGroupResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgExecResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgExecResponse
+func (x *fastReflection_MsgLeaveGroupResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgLeaveGroupResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgExecResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgExecResponse_messageType
+func (x *fastReflection_MsgLeaveGroupResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgLeaveGroupResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgExecResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgExecResponse)
+func (x *fastReflection_MsgLeaveGroupResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgLeaveGroupResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgExecResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgExecResponse)(x)
+func (x *fastReflection_MsgLeaveGroupResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgLeaveGroupResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12503,13 +14292,7 @@ func (x *fastReflection_MsgExecResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgExecResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Result != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Result))
-		if !f(fd_MsgExecResponse_result, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgLeaveGroupResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -12523,15 +14306,13 @@ func (x *fastReflection_MsgExecResponse) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgExecResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgLeaveGroupResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExecResponse.result":
-		return x.Result != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12541,15 +14322,13 @@ func (x *fastReflection_MsgExecResponse) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgLeaveGroupResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExecResponse.result":
-		x.Result = 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12559,16 +14338,13 @@ func (x *fastReflection_MsgExecResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgExecResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLeaveGroupResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.MsgExecResponse.result":
-		value := x.Result
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -12582,15 +14358,13 @@ func (x *fastReflection_MsgExecResponse) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExecResponse.result":
-		x.Result = (ProposalExecutorResult)(value.Enum())
+func (x *fastReflection_MsgLeaveGroupResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12604,40 +14378,36 @@ func (x *fastReflection_MsgExecResponse) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLeaveGroupResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExecResponse.result":
-		panic(fmt.Errorf("field result of message cosmos.group.v1.MsgExecResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgExecResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgLeaveGroupResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgExecResponse.result":
-		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgExecResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgExecResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgExecResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgLeaveGroupResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgExecResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgLeaveGroupResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -12645,7 +14415,7 @@ func (x *fastReflection_MsgExecResponse) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgExecResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgLeaveGroupResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -12656,7 +14426,7 @@ func (x *fastReflection_MsgExecResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgExecResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgLeaveGroupResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -12668,7 +14438,7 @@ func (x *fastReflection_MsgExecResponse) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgExecResponse) IsValid() bool {
+func (x *fastReflection_MsgLeaveGroupResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -12678,9 +14448,9 @@ func (x *fastReflection_MsgExecResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgLeaveGroupResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgExecResponse)
+		x := input.Message.Interface().(*MsgLeaveGroupResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12692,9 +14462,6 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Result != 0 {
-			n += 1 + runtime.Sov(uint64(x.Result))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -12705,7 +14472,7 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecResponse)
+		x := input.Message.Interface().(*MsgLeaveGroupResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12724,11 +14491,6 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Result != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Result))
-			i--
-			dAtA[i] = 0x10
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -12740,7 +14502,7 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgExecResponse)
+		x := input.Message.Interface().(*MsgLeaveGroupResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -12772,31 +14534,12 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-				}
-				x.Result = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Result |= ProposalExecutorResult(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -12833,28 +14576,28 @@ func (x *fastReflection_MsgExecResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgLeaveGroup          protoreflect.MessageDescriptor
-	fd_MsgLeaveGroup_address  protoreflect.FieldDescriptor
-	fd_MsgLeaveGroup_group_id protoreflect.FieldDescriptor
+	md_MsgVetoProposal             protoreflect.MessageDescriptor
+	fd_MsgVetoProposal_proposal_id protoreflect.FieldDescriptor
+	fd_MsgVetoProposal_vetoer      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgLeaveGroup = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgLeaveGroup")
-	fd_MsgLeaveGroup_address = md_MsgLeaveGroup.Fields().ByName("address")
-	fd_MsgLeaveGroup_group_id = md_MsgLeaveGroup.Fields().ByName("group_id")
+	md_MsgVetoProposal = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgVetoProposal")
+	fd_MsgVetoProposal_proposal_id = md_MsgVetoProposal.Fields().ByName("proposal_id")
+	fd_MsgVetoProposal_vetoer = md_MsgVetoProposal.Fields().ByName("vetoer")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgLeaveGroup)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVetoProposal)(nil)
 
-type fastReflection_MsgLeaveGroup MsgLeaveGroup
+type fastReflection_MsgVetoProposal MsgVetoProposal
 
-func (x *MsgLeaveGroup) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgLeaveGroup)(x)
+func (x *MsgVetoProposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVetoProposal)(x)
 }
 
-func (x *MsgLeaveGroup) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[26]
+func (x *MsgVetoProposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12865,43 +14608,43 @@ func (x *MsgLeaveGroup) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgLeaveGroup_messageType fastReflection_MsgLeaveGroup_messageType
-var _ protoreflect.MessageType = fastReflection_MsgLeaveGroup_messageType{}
+var _fastReflection_MsgVetoProposal_messageType fastReflection_MsgVetoProposal_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVetoProposal_messageType{}
 
-type fastReflection_MsgLeaveGroup_messageType struct{}
+type fastReflection_MsgVetoProposal_messageType struct{}
 
-func (x fastReflection_MsgLeaveGroup_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgLeaveGroup)(nil)
+func (x fastReflection_MsgVetoProposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVetoProposal)(nil)
 }
-func (x fastReflection_MsgLeaveGroup_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgLeaveGroup)
+func (x fastReflection_MsgVetoProposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVetoProposal)
 }
-func (x fastReflection_MsgLeaveGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgLeaveGroup
+func (x fastReflection_MsgVetoProposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVetoProposal
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgLeaveGroup) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgLeaveGroup
+func (x *fastReflection_MsgVetoProposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVetoProposal
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgLeaveGroup) Type() protoreflect.MessageType {
-	return _fastReflection_MsgLeaveGroup_messageType
+func (x *fastReflection_MsgVetoProposal) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVetoProposal_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgLeaveGroup) New() protoreflect.Message {
-	return new(fastReflection_MsgLeaveGroup)
+func (x *fastReflection_MsgVetoProposal) New() protoreflect.Message {
+	return new(fastReflection_MsgVetoProposal)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgLeaveGroup) Interface() protoreflect.ProtoMessage {
-	return (*MsgLeaveGroup)(x)
+func (x *fastReflection_MsgVetoProposal) Interface() protoreflect.ProtoMessage {
+	return (*MsgVetoProposal)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -12909,16 +14652,16 @@ func (x *fastReflection_MsgLeaveGroup) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgLeaveGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_MsgLeaveGroup_address, value) {
+func (x *fastReflection_MsgVetoProposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_MsgVetoProposal_proposal_id, value) {
 			return
 		}
 	}
-	if x.GroupId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GroupId)
-		if !f(fd_MsgLeaveGroup_group_id, value) {
+	if x.Vetoer != "" {
+		value := protoreflect.ValueOfString(x.Vetoer)
+		if !f(fd_MsgVetoProposal_vetoer, value) {
 			return
 		}
 	}
@@ -12935,17 +14678,17 @@ func (x *fastReflection_MsgLeaveGroup) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgLeaveGroup) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVetoProposal) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgLeaveGroup.address":
-		return x.Address != ""
-	case "cosmos.group.v1.MsgLeaveGroup.group_id":
-		return x.GroupId != uint64(0)
+	case "cosmos.group.v1.MsgVetoProposal.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.MsgVetoProposal.vetoer":
+		return x.Vetoer != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12955,17 +14698,17 @@ func (x *fastReflection_MsgLeaveGroup) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLeaveGroup) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVetoProposal) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgLeaveGroup.address":
-		x.Address = ""
-	case "cosmos.group.v1.MsgLeaveGroup.group_id":
-		x.GroupId = uint64(0)
+	case "cosmos.group.v1.MsgVetoProposal.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.MsgVetoProposal.vetoer":
+		x.Vetoer = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -12975,19 +14718,19 @@ func (x *fastReflection_MsgLeaveGroup) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgLeaveGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.MsgLeaveGroup.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.MsgLeaveGroup.group_id":
-		value := x.GroupId
+	case "cosmos.group.v1.MsgVetoProposal.proposal_id":
+		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.MsgVetoProposal.vetoer":
+		value := x.Vetoer
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposal does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -13001,17 +14744,17 @@ func (x *fastReflection_MsgLeaveGroup) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLeaveGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVetoProposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgLeaveGroup.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.group.v1.MsgLeaveGroup.group_id":
-		x.GroupId = value.Uint()
+	case "cosmos.group.v1.MsgVetoProposal.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.MsgVetoProposal.vetoer":
+		x.Vetoer = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13025,44 +14768,44 @@ func (x *fastReflection_MsgLeaveGroup) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLeaveGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgLeaveGroup.address":
-		panic(fmt.Errorf("field address of message cosmos.group.v1.MsgLeaveGroup is not mutable"))
-	case "cosmos.group.v1.MsgLeaveGroup.group_id":
-		panic(fmt.Errorf("field group_id of message cosmos.group.v1.MsgLeaveGroup is not mutable"))
+	case "cosmos.group.v1.MsgVetoProposal.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.MsgVetoProposal is not mutable"))
+	case "cosmos.group.v1.MsgVetoProposal.vetoer":
+		panic(fmt.Errorf("field vetoer of message cosmos.group.v1.MsgVetoProposal is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgLeaveGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.MsgLeaveGroup.address":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.MsgLeaveGroup.group_id":
+	case "cosmos.group.v1.MsgVetoProposal.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.MsgVetoProposal.vetoer":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroup"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposal"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroup does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposal does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgLeaveGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVetoProposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgLeaveGroup", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgVetoProposal", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -13070,7 +14813,7 @@ func (x *fastReflection_MsgLeaveGroup) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgLeaveGroup) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVetoProposal) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -13081,7 +14824,7 @@ func (x *fastReflection_MsgLeaveGroup) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLeaveGroup) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVetoProposal) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -13093,7 +14836,7 @@ func (x *fastReflection_MsgLeaveGroup) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgLeaveGroup) IsValid() bool {
+func (x *fastReflection_MsgVetoProposal) IsValid() bool {
 	return x != nil
 }
 
@@ -13103,9 +14846,9 @@ func (x *fastReflection_MsgLeaveGroup) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVetoProposal) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgLeaveGroup)
+		x := input.Message.Interface().(*MsgVetoProposal)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13117,13 +14860,13 @@ func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Vetoer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.GroupId != 0 {
-			n += 1 + runtime.Sov(uint64(x.GroupId))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -13134,7 +14877,7 @@ func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgLeaveGroup)
+		x := input.Message.Interface().(*MsgVetoProposal)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13153,17 +14896,17 @@ func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.GroupId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+		if len(x.Vetoer) > 0 {
+			i -= len(x.Vetoer)
+			copy(dAtA[i:], x.Vetoer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Vetoer)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -13176,7 +14919,7 @@ func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgLeaveGroup)
+		x := input.Message.Interface().(*MsgVetoProposal)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13208,17 +14951,17 @@ func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				var stringLen uint64
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13228,29 +14971,16 @@ func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
 				}
-				x.GroupId = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -13260,11 +14990,24 @@ func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GroupId |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Vetoer = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -13301,24 +15044,24 @@ func (x *fastReflection_MsgLeaveGroup) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgLeaveGroupResponse protoreflect.MessageDescriptor
+	md_MsgVetoProposalResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_tx_proto_init()
-	md_MsgLeaveGroupResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgLeaveGroupResponse")
+	md_MsgVetoProposalResponse = File_cosmos_group_v1_tx_proto.Messages().ByName("MsgVetoProposalResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgLeaveGroupResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgVetoProposalResponse)(nil)
 
-type fastReflection_MsgLeaveGroupResponse MsgLeaveGroupResponse
+type fastReflection_MsgVetoProposalResponse MsgVetoProposalResponse
 
-func (x *MsgLeaveGroupResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgLeaveGroupResponse)(x)
+func (x *MsgVetoProposalResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgVetoProposalResponse)(x)
 }
 
-func (x *MsgLeaveGroupResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_tx_proto_msgTypes[27]
+func (x *MsgVetoProposalResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_tx_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13329,43 +15072,43 @@ func (x *MsgLeaveGroupResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgLeaveGroupResponse_messageType fastReflection_MsgLeaveGroupResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgLeaveGroupResponse_messageType{}
+var _fastReflection_MsgVetoProposalResponse_messageType fastReflection_MsgVetoProposalResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgVetoProposalResponse_messageType{}
 
-type fastReflection_MsgLeaveGroupResponse_messageType struct{}
+type fastReflection_MsgVetoProposalResponse_messageType struct{}
 
-func (x fastReflection_MsgLeaveGroupResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgLeaveGroupResponse)(nil)
+func (x fastReflection_MsgVetoProposalResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgVetoProposalResponse)(nil)
 }
-func (x fastReflection_MsgLeaveGroupResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgLeaveGroupResponse)
+func (x fastReflection_MsgVetoProposalResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgVetoProposalResponse)
 }
-func (x fastReflection_MsgLeaveGroupResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgLeaveGroupResponse
+func (x fastReflection_MsgVetoProposalResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVetoProposalResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgLeaveGroupResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgLeaveGroupResponse
+func (x *fastReflection_MsgVetoProposalResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgVetoProposalResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgLeaveGroupResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgLeaveGroupResponse_messageType
+func (x *fastReflection_MsgVetoProposalResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgVetoProposalResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgLeaveGroupResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgLeaveGroupResponse)
+func (x *fastReflection_MsgVetoProposalResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgVetoProposalResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgLeaveGroupResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgLeaveGroupResponse)(x)
+func (x *fastReflection_MsgVetoProposalResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgVetoProposalResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -13373,7 +15116,7 @@ func (x *fastReflection_MsgLeaveGroupResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgLeaveGroupResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgVetoProposalResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -13387,13 +15130,13 @@ func (x *fastReflection_MsgLeaveGroupResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgLeaveGroupResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgVetoProposalResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13403,13 +15146,13 @@ func (x *fastReflection_MsgLeaveGroupResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLeaveGroupResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgVetoProposalResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13419,13 +15162,13 @@ func (x *fastReflection_MsgLeaveGroupResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgLeaveGroupResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposalResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposalResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -13439,13 +15182,13 @@ func (x *fastReflection_MsgLeaveGroupResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLeaveGroupResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgVetoProposalResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -13459,36 +15202,36 @@ func (x *fastReflection_MsgLeaveGroupResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLeaveGroupResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposalResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgLeaveGroupResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgVetoProposalResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgLeaveGroupResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MsgVetoProposalResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.MsgLeaveGroupResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MsgVetoProposalResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgLeaveGroupResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgVetoProposalResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgLeaveGroupResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MsgVetoProposalResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -13496,7 +15239,7 @@ func (x *fastReflection_MsgLeaveGroupResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgLeaveGroupResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgVetoProposalResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -13507,7 +15250,7 @@ func (x *fastReflection_MsgLeaveGroupResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgLeaveGroupResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgVetoProposalResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -13519,7 +15262,7 @@ func (x *fastReflection_MsgLeaveGroupResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgLeaveGroupResponse) IsValid() bool {
+func (x *fastReflection_MsgVetoProposalResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -13529,9 +15272,9 @@ func (x *fastReflection_MsgLeaveGroupResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgLeaveGroupResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgVetoProposalResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgLeaveGroupResponse)
+		x := input.Message.Interface().(*MsgVetoProposalResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13553,7 +15296,7 @@ func (x *fastReflection_MsgLeaveGroupResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgLeaveGroupResponse)
+		x := input.Message.Interface().(*MsgVetoProposalResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13583,7 +15326,7 @@ func (x *fastReflection_MsgLeaveGroupResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgLeaveGroupResponse)
+		x := input.Message.Interface().(*MsgVetoProposalResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -13615,10 +15358,10 @@ func (x *fastReflection_MsgLeaveGroupResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -14539,6 +16282,89 @@ func (*MsgUpdateGroupPolicyMetadataResponse) Descriptor() ([]byte, []int) {
 	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
+// MsgUpdateGroupPolicyExecutionSettings is the Msg/UpdateGroupPolicyExecutionSettings request type.
+type MsgUpdateGroupPolicyExecutionSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// execution_settings is the updated group policy's execution settings. If
+	// empty, the execution settings are removed.
+	ExecutionSettings *ExecutionSettings `protobuf:"bytes,3,opt,name=execution_settings,json=executionSettings,proto3" json:"execution_settings,omitempty"`
+}
+
+func (x *MsgUpdateGroupPolicyExecutionSettings) Reset() {
+	*x = MsgUpdateGroupPolicyExecutionSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateGroupPolicyExecutionSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateGroupPolicyExecutionSettings) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateGroupPolicyExecutionSettings.ProtoReflect.Descriptor instead.
+func (*MsgUpdateGroupPolicyExecutionSettings) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgUpdateGroupPolicyExecutionSettings) GetAdmin() string {
+	if x != nil {
+		return x.Admin
+	}
+	return ""
+}
+
+func (x *MsgUpdateGroupPolicyExecutionSettings) GetGroupPolicyAddress() string {
+	if x != nil {
+		return x.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (x *MsgUpdateGroupPolicyExecutionSettings) GetExecutionSettings() *ExecutionSettings {
+	if x != nil {
+		return x.ExecutionSettings
+	}
+	return nil
+}
+
+// MsgUpdateGroupPolicyExecutionSettingsResponse is the Msg/UpdateGroupPolicyExecutionSettings response type.
+type MsgUpdateGroupPolicyExecutionSettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateGroupPolicyExecutionSettingsResponse) Reset() {
+	*x = MsgUpdateGroupPolicyExecutionSettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateGroupPolicyExecutionSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateGroupPolicyExecutionSettingsResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateGroupPolicyExecutionSettingsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateGroupPolicyExecutionSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{19}
+}
+
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
 type MsgSubmitProposal struct {
 	state         protoimpl.MessageState
@@ -14571,7 +16397,7 @@ type MsgSubmitProposal struct {
 func (x *MsgSubmitProposal) Reset() {
 	*x = MsgSubmitProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[18]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14585,7 +16411,7 @@ func (*MsgSubmitProposal) ProtoMessage() {}
 
 // Deprecated: Use MsgSubmitProposal.ProtoReflect.Descriptor instead.
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{18}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MsgSubmitProposal) GetGroupPolicyAddress() string {
@@ -14650,7 +16476,7 @@ type MsgSubmitProposalResponse struct {
 func (x *MsgSubmitProposalResponse) Reset() {
 	*x = MsgSubmitProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[19]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14664,7 +16490,7 @@ func (*MsgSubmitProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgSubmitProposalResponse.ProtoReflect.Descriptor instead.
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{19}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MsgSubmitProposalResponse) GetProposalId() uint64 {
@@ -14689,7 +16515,7 @@ type MsgWithdrawProposal struct {
 func (x *MsgWithdrawProposal) Reset() {
 	*x = MsgWithdrawProposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[20]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14703,7 +16529,7 @@ func (*MsgWithdrawProposal) ProtoMessage() {}
 
 // Deprecated: Use MsgWithdrawProposal.ProtoReflect.Descriptor instead.
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{20}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MsgWithdrawProposal) GetProposalId() uint64 {
@@ -14730,7 +16556,7 @@ type MsgWithdrawProposalResponse struct {
 func (x *MsgWithdrawProposalResponse) Reset() {
 	*x = MsgWithdrawProposalResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[21]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14744,7 +16570,7 @@ func (*MsgWithdrawProposalResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgWithdrawProposalResponse.ProtoReflect.Descriptor instead.
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{21}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{23}
 }
 
 // MsgVote is the Msg/Vote request type.
@@ -14769,7 +16595,7 @@ type MsgVote struct {
 func (x *MsgVote) Reset() {
 	*x = MsgVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[22]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14783,7 +16609,7 @@ func (*MsgVote) ProtoMessage() {}
 
 // Deprecated: Use MsgVote.ProtoReflect.Descriptor instead.
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{22}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *MsgVote) GetProposalId() uint64 {
@@ -14831,7 +16657,7 @@ type MsgVoteResponse struct {
 func (x *MsgVoteResponse) Reset() {
 	*x = MsgVoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[23]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14845,7 +16671,7 @@ func (*MsgVoteResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgVoteResponse.ProtoReflect.Descriptor instead.
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{23}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{25}
 }
 
 // MsgExec is the Msg/Exec request type.
@@ -14863,7 +16689,7 @@ type MsgExec struct {
 func (x *MsgExec) Reset() {
 	*x = MsgExec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[24]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14877,7 +16703,7 @@ func (*MsgExec) ProtoMessage() {}
 
 // Deprecated: Use MsgExec.ProtoReflect.Descriptor instead.
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{24}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MsgExec) GetProposalId() uint64 {
@@ -14907,7 +16733,7 @@ type MsgExecResponse struct {
 func (x *MsgExecResponse) Reset() {
 	*x = MsgExecResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[25]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14921,7 +16747,7 @@ func (*MsgExecResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgExecResponse.ProtoReflect.Descriptor instead.
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{25}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *MsgExecResponse) GetResult() ProposalExecutorResult {
@@ -14946,7 +16772,7 @@ type MsgLeaveGroup struct {
 func (x *MsgLeaveGroup) Reset() {
 	*x = MsgLeaveGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[26]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -14960,7 +16786,7 @@ func (*MsgLeaveGroup) ProtoMessage() {}
 
 // Deprecated: Use MsgLeaveGroup.ProtoReflect.Descriptor instead.
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{26}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MsgLeaveGroup) GetAddress() string {
@@ -14987,7 +16813,7 @@ type MsgLeaveGroupResponse struct {
 func (x *MsgLeaveGroupResponse) Reset() {
 	*x = MsgLeaveGroupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_tx_proto_msgTypes[27]
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -15001,7 +16827,80 @@ func (*MsgLeaveGroupResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgLeaveGroupResponse.ProtoReflect.Descriptor instead.
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{27}
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{29}
+}
+
+// MsgVetoProposal is the Msg/VetoProposal request type.
+type MsgVetoProposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// vetoer is the veto address of the group policy.
+	Vetoer string `protobuf:"bytes,2,opt,name=vetoer,proto3" json:"vetoer,omitempty"`
+}
+
+func (x *MsgVetoProposal) Reset() {
+	*x = MsgVetoProposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVetoProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVetoProposal) ProtoMessage() {}
+
+// Deprecated: Use MsgVetoProposal.ProtoReflect.Descriptor instead.
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MsgVetoProposal) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *MsgVetoProposal) GetVetoer() string {
+	if x != nil {
+		return x.Vetoer
+	}
+	return ""
+}
+
+// MsgVetoProposalResponse is the Msg/VetoProposal response type.
+type MsgVetoProposalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgVetoProposalResponse) Reset() {
+	*x = MsgVetoProposalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_tx_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgVetoProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgVetoProposalResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgVetoProposalResponse.ProtoReflect.Descriptor instead.
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_tx_proto_rawDescGZIP(), []int{31}
 }
 
 var File_cosmos_group_v1_tx_proto protoreflect.FileDescriptor
@@ -15198,6 +17097,28 @@ var file_cosmos_group_v1_tx_proto_rawDesc = []byte{
 	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x26, 0x0a, 0x24, 0x4d, 0x73, 0x67, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
 	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xac, 0x02, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x4a, 0x0a, 0x14, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x05,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x25, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x45, 0x78, 0x65, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x2f, 0x0a, 0x2d, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0xe1, 0x02, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72,
 	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x4a, 0x0a, 0x14, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
 	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
@@ -15278,113 +17199,140 @@ var file_cosmos_group_v1_tx_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f,
 	0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x17, 0x0a,
 	0x15, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x2a, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x14,
-	0x0a, 0x10, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x54, 0x52, 0x59,
-	0x10, 0x01, 0x32, 0xca, 0x0b, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x57, 0x0a, 0x0b, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x27, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x66, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x56, 0x65,
+	0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x76,
+	0x65, 0x74, 0x6f, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x76, 0x65, 0x74, 0x6f, 0x65, 0x72, 0x3a, 0x30, 0x82,
+	0xe7, 0xb0, 0x2a, 0x06, 0x76, 0x65, 0x74, 0x6f, 0x65, 0x72, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f,
+	0x4d, 0x73, 0x67, 0x56, 0x65, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22,
+	0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x56, 0x65, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x2a, 0x0a, 0x04, 0x45, 0x78,
+	0x65, 0x63, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x45, 0x43,
+	0x5f, 0x54, 0x52, 0x59, 0x10, 0x01, 0x32, 0xc5, 0x0d, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x57,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1f, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x1a, 0x27,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x1a, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
 	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x2c, 0x2e, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
-	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x11, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
-	0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x29,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57,
-	0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x31, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x16,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x6d,
-	0x69, 0x6e, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x33, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a,
-	0x3b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a,
+	0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76,
 	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a,
-	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d, 0x2e, 0x63, 0x6f, 0x73,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x2f, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x2d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x57, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x31, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x57, 0x69,
+	0x74, 0x68, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x78, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x2a, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
 	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x35, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x79, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x32, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x1f, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x60, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x33,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x1a, 0x3b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x81, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x2d,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x35, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68,
-	0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x2c, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x56, 0x6f,
-	0x74, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x1a, 0x20, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
-	0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63,
+	0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x10, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72,
+	0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x1a, 0x2c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65,
 	0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
-	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42,
-	0xa6, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43,
-	0x47, 0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x18, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
+	0x45, 0x78, 0x65, 0x63, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x1e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9c, 0x01, 0x0a,
+	0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x36, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x3e, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0c, 0x56,
+	0x65, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x20, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73,
+	0x67, 0x56, 0x65, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x28, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x56, 0x65, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xa6,
+	0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x28, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2f, 0x76, 0x31, 0x3b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47,
+	0x58, 0xaa, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -15400,87 +17348,97 @@ func file_cosmos_group_v1_tx_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_group_v1_tx_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cosmos_group_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_cosmos_group_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_cosmos_group_v1_tx_proto_goTypes = []interface{}{
-	(Exec)(0),                                          // 0: cosmos.group.v1.Exec
-	(*MsgCreateGroup)(nil),                             // 1: cosmos.group.v1.MsgCreateGroup
-	(*MsgCreateGroupResponse)(nil),                     // 2: cosmos.group.v1.MsgCreateGroupResponse
-	(*MsgUpdateGroupMembers)(nil),                      // 3: cosmos.group.v1.MsgUpdateGroupMembers
-	(*MsgUpdateGroupMembersResponse)(nil),              // 4: cosmos.group.v1.MsgUpdateGroupMembersResponse
-	(*MsgUpdateGroupAdmin)(nil),                        // 5: cosmos.group.v1.MsgUpdateGroupAdmin
-	(*MsgUpdateGroupAdminResponse)(nil),                // 6: cosmos.group.v1.MsgUpdateGroupAdminResponse
-	(*MsgUpdateGroupMetadata)(nil),                     // 7: cosmos.group.v1.MsgUpdateGroupMetadata
-	(*MsgUpdateGroupMetadataResponse)(nil),             // 8: cosmos.group.v1.MsgUpdateGroupMetadataResponse
-	(*MsgCreateGroupPolicy)(nil),                       // 9: cosmos.group.v1.MsgCreateGroupPolicy
-	(*MsgCreateGroupPolicyResponse)(nil),               // 10: cosmos.group.v1.MsgCreateGroupPolicyResponse
-	(*MsgUpdateGroupPolicyAdmin)(nil),                  // 11: cosmos.group.v1.MsgUpdateGroupPolicyAdmin
-	(*MsgUpdateGroupPolicyAdminResponse)(nil),          // 12: cosmos.group.v1.MsgUpdateGroupPolicyAdminResponse
-	(*MsgCreateGroupWithPolicy)(nil),                   // 13: cosmos.group.v1.MsgCreateGroupWithPolicy
-	(*MsgCreateGroupWithPolicyResponse)(nil),           // 14: cosmos.group.v1.MsgCreateGroupWithPolicyResponse
-	(*MsgUpdateGroupPolicyDecisionPolicy)(nil),         // 15: cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy
-	(*MsgUpdateGroupPolicyDecisionPolicyResponse)(nil), // 16: cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse
-	(*MsgUpdateGroupPolicyMetadata)(nil),               // 17: cosmos.group.v1.MsgUpdateGroupPolicyMetadata
-	(*MsgUpdateGroupPolicyMetadataResponse)(nil),       // 18: cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse
-	(*MsgSubmitProposal)(nil),                          // 19: cosmos.group.v1.MsgSubmitProposal
-	(*MsgSubmitProposalResponse)(nil),                  // 20: cosmos.group.v1.MsgSubmitProposalResponse
-	(*MsgWithdrawProposal)(nil),                        // 21: cosmos.group.v1.MsgWithdrawProposal
-	(*MsgWithdrawProposalResponse)(nil),                // 22: cosmos.group.v1.MsgWithdrawProposalResponse
-	(*MsgVote)(nil),                                    // 23: cosmos.group.v1.MsgVote
-	(*MsgVoteResponse)(nil),                            // 24: cosmos.group.v1.MsgVoteResponse
-	(*MsgExec)(nil),                                    // 25: cosmos.group.v1.MsgExec
-	(*MsgExecResponse)(nil),                            // 26: cosmos.group.v1.MsgExecResponse
-	(*MsgLeaveGroup)(nil),                              // 27: cosmos.group.v1.MsgLeaveGroup
-	(*MsgLeaveGroupResponse)(nil),                      // 28: cosmos.group.v1.MsgLeaveGroupResponse
-	(*MemberRequest)(nil),                              // 29: cosmos.group.v1.MemberRequest
-	(*anypb.Any)(nil),                                  // 30: google.protobuf.Any
-	(VoteOption)(0),                                    // 31: cosmos.group.v1.VoteOption
-	(ProposalExecutorResult)(0),                        // 32: cosmos.group.v1.ProposalExecutorResult
+	(Exec)(0),                                             // 0: cosmos.group.v1.Exec
+	(*MsgCreateGroup)(nil),                                // 1: cosmos.group.v1.MsgCreateGroup
+	(*MsgCreateGroupResponse)(nil),                        // 2: cosmos.group.v1.MsgCreateGroupResponse
+	(*MsgUpdateGroupMembers)(nil),                         // 3: cosmos.group.v1.MsgUpdateGroupMembers
+	(*MsgUpdateGroupMembersResponse)(nil),                 // 4: cosmos.group.v1.MsgUpdateGroupMembersResponse
+	(*MsgUpdateGroupAdmin)(nil),                           // 5: cosmos.group.v1.MsgUpdateGroupAdmin
+	(*MsgUpdateGroupAdminResponse)(nil),                   // 6: cosmos.group.v1.MsgUpdateGroupAdminResponse
+	(*MsgUpdateGroupMetadata)(nil),                        // 7: cosmos.group.v1.MsgUpdateGroupMetadata
+	(*MsgUpdateGroupMetadataResponse)(nil),                // 8: cosmos.group.v1.MsgUpdateGroupMetadataResponse
+	(*MsgCreateGroupPolicy)(nil),                          // 9: cosmos.group.v1.MsgCreateGroupPolicy
+	(*MsgCreateGroupPolicyResponse)(nil),                  // 10: cosmos.group.v1.MsgCreateGroupPolicyResponse
+	(*MsgUpdateGroupPolicyAdmin)(nil),                     // 11: cosmos.group.v1.MsgUpdateGroupPolicyAdmin
+	(*MsgUpdateGroupPolicyAdminResponse)(nil),             // 12: cosmos.group.v1.MsgUpdateGroupPolicyAdminResponse
+	(*MsgCreateGroupWithPolicy)(nil),                      // 13: cosmos.group.v1.MsgCreateGroupWithPolicy
+	(*MsgCreateGroupWithPolicyResponse)(nil),              // 14: cosmos.group.v1.MsgCreateGroupWithPolicyResponse
+	(*MsgUpdateGroupPolicyDecisionPolicy)(nil),            // 15: cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy
+	(*MsgUpdateGroupPolicyDecisionPolicyResponse)(nil),    // 16: cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse
+	(*MsgUpdateGroupPolicyMetadata)(nil),                  // 17: cosmos.group.v1.MsgUpdateGroupPolicyMetadata
+	(*MsgUpdateGroupPolicyMetadataResponse)(nil),          // 18: cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse
+	(*MsgUpdateGroupPolicyExecutionSettings)(nil),         // 19: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings
+	(*MsgUpdateGroupPolicyExecutionSettingsResponse)(nil), // 20: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse
+	(*MsgSubmitProposal)(nil),                             // 21: cosmos.group.v1.MsgSubmitProposal
+	(*MsgSubmitProposalResponse)(nil),                     // 22: cosmos.group.v1.MsgSubmitProposalResponse
+	(*MsgWithdrawProposal)(nil),                           // 23: cosmos.group.v1.MsgWithdrawProposal
+	(*MsgWithdrawProposalResponse)(nil),                   // 24: cosmos.group.v1.MsgWithdrawProposalResponse
+	(*MsgVote)(nil),                                       // 25: cosmos.group.v1.MsgVote
+	(*MsgVoteResponse)(nil),                               // 26: cosmos.group.v1.MsgVoteResponse
+	(*MsgExec)(nil),                                       // 27: cosmos.group.v1.MsgExec
+	(*MsgExecResponse)(nil),                               // 28: cosmos.group.v1.MsgExecResponse
+	(*MsgLeaveGroup)(nil),                                 // 29: cosmos.group.v1.MsgLeaveGroup
+	(*MsgLeaveGroupResponse)(nil),                         // 30: cosmos.group.v1.MsgLeaveGroupResponse
+	(*MsgVetoProposal)(nil),                               // 31: cosmos.group.v1.MsgVetoProposal
+	(*MsgVetoProposalResponse)(nil),                       // 32: cosmos.group.v1.MsgVetoProposalResponse
+	(*MemberRequest)(nil),                                 // 33: cosmos.group.v1.MemberRequest
+	(*anypb.Any)(nil),                                     // 34: google.protobuf.Any
+	(*ExecutionSettings)(nil),                             // 35: cosmos.group.v1.ExecutionSettings
+	(VoteOption)(0),                                       // 36: cosmos.group.v1.VoteOption
+	(ProposalExecutorResult)(0),                           // 37: cosmos.group.v1.ProposalExecutorResult
 }
 var file_cosmos_group_v1_tx_proto_depIdxs = []int32{
-	29, // 0: cosmos.group.v1.MsgCreateGroup.members:type_name -> cosmos.group.v1.MemberRequest
-	29, // 1: cosmos.group.v1.MsgUpdateGroupMembers.member_updates:type_name -> cosmos.group.v1.MemberRequest
-	30, // 2: cosmos.group.v1.MsgCreateGroupPolicy.decision_policy:type_name -> google.protobuf.Any
-	29, // 3: cosmos.group.v1.MsgCreateGroupWithPolicy.members:type_name -> cosmos.group.v1.MemberRequest
-	30, // 4: cosmos.group.v1.MsgCreateGroupWithPolicy.decision_policy:type_name -> google.protobuf.Any
-	30, // 5: cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy.decision_policy:type_name -> google.protobuf.Any
-	30, // 6: cosmos.group.v1.MsgSubmitProposal.messages:type_name -> google.protobuf.Any
-	0,  // 7: cosmos.group.v1.MsgSubmitProposal.exec:type_name -> cosmos.group.v1.Exec
-	31, // 8: cosmos.group.v1.MsgVote.option:type_name -> cosmos.group.v1.VoteOption
-	0,  // 9: cosmos.group.v1.MsgVote.exec:type_name -> cosmos.group.v1.Exec
-	32, // 10: cosmos.group.v1.MsgExecResponse.result:type_name -> cosmos.group.v1.ProposalExecutorResult
-	1,  // 11: cosmos.group.v1.Msg.CreateGroup:input_type -> cosmos.group.v1.MsgCreateGroup
-	3,  // 12: cosmos.group.v1.Msg.UpdateGroupMembers:input_type -> cosmos.group.v1.MsgUpdateGroupMembers
-	5,  // 13: cosmos.group.v1.Msg.UpdateGroupAdmin:input_type -> cosmos.group.v1.MsgUpdateGroupAdmin
-	7,  // 14: cosmos.group.v1.Msg.UpdateGroupMetadata:input_type -> cosmos.group.v1.MsgUpdateGroupMetadata
-	9,  // 15: cosmos.group.v1.Msg.CreateGroupPolicy:input_type -> cosmos.group.v1.MsgCreateGroupPolicy
-	13, // 16: cosmos.group.v1.Msg.CreateGroupWithPolicy:input_type -> cosmos.group.v1.MsgCreateGroupWithPolicy
-	11, // 17: cosmos.group.v1.Msg.UpdateGroupPolicyAdmin:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyAdmin
-	15, // 18: cosmos.group.v1.Msg.UpdateGroupPolicyDecisionPolicy:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy
-	17, // 19: cosmos.group.v1.Msg.UpdateGroupPolicyMetadata:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyMetadata
-	19, // 20: cosmos.group.v1.Msg.SubmitProposal:input_type -> cosmos.group.v1.MsgSubmitProposal
-	21, // 21: cosmos.group.v1.Msg.WithdrawProposal:input_type -> cosmos.group.v1.MsgWithdrawProposal
-	23, // 22: cosmos.group.v1.Msg.Vote:input_type -> cosmos.group.v1.MsgVote
-	25, // 23: cosmos.group.v1.Msg.Exec:input_type -> cosmos.group.v1.MsgExec
-	27, // 24: cosmos.group.v1.Msg.LeaveGroup:input_type -> cosmos.group.v1.MsgLeaveGroup
-	2,  // 25: cosmos.group.v1.Msg.CreateGroup:output_type -> cosmos.group.v1.MsgCreateGroupResponse
-	4,  // 26: cosmos.group.v1.Msg.UpdateGroupMembers:output_type -> cosmos.group.v1.MsgUpdateGroupMembersResponse
-	6,  // 27: cosmos.group.v1.Msg.UpdateGroupAdmin:output_type -> cosmos.group.v1.MsgUpdateGroupAdminResponse
-	8,  // 28: cosmos.group.v1.Msg.UpdateGroupMetadata:output_type -> cosmos.group.v1.MsgUpdateGroupMetadataResponse
-	10, // 29: cosmos.group.v1.Msg.CreateGroupPolicy:output_type -> cosmos.group.v1.MsgCreateGroupPolicyResponse
-	14, // 30: cosmos.group.v1.Msg.CreateGroupWithPolicy:output_type -> cosmos.group.v1.MsgCreateGroupWithPolicyResponse
-	12, // 31: cosmos.group.v1.Msg.UpdateGroupPolicyAdmin:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyAdminResponse
-	16, // 32: cosmos.group.v1.Msg.UpdateGroupPolicyDecisionPolicy:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse
-	18, // 33: cosmos.group.v1.Msg.UpdateGroupPolicyMetadata:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse
-	20, // 34: cosmos.group.v1.Msg.SubmitProposal:output_type -> cosmos.group.v1.MsgSubmitProposalResponse
-	22, // 35: cosmos.group.v1.Msg.WithdrawProposal:output_type -> cosmos.group.v1.MsgWithdrawProposalResponse
-	24, // 36: cosmos.group.v1.Msg.Vote:output_type -> cosmos.group.v1.MsgVoteResponse
-	26, // 37: cosmos.group.v1.Msg.Exec:output_type -> cosmos.group.v1.MsgExecResponse
-	28, // 38: cosmos.group.v1.Msg.LeaveGroup:output_type -> cosmos.group.v1.MsgLeaveGroupResponse
-	25, // [25:39] is the sub-list for method output_type
-	11, // [11:25] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	33, // 0: cosmos.group.v1.MsgCreateGroup.members:type_name -> cosmos.group.v1.MemberRequest
+	33, // 1: cosmos.group.v1.MsgUpdateGroupMembers.member_updates:type_name -> cosmos.group.v1.MemberRequest
+	34, // 2: cosmos.group.v1.MsgCreateGroupPolicy.decision_policy:type_name -> google.protobuf.Any
+	33, // 3: cosmos.group.v1.MsgCreateGroupWithPolicy.members:type_name -> cosmos.group.v1.MemberRequest
+	34, // 4: cosmos.group.v1.MsgCreateGroupWithPolicy.decision_policy:type_name -> google.protobuf.Any
+	34, // 5: cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy.decision_policy:type_name -> google.protobuf.Any
+	35, // 6: cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings.execution_settings:type_name -> cosmos.group.v1.ExecutionSettings
+	34, // 7: cosmos.group.v1.MsgSubmitProposal.messages:type_name -> google.protobuf.Any
+	0,  // 8: cosmos.group.v1.MsgSubmitProposal.exec:type_name -> cosmos.group.v1.Exec
+	36, // 9: cosmos.group.v1.MsgVote.option:type_name -> cosmos.group.v1.VoteOption
+	0,  // 10: cosmos.group.v1.MsgVote.exec:type_name -> cosmos.group.v1.Exec
+	37, // 11: cosmos.group.v1.MsgExecResponse.result:type_name -> cosmos.group.v1.ProposalExecutorResult
+	1,  // 12: cosmos.group.v1.Msg.CreateGroup:input_type -> cosmos.group.v1.MsgCreateGroup
+	3,  // 13: cosmos.group.v1.Msg.UpdateGroupMembers:input_type -> cosmos.group.v1.MsgUpdateGroupMembers
+	5,  // 14: cosmos.group.v1.Msg.UpdateGroupAdmin:input_type -> cosmos.group.v1.MsgUpdateGroupAdmin
+	7,  // 15: cosmos.group.v1.Msg.UpdateGroupMetadata:input_type -> cosmos.group.v1.MsgUpdateGroupMetadata
+	9,  // 16: cosmos.group.v1.Msg.CreateGroupPolicy:input_type -> cosmos.group.v1.MsgCreateGroupPolicy
+	13, // 17: cosmos.group.v1.Msg.CreateGroupWithPolicy:input_type -> cosmos.group.v1.MsgCreateGroupWithPolicy
+	11, // 18: cosmos.group.v1.Msg.UpdateGroupPolicyAdmin:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyAdmin
+	15, // 19: cosmos.group.v1.Msg.UpdateGroupPolicyDecisionPolicy:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy
+	17, // 20: cosmos.group.v1.Msg.UpdateGroupPolicyMetadata:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyMetadata
+	21, // 21: cosmos.group.v1.Msg.SubmitProposal:input_type -> cosmos.group.v1.MsgSubmitProposal
+	23, // 22: cosmos.group.v1.Msg.WithdrawProposal:input_type -> cosmos.group.v1.MsgWithdrawProposal
+	25, // 23: cosmos.group.v1.Msg.Vote:input_type -> cosmos.group.v1.MsgVote
+	27, // 24: cosmos.group.v1.Msg.Exec:input_type -> cosmos.group.v1.MsgExec
+	29, // 25: cosmos.group.v1.Msg.LeaveGroup:input_type -> cosmos.group.v1.MsgLeaveGroup
+	19, // 26: cosmos.group.v1.Msg.UpdateGroupPolicyExecutionSettings:input_type -> cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings
+	31, // 27: cosmos.group.v1.Msg.VetoProposal:input_type -> cosmos.group.v1.MsgVetoProposal
+	2,  // 28: cosmos.group.v1.Msg.CreateGroup:output_type -> cosmos.group.v1.MsgCreateGroupResponse
+	4,  // 29: cosmos.group.v1.Msg.UpdateGroupMembers:output_type -> cosmos.group.v1.MsgUpdateGroupMembersResponse
+	6,  // 30: cosmos.group.v1.Msg.UpdateGroupAdmin:output_type -> cosmos.group.v1.MsgUpdateGroupAdminResponse
+	8,  // 31: cosmos.group.v1.Msg.UpdateGroupMetadata:output_type -> cosmos.group.v1.MsgUpdateGroupMetadataResponse
+	10, // 32: cosmos.group.v1.Msg.CreateGroupPolicy:output_type -> cosmos.group.v1.MsgCreateGroupPolicyResponse
+	14, // 33: cosmos.group.v1.Msg.CreateGroupWithPolicy:output_type -> cosmos.group.v1.MsgCreateGroupWithPolicyResponse
+	12, // 34: cosmos.group.v1.Msg.UpdateGroupPolicyAdmin:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyAdminResponse
+	16, // 35: cosmos.group.v1.Msg.UpdateGroupPolicyDecisionPolicy:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse
+	18, // 36: cosmos.group.v1.Msg.UpdateGroupPolicyMetadata:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse
+	22, // 37: cosmos.group.v1.Msg.SubmitProposal:output_type -> cosmos.group.v1.MsgSubmitProposalResponse
+	24, // 38: cosmos.group.v1.Msg.WithdrawProposal:output_type -> cosmos.group.v1.MsgWithdrawProposalResponse
+	26, // 39: cosmos.group.v1.Msg.Vote:output_type -> cosmos.group.v1.MsgVoteResponse
+	28, // 40: cosmos.group.v1.Msg.Exec:output_type -> cosmos.group.v1.MsgExecResponse
+	30, // 41: cosmos.group.v1.Msg.LeaveGroup:output_type -> cosmos.group.v1.MsgLeaveGroupResponse
+	20, // 42: cosmos.group.v1.Msg.UpdateGroupPolicyExecutionSettings:output_type -> cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse
+	32, // 43: cosmos.group.v1.Msg.VetoProposal:output_type -> cosmos.group.v1.MsgVetoProposalResponse
+	28, // [28:44] is the sub-list for method output_type
+	12, // [12:28] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_group_v1_tx_proto_init() }
@@ -15707,7 +17665,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitProposal); i {
+			switch v := v.(*MsgUpdateGroupPolicyExecutionSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15719,7 +17677,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitProposalResponse); i {
+			switch v := v.(*MsgUpdateGroupPolicyExecutionSettingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15731,7 +17689,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgWithdrawProposal); i {
+			switch v := v.(*MsgSubmitProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15743,7 +17701,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgWithdrawProposalResponse); i {
+			switch v := v.(*MsgSubmitProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15755,7 +17713,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgVote); i {
+			switch v := v.(*MsgWithdrawProposal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15767,7 +17725,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgVoteResponse); i {
+			switch v := v.(*MsgWithdrawProposalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15779,7 +17737,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExec); i {
+			switch v := v.(*MsgVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15791,7 +17749,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgExecResponse); i {
+			switch v := v.(*MsgVoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15803,7 +17761,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgLeaveGroup); i {
+			switch v := v.(*MsgExec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15815,6 +17773,30 @@ func file_cosmos_group_v1_tx_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgExecResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgLeaveGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgLeaveGroupResponse); i {
 			case 0:
 				return &v.state
@@ -15826,6 +17808,30 @@ func file_cosmos_group_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_group_v1_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgVetoProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgVetoProposalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -15833,7 +17839,7 @@ func file_cosmos_group_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_group_v1_tx_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   28,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
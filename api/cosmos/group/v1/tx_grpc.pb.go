@@ -1,5 +1,3 @@
-// Since: cosmos-sdk 0.46
-
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.3.0
@@ -21,20 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateGroup_FullMethodName                     = "/cosmos.group.v1.Msg/CreateGroup"
-	Msg_UpdateGroupMembers_FullMethodName              = "/cosmos.group.v1.Msg/UpdateGroupMembers"
-	Msg_UpdateGroupAdmin_FullMethodName                = "/cosmos.group.v1.Msg/UpdateGroupAdmin"
-	Msg_UpdateGroupMetadata_FullMethodName             = "/cosmos.group.v1.Msg/UpdateGroupMetadata"
-	Msg_CreateGroupPolicy_FullMethodName               = "/cosmos.group.v1.Msg/CreateGroupPolicy"
-	Msg_CreateGroupWithPolicy_FullMethodName           = "/cosmos.group.v1.Msg/CreateGroupWithPolicy"
-	Msg_UpdateGroupPolicyAdmin_FullMethodName          = "/cosmos.group.v1.Msg/UpdateGroupPolicyAdmin"
-	Msg_UpdateGroupPolicyDecisionPolicy_FullMethodName = "/cosmos.group.v1.Msg/UpdateGroupPolicyDecisionPolicy"
-	Msg_UpdateGroupPolicyMetadata_FullMethodName       = "/cosmos.group.v1.Msg/UpdateGroupPolicyMetadata"
-	Msg_SubmitProposal_FullMethodName                  = "/cosmos.group.v1.Msg/SubmitProposal"
-	Msg_WithdrawProposal_FullMethodName                = "/cosmos.group.v1.Msg/WithdrawProposal"
-	Msg_Vote_FullMethodName                            = "/cosmos.group.v1.Msg/Vote"
-	Msg_Exec_FullMethodName                            = "/cosmos.group.v1.Msg/Exec"
-	Msg_LeaveGroup_FullMethodName                      = "/cosmos.group.v1.Msg/LeaveGroup"
+	Msg_CreateGroup_FullMethodName                        = "/cosmos.group.v1.Msg/CreateGroup"
+	Msg_UpdateGroupMembers_FullMethodName                 = "/cosmos.group.v1.Msg/UpdateGroupMembers"
+	Msg_UpdateGroupAdmin_FullMethodName                   = "/cosmos.group.v1.Msg/UpdateGroupAdmin"
+	Msg_UpdateGroupMetadata_FullMethodName                = "/cosmos.group.v1.Msg/UpdateGroupMetadata"
+	Msg_CreateGroupPolicy_FullMethodName                  = "/cosmos.group.v1.Msg/CreateGroupPolicy"
+	Msg_CreateGroupWithPolicy_FullMethodName              = "/cosmos.group.v1.Msg/CreateGroupWithPolicy"
+	Msg_UpdateGroupPolicyAdmin_FullMethodName             = "/cosmos.group.v1.Msg/UpdateGroupPolicyAdmin"
+	Msg_UpdateGroupPolicyDecisionPolicy_FullMethodName    = "/cosmos.group.v1.Msg/UpdateGroupPolicyDecisionPolicy"
+	Msg_UpdateGroupPolicyMetadata_FullMethodName          = "/cosmos.group.v1.Msg/UpdateGroupPolicyMetadata"
+	Msg_SubmitProposal_FullMethodName                     = "/cosmos.group.v1.Msg/SubmitProposal"
+	Msg_WithdrawProposal_FullMethodName                   = "/cosmos.group.v1.Msg/WithdrawProposal"
+	Msg_Vote_FullMethodName                               = "/cosmos.group.v1.Msg/Vote"
+	Msg_Exec_FullMethodName                               = "/cosmos.group.v1.Msg/Exec"
+	Msg_LeaveGroup_FullMethodName                         = "/cosmos.group.v1.Msg/LeaveGroup"
+	Msg_UpdateGroupPolicyExecutionSettings_FullMethodName = "/cosmos.group.v1.Msg/UpdateGroupPolicyExecutionSettings"
+	Msg_VetoProposal_FullMethodName                       = "/cosmos.group.v1.Msg/VetoProposal"
 )
 
 // MsgClient is the client API for Msg service.
@@ -69,6 +69,12 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// UpdateGroupPolicyExecutionSettings updates the execution settings of a
+	// group policy.
+	UpdateGroupPolicyExecutionSettings(ctx context.Context, in *MsgUpdateGroupPolicyExecutionSettings, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyExecutionSettingsResponse, error)
+	// VetoProposal allows the veto address of a group policy to veto a proposal
+	// before its execution.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
 }
 
 type msgClient struct {
@@ -205,6 +211,24 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyExecutionSettings(ctx context.Context, in *MsgUpdateGroupPolicyExecutionSettings, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyExecutionSettingsResponse, error) {
+	out := new(MsgUpdateGroupPolicyExecutionSettingsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateGroupPolicyExecutionSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, Msg_VetoProposal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -237,6 +261,12 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// UpdateGroupPolicyExecutionSettings updates the execution settings of a
+	// group policy.
+	UpdateGroupPolicyExecutionSettings(context.Context, *MsgUpdateGroupPolicyExecutionSettings) (*MsgUpdateGroupPolicyExecutionSettingsResponse, error)
+	// VetoProposal allows the veto address of a group policy to veto a proposal
+	// before its execution.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -286,6 +316,12 @@ func (UnimplementedMsgServer) Exec(context.Context, *MsgExec) (*MsgExecResponse,
 func (UnimplementedMsgServer) LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (UnimplementedMsgServer) UpdateGroupPolicyExecutionSettings(context.Context, *MsgUpdateGroupPolicyExecutionSettings) (*MsgUpdateGroupPolicyExecutionSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyExecutionSettings not implemented")
+}
+func (UnimplementedMsgServer) VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -551,6 +587,42 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupPolicyExecutionSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyExecutionSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyExecutionSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateGroupPolicyExecutionSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyExecutionSettings(ctx, req.(*MsgUpdateGroupPolicyExecutionSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_VetoProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -614,6 +686,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyExecutionSettings",
+			Handler:    _Msg_UpdateGroupPolicyExecutionSettings_Handler,
+		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -2192,27 +2192,31 @@ func (x *fastReflection_PercentageDecisionPolicy) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_DecisionPolicyWindows                      protoreflect.MessageDescriptor
-	fd_DecisionPolicyWindows_voting_period        protoreflect.FieldDescriptor
-	fd_DecisionPolicyWindows_min_execution_period protoreflect.FieldDescriptor
+	md_TokenWeightedDecisionPolicy            protoreflect.MessageDescriptor
+	fd_TokenWeightedDecisionPolicy_percentage protoreflect.FieldDescriptor
+	fd_TokenWeightedDecisionPolicy_source     protoreflect.FieldDescriptor
+	fd_TokenWeightedDecisionPolicy_denom      protoreflect.FieldDescriptor
+	fd_TokenWeightedDecisionPolicy_windows    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_types_proto_init()
-	md_DecisionPolicyWindows = File_cosmos_group_v1_types_proto.Messages().ByName("DecisionPolicyWindows")
-	fd_DecisionPolicyWindows_voting_period = md_DecisionPolicyWindows.Fields().ByName("voting_period")
-	fd_DecisionPolicyWindows_min_execution_period = md_DecisionPolicyWindows.Fields().ByName("min_execution_period")
+	md_TokenWeightedDecisionPolicy = File_cosmos_group_v1_types_proto.Messages().ByName("TokenWeightedDecisionPolicy")
+	fd_TokenWeightedDecisionPolicy_percentage = md_TokenWeightedDecisionPolicy.Fields().ByName("percentage")
+	fd_TokenWeightedDecisionPolicy_source = md_TokenWeightedDecisionPolicy.Fields().ByName("source")
+	fd_TokenWeightedDecisionPolicy_denom = md_TokenWeightedDecisionPolicy.Fields().ByName("denom")
+	fd_TokenWeightedDecisionPolicy_windows = md_TokenWeightedDecisionPolicy.Fields().ByName("windows")
 }
 
-var _ protoreflect.Message = (*fastReflection_DecisionPolicyWindows)(nil)
+var _ protoreflect.Message = (*fastReflection_TokenWeightedDecisionPolicy)(nil)
 
-type fastReflection_DecisionPolicyWindows DecisionPolicyWindows
+type fastReflection_TokenWeightedDecisionPolicy TokenWeightedDecisionPolicy
 
-func (x *DecisionPolicyWindows) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DecisionPolicyWindows)(x)
+func (x *TokenWeightedDecisionPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenWeightedDecisionPolicy)(x)
 }
 
-func (x *DecisionPolicyWindows) slowProtoReflect() protoreflect.Message {
+func (x *TokenWeightedDecisionPolicy) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_types_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2224,43 +2228,43 @@ func (x *DecisionPolicyWindows) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DecisionPolicyWindows_messageType fastReflection_DecisionPolicyWindows_messageType
-var _ protoreflect.MessageType = fastReflection_DecisionPolicyWindows_messageType{}
+var _fastReflection_TokenWeightedDecisionPolicy_messageType fastReflection_TokenWeightedDecisionPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_TokenWeightedDecisionPolicy_messageType{}
 
-type fastReflection_DecisionPolicyWindows_messageType struct{}
+type fastReflection_TokenWeightedDecisionPolicy_messageType struct{}
 
-func (x fastReflection_DecisionPolicyWindows_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DecisionPolicyWindows)(nil)
+func (x fastReflection_TokenWeightedDecisionPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenWeightedDecisionPolicy)(nil)
 }
-func (x fastReflection_DecisionPolicyWindows_messageType) New() protoreflect.Message {
-	return new(fastReflection_DecisionPolicyWindows)
+func (x fastReflection_TokenWeightedDecisionPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenWeightedDecisionPolicy)
 }
-func (x fastReflection_DecisionPolicyWindows_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DecisionPolicyWindows
+func (x fastReflection_TokenWeightedDecisionPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenWeightedDecisionPolicy
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DecisionPolicyWindows) Descriptor() protoreflect.MessageDescriptor {
-	return md_DecisionPolicyWindows
+func (x *fastReflection_TokenWeightedDecisionPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenWeightedDecisionPolicy
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DecisionPolicyWindows) Type() protoreflect.MessageType {
-	return _fastReflection_DecisionPolicyWindows_messageType
+func (x *fastReflection_TokenWeightedDecisionPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_TokenWeightedDecisionPolicy_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DecisionPolicyWindows) New() protoreflect.Message {
-	return new(fastReflection_DecisionPolicyWindows)
+func (x *fastReflection_TokenWeightedDecisionPolicy) New() protoreflect.Message {
+	return new(fastReflection_TokenWeightedDecisionPolicy)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DecisionPolicyWindows) Interface() protoreflect.ProtoMessage {
-	return (*DecisionPolicyWindows)(x)
+func (x *fastReflection_TokenWeightedDecisionPolicy) Interface() protoreflect.ProtoMessage {
+	return (*TokenWeightedDecisionPolicy)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2268,16 +2272,28 @@ func (x *fastReflection_DecisionPolicyWindows) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DecisionPolicyWindows) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.VotingPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-		if !f(fd_DecisionPolicyWindows_voting_period, value) {
+func (x *fastReflection_TokenWeightedDecisionPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Percentage != "" {
+		value := protoreflect.ValueOfString(x.Percentage)
+		if !f(fd_TokenWeightedDecisionPolicy_percentage, value) {
 			return
 		}
 	}
-	if x.MinExecutionPeriod != nil {
-		value := protoreflect.ValueOfMessage(x.MinExecutionPeriod.ProtoReflect())
-		if !f(fd_DecisionPolicyWindows_min_execution_period, value) {
+	if x.Source != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Source))
+		if !f(fd_TokenWeightedDecisionPolicy_source, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_TokenWeightedDecisionPolicy_denom, value) {
+			return
+		}
+	}
+	if x.Windows != nil {
+		value := protoreflect.ValueOfMessage(x.Windows.ProtoReflect())
+		if !f(fd_TokenWeightedDecisionPolicy_windows, value) {
 			return
 		}
 	}
@@ -2294,17 +2310,21 @@ func (x *fastReflection_DecisionPolicyWindows) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DecisionPolicyWindows) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TokenWeightedDecisionPolicy) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
-		return x.VotingPeriod != nil
-	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
-		return x.MinExecutionPeriod != nil
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.percentage":
+		return x.Percentage != ""
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.source":
+		return x.Source != 0
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.denom":
+		return x.Denom != ""
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.windows":
+		return x.Windows != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TokenWeightedDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.TokenWeightedDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2314,17 +2334,21 @@ func (x *fastReflection_DecisionPolicyWindows) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DecisionPolicyWindows) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TokenWeightedDecisionPolicy) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
-		x.VotingPeriod = nil
-	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
-		x.MinExecutionPeriod = nil
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.percentage":
+		x.Percentage = ""
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.source":
+		x.Source = 0
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.denom":
+		x.Denom = ""
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.windows":
+		x.Windows = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TokenWeightedDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.TokenWeightedDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2334,19 +2358,25 @@ func (x *fastReflection_DecisionPolicyWindows) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DecisionPolicyWindows) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenWeightedDecisionPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
-		value := x.VotingPeriod
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
-		value := x.MinExecutionPeriod
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.percentage":
+		value := x.Percentage
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.source":
+		value := x.Source
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.windows":
+		value := x.Windows
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TokenWeightedDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.TokenWeightedDecisionPolicy does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2360,17 +2390,21 @@ func (x *fastReflection_DecisionPolicyWindows) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DecisionPolicyWindows) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TokenWeightedDecisionPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
-		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
-	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
-		x.MinExecutionPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.percentage":
+		x.Percentage = value.Interface().(string)
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.source":
+		x.Source = (VotingPowerSource)(value.Enum())
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.windows":
+		x.Windows = value.Message().Interface().(*DecisionPolicyWindows)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TokenWeightedDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.TokenWeightedDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2384,52 +2418,56 @@ func (x *fastReflection_DecisionPolicyWindows) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DecisionPolicyWindows) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenWeightedDecisionPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
-		if x.VotingPeriod == nil {
-			x.VotingPeriod = new(durationpb.Duration)
-		}
-		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
-	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
-		if x.MinExecutionPeriod == nil {
-			x.MinExecutionPeriod = new(durationpb.Duration)
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.windows":
+		if x.Windows == nil {
+			x.Windows = new(DecisionPolicyWindows)
 		}
-		return protoreflect.ValueOfMessage(x.MinExecutionPeriod.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Windows.ProtoReflect())
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.percentage":
+		panic(fmt.Errorf("field percentage of message cosmos.group.v1.TokenWeightedDecisionPolicy is not mutable"))
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.source":
+		panic(fmt.Errorf("field source of message cosmos.group.v1.TokenWeightedDecisionPolicy is not mutable"))
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.denom":
+		panic(fmt.Errorf("field denom of message cosmos.group.v1.TokenWeightedDecisionPolicy is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TokenWeightedDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.TokenWeightedDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DecisionPolicyWindows) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenWeightedDecisionPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
-		m := new(durationpb.Duration)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
-		m := new(durationpb.Duration)
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.percentage":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.source":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.TokenWeightedDecisionPolicy.windows":
+		m := new(DecisionPolicyWindows)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TokenWeightedDecisionPolicy"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.TokenWeightedDecisionPolicy does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DecisionPolicyWindows) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TokenWeightedDecisionPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.DecisionPolicyWindows", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.TokenWeightedDecisionPolicy", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2437,7 +2475,7 @@ func (x *fastReflection_DecisionPolicyWindows) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DecisionPolicyWindows) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TokenWeightedDecisionPolicy) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2448,7 +2486,7 @@ func (x *fastReflection_DecisionPolicyWindows) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DecisionPolicyWindows) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TokenWeightedDecisionPolicy) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2460,7 +2498,7 @@ func (x *fastReflection_DecisionPolicyWindows) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DecisionPolicyWindows) IsValid() bool {
+func (x *fastReflection_TokenWeightedDecisionPolicy) IsValid() bool {
 	return x != nil
 }
 
@@ -2470,9 +2508,9 @@ func (x *fastReflection_DecisionPolicyWindows) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TokenWeightedDecisionPolicy) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DecisionPolicyWindows)
+		x := input.Message.Interface().(*TokenWeightedDecisionPolicy)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2484,12 +2522,19 @@ func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.VotingPeriod != nil {
-			l = options.Size(x.VotingPeriod)
+		l = len(x.Percentage)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.MinExecutionPeriod != nil {
-			l = options.Size(x.MinExecutionPeriod)
+		if x.Source != 0 {
+			n += 1 + runtime.Sov(uint64(x.Source))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Windows != nil {
+			l = options.Size(x.Windows)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -2502,7 +2547,7 @@ func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DecisionPolicyWindows)
+		x := input.Message.Interface().(*TokenWeightedDecisionPolicy)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2521,8 +2566,8 @@ func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.MinExecutionPeriod != nil {
-			encoded, err := options.Marshal(x.MinExecutionPeriod)
+		if x.Windows != nil {
+			encoded, err := options.Marshal(x.Windows)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2533,19 +2578,24 @@ func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Method
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
-		if x.VotingPeriod != nil {
-			encoded, err := options.Marshal(x.VotingPeriod)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Source != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Source))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Percentage) > 0 {
+			i -= len(x.Percentage)
+			copy(dAtA[i:], x.Percentage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Percentage)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2560,7 +2610,7 @@ func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DecisionPolicyWindows)
+		x := input.Message.Interface().(*TokenWeightedDecisionPolicy)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2592,17 +2642,17 @@ func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenWeightedDecisionPolicy: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenWeightedDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2612,31 +2662,78 @@ func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingPeriod == nil {
-					x.VotingPeriod = &durationpb.Duration{}
+				x.Percentage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Source = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Source |= VotingPowerSource(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -2663,10 +2760,10 @@ func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MinExecutionPeriod == nil {
-					x.MinExecutionPeriod = &durationpb.Duration{}
+				if x.Windows == nil {
+					x.Windows = &DecisionPolicyWindows{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinExecutionPeriod); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Windows); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -2706,35 +2803,27 @@ func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_GroupInfo              protoreflect.MessageDescriptor
-	fd_GroupInfo_id           protoreflect.FieldDescriptor
-	fd_GroupInfo_admin        protoreflect.FieldDescriptor
-	fd_GroupInfo_metadata     protoreflect.FieldDescriptor
-	fd_GroupInfo_version      protoreflect.FieldDescriptor
-	fd_GroupInfo_total_weight protoreflect.FieldDescriptor
-	fd_GroupInfo_created_at   protoreflect.FieldDescriptor
+	md_DecisionPolicyWindows                      protoreflect.MessageDescriptor
+	fd_DecisionPolicyWindows_voting_period        protoreflect.FieldDescriptor
+	fd_DecisionPolicyWindows_min_execution_period protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_types_proto_init()
-	md_GroupInfo = File_cosmos_group_v1_types_proto.Messages().ByName("GroupInfo")
-	fd_GroupInfo_id = md_GroupInfo.Fields().ByName("id")
-	fd_GroupInfo_admin = md_GroupInfo.Fields().ByName("admin")
-	fd_GroupInfo_metadata = md_GroupInfo.Fields().ByName("metadata")
-	fd_GroupInfo_version = md_GroupInfo.Fields().ByName("version")
-	fd_GroupInfo_total_weight = md_GroupInfo.Fields().ByName("total_weight")
-	fd_GroupInfo_created_at = md_GroupInfo.Fields().ByName("created_at")
+	md_DecisionPolicyWindows = File_cosmos_group_v1_types_proto.Messages().ByName("DecisionPolicyWindows")
+	fd_DecisionPolicyWindows_voting_period = md_DecisionPolicyWindows.Fields().ByName("voting_period")
+	fd_DecisionPolicyWindows_min_execution_period = md_DecisionPolicyWindows.Fields().ByName("min_execution_period")
 }
 
-var _ protoreflect.Message = (*fastReflection_GroupInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_DecisionPolicyWindows)(nil)
 
-type fastReflection_GroupInfo GroupInfo
+type fastReflection_DecisionPolicyWindows DecisionPolicyWindows
 
-func (x *GroupInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GroupInfo)(x)
+func (x *DecisionPolicyWindows) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DecisionPolicyWindows)(x)
 }
 
-func (x *GroupInfo) slowProtoReflect() protoreflect.Message {
+func (x *DecisionPolicyWindows) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_types_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2746,43 +2835,43 @@ func (x *GroupInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GroupInfo_messageType fastReflection_GroupInfo_messageType
-var _ protoreflect.MessageType = fastReflection_GroupInfo_messageType{}
+var _fastReflection_DecisionPolicyWindows_messageType fastReflection_DecisionPolicyWindows_messageType
+var _ protoreflect.MessageType = fastReflection_DecisionPolicyWindows_messageType{}
 
-type fastReflection_GroupInfo_messageType struct{}
+type fastReflection_DecisionPolicyWindows_messageType struct{}
 
-func (x fastReflection_GroupInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GroupInfo)(nil)
+func (x fastReflection_DecisionPolicyWindows_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DecisionPolicyWindows)(nil)
 }
-func (x fastReflection_GroupInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_GroupInfo)
+func (x fastReflection_DecisionPolicyWindows_messageType) New() protoreflect.Message {
+	return new(fastReflection_DecisionPolicyWindows)
 }
-func (x fastReflection_GroupInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GroupInfo
+func (x fastReflection_DecisionPolicyWindows_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DecisionPolicyWindows
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GroupInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_GroupInfo
+func (x *fastReflection_DecisionPolicyWindows) Descriptor() protoreflect.MessageDescriptor {
+	return md_DecisionPolicyWindows
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GroupInfo) Type() protoreflect.MessageType {
-	return _fastReflection_GroupInfo_messageType
+func (x *fastReflection_DecisionPolicyWindows) Type() protoreflect.MessageType {
+	return _fastReflection_DecisionPolicyWindows_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GroupInfo) New() protoreflect.Message {
-	return new(fastReflection_GroupInfo)
+func (x *fastReflection_DecisionPolicyWindows) New() protoreflect.Message {
+	return new(fastReflection_DecisionPolicyWindows)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GroupInfo) Interface() protoreflect.ProtoMessage {
-	return (*GroupInfo)(x)
+func (x *fastReflection_DecisionPolicyWindows) Interface() protoreflect.ProtoMessage {
+	return (*DecisionPolicyWindows)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2790,40 +2879,16 @@ func (x *fastReflection_GroupInfo) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GroupInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_GroupInfo_id, value) {
-			return
-		}
-	}
-	if x.Admin != "" {
-		value := protoreflect.ValueOfString(x.Admin)
-		if !f(fd_GroupInfo_admin, value) {
-			return
-		}
-	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_GroupInfo_metadata, value) {
-			return
-		}
-	}
-	if x.Version != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Version)
-		if !f(fd_GroupInfo_version, value) {
-			return
-		}
-	}
-	if x.TotalWeight != "" {
-		value := protoreflect.ValueOfString(x.TotalWeight)
-		if !f(fd_GroupInfo_total_weight, value) {
+func (x *fastReflection_DecisionPolicyWindows) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.VotingPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+		if !f(fd_DecisionPolicyWindows_voting_period, value) {
 			return
 		}
 	}
-	if x.CreatedAt != nil {
-		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
-		if !f(fd_GroupInfo_created_at, value) {
+	if x.MinExecutionPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.MinExecutionPeriod.ProtoReflect())
+		if !f(fd_DecisionPolicyWindows_min_execution_period, value) {
 			return
 		}
 	}
@@ -2840,25 +2905,17 @@ func (x *fastReflection_GroupInfo) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GroupInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DecisionPolicyWindows) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupInfo.id":
-		return x.Id != uint64(0)
-	case "cosmos.group.v1.GroupInfo.admin":
-		return x.Admin != ""
-	case "cosmos.group.v1.GroupInfo.metadata":
-		return x.Metadata != ""
-	case "cosmos.group.v1.GroupInfo.version":
-		return x.Version != uint64(0)
-	case "cosmos.group.v1.GroupInfo.total_weight":
-		return x.TotalWeight != ""
-	case "cosmos.group.v1.GroupInfo.created_at":
-		return x.CreatedAt != nil
+	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
+		return x.VotingPeriod != nil
+	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
+		return x.MinExecutionPeriod != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2868,25 +2925,17 @@ func (x *fastReflection_GroupInfo) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DecisionPolicyWindows) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupInfo.id":
-		x.Id = uint64(0)
-	case "cosmos.group.v1.GroupInfo.admin":
-		x.Admin = ""
-	case "cosmos.group.v1.GroupInfo.metadata":
-		x.Metadata = ""
-	case "cosmos.group.v1.GroupInfo.version":
-		x.Version = uint64(0)
-	case "cosmos.group.v1.GroupInfo.total_weight":
-		x.TotalWeight = ""
-	case "cosmos.group.v1.GroupInfo.created_at":
-		x.CreatedAt = nil
+	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
+		x.VotingPeriod = nil
+	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
+		x.MinExecutionPeriod = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2896,31 +2945,19 @@ func (x *fastReflection_GroupInfo) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GroupInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DecisionPolicyWindows) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.GroupInfo.id":
-		value := x.Id
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.GroupInfo.admin":
-		value := x.Admin
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.GroupInfo.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.GroupInfo.version":
-		value := x.Version
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.GroupInfo.total_weight":
-		value := x.TotalWeight
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.GroupInfo.created_at":
-		value := x.CreatedAt
+	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
+		value := x.VotingPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
+		value := x.MinExecutionPeriod
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2934,25 +2971,17 @@ func (x *fastReflection_GroupInfo) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DecisionPolicyWindows) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupInfo.id":
-		x.Id = value.Uint()
-	case "cosmos.group.v1.GroupInfo.admin":
-		x.Admin = value.Interface().(string)
-	case "cosmos.group.v1.GroupInfo.metadata":
-		x.Metadata = value.Interface().(string)
-	case "cosmos.group.v1.GroupInfo.version":
-		x.Version = value.Uint()
-	case "cosmos.group.v1.GroupInfo.total_weight":
-		x.TotalWeight = value.Interface().(string)
-	case "cosmos.group.v1.GroupInfo.created_at":
-		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
+		x.VotingPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
+		x.MinExecutionPeriod = value.Message().Interface().(*durationpb.Duration)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2966,64 +2995,52 @@ func (x *fastReflection_GroupInfo) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DecisionPolicyWindows) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupInfo.created_at":
-		if x.CreatedAt == nil {
-			x.CreatedAt = new(timestamppb.Timestamp)
+	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
+		if x.VotingPeriod == nil {
+			x.VotingPeriod = new(durationpb.Duration)
 		}
-		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
-	case "cosmos.group.v1.GroupInfo.id":
-		panic(fmt.Errorf("field id of message cosmos.group.v1.GroupInfo is not mutable"))
-	case "cosmos.group.v1.GroupInfo.admin":
-		panic(fmt.Errorf("field admin of message cosmos.group.v1.GroupInfo is not mutable"))
-	case "cosmos.group.v1.GroupInfo.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.group.v1.GroupInfo is not mutable"))
-	case "cosmos.group.v1.GroupInfo.version":
-		panic(fmt.Errorf("field version of message cosmos.group.v1.GroupInfo is not mutable"))
-	case "cosmos.group.v1.GroupInfo.total_weight":
-		panic(fmt.Errorf("field total_weight of message cosmos.group.v1.GroupInfo is not mutable"))
+		return protoreflect.ValueOfMessage(x.VotingPeriod.ProtoReflect())
+	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
+		if x.MinExecutionPeriod == nil {
+			x.MinExecutionPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.MinExecutionPeriod.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GroupInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DecisionPolicyWindows) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupInfo.id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.GroupInfo.admin":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.GroupInfo.metadata":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.GroupInfo.version":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.GroupInfo.total_weight":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.GroupInfo.created_at":
-		m := new(timestamppb.Timestamp)
+	case "cosmos.group.v1.DecisionPolicyWindows.voting_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.group.v1.DecisionPolicyWindows.min_execution_period":
+		m := new(durationpb.Duration)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.DecisionPolicyWindows"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.DecisionPolicyWindows does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GroupInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DecisionPolicyWindows) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.GroupInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.DecisionPolicyWindows", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3031,7 +3048,7 @@ func (x *fastReflection_GroupInfo) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GroupInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DecisionPolicyWindows) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3042,7 +3059,7 @@ func (x *fastReflection_GroupInfo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DecisionPolicyWindows) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3054,7 +3071,7 @@ func (x *fastReflection_GroupInfo) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GroupInfo) IsValid() bool {
+func (x *fastReflection_DecisionPolicyWindows) IsValid() bool {
 	return x != nil
 }
 
@@ -3064,9 +3081,9 @@ func (x *fastReflection_GroupInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DecisionPolicyWindows) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GroupInfo)
+		x := input.Message.Interface().(*DecisionPolicyWindows)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3078,30 +3095,16 @@ func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.Admin)
-		if l > 0 {
+		if x.VotingPeriod != nil {
+			l = options.Size(x.VotingPeriod)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Metadata)
-		if l > 0 {
+		if x.MinExecutionPeriod != nil {
+			l = options.Size(x.MinExecutionPeriod)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Version != 0 {
-			n += 1 + runtime.Sov(uint64(x.Version))
-		}
-		l = len(x.TotalWeight)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.CreatedAt != nil {
-			l = options.Size(x.CreatedAt)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
 		}
 		return protoiface.SizeOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3110,7 +3113,7 @@ func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GroupInfo)
+		x := input.Message.Interface().(*DecisionPolicyWindows)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3129,8 +3132,8 @@ func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.CreatedAt != nil {
-			encoded, err := options.Marshal(x.CreatedAt)
+		if x.MinExecutionPeriod != nil {
+			encoded, err := options.Marshal(x.MinExecutionPeriod)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3141,38 +3144,21 @@ func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.TotalWeight) > 0 {
-			i -= len(x.TotalWeight)
-			copy(dAtA[i:], x.TotalWeight)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalWeight)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.Version != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
-			i--
-			dAtA[i] = 0x20
-		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Admin) > 0 {
-			i -= len(x.Admin)
-			copy(dAtA[i:], x.Admin)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
-			i--
 			dAtA[i] = 0x12
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if x.VotingPeriod != nil {
+			encoded, err := options.Marshal(x.VotingPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3185,7 +3171,7 @@ func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GroupInfo)
+		x := input.Message.Interface().(*DecisionPolicyWindows)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3217,68 +3203,17 @@ func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Admin = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3288,78 +3223,31 @@ func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-				}
-				x.Version = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Version |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if x.VotingPeriod == nil {
+					x.VotingPeriod = &durationpb.Duration{}
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.TotalWeight = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3386,10 +3274,10 @@ func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CreatedAt == nil {
-					x.CreatedAt = &timestamppb.Timestamp{}
+				if x.MinExecutionPeriod == nil {
+					x.MinExecutionPeriod = &durationpb.Duration{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinExecutionPeriod); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3429,27 +3317,35 @@ func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GroupMember          protoreflect.MessageDescriptor
-	fd_GroupMember_group_id protoreflect.FieldDescriptor
-	fd_GroupMember_member   protoreflect.FieldDescriptor
+	md_GroupInfo              protoreflect.MessageDescriptor
+	fd_GroupInfo_id           protoreflect.FieldDescriptor
+	fd_GroupInfo_admin        protoreflect.FieldDescriptor
+	fd_GroupInfo_metadata     protoreflect.FieldDescriptor
+	fd_GroupInfo_version      protoreflect.FieldDescriptor
+	fd_GroupInfo_total_weight protoreflect.FieldDescriptor
+	fd_GroupInfo_created_at   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_types_proto_init()
-	md_GroupMember = File_cosmos_group_v1_types_proto.Messages().ByName("GroupMember")
-	fd_GroupMember_group_id = md_GroupMember.Fields().ByName("group_id")
-	fd_GroupMember_member = md_GroupMember.Fields().ByName("member")
+	md_GroupInfo = File_cosmos_group_v1_types_proto.Messages().ByName("GroupInfo")
+	fd_GroupInfo_id = md_GroupInfo.Fields().ByName("id")
+	fd_GroupInfo_admin = md_GroupInfo.Fields().ByName("admin")
+	fd_GroupInfo_metadata = md_GroupInfo.Fields().ByName("metadata")
+	fd_GroupInfo_version = md_GroupInfo.Fields().ByName("version")
+	fd_GroupInfo_total_weight = md_GroupInfo.Fields().ByName("total_weight")
+	fd_GroupInfo_created_at = md_GroupInfo.Fields().ByName("created_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_GroupMember)(nil)
+var _ protoreflect.Message = (*fastReflection_GroupInfo)(nil)
 
-type fastReflection_GroupMember GroupMember
+type fastReflection_GroupInfo GroupInfo
 
-func (x *GroupMember) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GroupMember)(x)
+func (x *GroupInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GroupInfo)(x)
 }
 
-func (x *GroupMember) slowProtoReflect() protoreflect.Message {
+func (x *GroupInfo) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_types_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3461,43 +3357,43 @@ func (x *GroupMember) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GroupMember_messageType fastReflection_GroupMember_messageType
-var _ protoreflect.MessageType = fastReflection_GroupMember_messageType{}
+var _fastReflection_GroupInfo_messageType fastReflection_GroupInfo_messageType
+var _ protoreflect.MessageType = fastReflection_GroupInfo_messageType{}
 
-type fastReflection_GroupMember_messageType struct{}
+type fastReflection_GroupInfo_messageType struct{}
 
-func (x fastReflection_GroupMember_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GroupMember)(nil)
+func (x fastReflection_GroupInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GroupInfo)(nil)
 }
-func (x fastReflection_GroupMember_messageType) New() protoreflect.Message {
-	return new(fastReflection_GroupMember)
+func (x fastReflection_GroupInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_GroupInfo)
 }
-func (x fastReflection_GroupMember_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GroupMember
+func (x fastReflection_GroupInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroupInfo
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GroupMember) Descriptor() protoreflect.MessageDescriptor {
-	return md_GroupMember
+func (x *fastReflection_GroupInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroupInfo
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GroupMember) Type() protoreflect.MessageType {
-	return _fastReflection_GroupMember_messageType
+func (x *fastReflection_GroupInfo) Type() protoreflect.MessageType {
+	return _fastReflection_GroupInfo_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GroupMember) New() protoreflect.Message {
-	return new(fastReflection_GroupMember)
+func (x *fastReflection_GroupInfo) New() protoreflect.Message {
+	return new(fastReflection_GroupInfo)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GroupMember) Interface() protoreflect.ProtoMessage {
-	return (*GroupMember)(x)
+func (x *fastReflection_GroupInfo) Interface() protoreflect.ProtoMessage {
+	return (*GroupInfo)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3505,16 +3401,40 @@ func (x *fastReflection_GroupMember) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GroupMember) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.GroupId != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GroupId)
-		if !f(fd_GroupMember_group_id, value) {
-			return
+func (x *fastReflection_GroupInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_GroupInfo_id, value) {
+			return
 		}
 	}
-	if x.Member != nil {
-		value := protoreflect.ValueOfMessage(x.Member.ProtoReflect())
-		if !f(fd_GroupMember_member, value) {
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_GroupInfo_admin, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_GroupInfo_metadata, value) {
+			return
+		}
+	}
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_GroupInfo_version, value) {
+			return
+		}
+	}
+	if x.TotalWeight != "" {
+		value := protoreflect.ValueOfString(x.TotalWeight)
+		if !f(fd_GroupInfo_total_weight, value) {
+			return
+		}
+	}
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_GroupInfo_created_at, value) {
 			return
 		}
 	}
@@ -3531,17 +3451,25 @@ func (x *fastReflection_GroupMember) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GroupMember) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GroupInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupMember.group_id":
-		return x.GroupId != uint64(0)
-	case "cosmos.group.v1.GroupMember.member":
-		return x.Member != nil
+	case "cosmos.group.v1.GroupInfo.id":
+		return x.Id != uint64(0)
+	case "cosmos.group.v1.GroupInfo.admin":
+		return x.Admin != ""
+	case "cosmos.group.v1.GroupInfo.metadata":
+		return x.Metadata != ""
+	case "cosmos.group.v1.GroupInfo.version":
+		return x.Version != uint64(0)
+	case "cosmos.group.v1.GroupInfo.total_weight":
+		return x.TotalWeight != ""
+	case "cosmos.group.v1.GroupInfo.created_at":
+		return x.CreatedAt != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3551,17 +3479,25 @@ func (x *fastReflection_GroupMember) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupMember) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GroupInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupMember.group_id":
-		x.GroupId = uint64(0)
-	case "cosmos.group.v1.GroupMember.member":
-		x.Member = nil
+	case "cosmos.group.v1.GroupInfo.id":
+		x.Id = uint64(0)
+	case "cosmos.group.v1.GroupInfo.admin":
+		x.Admin = ""
+	case "cosmos.group.v1.GroupInfo.metadata":
+		x.Metadata = ""
+	case "cosmos.group.v1.GroupInfo.version":
+		x.Version = uint64(0)
+	case "cosmos.group.v1.GroupInfo.total_weight":
+		x.TotalWeight = ""
+	case "cosmos.group.v1.GroupInfo.created_at":
+		x.CreatedAt = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3571,19 +3507,31 @@ func (x *fastReflection_GroupMember) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GroupMember) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GroupInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.GroupMember.group_id":
-		value := x.GroupId
+	case "cosmos.group.v1.GroupInfo.id":
+		value := x.Id
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.GroupMember.member":
-		value := x.Member
+	case "cosmos.group.v1.GroupInfo.admin":
+		value := x.Admin
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.GroupInfo.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.GroupInfo.version":
+		value := x.Version
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.GroupInfo.total_weight":
+		value := x.TotalWeight
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.GroupInfo.created_at":
+		value := x.CreatedAt
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3597,17 +3545,25 @@ func (x *fastReflection_GroupMember) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupMember) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GroupInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupMember.group_id":
-		x.GroupId = value.Uint()
-	case "cosmos.group.v1.GroupMember.member":
-		x.Member = value.Message().Interface().(*Member)
+	case "cosmos.group.v1.GroupInfo.id":
+		x.Id = value.Uint()
+	case "cosmos.group.v1.GroupInfo.admin":
+		x.Admin = value.Interface().(string)
+	case "cosmos.group.v1.GroupInfo.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.group.v1.GroupInfo.version":
+		x.Version = value.Uint()
+	case "cosmos.group.v1.GroupInfo.total_weight":
+		x.TotalWeight = value.Interface().(string)
+	case "cosmos.group.v1.GroupInfo.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3621,48 +3577,64 @@ func (x *fastReflection_GroupMember) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupMember) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GroupInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupMember.member":
-		if x.Member == nil {
-			x.Member = new(Member)
+	case "cosmos.group.v1.GroupInfo.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.Member.ProtoReflect())
-	case "cosmos.group.v1.GroupMember.group_id":
-		panic(fmt.Errorf("field group_id of message cosmos.group.v1.GroupMember is not mutable"))
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "cosmos.group.v1.GroupInfo.id":
+		panic(fmt.Errorf("field id of message cosmos.group.v1.GroupInfo is not mutable"))
+	case "cosmos.group.v1.GroupInfo.admin":
+		panic(fmt.Errorf("field admin of message cosmos.group.v1.GroupInfo is not mutable"))
+	case "cosmos.group.v1.GroupInfo.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.group.v1.GroupInfo is not mutable"))
+	case "cosmos.group.v1.GroupInfo.version":
+		panic(fmt.Errorf("field version of message cosmos.group.v1.GroupInfo is not mutable"))
+	case "cosmos.group.v1.GroupInfo.total_weight":
+		panic(fmt.Errorf("field total_weight of message cosmos.group.v1.GroupInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GroupMember) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GroupInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupMember.group_id":
+	case "cosmos.group.v1.GroupInfo.id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.GroupMember.member":
-		m := new(Member)
+	case "cosmos.group.v1.GroupInfo.admin":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.GroupInfo.metadata":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.GroupInfo.version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.GroupInfo.total_weight":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.GroupInfo.created_at":
+		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GroupMember) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GroupInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.GroupMember", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.GroupInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3670,7 +3642,7 @@ func (x *fastReflection_GroupMember) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GroupMember) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GroupInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3681,7 +3653,7 @@ func (x *fastReflection_GroupMember) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupMember) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GroupInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3693,7 +3665,7 @@ func (x *fastReflection_GroupMember) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GroupMember) IsValid() bool {
+func (x *fastReflection_GroupInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -3703,9 +3675,9 @@ func (x *fastReflection_GroupMember) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GroupInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GroupMember)
+		x := input.Message.Interface().(*GroupInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3717,11 +3689,26 @@ func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.GroupId != 0 {
-			n += 1 + runtime.Sov(uint64(x.GroupId))
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		if x.Member != nil {
-			l = options.Size(x.Member)
+		l = len(x.Admin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
+		}
+		l = len(x.TotalWeight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -3734,7 +3721,7 @@ func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GroupMember)
+		x := input.Message.Interface().(*GroupInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3753,8 +3740,8 @@ func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Member != nil {
-			encoded, err := options.Marshal(x.Member)
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3765,10 +3752,36 @@ func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.TotalWeight) > 0 {
+			i -= len(x.TotalWeight)
+			copy(dAtA[i:], x.TotalWeight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalWeight)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
 			dAtA[i] = 0x12
 		}
-		if x.GroupId != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
 			i--
 			dAtA[i] = 0x8
 		}
@@ -3783,7 +3796,7 @@ func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GroupMember)
+		x := input.Message.Interface().(*GroupInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3815,17 +3828,17 @@ func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupMember: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 				}
-				x.GroupId = 0
+				x.Id = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3835,14 +3848,129 @@ func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GroupId |= uint64(b&0x7F) << shift
+					x.Id |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Admin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalWeight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -3869,10 +3997,10 @@ func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Member == nil {
-					x.Member = &Member{}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Member); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -3912,37 +4040,27 @@ func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GroupPolicyInfo                 protoreflect.MessageDescriptor
-	fd_GroupPolicyInfo_address         protoreflect.FieldDescriptor
-	fd_GroupPolicyInfo_group_id        protoreflect.FieldDescriptor
-	fd_GroupPolicyInfo_admin           protoreflect.FieldDescriptor
-	fd_GroupPolicyInfo_metadata        protoreflect.FieldDescriptor
-	fd_GroupPolicyInfo_version         protoreflect.FieldDescriptor
-	fd_GroupPolicyInfo_decision_policy protoreflect.FieldDescriptor
-	fd_GroupPolicyInfo_created_at      protoreflect.FieldDescriptor
+	md_GroupMember          protoreflect.MessageDescriptor
+	fd_GroupMember_group_id protoreflect.FieldDescriptor
+	fd_GroupMember_member   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_types_proto_init()
-	md_GroupPolicyInfo = File_cosmos_group_v1_types_proto.Messages().ByName("GroupPolicyInfo")
-	fd_GroupPolicyInfo_address = md_GroupPolicyInfo.Fields().ByName("address")
-	fd_GroupPolicyInfo_group_id = md_GroupPolicyInfo.Fields().ByName("group_id")
-	fd_GroupPolicyInfo_admin = md_GroupPolicyInfo.Fields().ByName("admin")
-	fd_GroupPolicyInfo_metadata = md_GroupPolicyInfo.Fields().ByName("metadata")
-	fd_GroupPolicyInfo_version = md_GroupPolicyInfo.Fields().ByName("version")
-	fd_GroupPolicyInfo_decision_policy = md_GroupPolicyInfo.Fields().ByName("decision_policy")
-	fd_GroupPolicyInfo_created_at = md_GroupPolicyInfo.Fields().ByName("created_at")
+	md_GroupMember = File_cosmos_group_v1_types_proto.Messages().ByName("GroupMember")
+	fd_GroupMember_group_id = md_GroupMember.Fields().ByName("group_id")
+	fd_GroupMember_member = md_GroupMember.Fields().ByName("member")
 }
 
-var _ protoreflect.Message = (*fastReflection_GroupPolicyInfo)(nil)
+var _ protoreflect.Message = (*fastReflection_GroupMember)(nil)
 
-type fastReflection_GroupPolicyInfo GroupPolicyInfo
+type fastReflection_GroupMember GroupMember
 
-func (x *GroupPolicyInfo) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GroupPolicyInfo)(x)
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GroupMember)(x)
 }
 
-func (x *GroupPolicyInfo) slowProtoReflect() protoreflect.Message {
+func (x *GroupMember) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_group_v1_types_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3954,43 +4072,43 @@ func (x *GroupPolicyInfo) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GroupPolicyInfo_messageType fastReflection_GroupPolicyInfo_messageType
-var _ protoreflect.MessageType = fastReflection_GroupPolicyInfo_messageType{}
+var _fastReflection_GroupMember_messageType fastReflection_GroupMember_messageType
+var _ protoreflect.MessageType = fastReflection_GroupMember_messageType{}
 
-type fastReflection_GroupPolicyInfo_messageType struct{}
+type fastReflection_GroupMember_messageType struct{}
 
-func (x fastReflection_GroupPolicyInfo_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GroupPolicyInfo)(nil)
+func (x fastReflection_GroupMember_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GroupMember)(nil)
 }
-func (x fastReflection_GroupPolicyInfo_messageType) New() protoreflect.Message {
-	return new(fastReflection_GroupPolicyInfo)
+func (x fastReflection_GroupMember_messageType) New() protoreflect.Message {
+	return new(fastReflection_GroupMember)
 }
-func (x fastReflection_GroupPolicyInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GroupPolicyInfo
+func (x fastReflection_GroupMember_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroupMember
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GroupPolicyInfo) Descriptor() protoreflect.MessageDescriptor {
-	return md_GroupPolicyInfo
+func (x *fastReflection_GroupMember) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroupMember
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GroupPolicyInfo) Type() protoreflect.MessageType {
-	return _fastReflection_GroupPolicyInfo_messageType
+func (x *fastReflection_GroupMember) Type() protoreflect.MessageType {
+	return _fastReflection_GroupMember_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GroupPolicyInfo) New() protoreflect.Message {
-	return new(fastReflection_GroupPolicyInfo)
+func (x *fastReflection_GroupMember) New() protoreflect.Message {
+	return new(fastReflection_GroupMember)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GroupPolicyInfo) Interface() protoreflect.ProtoMessage {
-	return (*GroupPolicyInfo)(x)
+func (x *fastReflection_GroupMember) Interface() protoreflect.ProtoMessage {
+	return (*GroupMember)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3998,46 +4116,16 @@ func (x *fastReflection_GroupPolicyInfo) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GroupPolicyInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_GroupPolicyInfo_address, value) {
-			return
-		}
-	}
+func (x *fastReflection_GroupMember) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.GroupId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.GroupId)
-		if !f(fd_GroupPolicyInfo_group_id, value) {
-			return
-		}
-	}
-	if x.Admin != "" {
-		value := protoreflect.ValueOfString(x.Admin)
-		if !f(fd_GroupPolicyInfo_admin, value) {
-			return
-		}
-	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_GroupPolicyInfo_metadata, value) {
-			return
-		}
-	}
-	if x.Version != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Version)
-		if !f(fd_GroupPolicyInfo_version, value) {
-			return
-		}
-	}
-	if x.DecisionPolicy != nil {
-		value := protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
-		if !f(fd_GroupPolicyInfo_decision_policy, value) {
+		if !f(fd_GroupMember_group_id, value) {
 			return
 		}
 	}
-	if x.CreatedAt != nil {
-		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
-		if !f(fd_GroupPolicyInfo_created_at, value) {
+	if x.Member != nil {
+		value := protoreflect.ValueOfMessage(x.Member.ProtoReflect())
+		if !f(fd_GroupMember_member, value) {
 			return
 		}
 	}
@@ -4054,27 +4142,17 @@ func (x *fastReflection_GroupPolicyInfo) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GroupPolicyInfo) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GroupMember) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupPolicyInfo.address":
-		return x.Address != ""
-	case "cosmos.group.v1.GroupPolicyInfo.group_id":
+	case "cosmos.group.v1.GroupMember.group_id":
 		return x.GroupId != uint64(0)
-	case "cosmos.group.v1.GroupPolicyInfo.admin":
-		return x.Admin != ""
-	case "cosmos.group.v1.GroupPolicyInfo.metadata":
-		return x.Metadata != ""
-	case "cosmos.group.v1.GroupPolicyInfo.version":
-		return x.Version != uint64(0)
-	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
-		return x.DecisionPolicy != nil
-	case "cosmos.group.v1.GroupPolicyInfo.created_at":
-		return x.CreatedAt != nil
+	case "cosmos.group.v1.GroupMember.member":
+		return x.Member != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4084,27 +4162,17 @@ func (x *fastReflection_GroupPolicyInfo) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupPolicyInfo) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GroupMember) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupPolicyInfo.address":
-		x.Address = ""
-	case "cosmos.group.v1.GroupPolicyInfo.group_id":
+	case "cosmos.group.v1.GroupMember.group_id":
 		x.GroupId = uint64(0)
-	case "cosmos.group.v1.GroupPolicyInfo.admin":
-		x.Admin = ""
-	case "cosmos.group.v1.GroupPolicyInfo.metadata":
-		x.Metadata = ""
-	case "cosmos.group.v1.GroupPolicyInfo.version":
-		x.Version = uint64(0)
-	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
-		x.DecisionPolicy = nil
-	case "cosmos.group.v1.GroupPolicyInfo.created_at":
-		x.CreatedAt = nil
+	case "cosmos.group.v1.GroupMember.member":
+		x.Member = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4114,34 +4182,19 @@ func (x *fastReflection_GroupPolicyInfo) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GroupPolicyInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GroupMember) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.GroupPolicyInfo.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.GroupPolicyInfo.group_id":
+	case "cosmos.group.v1.GroupMember.group_id":
 		value := x.GroupId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.GroupPolicyInfo.admin":
-		value := x.Admin
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.GroupPolicyInfo.metadata":
-		value := x.Metadata
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.GroupPolicyInfo.version":
-		value := x.Version
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
-		value := x.DecisionPolicy
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.group.v1.GroupPolicyInfo.created_at":
-		value := x.CreatedAt
+	case "cosmos.group.v1.GroupMember.member":
+		value := x.Member
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4155,27 +4208,17 @@ func (x *fastReflection_GroupPolicyInfo) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupPolicyInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GroupMember) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupPolicyInfo.address":
-		x.Address = value.Interface().(string)
-	case "cosmos.group.v1.GroupPolicyInfo.group_id":
+	case "cosmos.group.v1.GroupMember.group_id":
 		x.GroupId = value.Uint()
-	case "cosmos.group.v1.GroupPolicyInfo.admin":
-		x.Admin = value.Interface().(string)
-	case "cosmos.group.v1.GroupPolicyInfo.metadata":
-		x.Metadata = value.Interface().(string)
-	case "cosmos.group.v1.GroupPolicyInfo.version":
-		x.Version = value.Uint()
-	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
-		x.DecisionPolicy = value.Message().Interface().(*anypb.Any)
-	case "cosmos.group.v1.GroupPolicyInfo.created_at":
-		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.group.v1.GroupMember.member":
+		x.Member = value.Message().Interface().(*Member)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4189,72 +4232,48 @@ func (x *fastReflection_GroupPolicyInfo) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupPolicyInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GroupMember) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
-		if x.DecisionPolicy == nil {
-			x.DecisionPolicy = new(anypb.Any)
-		}
-		return protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
-	case "cosmos.group.v1.GroupPolicyInfo.created_at":
-		if x.CreatedAt == nil {
-			x.CreatedAt = new(timestamppb.Timestamp)
+	case "cosmos.group.v1.GroupMember.member":
+		if x.Member == nil {
+			x.Member = new(Member)
 		}
-		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
-	case "cosmos.group.v1.GroupPolicyInfo.address":
-		panic(fmt.Errorf("field address of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
-	case "cosmos.group.v1.GroupPolicyInfo.group_id":
-		panic(fmt.Errorf("field group_id of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
-	case "cosmos.group.v1.GroupPolicyInfo.admin":
-		panic(fmt.Errorf("field admin of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
-	case "cosmos.group.v1.GroupPolicyInfo.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
-	case "cosmos.group.v1.GroupPolicyInfo.version":
-		panic(fmt.Errorf("field version of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
+		return protoreflect.ValueOfMessage(x.Member.ProtoReflect())
+	case "cosmos.group.v1.GroupMember.group_id":
+		panic(fmt.Errorf("field group_id of message cosmos.group.v1.GroupMember is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GroupPolicyInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GroupMember) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.GroupPolicyInfo.address":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.GroupPolicyInfo.group_id":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.GroupPolicyInfo.admin":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.GroupPolicyInfo.metadata":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.GroupPolicyInfo.version":
+	case "cosmos.group.v1.GroupMember.group_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
-		m := new(anypb.Any)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.group.v1.GroupPolicyInfo.created_at":
-		m := new(timestamppb.Timestamp)
+	case "cosmos.group.v1.GroupMember.member":
+		m := new(Member)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupMember"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupMember does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GroupPolicyInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GroupMember) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.GroupPolicyInfo", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.GroupMember", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4262,7 +4281,7 @@ func (x *fastReflection_GroupPolicyInfo) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GroupPolicyInfo) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GroupMember) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4273,7 +4292,7 @@ func (x *fastReflection_GroupPolicyInfo) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GroupPolicyInfo) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GroupMember) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4285,7 +4304,7 @@ func (x *fastReflection_GroupPolicyInfo) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GroupPolicyInfo) IsValid() bool {
+func (x *fastReflection_GroupMember) IsValid() bool {
 	return x != nil
 }
 
@@ -4295,9 +4314,9 @@ func (x *fastReflection_GroupPolicyInfo) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GroupMember) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GroupPolicyInfo)
+		x := input.Message.Interface().(*GroupMember)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4309,30 +4328,11 @@ func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.GroupId != 0 {
 			n += 1 + runtime.Sov(uint64(x.GroupId))
 		}
-		l = len(x.Admin)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Metadata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Version != 0 {
-			n += 1 + runtime.Sov(uint64(x.Version))
-		}
-		if x.DecisionPolicy != nil {
-			l = options.Size(x.DecisionPolicy)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.CreatedAt != nil {
-			l = options.Size(x.CreatedAt)
+		if x.Member != nil {
+			l = options.Size(x.Member)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4345,7 +4345,7 @@ func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GroupPolicyInfo)
+		x := input.Message.Interface().(*GroupMember)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4364,22 +4364,8 @@ func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.CreatedAt != nil {
-			encoded, err := options.Marshal(x.CreatedAt)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.DecisionPolicy != nil {
-			encoded, err := options.Marshal(x.DecisionPolicy)
+		if x.Member != nil {
+			encoded, err := options.Marshal(x.Member)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4390,38 +4376,12 @@ func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x32
-		}
-		if x.Version != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Admin) > 0 {
-			i -= len(x.Admin)
-			copy(dAtA[i:], x.Admin)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
-			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 		if x.GroupId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
 			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4434,7 +4394,7 @@ func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GroupPolicyInfo)
+		x := input.Message.Interface().(*GroupMember)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4466,45 +4426,13 @@ func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupPolicyInfo: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupMember: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupPolicyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupMember: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
 				if wireType != 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 				}
@@ -4523,11 +4451,11 @@ func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			case 3:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4537,144 +4465,25 @@ func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Admin = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-				}
-				x.Version = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Version |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 6:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.DecisionPolicy == nil {
-					x.DecisionPolicy = &anypb.Any{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecisionPolicy); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.CreatedAt == nil {
-					x.CreatedAt = &timestamppb.Timestamp{}
+				if x.Member == nil {
+					x.Member = &Member{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Member); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4713,286 +4522,141 @@ func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var _ protoreflect.List = (*_Proposal_4_list)(nil)
-
-type _Proposal_4_list struct {
-	list *[]string
-}
+var (
+	md_GroupPolicyInfo                    protoreflect.MessageDescriptor
+	fd_GroupPolicyInfo_address            protoreflect.FieldDescriptor
+	fd_GroupPolicyInfo_group_id           protoreflect.FieldDescriptor
+	fd_GroupPolicyInfo_admin              protoreflect.FieldDescriptor
+	fd_GroupPolicyInfo_metadata           protoreflect.FieldDescriptor
+	fd_GroupPolicyInfo_version            protoreflect.FieldDescriptor
+	fd_GroupPolicyInfo_decision_policy    protoreflect.FieldDescriptor
+	fd_GroupPolicyInfo_created_at         protoreflect.FieldDescriptor
+	fd_GroupPolicyInfo_execution_settings protoreflect.FieldDescriptor
+)
 
-func (x *_Proposal_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+func init() {
+	file_cosmos_group_v1_types_proto_init()
+	md_GroupPolicyInfo = File_cosmos_group_v1_types_proto.Messages().ByName("GroupPolicyInfo")
+	fd_GroupPolicyInfo_address = md_GroupPolicyInfo.Fields().ByName("address")
+	fd_GroupPolicyInfo_group_id = md_GroupPolicyInfo.Fields().ByName("group_id")
+	fd_GroupPolicyInfo_admin = md_GroupPolicyInfo.Fields().ByName("admin")
+	fd_GroupPolicyInfo_metadata = md_GroupPolicyInfo.Fields().ByName("metadata")
+	fd_GroupPolicyInfo_version = md_GroupPolicyInfo.Fields().ByName("version")
+	fd_GroupPolicyInfo_decision_policy = md_GroupPolicyInfo.Fields().ByName("decision_policy")
+	fd_GroupPolicyInfo_created_at = md_GroupPolicyInfo.Fields().ByName("created_at")
+	fd_GroupPolicyInfo_execution_settings = md_GroupPolicyInfo.Fields().ByName("execution_settings")
 }
 
-func (x *_Proposal_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
+var _ protoreflect.Message = (*fastReflection_GroupPolicyInfo)(nil)
 
-func (x *_Proposal_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
+type fastReflection_GroupPolicyInfo GroupPolicyInfo
 
-func (x *_Proposal_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+func (x *GroupPolicyInfo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GroupPolicyInfo)(x)
 }
 
-func (x *_Proposal_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message Proposal at list field Proposers as it is not of Message kind"))
+func (x *GroupPolicyInfo) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_Proposal_4_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
+var _fastReflection_GroupPolicyInfo_messageType fastReflection_GroupPolicyInfo_messageType
+var _ protoreflect.MessageType = fastReflection_GroupPolicyInfo_messageType{}
 
-func (x *_Proposal_4_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
+type fastReflection_GroupPolicyInfo_messageType struct{}
 
-func (x *_Proposal_4_list) IsValid() bool {
-	return x.list != nil
+func (x fastReflection_GroupPolicyInfo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GroupPolicyInfo)(nil)
 }
-
-var _ protoreflect.List = (*_Proposal_12_list)(nil)
-
-type _Proposal_12_list struct {
-	list *[]*anypb.Any
+func (x fastReflection_GroupPolicyInfo_messageType) New() protoreflect.Message {
+	return new(fastReflection_GroupPolicyInfo)
 }
-
-func (x *_Proposal_12_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
+func (x fastReflection_GroupPolicyInfo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroupPolicyInfo
 }
 
-func (x *_Proposal_12_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GroupPolicyInfo) Descriptor() protoreflect.MessageDescriptor {
+	return md_GroupPolicyInfo
 }
 
-func (x *_Proposal_12_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	(*x.list)[i] = concreteValue
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GroupPolicyInfo) Type() protoreflect.MessageType {
+	return _fastReflection_GroupPolicyInfo_messageType
 }
 
-func (x *_Proposal_12_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
-	*x.list = append(*x.list, concreteValue)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GroupPolicyInfo) New() protoreflect.Message {
+	return new(fastReflection_GroupPolicyInfo)
 }
 
-func (x *_Proposal_12_list) AppendMutable() protoreflect.Value {
-	v := new(anypb.Any)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GroupPolicyInfo) Interface() protoreflect.ProtoMessage {
+	return (*GroupPolicyInfo)(x)
 }
 
-func (x *_Proposal_12_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GroupPolicyInfo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_GroupPolicyInfo_address, value) {
+			return
+		}
 	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_Proposal_12_list) NewElement() protoreflect.Value {
-	v := new(anypb.Any)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_Proposal_12_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_Proposal                      protoreflect.MessageDescriptor
-	fd_Proposal_id                   protoreflect.FieldDescriptor
-	fd_Proposal_group_policy_address protoreflect.FieldDescriptor
-	fd_Proposal_metadata             protoreflect.FieldDescriptor
-	fd_Proposal_proposers            protoreflect.FieldDescriptor
-	fd_Proposal_submit_time          protoreflect.FieldDescriptor
-	fd_Proposal_group_version        protoreflect.FieldDescriptor
-	fd_Proposal_group_policy_version protoreflect.FieldDescriptor
-	fd_Proposal_status               protoreflect.FieldDescriptor
-	fd_Proposal_final_tally_result   protoreflect.FieldDescriptor
-	fd_Proposal_voting_period_end    protoreflect.FieldDescriptor
-	fd_Proposal_executor_result      protoreflect.FieldDescriptor
-	fd_Proposal_messages             protoreflect.FieldDescriptor
-	fd_Proposal_title                protoreflect.FieldDescriptor
-	fd_Proposal_summary              protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_cosmos_group_v1_types_proto_init()
-	md_Proposal = File_cosmos_group_v1_types_proto.Messages().ByName("Proposal")
-	fd_Proposal_id = md_Proposal.Fields().ByName("id")
-	fd_Proposal_group_policy_address = md_Proposal.Fields().ByName("group_policy_address")
-	fd_Proposal_metadata = md_Proposal.Fields().ByName("metadata")
-	fd_Proposal_proposers = md_Proposal.Fields().ByName("proposers")
-	fd_Proposal_submit_time = md_Proposal.Fields().ByName("submit_time")
-	fd_Proposal_group_version = md_Proposal.Fields().ByName("group_version")
-	fd_Proposal_group_policy_version = md_Proposal.Fields().ByName("group_policy_version")
-	fd_Proposal_status = md_Proposal.Fields().ByName("status")
-	fd_Proposal_final_tally_result = md_Proposal.Fields().ByName("final_tally_result")
-	fd_Proposal_voting_period_end = md_Proposal.Fields().ByName("voting_period_end")
-	fd_Proposal_executor_result = md_Proposal.Fields().ByName("executor_result")
-	fd_Proposal_messages = md_Proposal.Fields().ByName("messages")
-	fd_Proposal_title = md_Proposal.Fields().ByName("title")
-	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
-}
-
-var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
-
-type fastReflection_Proposal Proposal
-
-func (x *Proposal) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Proposal)(x)
-}
-
-func (x *Proposal) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_types_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
-var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
-
-type fastReflection_Proposal_messageType struct{}
-
-func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Proposal)(nil)
-}
-func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
-}
-func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
-	return md_Proposal
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
-	return _fastReflection_Proposal_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Proposal) New() protoreflect.Message {
-	return new(fastReflection_Proposal)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
-	return (*Proposal)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Id != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_Proposal_id, value) {
+	if x.GroupId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupId)
+		if !f(fd_GroupPolicyInfo_group_id, value) {
 			return
 		}
 	}
-	if x.GroupPolicyAddress != "" {
-		value := protoreflect.ValueOfString(x.GroupPolicyAddress)
-		if !f(fd_Proposal_group_policy_address, value) {
+	if x.Admin != "" {
+		value := protoreflect.ValueOfString(x.Admin)
+		if !f(fd_GroupPolicyInfo_admin, value) {
 			return
 		}
 	}
 	if x.Metadata != "" {
 		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_Proposal_metadata, value) {
-			return
-		}
-	}
-	if len(x.Proposers) != 0 {
-		value := protoreflect.ValueOfList(&_Proposal_4_list{list: &x.Proposers})
-		if !f(fd_Proposal_proposers, value) {
-			return
-		}
-	}
-	if x.SubmitTime != nil {
-		value := protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
-		if !f(fd_Proposal_submit_time, value) {
-			return
-		}
-	}
-	if x.GroupVersion != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GroupVersion)
-		if !f(fd_Proposal_group_version, value) {
-			return
-		}
-	}
-	if x.GroupPolicyVersion != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.GroupPolicyVersion)
-		if !f(fd_Proposal_group_policy_version, value) {
-			return
-		}
-	}
-	if x.Status != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
-		if !f(fd_Proposal_status, value) {
-			return
-		}
-	}
-	if x.FinalTallyResult != nil {
-		value := protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
-		if !f(fd_Proposal_final_tally_result, value) {
-			return
-		}
-	}
-	if x.VotingPeriodEnd != nil {
-		value := protoreflect.ValueOfMessage(x.VotingPeriodEnd.ProtoReflect())
-		if !f(fd_Proposal_voting_period_end, value) {
+		if !f(fd_GroupPolicyInfo_metadata, value) {
 			return
 		}
 	}
-	if x.ExecutorResult != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ExecutorResult))
-		if !f(fd_Proposal_executor_result, value) {
+	if x.Version != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Version)
+		if !f(fd_GroupPolicyInfo_version, value) {
 			return
 		}
 	}
-	if len(x.Messages) != 0 {
-		value := protoreflect.ValueOfList(&_Proposal_12_list{list: &x.Messages})
-		if !f(fd_Proposal_messages, value) {
+	if x.DecisionPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
+		if !f(fd_GroupPolicyInfo_decision_policy, value) {
 			return
 		}
 	}
-	if x.Title != "" {
-		value := protoreflect.ValueOfString(x.Title)
-		if !f(fd_Proposal_title, value) {
+	if x.CreatedAt != nil {
+		value := protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+		if !f(fd_GroupPolicyInfo_created_at, value) {
 			return
 		}
 	}
-	if x.Summary != "" {
-		value := protoreflect.ValueOfString(x.Summary)
-		if !f(fd_Proposal_summary, value) {
+	if x.ExecutionSettings != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutionSettings.ProtoReflect())
+		if !f(fd_GroupPolicyInfo_execution_settings, value) {
 			return
 		}
 	}
@@ -5009,41 +4673,29 @@ func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, pro
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GroupPolicyInfo) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.Proposal.id":
-		return x.Id != uint64(0)
-	case "cosmos.group.v1.Proposal.group_policy_address":
-		return x.GroupPolicyAddress != ""
-	case "cosmos.group.v1.Proposal.metadata":
+	case "cosmos.group.v1.GroupPolicyInfo.address":
+		return x.Address != ""
+	case "cosmos.group.v1.GroupPolicyInfo.group_id":
+		return x.GroupId != uint64(0)
+	case "cosmos.group.v1.GroupPolicyInfo.admin":
+		return x.Admin != ""
+	case "cosmos.group.v1.GroupPolicyInfo.metadata":
 		return x.Metadata != ""
-	case "cosmos.group.v1.Proposal.proposers":
-		return len(x.Proposers) != 0
-	case "cosmos.group.v1.Proposal.submit_time":
-		return x.SubmitTime != nil
-	case "cosmos.group.v1.Proposal.group_version":
-		return x.GroupVersion != uint64(0)
-	case "cosmos.group.v1.Proposal.group_policy_version":
-		return x.GroupPolicyVersion != uint64(0)
-	case "cosmos.group.v1.Proposal.status":
-		return x.Status != 0
-	case "cosmos.group.v1.Proposal.final_tally_result":
-		return x.FinalTallyResult != nil
-	case "cosmos.group.v1.Proposal.voting_period_end":
-		return x.VotingPeriodEnd != nil
-	case "cosmos.group.v1.Proposal.executor_result":
-		return x.ExecutorResult != 0
-	case "cosmos.group.v1.Proposal.messages":
-		return len(x.Messages) != 0
-	case "cosmos.group.v1.Proposal.title":
-		return x.Title != ""
-	case "cosmos.group.v1.Proposal.summary":
-		return x.Summary != ""
+	case "cosmos.group.v1.GroupPolicyInfo.version":
+		return x.Version != uint64(0)
+	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
+		return x.DecisionPolicy != nil
+	case "cosmos.group.v1.GroupPolicyInfo.created_at":
+		return x.CreatedAt != nil
+	case "cosmos.group.v1.GroupPolicyInfo.execution_settings":
+		return x.ExecutionSettings != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5053,41 +4705,29 @@ func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GroupPolicyInfo) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.Proposal.id":
-		x.Id = uint64(0)
-	case "cosmos.group.v1.Proposal.group_policy_address":
-		x.GroupPolicyAddress = ""
-	case "cosmos.group.v1.Proposal.metadata":
+	case "cosmos.group.v1.GroupPolicyInfo.address":
+		x.Address = ""
+	case "cosmos.group.v1.GroupPolicyInfo.group_id":
+		x.GroupId = uint64(0)
+	case "cosmos.group.v1.GroupPolicyInfo.admin":
+		x.Admin = ""
+	case "cosmos.group.v1.GroupPolicyInfo.metadata":
 		x.Metadata = ""
-	case "cosmos.group.v1.Proposal.proposers":
-		x.Proposers = nil
-	case "cosmos.group.v1.Proposal.submit_time":
-		x.SubmitTime = nil
-	case "cosmos.group.v1.Proposal.group_version":
-		x.GroupVersion = uint64(0)
-	case "cosmos.group.v1.Proposal.group_policy_version":
-		x.GroupPolicyVersion = uint64(0)
-	case "cosmos.group.v1.Proposal.status":
-		x.Status = 0
-	case "cosmos.group.v1.Proposal.final_tally_result":
-		x.FinalTallyResult = nil
-	case "cosmos.group.v1.Proposal.voting_period_end":
-		x.VotingPeriodEnd = nil
-	case "cosmos.group.v1.Proposal.executor_result":
-		x.ExecutorResult = 0
-	case "cosmos.group.v1.Proposal.messages":
-		x.Messages = nil
-	case "cosmos.group.v1.Proposal.title":
-		x.Title = ""
-	case "cosmos.group.v1.Proposal.summary":
-		x.Summary = ""
+	case "cosmos.group.v1.GroupPolicyInfo.version":
+		x.Version = uint64(0)
+	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
+		x.DecisionPolicy = nil
+	case "cosmos.group.v1.GroupPolicyInfo.created_at":
+		x.CreatedAt = nil
+	case "cosmos.group.v1.GroupPolicyInfo.execution_settings":
+		x.ExecutionSettings = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5097,61 +4737,37 @@ func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GroupPolicyInfo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.Proposal.id":
-		value := x.Id
+	case "cosmos.group.v1.GroupPolicyInfo.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.GroupPolicyInfo.group_id":
+		value := x.GroupId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.Proposal.group_policy_address":
-		value := x.GroupPolicyAddress
+	case "cosmos.group.v1.GroupPolicyInfo.admin":
+		value := x.Admin
 		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.Proposal.metadata":
+	case "cosmos.group.v1.GroupPolicyInfo.metadata":
 		value := x.Metadata
 		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.Proposal.proposers":
-		if len(x.Proposers) == 0 {
-			return protoreflect.ValueOfList(&_Proposal_4_list{})
-		}
-		listValue := &_Proposal_4_list{list: &x.Proposers}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.Proposal.submit_time":
-		value := x.SubmitTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.group.v1.Proposal.group_version":
-		value := x.GroupVersion
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.Proposal.group_policy_version":
-		value := x.GroupPolicyVersion
+	case "cosmos.group.v1.GroupPolicyInfo.version":
+		value := x.Version
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.Proposal.status":
-		value := x.Status
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.group.v1.Proposal.final_tally_result":
-		value := x.FinalTallyResult
+	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
+		value := x.DecisionPolicy
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.group.v1.Proposal.voting_period_end":
-		value := x.VotingPeriodEnd
+	case "cosmos.group.v1.GroupPolicyInfo.created_at":
+		value := x.CreatedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.v1.GroupPolicyInfo.execution_settings":
+		value := x.ExecutionSettings
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.group.v1.Proposal.executor_result":
-		value := x.ExecutorResult
-		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.group.v1.Proposal.messages":
-		if len(x.Messages) == 0 {
-			return protoreflect.ValueOfList(&_Proposal_12_list{})
-		}
-		listValue := &_Proposal_12_list{list: &x.Messages}
-		return protoreflect.ValueOfList(listValue)
-	case "cosmos.group.v1.Proposal.title":
-		value := x.Title
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.Proposal.summary":
-		value := x.Summary
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5165,45 +4781,29 @@ func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) p
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GroupPolicyInfo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.Proposal.id":
-		x.Id = value.Uint()
-	case "cosmos.group.v1.Proposal.group_policy_address":
-		x.GroupPolicyAddress = value.Interface().(string)
-	case "cosmos.group.v1.Proposal.metadata":
+	case "cosmos.group.v1.GroupPolicyInfo.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.group.v1.GroupPolicyInfo.group_id":
+		x.GroupId = value.Uint()
+	case "cosmos.group.v1.GroupPolicyInfo.admin":
+		x.Admin = value.Interface().(string)
+	case "cosmos.group.v1.GroupPolicyInfo.metadata":
 		x.Metadata = value.Interface().(string)
-	case "cosmos.group.v1.Proposal.proposers":
-		lv := value.List()
-		clv := lv.(*_Proposal_4_list)
-		x.Proposers = *clv.list
-	case "cosmos.group.v1.Proposal.submit_time":
-		x.SubmitTime = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.group.v1.Proposal.group_version":
-		x.GroupVersion = value.Uint()
-	case "cosmos.group.v1.Proposal.group_policy_version":
-		x.GroupPolicyVersion = value.Uint()
-	case "cosmos.group.v1.Proposal.status":
-		x.Status = (ProposalStatus)(value.Enum())
-	case "cosmos.group.v1.Proposal.final_tally_result":
-		x.FinalTallyResult = value.Message().Interface().(*TallyResult)
-	case "cosmos.group.v1.Proposal.voting_period_end":
-		x.VotingPeriodEnd = value.Message().Interface().(*timestamppb.Timestamp)
-	case "cosmos.group.v1.Proposal.executor_result":
-		x.ExecutorResult = (ProposalExecutorResult)(value.Enum())
-	case "cosmos.group.v1.Proposal.messages":
-		lv := value.List()
-		clv := lv.(*_Proposal_12_list)
-		x.Messages = *clv.list
-	case "cosmos.group.v1.Proposal.title":
-		x.Title = value.Interface().(string)
-	case "cosmos.group.v1.Proposal.summary":
-		x.Summary = value.Interface().(string)
+	case "cosmos.group.v1.GroupPolicyInfo.version":
+		x.Version = value.Uint()
+	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
+		x.DecisionPolicy = value.Message().Interface().(*anypb.Any)
+	case "cosmos.group.v1.GroupPolicyInfo.created_at":
+		x.CreatedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.group.v1.GroupPolicyInfo.execution_settings":
+		x.ExecutionSettings = value.Message().Interface().(*ExecutionSettings)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5217,114 +4817,80 @@ func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value pro
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GroupPolicyInfo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.Proposal.proposers":
-		if x.Proposers == nil {
-			x.Proposers = []string{}
-		}
-		value := &_Proposal_4_list{list: &x.Proposers}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.Proposal.submit_time":
-		if x.SubmitTime == nil {
-			x.SubmitTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
-	case "cosmos.group.v1.Proposal.final_tally_result":
-		if x.FinalTallyResult == nil {
-			x.FinalTallyResult = new(TallyResult)
+	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
+		if x.DecisionPolicy == nil {
+			x.DecisionPolicy = new(anypb.Any)
 		}
-		return protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
-	case "cosmos.group.v1.Proposal.voting_period_end":
-		if x.VotingPeriodEnd == nil {
-			x.VotingPeriodEnd = new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(x.DecisionPolicy.ProtoReflect())
+	case "cosmos.group.v1.GroupPolicyInfo.created_at":
+		if x.CreatedAt == nil {
+			x.CreatedAt = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.VotingPeriodEnd.ProtoReflect())
-	case "cosmos.group.v1.Proposal.messages":
-		if x.Messages == nil {
-			x.Messages = []*anypb.Any{}
+		return protoreflect.ValueOfMessage(x.CreatedAt.ProtoReflect())
+	case "cosmos.group.v1.GroupPolicyInfo.execution_settings":
+		if x.ExecutionSettings == nil {
+			x.ExecutionSettings = new(ExecutionSettings)
 		}
-		value := &_Proposal_12_list{list: &x.Messages}
-		return protoreflect.ValueOfList(value)
-	case "cosmos.group.v1.Proposal.id":
-		panic(fmt.Errorf("field id of message cosmos.group.v1.Proposal is not mutable"))
-	case "cosmos.group.v1.Proposal.group_policy_address":
-		panic(fmt.Errorf("field group_policy_address of message cosmos.group.v1.Proposal is not mutable"))
-	case "cosmos.group.v1.Proposal.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.group.v1.Proposal is not mutable"))
-	case "cosmos.group.v1.Proposal.group_version":
-		panic(fmt.Errorf("field group_version of message cosmos.group.v1.Proposal is not mutable"))
-	case "cosmos.group.v1.Proposal.group_policy_version":
-		panic(fmt.Errorf("field group_policy_version of message cosmos.group.v1.Proposal is not mutable"))
-	case "cosmos.group.v1.Proposal.status":
-		panic(fmt.Errorf("field status of message cosmos.group.v1.Proposal is not mutable"))
-	case "cosmos.group.v1.Proposal.executor_result":
-		panic(fmt.Errorf("field executor_result of message cosmos.group.v1.Proposal is not mutable"))
-	case "cosmos.group.v1.Proposal.title":
-		panic(fmt.Errorf("field title of message cosmos.group.v1.Proposal is not mutable"))
-	case "cosmos.group.v1.Proposal.summary":
-		panic(fmt.Errorf("field summary of message cosmos.group.v1.Proposal is not mutable"))
+		return protoreflect.ValueOfMessage(x.ExecutionSettings.ProtoReflect())
+	case "cosmos.group.v1.GroupPolicyInfo.address":
+		panic(fmt.Errorf("field address of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
+	case "cosmos.group.v1.GroupPolicyInfo.group_id":
+		panic(fmt.Errorf("field group_id of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
+	case "cosmos.group.v1.GroupPolicyInfo.admin":
+		panic(fmt.Errorf("field admin of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
+	case "cosmos.group.v1.GroupPolicyInfo.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
+	case "cosmos.group.v1.GroupPolicyInfo.version":
+		panic(fmt.Errorf("field version of message cosmos.group.v1.GroupPolicyInfo is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GroupPolicyInfo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.Proposal.id":
+	case "cosmos.group.v1.GroupPolicyInfo.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.GroupPolicyInfo.group_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.Proposal.group_policy_address":
+	case "cosmos.group.v1.GroupPolicyInfo.admin":
 		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.Proposal.metadata":
+	case "cosmos.group.v1.GroupPolicyInfo.metadata":
 		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.Proposal.proposers":
-		list := []string{}
-		return protoreflect.ValueOfList(&_Proposal_4_list{list: &list})
-	case "cosmos.group.v1.Proposal.submit_time":
-		m := new(timestamppb.Timestamp)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.group.v1.Proposal.group_version":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.Proposal.group_policy_version":
+	case "cosmos.group.v1.GroupPolicyInfo.version":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.Proposal.status":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.group.v1.Proposal.final_tally_result":
-		m := new(TallyResult)
+	case "cosmos.group.v1.GroupPolicyInfo.decision_policy":
+		m := new(anypb.Any)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.group.v1.Proposal.voting_period_end":
+	case "cosmos.group.v1.GroupPolicyInfo.created_at":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.group.v1.Proposal.executor_result":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.group.v1.Proposal.messages":
-		list := []*anypb.Any{}
-		return protoreflect.ValueOfList(&_Proposal_12_list{list: &list})
-	case "cosmos.group.v1.Proposal.title":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.Proposal.summary":
-		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.GroupPolicyInfo.execution_settings":
+		m := new(ExecutionSettings)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.GroupPolicyInfo"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.GroupPolicyInfo does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GroupPolicyInfo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.Proposal", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.GroupPolicyInfo", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5332,7 +4898,7 @@ func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) pro
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GroupPolicyInfo) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5343,7 +4909,7 @@ func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GroupPolicyInfo) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5355,7 +4921,7 @@ func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Proposal) IsValid() bool {
+func (x *fastReflection_GroupPolicyInfo) IsValid() bool {
 	return x != nil
 }
 
@@ -5365,9 +4931,9 @@ func (x *fastReflection_Proposal) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GroupPolicyInfo) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*GroupPolicyInfo)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5379,59 +4945,34 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Id != 0 {
-			n += 1 + runtime.Sov(uint64(x.Id))
-		}
-		l = len(x.GroupPolicyAddress)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Metadata)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Proposers) > 0 {
-			for _, s := range x.Proposers {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.SubmitTime != nil {
-			l = options.Size(x.SubmitTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.GroupVersion != 0 {
-			n += 1 + runtime.Sov(uint64(x.GroupVersion))
-		}
-		if x.GroupPolicyVersion != 0 {
-			n += 1 + runtime.Sov(uint64(x.GroupPolicyVersion))
-		}
-		if x.Status != 0 {
-			n += 1 + runtime.Sov(uint64(x.Status))
+		if x.GroupId != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupId))
 		}
-		if x.FinalTallyResult != nil {
-			l = options.Size(x.FinalTallyResult)
+		l = len(x.Admin)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.VotingPeriodEnd != nil {
-			l = options.Size(x.VotingPeriodEnd)
+		l = len(x.Metadata)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ExecutorResult != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExecutorResult))
+		if x.Version != 0 {
+			n += 1 + runtime.Sov(uint64(x.Version))
 		}
-		if len(x.Messages) > 0 {
-			for _, e := range x.Messages {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		if x.DecisionPolicy != nil {
+			l = options.Size(x.DecisionPolicy)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Title)
-		if l > 0 {
+		if x.CreatedAt != nil {
+			l = options.Size(x.CreatedAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Summary)
-		if l > 0 {
+		if x.ExecutionSettings != nil {
+			l = options.Size(x.ExecutionSettings)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5444,7 +4985,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*GroupPolicyInfo)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5463,43 +5004,8 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Summary) > 0 {
-			i -= len(x.Summary)
-			copy(dAtA[i:], x.Summary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
-			i--
-			dAtA[i] = 0x72
-		}
-		if len(x.Title) > 0 {
-			i -= len(x.Title)
-			copy(dAtA[i:], x.Title)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
-			i--
-			dAtA[i] = 0x6a
-		}
-		if len(x.Messages) > 0 {
-			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Messages[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x62
-			}
-		}
-		if x.ExecutorResult != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorResult))
-			i--
-			dAtA[i] = 0x58
-		}
-		if x.VotingPeriodEnd != nil {
-			encoded, err := options.Marshal(x.VotingPeriodEnd)
+		if x.ExecutionSettings != nil {
+			encoded, err := options.Marshal(x.ExecutionSettings)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5510,10 +5016,10 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x52
+			dAtA[i] = 0x42
 		}
-		if x.FinalTallyResult != nil {
-			encoded, err := options.Marshal(x.FinalTallyResult)
+		if x.CreatedAt != nil {
+			encoded, err := options.Marshal(x.CreatedAt)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5524,25 +5030,10 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x4a
-		}
-		if x.Status != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
-			i--
-			dAtA[i] = 0x40
-		}
-		if x.GroupPolicyVersion != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupPolicyVersion))
-			i--
-			dAtA[i] = 0x38
-		}
-		if x.GroupVersion != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupVersion))
-			i--
-			dAtA[i] = 0x30
+			dAtA[i] = 0x3a
 		}
-		if x.SubmitTime != nil {
-			encoded, err := options.Marshal(x.SubmitTime)
+		if x.DecisionPolicy != nil {
+			encoded, err := options.Marshal(x.DecisionPolicy)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5553,35 +5044,38 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x32
 		}
-		if len(x.Proposers) > 0 {
-			for iNdEx := len(x.Proposers) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Proposers[iNdEx])
-				copy(dAtA[i:], x.Proposers[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposers[iNdEx])))
-				i--
-				dAtA[i] = 0x22
-			}
+		if x.Version != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Version))
+			i--
+			dAtA[i] = 0x28
 		}
 		if len(x.Metadata) > 0 {
 			i -= len(x.Metadata)
 			copy(dAtA[i:], x.Metadata)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
 			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Admin) > 0 {
+			i -= len(x.Admin)
+			copy(dAtA[i:], x.Admin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Admin)))
+			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.GroupPolicyAddress) > 0 {
-			i -= len(x.GroupPolicyAddress)
-			copy(dAtA[i:], x.GroupPolicyAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPolicyAddress)))
+		if x.GroupId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupId))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5594,7 +5088,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Proposal)
+		x := input.Message.Interface().(*GroupPolicyInfo)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5626,34 +5120,15 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupPolicyInfo: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GroupPolicyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-				}
-				x.Id = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Id |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5681,11 +5156,30 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+				}
+				x.GroupId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5713,11 +5207,11 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
+				x.Admin = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5745,11 +5239,30 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Proposers = append(x.Proposers, string(dAtA[iNdEx:postIndex]))
+				x.Metadata = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				}
+				x.Version = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Version |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecisionPolicy", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5776,18 +5289,18 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.SubmitTime == nil {
-					x.SubmitTime = &timestamppb.Timestamp{}
+				if x.DecisionPolicy == nil {
+					x.DecisionPolicy = &anypb.Any{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitTime); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecisionPolicy); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 				}
-				x.GroupVersion = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5797,52 +5310,31 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.GroupVersion |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 7:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyVersion", wireType)
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.GroupPolicyVersion = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.GroupPolicyVersion |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 8:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Status = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Status |= ProposalStatus(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if x.CreatedAt == nil {
+					x.CreatedAt = &timestamppb.Timestamp{}
 				}
-			case 9:
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreatedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionSettings", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5869,54 +5361,3918 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.FinalTallyResult == nil {
-					x.FinalTallyResult = &TallyResult{}
+				if x.ExecutionSettings == nil {
+					x.ExecutionSettings = &ExecutionSettings{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalTallyResult); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionSettings); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 10:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodEnd", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if msglen < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ExecutionSettings                        protoreflect.MessageDescriptor
+	fd_ExecutionSettings_timelock               protoreflect.FieldDescriptor
+	fd_ExecutionSettings_veto_address           protoreflect.FieldDescriptor
+	fd_ExecutionSettings_max_execution_attempts protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_types_proto_init()
+	md_ExecutionSettings = File_cosmos_group_v1_types_proto.Messages().ByName("ExecutionSettings")
+	fd_ExecutionSettings_timelock = md_ExecutionSettings.Fields().ByName("timelock")
+	fd_ExecutionSettings_veto_address = md_ExecutionSettings.Fields().ByName("veto_address")
+	fd_ExecutionSettings_max_execution_attempts = md_ExecutionSettings.Fields().ByName("max_execution_attempts")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExecutionSettings)(nil)
+
+type fastReflection_ExecutionSettings ExecutionSettings
+
+func (x *ExecutionSettings) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExecutionSettings)(x)
+}
+
+func (x *ExecutionSettings) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExecutionSettings_messageType fastReflection_ExecutionSettings_messageType
+var _ protoreflect.MessageType = fastReflection_ExecutionSettings_messageType{}
+
+type fastReflection_ExecutionSettings_messageType struct{}
+
+func (x fastReflection_ExecutionSettings_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExecutionSettings)(nil)
+}
+func (x fastReflection_ExecutionSettings_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExecutionSettings)
+}
+func (x fastReflection_ExecutionSettings_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionSettings
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExecutionSettings) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionSettings
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExecutionSettings) Type() protoreflect.MessageType {
+	return _fastReflection_ExecutionSettings_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExecutionSettings) New() protoreflect.Message {
+	return new(fastReflection_ExecutionSettings)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExecutionSettings) Interface() protoreflect.ProtoMessage {
+	return (*ExecutionSettings)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExecutionSettings) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Timelock != nil {
+		value := protoreflect.ValueOfMessage(x.Timelock.ProtoReflect())
+		if !f(fd_ExecutionSettings_timelock, value) {
+			return
+		}
+	}
+	if x.VetoAddress != "" {
+		value := protoreflect.ValueOfString(x.VetoAddress)
+		if !f(fd_ExecutionSettings_veto_address, value) {
+			return
+		}
+	}
+	if x.MaxExecutionAttempts != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxExecutionAttempts)
+		if !f(fd_ExecutionSettings_max_execution_attempts, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExecutionSettings) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.ExecutionSettings.timelock":
+		return x.Timelock != nil
+	case "cosmos.group.v1.ExecutionSettings.veto_address":
+		return x.VetoAddress != ""
+	case "cosmos.group.v1.ExecutionSettings.max_execution_attempts":
+		return x.MaxExecutionAttempts != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionSettings"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionSettings does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionSettings) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.ExecutionSettings.timelock":
+		x.Timelock = nil
+	case "cosmos.group.v1.ExecutionSettings.veto_address":
+		x.VetoAddress = ""
+	case "cosmos.group.v1.ExecutionSettings.max_execution_attempts":
+		x.MaxExecutionAttempts = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionSettings"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionSettings does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExecutionSettings) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.ExecutionSettings.timelock":
+		value := x.Timelock
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.v1.ExecutionSettings.veto_address":
+		value := x.VetoAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.ExecutionSettings.max_execution_attempts":
+		value := x.MaxExecutionAttempts
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionSettings"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionSettings does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionSettings) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.ExecutionSettings.timelock":
+		x.Timelock = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.group.v1.ExecutionSettings.veto_address":
+		x.VetoAddress = value.Interface().(string)
+	case "cosmos.group.v1.ExecutionSettings.max_execution_attempts":
+		x.MaxExecutionAttempts = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionSettings"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionSettings does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionSettings) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.ExecutionSettings.timelock":
+		if x.Timelock == nil {
+			x.Timelock = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.Timelock.ProtoReflect())
+	case "cosmos.group.v1.ExecutionSettings.veto_address":
+		panic(fmt.Errorf("field veto_address of message cosmos.group.v1.ExecutionSettings is not mutable"))
+	case "cosmos.group.v1.ExecutionSettings.max_execution_attempts":
+		panic(fmt.Errorf("field max_execution_attempts of message cosmos.group.v1.ExecutionSettings is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionSettings"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionSettings does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExecutionSettings) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.ExecutionSettings.timelock":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.group.v1.ExecutionSettings.veto_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.ExecutionSettings.max_execution_attempts":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionSettings"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionSettings does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExecutionSettings) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.ExecutionSettings", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExecutionSettings) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionSettings) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExecutionSettings) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExecutionSettings) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExecutionSettings)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Timelock != nil {
+			l = options.Size(x.Timelock)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.VetoAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxExecutionAttempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxExecutionAttempts))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionSettings)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MaxExecutionAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxExecutionAttempts))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.VetoAddress) > 0 {
+			i -= len(x.VetoAddress)
+			copy(dAtA[i:], x.VetoAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.VetoAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Timelock != nil {
+			encoded, err := options.Marshal(x.Timelock)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionSettings)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionSettings: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Timelock == nil {
+					x.Timelock = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timelock); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VetoAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VetoAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionAttempts", wireType)
+				}
+				x.MaxExecutionAttempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxExecutionAttempts |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_Proposal_4_list)(nil)
+
+type _Proposal_4_list struct {
+	list *[]string
+}
+
+func (x *_Proposal_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Proposal_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Proposal at list field Proposers as it is not of Message kind"))
+}
+
+func (x *_Proposal_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Proposal_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Proposal_12_list)(nil)
+
+type _Proposal_12_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_Proposal_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Proposal_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Proposal_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Proposal_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Proposal_12_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Proposal_12_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Proposal_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Proposal                      protoreflect.MessageDescriptor
+	fd_Proposal_id                   protoreflect.FieldDescriptor
+	fd_Proposal_group_policy_address protoreflect.FieldDescriptor
+	fd_Proposal_metadata             protoreflect.FieldDescriptor
+	fd_Proposal_proposers            protoreflect.FieldDescriptor
+	fd_Proposal_submit_time          protoreflect.FieldDescriptor
+	fd_Proposal_group_version        protoreflect.FieldDescriptor
+	fd_Proposal_group_policy_version protoreflect.FieldDescriptor
+	fd_Proposal_status               protoreflect.FieldDescriptor
+	fd_Proposal_final_tally_result   protoreflect.FieldDescriptor
+	fd_Proposal_voting_period_end    protoreflect.FieldDescriptor
+	fd_Proposal_executor_result      protoreflect.FieldDescriptor
+	fd_Proposal_messages             protoreflect.FieldDescriptor
+	fd_Proposal_title                protoreflect.FieldDescriptor
+	fd_Proposal_summary              protoreflect.FieldDescriptor
+	fd_Proposal_timelock_end         protoreflect.FieldDescriptor
+	fd_Proposal_execution_attempts   protoreflect.FieldDescriptor
+	fd_Proposal_execution_settings   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_types_proto_init()
+	md_Proposal = File_cosmos_group_v1_types_proto.Messages().ByName("Proposal")
+	fd_Proposal_id = md_Proposal.Fields().ByName("id")
+	fd_Proposal_group_policy_address = md_Proposal.Fields().ByName("group_policy_address")
+	fd_Proposal_metadata = md_Proposal.Fields().ByName("metadata")
+	fd_Proposal_proposers = md_Proposal.Fields().ByName("proposers")
+	fd_Proposal_submit_time = md_Proposal.Fields().ByName("submit_time")
+	fd_Proposal_group_version = md_Proposal.Fields().ByName("group_version")
+	fd_Proposal_group_policy_version = md_Proposal.Fields().ByName("group_policy_version")
+	fd_Proposal_status = md_Proposal.Fields().ByName("status")
+	fd_Proposal_final_tally_result = md_Proposal.Fields().ByName("final_tally_result")
+	fd_Proposal_voting_period_end = md_Proposal.Fields().ByName("voting_period_end")
+	fd_Proposal_executor_result = md_Proposal.Fields().ByName("executor_result")
+	fd_Proposal_messages = md_Proposal.Fields().ByName("messages")
+	fd_Proposal_title = md_Proposal.Fields().ByName("title")
+	fd_Proposal_summary = md_Proposal.Fields().ByName("summary")
+	fd_Proposal_timelock_end = md_Proposal.Fields().ByName("timelock_end")
+	fd_Proposal_execution_attempts = md_Proposal.Fields().ByName("execution_attempts")
+	fd_Proposal_execution_settings = md_Proposal.Fields().ByName("execution_settings")
+}
+
+var _ protoreflect.Message = (*fastReflection_Proposal)(nil)
+
+type fastReflection_Proposal Proposal
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Proposal)(x)
+}
+
+func (x *Proposal) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Proposal_messageType fastReflection_Proposal_messageType
+var _ protoreflect.MessageType = fastReflection_Proposal_messageType{}
+
+type fastReflection_Proposal_messageType struct{}
+
+func (x fastReflection_Proposal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Proposal)(nil)
+}
+func (x fastReflection_Proposal_messageType) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
+}
+func (x fastReflection_Proposal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Proposal) Descriptor() protoreflect.MessageDescriptor {
+	return md_Proposal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Proposal) Type() protoreflect.MessageType {
+	return _fastReflection_Proposal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Proposal) New() protoreflect.Message {
+	return new(fastReflection_Proposal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Proposal) Interface() protoreflect.ProtoMessage {
+	return (*Proposal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Proposal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Proposal_id, value) {
+			return
+		}
+	}
+	if x.GroupPolicyAddress != "" {
+		value := protoreflect.ValueOfString(x.GroupPolicyAddress)
+		if !f(fd_Proposal_group_policy_address, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_Proposal_metadata, value) {
+			return
+		}
+	}
+	if len(x.Proposers) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_4_list{list: &x.Proposers})
+		if !f(fd_Proposal_proposers, value) {
+			return
+		}
+	}
+	if x.SubmitTime != nil {
+		value := protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
+		if !f(fd_Proposal_submit_time, value) {
+			return
+		}
+	}
+	if x.GroupVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupVersion)
+		if !f(fd_Proposal_group_version, value) {
+			return
+		}
+	}
+	if x.GroupPolicyVersion != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GroupPolicyVersion)
+		if !f(fd_Proposal_group_policy_version, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Proposal_status, value) {
+			return
+		}
+	}
+	if x.FinalTallyResult != nil {
+		value := protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
+		if !f(fd_Proposal_final_tally_result, value) {
+			return
+		}
+	}
+	if x.VotingPeriodEnd != nil {
+		value := protoreflect.ValueOfMessage(x.VotingPeriodEnd.ProtoReflect())
+		if !f(fd_Proposal_voting_period_end, value) {
+			return
+		}
+	}
+	if x.ExecutorResult != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ExecutorResult))
+		if !f(fd_Proposal_executor_result, value) {
+			return
+		}
+	}
+	if len(x.Messages) != 0 {
+		value := protoreflect.ValueOfList(&_Proposal_12_list{list: &x.Messages})
+		if !f(fd_Proposal_messages, value) {
+			return
+		}
+	}
+	if x.Title != "" {
+		value := protoreflect.ValueOfString(x.Title)
+		if !f(fd_Proposal_title, value) {
+			return
+		}
+	}
+	if x.Summary != "" {
+		value := protoreflect.ValueOfString(x.Summary)
+		if !f(fd_Proposal_summary, value) {
+			return
+		}
+	}
+	if x.TimelockEnd != nil {
+		value := protoreflect.ValueOfMessage(x.TimelockEnd.ProtoReflect())
+		if !f(fd_Proposal_timelock_end, value) {
+			return
+		}
+	}
+	if x.ExecutionAttempts != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ExecutionAttempts)
+		if !f(fd_Proposal_execution_attempts, value) {
+			return
+		}
+	}
+	if x.ExecutionSettings != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutionSettings.ProtoReflect())
+		if !f(fd_Proposal_execution_settings, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Proposal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Proposal.id":
+		return x.Id != uint64(0)
+	case "cosmos.group.v1.Proposal.group_policy_address":
+		return x.GroupPolicyAddress != ""
+	case "cosmos.group.v1.Proposal.metadata":
+		return x.Metadata != ""
+	case "cosmos.group.v1.Proposal.proposers":
+		return len(x.Proposers) != 0
+	case "cosmos.group.v1.Proposal.submit_time":
+		return x.SubmitTime != nil
+	case "cosmos.group.v1.Proposal.group_version":
+		return x.GroupVersion != uint64(0)
+	case "cosmos.group.v1.Proposal.group_policy_version":
+		return x.GroupPolicyVersion != uint64(0)
+	case "cosmos.group.v1.Proposal.status":
+		return x.Status != 0
+	case "cosmos.group.v1.Proposal.final_tally_result":
+		return x.FinalTallyResult != nil
+	case "cosmos.group.v1.Proposal.voting_period_end":
+		return x.VotingPeriodEnd != nil
+	case "cosmos.group.v1.Proposal.executor_result":
+		return x.ExecutorResult != 0
+	case "cosmos.group.v1.Proposal.messages":
+		return len(x.Messages) != 0
+	case "cosmos.group.v1.Proposal.title":
+		return x.Title != ""
+	case "cosmos.group.v1.Proposal.summary":
+		return x.Summary != ""
+	case "cosmos.group.v1.Proposal.timelock_end":
+		return x.TimelockEnd != nil
+	case "cosmos.group.v1.Proposal.execution_attempts":
+		return x.ExecutionAttempts != uint32(0)
+	case "cosmos.group.v1.Proposal.execution_settings":
+		return x.ExecutionSettings != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Proposal.id":
+		x.Id = uint64(0)
+	case "cosmos.group.v1.Proposal.group_policy_address":
+		x.GroupPolicyAddress = ""
+	case "cosmos.group.v1.Proposal.metadata":
+		x.Metadata = ""
+	case "cosmos.group.v1.Proposal.proposers":
+		x.Proposers = nil
+	case "cosmos.group.v1.Proposal.submit_time":
+		x.SubmitTime = nil
+	case "cosmos.group.v1.Proposal.group_version":
+		x.GroupVersion = uint64(0)
+	case "cosmos.group.v1.Proposal.group_policy_version":
+		x.GroupPolicyVersion = uint64(0)
+	case "cosmos.group.v1.Proposal.status":
+		x.Status = 0
+	case "cosmos.group.v1.Proposal.final_tally_result":
+		x.FinalTallyResult = nil
+	case "cosmos.group.v1.Proposal.voting_period_end":
+		x.VotingPeriodEnd = nil
+	case "cosmos.group.v1.Proposal.executor_result":
+		x.ExecutorResult = 0
+	case "cosmos.group.v1.Proposal.messages":
+		x.Messages = nil
+	case "cosmos.group.v1.Proposal.title":
+		x.Title = ""
+	case "cosmos.group.v1.Proposal.summary":
+		x.Summary = ""
+	case "cosmos.group.v1.Proposal.timelock_end":
+		x.TimelockEnd = nil
+	case "cosmos.group.v1.Proposal.execution_attempts":
+		x.ExecutionAttempts = uint32(0)
+	case "cosmos.group.v1.Proposal.execution_settings":
+		x.ExecutionSettings = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Proposal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.Proposal.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.Proposal.group_policy_address":
+		value := x.GroupPolicyAddress
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.Proposal.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.Proposal.proposers":
+		if len(x.Proposers) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_4_list{})
+		}
+		listValue := &_Proposal_4_list{list: &x.Proposers}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.Proposal.submit_time":
+		value := x.SubmitTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.v1.Proposal.group_version":
+		value := x.GroupVersion
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.Proposal.group_policy_version":
+		value := x.GroupPolicyVersion
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.Proposal.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.Proposal.final_tally_result":
+		value := x.FinalTallyResult
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.v1.Proposal.voting_period_end":
+		value := x.VotingPeriodEnd
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.v1.Proposal.executor_result":
+		value := x.ExecutorResult
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.Proposal.messages":
+		if len(x.Messages) == 0 {
+			return protoreflect.ValueOfList(&_Proposal_12_list{})
+		}
+		listValue := &_Proposal_12_list{list: &x.Messages}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.Proposal.title":
+		value := x.Title
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.Proposal.summary":
+		value := x.Summary
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.Proposal.timelock_end":
+		value := x.TimelockEnd
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.v1.Proposal.execution_attempts":
+		value := x.ExecutionAttempts
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.group.v1.Proposal.execution_settings":
+		value := x.ExecutionSettings
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Proposal.id":
+		x.Id = value.Uint()
+	case "cosmos.group.v1.Proposal.group_policy_address":
+		x.GroupPolicyAddress = value.Interface().(string)
+	case "cosmos.group.v1.Proposal.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.group.v1.Proposal.proposers":
+		lv := value.List()
+		clv := lv.(*_Proposal_4_list)
+		x.Proposers = *clv.list
+	case "cosmos.group.v1.Proposal.submit_time":
+		x.SubmitTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.group.v1.Proposal.group_version":
+		x.GroupVersion = value.Uint()
+	case "cosmos.group.v1.Proposal.group_policy_version":
+		x.GroupPolicyVersion = value.Uint()
+	case "cosmos.group.v1.Proposal.status":
+		x.Status = (ProposalStatus)(value.Enum())
+	case "cosmos.group.v1.Proposal.final_tally_result":
+		x.FinalTallyResult = value.Message().Interface().(*TallyResult)
+	case "cosmos.group.v1.Proposal.voting_period_end":
+		x.VotingPeriodEnd = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.group.v1.Proposal.executor_result":
+		x.ExecutorResult = (ProposalExecutorResult)(value.Enum())
+	case "cosmos.group.v1.Proposal.messages":
+		lv := value.List()
+		clv := lv.(*_Proposal_12_list)
+		x.Messages = *clv.list
+	case "cosmos.group.v1.Proposal.title":
+		x.Title = value.Interface().(string)
+	case "cosmos.group.v1.Proposal.summary":
+		x.Summary = value.Interface().(string)
+	case "cosmos.group.v1.Proposal.timelock_end":
+		x.TimelockEnd = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.group.v1.Proposal.execution_attempts":
+		x.ExecutionAttempts = uint32(value.Uint())
+	case "cosmos.group.v1.Proposal.execution_settings":
+		x.ExecutionSettings = value.Message().Interface().(*ExecutionSettings)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Proposal.proposers":
+		if x.Proposers == nil {
+			x.Proposers = []string{}
+		}
+		value := &_Proposal_4_list{list: &x.Proposers}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.Proposal.submit_time":
+		if x.SubmitTime == nil {
+			x.SubmitTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
+	case "cosmos.group.v1.Proposal.final_tally_result":
+		if x.FinalTallyResult == nil {
+			x.FinalTallyResult = new(TallyResult)
+		}
+		return protoreflect.ValueOfMessage(x.FinalTallyResult.ProtoReflect())
+	case "cosmos.group.v1.Proposal.voting_period_end":
+		if x.VotingPeriodEnd == nil {
+			x.VotingPeriodEnd = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.VotingPeriodEnd.ProtoReflect())
+	case "cosmos.group.v1.Proposal.messages":
+		if x.Messages == nil {
+			x.Messages = []*anypb.Any{}
+		}
+		value := &_Proposal_12_list{list: &x.Messages}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.Proposal.timelock_end":
+		if x.TimelockEnd == nil {
+			x.TimelockEnd = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.TimelockEnd.ProtoReflect())
+	case "cosmos.group.v1.Proposal.execution_settings":
+		if x.ExecutionSettings == nil {
+			x.ExecutionSettings = new(ExecutionSettings)
+		}
+		return protoreflect.ValueOfMessage(x.ExecutionSettings.ProtoReflect())
+	case "cosmos.group.v1.Proposal.id":
+		panic(fmt.Errorf("field id of message cosmos.group.v1.Proposal is not mutable"))
+	case "cosmos.group.v1.Proposal.group_policy_address":
+		panic(fmt.Errorf("field group_policy_address of message cosmos.group.v1.Proposal is not mutable"))
+	case "cosmos.group.v1.Proposal.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.group.v1.Proposal is not mutable"))
+	case "cosmos.group.v1.Proposal.group_version":
+		panic(fmt.Errorf("field group_version of message cosmos.group.v1.Proposal is not mutable"))
+	case "cosmos.group.v1.Proposal.group_policy_version":
+		panic(fmt.Errorf("field group_policy_version of message cosmos.group.v1.Proposal is not mutable"))
+	case "cosmos.group.v1.Proposal.status":
+		panic(fmt.Errorf("field status of message cosmos.group.v1.Proposal is not mutable"))
+	case "cosmos.group.v1.Proposal.executor_result":
+		panic(fmt.Errorf("field executor_result of message cosmos.group.v1.Proposal is not mutable"))
+	case "cosmos.group.v1.Proposal.title":
+		panic(fmt.Errorf("field title of message cosmos.group.v1.Proposal is not mutable"))
+	case "cosmos.group.v1.Proposal.summary":
+		panic(fmt.Errorf("field summary of message cosmos.group.v1.Proposal is not mutable"))
+	case "cosmos.group.v1.Proposal.execution_attempts":
+		panic(fmt.Errorf("field execution_attempts of message cosmos.group.v1.Proposal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Proposal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Proposal.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.Proposal.group_policy_address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.Proposal.metadata":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.Proposal.proposers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Proposal_4_list{list: &list})
+	case "cosmos.group.v1.Proposal.submit_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.group.v1.Proposal.group_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.Proposal.group_policy_version":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.Proposal.status":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.Proposal.final_tally_result":
+		m := new(TallyResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.group.v1.Proposal.voting_period_end":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.group.v1.Proposal.executor_result":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.Proposal.messages":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_Proposal_12_list{list: &list})
+	case "cosmos.group.v1.Proposal.title":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.Proposal.summary":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.Proposal.timelock_end":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.group.v1.Proposal.execution_attempts":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.group.v1.Proposal.execution_settings":
+		m := new(ExecutionSettings)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Proposal"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Proposal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Proposal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.Proposal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Proposal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Proposal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Proposal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.GroupPolicyAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Proposers) > 0 {
+			for _, s := range x.Proposers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.SubmitTime != nil {
+			l = options.Size(x.SubmitTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GroupVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupVersion))
+		}
+		if x.GroupPolicyVersion != 0 {
+			n += 1 + runtime.Sov(uint64(x.GroupPolicyVersion))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.FinalTallyResult != nil {
+			l = options.Size(x.FinalTallyResult)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.VotingPeriodEnd != nil {
+			l = options.Size(x.VotingPeriodEnd)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutorResult != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutorResult))
+		}
+		if len(x.Messages) > 0 {
+			for _, e := range x.Messages {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Title)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Summary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimelockEnd != nil {
+			l = options.Size(x.TimelockEnd)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutionAttempts != 0 {
+			n += 2 + runtime.Sov(uint64(x.ExecutionAttempts))
+		}
+		if x.ExecutionSettings != nil {
+			l = options.Size(x.ExecutionSettings)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.ExecutionSettings != nil {
+			encoded, err := options.Marshal(x.ExecutionSettings)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.ExecutionAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionAttempts))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.TimelockEnd != nil {
+			encoded, err := options.Marshal(x.TimelockEnd)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if len(x.Summary) > 0 {
+			i -= len(x.Summary)
+			copy(dAtA[i:], x.Summary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Summary)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.Title) > 0 {
+			i -= len(x.Title)
+			copy(dAtA[i:], x.Title)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Title)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.Messages) > 0 {
+			for iNdEx := len(x.Messages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Messages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.ExecutorResult != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorResult))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.VotingPeriodEnd != nil {
+			encoded, err := options.Marshal(x.VotingPeriodEnd)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.FinalTallyResult != nil {
+			encoded, err := options.Marshal(x.FinalTallyResult)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.GroupPolicyVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupPolicyVersion))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.GroupVersion != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GroupVersion))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.SubmitTime != nil {
+			encoded, err := options.Marshal(x.SubmitTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Proposers) > 0 {
+			for iNdEx := len(x.Proposers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Proposers[iNdEx])
+				copy(dAtA[i:], x.Proposers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Proposers[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.GroupPolicyAddress) > 0 {
+			i -= len(x.GroupPolicyAddress)
+			copy(dAtA[i:], x.GroupPolicyAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPolicyAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Proposal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Proposers = append(x.Proposers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SubmitTime == nil {
+					x.SubmitTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupVersion", wireType)
+				}
+				x.GroupVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyVersion", wireType)
+				}
+				x.GroupPolicyVersion = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GroupPolicyVersion |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ProposalStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FinalTallyResult", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FinalTallyResult == nil {
+					x.FinalTallyResult = &TallyResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FinalTallyResult); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPeriodEnd", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.VotingPeriodEnd == nil {
+					x.VotingPeriodEnd = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriodEnd); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
+				}
+				x.ExecutorResult = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutorResult |= ProposalExecutorResult(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Messages = append(x.Messages, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Title = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Summary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimelockEnd", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TimelockEnd == nil {
+					x.TimelockEnd = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TimelockEnd); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionAttempts", wireType)
+				}
+				x.ExecutionAttempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionAttempts |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionSettings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecutionSettings == nil {
+					x.ExecutionSettings = &ExecutionSettings{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionSettings); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TallyResult                    protoreflect.MessageDescriptor
+	fd_TallyResult_yes_count          protoreflect.FieldDescriptor
+	fd_TallyResult_abstain_count      protoreflect.FieldDescriptor
+	fd_TallyResult_no_count           protoreflect.FieldDescriptor
+	fd_TallyResult_no_with_veto_count protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_types_proto_init()
+	md_TallyResult = File_cosmos_group_v1_types_proto.Messages().ByName("TallyResult")
+	fd_TallyResult_yes_count = md_TallyResult.Fields().ByName("yes_count")
+	fd_TallyResult_abstain_count = md_TallyResult.Fields().ByName("abstain_count")
+	fd_TallyResult_no_count = md_TallyResult.Fields().ByName("no_count")
+	fd_TallyResult_no_with_veto_count = md_TallyResult.Fields().ByName("no_with_veto_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
+
+type fastReflection_TallyResult TallyResult
+
+func (x *TallyResult) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TallyResult)(x)
+}
+
+func (x *TallyResult) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
+var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
+
+type fastReflection_TallyResult_messageType struct{}
+
+func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TallyResult)(nil)
+}
+func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
+}
+func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
+	return md_TallyResult
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
+	return _fastReflection_TallyResult_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TallyResult) New() protoreflect.Message {
+	return new(fastReflection_TallyResult)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
+	return (*TallyResult)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.YesCount != "" {
+		value := protoreflect.ValueOfString(x.YesCount)
+		if !f(fd_TallyResult_yes_count, value) {
+			return
+		}
+	}
+	if x.AbstainCount != "" {
+		value := protoreflect.ValueOfString(x.AbstainCount)
+		if !f(fd_TallyResult_abstain_count, value) {
+			return
+		}
+	}
+	if x.NoCount != "" {
+		value := protoreflect.ValueOfString(x.NoCount)
+		if !f(fd_TallyResult_no_count, value) {
+			return
+		}
+	}
+	if x.NoWithVetoCount != "" {
+		value := protoreflect.ValueOfString(x.NoWithVetoCount)
+		if !f(fd_TallyResult_no_with_veto_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.TallyResult.yes_count":
+		return x.YesCount != ""
+	case "cosmos.group.v1.TallyResult.abstain_count":
+		return x.AbstainCount != ""
+	case "cosmos.group.v1.TallyResult.no_count":
+		return x.NoCount != ""
+	case "cosmos.group.v1.TallyResult.no_with_veto_count":
+		return x.NoWithVetoCount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.TallyResult.yes_count":
+		x.YesCount = ""
+	case "cosmos.group.v1.TallyResult.abstain_count":
+		x.AbstainCount = ""
+	case "cosmos.group.v1.TallyResult.no_count":
+		x.NoCount = ""
+	case "cosmos.group.v1.TallyResult.no_with_veto_count":
+		x.NoWithVetoCount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.TallyResult.yes_count":
+		value := x.YesCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.TallyResult.abstain_count":
+		value := x.AbstainCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.TallyResult.no_count":
+		value := x.NoCount
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.TallyResult.no_with_veto_count":
+		value := x.NoWithVetoCount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.TallyResult.yes_count":
+		x.YesCount = value.Interface().(string)
+	case "cosmos.group.v1.TallyResult.abstain_count":
+		x.AbstainCount = value.Interface().(string)
+	case "cosmos.group.v1.TallyResult.no_count":
+		x.NoCount = value.Interface().(string)
+	case "cosmos.group.v1.TallyResult.no_with_veto_count":
+		x.NoWithVetoCount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.TallyResult.yes_count":
+		panic(fmt.Errorf("field yes_count of message cosmos.group.v1.TallyResult is not mutable"))
+	case "cosmos.group.v1.TallyResult.abstain_count":
+		panic(fmt.Errorf("field abstain_count of message cosmos.group.v1.TallyResult is not mutable"))
+	case "cosmos.group.v1.TallyResult.no_count":
+		panic(fmt.Errorf("field no_count of message cosmos.group.v1.TallyResult is not mutable"))
+	case "cosmos.group.v1.TallyResult.no_with_veto_count":
+		panic(fmt.Errorf("field no_with_veto_count of message cosmos.group.v1.TallyResult is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.TallyResult.yes_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.TallyResult.abstain_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.TallyResult.no_count":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.TallyResult.no_with_veto_count":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.TallyResult", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TallyResult) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TallyResult)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.YesCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AbstainCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NoCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NoWithVetoCount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TallyResult)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NoWithVetoCount) > 0 {
+			i -= len(x.NoWithVetoCount)
+			copy(dAtA[i:], x.NoWithVetoCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoWithVetoCount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.NoCount) > 0 {
+			i -= len(x.NoCount)
+			copy(dAtA[i:], x.NoCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoCount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.AbstainCount) > 0 {
+			i -= len(x.AbstainCount)
+			copy(dAtA[i:], x.AbstainCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AbstainCount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.YesCount) > 0 {
+			i -= len(x.YesCount)
+			copy(dAtA[i:], x.YesCount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YesCount)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TallyResult)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.YesCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AbstainCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NoCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Vote             protoreflect.MessageDescriptor
+	fd_Vote_proposal_id protoreflect.FieldDescriptor
+	fd_Vote_voter       protoreflect.FieldDescriptor
+	fd_Vote_option      protoreflect.FieldDescriptor
+	fd_Vote_metadata    protoreflect.FieldDescriptor
+	fd_Vote_submit_time protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_types_proto_init()
+	md_Vote = File_cosmos_group_v1_types_proto.Messages().ByName("Vote")
+	fd_Vote_proposal_id = md_Vote.Fields().ByName("proposal_id")
+	fd_Vote_voter = md_Vote.Fields().ByName("voter")
+	fd_Vote_option = md_Vote.Fields().ByName("option")
+	fd_Vote_metadata = md_Vote.Fields().ByName("metadata")
+	fd_Vote_submit_time = md_Vote.Fields().ByName("submit_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_Vote)(nil)
+
+type fastReflection_Vote Vote
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Vote)(x)
+}
+
+func (x *Vote) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Vote_messageType fastReflection_Vote_messageType
+var _ protoreflect.MessageType = fastReflection_Vote_messageType{}
+
+type fastReflection_Vote_messageType struct{}
+
+func (x fastReflection_Vote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Vote)(nil)
+}
+func (x fastReflection_Vote_messageType) New() protoreflect.Message {
+	return new(fastReflection_Vote)
+}
+func (x fastReflection_Vote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vote
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Vote) Descriptor() protoreflect.MessageDescriptor {
+	return md_Vote
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Vote) Type() protoreflect.MessageType {
+	return _fastReflection_Vote_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Vote) New() protoreflect.Message {
+	return new(fastReflection_Vote)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
+	return (*Vote)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_Vote_proposal_id, value) {
+			return
+		}
+	}
+	if x.Voter != "" {
+		value := protoreflect.ValueOfString(x.Voter)
+		if !f(fd_Vote_voter, value) {
+			return
+		}
+	}
+	if x.Option != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Option))
+		if !f(fd_Vote_option, value) {
+			return
+		}
+	}
+	if x.Metadata != "" {
+		value := protoreflect.ValueOfString(x.Metadata)
+		if !f(fd_Vote_metadata, value) {
+			return
+		}
+	}
+	if x.SubmitTime != nil {
+		value := protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
+		if !f(fd_Vote_submit_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Vote.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.Vote.voter":
+		return x.Voter != ""
+	case "cosmos.group.v1.Vote.option":
+		return x.Option != 0
+	case "cosmos.group.v1.Vote.metadata":
+		return x.Metadata != ""
+	case "cosmos.group.v1.Vote.submit_time":
+		return x.SubmitTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Vote.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.Vote.voter":
+		x.Voter = ""
+	case "cosmos.group.v1.Vote.option":
+		x.Option = 0
+	case "cosmos.group.v1.Vote.metadata":
+		x.Metadata = ""
+	case "cosmos.group.v1.Vote.submit_time":
+		x.SubmitTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.Vote.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.Vote.voter":
+		value := x.Voter
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.Vote.option":
+		value := x.Option
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.group.v1.Vote.metadata":
+		value := x.Metadata
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.Vote.submit_time":
+		value := x.SubmitTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Vote.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.Vote.voter":
+		x.Voter = value.Interface().(string)
+	case "cosmos.group.v1.Vote.option":
+		x.Option = (VoteOption)(value.Enum())
+	case "cosmos.group.v1.Vote.metadata":
+		x.Metadata = value.Interface().(string)
+	case "cosmos.group.v1.Vote.submit_time":
+		x.SubmitTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Vote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Vote.submit_time":
+		if x.SubmitTime == nil {
+			x.SubmitTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
+	case "cosmos.group.v1.Vote.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.Vote is not mutable"))
+	case "cosmos.group.v1.Vote.voter":
+		panic(fmt.Errorf("field voter of message cosmos.group.v1.Vote is not mutable"))
+	case "cosmos.group.v1.Vote.option":
+		panic(fmt.Errorf("field option of message cosmos.group.v1.Vote is not mutable"))
+	case "cosmos.group.v1.Vote.metadata":
+		panic(fmt.Errorf("field metadata of message cosmos.group.v1.Vote is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Vote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.Vote.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.Vote.voter":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.Vote.option":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.Vote.metadata":
+		return protoreflect.ValueOfString("")
+	case "cosmos.group.v1.Vote.submit_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.Vote", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Vote) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Vote)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		l = len(x.Voter)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Option != 0 {
+			n += 1 + runtime.Sov(uint64(x.Option))
+		}
+		l = len(x.Metadata)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SubmitTime != nil {
+			l = options.Size(x.SubmitTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Vote)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SubmitTime != nil {
+			encoded, err := options.Marshal(x.SubmitTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Metadata) > 0 {
+			i -= len(x.Metadata)
+			copy(dAtA[i:], x.Metadata)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Option != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Option))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Voter) > 0 {
+			i -= len(x.Voter)
+			copy(dAtA[i:], x.Voter)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Vote)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+				}
+				x.ProposalId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ProposalId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Voter = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				}
+				x.Option = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Option |= VoteOption(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Metadata = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SubmitTime == nil {
+					x.SubmitTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_VotingPowerSnapshot_2_list)(nil)
+
+type _VotingPowerSnapshot_2_list struct {
+	list *[]*MemberPower
+}
+
+func (x *_VotingPowerSnapshot_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_VotingPowerSnapshot_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_VotingPowerSnapshot_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MemberPower)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_VotingPowerSnapshot_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MemberPower)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_VotingPowerSnapshot_2_list) AppendMutable() protoreflect.Value {
+	v := new(MemberPower)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VotingPowerSnapshot_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_VotingPowerSnapshot_2_list) NewElement() protoreflect.Value {
+	v := new(MemberPower)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_VotingPowerSnapshot_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_VotingPowerSnapshot             protoreflect.MessageDescriptor
+	fd_VotingPowerSnapshot_proposal_id protoreflect.FieldDescriptor
+	fd_VotingPowerSnapshot_members     protoreflect.FieldDescriptor
+	fd_VotingPowerSnapshot_total_power protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_group_v1_types_proto_init()
+	md_VotingPowerSnapshot = File_cosmos_group_v1_types_proto.Messages().ByName("VotingPowerSnapshot")
+	fd_VotingPowerSnapshot_proposal_id = md_VotingPowerSnapshot.Fields().ByName("proposal_id")
+	fd_VotingPowerSnapshot_members = md_VotingPowerSnapshot.Fields().ByName("members")
+	fd_VotingPowerSnapshot_total_power = md_VotingPowerSnapshot.Fields().ByName("total_power")
+}
+
+var _ protoreflect.Message = (*fastReflection_VotingPowerSnapshot)(nil)
+
+type fastReflection_VotingPowerSnapshot VotingPowerSnapshot
+
+func (x *VotingPowerSnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VotingPowerSnapshot)(x)
+}
+
+func (x *VotingPowerSnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VotingPowerSnapshot_messageType fastReflection_VotingPowerSnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_VotingPowerSnapshot_messageType{}
+
+type fastReflection_VotingPowerSnapshot_messageType struct{}
+
+func (x fastReflection_VotingPowerSnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VotingPowerSnapshot)(nil)
+}
+func (x fastReflection_VotingPowerSnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_VotingPowerSnapshot)
+}
+func (x fastReflection_VotingPowerSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingPowerSnapshot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VotingPowerSnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingPowerSnapshot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VotingPowerSnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_VotingPowerSnapshot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VotingPowerSnapshot) New() protoreflect.Message {
+	return new(fastReflection_VotingPowerSnapshot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VotingPowerSnapshot) Interface() protoreflect.ProtoMessage {
+	return (*VotingPowerSnapshot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VotingPowerSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProposalId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ProposalId)
+		if !f(fd_VotingPowerSnapshot_proposal_id, value) {
+			return
+		}
+	}
+	if len(x.Members) != 0 {
+		value := protoreflect.ValueOfList(&_VotingPowerSnapshot_2_list{list: &x.Members})
+		if !f(fd_VotingPowerSnapshot_members, value) {
+			return
+		}
+	}
+	if x.TotalPower != "" {
+		value := protoreflect.ValueOfString(x.TotalPower)
+		if !f(fd_VotingPowerSnapshot_total_power, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VotingPowerSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.group.v1.VotingPowerSnapshot.proposal_id":
+		return x.ProposalId != uint64(0)
+	case "cosmos.group.v1.VotingPowerSnapshot.members":
+		return len(x.Members) != 0
+	case "cosmos.group.v1.VotingPowerSnapshot.total_power":
+		return x.TotalPower != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.VotingPowerSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.VotingPowerSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerSnapshot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.VotingPowerSnapshot.proposal_id":
+		x.ProposalId = uint64(0)
+	case "cosmos.group.v1.VotingPowerSnapshot.members":
+		x.Members = nil
+	case "cosmos.group.v1.VotingPowerSnapshot.total_power":
+		x.TotalPower = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.VotingPowerSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.VotingPowerSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VotingPowerSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.group.v1.VotingPowerSnapshot.proposal_id":
+		value := x.ProposalId
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.group.v1.VotingPowerSnapshot.members":
+		if len(x.Members) == 0 {
+			return protoreflect.ValueOfList(&_VotingPowerSnapshot_2_list{})
+		}
+		listValue := &_VotingPowerSnapshot_2_list{list: &x.Members}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.group.v1.VotingPowerSnapshot.total_power":
+		value := x.TotalPower
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.VotingPowerSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.VotingPowerSnapshot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.VotingPowerSnapshot.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.VotingPowerSnapshot.members":
+		lv := value.List()
+		clv := lv.(*_VotingPowerSnapshot_2_list)
+		x.Members = *clv.list
+	case "cosmos.group.v1.VotingPowerSnapshot.total_power":
+		x.TotalPower = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.VotingPowerSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.VotingPowerSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.VotingPowerSnapshot.members":
+		if x.Members == nil {
+			x.Members = []*MemberPower{}
+		}
+		value := &_VotingPowerSnapshot_2_list{list: &x.Members}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.group.v1.VotingPowerSnapshot.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.VotingPowerSnapshot is not mutable"))
+	case "cosmos.group.v1.VotingPowerSnapshot.total_power":
+		panic(fmt.Errorf("field total_power of message cosmos.group.v1.VotingPowerSnapshot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.VotingPowerSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.VotingPowerSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VotingPowerSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.group.v1.VotingPowerSnapshot.proposal_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.group.v1.VotingPowerSnapshot.members":
+		list := []*MemberPower{}
+		return protoreflect.ValueOfList(&_VotingPowerSnapshot_2_list{list: &list})
+	case "cosmos.group.v1.VotingPowerSnapshot.total_power":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.VotingPowerSnapshot"))
+		}
+		panic(fmt.Errorf("message cosmos.group.v1.VotingPowerSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VotingPowerSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.VotingPowerSnapshot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VotingPowerSnapshot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerSnapshot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VotingPowerSnapshot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VotingPowerSnapshot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VotingPowerSnapshot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ProposalId != 0 {
+			n += 1 + runtime.Sov(uint64(x.ProposalId))
+		}
+		if len(x.Members) > 0 {
+			for _, e := range x.Members {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.TotalPower)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VotingPowerSnapshot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalPower) > 0 {
+			i -= len(x.TotalPower)
+			copy(dAtA[i:], x.TotalPower)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TotalPower)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Members) > 0 {
+			for iNdEx := len(x.Members) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Members[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.ProposalId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VotingPowerSnapshot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.VotingPeriodEnd == nil {
-					x.VotingPeriodEnd = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPeriodEnd); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 11:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingPowerSnapshot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingPowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 				}
-				x.ExecutorResult = 0
+				x.ProposalId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5926,14 +9282,14 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExecutorResult |= ProposalExecutorResult(b&0x7F) << shift
+					x.ProposalId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 12:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5960,46 +9316,14 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Messages = append(x.Messages, &anypb.Any{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Messages[len(x.Messages)-1]); err != nil {
+				x.Members = append(x.Members, &MemberPower{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Members[len(x.Members)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 13:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Title = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 14:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6027,7 +9351,7 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Summary = string(dAtA[iNdEx:postIndex])
+				x.TotalPower = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6065,32 +9389,28 @@ func (x *fastReflection_Proposal) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TallyResult                    protoreflect.MessageDescriptor
-	fd_TallyResult_yes_count          protoreflect.FieldDescriptor
-	fd_TallyResult_abstain_count      protoreflect.FieldDescriptor
-	fd_TallyResult_no_count           protoreflect.FieldDescriptor
-	fd_TallyResult_no_with_veto_count protoreflect.FieldDescriptor
+	md_MemberPower         protoreflect.MessageDescriptor
+	fd_MemberPower_address protoreflect.FieldDescriptor
+	fd_MemberPower_power   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_types_proto_init()
-	md_TallyResult = File_cosmos_group_v1_types_proto.Messages().ByName("TallyResult")
-	fd_TallyResult_yes_count = md_TallyResult.Fields().ByName("yes_count")
-	fd_TallyResult_abstain_count = md_TallyResult.Fields().ByName("abstain_count")
-	fd_TallyResult_no_count = md_TallyResult.Fields().ByName("no_count")
-	fd_TallyResult_no_with_veto_count = md_TallyResult.Fields().ByName("no_with_veto_count")
+	md_MemberPower = File_cosmos_group_v1_types_proto.Messages().ByName("MemberPower")
+	fd_MemberPower_address = md_MemberPower.Fields().ByName("address")
+	fd_MemberPower_power = md_MemberPower.Fields().ByName("power")
 }
 
-var _ protoreflect.Message = (*fastReflection_TallyResult)(nil)
+var _ protoreflect.Message = (*fastReflection_MemberPower)(nil)
 
-type fastReflection_TallyResult TallyResult
+type fastReflection_MemberPower MemberPower
 
-func (x *TallyResult) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TallyResult)(x)
+func (x *MemberPower) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MemberPower)(x)
 }
 
-func (x *TallyResult) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_types_proto_msgTypes[9]
+func (x *MemberPower) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_types_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6101,43 +9421,43 @@ func (x *TallyResult) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TallyResult_messageType fastReflection_TallyResult_messageType
-var _ protoreflect.MessageType = fastReflection_TallyResult_messageType{}
+var _fastReflection_MemberPower_messageType fastReflection_MemberPower_messageType
+var _ protoreflect.MessageType = fastReflection_MemberPower_messageType{}
 
-type fastReflection_TallyResult_messageType struct{}
+type fastReflection_MemberPower_messageType struct{}
 
-func (x fastReflection_TallyResult_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TallyResult)(nil)
+func (x fastReflection_MemberPower_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MemberPower)(nil)
 }
-func (x fastReflection_TallyResult_messageType) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x fastReflection_MemberPower_messageType) New() protoreflect.Message {
+	return new(fastReflection_MemberPower)
 }
-func (x fastReflection_TallyResult_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x fastReflection_MemberPower_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MemberPower
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TallyResult) Descriptor() protoreflect.MessageDescriptor {
-	return md_TallyResult
+func (x *fastReflection_MemberPower) Descriptor() protoreflect.MessageDescriptor {
+	return md_MemberPower
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TallyResult) Type() protoreflect.MessageType {
-	return _fastReflection_TallyResult_messageType
+func (x *fastReflection_MemberPower) Type() protoreflect.MessageType {
+	return _fastReflection_MemberPower_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TallyResult) New() protoreflect.Message {
-	return new(fastReflection_TallyResult)
+func (x *fastReflection_MemberPower) New() protoreflect.Message {
+	return new(fastReflection_MemberPower)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
-	return (*TallyResult)(x)
+func (x *fastReflection_MemberPower) Interface() protoreflect.ProtoMessage {
+	return (*MemberPower)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6145,28 +9465,16 @@ func (x *fastReflection_TallyResult) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.YesCount != "" {
-		value := protoreflect.ValueOfString(x.YesCount)
-		if !f(fd_TallyResult_yes_count, value) {
-			return
-		}
-	}
-	if x.AbstainCount != "" {
-		value := protoreflect.ValueOfString(x.AbstainCount)
-		if !f(fd_TallyResult_abstain_count, value) {
-			return
-		}
-	}
-	if x.NoCount != "" {
-		value := protoreflect.ValueOfString(x.NoCount)
-		if !f(fd_TallyResult_no_count, value) {
+func (x *fastReflection_MemberPower) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_MemberPower_address, value) {
 			return
 		}
 	}
-	if x.NoWithVetoCount != "" {
-		value := protoreflect.ValueOfString(x.NoWithVetoCount)
-		if !f(fd_TallyResult_no_with_veto_count, value) {
+	if x.Power != "" {
+		value := protoreflect.ValueOfString(x.Power)
+		if !f(fd_MemberPower_power, value) {
 			return
 		}
 	}
@@ -6183,21 +9491,17 @@ func (x *fastReflection_TallyResult) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MemberPower) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.TallyResult.yes_count":
-		return x.YesCount != ""
-	case "cosmos.group.v1.TallyResult.abstain_count":
-		return x.AbstainCount != ""
-	case "cosmos.group.v1.TallyResult.no_count":
-		return x.NoCount != ""
-	case "cosmos.group.v1.TallyResult.no_with_veto_count":
-		return x.NoWithVetoCount != ""
+	case "cosmos.group.v1.MemberPower.address":
+		return x.Address != ""
+	case "cosmos.group.v1.MemberPower.power":
+		return x.Power != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MemberPower"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MemberPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6207,21 +9511,17 @@ func (x *fastReflection_TallyResult) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MemberPower) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.TallyResult.yes_count":
-		x.YesCount = ""
-	case "cosmos.group.v1.TallyResult.abstain_count":
-		x.AbstainCount = ""
-	case "cosmos.group.v1.TallyResult.no_count":
-		x.NoCount = ""
-	case "cosmos.group.v1.TallyResult.no_with_veto_count":
-		x.NoWithVetoCount = ""
+	case "cosmos.group.v1.MemberPower.address":
+		x.Address = ""
+	case "cosmos.group.v1.MemberPower.power":
+		x.Power = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MemberPower"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MemberPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6231,25 +9531,19 @@ func (x *fastReflection_TallyResult) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MemberPower) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.TallyResult.yes_count":
-		value := x.YesCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.TallyResult.abstain_count":
-		value := x.AbstainCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.TallyResult.no_count":
-		value := x.NoCount
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.TallyResult.no_with_veto_count":
-		value := x.NoWithVetoCount
+	case "cosmos.group.v1.MemberPower.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.group.v1.MemberPower.power":
+		value := x.Power
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MemberPower"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MemberPower does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6263,21 +9557,17 @@ func (x *fastReflection_TallyResult) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MemberPower) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.TallyResult.yes_count":
-		x.YesCount = value.Interface().(string)
-	case "cosmos.group.v1.TallyResult.abstain_count":
-		x.AbstainCount = value.Interface().(string)
-	case "cosmos.group.v1.TallyResult.no_count":
-		x.NoCount = value.Interface().(string)
-	case "cosmos.group.v1.TallyResult.no_with_veto_count":
-		x.NoWithVetoCount = value.Interface().(string)
+	case "cosmos.group.v1.MemberPower.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.group.v1.MemberPower.power":
+		x.Power = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MemberPower"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MemberPower does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6291,52 +9581,44 @@ func (x *fastReflection_TallyResult) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MemberPower) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.TallyResult.yes_count":
-		panic(fmt.Errorf("field yes_count of message cosmos.group.v1.TallyResult is not mutable"))
-	case "cosmos.group.v1.TallyResult.abstain_count":
-		panic(fmt.Errorf("field abstain_count of message cosmos.group.v1.TallyResult is not mutable"))
-	case "cosmos.group.v1.TallyResult.no_count":
-		panic(fmt.Errorf("field no_count of message cosmos.group.v1.TallyResult is not mutable"))
-	case "cosmos.group.v1.TallyResult.no_with_veto_count":
-		panic(fmt.Errorf("field no_with_veto_count of message cosmos.group.v1.TallyResult is not mutable"))
+	case "cosmos.group.v1.MemberPower.address":
+		panic(fmt.Errorf("field address of message cosmos.group.v1.MemberPower is not mutable"))
+	case "cosmos.group.v1.MemberPower.power":
+		panic(fmt.Errorf("field power of message cosmos.group.v1.MemberPower is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MemberPower"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MemberPower does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TallyResult) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MemberPower) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.TallyResult.yes_count":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.TallyResult.abstain_count":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.TallyResult.no_count":
+	case "cosmos.group.v1.MemberPower.address":
 		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.TallyResult.no_with_veto_count":
+	case "cosmos.group.v1.MemberPower.power":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.TallyResult"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.MemberPower"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.TallyResult does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.MemberPower does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MemberPower) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.TallyResult", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.MemberPower", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6344,7 +9626,7 @@ func (x *fastReflection_TallyResult) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MemberPower) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6355,7 +9637,7 @@ func (x *fastReflection_TallyResult) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MemberPower) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6367,7 +9649,7 @@ func (x *fastReflection_TallyResult) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TallyResult) IsValid() bool {
+func (x *fastReflection_MemberPower) IsValid() bool {
 	return x != nil
 }
 
@@ -6377,9 +9659,9 @@ func (x *fastReflection_TallyResult) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MemberPower) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*MemberPower)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6391,19 +9673,11 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.YesCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.AbstainCount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.NoCount)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.NoWithVetoCount)
+		l = len(x.Power)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6417,7 +9691,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*MemberPower)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6436,31 +9710,17 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NoWithVetoCount) > 0 {
-			i -= len(x.NoWithVetoCount)
-			copy(dAtA[i:], x.NoWithVetoCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoWithVetoCount)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.NoCount) > 0 {
-			i -= len(x.NoCount)
-			copy(dAtA[i:], x.NoCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NoCount)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.AbstainCount) > 0 {
-			i -= len(x.AbstainCount)
-			copy(dAtA[i:], x.AbstainCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AbstainCount)))
+		if len(x.Power) > 0 {
+			i -= len(x.Power)
+			copy(dAtA[i:], x.Power)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Power)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.YesCount) > 0 {
-			i -= len(x.YesCount)
-			copy(dAtA[i:], x.YesCount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.YesCount)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6475,7 +9735,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TallyResult)
+		x := input.Message.Interface().(*MemberPower)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6507,15 +9767,15 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MemberPower: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MemberPower: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6543,75 +9803,11 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.YesCount = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.AbstainCount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.NoCount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6639,7 +9835,7 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
+				x.Power = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6677,34 +9873,34 @@ func (x *fastReflection_TallyResult) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Vote             protoreflect.MessageDescriptor
-	fd_Vote_proposal_id protoreflect.FieldDescriptor
-	fd_Vote_voter       protoreflect.FieldDescriptor
-	fd_Vote_option      protoreflect.FieldDescriptor
-	fd_Vote_metadata    protoreflect.FieldDescriptor
-	fd_Vote_submit_time protoreflect.FieldDescriptor
+	md_ExecutionLog             protoreflect.MessageDescriptor
+	fd_ExecutionLog_proposal_id protoreflect.FieldDescriptor
+	fd_ExecutionLog_attempt     protoreflect.FieldDescriptor
+	fd_ExecutionLog_executed_at protoreflect.FieldDescriptor
+	fd_ExecutionLog_result      protoreflect.FieldDescriptor
+	fd_ExecutionLog_logs        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_group_v1_types_proto_init()
-	md_Vote = File_cosmos_group_v1_types_proto.Messages().ByName("Vote")
-	fd_Vote_proposal_id = md_Vote.Fields().ByName("proposal_id")
-	fd_Vote_voter = md_Vote.Fields().ByName("voter")
-	fd_Vote_option = md_Vote.Fields().ByName("option")
-	fd_Vote_metadata = md_Vote.Fields().ByName("metadata")
-	fd_Vote_submit_time = md_Vote.Fields().ByName("submit_time")
+	md_ExecutionLog = File_cosmos_group_v1_types_proto.Messages().ByName("ExecutionLog")
+	fd_ExecutionLog_proposal_id = md_ExecutionLog.Fields().ByName("proposal_id")
+	fd_ExecutionLog_attempt = md_ExecutionLog.Fields().ByName("attempt")
+	fd_ExecutionLog_executed_at = md_ExecutionLog.Fields().ByName("executed_at")
+	fd_ExecutionLog_result = md_ExecutionLog.Fields().ByName("result")
+	fd_ExecutionLog_logs = md_ExecutionLog.Fields().ByName("logs")
 }
 
-var _ protoreflect.Message = (*fastReflection_Vote)(nil)
+var _ protoreflect.Message = (*fastReflection_ExecutionLog)(nil)
 
-type fastReflection_Vote Vote
+type fastReflection_ExecutionLog ExecutionLog
 
-func (x *Vote) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Vote)(x)
+func (x *ExecutionLog) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExecutionLog)(x)
 }
 
-func (x *Vote) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_group_v1_types_proto_msgTypes[10]
+func (x *ExecutionLog) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_group_v1_types_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6715,43 +9911,43 @@ func (x *Vote) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Vote_messageType fastReflection_Vote_messageType
-var _ protoreflect.MessageType = fastReflection_Vote_messageType{}
+var _fastReflection_ExecutionLog_messageType fastReflection_ExecutionLog_messageType
+var _ protoreflect.MessageType = fastReflection_ExecutionLog_messageType{}
 
-type fastReflection_Vote_messageType struct{}
+type fastReflection_ExecutionLog_messageType struct{}
 
-func (x fastReflection_Vote_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Vote)(nil)
+func (x fastReflection_ExecutionLog_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExecutionLog)(nil)
 }
-func (x fastReflection_Vote_messageType) New() protoreflect.Message {
-	return new(fastReflection_Vote)
+func (x fastReflection_ExecutionLog_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExecutionLog)
 }
-func (x fastReflection_Vote_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vote
+func (x fastReflection_ExecutionLog_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionLog
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Vote) Descriptor() protoreflect.MessageDescriptor {
-	return md_Vote
+func (x *fastReflection_ExecutionLog) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionLog
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Vote) Type() protoreflect.MessageType {
-	return _fastReflection_Vote_messageType
+func (x *fastReflection_ExecutionLog) Type() protoreflect.MessageType {
+	return _fastReflection_ExecutionLog_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Vote) New() protoreflect.Message {
-	return new(fastReflection_Vote)
+func (x *fastReflection_ExecutionLog) New() protoreflect.Message {
+	return new(fastReflection_ExecutionLog)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
-	return (*Vote)(x)
+func (x *fastReflection_ExecutionLog) Interface() protoreflect.ProtoMessage {
+	return (*ExecutionLog)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6759,34 +9955,34 @@ func (x *fastReflection_Vote) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_ExecutionLog) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProposalId != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.ProposalId)
-		if !f(fd_Vote_proposal_id, value) {
+		if !f(fd_ExecutionLog_proposal_id, value) {
 			return
 		}
 	}
-	if x.Voter != "" {
-		value := protoreflect.ValueOfString(x.Voter)
-		if !f(fd_Vote_voter, value) {
+	if x.Attempt != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempt)
+		if !f(fd_ExecutionLog_attempt, value) {
 			return
 		}
 	}
-	if x.Option != 0 {
-		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Option))
-		if !f(fd_Vote_option, value) {
+	if x.ExecutedAt != nil {
+		value := protoreflect.ValueOfMessage(x.ExecutedAt.ProtoReflect())
+		if !f(fd_ExecutionLog_executed_at, value) {
 			return
 		}
 	}
-	if x.Metadata != "" {
-		value := protoreflect.ValueOfString(x.Metadata)
-		if !f(fd_Vote_metadata, value) {
+	if x.Result != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Result))
+		if !f(fd_ExecutionLog_result, value) {
 			return
 		}
 	}
-	if x.SubmitTime != nil {
-		value := protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
-		if !f(fd_Vote_submit_time, value) {
+	if x.Logs != "" {
+		value := protoreflect.ValueOfString(x.Logs)
+		if !f(fd_ExecutionLog_logs, value) {
 			return
 		}
 	}
@@ -6803,23 +9999,23 @@ func (x *fastReflection_Vote) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ExecutionLog) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.group.v1.Vote.proposal_id":
+	case "cosmos.group.v1.ExecutionLog.proposal_id":
 		return x.ProposalId != uint64(0)
-	case "cosmos.group.v1.Vote.voter":
-		return x.Voter != ""
-	case "cosmos.group.v1.Vote.option":
-		return x.Option != 0
-	case "cosmos.group.v1.Vote.metadata":
-		return x.Metadata != ""
-	case "cosmos.group.v1.Vote.submit_time":
-		return x.SubmitTime != nil
+	case "cosmos.group.v1.ExecutionLog.attempt":
+		return x.Attempt != uint32(0)
+	case "cosmos.group.v1.ExecutionLog.executed_at":
+		return x.ExecutedAt != nil
+	case "cosmos.group.v1.ExecutionLog.result":
+		return x.Result != 0
+	case "cosmos.group.v1.ExecutionLog.logs":
+		return x.Logs != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionLog"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionLog does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6829,23 +10025,23 @@ func (x *fastReflection_Vote) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ExecutionLog) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.group.v1.Vote.proposal_id":
+	case "cosmos.group.v1.ExecutionLog.proposal_id":
 		x.ProposalId = uint64(0)
-	case "cosmos.group.v1.Vote.voter":
-		x.Voter = ""
-	case "cosmos.group.v1.Vote.option":
-		x.Option = 0
-	case "cosmos.group.v1.Vote.metadata":
-		x.Metadata = ""
-	case "cosmos.group.v1.Vote.submit_time":
-		x.SubmitTime = nil
+	case "cosmos.group.v1.ExecutionLog.attempt":
+		x.Attempt = uint32(0)
+	case "cosmos.group.v1.ExecutionLog.executed_at":
+		x.ExecutedAt = nil
+	case "cosmos.group.v1.ExecutionLog.result":
+		x.Result = 0
+	case "cosmos.group.v1.ExecutionLog.logs":
+		x.Logs = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionLog"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionLog does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6855,28 +10051,28 @@ func (x *fastReflection_Vote) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExecutionLog) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.group.v1.Vote.proposal_id":
+	case "cosmos.group.v1.ExecutionLog.proposal_id":
 		value := x.ProposalId
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.group.v1.Vote.voter":
-		value := x.Voter
-		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.Vote.option":
-		value := x.Option
+	case "cosmos.group.v1.ExecutionLog.attempt":
+		value := x.Attempt
+		return protoreflect.ValueOfUint32(value)
+	case "cosmos.group.v1.ExecutionLog.executed_at":
+		value := x.ExecutedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.group.v1.ExecutionLog.result":
+		value := x.Result
 		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
-	case "cosmos.group.v1.Vote.metadata":
-		value := x.Metadata
+	case "cosmos.group.v1.ExecutionLog.logs":
+		value := x.Logs
 		return protoreflect.ValueOfString(value)
-	case "cosmos.group.v1.Vote.submit_time":
-		value := x.SubmitTime
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionLog"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionLog does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6887,26 +10083,26 @@ func (x *fastReflection_Vote) Get(descriptor protoreflect.FieldDescriptor) proto
 // For extension fields, it implicitly stores the provided ExtensionType.
 // When setting a composite type, it is unspecified whether the stored value
 // aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "cosmos.group.v1.Vote.proposal_id":
-		x.ProposalId = value.Uint()
-	case "cosmos.group.v1.Vote.voter":
-		x.Voter = value.Interface().(string)
-	case "cosmos.group.v1.Vote.option":
-		x.Option = (VoteOption)(value.Enum())
-	case "cosmos.group.v1.Vote.metadata":
-		x.Metadata = value.Interface().(string)
-	case "cosmos.group.v1.Vote.submit_time":
-		x.SubmitTime = value.Message().Interface().(*timestamppb.Timestamp)
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionLog) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.group.v1.ExecutionLog.proposal_id":
+		x.ProposalId = value.Uint()
+	case "cosmos.group.v1.ExecutionLog.attempt":
+		x.Attempt = uint32(value.Uint())
+	case "cosmos.group.v1.ExecutionLog.executed_at":
+		x.ExecutedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "cosmos.group.v1.ExecutionLog.result":
+		x.Result = (ProposalExecutorResult)(value.Enum())
+	case "cosmos.group.v1.ExecutionLog.logs":
+		x.Logs = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionLog"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionLog does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6920,60 +10116,60 @@ func (x *fastReflection_Vote) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExecutionLog) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.Vote.submit_time":
-		if x.SubmitTime == nil {
-			x.SubmitTime = new(timestamppb.Timestamp)
-		}
-		return protoreflect.ValueOfMessage(x.SubmitTime.ProtoReflect())
-	case "cosmos.group.v1.Vote.proposal_id":
-		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.Vote is not mutable"))
-	case "cosmos.group.v1.Vote.voter":
-		panic(fmt.Errorf("field voter of message cosmos.group.v1.Vote is not mutable"))
-	case "cosmos.group.v1.Vote.option":
-		panic(fmt.Errorf("field option of message cosmos.group.v1.Vote is not mutable"))
-	case "cosmos.group.v1.Vote.metadata":
-		panic(fmt.Errorf("field metadata of message cosmos.group.v1.Vote is not mutable"))
+	case "cosmos.group.v1.ExecutionLog.executed_at":
+		if x.ExecutedAt == nil {
+			x.ExecutedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ExecutedAt.ProtoReflect())
+	case "cosmos.group.v1.ExecutionLog.proposal_id":
+		panic(fmt.Errorf("field proposal_id of message cosmos.group.v1.ExecutionLog is not mutable"))
+	case "cosmos.group.v1.ExecutionLog.attempt":
+		panic(fmt.Errorf("field attempt of message cosmos.group.v1.ExecutionLog is not mutable"))
+	case "cosmos.group.v1.ExecutionLog.result":
+		panic(fmt.Errorf("field result of message cosmos.group.v1.ExecutionLog is not mutable"))
+	case "cosmos.group.v1.ExecutionLog.logs":
+		panic(fmt.Errorf("field logs of message cosmos.group.v1.ExecutionLog is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionLog"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionLog does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Vote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ExecutionLog) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.group.v1.Vote.proposal_id":
+	case "cosmos.group.v1.ExecutionLog.proposal_id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.group.v1.Vote.voter":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.Vote.option":
-		return protoreflect.ValueOfEnum(0)
-	case "cosmos.group.v1.Vote.metadata":
-		return protoreflect.ValueOfString("")
-	case "cosmos.group.v1.Vote.submit_time":
+	case "cosmos.group.v1.ExecutionLog.attempt":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "cosmos.group.v1.ExecutionLog.executed_at":
 		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.group.v1.ExecutionLog.result":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.group.v1.ExecutionLog.logs":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.Vote"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.group.v1.ExecutionLog"))
 		}
-		panic(fmt.Errorf("message cosmos.group.v1.Vote does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.group.v1.ExecutionLog does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ExecutionLog) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.Vote", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.group.v1.ExecutionLog", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6981,7 +10177,7 @@ func (x *fastReflection_Vote) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ExecutionLog) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6992,7 +10188,7 @@ func (x *fastReflection_Vote) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ExecutionLog) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7004,7 +10200,7 @@ func (x *fastReflection_Vote) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Vote) IsValid() bool {
+func (x *fastReflection_ExecutionLog) IsValid() bool {
 	return x != nil
 }
 
@@ -7014,9 +10210,9 @@ func (x *fastReflection_Vote) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ExecutionLog) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*ExecutionLog)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7031,21 +10227,20 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 		if x.ProposalId != 0 {
 			n += 1 + runtime.Sov(uint64(x.ProposalId))
 		}
-		l = len(x.Voter)
-		if l > 0 {
+		if x.Attempt != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempt))
+		}
+		if x.ExecutedAt != nil {
+			l = options.Size(x.ExecutedAt)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Option != 0 {
-			n += 1 + runtime.Sov(uint64(x.Option))
+		if x.Result != 0 {
+			n += 1 + runtime.Sov(uint64(x.Result))
 		}
-		l = len(x.Metadata)
+		l = len(x.Logs)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SubmitTime != nil {
-			l = options.Size(x.SubmitTime)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7056,7 +10251,7 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*ExecutionLog)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7075,8 +10270,20 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SubmitTime != nil {
-			encoded, err := options.Marshal(x.SubmitTime)
+		if len(x.Logs) > 0 {
+			i -= len(x.Logs)
+			copy(dAtA[i:], x.Logs)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Logs)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Result != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Result))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ExecutedAt != nil {
+			encoded, err := options.Marshal(x.ExecutedAt)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7087,26 +10294,12 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Metadata) > 0 {
-			i -= len(x.Metadata)
-			copy(dAtA[i:], x.Metadata)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Metadata)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Option != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Option))
-			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if len(x.Voter) > 0 {
-			i -= len(x.Voter)
-			copy(dAtA[i:], x.Voter)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Voter)))
+		if x.Attempt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempt))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
 		if x.ProposalId != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProposalId))
@@ -7124,7 +10317,7 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Vote)
+		x := input.Message.Interface().(*ExecutionLog)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7156,10 +10349,10 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionLog: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionLog: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7182,10 +10375,10 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 				}
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 				}
-				var stringLen uint64
+				x.Attempt = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7195,29 +10388,16 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Attempt |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Voter = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
 				}
-				x.Option = 0
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7227,16 +10407,33 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Option |= VoteOption(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ExecutedAt == nil {
+					x.ExecutedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 				}
-				var stringLen uint64
+				x.Result = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7246,29 +10443,16 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.Result |= ProposalExecutorResult(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Metadata = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7278,27 +10462,23 @@ func (x *fastReflection_Vote) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.SubmitTime == nil {
-					x.SubmitTime = &timestamppb.Timestamp{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SubmitTime); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Logs = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7350,6 +10530,63 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// VotingPowerSource enumerates the sources of voting power of a
+// TokenWeightedDecisionPolicy.
+type VotingPowerSource int32
+
+const (
+	// VOTING_POWER_SOURCE_UNSPECIFIED defines an unspecified source which will
+	// return an error.
+	VotingPowerSource_VOTING_POWER_SOURCE_UNSPECIFIED VotingPowerSource = 0
+	// VOTING_POWER_SOURCE_BANK defines the member's bank balance of a denom as
+	// voting power.
+	VotingPowerSource_VOTING_POWER_SOURCE_BANK VotingPowerSource = 1
+	// VOTING_POWER_SOURCE_STAKING defines the member's bonded amount in
+	// x/staking as voting power.
+	VotingPowerSource_VOTING_POWER_SOURCE_STAKING VotingPowerSource = 2
+)
+
+// Enum value maps for VotingPowerSource.
+var (
+	VotingPowerSource_name = map[int32]string{
+		0: "VOTING_POWER_SOURCE_UNSPECIFIED",
+		1: "VOTING_POWER_SOURCE_BANK",
+		2: "VOTING_POWER_SOURCE_STAKING",
+	}
+	VotingPowerSource_value = map[string]int32{
+		"VOTING_POWER_SOURCE_UNSPECIFIED": 0,
+		"VOTING_POWER_SOURCE_BANK":        1,
+		"VOTING_POWER_SOURCE_STAKING":     2,
+	}
+)
+
+func (x VotingPowerSource) Enum() *VotingPowerSource {
+	p := new(VotingPowerSource)
+	*p = x
+	return p
+}
+
+func (x VotingPowerSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VotingPowerSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_group_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (VotingPowerSource) Type() protoreflect.EnumType {
+	return &file_cosmos_group_v1_types_proto_enumTypes[0]
+}
+
+func (x VotingPowerSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VotingPowerSource.Descriptor instead.
+func (VotingPowerSource) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
 // VoteOption enumerates the valid vote options for a given proposal.
 type VoteOption int32
 
@@ -7396,11 +10633,11 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_group_v1_types_proto_enumTypes[0].Descriptor()
+	return file_cosmos_group_v1_types_proto_enumTypes[1].Descriptor()
 }
 
 func (VoteOption) Type() protoreflect.EnumType {
-	return &file_cosmos_group_v1_types_proto_enumTypes[0]
+	return &file_cosmos_group_v1_types_proto_enumTypes[1]
 }
 
 func (x VoteOption) Number() protoreflect.EnumNumber {
@@ -7409,7 +10646,7 @@ func (x VoteOption) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VoteOption.Descriptor instead.
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{0}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{1}
 }
 
 // ProposalStatus defines proposal statuses.
@@ -7432,6 +10669,9 @@ const (
 	// A proposal can be withdrawn before the voting start time by the owner.
 	// When this happens the final status is Withdrawn.
 	ProposalStatus_PROPOSAL_STATUS_WITHDRAWN ProposalStatus = 5
+	// Final status of a proposal when it is vetoed by the veto address of the
+	// group policy before its execution.
+	ProposalStatus_PROPOSAL_STATUS_VETOED ProposalStatus = 6
 )
 
 // Enum value maps for ProposalStatus.
@@ -7443,6 +10683,7 @@ var (
 		3: "PROPOSAL_STATUS_REJECTED",
 		4: "PROPOSAL_STATUS_ABORTED",
 		5: "PROPOSAL_STATUS_WITHDRAWN",
+		6: "PROPOSAL_STATUS_VETOED",
 	}
 	ProposalStatus_value = map[string]int32{
 		"PROPOSAL_STATUS_UNSPECIFIED": 0,
@@ -7451,6 +10692,7 @@ var (
 		"PROPOSAL_STATUS_REJECTED":    3,
 		"PROPOSAL_STATUS_ABORTED":     4,
 		"PROPOSAL_STATUS_WITHDRAWN":   5,
+		"PROPOSAL_STATUS_VETOED":      6,
 	}
 )
 
@@ -7465,11 +10707,11 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_group_v1_types_proto_enumTypes[1].Descriptor()
+	return file_cosmos_group_v1_types_proto_enumTypes[2].Descriptor()
 }
 
 func (ProposalStatus) Type() protoreflect.EnumType {
-	return &file_cosmos_group_v1_types_proto_enumTypes[1]
+	return &file_cosmos_group_v1_types_proto_enumTypes[2]
 }
 
 func (x ProposalStatus) Number() protoreflect.EnumNumber {
@@ -7478,7 +10720,7 @@ func (x ProposalStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProposalStatus.Descriptor instead.
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{1}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{2}
 }
 
 // ProposalExecutorResult defines types of proposal executor results.
@@ -7522,11 +10764,11 @@ func (x ProposalExecutorResult) String() string {
 }
 
 func (ProposalExecutorResult) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_group_v1_types_proto_enumTypes[2].Descriptor()
+	return file_cosmos_group_v1_types_proto_enumTypes[3].Descriptor()
 }
 
 func (ProposalExecutorResult) Type() protoreflect.EnumType {
-	return &file_cosmos_group_v1_types_proto_enumTypes[2]
+	return &file_cosmos_group_v1_types_proto_enumTypes[3]
 }
 
 func (x ProposalExecutorResult) Number() protoreflect.EnumNumber {
@@ -7535,7 +10777,7 @@ func (x ProposalExecutorResult) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ProposalExecutorResult.Descriptor instead.
 func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{2}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{3}
 }
 
 // Member represents a group member with an account address,
@@ -7764,6 +11006,79 @@ func (x *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// TokenWeightedDecisionPolicy is a decision policy where the voting power of
+// each member is its token balance instead of its group weight. The voting
+// power is snapshotted when a proposal is submitted, and a proposal passes when
+// it satisfies the two following conditions:
+//  1. The percentage of all `YES` voters' power out of the total power of the
+//     group members is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+type TokenWeightedDecisionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// percentage is the minimum percentage of the `YES` voting power out of the
+	// total voting power must meet for a proposal to succeed.
+	Percentage string `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// source defines where the voting power of the members is read from.
+	Source VotingPowerSource `protobuf:"varint,2,opt,name=source,proto3,enum=cosmos.group.v1.VotingPowerSource" json:"source,omitempty"`
+	// denom is the denomination of the balance used as voting power. It must be
+	// set for the bank source only, the bonded amount is used otherwise.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *TokenWeightedDecisionPolicy) Reset() {
+	*x = TokenWeightedDecisionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenWeightedDecisionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenWeightedDecisionPolicy) ProtoMessage() {}
+
+// Deprecated: Use TokenWeightedDecisionPolicy.ProtoReflect.Descriptor instead.
+func (*TokenWeightedDecisionPolicy) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TokenWeightedDecisionPolicy) GetPercentage() string {
+	if x != nil {
+		return x.Percentage
+	}
+	return ""
+}
+
+func (x *TokenWeightedDecisionPolicy) GetSource() VotingPowerSource {
+	if x != nil {
+		return x.Source
+	}
+	return VotingPowerSource_VOTING_POWER_SOURCE_UNSPECIFIED
+}
+
+func (x *TokenWeightedDecisionPolicy) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *TokenWeightedDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	state         protoimpl.MessageState
@@ -7790,7 +11105,7 @@ type DecisionPolicyWindows struct {
 func (x *DecisionPolicyWindows) Reset() {
 	*x = DecisionPolicyWindows{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_types_proto_msgTypes[4]
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7804,7 +11119,7 @@ func (*DecisionPolicyWindows) ProtoMessage() {}
 
 // Deprecated: Use DecisionPolicyWindows.ProtoReflect.Descriptor instead.
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{4}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DecisionPolicyWindows) GetVotingPeriod() *durationpb.Duration {
@@ -7847,7 +11162,7 @@ type GroupInfo struct {
 func (x *GroupInfo) Reset() {
 	*x = GroupInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_types_proto_msgTypes[5]
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7861,7 +11176,7 @@ func (*GroupInfo) ProtoMessage() {}
 
 // Deprecated: Use GroupInfo.ProtoReflect.Descriptor instead.
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{5}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GroupInfo) GetId() uint64 {
@@ -7921,7 +11236,7 @@ type GroupMember struct {
 func (x *GroupMember) Reset() {
 	*x = GroupMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_types_proto_msgTypes[6]
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7935,7 +11250,7 @@ func (*GroupMember) ProtoMessage() {}
 
 // Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GroupMember) GetGroupId() uint64 {
@@ -7975,12 +11290,15 @@ type GroupPolicyInfo struct {
 	DecisionPolicy *anypb.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
 	// created_at is a timestamp specifying when a group policy was created.
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// execution_settings defines the optional execution rules of the proposals
+	// accepted by the group policy.
+	ExecutionSettings *ExecutionSettings `protobuf:"bytes,8,opt,name=execution_settings,json=executionSettings,proto3" json:"execution_settings,omitempty"`
 }
 
 func (x *GroupPolicyInfo) Reset() {
 	*x = GroupPolicyInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_types_proto_msgTypes[7]
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7994,7 +11312,7 @@ func (*GroupPolicyInfo) ProtoMessage() {}
 
 // Deprecated: Use GroupPolicyInfo.ProtoReflect.Descriptor instead.
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GroupPolicyInfo) GetAddress() string {
@@ -8046,6 +11364,76 @@ func (x *GroupPolicyInfo) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GroupPolicyInfo) GetExecutionSettings() *ExecutionSettings {
+	if x != nil {
+		return x.ExecutionSettings
+	}
+	return nil
+}
+
+// ExecutionSettings defines the execution rules of the proposals accepted by a
+// group policy.
+type ExecutionSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timelock is the duration after a proposal is accepted during which it
+	// can't be executed, and can be vetoed by the veto_address. It must be
+	// smaller than the app-defined max_execution_period.
+	Timelock *durationpb.Duration `protobuf:"bytes,1,opt,name=timelock,proto3" json:"timelock,omitempty"`
+	// veto_address is the account allowed to veto the proposals of the group
+	// policy, usually the account of another group policy acting as a veto
+	// council. If empty, proposals can't be vetoed.
+	VetoAddress string `protobuf:"bytes,2,opt,name=veto_address,json=vetoAddress,proto3" json:"veto_address,omitempty"`
+	// max_execution_attempts is the maximum number of times the execution of an
+	// accepted proposal is attempted. Once reached after failed executions, the
+	// proposal expires and can't be executed anymore. If set to 0, the number
+	// of attempts is not limited.
+	MaxExecutionAttempts uint32 `protobuf:"varint,3,opt,name=max_execution_attempts,json=maxExecutionAttempts,proto3" json:"max_execution_attempts,omitempty"`
+}
+
+func (x *ExecutionSettings) Reset() {
+	*x = ExecutionSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionSettings) ProtoMessage() {}
+
+// Deprecated: Use ExecutionSettings.ProtoReflect.Descriptor instead.
+func (*ExecutionSettings) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExecutionSettings) GetTimelock() *durationpb.Duration {
+	if x != nil {
+		return x.Timelock
+	}
+	return nil
+}
+
+func (x *ExecutionSettings) GetVetoAddress() string {
+	if x != nil {
+		return x.VetoAddress
+	}
+	return ""
+}
+
+func (x *ExecutionSettings) GetMaxExecutionAttempts() uint32 {
+	if x != nil {
+		return x.MaxExecutionAttempts
+	}
+	return 0
+}
+
 // Proposal defines a group proposal. Any member of a group can submit a proposal
 // for a group policy to decide upon.
 // A proposal consists of a set of `sdk.Msg`s that will be executed if the proposal
@@ -8100,12 +11488,23 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// timelock_end is the timestamp before which an accepted proposal can't be
+	// executed. It is only set when the group policy defines a timelock.
+	TimelockEnd *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=timelock_end,json=timelockEnd,proto3" json:"timelock_end,omitempty"`
+	// execution_attempts is the number of times the execution of the proposal
+	// has been attempted.
+	ExecutionAttempts uint32 `protobuf:"varint,16,opt,name=execution_attempts,json=executionAttempts,proto3" json:"execution_attempts,omitempty"`
+	// execution_settings is a copy of the execution settings of the group policy
+	// when the proposal is accepted. The veto address and the maximum number of
+	// execution attempts of an accepted proposal are read from it, so that later
+	// updates of the group policy don't apply to the proposal.
+	ExecutionSettings *ExecutionSettings `protobuf:"bytes,17,opt,name=execution_settings,json=executionSettings,proto3" json:"execution_settings,omitempty"`
 }
 
 func (x *Proposal) Reset() {
 	*x = Proposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_types_proto_msgTypes[8]
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8119,7 +11518,7 @@ func (*Proposal) ProtoMessage() {}
 
 // Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Proposal) GetId() uint64 {
@@ -8220,6 +11619,27 @@ func (x *Proposal) GetSummary() string {
 	return ""
 }
 
+func (x *Proposal) GetTimelockEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimelockEnd
+	}
+	return nil
+}
+
+func (x *Proposal) GetExecutionAttempts() uint32 {
+	if x != nil {
+		return x.ExecutionAttempts
+	}
+	return 0
+}
+
+func (x *Proposal) GetExecutionSettings() *ExecutionSettings {
+	if x != nil {
+		return x.ExecutionSettings
+	}
+	return nil
+}
+
 // TallyResult represents the sum of weighted votes for each vote option.
 type TallyResult struct {
 	state         protoimpl.MessageState
@@ -8239,7 +11659,7 @@ type TallyResult struct {
 func (x *TallyResult) Reset() {
 	*x = TallyResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_types_proto_msgTypes[9]
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8253,7 +11673,7 @@ func (*TallyResult) ProtoMessage() {}
 
 // Deprecated: Use TallyResult.ProtoReflect.Descriptor instead.
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TallyResult) GetYesCount() string {
@@ -8305,7 +11725,7 @@ type Vote struct {
 func (x *Vote) Reset() {
 	*x = Vote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_group_v1_types_proto_msgTypes[10]
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8319,7 +11739,7 @@ func (*Vote) ProtoMessage() {}
 
 // Deprecated: Use Vote.ProtoReflect.Descriptor instead.
 func (*Vote) Descriptor() ([]byte, []int) {
-	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Vote) GetProposalId() uint64 {
@@ -8357,6 +11777,181 @@ func (x *Vote) GetSubmitTime() *timestamppb.Timestamp {
 	return nil
 }
 
+// VotingPowerSnapshot is the voting power of the group members taken when a
+// proposal with a TokenWeightedDecisionPolicy is submitted.
+type VotingPowerSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// members is the voting power of each group member.
+	Members []*MemberPower `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// total_power is the sum of the voting power of all members.
+	TotalPower string `protobuf:"bytes,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (x *VotingPowerSnapshot) Reset() {
+	*x = VotingPowerSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VotingPowerSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VotingPowerSnapshot) ProtoMessage() {}
+
+// Deprecated: Use VotingPowerSnapshot.ProtoReflect.Descriptor instead.
+func (*VotingPowerSnapshot) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VotingPowerSnapshot) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *VotingPowerSnapshot) GetMembers() []*MemberPower {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *VotingPowerSnapshot) GetTotalPower() string {
+	if x != nil {
+		return x.TotalPower
+	}
+	return ""
+}
+
+// MemberPower is the voting power of a group member in a snapshot.
+type MemberPower struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the member's account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// power is the member's voting power.
+	Power string `protobuf:"bytes,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (x *MemberPower) Reset() {
+	*x = MemberPower{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemberPower) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberPower) ProtoMessage() {}
+
+// Deprecated: Use MemberPower.ProtoReflect.Descriptor instead.
+func (*MemberPower) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MemberPower) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MemberPower) GetPower() string {
+	if x != nil {
+		return x.Power
+	}
+	return ""
+}
+
+// ExecutionLog is the record of an execution attempt of a proposal.
+type ExecutionLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// attempt is the number of the execution attempt, starting at 1.
+	Attempt uint32 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// executed_at is the timestamp of the execution attempt.
+	ExecutedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	// result is the result of the execution attempt.
+	Result ProposalExecutorResult `protobuf:"varint,4,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+	// logs contains the error of a failed execution attempt.
+	Logs string `protobuf:"bytes,5,opt,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (x *ExecutionLog) Reset() {
+	*x = ExecutionLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_group_v1_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionLog) ProtoMessage() {}
+
+// Deprecated: Use ExecutionLog.ProtoReflect.Descriptor instead.
+func (*ExecutionLog) Descriptor() ([]byte, []int) {
+	return file_cosmos_group_v1_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExecutionLog) GetProposalId() uint64 {
+	if x != nil {
+		return x.ProposalId
+	}
+	return 0
+}
+
+func (x *ExecutionLog) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *ExecutionLog) GetExecutedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return nil
+}
+
+func (x *ExecutionLog) GetResult() ProposalExecutorResult {
+	if x != nil {
+		return x.Result
+	}
+	return ProposalExecutorResult_PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (x *ExecutionLog) GetLogs() string {
+	if x != nil {
+		return x.Logs
+	}
+	return ""
+}
+
 var File_cosmos_group_v1_types_proto protoreflect.FileDescriptor
 
 var file_cosmos_group_v1_types_proto_rawDesc = []byte{
@@ -8417,159 +12012,248 @@ var file_cosmos_group_v1_types_proto_rawDesc = []byte{
 	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
 	0x74, 0x61, 0x67, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x22, 0xc2, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x4d, 0x0a, 0x0d,
-	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d,
-	0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x76,
-	0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x5a, 0x0a, 0x14, 0x6d,
-	0x69, 0x6e, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x48,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d,
-	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x59, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x22, 0xfd, 0x02, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x0f,
-	0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x22, 0xca, 0xb4, 0x2d,
-	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
-	0x0e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
-	0x48, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
-	0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8,
-	0xa0, 0x1f, 0x01, 0x22, 0xfe, 0x05, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x4a, 0x0a, 0x14, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
-	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73,
-	0x12, 0x4a, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x63, 0x79, 0x22, 0xa0, 0x02, 0x0a, 0x1b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x40, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x07, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x3a, 0x4d, 0xca, 0xb4, 0x2d, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x26, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xc2, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12,
+	0x4d, 0x0a, 0x0d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x0c, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x5a,
+	0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf, 0x1f,
+	0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0xee, 0x01, 0x0a, 0x09, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
-	0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x30, 0x0a, 0x14, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x55, 0x0a, 0x12,
-	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x55, 0x0a, 0x11, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00,
-	0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e,
-	0x67, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x50, 0x0a, 0x0f, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x08,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x41, 0x6e, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x3a, 0x04,
-	0x88, 0xa0, 0x1f, 0x00, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x79, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x79, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69,
-	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6e, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76, 0x65, 0x74,
-	0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e,
-	0x6f, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x04,
-	0x88, 0xa0, 0x1f, 0x00, 0x22, 0xf4, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x2e,
-	0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x33,
-	0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x59, 0x0a, 0x0b, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xd0, 0x03, 0x0a, 0x0f, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x61, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x22,
+	0xca, 0xb4, 0x2d, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x51, 0x0a, 0x12,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x11, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a,
+	0x08, 0x88, 0xa0, 0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xd2, 0x01, 0x0a, 0x11, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x44, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde,
+	0x1f, 0x00, 0x98, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x0c, 0x76, 0x65, 0x74, 0x6f, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x76, 0x65, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc5,
+	0x07, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4a, 0x0a, 0x14, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x12, 0x4a, 0x0a, 0x0b, 0x73,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde,
+	0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x55, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x55,
+	0x0a, 0x11, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x45, 0x6e, 0x64, 0x12, 0x50, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x4a, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x43, 0x0a, 0x0c, 0x74, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6e, 0x64, 0x12, 0x2d,
+	0x0a, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x51, 0x0a,
+	0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x11, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6c, 0x6c, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x79, 0x65, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x79, 0x65, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x74,
+	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x6f, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6e, 0x6f, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x76,
+	0x65, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x6e, 0x6f, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x74, 0x6f, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x3a, 0x04, 0x88, 0xa0, 0x1f, 0x00, 0x22, 0xf4, 0x01, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
+	0x12, 0x33, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x4a, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9a, 0x01,
+	0x0a, 0x13, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x50, 0x6f, 0x77, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x0b, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x22, 0xea, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x6f, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12,
+	0x4a, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
 	0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0x8f, 0x01, 0x0a, 0x0a,
-	0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x4f,
-	0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56, 0x4f, 0x54, 0x45, 0x5f,
-	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45, 0x53, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
-	0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x42, 0x53, 0x54,
-	0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x4f, 0x54,
-	0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x57, 0x49, 0x54, 0x48,
-	0x5f, 0x56, 0x45, 0x54, 0x4f, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xce, 0x01,
-	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c,
-	0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17,
+	0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x6f, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
+	0x2a, 0x7d, 0x0a, 0x11, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x56, 0x4f, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x4f,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x42, 0x41, 0x4e, 0x4b, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x4f, 0x54, 0x49,
+	0x4e, 0x47, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a,
+	0x8f, 0x01, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x17, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x56,
+	0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x59, 0x45, 0x53, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x41, 0x42, 0x53, 0x54, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x56, 0x4f, 0x54,
+	0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x10, 0x03, 0x12, 0x1c, 0x0a,
+	0x18, 0x56, 0x4f, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f,
+	0x57, 0x49, 0x54, 0x48, 0x5f, 0x56, 0x45, 0x54, 0x4f, 0x10, 0x04, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
+	0x00, 0x2a, 0xea, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41,
+	0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x42, 0x4d, 0x49, 0x54, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a,
+	0x19, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57, 0x4e, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16,
 	0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x41, 0x42, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f,
-	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x44, 0x52, 0x41, 0x57, 0x4e, 0x10, 0x05, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xba,
+	0x56, 0x45, 0x54, 0x4f, 0x45, 0x44, 0x10, 0x06, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xba,
 	0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x78, 0x65, 0x63, 0x75,
 	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x52, 0x4f,
 	0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x4f, 0x52, 0x5f, 0x52,
@@ -8607,50 +12291,65 @@ func file_cosmos_group_v1_types_proto_rawDescGZIP() []byte {
 	return file_cosmos_group_v1_types_proto_rawDescData
 }
 
-var file_cosmos_group_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_cosmos_group_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_cosmos_group_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_cosmos_group_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_cosmos_group_v1_types_proto_goTypes = []interface{}{
-	(VoteOption)(0),                  // 0: cosmos.group.v1.VoteOption
-	(ProposalStatus)(0),              // 1: cosmos.group.v1.ProposalStatus
-	(ProposalExecutorResult)(0),      // 2: cosmos.group.v1.ProposalExecutorResult
-	(*Member)(nil),                   // 3: cosmos.group.v1.Member
-	(*MemberRequest)(nil),            // 4: cosmos.group.v1.MemberRequest
-	(*ThresholdDecisionPolicy)(nil),  // 5: cosmos.group.v1.ThresholdDecisionPolicy
-	(*PercentageDecisionPolicy)(nil), // 6: cosmos.group.v1.PercentageDecisionPolicy
-	(*DecisionPolicyWindows)(nil),    // 7: cosmos.group.v1.DecisionPolicyWindows
-	(*GroupInfo)(nil),                // 8: cosmos.group.v1.GroupInfo
-	(*GroupMember)(nil),              // 9: cosmos.group.v1.GroupMember
-	(*GroupPolicyInfo)(nil),          // 10: cosmos.group.v1.GroupPolicyInfo
-	(*Proposal)(nil),                 // 11: cosmos.group.v1.Proposal
-	(*TallyResult)(nil),              // 12: cosmos.group.v1.TallyResult
-	(*Vote)(nil),                     // 13: cosmos.group.v1.Vote
-	(*timestamppb.Timestamp)(nil),    // 14: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),      // 15: google.protobuf.Duration
-	(*anypb.Any)(nil),                // 16: google.protobuf.Any
+	(VotingPowerSource)(0),              // 0: cosmos.group.v1.VotingPowerSource
+	(VoteOption)(0),                     // 1: cosmos.group.v1.VoteOption
+	(ProposalStatus)(0),                 // 2: cosmos.group.v1.ProposalStatus
+	(ProposalExecutorResult)(0),         // 3: cosmos.group.v1.ProposalExecutorResult
+	(*Member)(nil),                      // 4: cosmos.group.v1.Member
+	(*MemberRequest)(nil),               // 5: cosmos.group.v1.MemberRequest
+	(*ThresholdDecisionPolicy)(nil),     // 6: cosmos.group.v1.ThresholdDecisionPolicy
+	(*PercentageDecisionPolicy)(nil),    // 7: cosmos.group.v1.PercentageDecisionPolicy
+	(*TokenWeightedDecisionPolicy)(nil), // 8: cosmos.group.v1.TokenWeightedDecisionPolicy
+	(*DecisionPolicyWindows)(nil),       // 9: cosmos.group.v1.DecisionPolicyWindows
+	(*GroupInfo)(nil),                   // 10: cosmos.group.v1.GroupInfo
+	(*GroupMember)(nil),                 // 11: cosmos.group.v1.GroupMember
+	(*GroupPolicyInfo)(nil),             // 12: cosmos.group.v1.GroupPolicyInfo
+	(*ExecutionSettings)(nil),           // 13: cosmos.group.v1.ExecutionSettings
+	(*Proposal)(nil),                    // 14: cosmos.group.v1.Proposal
+	(*TallyResult)(nil),                 // 15: cosmos.group.v1.TallyResult
+	(*Vote)(nil),                        // 16: cosmos.group.v1.Vote
+	(*VotingPowerSnapshot)(nil),         // 17: cosmos.group.v1.VotingPowerSnapshot
+	(*MemberPower)(nil),                 // 18: cosmos.group.v1.MemberPower
+	(*ExecutionLog)(nil),                // 19: cosmos.group.v1.ExecutionLog
+	(*timestamppb.Timestamp)(nil),       // 20: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 21: google.protobuf.Duration
+	(*anypb.Any)(nil),                   // 22: google.protobuf.Any
 }
 var file_cosmos_group_v1_types_proto_depIdxs = []int32{
-	14, // 0: cosmos.group.v1.Member.added_at:type_name -> google.protobuf.Timestamp
-	7,  // 1: cosmos.group.v1.ThresholdDecisionPolicy.windows:type_name -> cosmos.group.v1.DecisionPolicyWindows
-	7,  // 2: cosmos.group.v1.PercentageDecisionPolicy.windows:type_name -> cosmos.group.v1.DecisionPolicyWindows
-	15, // 3: cosmos.group.v1.DecisionPolicyWindows.voting_period:type_name -> google.protobuf.Duration
-	15, // 4: cosmos.group.v1.DecisionPolicyWindows.min_execution_period:type_name -> google.protobuf.Duration
-	14, // 5: cosmos.group.v1.GroupInfo.created_at:type_name -> google.protobuf.Timestamp
-	3,  // 6: cosmos.group.v1.GroupMember.member:type_name -> cosmos.group.v1.Member
-	16, // 7: cosmos.group.v1.GroupPolicyInfo.decision_policy:type_name -> google.protobuf.Any
-	14, // 8: cosmos.group.v1.GroupPolicyInfo.created_at:type_name -> google.protobuf.Timestamp
-	14, // 9: cosmos.group.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
-	1,  // 10: cosmos.group.v1.Proposal.status:type_name -> cosmos.group.v1.ProposalStatus
-	12, // 11: cosmos.group.v1.Proposal.final_tally_result:type_name -> cosmos.group.v1.TallyResult
-	14, // 12: cosmos.group.v1.Proposal.voting_period_end:type_name -> google.protobuf.Timestamp
-	2,  // 13: cosmos.group.v1.Proposal.executor_result:type_name -> cosmos.group.v1.ProposalExecutorResult
-	16, // 14: cosmos.group.v1.Proposal.messages:type_name -> google.protobuf.Any
-	0,  // 15: cosmos.group.v1.Vote.option:type_name -> cosmos.group.v1.VoteOption
-	14, // 16: cosmos.group.v1.Vote.submit_time:type_name -> google.protobuf.Timestamp
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	20, // 0: cosmos.group.v1.Member.added_at:type_name -> google.protobuf.Timestamp
+	9,  // 1: cosmos.group.v1.ThresholdDecisionPolicy.windows:type_name -> cosmos.group.v1.DecisionPolicyWindows
+	9,  // 2: cosmos.group.v1.PercentageDecisionPolicy.windows:type_name -> cosmos.group.v1.DecisionPolicyWindows
+	0,  // 3: cosmos.group.v1.TokenWeightedDecisionPolicy.source:type_name -> cosmos.group.v1.VotingPowerSource
+	9,  // 4: cosmos.group.v1.TokenWeightedDecisionPolicy.windows:type_name -> cosmos.group.v1.DecisionPolicyWindows
+	21, // 5: cosmos.group.v1.DecisionPolicyWindows.voting_period:type_name -> google.protobuf.Duration
+	21, // 6: cosmos.group.v1.DecisionPolicyWindows.min_execution_period:type_name -> google.protobuf.Duration
+	20, // 7: cosmos.group.v1.GroupInfo.created_at:type_name -> google.protobuf.Timestamp
+	4,  // 8: cosmos.group.v1.GroupMember.member:type_name -> cosmos.group.v1.Member
+	22, // 9: cosmos.group.v1.GroupPolicyInfo.decision_policy:type_name -> google.protobuf.Any
+	20, // 10: cosmos.group.v1.GroupPolicyInfo.created_at:type_name -> google.protobuf.Timestamp
+	13, // 11: cosmos.group.v1.GroupPolicyInfo.execution_settings:type_name -> cosmos.group.v1.ExecutionSettings
+	21, // 12: cosmos.group.v1.ExecutionSettings.timelock:type_name -> google.protobuf.Duration
+	20, // 13: cosmos.group.v1.Proposal.submit_time:type_name -> google.protobuf.Timestamp
+	2,  // 14: cosmos.group.v1.Proposal.status:type_name -> cosmos.group.v1.ProposalStatus
+	15, // 15: cosmos.group.v1.Proposal.final_tally_result:type_name -> cosmos.group.v1.TallyResult
+	20, // 16: cosmos.group.v1.Proposal.voting_period_end:type_name -> google.protobuf.Timestamp
+	3,  // 17: cosmos.group.v1.Proposal.executor_result:type_name -> cosmos.group.v1.ProposalExecutorResult
+	22, // 18: cosmos.group.v1.Proposal.messages:type_name -> google.protobuf.Any
+	20, // 19: cosmos.group.v1.Proposal.timelock_end:type_name -> google.protobuf.Timestamp
+	13, // 20: cosmos.group.v1.Proposal.execution_settings:type_name -> cosmos.group.v1.ExecutionSettings
+	1,  // 21: cosmos.group.v1.Vote.option:type_name -> cosmos.group.v1.VoteOption
+	20, // 22: cosmos.group.v1.Vote.submit_time:type_name -> google.protobuf.Timestamp
+	18, // 23: cosmos.group.v1.VotingPowerSnapshot.members:type_name -> cosmos.group.v1.MemberPower
+	20, // 24: cosmos.group.v1.ExecutionLog.executed_at:type_name -> google.protobuf.Timestamp
+	3,  // 25: cosmos.group.v1.ExecutionLog.result:type_name -> cosmos.group.v1.ProposalExecutorResult
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_group_v1_types_proto_init() }
@@ -8708,7 +12407,7 @@ func file_cosmos_group_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DecisionPolicyWindows); i {
+			switch v := v.(*TokenWeightedDecisionPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8720,7 +12419,7 @@ func file_cosmos_group_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupInfo); i {
+			switch v := v.(*DecisionPolicyWindows); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8732,7 +12431,7 @@ func file_cosmos_group_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupMember); i {
+			switch v := v.(*GroupInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8744,7 +12443,7 @@ func file_cosmos_group_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupPolicyInfo); i {
+			switch v := v.(*GroupMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8756,7 +12455,7 @@ func file_cosmos_group_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Proposal); i {
+			switch v := v.(*GroupPolicyInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8768,7 +12467,7 @@ func file_cosmos_group_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TallyResult); i {
+			switch v := v.(*ExecutionSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8780,6 +12479,30 @@ func file_cosmos_group_v1_types_proto_init() {
 			}
 		}
 		file_cosmos_group_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TallyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Vote); i {
 			case 0:
 				return &v.state
@@ -8791,14 +12514,50 @@ func file_cosmos_group_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_group_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotingPowerSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemberPower); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_group_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_group_v1_types_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,9 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName           = "/cosmos.mint.v1beta1.Query/Params"
-	Query_Inflation_FullMethodName        = "/cosmos.mint.v1beta1.Query/Inflation"
-	Query_AnnualProvisions_FullMethodName = "/cosmos.mint.v1beta1.Query/AnnualProvisions"
+	Query_Params_FullMethodName             = "/cosmos.mint.v1beta1.Query/Params"
+	Query_Inflation_FullMethodName          = "/cosmos.mint.v1beta1.Query/Inflation"
+	Query_AnnualProvisions_FullMethodName   = "/cosmos.mint.v1beta1.Query/AnnualProvisions"
+	Query_ProjectedEmissions_FullMethodName = "/cosmos.mint.v1beta1.Query/ProjectedEmissions"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedEmissions returns the projected amount of tokens minted over a
+	// range of future block heights.
+	ProjectedEmissions(ctx context.Context, in *QueryProjectedEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectedEmissionsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +75,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) ProjectedEmissions(ctx context.Context, in *QueryProjectedEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectedEmissionsResponse, error) {
+	out := new(QueryProjectedEmissionsResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectedEmissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +94,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedEmissions returns the projected amount of tokens minted over a
+	// range of future block heights.
+	ProjectedEmissions(context.Context, *QueryProjectedEmissionsRequest) (*QueryProjectedEmissionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +113,9 @@ func (UnimplementedQueryServer) Inflation(context.Context, *QueryInflationReques
 func (UnimplementedQueryServer) AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (UnimplementedQueryServer) ProjectedEmissions(context.Context, *QueryProjectedEmissionsRequest) (*QueryProjectedEmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedEmissions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +183,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedEmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedEmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedEmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectedEmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedEmissions(ctx, req.(*QueryProjectedEmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +220,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "ProjectedEmissions",
+			Handler:    _Query_ProjectedEmissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
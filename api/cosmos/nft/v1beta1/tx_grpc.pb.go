@@ -19,7 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Send_FullMethodName = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_Send_FullMethodName        = "/cosmos.nft.v1beta1.Msg/Send"
+	Msg_CreateClass_FullMethodName = "/cosmos.nft.v1beta1.Msg/CreateClass"
+	Msg_UpdateClass_FullMethodName = "/cosmos.nft.v1beta1.Msg/UpdateClass"
+	Msg_FreezeClass_FullMethodName = "/cosmos.nft.v1beta1.Msg/FreezeClass"
+	Msg_Mint_FullMethodName        = "/cosmos.nft.v1beta1.Msg/Mint"
+	Msg_UpdateNFT_FullMethodName   = "/cosmos.nft.v1beta1.Msg/UpdateNFT"
+	Msg_Burn_FullMethodName        = "/cosmos.nft.v1beta1.Msg/Burn"
 )
 
 // MsgClient is the client API for Msg service.
@@ -28,6 +34,18 @@ const (
 type MsgClient interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
+	// CreateClass defines a method to create a new nft class owned by the signer.
+	CreateClass(ctx context.Context, in *MsgCreateClass, opts ...grpc.CallOption) (*MsgCreateClassResponse, error)
+	// UpdateClass defines a method to update the metadata of a nft class, only allowed to the class owner.
+	UpdateClass(ctx context.Context, in *MsgUpdateClass, opts ...grpc.CallOption) (*MsgUpdateClassResponse, error)
+	// FreezeClass defines a method to freeze a nft class, only allowed to the class owner.
+	FreezeClass(ctx context.Context, in *MsgFreezeClass, opts ...grpc.CallOption) (*MsgFreezeClassResponse, error)
+	// Mint defines a method to mint a new nft, only allowed to the class owner.
+	Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error)
+	// UpdateNFT defines a method to update the metadata of a nft, only allowed to the class owner.
+	UpdateNFT(ctx context.Context, in *MsgUpdateNFT, opts ...grpc.CallOption) (*MsgUpdateNFTResponse, error)
+	// Burn defines a method to burn a nft, only allowed to the nft owner.
+	Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error)
 }
 
 type msgClient struct {
@@ -47,12 +65,78 @@ func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) CreateClass(ctx context.Context, in *MsgCreateClass, opts ...grpc.CallOption) (*MsgCreateClassResponse, error) {
+	out := new(MsgCreateClassResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateClass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClass(ctx context.Context, in *MsgUpdateClass, opts ...grpc.CallOption) (*MsgUpdateClassResponse, error) {
+	out := new(MsgUpdateClassResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateClass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeClass(ctx context.Context, in *MsgFreezeClass, opts ...grpc.CallOption) (*MsgFreezeClassResponse, error) {
+	out := new(MsgFreezeClassResponse)
+	err := c.cc.Invoke(ctx, Msg_FreezeClass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Mint(ctx context.Context, in *MsgMint, opts ...grpc.CallOption) (*MsgMintResponse, error) {
+	out := new(MsgMintResponse)
+	err := c.cc.Invoke(ctx, Msg_Mint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNFT(ctx context.Context, in *MsgUpdateNFT, opts ...grpc.CallOption) (*MsgUpdateNFTResponse, error) {
+	out := new(MsgUpdateNFTResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateNFT_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Burn(ctx context.Context, in *MsgBurn, opts ...grpc.CallOption) (*MsgBurnResponse, error) {
+	out := new(MsgBurnResponse)
+	err := c.cc.Invoke(ctx, Msg_Burn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	// Send defines a method to send a nft from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
+	// CreateClass defines a method to create a new nft class owned by the signer.
+	CreateClass(context.Context, *MsgCreateClass) (*MsgCreateClassResponse, error)
+	// UpdateClass defines a method to update the metadata of a nft class, only allowed to the class owner.
+	UpdateClass(context.Context, *MsgUpdateClass) (*MsgUpdateClassResponse, error)
+	// FreezeClass defines a method to freeze a nft class, only allowed to the class owner.
+	FreezeClass(context.Context, *MsgFreezeClass) (*MsgFreezeClassResponse, error)
+	// Mint defines a method to mint a new nft, only allowed to the class owner.
+	Mint(context.Context, *MsgMint) (*MsgMintResponse, error)
+	// UpdateNFT defines a method to update the metadata of a nft, only allowed to the class owner.
+	UpdateNFT(context.Context, *MsgUpdateNFT) (*MsgUpdateNFTResponse, error)
+	// Burn defines a method to burn a nft, only allowed to the nft owner.
+	Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -63,6 +147,24 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Send(context.Context, *MsgSend) (*MsgSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedMsgServer) CreateClass(context.Context, *MsgCreateClass) (*MsgCreateClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClass not implemented")
+}
+func (UnimplementedMsgServer) UpdateClass(context.Context, *MsgUpdateClass) (*MsgUpdateClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClass not implemented")
+}
+func (UnimplementedMsgServer) FreezeClass(context.Context, *MsgFreezeClass) (*MsgFreezeClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeClass not implemented")
+}
+func (UnimplementedMsgServer) Mint(context.Context, *MsgMint) (*MsgMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (UnimplementedMsgServer) UpdateNFT(context.Context, *MsgUpdateNFT) (*MsgUpdateNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNFT not implemented")
+}
+func (UnimplementedMsgServer) Burn(context.Context, *MsgBurn) (*MsgBurnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Burn not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -94,6 +196,114 @@ func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClass(ctx, req.(*MsgCreateClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClass(ctx, req.(*MsgUpdateClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_FreezeClass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeClass(ctx, req.(*MsgFreezeClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Mint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Mint(ctx, req.(*MsgMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNFT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateNFT_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNFT(ctx, req.(*MsgUpdateNFT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Burn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBurn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Burn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Burn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Burn(ctx, req.(*MsgBurn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -105,6 +315,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Send",
 			Handler:    _Msg_Send_Handler,
 		},
+		{
+			MethodName: "CreateClass",
+			Handler:    _Msg_CreateClass_Handler,
+		},
+		{
+			MethodName: "UpdateClass",
+			Handler:    _Msg_UpdateClass_Handler,
+		},
+		{
+			MethodName: "FreezeClass",
+			Handler:    _Msg_FreezeClass_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Msg_Mint_Handler,
+		},
+		{
+			MethodName: "UpdateNFT",
+			Handler:    _Msg_UpdateNFT_Handler,
+		},
+		{
+			MethodName: "Burn",
+			Handler:    _Msg_Burn_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/tx.proto",
@@ -22,6 +22,7 @@ const (
 	Query_Params_FullMethodName       = "/cosmos.slashing.v1beta1.Query/Params"
 	Query_SigningInfo_FullMethodName  = "/cosmos.slashing.v1beta1.Query/SigningInfo"
 	Query_SigningInfos_FullMethodName = "/cosmos.slashing.v1beta1.Query/SigningInfos"
+	Query_MissedBlocks_FullMethodName = "/cosmos.slashing.v1beta1.Query/MissedBlocks"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +35,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed blocks bitmap of the current signed blocks
+	// window of given cons address
+	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +75,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error) {
+	out := new(QueryMissedBlocksResponse)
+	err := c.cc.Invoke(ctx, Query_MissedBlocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +94,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed blocks bitmap of the current signed blocks
+	// window of given cons address
+	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +113,9 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +183,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MissedBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocks(ctx, req.(*QueryMissedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +220,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MissedBlocks",
+			Handler:    _Query_MissedBlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -715,12 +715,14 @@ func (x *fastReflection_ValidatorSigningInfo) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_Params                            protoreflect.MessageDescriptor
-	fd_Params_signed_blocks_window       protoreflect.FieldDescriptor
-	fd_Params_min_signed_per_window      protoreflect.FieldDescriptor
-	fd_Params_downtime_jail_duration     protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_double_sign protoreflect.FieldDescriptor
-	fd_Params_slash_fraction_downtime    protoreflect.FieldDescriptor
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_signed_blocks_window           protoreflect.FieldDescriptor
+	fd_Params_min_signed_per_window          protoreflect.FieldDescriptor
+	fd_Params_downtime_jail_duration         protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_double_sign     protoreflect.FieldDescriptor
+	fd_Params_slash_fraction_downtime        protoreflect.FieldDescriptor
+	fd_Params_downtime_escalation_period     protoreflect.FieldDescriptor
+	fd_Params_downtime_escalation_multiplier protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -731,6 +733,8 @@ func init() {
 	fd_Params_downtime_jail_duration = md_Params.Fields().ByName("downtime_jail_duration")
 	fd_Params_slash_fraction_double_sign = md_Params.Fields().ByName("slash_fraction_double_sign")
 	fd_Params_slash_fraction_downtime = md_Params.Fields().ByName("slash_fraction_downtime")
+	fd_Params_downtime_escalation_period = md_Params.Fields().ByName("downtime_escalation_period")
+	fd_Params_downtime_escalation_multiplier = md_Params.Fields().ByName("downtime_escalation_multiplier")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -828,6 +832,18 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.DowntimeEscalationPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.DowntimeEscalationPeriod.ProtoReflect())
+		if !f(fd_Params_downtime_escalation_period, value) {
+			return
+		}
+	}
+	if len(x.DowntimeEscalationMultiplier) != 0 {
+		value := protoreflect.ValueOfBytes(x.DowntimeEscalationMultiplier)
+		if !f(fd_Params_downtime_escalation_multiplier, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -853,6 +869,10 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.SlashFractionDoubleSign) != 0
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		return len(x.SlashFractionDowntime) != 0
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_period":
+		return x.DowntimeEscalationPeriod != nil
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_multiplier":
+		return len(x.DowntimeEscalationMultiplier) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -879,6 +899,10 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.SlashFractionDoubleSign = nil
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		x.SlashFractionDowntime = nil
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_period":
+		x.DowntimeEscalationPeriod = nil
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_multiplier":
+		x.DowntimeEscalationMultiplier = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -910,6 +934,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		value := x.SlashFractionDowntime
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_period":
+		value := x.DowntimeEscalationPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_multiplier":
+		value := x.DowntimeEscalationMultiplier
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -940,6 +970,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.SlashFractionDoubleSign = value.Bytes()
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		x.SlashFractionDowntime = value.Bytes()
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_period":
+		x.DowntimeEscalationPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_multiplier":
+		x.DowntimeEscalationMultiplier = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -965,6 +999,11 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.DowntimeJailDuration = new(durationpb.Duration)
 		}
 		return protoreflect.ValueOfMessage(x.DowntimeJailDuration.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_period":
+		if x.DowntimeEscalationPeriod == nil {
+			x.DowntimeEscalationPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DowntimeEscalationPeriod.ProtoReflect())
 	case "cosmos.slashing.v1beta1.Params.signed_blocks_window":
 		panic(fmt.Errorf("field signed_blocks_window of message cosmos.slashing.v1beta1.Params is not mutable"))
 	case "cosmos.slashing.v1beta1.Params.min_signed_per_window":
@@ -973,6 +1012,8 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field slash_fraction_double_sign of message cosmos.slashing.v1beta1.Params is not mutable"))
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		panic(fmt.Errorf("field slash_fraction_downtime of message cosmos.slashing.v1beta1.Params is not mutable"))
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_multiplier":
+		panic(fmt.Errorf("field downtime_escalation_multiplier of message cosmos.slashing.v1beta1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -997,6 +1038,11 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBytes(nil)
 	case "cosmos.slashing.v1beta1.Params.slash_fraction_downtime":
 		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.slashing.v1beta1.Params.downtime_escalation_multiplier":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.Params"))
@@ -1085,6 +1131,14 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.DowntimeEscalationPeriod != nil {
+			l = options.Size(x.DowntimeEscalationPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DowntimeEscalationMultiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1114,6 +1168,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.DowntimeEscalationMultiplier) > 0 {
+			i -= len(x.DowntimeEscalationMultiplier)
+			copy(dAtA[i:], x.DowntimeEscalationMultiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DowntimeEscalationMultiplier)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.DowntimeEscalationPeriod != nil {
+			encoded, err := options.Marshal(x.DowntimeEscalationPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
 		if len(x.SlashFractionDowntime) > 0 {
 			i -= len(x.SlashFractionDowntime)
 			copy(dAtA[i:], x.SlashFractionDowntime)
@@ -1360,6 +1435,76 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					x.SlashFractionDowntime = []byte{}
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DowntimeEscalationPeriod == nil {
+					x.DowntimeEscalationPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DowntimeEscalationPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationMultiplier", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DowntimeEscalationMultiplier = append(x.DowntimeEscalationMultiplier[:0], dAtA[iNdEx:postIndex]...)
+				if x.DowntimeEscalationMultiplier == nil {
+					x.DowntimeEscalationMultiplier = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1395,182 +1540,809 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/slashing/v1beta1/slashing.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// ValidatorSigningInfo defines a validator's signing info for monitoring their
-// liveness activity.
-type ValidatorSigningInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.List = (*_ValidatorDowntimeJails_2_list)(nil)
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// Height at which validator was first a candidate OR was unjailed
-	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// Index which is incremented each time the validator was a bonded
-	// in a block and may have signed a precommit or not. This in conjunction with the
-	// `SignedBlocksWindow` param determines the index in the `MissedBlocksBitArray`.
-	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
-	// Timestamp until which the validator is jailed due to liveness downtime.
-	JailedUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
-	// Whether or not a validator has been tombstoned (killed out of validator set). It is set
-	// once the validator commits an equivocation or for any other configured misbehiavor.
-	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
-	// A counter kept to avoid unnecessary array reads.
-	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
-	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+type _ValidatorDowntimeJails_2_list struct {
+	list *[]*timestamppb.Timestamp
 }
 
-func (x *ValidatorSigningInfo) Reset() {
-	*x = ValidatorSigningInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *_ValidatorDowntimeJails_2_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-func (x *ValidatorSigningInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_ValidatorDowntimeJails_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (*ValidatorSigningInfo) ProtoMessage() {}
-
-// Deprecated: Use ValidatorSigningInfo.ProtoReflect.Descriptor instead.
-func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
-	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{0}
+func (x *_ValidatorDowntimeJails_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*timestamppb.Timestamp)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *ValidatorSigningInfo) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+func (x *_ValidatorDowntimeJails_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*timestamppb.Timestamp)
+	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *ValidatorSigningInfo) GetStartHeight() int64 {
-	if x != nil {
-		return x.StartHeight
-	}
-	return 0
+func (x *_ValidatorDowntimeJails_2_list) AppendMutable() protoreflect.Value {
+	v := new(timestamppb.Timestamp)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *ValidatorSigningInfo) GetIndexOffset() int64 {
-	if x != nil {
-		return x.IndexOffset
+func (x *_ValidatorDowntimeJails_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	return 0
+	*x.list = (*x.list)[:n]
 }
 
-func (x *ValidatorSigningInfo) GetJailedUntil() *timestamppb.Timestamp {
-	if x != nil {
-		return x.JailedUntil
-	}
-	return nil
+func (x *_ValidatorDowntimeJails_2_list) NewElement() protoreflect.Value {
+	v := new(timestamppb.Timestamp)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *ValidatorSigningInfo) GetTombstoned() bool {
-	if x != nil {
-		return x.Tombstoned
-	}
-	return false
+func (x *_ValidatorDowntimeJails_2_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (x *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
-	if x != nil {
-		return x.MissedBlocksCounter
-	}
-	return 0
+var (
+	md_ValidatorDowntimeJails            protoreflect.MessageDescriptor
+	fd_ValidatorDowntimeJails_address    protoreflect.FieldDescriptor
+	fd_ValidatorDowntimeJails_jail_times protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_slashing_v1beta1_slashing_proto_init()
+	md_ValidatorDowntimeJails = File_cosmos_slashing_v1beta1_slashing_proto.Messages().ByName("ValidatorDowntimeJails")
+	fd_ValidatorDowntimeJails_address = md_ValidatorDowntimeJails.Fields().ByName("address")
+	fd_ValidatorDowntimeJails_jail_times = md_ValidatorDowntimeJails.Fields().ByName("jail_times")
 }
 
-// Params represents the parameters used for by the slashing module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _ protoreflect.Message = (*fastReflection_ValidatorDowntimeJails)(nil)
 
-	SignedBlocksWindow      int64                `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
-	MinSignedPerWindow      []byte               `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3" json:"min_signed_per_window,omitempty"`
-	DowntimeJailDuration    *durationpb.Duration `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty"`
-	SlashFractionDoubleSign []byte               `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3" json:"slash_fraction_double_sign,omitempty"`
-	SlashFractionDowntime   []byte               `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3" json:"slash_fraction_downtime,omitempty"`
+type fastReflection_ValidatorDowntimeJails ValidatorDowntimeJails
+
+func (x *ValidatorDowntimeJails) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ValidatorDowntimeJails)(x)
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[1]
+func (x *ValidatorDowntimeJails) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_ValidatorDowntimeJails_messageType fastReflection_ValidatorDowntimeJails_messageType
+var _ protoreflect.MessageType = fastReflection_ValidatorDowntimeJails_messageType{}
 
-func (*Params) ProtoMessage() {}
+type fastReflection_ValidatorDowntimeJails_messageType struct{}
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{1}
+func (x fastReflection_ValidatorDowntimeJails_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ValidatorDowntimeJails)(nil)
+}
+func (x fastReflection_ValidatorDowntimeJails_messageType) New() protoreflect.Message {
+	return new(fastReflection_ValidatorDowntimeJails)
+}
+func (x fastReflection_ValidatorDowntimeJails_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorDowntimeJails
 }
 
-func (x *Params) GetSignedBlocksWindow() int64 {
-	if x != nil {
-		return x.SignedBlocksWindow
-	}
-	return 0
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ValidatorDowntimeJails) Descriptor() protoreflect.MessageDescriptor {
+	return md_ValidatorDowntimeJails
 }
 
-func (x *Params) GetMinSignedPerWindow() []byte {
-	if x != nil {
-		return x.MinSignedPerWindow
-	}
-	return nil
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ValidatorDowntimeJails) Type() protoreflect.MessageType {
+	return _fastReflection_ValidatorDowntimeJails_messageType
 }
 
-func (x *Params) GetDowntimeJailDuration() *durationpb.Duration {
-	if x != nil {
-		return x.DowntimeJailDuration
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ValidatorDowntimeJails) New() protoreflect.Message {
+	return new(fastReflection_ValidatorDowntimeJails)
 }
 
-func (x *Params) GetSlashFractionDoubleSign() []byte {
-	if x != nil {
-		return x.SlashFractionDoubleSign
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ValidatorDowntimeJails) Interface() protoreflect.ProtoMessage {
+	return (*ValidatorDowntimeJails)(x)
 }
 
-func (x *Params) GetSlashFractionDowntime() []byte {
-	if x != nil {
-		return x.SlashFractionDowntime
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ValidatorDowntimeJails) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ValidatorDowntimeJails_address, value) {
+			return
+		}
+	}
+	if len(x.JailTimes) != 0 {
+		value := protoreflect.ValueOfList(&_ValidatorDowntimeJails_2_list{list: &x.JailTimes})
+		if !f(fd_ValidatorDowntimeJails_jail_times, value) {
+			return
+		}
 	}
-	return nil
 }
 
-var File_cosmos_slashing_v1beta1_slashing_proto protoreflect.FileDescriptor
-
-var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
-	0x0a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
-	0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69,
-	0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ValidatorDowntimeJails) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.address":
+		return x.Address != ""
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.jail_times":
+		return len(x.JailTimes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorDowntimeJails"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorDowntimeJails does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorDowntimeJails) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.address":
+		x.Address = ""
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.jail_times":
+		x.JailTimes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorDowntimeJails"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorDowntimeJails does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ValidatorDowntimeJails) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.jail_times":
+		if len(x.JailTimes) == 0 {
+			return protoreflect.ValueOfList(&_ValidatorDowntimeJails_2_list{})
+		}
+		listValue := &_ValidatorDowntimeJails_2_list{list: &x.JailTimes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorDowntimeJails"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorDowntimeJails does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorDowntimeJails) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.jail_times":
+		lv := value.List()
+		clv := lv.(*_ValidatorDowntimeJails_2_list)
+		x.JailTimes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorDowntimeJails"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorDowntimeJails does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorDowntimeJails) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.jail_times":
+		if x.JailTimes == nil {
+			x.JailTimes = []*timestamppb.Timestamp{}
+		}
+		value := &_ValidatorDowntimeJails_2_list{list: &x.JailTimes}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.address":
+		panic(fmt.Errorf("field address of message cosmos.slashing.v1beta1.ValidatorDowntimeJails is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorDowntimeJails"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorDowntimeJails does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ValidatorDowntimeJails) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.slashing.v1beta1.ValidatorDowntimeJails.jail_times":
+		list := []*timestamppb.Timestamp{}
+		return protoreflect.ValueOfList(&_ValidatorDowntimeJails_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.slashing.v1beta1.ValidatorDowntimeJails"))
+		}
+		panic(fmt.Errorf("message cosmos.slashing.v1beta1.ValidatorDowntimeJails does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ValidatorDowntimeJails) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.slashing.v1beta1.ValidatorDowntimeJails", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ValidatorDowntimeJails) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ValidatorDowntimeJails) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ValidatorDowntimeJails) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ValidatorDowntimeJails) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ValidatorDowntimeJails)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.JailTimes) > 0 {
+			for _, e := range x.JailTimes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorDowntimeJails)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.JailTimes) > 0 {
+			for iNdEx := len(x.JailTimes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.JailTimes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ValidatorDowntimeJails)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorDowntimeJails: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ValidatorDowntimeJails: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JailTimes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.JailTimes = append(x.JailTimes, &timestamppb.Timestamp{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.JailTimes[len(x.JailTimes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/slashing/v1beta1/slashing.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ValidatorSigningInfo defines a validator's signing info for monitoring their
+// liveness activity.
+type ValidatorSigningInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Height at which validator was first a candidate OR was unjailed
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// Index which is incremented each time the validator was a bonded
+	// in a block and may have signed a precommit or not. This in conjunction with the
+	// `SignedBlocksWindow` param determines the index in the `MissedBlocksBitArray`.
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// Timestamp until which the validator is jailed due to liveness downtime.
+	JailedUntil *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	// Whether or not a validator has been tombstoned (killed out of validator set). It is set
+	// once the validator commits an equivocation or for any other configured misbehiavor.
+	Tombstoned bool `protobuf:"varint,5,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// A counter kept to avoid unnecessary array reads.
+	// Note that `Sum(MissedBlocksBitArray)` always equals `MissedBlocksCounter`.
+	MissedBlocksCounter int64 `protobuf:"varint,6,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+}
+
+func (x *ValidatorSigningInfo) Reset() {
+	*x = ValidatorSigningInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorSigningInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorSigningInfo) ProtoMessage() {}
+
+// Deprecated: Use ValidatorSigningInfo.ProtoReflect.Descriptor instead.
+func (*ValidatorSigningInfo) Descriptor() ([]byte, []int) {
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ValidatorSigningInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorSigningInfo) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetIndexOffset() int64 {
+	if x != nil {
+		return x.IndexOffset
+	}
+	return 0
+}
+
+func (x *ValidatorSigningInfo) GetJailedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JailedUntil
+	}
+	return nil
+}
+
+func (x *ValidatorSigningInfo) GetTombstoned() bool {
+	if x != nil {
+		return x.Tombstoned
+	}
+	return false
+}
+
+func (x *ValidatorSigningInfo) GetMissedBlocksCounter() int64 {
+	if x != nil {
+		return x.MissedBlocksCounter
+	}
+	return 0
+}
+
+// Params represents the parameters used for by the slashing module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedBlocksWindow      int64                `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	MinSignedPerWindow      []byte               `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3" json:"min_signed_per_window,omitempty"`
+	DowntimeJailDuration    *durationpb.Duration `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3" json:"downtime_jail_duration,omitempty"`
+	SlashFractionDoubleSign []byte               `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3" json:"slash_fraction_double_sign,omitempty"`
+	SlashFractionDowntime   []byte               `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3" json:"slash_fraction_downtime,omitempty"`
+	// downtime_escalation_period is the period during which previous downtime
+	// jails of a validator escalate its downtime penalties. A zero period
+	// disables the escalation.
+	DowntimeEscalationPeriod *durationpb.Duration `protobuf:"bytes,6,opt,name=downtime_escalation_period,json=downtimeEscalationPeriod,proto3" json:"downtime_escalation_period,omitempty"`
+	// downtime_escalation_multiplier is the factor by which the downtime slash
+	// fraction and jail duration are multiplied for each previous downtime jail
+	// of the validator within the escalation period.
+	DowntimeEscalationMultiplier []byte `protobuf:"bytes,7,opt,name=downtime_escalation_multiplier,json=downtimeEscalationMultiplier,proto3" json:"downtime_escalation_multiplier,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Params) GetSignedBlocksWindow() int64 {
+	if x != nil {
+		return x.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMinSignedPerWindow() []byte {
+	if x != nil {
+		return x.MinSignedPerWindow
+	}
+	return nil
+}
+
+func (x *Params) GetDowntimeJailDuration() *durationpb.Duration {
+	if x != nil {
+		return x.DowntimeJailDuration
+	}
+	return nil
+}
+
+func (x *Params) GetSlashFractionDoubleSign() []byte {
+	if x != nil {
+		return x.SlashFractionDoubleSign
+	}
+	return nil
+}
+
+func (x *Params) GetSlashFractionDowntime() []byte {
+	if x != nil {
+		return x.SlashFractionDowntime
+	}
+	return nil
+}
+
+func (x *Params) GetDowntimeEscalationPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.DowntimeEscalationPeriod
+	}
+	return nil
+}
+
+func (x *Params) GetDowntimeEscalationMultiplier() []byte {
+	if x != nil {
+		return x.DowntimeEscalationMultiplier
+	}
+	return nil
+}
+
+// ValidatorDowntimeJails defines the times at which a validator was jailed for
+// downtime within the downtime escalation period.
+type ValidatorDowntimeJails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the validator consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// jail_times are the times of the downtime jails, in ascending order.
+	JailTimes []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=jail_times,json=jailTimes,proto3" json:"jail_times,omitempty"`
+}
+
+func (x *ValidatorDowntimeJails) Reset() {
+	*x = ValidatorDowntimeJails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorDowntimeJails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorDowntimeJails) ProtoMessage() {}
+
+// Deprecated: Use ValidatorDowntimeJails.ProtoReflect.Descriptor instead.
+func (*ValidatorDowntimeJails) Descriptor() ([]byte, []int) {
+	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ValidatorDowntimeJails) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ValidatorDowntimeJails) GetJailTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.JailTimes
+	}
+	return nil
+}
+
+var File_cosmos_slashing_v1beta1_slashing_proto protoreflect.FileDescriptor
+
+var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x67, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
@@ -1597,7 +2369,7 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
 	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x42, 0x6c,
 	0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x3a, 0x08, 0x98, 0xa0, 0x1f,
-	0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0x84, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x00, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xe7, 0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x12, 0x30, 0x0a, 0x14, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
 	0x73, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
 	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x57, 0x69, 0x6e, 0x64,
@@ -1627,9 +2399,33 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
 	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0,
 	0x2a, 0x01, 0x52, 0x15, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x73, 0x6c, 0x61,
-	0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xe8, 0x01, 0x0a,
+	0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x66, 0x0a, 0x1a, 0x64, 0x6f, 0x77,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xc8, 0xde, 0x1f, 0x00, 0x98, 0xdf,
+	0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x18, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x79, 0x0a, 0x1e, 0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x73,
+	0x63, 0x61, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x33, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1c,
+	0x64, 0x6f, 0x77, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x3a, 0x21, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f,
+	0x73, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
+	0x96, 0x01, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x6f, 0x77,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4a, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48,
+	0x0a, 0x0a, 0x6a, 0x61, 0x69, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d,
+	0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x6a,
+	0x61, 0x69, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x42, 0xe8, 0x01, 0xa8, 0xe2, 0x1e, 0x01, 0x0a,
 	0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x73, 0x6c, 0x61, 0x73,
 	0x68, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0d, 0x53, 0x6c,
 	0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x38, 0x63,
@@ -1644,7 +2440,7 @@ var file_cosmos_slashing_v1beta1_slashing_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
 	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x3a, 0x3a, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0xa8, 0xe2, 0x1e, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1659,21 +2455,24 @@ func file_cosmos_slashing_v1beta1_slashing_proto_rawDescGZIP() []byte {
 	return file_cosmos_slashing_v1beta1_slashing_proto_rawDescData
 }
 
-var file_cosmos_slashing_v1beta1_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cosmos_slashing_v1beta1_slashing_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_cosmos_slashing_v1beta1_slashing_proto_goTypes = []interface{}{
-	(*ValidatorSigningInfo)(nil),  // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo
-	(*Params)(nil),                // 1: cosmos.slashing.v1beta1.Params
-	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+	(*ValidatorSigningInfo)(nil),   // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo
+	(*Params)(nil),                 // 1: cosmos.slashing.v1beta1.Params
+	(*ValidatorDowntimeJails)(nil), // 2: cosmos.slashing.v1beta1.ValidatorDowntimeJails
+	(*timestamppb.Timestamp)(nil),  // 3: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 4: google.protobuf.Duration
 }
 var file_cosmos_slashing_v1beta1_slashing_proto_depIdxs = []int32{
-	2, // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
-	3, // 1: cosmos.slashing.v1beta1.Params.downtime_jail_duration:type_name -> google.protobuf.Duration
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: cosmos.slashing.v1beta1.ValidatorSigningInfo.jailed_until:type_name -> google.protobuf.Timestamp
+	4, // 1: cosmos.slashing.v1beta1.Params.downtime_jail_duration:type_name -> google.protobuf.Duration
+	4, // 2: cosmos.slashing.v1beta1.Params.downtime_escalation_period:type_name -> google.protobuf.Duration
+	3, // 3: cosmos.slashing.v1beta1.ValidatorDowntimeJails.jail_times:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_slashing_v1beta1_slashing_proto_init() }
@@ -1706,6 +2505,18 @@ func file_cosmos_slashing_v1beta1_slashing_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_slashing_v1beta1_slashing_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorDowntimeJails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1713,7 +2524,7 @@ func file_cosmos_slashing_v1beta1_slashing_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_slashing_v1beta1_slashing_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
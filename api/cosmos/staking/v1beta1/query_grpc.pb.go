@@ -33,6 +33,7 @@ const (
 	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
 	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
 	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_ConsPubKeyRotationHistory_FullMethodName     = "/cosmos.staking.v1beta1.Query/ConsPubKeyRotationHistory"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +93,9 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ConsPubKeyRotationHistory queries the consensus public key rotations
+	// performed by a validator.
+	ConsPubKeyRotationHistory(ctx context.Context, in *QueryConsPubKeyRotationHistoryRequest, opts ...grpc.CallOption) (*QueryConsPubKeyRotationHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +232,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ConsPubKeyRotationHistory(ctx context.Context, in *QueryConsPubKeyRotationHistoryRequest, opts ...grpc.CallOption) (*QueryConsPubKeyRotationHistoryResponse, error) {
+	out := new(QueryConsPubKeyRotationHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ConsPubKeyRotationHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +298,9 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ConsPubKeyRotationHistory queries the consensus public key rotations
+	// performed by a validator.
+	ConsPubKeyRotationHistory(context.Context, *QueryConsPubKeyRotationHistoryRequest) (*QueryConsPubKeyRotationHistoryResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +350,9 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) ConsPubKeyRotationHistory(context.Context, *QueryConsPubKeyRotationHistoryRequest) (*QueryConsPubKeyRotationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsPubKeyRotationHistory not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,6 +618,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConsPubKeyRotationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsPubKeyRotationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsPubKeyRotationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ConsPubKeyRotationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsPubKeyRotationHistory(ctx, req.(*QueryConsPubKeyRotationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +699,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ConsPubKeyRotationHistory",
+			Handler:    _Query_ConsPubKeyRotationHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
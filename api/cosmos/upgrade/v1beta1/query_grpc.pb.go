@@ -24,6 +24,7 @@ const (
 	Query_UpgradedConsensusState_FullMethodName = "/cosmos.upgrade.v1beta1.Query/UpgradedConsensusState"
 	Query_ModuleVersions_FullMethodName         = "/cosmos.upgrade.v1beta1.Query/ModuleVersions"
 	Query_Authority_FullMethodName              = "/cosmos.upgrade.v1beta1.Query/Authority"
+	Query_UpgradeInfo_FullMethodName            = "/cosmos.upgrade.v1beta1.Query/UpgradeInfo"
 )
 
 // QueryClient is the client API for Query service.
@@ -50,6 +51,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// UpgradeInfo queries the structured info of an upgrade plan by its name, for
+	// the binaries of the upgrade to be verified before its height.
+	UpgradeInfo(ctx context.Context, in *QueryUpgradeInfoRequest, opts ...grpc.CallOption) (*QueryUpgradeInfoResponse, error)
 }
 
 type queryClient struct {
@@ -106,6 +110,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) UpgradeInfo(ctx context.Context, in *QueryUpgradeInfoRequest, opts ...grpc.CallOption) (*QueryUpgradeInfoResponse, error) {
+	out := new(QueryUpgradeInfoResponse)
+	err := c.cc.Invoke(ctx, Query_UpgradeInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -130,6 +143,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// UpgradeInfo queries the structured info of an upgrade plan by its name, for
+	// the binaries of the upgrade to be verified before its height.
+	UpgradeInfo(context.Context, *QueryUpgradeInfoRequest) (*QueryUpgradeInfoResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -152,6 +168,9 @@ func (UnimplementedQueryServer) ModuleVersions(context.Context, *QueryModuleVers
 func (UnimplementedQueryServer) Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (UnimplementedQueryServer) UpgradeInfo(context.Context, *QueryUpgradeInfoRequest) (*QueryUpgradeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeInfo not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -255,6 +274,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UpgradeInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeInfo(ctx, req.(*QueryUpgradeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -282,6 +319,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "UpgradeInfo",
+			Handler:    _Query_UpgradeInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -22,6 +22,10 @@ const (
 	Msg_CreateVestingAccount_FullMethodName         = "/cosmos.vesting.v1beta1.Msg/CreateVestingAccount"
 	Msg_CreatePermanentLockedAccount_FullMethodName = "/cosmos.vesting.v1beta1.Msg/CreatePermanentLockedAccount"
 	Msg_CreatePeriodicVestingAccount_FullMethodName = "/cosmos.vesting.v1beta1.Msg/CreatePeriodicVestingAccount"
+	Msg_CreateVestingGrant_FullMethodName           = "/cosmos.vesting.v1beta1.Msg/CreateVestingGrant"
+	Msg_AcceptVestingGrants_FullMethodName          = "/cosmos.vesting.v1beta1.Msg/AcceptVestingGrants"
+	Msg_CreateClawbackVestingAccount_FullMethodName = "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount"
+	Msg_Clawback_FullMethodName                     = "/cosmos.vesting.v1beta1.Msg/Clawback"
 )
 
 // MsgClient is the client API for Msg service.
@@ -41,6 +45,22 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// CreateVestingGrant defines a method that adds a clawback-able vesting grant
+	// to an account. The account is created if it doesn't exist yet, an existing
+	// account must have accepted vesting grants first.
+	CreateVestingGrant(ctx context.Context, in *MsgCreateVestingGrant, opts ...grpc.CallOption) (*MsgCreateVestingGrantResponse, error)
+	// AcceptVestingGrants defines a method that enables the owner of a plain
+	// account to convert it into a GrantVestingAccount, consenting to receive
+	// vesting grants.
+	AcceptVestingGrants(ctx context.Context, in *MsgAcceptVestingGrants, opts ...grpc.CallOption) (*MsgAcceptVestingGrantsResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// vesting account with separate lockup and vesting schedules, whose unvested
+	// coins can be clawed back by the funder.
+	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback defines a method that enables a funder to claw back the coins
+	// of a vesting account which have not vested yet. Delegated unvested coins
+	// are force-unbonded to the destination address.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
 }
 
 type msgClient struct {
@@ -78,6 +98,42 @@ func (c *msgClient) CreatePeriodicVestingAccount(ctx context.Context, in *MsgCre
 	return out, nil
 }
 
+func (c *msgClient) CreateVestingGrant(ctx context.Context, in *MsgCreateVestingGrant, opts ...grpc.CallOption) (*MsgCreateVestingGrantResponse, error) {
+	out := new(MsgCreateVestingGrantResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateVestingGrant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptVestingGrants(ctx context.Context, in *MsgAcceptVestingGrants, opts ...grpc.CallOption) (*MsgAcceptVestingGrantsResponse, error) {
+	out := new(MsgAcceptVestingGrantsResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptVestingGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error) {
+	out := new(MsgCreateClawbackVestingAccountResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateClawbackVestingAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, Msg_Clawback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -95,6 +151,22 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// CreateVestingGrant defines a method that adds a clawback-able vesting grant
+	// to an account. The account is created if it doesn't exist yet, an existing
+	// account must have accepted vesting grants first.
+	CreateVestingGrant(context.Context, *MsgCreateVestingGrant) (*MsgCreateVestingGrantResponse, error)
+	// AcceptVestingGrants defines a method that enables the owner of a plain
+	// account to convert it into a GrantVestingAccount, consenting to receive
+	// vesting grants.
+	AcceptVestingGrants(context.Context, *MsgAcceptVestingGrants) (*MsgAcceptVestingGrantsResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// vesting account with separate lockup and vesting schedules, whose unvested
+	// coins can be clawed back by the funder.
+	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback defines a method that enables a funder to claw back the coins
+	// of a vesting account which have not vested yet. Delegated unvested coins
+	// are force-unbonded to the destination address.
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -111,6 +183,18 @@ func (UnimplementedMsgServer) CreatePermanentLockedAccount(context.Context, *Msg
 func (UnimplementedMsgServer) CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePeriodicVestingAccount not implemented")
 }
+func (UnimplementedMsgServer) CreateVestingGrant(context.Context, *MsgCreateVestingGrant) (*MsgCreateVestingGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVestingGrant not implemented")
+}
+func (UnimplementedMsgServer) AcceptVestingGrants(context.Context, *MsgAcceptVestingGrants) (*MsgAcceptVestingGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptVestingGrants not implemented")
+}
+func (UnimplementedMsgServer) CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClawbackVestingAccount not implemented")
+}
+func (UnimplementedMsgServer) Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -178,6 +262,78 @@ func _Msg_CreatePeriodicVestingAccount_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateVestingGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVestingGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVestingGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateVestingGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVestingGrant(ctx, req.(*MsgCreateVestingGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptVestingGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptVestingGrants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptVestingGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptVestingGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptVestingGrants(ctx, req.(*MsgAcceptVestingGrants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateClawbackVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClawbackVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateClawbackVestingAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, req.(*MsgCreateClawbackVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Clawback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -197,6 +353,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePeriodicVestingAccount",
 			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
 		},
+		{
+			MethodName: "CreateVestingGrant",
+			Handler:    _Msg_CreateVestingGrant_Handler,
+		},
+		{
+			MethodName: "AcceptVestingGrants",
+			Handler:    _Msg_AcceptVestingGrants_Handler,
+		},
+		{
+			MethodName: "CreateClawbackVestingAccount",
+			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/vesting/v1beta1/tx.proto",
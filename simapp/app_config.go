@@ -100,8 +100,10 @@ var (
 					// CanWithdrawInvariant invariant.
 					// NOTE: staking module is required if HistoricalEntries param > 0
 					// NOTE: capability module's beginblocker must come before any modules using capabilities (e.g. IBC)
+					// NOTE: gov module's beginblocker must come before any modules whose params updates can be scheduled
 					BeginBlockers: []string{
 						upgradetypes.ModuleName,
+						govtypes.ModuleName,
 						capabilitytypes.ModuleName,
 						minttypes.ModuleName,
 						distrtypes.ModuleName,
@@ -110,7 +112,6 @@ var (
 						stakingtypes.ModuleName,
 						authtypes.ModuleName,
 						banktypes.ModuleName,
-						govtypes.ModuleName,
 						crisistypes.ModuleName,
 						genutiltypes.ModuleName,
 						authz.ModuleName,
@@ -27,7 +27,19 @@ import (
 // v0.46.x to v0.47.x.
 const UpgradeName = "v046-to-v047"
 
+// MinRequirementsUpgradeName defines the on-chain upgrade name for the x/staking
+// minimum self delegation param and the grace period of validators below the
+// minimum requirements.
+const MinRequirementsUpgradeName = "staking-min-requirements"
+
+// AppVersion defines the app version of the SimApp binary, which a new chain
+// starts at. The app version is then bumped by every applied upgrade, the
+// binary of an upgrade should thus bump it too.
+const AppVersion = 1
+
 func (app SimApp) RegisterUpgradeHandlers() {
+	app.SetProtocolVersion(AppVersion)
+
 	// Set param key table for params module migration
 	for _, subspace := range app.ParamsKeeper.GetSubspaces() {
 		subspace := subspace
@@ -72,6 +84,18 @@ func (app SimApp) RegisterUpgradeHandlers() {
 		},
 	)
 
+	app.UpgradeKeeper.SetUpgradeHandler(
+		MinRequirementsUpgradeName,
+		func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// The x/staking v5 to v6 migration sets the default minimum self
+			// delegation and grace period, and starts the grace period for
+			// validators below the minimum requirements. Chains raising the
+			// minimums as part of the upgrade should update the params after
+			// the migrations and call ScheduleMinRequirementsEnforcement again.
+			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+		},
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
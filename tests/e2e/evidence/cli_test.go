@@ -14,6 +14,8 @@ import (
 
 func TestE2ETestSuite(t *testing.T) {
 	cfg := network.DefaultConfig(simapp.NewTestNetworkFixture)
-	cfg.NumValidators = 1
+	// a validator is tombstoned by the light client attack evidence, the
+	// remaining validators must hold more than 2/3 of the voting power
+	cfg.NumValidators = 4
 	suite.Run(t, NewE2ETestSuite(cfg))
 }
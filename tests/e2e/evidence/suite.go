@@ -1,13 +1,26 @@
 package evidence
 
 import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/evidence/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/evidence/testutil"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 type E2ETestSuite struct {
@@ -74,3 +87,68 @@ func (s *E2ETestSuite) TestGetQueryCmd() {
 		})
 	}
 }
+
+func (s *E2ETestSuite) TestSubmitLightClientAttackEvidence() {
+	val := s.network.Validators[0]
+
+	// the committed header of the latest block
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err)
+	commit, err := val.RPCClient.Commit(context.Background(), &height)
+	s.Require().NoError(err)
+
+	// another validator who signed the committed header, the commit only
+	// needs the signatures of two thirds of the validators
+	var attacker *network.Validator
+	for _, v := range s.network.Validators[1:] {
+		for _, sig := range commit.SignedHeader.Commit.Signatures {
+			if sig.ForBlock() && bytes.Equal(sig.ValidatorAddress, v.PubKey.Address()) {
+				attacker = v
+			}
+		}
+	}
+	s.Require().NotNil(attacker)
+
+	// the attacker signs a header conflicting with the committed one in the
+	// same round
+	pv := privval.LoadFilePVEmptyState(attacker.Ctx.Config.PrivValidatorKeyFile(), "")
+	consKey := &ed25519.PrivKey{Key: pv.Key.PrivKey.Bytes()}
+	conflicting, err := testutil.ConflictingSignedHeader(*commit.SignedHeader.Header.ToProto(), commit.SignedHeader.Commit.Round, consKey)
+	s.Require().NoError(err)
+
+	signedHeader, err := tmtypes.SignedHeaderFromProto(conflicting)
+	s.Require().NoError(err)
+	bz, err := tmjson.Marshal(signedHeader)
+	s.Require().NoError(err)
+	signedHeaderFile := filepath.Join(s.T().TempDir(), "signed_header.json")
+	s.Require().NoError(os.WriteFile(signedHeaderFile, bz, 0o600))
+
+	bz, err = tmjson.Marshal(commit.SignedHeader.Commit)
+	s.Require().NoError(err)
+	trustedCommitFile := filepath.Join(s.T().TempDir(), "trusted_commit.json")
+	s.Require().NoError(os.WriteFile(trustedCommitFile, bz, 0o600))
+
+	args := []string{
+		"submit", "light-client-attack", signedHeaderFile, trustedCommitFile,
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetTxCmd(nil), args)
+	s.Require().NoError(err)
+
+	var txResp sdk.TxResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().NoError(clitestutil.CheckTxCode(s.network, val.ClientCtx, txResp.TxHash, 0))
+
+	// the signer of the conflicting header is tombstoned
+	consAddr := sdk.ConsAddress(consKey.PubKey().Address())
+	res, err := slashingtypes.NewQueryClient(val.ClientCtx).SigningInfo(
+		context.Background(),
+		&slashingtypes.QuerySigningInfoRequest{ConsAddress: consAddr.String()},
+	)
+	s.Require().NoError(err)
+	s.Require().True(res.ValSigningInfo.Tombstoned)
+}
@@ -65,16 +65,20 @@ func (s *E2ETestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", flags.FlagOutput)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","inflation_curve":"INFLATION_CURVE_BONDED_RATIO","halving_interval":"25246080","emission_schedule":[],"max_supply":"0"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", flags.FlagOutput)},
 			`blocks_per_year: "6311520"
+emission_schedule: []
 goal_bonded: "0.670000000000000000"
+halving_interval: "25246080"
+inflation_curve: INFLATION_CURVE_BONDED_RATIO
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
 inflation_rate_change: "0.130000000000000000"
+max_supply: "0"
 mint_denom: stake`,
 		},
 	}
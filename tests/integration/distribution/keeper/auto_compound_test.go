@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+)
+
+func TestAutoCompound(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&accountKeeper,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	queryClient := keeper.NewQuerier(distrKeeper)
+
+	addr := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addr)
+	tstaking := stakingtestutil.NewHelper(t, ctx, stakingKeeper)
+
+	// set module account coins
+	distrAcc := distrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000000000)))))
+	accountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create a validator without commission and two delegators
+	tstaking.Commission = stakingtestutil.ZeroCommission()
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk0, 100, true)
+	delegators := addr[1:]
+	for _, del := range delegators {
+		tstaking.DelegateWithPower(del, valAddrs[0], 100)
+	}
+
+	// end block to bond validator
+	staking.EndBlocker(ctx, stakingKeeper)
+
+	// enabling auto-compounding fails while it is disabled for the chain
+	params := distrKeeper.GetParams(ctx)
+	params.AutoCompoundInterval = 0
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(delegators[0], true))
+	require.ErrorIs(t, err, types.ErrAutoCompoundDisabled)
+
+	params.AutoCompoundInterval = 10
+	params.AutoCompoundBatchSize = 1
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	for _, del := range delegators {
+		_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(del, true))
+		require.NoError(t, err)
+	}
+
+	// delegators are compounded in store order
+	delegators = distrKeeper.GetAllAutoCompoundDelegators(ctx)
+	require.Len(t, delegators, 2)
+
+	// allocate some rewards
+	ctx = ctx.WithBlockHeight(1)
+	val := stakingKeeper.Validator(ctx, valAddrs[0])
+	rewards := stakingKeeper.TokensFromConsensusPower(ctx, 30)
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, rewards)})
+
+	res, err := queryClient.AutoCompound(ctx, &types.QueryAutoCompoundRequest{DelegatorAddress: delegators[1].String()})
+	require.NoError(t, err)
+	require.True(t, res.Enabled)
+	require.Equal(t, int64(10), res.NextRunHeight)
+
+	// nothing happens before the round starts
+	balances := bankKeeper.GetAllBalances(ctx, delegators[0])
+	distrKeeper.AutoCompound(ctx)
+	require.Nil(t, distrKeeper.GetAutoCompoundCursor(ctx))
+
+	// the first batch compounds the rewards of the first delegator
+	ctx = ctx.WithBlockHeight(10)
+	distrKeeper.AutoCompound(ctx)
+	require.Equal(t, delegators[0], distrKeeper.GetAutoCompoundCursor(ctx))
+	require.Equal(t, balances, bankKeeper.GetAllBalances(ctx, delegators[0]))
+	delegation, found := stakingKeeper.GetDelegation(ctx, delegators[0], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, stakingKeeper.TokensFromConsensusPower(ctx, 110), val.TokensFromShares(delegation.Shares).TruncateInt())
+
+	delegation, found = stakingKeeper.GetDelegation(ctx, delegators[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, stakingKeeper.TokensFromConsensusPower(ctx, 100), val.TokensFromShares(delegation.Shares).TruncateInt())
+	require.Equal(t, int64(11), distrKeeper.NextAutoCompoundHeight(ctx, delegators[1]))
+	require.Equal(t, int64(20), distrKeeper.NextAutoCompoundHeight(ctx, delegators[0]))
+
+	// the second batch finishes the round
+	ctx = ctx.WithBlockHeight(11)
+	distrKeeper.AutoCompound(ctx)
+	require.Nil(t, distrKeeper.GetAutoCompoundCursor(ctx))
+	delegation, found = stakingKeeper.GetDelegation(ctx, delegators[1], valAddrs[0])
+	require.True(t, found)
+	require.True(t, delegation.Shares.GT(sdk.NewDecFromInt(stakingKeeper.TokensFromConsensusPower(ctx, 100))))
+
+	// delegators exceeding the gas limit are skipped without state changes
+	params.AutoCompoundBatchSize = 10
+	params.AutoCompoundMaxGas = 1
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[0]), sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, rewards)})
+	delegation, _ = stakingKeeper.GetDelegation(ctx, delegators[0], valAddrs[0])
+
+	ctx = ctx.WithBlockHeight(20)
+	distrKeeper.AutoCompound(ctx)
+	require.Equal(t, delegators[0], distrKeeper.GetAutoCompoundCursor(ctx))
+	newDelegation, _ := stakingKeeper.GetDelegation(ctx, delegators[0], valAddrs[0])
+	require.Equal(t, delegation, newDelegation)
+
+	ctx = ctx.WithBlockHeight(21)
+	distrKeeper.AutoCompound(ctx)
+	require.Nil(t, distrKeeper.GetAutoCompoundCursor(ctx))
+
+	// disabling auto-compounding removes the delegator
+	_, err = msgServer.SetAutoCompound(ctx, types.NewMsgSetAutoCompound(delegators[0], false))
+	require.NoError(t, err)
+	require.False(t, distrKeeper.HasAutoCompound(ctx, delegators[0]))
+	require.Equal(t, int64(0), distrKeeper.NextAutoCompoundHeight(ctx, delegators[0]))
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+func TestContinuousFunds(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, Time: time.Now()})
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	queryClient := keeper.NewQuerier(distrKeeper)
+	authority := distrKeeper.GetAuthority()
+
+	addr := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(1000000000))
+	poolCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(t, distrKeeper.FundCommunityPool(ctx, poolCoins, addr[0]))
+	distrKeeper.SetFeePool(ctx, types.FeePool{CommunityPool: sdk.NewDecCoinsFromCoins(poolCoins...)})
+
+	stake := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) sdk.Int {
+		return bankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+	initialBalance := balance(ctx, addr[1])
+
+	// only the authority can create funds
+	msg := &types.MsgCreateContinuousFund{
+		Authority:   addr[0].String(),
+		Recipient:   addr[1].String(),
+		Percentage:  sdk.ZeroDec(),
+		Amount:      stake(100),
+		EpochBlocks: 2,
+		Cap:         stake(250),
+	}
+	_, err = msgServer.CreateContinuousFund(ctx, msg)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	msg.Authority = authority
+	res, err := msgServer.CreateContinuousFund(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+
+	// a fixed amount is paid every epoch until the cap is reached
+	for _, tc := range []struct{ height, paid int64 }{{11, 0}, {12, 100}, {13, 100}, {14, 200}} {
+		distrKeeper.PayContinuousFunds(ctx.WithBlockHeight(tc.height))
+		fund, found := distrKeeper.GetContinuousFund(ctx, res.Id)
+		require.True(t, found)
+		require.True(t, stake(tc.paid).IsEqual(fund.Paid), "height %d", tc.height)
+	}
+	ctx = ctx.WithBlockHeight(16)
+	distrKeeper.PayContinuousFunds(ctx)
+	_, found := distrKeeper.GetContinuousFund(ctx, res.Id)
+	require.False(t, found)
+	require.Equal(t, initialBalance.AddRaw(250), balance(ctx, addr[1]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(stake(750)...), distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// a percentage of the pool is paid every block until the fund expires
+	expiry := ctx.BlockTime().Add(time.Hour)
+	res, err = msgServer.CreateContinuousFund(ctx, &types.MsgCreateContinuousFund{
+		Authority:   authority,
+		Recipient:   addr[2].String(),
+		Percentage:  sdk.NewDecWithPrec(1, 1),
+		EpochBlocks: 1,
+		Expiry:      &expiry,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Id)
+
+	ctx = ctx.WithBlockHeight(17)
+	distrKeeper.PayContinuousFunds(ctx)
+	fund, found := distrKeeper.GetContinuousFund(ctx, res.Id)
+	require.True(t, found)
+	require.Equal(t, stake(75), fund.Paid)
+
+	queryRes, err := queryClient.ContinuousFunds(ctx, &types.QueryContinuousFundsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ContinuousFund{fund}, queryRes.Funds)
+
+	ctx = ctx.WithBlockHeight(18).WithBlockTime(expiry)
+	distrKeeper.PayContinuousFunds(ctx)
+	_, found = distrKeeper.GetContinuousFund(ctx, res.Id)
+	require.False(t, found)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(stake(675)...), distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// only the available coins are paid out when the pool runs dry
+	res, err = msgServer.CreateContinuousFund(ctx, &types.MsgCreateContinuousFund{
+		Authority:   authority,
+		Recipient:   addr[2].String(),
+		Amount:      stake(1000),
+		EpochBlocks: 1,
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(19)
+	distrKeeper.PayContinuousFunds(ctx)
+	fund, found = distrKeeper.GetContinuousFund(ctx, res.Id)
+	require.True(t, found)
+	require.Equal(t, stake(675), fund.Paid)
+	require.True(t, distrKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+
+	ctx = ctx.WithBlockHeight(20)
+	distrKeeper.PayContinuousFunds(ctx)
+	fund, _ = distrKeeper.GetContinuousFund(ctx, res.Id)
+	require.Equal(t, stake(675), fund.Paid)
+
+	// cancelling returns the total paid out amount
+	cancelRes, err := msgServer.CancelContinuousFund(ctx, &types.MsgCancelContinuousFund{Authority: authority, Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, stake(675), cancelRes.Paid)
+
+	_, err = msgServer.CancelContinuousFund(ctx, &types.MsgCancelContinuousFund{Authority: authority, Id: res.Id})
+	require.ErrorIs(t, err, types.ErrContinuousFundNotFound)
+
+	_, err = queryClient.ContinuousFund(ctx, &types.QueryContinuousFundRequest{Id: res.Id})
+	require.Error(t, err)
+
+	genesis := distrKeeper.ExportGenesis(ctx)
+	require.Empty(t, genesis.ContinuousFunds)
+	require.Equal(t, uint64(4), genesis.NextContinuousFundId)
+}
@@ -6,9 +6,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/version"
 	"gotest.tools/v3/assert"
 
+	"cosmossdk.io/math"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -27,6 +32,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtestutil "github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -193,6 +199,96 @@ func TestHandleDoubleSign_TooOld(t *testing.T) {
 	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())) == false)
 }
 
+func TestHandleLightClientAttack(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.ctx.WithIsCheckTx(false).WithChainID("test-chain").
+		WithBlockHeader(tmproto.Header{
+			Version:         tmversion.Consensus{Block: version.BlockProtocol},
+			ChainID:         "test-chain",
+			Height:          1,
+			Time:            time.Now().UTC(),
+			ValidatorsHash:  tmhash.Sum([]byte("validators hash")),
+			ProposerAddress: tmhash.SumTruncated([]byte("proposer")),
+		})
+	populateValidators(t, f)
+
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(t, ctx, f.stakingKeeper)
+
+	consKeys := make([]cryptotypes.PrivKey, len(valAddresses))
+	for i, operatorAddr := range valAddresses {
+		consKeys[i] = ed25519.GenPrivKey()
+		tstaking.CreateValidatorWithValPower(operatorAddr, consKeys[i].PubKey(), power, true)
+	}
+
+	// execute end-blocker and track the header of the block with its validator set
+	staking.EndBlocker(ctx, f.stakingKeeper)
+	f.stakingKeeper.TrackHistoricalInfo(ctx)
+	historicalInfo, found := f.stakingKeeper.GetHistoricalInfo(ctx, 1)
+	assert.Assert(t, found)
+
+	// handle a signature to set signing info
+	for _, consKey := range consKeys {
+		f.slashingKeeper.HandleValidatorSignature(ctx, consKey.PubKey().Address(), power, true)
+	}
+
+	oldTokens := make([]math.Int, len(valAddresses))
+	for i, operatorAddr := range valAddresses {
+		oldTokens[i] = f.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	}
+
+	// the first validator signs both the committed header and a conflicting
+	// one in the same round, the second one only signs the conflicting header
+	// and the third one only the committed header
+	trustedCommit, err := testutil.TrustedCommit(historicalInfo.Header, 0, consKeys[0], consKeys[2])
+	assert.NilError(t, err)
+	signedHeader, err := testutil.ConflictingSignedHeader(historicalInfo.Header, 0, consKeys[0], consKeys[1])
+	assert.NilError(t, err)
+
+	ctx = ctx.WithBlockHeight(2)
+	msg, err := types.NewMsgSubmitEvidence(sdk.AccAddress(valAddresses[2]), &types.LightClientAttackEvidence{
+		ConflictingHeader: signedHeader,
+		TrustedCommit:     trustedCommit,
+	})
+	assert.NilError(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(f.evidenceKeeper)
+	_, err = msgServer.SubmitEvidence(sdk.WrapSDKContext(ctx), msg)
+	assert.NilError(t, err)
+
+	// the validator who signed both blocks should be jailed, tombstoned and
+	// slashed
+	validator := f.stakingKeeper.Validator(ctx, valAddresses[0])
+	assert.Assert(t, validator.IsJailed())
+	assert.Assert(t, f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(consKeys[0].PubKey().Address())))
+	assert.Assert(t, validator.GetTokens().LT(oldTokens[0]))
+
+	// the validators who signed a single block are not affected
+	for i := 1; i < 3; i++ {
+		validator := f.stakingKeeper.Validator(ctx, valAddresses[i])
+		assert.Assert(t, !validator.IsJailed())
+		assert.Assert(t, !f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(consKeys[i].PubKey().Address())))
+		assert.Assert(t, validator.GetTokens().Equal(oldTokens[i]))
+	}
+
+	// submit duplicate evidence
+	_, err = msgServer.SubmitEvidence(sdk.WrapSDKContext(ctx), msg)
+	assert.ErrorIs(t, err, types.ErrEvidenceExists)
+
+	// the committed header is not evidence of an attack
+	signedHeader, err = testutil.ConflictingSignedHeader(historicalInfo.Header, 0, consKeys[2])
+	assert.NilError(t, err)
+	f.stakingKeeper.SetHistoricalInfo(ctx, 1, &stakingtypes.HistoricalInfo{Header: *signedHeader.Header, Valset: historicalInfo.Valset})
+	err = f.evidenceKeeper.SubmitEvidence(ctx, &types.LightClientAttackEvidence{
+		ConflictingHeader: signedHeader,
+		TrustedCommit:     signedHeader.Commit,
+	})
+	assert.ErrorIs(t, err, types.ErrInvalidEvidence)
+	assert.Assert(t, !f.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(consKeys[2].PubKey().Address())))
+}
+
 func populateValidators(t assert.TestingT, f *fixture) {
 	// add accounts and set total supply
 	totalSupplyAmt := initAmt.MulRaw(int64(len(valAddresses)))
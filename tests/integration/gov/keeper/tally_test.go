@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -20,7 +21,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 	createValidators(t, ctx, app, []int64{5, 5, 5})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -44,7 +45,7 @@ func TestTallyNoQuorum(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -67,7 +68,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
 	tp := TestProposal
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -93,7 +94,7 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -117,7 +118,7 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -142,7 +143,7 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -168,7 +169,7 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -194,7 +195,7 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -221,7 +222,7 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	valAccAddr1, valAccAddr2 := valAccAddrs[0], valAccAddrs[1]
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -255,7 +256,7 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -291,7 +292,7 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -330,7 +331,7 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -372,7 +373,7 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -415,7 +416,7 @@ func TestTallyJailedValidator(t *testing.T) {
 	app.StakingKeeper.Jail(ctx, sdk.ConsAddress(consAddr.Bytes()))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -448,7 +449,7 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 	require.NoError(t, err)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0])
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -473,3 +474,174 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyMessageBasedParams(t *testing.T) {
+	testCases := []struct {
+		name         string
+		setParams    func(params *v1.MessageBasedParams)
+		votes        []v1.VoteOption
+		burnDeposits bool
+	}{
+		{
+			// 6/11 yes passes the default threshold but not the one set for MsgSend
+			name:      "strictest threshold",
+			setParams: func(params *v1.MessageBasedParams) { params.Threshold = "0.6" },
+			votes:     []v1.VoteOption{v1.OptionNo, v1.OptionYes},
+		},
+		{
+			// 6/11 of the bonded stake voting reaches the default quorum but not
+			// the one set for MsgSend
+			name:      "strictest quorum",
+			setParams: func(params *v1.MessageBasedParams) { params.Quorum = "0.6" },
+			votes:     []v1.VoteOption{v1.OptionEmpty, v1.OptionYes},
+		},
+		{
+			// 5/11 no with veto stays below the veto threshold set for MsgSend
+			// but reaches the default one
+			name:         "strictest veto threshold",
+			setParams:    func(params *v1.MessageBasedParams) { params.VetoThreshold = "0.5" },
+			votes:        []v1.VoteOption{v1.OptionNoWithVeto, v1.OptionYes},
+			burnDeposits: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
+
+			msgParams := app.GovKeeper.GetParams(ctx).ToMessageBasedParams()
+			tc.setParams(&msgParams)
+			app.GovKeeper.SetMessageBasedParams(ctx, sdk.MsgTypeURL(&banktypes.MsgSend{}), msgParams)
+
+			// the test proposal bundles the bank MsgSend with a legacy content
+			// message with the default params, and is tallied with the strictest
+			// value of each param
+			tp := TestProposal
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false, false)
+			require.NoError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			for i, option := range tc.votes {
+				if option == v1.OptionEmpty {
+					continue
+				}
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+
+			require.False(t, passes)
+			require.Equal(t, tc.burnDeposits, burnDeposits)
+		})
+	}
+}
+
+func TestTallyGovernor(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs, valAddrs := createValidators(t, ctx, app, []int64{5, 6, 7})
+	governor, delegator := addrs[3], addrs[4]
+
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := app.StakingKeeper.GetValidator(ctx, valAddrs[0])
+	require.True(t, found)
+
+	_, err := app.StakingKeeper.Delegate(ctx, delegator, delTokens, stakingtypes.Unbonded, val1, true)
+	require.NoError(t, err)
+
+	_ = staking.EndBlocker(ctx, app.StakingKeeper)
+
+	require.NoError(t, app.GovKeeper.CreateGovernor(ctx, governor, ""))
+	require.NoError(t, app.GovKeeper.DelegateGovernance(ctx, delegator, governor))
+	require.Equal(t, sdk.NewDecFromInt(delTokens), app.GovKeeper.GetGovernorDelegatedPower(ctx, governor))
+
+	testCases := []struct {
+		name           string
+		maxRatio       string
+		delegatorVote  bool
+		removeGovernor bool
+		expPass        bool
+		expYesCount    string
+		expNoCount     string
+	}{
+		{
+			// the delegator stake is deducted from its validator voting yes
+			name:        "governor votes with the delegator stake",
+			maxRatio:    "1",
+			expPass:     false,
+			expYesCount: "18000000",
+			expNoCount:  "30000000",
+		},
+		{
+			// the 30 delegated power is capped to a quarter of the 49 bonded
+			// power, which includes the genesis validator, and the stake above
+			// the cap inherits the yes vote of the validator
+			name:        "governor voting power is capped",
+			maxRatio:    "0.25",
+			expPass:     true,
+			expYesCount: "35750000",
+			expNoCount:  "12250000",
+		},
+		{
+			name:          "delegator vote overrides the governor vote",
+			maxRatio:      "1",
+			delegatorVote: true,
+			expPass:       true,
+			expYesCount:   "48000000",
+			expNoCount:    "0",
+		},
+		{
+			// the delegator inherits the vote of its validator again
+			name:           "removed governor",
+			maxRatio:       "1",
+			removeGovernor: true,
+			expPass:        true,
+			expYesCount:    "48000000",
+			expNoCount:     "0",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			params := app.GovKeeper.GetParams(ctx)
+			params.MaxGovernorVotingPowerRatio = tc.maxRatio
+			require.NoError(t, app.GovKeeper.SetParams(ctx, params))
+
+			if tc.removeGovernor {
+				require.NoError(t, app.GovKeeper.RemoveGovernor(ctx, governor))
+			}
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", "test", "description", addrs[0], false, false)
+			require.NoError(t, err)
+			proposalID := proposal.Id
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, governor, v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+			if tc.delegatorVote {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, delegator, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			passes, burnDeposits, tallyResults := app.GovKeeper.Tally(ctx, proposal)
+
+			require.Equal(t, tc.expPass, passes)
+			require.False(t, burnDeposits)
+			require.Equal(t, tc.expYesCount, tallyResults.YesCount)
+			require.Equal(t, tc.expNoCount, tallyResults.NoCount)
+		})
+	}
+}
@@ -233,6 +233,77 @@ func (s *KeeperTestSuite) TestHandleAlreadyJailed() {
 	s.Require().Equal(resultingTokens, validator.GetTokens())
 }
 
+// Test that the penalties of repeated downtime jails within the downtime
+// escalation period are escalated
+func (s *KeeperTestSuite) TestHandleEscalatingDowntime() {
+	// initial setup
+	ctx := s.ctx
+
+	params := testutil.TestParams()
+	params.DowntimeEscalationPeriod = 24 * time.Hour
+	params.DowntimeEscalationMultiplier = sdk.NewDec(2)
+	s.Require().NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	addrDels := simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, ctx, 1, s.stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	power := int64(100)
+	tstaking := stakingtestutil.NewHelper(s.T(), ctx, s.stakingKeeper)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	height := int64(0)
+	downtime := func() {
+		// a window of signed blocks then 501 blocks missed
+		startHeight := height
+		for ; height < startHeight+params.SignedBlocksWindow+1; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			s.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+		}
+		for ; height < startHeight+params.SignedBlocksWindow+1+(params.SignedBlocksWindow-s.slashingKeeper.MinSignedPerWindow(ctx))+1; height++ {
+			ctx = ctx.WithBlockHeight(height)
+			s.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+
+		staking.EndBlocker(ctx, s.stakingKeeper)
+	}
+
+	// the first downtime is slashed and jailed with the base penalties
+	downtime()
+	validator, _ := s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
+	s.Require().True(validator.IsJailed())
+	resultingTokens := amt.Sub(s.stakingKeeper.TokensFromConsensusPower(ctx, 1))
+	s.Require().Equal(resultingTokens, validator.GetTokens())
+
+	info, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockHeader().Time.Add(params.DowntimeJailDuration), info.JailedUntil)
+
+	// unjail the validator
+	ctx = ctx.WithBlockTime(info.JailedUntil)
+	s.Require().NoError(s.slashingKeeper.Unjail(ctx, addr))
+	staking.EndBlocker(ctx, s.stakingKeeper)
+	power = 99
+
+	// the second downtime within the escalation period doubles the penalties
+	downtime()
+	validator, _ = s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
+	s.Require().True(validator.IsJailed())
+	resultingTokens = resultingTokens.Sub(s.stakingKeeper.TokensFromConsensusPower(ctx, power).MulRaw(2).QuoRaw(100))
+	s.Require().Equal(resultingTokens, validator.GetTokens())
+
+	info, found = s.slashingKeeper.GetValidatorSigningInfo(ctx, sdk.GetConsAddress(val))
+	s.Require().True(found)
+	s.Require().Equal(ctx.BlockHeader().Time.Add(2*params.DowntimeJailDuration), info.JailedUntil)
+
+	jails, found := s.slashingKeeper.GetValidatorDowntimeJails(ctx, sdk.GetConsAddress(val))
+	s.Require().True(found)
+	s.Require().Len(jails.JailTimes, 2)
+}
+
 // Test a validator dipping in and out of the validator set
 // Ensure that missed blocks are tracked correctly and that
 // the start height of the signing info is reset correctly
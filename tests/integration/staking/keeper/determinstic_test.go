@@ -317,7 +317,7 @@ func (suite *DeterministicTestSuite) TestGRPCValidatorDelegations() {
 		ValidatorAddr: validator.OperatorAddress,
 	}
 
-	testdata.DeterministicIterations(suite.ctx, suite.Require(), req, suite.queryClient.ValidatorDelegations, 11985, false)
+	testdata.DeterministicIterations(suite.ctx, suite.Require(), req, suite.queryClient.ValidatorDelegations, 12246, false)
 }
 
 func (suite *DeterministicTestSuite) TestGRPCValidatorUnbondingDelegations() {
@@ -390,7 +390,7 @@ func (suite *DeterministicTestSuite) TestGRPCDelegation() {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(suite.ctx, suite.Require(), req, suite.queryClient.Delegation, 4635, false)
+	testdata.DeterministicIterations(suite.ctx, suite.Require(), req, suite.queryClient.Delegation, 4722, false)
 }
 
 func (suite *DeterministicTestSuite) TestGRPCUnbondingDelegation() {
@@ -457,7 +457,7 @@ func (suite *DeterministicTestSuite) TestGRPCDelegatorDelegations() {
 		DelegatorAddr: delegator1,
 	}
 
-	testdata.DeterministicIterations(suite.ctx, suite.Require(), req, suite.queryClient.DelegatorDelegations, 4238, false)
+	testdata.DeterministicIterations(suite.ctx, suite.Require(), req, suite.queryClient.DelegatorDelegations, 4325, false)
 }
 
 func (suite *DeterministicTestSuite) TestGRPCDelegatorValidator() {
@@ -621,7 +621,7 @@ func (suite *DeterministicTestSuite) TestGRPCPool() {
 
 	suite.SetupTest() // reset
 	suite.getStaticValidator()
-	testdata.DeterministicIterations(suite.ctx, suite.Require(), &stakingtypes.QueryPoolRequest{}, suite.queryClient.Pool, 6185, false)
+	testdata.DeterministicIterations(suite.ctx, suite.Require(), &stakingtypes.QueryPoolRequest{}, suite.queryClient.Pool, 6272, false)
 }
 
 func (suite *DeterministicTestSuite) TestGRPCRedelegations() {
@@ -688,13 +688,18 @@ func (suite *DeterministicTestSuite) TestGRPCRedelegations() {
 
 func (suite *DeterministicTestSuite) TestGRPCParams() {
 	rapid.Check(suite.T(), func(t *rapid.T) {
+		bondDenom := rapid.StringMatching(sdk.DefaultCoinDenomRegex()).Draw(t, "bond-denom")
 		params := stakingtypes.Params{
-			BondDenom:         rapid.StringMatching(sdk.DefaultCoinDenomRegex()).Draw(t, "bond-denom"),
-			UnbondingTime:     durationGenerator().Draw(t, "duration"),
-			MaxValidators:     rapid.Uint32Min(1).Draw(t, "max-validators"),
-			MaxEntries:        rapid.Uint32Min(1).Draw(t, "max-entries"),
-			HistoricalEntries: rapid.Uint32Min(1).Draw(t, "historical-entries"),
-			MinCommissionRate: sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(t, "commission"), 2),
+			BondDenom:                  bondDenom,
+			UnbondingTime:              durationGenerator().Draw(t, "duration"),
+			MaxValidators:              rapid.Uint32Min(1).Draw(t, "max-validators"),
+			MaxEntries:                 rapid.Uint32Min(1).Draw(t, "max-entries"),
+			HistoricalEntries:          rapid.Uint32Min(1).Draw(t, "historical-entries"),
+			MinCommissionRate:          sdk.NewDecWithPrec(rapid.Int64Range(0, 100).Draw(t, "commission"), 2),
+			KeyRotationFee:             sdk.NewInt64Coin(bondDenom, rapid.Int64Min(0).Draw(t, "key-rotation-fee-amount")),
+			MaxConsPubkeyRotations:     rapid.Uint32().Draw(t, "max-cons-pubkey-rotations"),
+			MinSelfDelegation:          sdk.NewInt(rapid.Int64Min(1).Draw(t, "min-self-delegation")),
+			MinRequirementsGracePeriod: durationGenerator().Draw(t, "min-requirements-grace-period"),
 		}
 
 		err := suite.stakingKeeper.SetParams(suite.ctx, params)
@@ -704,16 +709,20 @@ func (suite *DeterministicTestSuite) TestGRPCParams() {
 	})
 
 	params := stakingtypes.Params{
-		BondDenom:         "denom",
-		UnbondingTime:     time.Hour,
-		MaxValidators:     85,
-		MaxEntries:        5,
-		HistoricalEntries: 5,
-		MinCommissionRate: sdk.NewDecWithPrec(5, 2),
+		BondDenom:                  "denom",
+		UnbondingTime:              time.Hour,
+		MaxValidators:              85,
+		MaxEntries:                 5,
+		HistoricalEntries:          5,
+		MinCommissionRate:          sdk.NewDecWithPrec(5, 2),
+		KeyRotationFee:             sdk.NewInt64Coin("denom", 1000),
+		MaxConsPubkeyRotations:     1,
+		MinSelfDelegation:          sdk.NewInt(1000),
+		MinRequirementsGracePeriod: time.Hour,
 	}
 
 	err := suite.stakingKeeper.SetParams(suite.ctx, params)
 	suite.Require().NoError(err)
 
-	testdata.DeterministicIterations(suite.ctx, suite.Require(), &stakingtypes.QueryParamsRequest{}, suite.queryClient.Params, 1114, false)
+	testdata.DeterministicIterations(suite.ctx, suite.Require(), &stakingtypes.QueryParamsRequest{}, suite.queryClient.Params, 1198, false)
 }
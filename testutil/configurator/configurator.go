@@ -37,6 +37,7 @@ var defaultConfig = &Config{
 	ModuleConfigs: make(map[string]*appv1alpha1.ModuleConfig),
 	BeginBlockersOrder: []string{
 		"upgrade",
+		"gov",
 		"mint",
 		"distribution",
 		"slashing",
@@ -44,7 +45,6 @@ var defaultConfig = &Config{
 		"staking",
 		"auth",
 		"bank",
-		"gov",
 		"crisis",
 		"genutil",
 		"authz",
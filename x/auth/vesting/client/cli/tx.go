@@ -18,6 +18,9 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagDest    = "dest"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -34,6 +37,10 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateVestingAccountCmd(),
 		NewMsgCreatePermanentLockedAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateVestingGrantCmd(),
+		NewMsgAcceptVestingGrantsCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -166,35 +173,193 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 				return err
 			}
 
-			contents, err := os.ReadFile(args[1])
+			startTime, periods, err := readVestingPeriods(args[1])
 			if err != nil {
 				return err
 			}
 
-			var vestingData VestingData
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateVestingGrantCmd returns a CLI command handler for creating a
+// MsgCreateVestingGrant transaction.
+func NewMsgCreateVestingGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-grant [to_address] [periods_json_file]",
+		Short: "Add a vesting grant, which can be clawed back by the funder, to a new or existing account.",
+		Long: `Add a periodic vesting grant funded by the sender to a new or existing account.
+An existing account must have accepted vesting grants with accept-vesting-grants first.
+The coins of the grant which have not vested yet can be clawed back by the sender
+using the clawback command. An account can hold several grants from different funders.
+The periods_json_file has the same format as for create-periodic-vesting-account.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			startTime, periods, err := readVestingPeriods(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingGrant(clientCtx.GetFromAddress(), toAddr, startTime, periods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgAcceptVestingGrantsCmd returns a CLI command handler for creating a
+// MsgAcceptVestingGrants transaction.
+func NewMsgAcceptVestingGrantsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-vesting-grants",
+		Short: "Convert the sender account into a grant vesting account, accepting to receive vesting grants.",
+		Long: `Convert the plain account of the sender into a grant vesting account without any
+vesting grant, so that vesting grants can be added to it with create-vesting-grant.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptVestingGrants(clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
 
-			err = json.Unmarshal(contents, &vestingData)
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for
+// creating a MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address]",
+		Short: "Create a new vesting account with separate lockup and vesting schedules, which can be clawed back by the sender.",
+		Long: `Create a new vesting account funded by the sender, with a lockup schedule defining
+when the coins can be transferred and a vesting schedule defining when they are earned.
+The coins which have not been earned yet can be clawed back by the sender using the
+clawback command. The schedules are read from the JSON files given by the '--lockup'
+and '--vesting' flags, which have the same format as for create-periodic-vesting-account
+and must have the same start time. If one of them is omitted, the coins are unlocked
+or vested at the start time.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			var periods []types.Period
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
 
-			for i, p := range vestingData.Periods {
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("at least one of --%s and --%s must be set", FlagLockup, FlagVesting)
+			}
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+			var (
+				startTime                     int64
+				lockupPeriods, vestingPeriods []types.Period
+			)
+			if lockupFile != "" {
+				if startTime, lockupPeriods, err = readVestingPeriods(lockupFile); err != nil {
+					return err
+				}
+			}
+			if vestingFile != "" {
+				vestingStartTime, periods, err := readVestingPeriods(vestingFile)
 				if err != nil {
 					return err
 				}
+				if lockupFile != "" && vestingStartTime != startTime {
+					return fmt.Errorf("lockup start time %d does not match vesting start time %d", startTime, vestingStartTime)
+				}
+				startTime, vestingPeriods = vestingStartTime, periods
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "Path to the JSON file of the lockup periods")
+	cmd.Flags().String(FlagVesting, "", "Path to the JSON file of the vesting periods")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a MsgClawback
+// transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested coins funded by the sender.",
+		Long: `Claw back the coins funded by the sender which have not vested yet in the given
+vesting grant or clawback vesting account. The coins are sent to the sender, or to
+the address given by the '--dest' flag. The delegated unvested coins are unbonded
+to the destination address.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
 
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+			var destAddr sdk.AccAddress
+			if dest, _ := cmd.Flags().GetString(FlagDest); dest != "" {
+				if destAddr, err = sdk.AccAddressFromBech32(dest); err != nil {
+					return err
 				}
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, destAddr)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -203,7 +368,42 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDest, "", "Address receiving the clawed back coins, defaults to the sender")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// readVestingPeriods reads the start time and vesting periods from a JSON file
+// in the VestingData format.
+func readVestingPeriods(path string) (int64, []types.Period, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var vestingData VestingData
+
+	err = json.Unmarshal(contents, &vestingData)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var periods []types.Period
+
+	for i, p := range vestingData.Periods {
+
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if p.Length < 0 {
+			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+
+	return vestingData.StartTime, periods, nil
+}
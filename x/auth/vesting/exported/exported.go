@@ -40,3 +40,23 @@ type VestingAccount interface {
 	GetDelegatedFree() sdk.Coins
 	GetDelegatedVesting() sdk.Coins
 }
+
+// ClawbackAccount defines a vesting account whose unvested coins can be clawed
+// back by their funder.
+type ClawbackAccount interface {
+	VestingAccount
+
+	// IsFundedBy returns true if the account holds vesting coins funded by the
+	// given funder.
+	IsFundedBy(funder string) bool
+
+	// Clawback removes the coins funded by the given funder which have not
+	// vested at the given block time from the vesting schedules and returns
+	// them. It is the caller's responsibility to transfer the returned coins
+	// out of the account.
+	Clawback(funder string, blockTime time.Time) sdk.Coins
+
+	// TrackClawedBackDelegation performs internal vesting accounting necessary
+	// when delegated vesting coins are clawed back.
+	TrackClawedBackDelegation(amount sdk.Coins)
+}
@@ -80,6 +80,7 @@ type AppModule struct {
 
 	accountKeeper keeper.AccountKeeper
 	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
 }
 
 func NewAppModule(ak keeper.AccountKeeper, bk types.BankKeeper) AppModule {
@@ -90,6 +91,16 @@ func NewAppModule(ak keeper.AccountKeeper, bk types.BankKeeper) AppModule {
 	}
 }
 
+// NewAppModuleWithStakingKeeper returns a vesting AppModule whose message
+// server tracks the delegations of the accounts accepting vesting grants and
+// claws back delegated vesting coins.
+func NewAppModuleWithStakingKeeper(ak keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) AppModule {
+	m := NewAppModule(ak, bk)
+	m.stakingKeeper = sk
+
+	return m
+}
+
 var _ appmodule.AppModule = AppModule{}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -103,7 +114,7 @@ func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(am.accountKeeper, am.bankKeeper))
+	types.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImplWithStakingKeeper(am.accountKeeper, am.bankKeeper, am.stakingKeeper))
 }
 
 // InitGenesis performs a no-op.
@@ -134,6 +145,7 @@ type VestingInputs struct {
 
 	AccountKeeper keeper.AccountKeeper
 	BankKeeper    types.BankKeeper
+	StakingKeeper types.StakingKeeper `optional:"true"`
 }
 
 type VestingOutputs struct {
@@ -143,7 +155,7 @@ type VestingOutputs struct {
 }
 
 func ProvideModule(in VestingInputs) VestingOutputs {
-	m := NewAppModule(in.AccountKeeper, in.BankKeeper)
+	m := NewAppModuleWithStakingKeeper(in.AccountKeeper, in.BankKeeper, in.StakingKeeper)
 
 	return VestingOutputs{Module: m}
 }
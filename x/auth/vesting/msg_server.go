@@ -2,7 +2,9 @@ package vesting
 
 import (
 	"context"
+	"math"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -10,20 +12,32 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
+// wrapping the corresponding AccountKeeper and BankKeeper. Without a staking
+// keeper, plain accounts can't accept vesting grants and delegated vesting
+// coins can't be clawed back.
 func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
 	return &msgServer{AccountKeeper: k, BankKeeper: bk}
 }
 
+// NewMsgServerImplWithStakingKeeper returns an implementation of the vesting
+// MsgServer interface, wrapping the corresponding AccountKeeper, BankKeeper
+// and StakingKeeper.
+func NewMsgServerImplWithStakingKeeper(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
+}
+
 var _ types.MsgServer = msgServer{}
 
 func (s msgServer) CreateVestingAccount(goCtx context.Context, msg *types.MsgCreateVestingAccount) (*types.MsgCreateVestingAccountResponse, error) {
@@ -193,3 +207,320 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreateVestingGrant(goCtx context.Context, msg *types.MsgCreateVestingGrant) (*types.MsgCreateVestingGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	grant := types.NewVestingGrant(funder, msg.StartTime, msg.VestingPeriods)
+	totalCoins := grant.TotalAmount()
+
+	for _, coin := range totalCoins {
+		if coin.Amount.LT(types.MinVestingGrantAmount) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "vesting grant of %s is lower than the minimum amount of %s per denom", coin, types.MinVestingGrantAmount)
+		}
+	}
+
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	// Plain accounts must be converted into grant vesting accounts by their
+	// owner with MsgAcceptVestingGrants before receiving grants.
+	var (
+		vestingAccount *types.GrantVestingAccount
+		newAccount     bool
+	)
+	switch acc := ak.GetAccount(ctx, to).(type) {
+	case nil:
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount = types.NewGrantVestingAccount(baseAccount)
+		newAccount = true
+	case *authtypes.BaseAccount:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s has not accepted vesting grants", msg.ToAddress)
+	case *types.GrantVestingAccount:
+		vestingAccount = acc
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s of type %T cannot receive vesting grants", msg.ToAddress, acc)
+	}
+
+	vestingAccount.PruneVestedGrants(ctx.BlockTime())
+	if len(vestingAccount.Grants) >= types.MaxVestingGrants {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already holds the maximum number of %d vesting grants", msg.ToAddress, types.MaxVestingGrants)
+	}
+
+	vestingAccount.AddGrant(grant)
+	ak.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		if newAccount {
+			telemetry.IncrCounter(1, "new", "account")
+		}
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_vesting_grant"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = bk.SendCoins(ctx, funder, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateVestingGrantResponse{}, nil
+}
+
+func (s msgServer) AcceptVestingGrants(goCtx context.Context, msg *types.MsgAcceptVestingGrants) (*types.MsgAcceptVestingGrantsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	baseAccount, ok := s.AccountKeeper.GetAccount(ctx, addr).(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s is not a plain account", msg.Address)
+	}
+
+	if s.StakingKeeper == nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the delegations of the account can't be tracked without a staking keeper")
+	}
+
+	// The coins delegated before the conversion are free coins, they are
+	// tracked as such so that undelegating them doesn't unlock vesting coins.
+	delegated, err := s.delegatedCoins(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAccount := types.NewGrantVestingAccount(baseAccount)
+	vestingAccount.DelegatedFree = delegated
+	s.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	return &types.MsgAcceptVestingGrantsResponse{}, nil
+}
+
+// delegatedCoins returns the coins delegated by addr, including the unbonding
+// ones, which stay tracked as delegated until their unbonding completes.
+func (s msgServer) delegatedCoins(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	sk := s.StakingKeeper
+
+	delegated := sdkmath.ZeroInt()
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		validator, found := sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return nil, stakingtypes.ErrNoValidatorFound
+		}
+
+		delegated = delegated.Add(validator.TokensFromShares(delegation.Shares).TruncateInt())
+	}
+
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		for _, entry := range ubd.Entries {
+			delegated = delegated.Add(entry.Balance)
+		}
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(sk.BondDenom(ctx), delegated)), nil
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	totalCoins := msg.TotalAmount()
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewClawbackVestingAccount(baseAccount, from, totalCoins, msg.StartTime, msg.LockupPeriods, msg.VestingPeriods)
+
+	ak.SetAccount(ctx, vestingAccount)
+
+	defer func() {
+		telemetry.IncrCounter(1, "new", "account")
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = bk.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	dest, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if msg.DestAddress != "" {
+		if dest, err = sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	vestingAccount, ok := ak.GetAccount(ctx, addr).(vestexported.ClawbackAccount)
+	if !ok || !vestingAccount.IsFundedBy(msg.FunderAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no vesting coins funded by %s in account %s", msg.FunderAddress, msg.Address)
+	}
+
+	// The unvested coins which are not delegated are transferred from the
+	// account balance, the delegated ones are force-unbonded to the
+	// destination address.
+	locked := vestingAccount.LockedCoins(ctx.BlockTime())
+	unvested := vestingAccount.Clawback(msg.FunderAddress, ctx.BlockTime())
+	fromBalance := unvested.Min(locked)
+	toUnbond := unvested.Sub(fromBalance...)
+
+	ak.SetAccount(ctx, vestingAccount)
+
+	clawedBack := fromBalance
+	if !fromBalance.IsZero() {
+		if err = bk.SendCoins(ctx, addr, dest, fromBalance); err != nil {
+			return nil, err
+		}
+	}
+
+	if !toUnbond.IsZero() {
+		if s.StakingKeeper == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "delegated vesting coins can't be clawed back without a staking keeper")
+		}
+
+		unbonded, err := s.forceUnbond(ctx, addr, dest, toUnbond)
+		if err != nil {
+			return nil, err
+		}
+
+		// The unbonded amount is lower than requested if the delegations
+		// were slashed.
+		if !unbonded.IsZero() {
+			vestingAccount.TrackClawedBackDelegation(unbonded)
+			ak.SetAccount(ctx, vestingAccount)
+		}
+		clawedBack = clawedBack.Add(unbonded...)
+	}
+
+	return &types.MsgClawbackResponse{ClawedBack: clawedBack}, nil
+}
+
+// forceUnbond transfers up to amount of the delegations of addr to dest and
+// undelegates them, so that the unbonded tokens are returned to dest after
+// the unbonding period. It returns the amount of tokens unbonded, which can be
+// lower than the requested amount if the delegations were slashed.
+func (s msgServer) forceUnbond(ctx sdk.Context, addr, dest sdk.AccAddress, amount sdk.Coins) (sdk.Coins, error) {
+	sk := s.StakingKeeper
+	bondDenom := sk.BondDenom(ctx)
+
+	remaining := amount.AmountOf(bondDenom)
+	unbonded := sdkmath.ZeroInt()
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			return nil, stakingtypes.ErrNoValidatorFound
+		}
+
+		tokens := sdkmath.MinInt(validator.TokensFromShares(delegation.Shares).TruncateInt(), remaining)
+		if !tokens.IsPositive() {
+			continue
+		}
+
+		shares, err := sk.ValidateUnbondAmount(ctx, addr, valAddr, tokens)
+		if err != nil {
+			return nil, err
+		}
+
+		_, returnAmount, err := sk.TransferAndUndelegate(ctx, addr, dest, valAddr, shares)
+		if err != nil {
+			return nil, err
+		}
+
+		unbonded = unbonded.Add(returnAmount)
+		remaining = remaining.Sub(sdkmath.MinInt(returnAmount, remaining))
+	}
+
+	// Tokens being unbonded by the account itself would be returned to it as
+	// free coins, the clawback must wait for the unbonding to complete.
+	if remaining.IsPositive() {
+		for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+			for _, entry := range ubd.Entries {
+				if entry.Balance.IsPositive() {
+					return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unvested coins of %s are unbonding, retry once the unbonding completes", addr)
+				}
+			}
+		}
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(bondDenom, unbonded)), nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtestutil "github.com/cosmos/cosmos-sdk/x/auth/vesting/testutil"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -24,6 +25,7 @@ var (
 	to1Addr    = sdk.AccAddress([]byte("to1__________________"))
 	to2Addr    = sdk.AccAddress([]byte("to2__________________"))
 	to3Addr    = sdk.AccAddress([]byte("to3__________________"))
+	destAddr   = sdk.AccAddress([]byte("dest_________________"))
 	fooCoin    = sdk.NewInt64Coin("foo", 100)
 	periodCoin = sdk.NewInt64Coin("foo", 20)
 )
@@ -34,6 +36,7 @@ type VestingTestSuite struct {
 	ctx           sdk.Context
 	accountKeeper authkeeper.AccountKeeper
 	bankKeeper    *vestingtestutil.MockBankKeeper
+	stakingKeeper *vestingtestutil.MockStakingKeeper
 	msgServer     vestingtypes.MsgServer
 }
 
@@ -47,6 +50,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	ctrl := gomock.NewController(s.T())
 	s.bankKeeper = vestingtestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = vestingtestutil.NewMockStakingKeeper(ctrl)
 	s.accountKeeper = authkeeper.NewAccountKeeper(
 		encCfg.Codec,
 		key,
@@ -58,7 +62,7 @@ func (s *VestingTestSuite) SetupTest() {
 
 	vestingtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
 	authtypes.RegisterInterfaces(encCfg.InterfaceRegistry)
-	s.msgServer = vesting.NewMsgServerImpl(s.accountKeeper, s.bankKeeper)
+	s.msgServer = vesting.NewMsgServerImplWithStakingKeeper(s.accountKeeper, s.bankKeeper, s.stakingKeeper)
 }
 
 func (s *VestingTestSuite) TestCreateVestingAccount() {
@@ -253,6 +257,502 @@ func (s *VestingTestSuite) TestCreatePeriodicVestingAccount() {
 	}
 }
 
+func (s *VestingTestSuite) TestCreateVestingGrant() {
+	grantCoin := sdk.NewCoin("foo", vestingtypes.MinVestingGrantAmount)
+	periods := []vestingtypes.Period{
+		{
+			Length: 10,
+			Amount: sdk.NewCoins(periodCoin),
+		},
+		{
+			Length: 20,
+			Amount: sdk.NewCoins(grantCoin),
+		},
+	}
+	totalCoins := sdk.NewCoins(periodCoin.Add(grantCoin))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreateVestingGrant
+		expErr    bool
+		expErrMsg string
+		postRun   func()
+	}{
+		{
+			name: "blocked recipient",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(true)
+			},
+			input:     vestingtypes.NewMsgCreateVestingGrant(fromAddr, to1Addr, s.ctx.BlockTime().Unix(), periods),
+			expErr:    true,
+			expErrMsg: "not allowed to receive funds",
+		},
+		{
+			name: "grant lower than the minimum amount",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateVestingGrant(fromAddr, to1Addr, s.ctx.BlockTime().Unix(), periods[:1]),
+			expErr:    true,
+			expErrMsg: "lower than the minimum amount",
+		},
+		{
+			name: "create a new grant vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, totalCoins).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateVestingGrant(fromAddr, to1Addr, s.ctx.BlockTime().Unix(), periods),
+			postRun: func() {
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.GrantVestingAccount)
+				s.Require().True(ok)
+				s.Require().Len(acc.Grants, 1)
+				s.Require().Equal(totalCoins, acc.OriginalVesting)
+			},
+		},
+		{
+			name: "add a grant to an existing grant vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to2Addr, to1Addr, totalCoins).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateVestingGrant(to2Addr, to1Addr, s.ctx.BlockTime().Unix(), periods),
+			postRun: func() {
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.GrantVestingAccount)
+				s.Require().True(ok)
+				s.Require().Len(acc.Grants, 2)
+				s.Require().Equal(to2Addr.String(), acc.Grants[1].FunderAddress)
+				s.Require().Equal(totalCoins.Add(totalCoins...), acc.OriginalVesting)
+			},
+		},
+		{
+			name: "add a grant to a base account which has not accepted grants",
+			preRun: func() {
+				s.accountKeeper.SetAccount(s.ctx, s.accountKeeper.NewAccountWithAddress(s.ctx, to2Addr))
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+			},
+			input:     vestingtypes.NewMsgCreateVestingGrant(fromAddr, to2Addr, s.ctx.BlockTime().Unix(), periods),
+			expErr:    true,
+			expErrMsg: "has not accepted vesting grants",
+		},
+		{
+			name: "add a grant to another vesting account type",
+			preRun: func() {
+				baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to3Addr).(*authtypes.BaseAccount)
+				s.accountKeeper.SetAccount(s.ctx, vestingtypes.NewPermanentLockedAccount(baseAcc, totalCoins))
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+			},
+			input:     vestingtypes.NewMsgCreateVestingGrant(fromAddr, to3Addr, s.ctx.BlockTime().Unix(), periods),
+			expErr:    true,
+			expErrMsg: "cannot receive vesting grants",
+		},
+		{
+			name: "add a grant to an account holding the maximum number of grants",
+			preRun: func() {
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.GrantVestingAccount)
+				s.Require().True(ok)
+				for len(acc.Grants) < vestingtypes.MaxVestingGrants {
+					acc.AddGrant(vestingtypes.NewVestingGrant(fromAddr, s.ctx.BlockTime().Unix(), periods))
+				}
+				s.accountKeeper.SetAccount(s.ctx, acc)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+			},
+			input:     vestingtypes.NewMsgCreateVestingGrant(fromAddr, to1Addr, s.ctx.BlockTime().Unix(), periods),
+			expErr:    true,
+			expErrMsg: "maximum number of 10 vesting grants",
+		},
+		{
+			name: "fully vested grants are pruned",
+			preRun: func() {
+				// all the grants of the account vested
+				s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(30 * time.Second))
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to1Addr, totalCoins).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateVestingGrant(fromAddr, to1Addr, s.ctx.BlockTime().Add(30*time.Second).Unix(), periods),
+			postRun: func() {
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.GrantVestingAccount)
+				s.Require().True(ok)
+				s.Require().NoError(acc.Validate())
+				s.Require().Len(acc.Grants, 1)
+				s.Require().Equal(totalCoins, acc.OriginalVesting)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.preRun()
+			_, err := s.msgServer.CreateVestingGrant(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun()
+			}
+		})
+	}
+}
+
+func (s *VestingTestSuite) TestAcceptVestingGrants() {
+	valAddr := sdk.ValAddress([]byte("val__________________"))
+	validator := stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          periodCoin.Amount,
+		DelegatorShares: sdk.NewDecFromInt(periodCoin.Amount),
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgAcceptVestingGrants
+		expErr    bool
+		expErrMsg string
+		postRun   func()
+	}{
+		{
+			name:      "account does not exist",
+			preRun:    func() {},
+			input:     vestingtypes.NewMsgAcceptVestingGrants(to1Addr),
+			expErr:    true,
+			expErrMsg: "is not a plain account",
+		},
+		{
+			name: "convert a base account with delegations",
+			preRun: func() {
+				toAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr)
+				s.Require().NoError(toAcc.SetSequence(5))
+				s.accountKeeper.SetAccount(s.ctx, toAcc)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo")
+				s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), to1Addr, gomock.Any()).Return([]stakingtypes.Delegation{
+					stakingtypes.NewDelegation(to1Addr, valAddr, sdk.NewDecFromInt(periodCoin.Amount)),
+				})
+				s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(validator, true)
+				s.stakingKeeper.EXPECT().GetUnbondingDelegations(gomock.Any(), to1Addr, gomock.Any()).Return([]stakingtypes.UnbondingDelegation{
+					stakingtypes.NewUnbondingDelegation(to1Addr, valAddr, 1, s.ctx.BlockTime().Add(time.Hour), fooCoin.Amount, 1),
+				})
+			},
+			input: vestingtypes.NewMsgAcceptVestingGrants(to1Addr),
+			postRun: func() {
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.GrantVestingAccount)
+				s.Require().True(ok)
+				s.Require().Equal(uint64(5), acc.GetSequence())
+				s.Require().Empty(acc.Grants)
+				s.Require().Equal(sdk.NewCoins(periodCoin.Add(fooCoin)), acc.DelegatedFree)
+				s.Require().NoError(acc.Validate())
+			},
+		},
+		{
+			name:      "account already accepted vesting grants",
+			preRun:    func() {},
+			input:     vestingtypes.NewMsgAcceptVestingGrants(to1Addr),
+			expErr:    true,
+			expErrMsg: "is not a plain account",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.preRun()
+			_, err := s.msgServer.AcceptVestingGrants(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun()
+			}
+		})
+	}
+}
+
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	periods := []vestingtypes.Period{
+		{
+			Length: 10,
+			Amount: sdk.NewCoins(periodCoin),
+		},
+		{
+			Length: 20,
+			Amount: sdk.NewCoins(fooCoin),
+		},
+	}
+	lockupPeriods := []vestingtypes.Period{
+		{
+			Length: 100,
+			Amount: sdk.NewCoins(periodCoin.Add(fooCoin)),
+		},
+	}
+	totalCoins := sdk.NewCoins(periodCoin.Add(fooCoin))
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgCreateClawbackVestingAccount
+		expErr    bool
+		expErrMsg string
+		postRun   func()
+	}{
+		{
+			name: "create for existing account",
+			preRun: func() {
+				toAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr)
+				s.accountKeeper.SetAccount(s.ctx, toAcc)
+				s.bankKeeper.EXPECT().BlockedAddr(to1Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to1Addr, s.ctx.BlockTime().Unix(), lockupPeriods, periods),
+			expErr:    true,
+			expErrMsg: "already exists",
+		},
+		{
+			name: "create a valid clawback vesting account",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to2Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to2Addr, totalCoins).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to2Addr, s.ctx.BlockTime().Unix(), lockupPeriods, periods),
+			postRun: func() {
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().NoError(acc.Validate())
+				s.Require().Equal(fromAddr.String(), acc.FunderAddress)
+				s.Require().Equal(totalCoins, acc.OriginalVesting)
+				s.Require().Equal(s.ctx.BlockTime().Unix()+100, acc.EndTime)
+			},
+		},
+		{
+			name: "create without lockup periods",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+				s.bankKeeper.EXPECT().IsSendEnabledCoins(gomock.Any(), totalCoins).Return(nil)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), fromAddr, to3Addr, totalCoins).Return(nil)
+			},
+			input: vestingtypes.NewMsgCreateClawbackVestingAccount(fromAddr, to3Addr, s.ctx.BlockTime().Unix(), nil, periods),
+			postRun: func() {
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to3Addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().NoError(acc.Validate())
+				s.Require().Len(acc.LockupPeriods, 1)
+				s.Require().Equal(s.ctx.BlockTime().Unix()+30, acc.EndTime)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.preRun()
+			_, err := s.msgServer.CreateClawbackVestingAccount(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun()
+			}
+		})
+	}
+}
+
+func (s *VestingTestSuite) TestClawback() {
+	now := s.ctx.BlockTime()
+	periods := vestingtypes.Periods{
+		{
+			Length: 10,
+			Amount: sdk.NewCoins(periodCoin),
+		},
+		{
+			Length: 20,
+			Amount: sdk.NewCoins(fooCoin),
+		},
+	}
+
+	baseAcc := s.accountKeeper.NewAccountWithAddress(s.ctx, to1Addr).(*authtypes.BaseAccount)
+	acc := vestingtypes.NewGrantVestingAccount(baseAcc)
+	acc.AddGrant(vestingtypes.NewVestingGrant(fromAddr, now.Unix(), periods))
+	acc.AddGrant(vestingtypes.NewVestingGrant(to2Addr, now.Unix(), periods))
+	s.accountKeeper.SetAccount(s.ctx, acc)
+
+	// the first period of the account is delegated
+	delegatedAcc := vestingtypes.NewClawbackVestingAccount(
+		s.accountKeeper.NewAccountWithAddress(s.ctx, to2Addr).(*authtypes.BaseAccount),
+		fromAddr, periods.TotalAmount(), now.Unix(), nil, periods,
+	)
+	delegatedAcc.TrackDelegation(now, periods.TotalAmount(), sdk.NewCoins(periodCoin))
+	s.accountKeeper.SetAccount(s.ctx, delegatedAcc)
+
+	// the account is unbonding its delegation
+	unbondingAcc := vestingtypes.NewClawbackVestingAccount(
+		s.accountKeeper.NewAccountWithAddress(s.ctx, to3Addr).(*authtypes.BaseAccount),
+		fromAddr, periods.TotalAmount(), now.Unix(), nil, periods,
+	)
+	unbondingAcc.TrackDelegation(now, periods.TotalAmount(), periods.TotalAmount())
+	s.accountKeeper.SetAccount(s.ctx, unbondingAcc)
+
+	// the whole account is delegated to a validator which was slashed by 10%
+	slashedAddr := sdk.AccAddress([]byte("slashed______________"))
+	slashedAcc := vestingtypes.NewClawbackVestingAccount(
+		s.accountKeeper.NewAccountWithAddress(s.ctx, slashedAddr).(*authtypes.BaseAccount),
+		fromAddr, periods.TotalAmount(), now.Unix(), nil, periods,
+	)
+	slashedAcc.TrackDelegation(now, periods.TotalAmount(), periods.TotalAmount())
+	s.accountKeeper.SetAccount(s.ctx, slashedAcc)
+
+	s.ctx = s.ctx.WithBlockTime(now.Add(15 * time.Second))
+
+	slashedValAddr := sdk.ValAddress([]byte("slashed_val__________"))
+	slashedValidator := stakingtypes.Validator{
+		OperatorAddress: slashedValAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          sdk.NewInt(90),
+		DelegatorShares: sdk.NewDecFromInt(fooCoin.Amount),
+	}
+
+	valAddr := sdk.ValAddress([]byte("val__________________"))
+	validator := stakingtypes.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          periodCoin.Amount,
+		DelegatorShares: sdk.NewDecFromInt(periodCoin.Amount),
+	}
+
+	testCases := []struct {
+		name      string
+		preRun    func()
+		input     *vestingtypes.MsgClawback
+		expErr    bool
+		expErrMsg string
+		postRun   func(clawedBack sdk.Coins)
+	}{
+		{
+			name: "no grant from funder",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(to3Addr).Return(false)
+			},
+			input:     vestingtypes.NewMsgClawback(to3Addr, to1Addr, nil),
+			expErr:    true,
+			expErrMsg: "no vesting coins funded by",
+		},
+		{
+			name: "blocked destination",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(destAddr).Return(true)
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to1Addr, destAddr),
+			expErr:    true,
+			expErrMsg: "not allowed to receive funds",
+		},
+		{
+			name: "claw back unvested coins of a grant",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(destAddr).Return(false)
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to1Addr, destAddr, sdk.NewCoins(fooCoin)).Return(nil)
+			},
+			input: vestingtypes.NewMsgClawback(fromAddr, to1Addr, destAddr),
+			postRun: func(clawedBack sdk.Coins) {
+				s.Require().Equal(sdk.NewCoins(fooCoin), clawedBack)
+
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to1Addr).(*vestingtypes.GrantVestingAccount)
+				s.Require().True(ok)
+				s.Require().NoError(acc.Validate())
+				s.Require().Len(acc.Grants, 2)
+				s.Require().Equal(fromAddr.String(), acc.Grants[0].FunderAddress)
+				s.Require().Len(acc.Grants[0].VestingPeriods, 1)
+				s.Require().Equal(periods.TotalAmount().Add(periodCoin), acc.OriginalVesting)
+			},
+		},
+		{
+			name: "claw back unvested coins with force-unbonding",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				// 100 unvested coins, 80 in the balance and 20 delegated
+				s.bankKeeper.EXPECT().SendCoins(gomock.Any(), to2Addr, fromAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 80))).Return(nil)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo")
+				s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), to2Addr, gomock.Any()).Return([]stakingtypes.Delegation{
+					stakingtypes.NewDelegation(to2Addr, valAddr, sdk.NewDecFromInt(periodCoin.Amount)),
+				})
+				s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), valAddr).Return(validator, true)
+				s.stakingKeeper.EXPECT().ValidateUnbondAmount(gomock.Any(), to2Addr, valAddr, periodCoin.Amount).Return(sdk.NewDecFromInt(periodCoin.Amount), nil)
+				s.stakingKeeper.EXPECT().TransferAndUndelegate(gomock.Any(), to2Addr, fromAddr, valAddr, sdk.NewDecFromInt(periodCoin.Amount)).Return(s.ctx.BlockTime().Add(time.Hour), periodCoin.Amount, nil)
+			},
+			input: vestingtypes.NewMsgClawback(fromAddr, to2Addr, nil),
+			postRun: func(clawedBack sdk.Coins) {
+				s.Require().Equal(sdk.NewCoins(fooCoin), clawedBack)
+
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, to2Addr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().NoError(acc.Validate())
+				s.Require().Equal(sdk.NewCoins(periodCoin), acc.OriginalVesting)
+				s.Require().Empty(acc.DelegatedVesting)
+				s.Require().Equal(sdk.NewCoins(periodCoin), acc.LockupPeriods[0].Amount)
+				s.Require().Empty(acc.LockedCoins(s.ctx.BlockTime()))
+			},
+		},
+		{
+			name: "claw back slashed delegated coins",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				// 100 unvested coins, all delegated but slashed to 90
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo")
+				s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), slashedAddr, gomock.Any()).Return([]stakingtypes.Delegation{
+					stakingtypes.NewDelegation(slashedAddr, slashedValAddr, sdk.NewDecFromInt(fooCoin.Amount)),
+				})
+				s.stakingKeeper.EXPECT().GetValidator(gomock.Any(), slashedValAddr).Return(slashedValidator, true)
+				s.stakingKeeper.EXPECT().ValidateUnbondAmount(gomock.Any(), slashedAddr, slashedValAddr, sdk.NewInt(90)).Return(sdk.NewDecFromInt(fooCoin.Amount), nil)
+				s.stakingKeeper.EXPECT().TransferAndUndelegate(gomock.Any(), slashedAddr, fromAddr, slashedValAddr, sdk.NewDecFromInt(fooCoin.Amount)).Return(s.ctx.BlockTime().Add(time.Hour), sdk.NewInt(90), nil)
+				s.stakingKeeper.EXPECT().GetUnbondingDelegations(gomock.Any(), slashedAddr, gomock.Any()).Return(nil)
+			},
+			input: vestingtypes.NewMsgClawback(fromAddr, slashedAddr, nil),
+			postRun: func(clawedBack sdk.Coins) {
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("foo", 90)), clawedBack)
+
+				acc, ok := s.accountKeeper.GetAccount(s.ctx, slashedAddr).(*vestingtypes.ClawbackVestingAccount)
+				s.Require().True(ok)
+				s.Require().NoError(acc.Validate())
+				s.Require().Equal(sdk.NewCoins(periodCoin), acc.OriginalVesting)
+				s.Require().Equal(sdk.NewCoins(periodCoin), acc.DelegatedVesting)
+			},
+		},
+		{
+			name: "unbonding unvested coins",
+			preRun: func() {
+				s.bankKeeper.EXPECT().BlockedAddr(fromAddr).Return(false)
+				s.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return("foo")
+				s.stakingKeeper.EXPECT().GetDelegatorDelegations(gomock.Any(), to3Addr, gomock.Any()).Return(nil)
+				s.stakingKeeper.EXPECT().GetUnbondingDelegations(gomock.Any(), to3Addr, gomock.Any()).Return([]stakingtypes.UnbondingDelegation{
+					stakingtypes.NewUnbondingDelegation(to3Addr, valAddr, 1, s.ctx.BlockTime().Add(time.Hour), fooCoin.Amount, 1),
+				})
+			},
+			input:     vestingtypes.NewMsgClawback(fromAddr, to3Addr, nil),
+			expErr:    true,
+			expErrMsg: "are unbonding",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tc.preRun()
+			res, err := s.msgServer.Clawback(s.ctx, tc.input)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				tc.postRun(res.ClawedBack)
+			}
+		})
+	}
+}
+
 func TestVestingTestSuite(t *testing.T) {
 	suite.Run(t, new(VestingTestSuite))
 }
@@ -16,12 +16,32 @@ const TypeMsgCreatePermanentLockedAccount = "msg_create_permanent_locked_account
 // TypeMsgCreatePeriodicVestingAccount defines the type value for a MsgCreateVestingAccount.
 const TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
 
+// TypeMsgCreateVestingGrant defines the type value for a MsgCreateVestingGrant.
+const TypeMsgCreateVestingGrant = "msg_create_vesting_grant"
+
+// TypeMsgAcceptVestingGrants defines the type value for a MsgAcceptVestingGrants.
+const TypeMsgAcceptVestingGrants = "msg_accept_vesting_grants"
+
+// TypeMsgCreateClawbackVestingAccount defines the type value for a MsgCreateClawbackVestingAccount.
+const TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+
+// TypeMsgClawback defines the type value for a MsgClawback.
+const TypeMsgClawback = "msg_clawback"
+
 var _ sdk.Msg = &MsgCreateVestingAccount{}
 
 var _ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 
 var _ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
 
+var _ sdk.Msg = &MsgCreateVestingGrant{}
+
+var _ sdk.Msg = &MsgAcceptVestingGrants{}
+
+var _ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+
+var _ sdk.Msg = &MsgClawback{}
+
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 //
 //nolint:interfacer
@@ -197,3 +217,235 @@ func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgCreateVestingGrant returns a reference to a new MsgCreateVestingGrant.
+//
+//nolint:interfacer
+func NewMsgCreateVestingGrant(funderAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreateVestingGrant {
+	return &MsgCreateVestingGrant{
+		FunderAddress:  funderAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// Route returns the message route for a MsgCreateVestingGrant.
+func (msg MsgCreateVestingGrant) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateVestingGrant.
+func (msg MsgCreateVestingGrant) Type() string { return TypeMsgCreateVestingGrant }
+
+// GetSigners returns the expected signers for a MsgCreateVestingGrant.
+func (msg MsgCreateVestingGrant) GetSigners() []sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateVestingGrant.
+func (msg MsgCreateVestingGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingGrant) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if len(msg.VestingPeriods) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("vesting periods cannot be empty")
+	}
+
+	for i, period := range msg.VestingPeriods {
+		if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+			return sdkerrors.ErrInvalidCoins.Wrap(period.Amount.String())
+		}
+
+		if period.Length < 1 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgAcceptVestingGrants returns a reference to a new MsgAcceptVestingGrants.
+//
+//nolint:interfacer
+func NewMsgAcceptVestingGrants(addr sdk.AccAddress) *MsgAcceptVestingGrants {
+	return &MsgAcceptVestingGrants{
+		Address: addr.String(),
+	}
+}
+
+// Route returns the message route for a MsgAcceptVestingGrants.
+func (msg MsgAcceptVestingGrants) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgAcceptVestingGrants.
+func (msg MsgAcceptVestingGrants) Type() string { return TypeMsgAcceptVestingGrants }
+
+// GetSigners returns the expected signers for a MsgAcceptVestingGrants.
+func (msg MsgAcceptVestingGrants) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Address)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgAcceptVestingGrants.
+func (msg MsgAcceptVestingGrants) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgAcceptVestingGrants) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+//
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods []Period) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+}
+
+// TotalAmount returns the amount of coins of the schedules of a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) TotalAmount() sdk.Coins {
+	if len(msg.VestingPeriods) == 0 {
+		return Periods(msg.LockupPeriods).TotalAmount()
+	}
+
+	return Periods(msg.VestingPeriods).TotalAmount()
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string {
+	return TypeMsgCreateClawbackVestingAccount
+}
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("lockup and vesting periods cannot be both empty")
+	}
+
+	for _, periods := range [][]Period{msg.LockupPeriods, msg.VestingPeriods} {
+		for i, period := range periods {
+			if !period.Amount.IsValid() || !period.Amount.IsAllPositive() {
+				return sdkerrors.ErrInvalidCoins.Wrap(period.Amount.String())
+			}
+
+			if period.Length < 1 {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid period length of %d in period %d, length must be greater than 0", period.Length, i)
+			}
+		}
+	}
+
+	if len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 {
+		lockupTotal := Periods(msg.LockupPeriods).TotalAmount()
+		vestingTotal := Periods(msg.VestingPeriods).TotalAmount()
+		if !lockupTotal.IsEqual(vestingTotal) {
+			return sdkerrors.ErrInvalidRequest.Wrapf("lockup periods amount %s does not match vesting periods amount %s", lockupTotal, vestingTotal)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. The clawed back
+// coins are sent to the funder if destAddr is empty.
+//
+//nolint:interfacer
+func NewMsgClawback(funderAddr, addr, destAddr sdk.AccAddress) *MsgClawback {
+	var dest string
+	if destAddr != nil {
+		dest = destAddr.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress: funderAddr.String(),
+		Address:       addr.String(),
+		DestAddress:   dest,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(msg.FunderAddress)
+	return []sdk.AccAddress{funder}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+
+	return nil
+}
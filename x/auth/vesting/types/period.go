@@ -48,6 +48,45 @@ func (p Periods) TotalAmount() sdk.Coins {
 	return total
 }
 
+// elapsedPeriods returns the leading periods of a schedule starting at
+// startTime which are over at the given block time.
+func elapsedPeriods(startTime int64, periods []Period, blockTime time.Time) Periods {
+	// track the start time of the next period
+	currentPeriodStartTime := startTime
+
+	for i, period := range periods {
+		if blockTime.Unix()-currentPeriodStartTime < period.Length {
+			return periods[:i]
+		}
+
+		currentPeriodStartTime += period.Length
+	}
+
+	return periods
+}
+
+// capPeriods returns the periods with their cumulative amount capped to the
+// given total, so that the capped amounts are released first. The trailing
+// periods left without any coin are dropped.
+func capPeriods(periods []Period, total sdk.Coins) Periods {
+	var (
+		capped                 Periods
+		cumulative, prevCapped sdk.Coins
+	)
+	for _, period := range periods {
+		cumulative = cumulative.Add(period.Amount...)
+		cappedCumulative := cumulative.Min(total)
+		capped = append(capped, Period{Length: period.Length, Amount: cappedCumulative.Sub(prevCapped...)})
+		prevCapped = cappedCumulative
+	}
+
+	for len(capped) > 0 && capped[len(capped)-1].Amount.IsZero() {
+		capped = capped[:len(capped)-1]
+	}
+
+	return capped
+}
+
 // String implements the fmt.Stringer interface
 func (p Periods) String() string {
 	periodsListString := make([]string, len(p))
@@ -369,6 +369,436 @@ func (m *MsgCreatePeriodicVestingAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreatePeriodicVestingAccountResponse proto.InternalMessageInfo
 
+// MsgCreateVestingGrant defines a message that adds a periodic vesting grant,
+// clawback-able by its funder, to a new account or to an existing account
+// which accepted vesting grants.
+type MsgCreateVestingGrant struct {
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	ToAddress     string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// start of vesting as unix time (in seconds).
+	StartTime      int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	VestingPeriods []Period `protobuf:"bytes,4,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *MsgCreateVestingGrant) Reset()         { *m = MsgCreateVestingGrant{} }
+func (m *MsgCreateVestingGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingGrant) ProtoMessage()    {}
+func (*MsgCreateVestingGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{6}
+}
+func (m *MsgCreateVestingGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateVestingGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingGrant.Merge(m, src)
+}
+func (m *MsgCreateVestingGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateVestingGrant proto.InternalMessageInfo
+
+func (m *MsgCreateVestingGrant) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateVestingGrant) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateVestingGrant) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgCreateVestingGrant) GetVestingPeriods() []Period {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgCreateVestingGrantResponse defines the Msg/CreateVestingGrant response type.
+type MsgCreateVestingGrantResponse struct {
+}
+
+func (m *MsgCreateVestingGrantResponse) Reset()         { *m = MsgCreateVestingGrantResponse{} }
+func (m *MsgCreateVestingGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateVestingGrantResponse) ProtoMessage()    {}
+func (*MsgCreateVestingGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{7}
+}
+func (m *MsgCreateVestingGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateVestingGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateVestingGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateVestingGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateVestingGrantResponse.Merge(m, src)
+}
+func (m *MsgCreateVestingGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateVestingGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateVestingGrantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateVestingGrantResponse proto.InternalMessageInfo
+
+// MsgAcceptVestingGrants defines a message that converts the plain account of
+// its signer into a GrantVestingAccount without any vesting grant.
+type MsgAcceptVestingGrants struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *MsgAcceptVestingGrants) Reset()         { *m = MsgAcceptVestingGrants{} }
+func (m *MsgAcceptVestingGrants) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptVestingGrants) ProtoMessage()    {}
+func (*MsgAcceptVestingGrants) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{8}
+}
+func (m *MsgAcceptVestingGrants) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptVestingGrants) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptVestingGrants.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptVestingGrants) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptVestingGrants.Merge(m, src)
+}
+func (m *MsgAcceptVestingGrants) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptVestingGrants) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptVestingGrants.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptVestingGrants proto.InternalMessageInfo
+
+func (m *MsgAcceptVestingGrants) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// MsgAcceptVestingGrantsResponse defines the Msg/AcceptVestingGrants response type.
+type MsgAcceptVestingGrantsResponse struct {
+}
+
+func (m *MsgAcceptVestingGrantsResponse) Reset()         { *m = MsgAcceptVestingGrantsResponse{} }
+func (m *MsgAcceptVestingGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptVestingGrantsResponse) ProtoMessage()    {}
+func (*MsgAcceptVestingGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{9}
+}
+func (m *MsgAcceptVestingGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptVestingGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptVestingGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptVestingGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptVestingGrantsResponse.Merge(m, src)
+}
+func (m *MsgAcceptVestingGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptVestingGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptVestingGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptVestingGrantsResponse proto.InternalMessageInfo
+
+// MsgCreateClawbackVestingAccount defines a message that enables creating a
+// ClawbackVestingAccount.
+type MsgCreateClawbackVestingAccount struct {
+	// from_address is the funder of the account, which can claw back its
+	// unvested coins.
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// start of the lockup and vesting schedules as unix time (in seconds).
+	StartTime int64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// lockup_periods defines when the coins can be transferred, the coins are
+	// unlocked at start_time if empty.
+	LockupPeriods []Period `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3" json:"lockup_periods"`
+	// vesting_periods defines when the coins are earned, the coins are vested
+	// at start_time if empty.
+	VestingPeriods []Period `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *MsgCreateClawbackVestingAccount) Reset()         { *m = MsgCreateClawbackVestingAccount{} }
+func (m *MsgCreateClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateClawbackVestingAccount) ProtoMessage()    {}
+func (*MsgCreateClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{10}
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateClawbackVestingAccount.Merge(m, src)
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateClawbackVestingAccount proto.InternalMessageInfo
+
+func (m *MsgCreateClawbackVestingAccount) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetLockupPeriods() []Period {
+	if m != nil {
+		return m.LockupPeriods
+	}
+	return nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) GetVestingPeriods() []Period {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// MsgCreateClawbackVestingAccountResponse defines the
+// Msg/CreateClawbackVestingAccount response type.
+type MsgCreateClawbackVestingAccountResponse struct {
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Reset() {
+	*m = MsgCreateClawbackVestingAccountResponse{}
+}
+func (m *MsgCreateClawbackVestingAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateClawbackVestingAccountResponse) ProtoMessage()    {}
+func (*MsgCreateClawbackVestingAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{11}
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateClawbackVestingAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateClawbackVestingAccountResponse.Merge(m, src)
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateClawbackVestingAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateClawbackVestingAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateClawbackVestingAccountResponse proto.InternalMessageInfo
+
+// MsgClawback defines a message that removes the unvested coins funded by
+// funder_address from a GrantVestingAccount or a ClawbackVestingAccount.
+type MsgClawback struct {
+	// funder_address is the address of the funder of the grants to claw back.
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// address is the address of the vesting account.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// dest_address is the address receiving the clawed back coins, it
+	// defaults to funder_address if empty.
+	DestAddress string `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
+}
+
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{12}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+func (m *MsgClawback) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *MsgClawback) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgClawback) GetDestAddress() string {
+	if m != nil {
+		return m.DestAddress
+	}
+	return ""
+}
+
+// MsgClawbackResponse defines the Msg/Clawback response type.
+type MsgClawbackResponse struct {
+	// clawed_back is the amount of coins sent to the destination address,
+	// including the force-unbonded ones.
+	ClawedBack github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=clawed_back,json=clawedBack,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawed_back"`
+}
+
+func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
+func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackResponse) ProtoMessage()    {}
+func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5338ca97811f9792, []int{13}
+}
+func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackResponse.Merge(m, src)
+}
+func (m *MsgClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
+
+func (m *MsgClawbackResponse) GetClawedBack() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccount")
 	proto.RegisterType((*MsgCreateVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingAccountResponse")
@@ -376,54 +806,80 @@ func init() {
 	proto.RegisterType((*MsgCreatePermanentLockedAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreatePermanentLockedAccountResponse")
 	proto.RegisterType((*MsgCreatePeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccount")
 	proto.RegisterType((*MsgCreatePeriodicVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccountResponse")
+	proto.RegisterType((*MsgCreateVestingGrant)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingGrant")
+	proto.RegisterType((*MsgCreateVestingGrantResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateVestingGrantResponse")
+	proto.RegisterType((*MsgAcceptVestingGrants)(nil), "cosmos.vesting.v1beta1.MsgAcceptVestingGrants")
+	proto.RegisterType((*MsgAcceptVestingGrantsResponse)(nil), "cosmos.vesting.v1beta1.MsgAcceptVestingGrantsResponse")
+	proto.RegisterType((*MsgCreateClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.MsgCreateClawbackVestingAccount")
+	proto.RegisterType((*MsgCreateClawbackVestingAccountResponse)(nil), "cosmos.vesting.v1beta1.MsgCreateClawbackVestingAccountResponse")
+	proto.RegisterType((*MsgClawback)(nil), "cosmos.vesting.v1beta1.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "cosmos.vesting.v1beta1.MsgClawbackResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/vesting/v1beta1/tx.proto", fileDescriptor_5338ca97811f9792) }
 
 var fileDescriptor_5338ca97811f9792 = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0xba, 0xbf, 0x72, 0x45, 0xa0, 0x9a, 0x42, 0xdd, 0x88, 0xda, 0xa9, 0x05, 0x22,
-	0x44, 0xaa, 0xad, 0x16, 0x50, 0xa5, 0x80, 0x54, 0x35, 0x65, 0x83, 0x4a, 0xc8, 0x20, 0x06, 0x96,
-	0xc8, 0xb1, 0x0f, 0xd7, 0x6a, 0xed, 0x8b, 0x7c, 0x97, 0xaa, 0xd9, 0x2a, 0x46, 0x26, 0x46, 0x06,
-	0x86, 0x8e, 0x88, 0x29, 0x03, 0x33, 0x1b, 0x52, 0xc7, 0x8a, 0x89, 0x29, 0xa0, 0x64, 0x48, 0x07,
-	0xa6, 0xfe, 0x05, 0xc8, 0xbe, 0x73, 0x48, 0xc2, 0x39, 0x29, 0x2c, 0x2c, 0x71, 0x7c, 0xef, 0xfb,
-	0x7d, 0xf7, 0xfc, 0x79, 0xf7, 0x6c, 0xa0, 0xda, 0x08, 0xfb, 0x08, 0x1b, 0x07, 0x10, 0x13, 0x2f,
-	0x70, 0x8d, 0x83, 0xb5, 0x2a, 0x24, 0xd6, 0x9a, 0x41, 0x0e, 0xf5, 0x5a, 0x88, 0x08, 0x92, 0xae,
-	0x53, 0x81, 0xce, 0x04, 0x3a, 0x13, 0xe4, 0x16, 0x5c, 0xe4, 0xa2, 0x58, 0x62, 0x44, 0xff, 0xa8,
-	0x3a, 0xa7, 0xb0, 0x74, 0x55, 0x0b, 0xc3, 0x5e, 0x2e, 0x1b, 0x79, 0x01, 0x8b, 0x2f, 0xd1, 0x78,
-	0x85, 0x1a, 0x59, 0x6a, 0x1a, 0xba, 0x99, 0x52, 0x49, 0xb2, 0x31, 0x55, 0x2d, 0x32, 0x95, 0x8f,
-	0x23, 0x45, 0x74, 0x61, 0x81, 0x79, 0xcb, 0xf7, 0x02, 0x64, 0xc4, 0xbf, 0x74, 0x49, 0xfb, 0x39,
-	0x01, 0x16, 0x77, 0xb0, 0xbb, 0x1d, 0x42, 0x8b, 0xc0, 0x17, 0x34, 0xcd, 0x96, 0x6d, 0xa3, 0x7a,
-	0x40, 0xa4, 0x07, 0xe0, 0xd2, 0xab, 0x10, 0xf9, 0x15, 0xcb, 0x71, 0x42, 0x88, 0xb1, 0x2c, 0xe4,
-	0x85, 0x42, 0xb6, 0x2c, 0x7f, 0xfd, 0xb4, 0xba, 0xc0, 0xaa, 0xda, 0xa2, 0x91, 0x67, 0x24, 0xf4,
-	0x02, 0xd7, 0x9c, 0x8b, 0xd4, 0x6c, 0x49, 0xda, 0x00, 0x80, 0xa0, 0x9e, 0x75, 0x62, 0x8c, 0x35,
-	0x4b, 0x50, 0x62, 0xdc, 0x05, 0xd3, 0x96, 0x1f, 0xed, 0x2f, 0x8b, 0x79, 0xb1, 0x30, 0xb7, 0xbe,
-	0xa4, 0x33, 0x47, 0xc4, 0x2b, 0x41, 0xab, 0x6f, 0x23, 0x2f, 0x28, 0xdf, 0x3f, 0x69, 0xa9, 0x99,
-	0x8f, 0xdf, 0xd5, 0x82, 0xeb, 0x91, 0xdd, 0x7a, 0x55, 0xb7, 0x91, 0xcf, 0x78, 0xb1, 0xcb, 0x2a,
-	0x76, 0xf6, 0x0c, 0xd2, 0xa8, 0x41, 0x1c, 0x1b, 0xf0, 0x87, 0x6e, 0xb3, 0x28, 0x98, 0x2c, 0xbf,
-	0xb4, 0x04, 0x66, 0x61, 0xe0, 0x54, 0x88, 0xe7, 0x43, 0x79, 0x32, 0x2f, 0x14, 0x44, 0x73, 0x06,
-	0x06, 0xce, 0x73, 0xcf, 0x87, 0x92, 0x0c, 0x66, 0x1c, 0xb8, 0x6f, 0x35, 0xa0, 0x23, 0x4f, 0xe5,
-	0x85, 0xc2, 0xac, 0x99, 0xdc, 0x96, 0x1e, 0x9e, 0x1d, 0xab, 0xc2, 0xeb, 0x6e, 0xb3, 0x38, 0xc0,
-	0xe6, 0x4d, 0xb7, 0x59, 0xd4, 0xfa, 0xf6, 0x4c, 0x41, 0xaa, 0xad, 0x00, 0x35, 0x25, 0x64, 0x42,
-	0x5c, 0x43, 0x01, 0x86, 0xda, 0xe7, 0x89, 0x3e, 0xcd, 0x53, 0x18, 0xfa, 0x56, 0x00, 0x03, 0xf2,
-	0x04, 0xd9, 0x7b, 0xd0, 0x49, 0x3a, 0x53, 0xe2, 0x76, 0x66, 0xf1, 0xbc, 0xa5, 0x5e, 0x6d, 0x58,
-	0xfe, 0x7e, 0x49, 0xeb, 0x8f, 0x6a, 0x83, 0x8d, 0xb9, 0xc7, 0x69, 0xcc, 0xb5, 0xf3, 0x96, 0x3a,
-	0x4f, 0x9d, 0xbf, 0x63, 0xda, 0x7f, 0xe9, 0x4a, 0x69, 0x33, 0x15, 0xf0, 0x2d, 0x1e, 0xe0, 0x88,
-	0xd0, 0x00, 0x1c, 0xed, 0x0e, 0xb8, 0x3d, 0x86, 0x5f, 0x8f, 0xf5, 0xfb, 0x21, 0xd6, 0x1e, 0x72,
-	0x3c, 0x7b, 0x68, 0x0a, 0x56, 0x78, 0xac, 0x07, 0x91, 0x2e, 0xff, 0x89, 0xb4, 0x9f, 0xdd, 0x32,
-	0x00, 0x98, 0x58, 0x21, 0xa1, 0x27, 0x4d, 0x8c, 0x4f, 0x5a, 0x36, 0x5e, 0x89, 0xcf, 0x9a, 0x09,
-	0xae, 0xb0, 0xf9, 0xad, 0xd4, 0xe2, 0x12, 0xb0, 0x3c, 0x19, 0x33, 0x56, 0x74, 0xfe, 0x7b, 0x45,
-	0xa7, 0x95, 0x96, 0xb3, 0x11, 0x68, 0x0a, 0xef, 0x32, 0x93, 0xd0, 0x08, 0x2e, 0x3d, 0x3a, 0x3b,
-	0x56, 0x33, 0x5c, 0x88, 0xc5, 0x14, 0x88, 0x9c, 0x47, 0x1f, 0x26, 0xc9, 0x91, 0x24, 0x24, 0xd7,
-	0xbf, 0x88, 0x40, 0xdc, 0xc1, 0xae, 0x74, 0x24, 0x80, 0x05, 0xee, 0xcb, 0xc4, 0x48, 0x7b, 0x98,
-	0x94, 0x79, 0xc8, 0x6d, 0xfc, 0xa5, 0x21, 0x29, 0x45, 0x7a, 0x27, 0x80, 0x1b, 0x23, 0xa7, 0x67,
-	0x7c, 0x66, 0xbe, 0x31, 0xb7, 0xf9, 0x8f, 0x46, 0x7e, 0x69, 0xbc, 0xc3, 0x76, 0xa1, 0xd2, 0x38,
-	0xc6, 0x8b, 0x95, 0x36, 0xa2, 0x81, 0xb9, 0xa9, 0xa3, 0xe8, 0x20, 0x95, 0x1f, 0x9f, 0xb4, 0x15,
-	0xe1, 0xb4, 0xad, 0x08, 0x3f, 0xda, 0x8a, 0xf0, 0xb6, 0xa3, 0x64, 0x4e, 0x3b, 0x4a, 0xe6, 0x5b,
-	0x47, 0xc9, 0xbc, 0x5c, 0x1b, 0x39, 0xce, 0x87, 0x86, 0x55, 0x27, 0xbb, 0xbd, 0x0f, 0x53, 0x3c,
-	0xdd, 0xd5, 0xe9, 0xf8, 0x1b, 0x73, 0xf7, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x53, 0x83, 0x9d,
-	0x65, 0x41, 0x07, 0x00, 0x00,
+	// 951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xe3, 0x66, 0xbb, 0x69, 0xa7, 0x6c, 0xd1, 0xba, 0xdd, 0xd6, 0xb5, 0xa8, 0x9d, 0x1a,
+	0xd0, 0x86, 0xa2, 0xda, 0x6a, 0x61, 0x59, 0x14, 0x40, 0xd1, 0xa6, 0x48, 0x1c, 0xa0, 0xd2, 0x2a,
+	0x20, 0x0e, 0x08, 0x29, 0x9a, 0xd8, 0xb3, 0xa9, 0x95, 0xd8, 0x13, 0x3c, 0x93, 0xdd, 0x2d, 0x12,
+	0x52, 0xc5, 0x09, 0x71, 0xe2, 0x88, 0x04, 0x87, 0x3d, 0x22, 0x4e, 0x3d, 0x70, 0xe1, 0xc2, 0x79,
+	0x8f, 0x2b, 0x4e, 0x9c, 0x0a, 0x6a, 0x0f, 0xdd, 0x03, 0x12, 0xd2, 0x7e, 0x02, 0x34, 0x9e, 0xb1,
+	0x71, 0xc2, 0x38, 0x49, 0x53, 0xa1, 0x72, 0x69, 0x9a, 0x79, 0xff, 0xf7, 0xe6, 0xf9, 0xf7, 0xde,
+	0xbc, 0x71, 0x80, 0xe9, 0x62, 0x12, 0x60, 0xe2, 0xdc, 0x47, 0x84, 0xfa, 0x61, 0xdb, 0xb9, 0xbf,
+	0xdd, 0x42, 0x14, 0x6e, 0x3b, 0xf4, 0xa1, 0xdd, 0x8b, 0x30, 0xc5, 0xea, 0x0a, 0x17, 0xd8, 0x42,
+	0x60, 0x0b, 0x81, 0xbe, 0xdc, 0xc6, 0x6d, 0x1c, 0x4b, 0x1c, 0xf6, 0x1f, 0x57, 0xeb, 0x86, 0x08,
+	0xd7, 0x82, 0x04, 0xa5, 0xb1, 0x5c, 0xec, 0x87, 0xc2, 0xbe, 0xc6, 0xed, 0x4d, 0xee, 0x28, 0x42,
+	0x73, 0xd3, 0x4b, 0x39, 0x99, 0x24, 0x1b, 0x73, 0xd5, 0xaa, 0x50, 0x05, 0x84, 0x29, 0xd8, 0x87,
+	0x30, 0x5c, 0x87, 0x81, 0x1f, 0x62, 0x27, 0xfe, 0xcb, 0x97, 0xac, 0x3f, 0x67, 0xc0, 0xea, 0x1e,
+	0x69, 0xef, 0x46, 0x08, 0x52, 0xf4, 0x31, 0x0f, 0x73, 0xc7, 0x75, 0x71, 0x3f, 0xa4, 0xea, 0x5b,
+	0xe0, 0xb9, 0x7b, 0x11, 0x0e, 0x9a, 0xd0, 0xf3, 0x22, 0x44, 0x88, 0xa6, 0x94, 0x95, 0xca, 0x7c,
+	0x5d, 0xfb, 0xf5, 0xa7, 0xad, 0x65, 0x91, 0xd5, 0x1d, 0x6e, 0xf9, 0x90, 0x46, 0x7e, 0xd8, 0x6e,
+	0x2c, 0x30, 0xb5, 0x58, 0x52, 0x6f, 0x03, 0x40, 0x71, 0xea, 0x3a, 0x33, 0xc6, 0x75, 0x9e, 0xe2,
+	0xc4, 0x71, 0x1f, 0x5c, 0x85, 0x01, 0xdb, 0x5f, 0x2b, 0x96, 0x8b, 0x95, 0x85, 0x9d, 0x35, 0x5b,
+	0x78, 0x30, 0x5e, 0x09, 0x5a, 0x7b, 0x17, 0xfb, 0x61, 0xfd, 0xd6, 0xe3, 0x63, 0xb3, 0xf0, 0xe3,
+	0xef, 0x66, 0xa5, 0xed, 0xd3, 0xfd, 0x7e, 0xcb, 0x76, 0x71, 0x20, 0x78, 0x89, 0x8f, 0x2d, 0xe2,
+	0x75, 0x1c, 0x7a, 0xd0, 0x43, 0x24, 0x76, 0x20, 0x3f, 0x9c, 0x1d, 0x6d, 0x2a, 0x0d, 0x11, 0x5f,
+	0x5d, 0x03, 0x73, 0x28, 0xf4, 0x9a, 0xd4, 0x0f, 0x90, 0x76, 0xa5, 0xac, 0x54, 0x8a, 0x8d, 0x12,
+	0x0a, 0xbd, 0x8f, 0xfc, 0x00, 0xa9, 0x1a, 0x28, 0x79, 0xa8, 0x0b, 0x0f, 0x90, 0xa7, 0xcd, 0x96,
+	0x95, 0xca, 0x5c, 0x23, 0xf9, 0x5a, 0x7d, 0xfb, 0xe9, 0x23, 0x53, 0xf9, 0xf2, 0xec, 0x68, 0x73,
+	0x80, 0xcd, 0xd7, 0x67, 0x47, 0x9b, 0x56, 0x66, 0xcf, 0x1c, 0xa4, 0xd6, 0x06, 0x30, 0x73, 0x4c,
+	0x0d, 0x44, 0x7a, 0x38, 0x24, 0xc8, 0xfa, 0x65, 0x26, 0xa3, 0xb9, 0x8b, 0xa2, 0x00, 0x86, 0x28,
+	0xa4, 0x1f, 0x60, 0xb7, 0x83, 0xbc, 0xa4, 0x32, 0x55, 0x69, 0x65, 0x56, 0x9f, 0x1d, 0x9b, 0x4b,
+	0x07, 0x30, 0xe8, 0x56, 0xad, 0xac, 0xd5, 0x1a, 0x2c, 0xcc, 0xeb, 0x92, 0xc2, 0xdc, 0x78, 0x76,
+	0x6c, 0x5e, 0xe7, 0x9e, 0xff, 0xd8, 0xac, 0x4b, 0xa9, 0x4a, 0xb5, 0x96, 0x0b, 0xf8, 0x65, 0x19,
+	0x60, 0x46, 0x68, 0x00, 0x8e, 0xf5, 0x0a, 0xb8, 0x39, 0x86, 0x5f, 0xca, 0xfa, 0xfb, 0x21, 0xd6,
+	0x3e, 0xf6, 0x7c, 0x77, 0xe8, 0x14, 0x6c, 0xc8, 0x58, 0x0f, 0x22, 0x5d, 0xff, 0x37, 0xd2, 0x2c,
+	0xbb, 0x75, 0x00, 0x08, 0x85, 0x11, 0xe5, 0x9d, 0x56, 0x8c, 0x3b, 0x6d, 0x3e, 0x5e, 0x89, 0x7b,
+	0xad, 0x01, 0x9e, 0x17, 0xe7, 0xb7, 0xd9, 0x8b, 0x53, 0x20, 0xda, 0x95, 0x98, 0xb1, 0x61, 0xcb,
+	0xe7, 0x8a, 0xcd, 0x33, 0xad, 0xcf, 0x33, 0xd0, 0x1c, 0xde, 0xa2, 0x90, 0x70, 0x0b, 0xa9, 0xbe,
+	0xfb, 0xf4, 0x91, 0x59, 0x90, 0x42, 0xdc, 0xcc, 0x81, 0x28, 0x79, 0xf4, 0x61, 0x92, 0x12, 0x49,
+	0xb6, 0x6b, 0x6f, 0x0c, 0x77, 0xf6, 0x7b, 0x11, 0x0c, 0xa9, 0x5a, 0x03, 0x8b, 0xf7, 0xfa, 0xa1,
+	0x87, 0xa2, 0x89, 0xe7, 0xc8, 0x35, 0xae, 0xbf, 0xf0, 0x24, 0xb9, 0x04, 0xee, 0x6f, 0x32, 0xe6,
+	0x43, 0xcf, 0xcb, 0xa8, 0x97, 0x47, 0xcc, 0x86, 0x18, 0x93, 0x65, 0x82, 0x75, 0xa9, 0x21, 0x25,
+	0x7c, 0xa8, 0x80, 0x95, 0x3d, 0xc2, 0xc0, 0xa3, 0x1e, 0xcd, 0x2a, 0x88, 0xba, 0x03, 0x4a, 0x93,
+	0xb2, 0x4d, 0x84, 0xd5, 0x1d, 0x96, 0x69, 0x29, 0x93, 0xe2, 0xc6, 0x60, 0x8a, 0x92, 0x7d, 0xac,
+	0x32, 0x30, 0xe4, 0x96, 0x34, 0xc9, 0xef, 0x8a, 0x99, 0x03, 0xb5, 0xdb, 0x85, 0x0f, 0x5a, 0xd0,
+	0xed, 0xfc, 0x2f, 0xae, 0x95, 0x31, 0xcd, 0x70, 0x17, 0x2c, 0x76, 0xb1, 0xdb, 0xe9, 0xf7, 0xa6,
+	0xef, 0x85, 0x6b, 0x3c, 0x00, 0x37, 0x10, 0x59, 0x7b, 0xcd, 0x5e, 0xb4, 0xbd, 0xde, 0x91, 0x1e,
+	0xe9, 0x9b, 0xb2, 0xe6, 0xca, 0xc2, 0x97, 0x9d, 0x67, 0x79, 0x71, 0xd2, 0x42, 0xfe, 0xa5, 0x80,
+	0x05, 0xa6, 0x15, 0xaa, 0x8b, 0x9f, 0xe2, 0x4c, 0x8f, 0xce, 0x4c, 0xd8, 0xa3, 0xac, 0x53, 0x3c,
+	0x44, 0x68, 0xba, 0x65, 0x71, 0x5c, 0xa7, 0x30, 0xb5, 0x58, 0xaa, 0xda, 0x39, 0x47, 0x71, 0x65,
+	0x88, 0x96, 0x78, 0x42, 0xeb, 0x2b, 0x05, 0x2c, 0x65, 0xbe, 0x27, 0x24, 0xd4, 0xcf, 0xc0, 0x82,
+	0xdb, 0x85, 0x0f, 0x90, 0xd7, 0x64, 0xcb, 0x9a, 0xf2, 0x1f, 0x5d, 0x7f, 0x80, 0x6f, 0x52, 0x87,
+	0x6e, 0x67, 0xe7, 0xe7, 0x12, 0x28, 0xee, 0x91, 0xb6, 0x7a, 0xa8, 0x80, 0x65, 0xe9, 0x9b, 0x99,
+	0x93, 0xd7, 0x42, 0x39, 0x2f, 0x17, 0xfa, 0xed, 0x73, 0x3a, 0xa4, 0x4f, 0xff, 0xad, 0x02, 0x5e,
+	0x18, 0xf9, 0x2a, 0x32, 0x3e, 0xb2, 0xdc, 0x51, 0xaf, 0x4d, 0xe9, 0x28, 0x4f, 0x4d, 0x76, 0x73,
+	0x4f, 0x94, 0x9a, 0xc4, 0x51, 0xaf, 0x4d, 0xe9, 0x98, 0xa6, 0xf6, 0x39, 0x50, 0x25, 0x37, 0xe1,
+	0xd6, 0xa4, 0x45, 0x88, 0xe5, 0xfa, 0xad, 0x73, 0xc9, 0xd3, 0xbd, 0xbf, 0x00, 0x4b, 0xb2, 0x3b,
+	0xc2, 0x1e, 0x11, 0x4d, 0xa2, 0xd7, 0xdf, 0x38, 0x9f, 0x5e, 0x52, 0x95, 0x9c, 0xf1, 0x3f, 0xbe,
+	0x2a, 0x72, 0x47, 0xbd, 0x36, 0xa5, 0x63, 0x9a, 0xda, 0xa7, 0x60, 0x2e, 0x9d, 0x67, 0x2f, 0x8e,
+	0x0a, 0x26, 0x44, 0xfa, 0xab, 0x13, 0x88, 0x92, 0xe8, 0xfa, 0xec, 0x21, 0x3b, 0xc7, 0xf5, 0xf7,
+	0x1f, 0x9f, 0x18, 0xca, 0x93, 0x13, 0x43, 0xf9, 0xe3, 0xc4, 0x50, 0xbe, 0x39, 0x35, 0x0a, 0x4f,
+	0x4e, 0x8d, 0xc2, 0x6f, 0xa7, 0x46, 0xe1, 0x93, 0xed, 0x91, 0x03, 0xe1, 0xa1, 0x03, 0xfb, 0x74,
+	0x3f, 0xfd, 0x65, 0x17, 0xcf, 0x87, 0xd6, 0xd5, 0xf8, 0x47, 0xda, 0x6b, 0x7f, 0x0f, 0x00, 0xcc,
+	0x22, 0xf9, 0xd8, 0x82, 0x0e, 0x00, 0x00,
 }
 
 func (this *MsgCreateVestingAccount) Equal(that interface{}) bool {
@@ -528,6 +984,22 @@ type MsgClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(ctx context.Context, in *MsgCreatePeriodicVestingAccount, opts ...grpc.CallOption) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// CreateVestingGrant defines a method that adds a clawback-able vesting grant
+	// to an account. The account is created if it doesn't exist yet, an existing
+	// account must have accepted vesting grants first.
+	CreateVestingGrant(ctx context.Context, in *MsgCreateVestingGrant, opts ...grpc.CallOption) (*MsgCreateVestingGrantResponse, error)
+	// AcceptVestingGrants defines a method that enables the owner of a plain
+	// account to convert it into a GrantVestingAccount, consenting to receive
+	// vesting grants.
+	AcceptVestingGrants(ctx context.Context, in *MsgAcceptVestingGrants, opts ...grpc.CallOption) (*MsgAcceptVestingGrantsResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// vesting account with separate lockup and vesting schedules, whose unvested
+	// coins can be clawed back by the funder.
+	CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback defines a method that enables a funder to claw back the coins
+	// of a vesting account which have not vested yet. Delegated unvested coins
+	// are force-unbonded to the destination address.
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
 }
 
 type msgClient struct {
@@ -565,14 +1037,50 @@ func (c *msgClient) CreatePeriodicVestingAccount(ctx context.Context, in *MsgCre
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateVestingAccount defines a method that enables creating a vesting
-	// account.
-	CreateVestingAccount(context.Context, *MsgCreateVestingAccount) (*MsgCreateVestingAccountResponse, error)
-	// CreatePermanentLockedAccount defines a method that enables creating a permanent
-	// locked account.
-	//
+func (c *msgClient) CreateVestingGrant(ctx context.Context, in *MsgCreateVestingGrant, opts ...grpc.CallOption) (*MsgCreateVestingGrantResponse, error) {
+	out := new(MsgCreateVestingGrantResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/CreateVestingGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptVestingGrants(ctx context.Context, in *MsgAcceptVestingGrants, opts ...grpc.CallOption) (*MsgAcceptVestingGrantsResponse, error) {
+	out := new(MsgAcceptVestingGrantsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/AcceptVestingGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateClawbackVestingAccount(ctx context.Context, in *MsgCreateClawbackVestingAccount, opts ...grpc.CallOption) (*MsgCreateClawbackVestingAccountResponse, error) {
+	out := new(MsgCreateClawbackVestingAccountResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.vesting.v1beta1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateVestingAccount defines a method that enables creating a vesting
+	// account.
+	CreateVestingAccount(context.Context, *MsgCreateVestingAccount) (*MsgCreateVestingAccountResponse, error)
+	// CreatePermanentLockedAccount defines a method that enables creating a permanent
+	// locked account.
+	//
 	// Since: cosmos-sdk 0.46
 	CreatePermanentLockedAccount(context.Context, *MsgCreatePermanentLockedAccount) (*MsgCreatePermanentLockedAccountResponse, error)
 	// CreatePeriodicVestingAccount defines a method that enables creating a
@@ -580,6 +1088,22 @@ type MsgServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	CreatePeriodicVestingAccount(context.Context, *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error)
+	// CreateVestingGrant defines a method that adds a clawback-able vesting grant
+	// to an account. The account is created if it doesn't exist yet, an existing
+	// account must have accepted vesting grants first.
+	CreateVestingGrant(context.Context, *MsgCreateVestingGrant) (*MsgCreateVestingGrantResponse, error)
+	// AcceptVestingGrants defines a method that enables the owner of a plain
+	// account to convert it into a GrantVestingAccount, consenting to receive
+	// vesting grants.
+	AcceptVestingGrants(context.Context, *MsgAcceptVestingGrants) (*MsgAcceptVestingGrantsResponse, error)
+	// CreateClawbackVestingAccount defines a method that enables creating a
+	// vesting account with separate lockup and vesting schedules, whose unvested
+	// coins can be clawed back by the funder.
+	CreateClawbackVestingAccount(context.Context, *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error)
+	// Clawback defines a method that enables a funder to claw back the coins
+	// of a vesting account which have not vested yet. Delegated unvested coins
+	// are force-unbonded to the destination address.
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -595,6 +1119,18 @@ func (*UnimplementedMsgServer) CreatePermanentLockedAccount(ctx context.Context,
 func (*UnimplementedMsgServer) CreatePeriodicVestingAccount(ctx context.Context, req *MsgCreatePeriodicVestingAccount) (*MsgCreatePeriodicVestingAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePeriodicVestingAccount not implemented")
 }
+func (*UnimplementedMsgServer) CreateVestingGrant(ctx context.Context, req *MsgCreateVestingGrant) (*MsgCreateVestingGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVestingGrant not implemented")
+}
+func (*UnimplementedMsgServer) AcceptVestingGrants(ctx context.Context, req *MsgAcceptVestingGrants) (*MsgAcceptVestingGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptVestingGrants not implemented")
+}
+func (*UnimplementedMsgServer) CreateClawbackVestingAccount(ctx context.Context, req *MsgCreateClawbackVestingAccount) (*MsgCreateClawbackVestingAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClawbackVestingAccount not implemented")
+}
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -654,6 +1190,78 @@ func _Msg_CreatePeriodicVestingAccount_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateVestingGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVestingGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVestingGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/CreateVestingGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVestingGrant(ctx, req.(*MsgCreateVestingGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptVestingGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptVestingGrants)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptVestingGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/AcceptVestingGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptVestingGrants(ctx, req.(*MsgAcceptVestingGrants))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateClawbackVestingAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClawbackVestingAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/CreateClawbackVestingAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClawbackVestingAccount(ctx, req.(*MsgCreateClawbackVestingAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.vesting.v1beta1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.vesting.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -670,6 +1278,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePeriodicVestingAccount",
 			Handler:    _Msg_CreatePeriodicVestingAccount_Handler,
 		},
+		{
+			MethodName: "CreateVestingGrant",
+			Handler:    _Msg_CreateVestingGrant_Handler,
+		},
+		{
+			MethodName: "AcceptVestingGrants",
+			Handler:    _Msg_AcceptVestingGrants_Handler,
+		},
+		{
+			MethodName: "CreateClawbackVestingAccount",
+			Handler:    _Msg_CreateClawbackVestingAccount_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/vesting/v1beta1/tx.proto",
@@ -917,95 +1541,401 @@ func (m *MsgCreatePeriodicVestingAccountResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCreateVestingGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCreateVestingGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.EndTime != 0 {
-		n += 1 + sovTx(uint64(m.EndTime))
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Delayed {
-		n += 2
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateVestingAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateVestingGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVestingGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVestingGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePermanentLockedAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptVestingGrants) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptVestingGrants) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptVestingGrants) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ToAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePermanentLockedAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptVestingGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptVestingGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptVestingGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePeriodicVestingAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestAddress) > 0 {
+		i -= len(m.DestAddress)
+		copy(dAtA[i:], m.DestAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClawedBack) > 0 {
+		for iNdEx := len(m.ClawedBack) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawedBack[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovTx(uint64(m.EndTime))
+	}
+	if m.Delayed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePermanentLockedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreatePermanentLockedAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePeriodicVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	l = len(m.ToAddress)
@@ -1033,13 +1963,1016 @@ func (m *MsgCreatePeriodicVestingAccountResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgCreateVestingGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgCreateVestingGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptVestingGrants) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptVestingGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovTx(uint64(m.StartTime))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateClawbackVestingAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClawedBack) > 0 {
+		for _, e := range m.ClawedBack {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delayed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVestingGrantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVestingGrantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVestingGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptVestingGrants) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1062,47 +2995,15 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptVestingGrants: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptVestingGrants: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1130,81 +3031,8 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			m.EndTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delayed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Delayed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1226,7 +3054,7 @@ func (m *MsgCreateVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptVestingGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1249,10 +3077,10 @@ func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptVestingGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptVestingGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1276,7 +3104,7 @@ func (m *MsgCreateVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1299,10 +3127,10 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1370,8 +3198,27 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 			m.ToAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1398,8 +3245,42 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LockupPeriods = append(m.LockupPeriods, Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1424,7 +3305,7 @@ func (m *MsgCreatePermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateClawbackVestingAccountResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1447,10 +3328,10 @@ func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePermanentLockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateClawbackVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1474,7 +3355,7 @@ func (m *MsgCreatePermanentLockedAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1497,15 +3378,15 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1533,11 +3414,11 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1565,32 +3446,13 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			m.StartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1600,25 +3462,23 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1641,7 +3501,7 @@ func (m *MsgCreatePeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1664,12 +3524,46 @@ func (m *MsgCreatePeriodicVestingAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePeriodicVestingAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawedBack = append(m.ClawedBack, types.Coin{})
+			if err := m.ClawedBack[len(m.ClawedBack)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -283,6 +284,160 @@ func (m *PermanentLockedAccount) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PermanentLockedAccount proto.InternalMessageInfo
 
+// VestingGrant defines a periodic vesting schedule funded by a single funder.
+// The coins of a grant which are still vesting can be clawed back by its
+// funder.
+type VestingGrant struct {
+	// funder_address is the address of the account which funded the grant.
+	FunderAddress string `protobuf:"bytes,1,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// start of vesting as unix time (in seconds).
+	StartTime      int64    `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	VestingPeriods []Period `protobuf:"bytes,3,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *VestingGrant) Reset()         { *m = VestingGrant{} }
+func (m *VestingGrant) String() string { return proto.CompactTextString(m) }
+func (*VestingGrant) ProtoMessage()    {}
+func (*VestingGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{6}
+}
+func (m *VestingGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingGrant.Merge(m, src)
+}
+func (m *VestingGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingGrant proto.InternalMessageInfo
+
+func (m *VestingGrant) GetFunderAddress() string {
+	if m != nil {
+		return m.FunderAddress
+	}
+	return ""
+}
+
+func (m *VestingGrant) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *VestingGrant) GetVestingPeriods() []Period {
+	if m != nil {
+		return m.VestingPeriods
+	}
+	return nil
+}
+
+// GrantVestingAccount implements the VestingAccount interface. It vests the
+// coins of one or more independent vesting grants, each of them vesting
+// periodically and clawback-able by its funder. Grants can be added to an
+// account which already holds coins.
+type GrantVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	Grants              []VestingGrant `protobuf:"bytes,2,rep,name=grants,proto3" json:"grants"`
+}
+
+func (m *GrantVestingAccount) Reset()      { *m = GrantVestingAccount{} }
+func (*GrantVestingAccount) ProtoMessage() {}
+func (*GrantVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{7}
+}
+func (m *GrantVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantVestingAccount.Merge(m, src)
+}
+func (m *GrantVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantVestingAccount proto.InternalMessageInfo
+
+// ClawbackVestingAccount implements the VestingAccount interface. It has
+// separate lockup and vesting schedules: the lockup periods define when the
+// coins can be transferred and the vesting periods define when they are
+// earned. The funder can claw back the coins which have not been earned yet,
+// including the delegated ones.
+type ClawbackVestingAccount struct {
+	*BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
+	// funder_address is the address of the account which funded the vesting
+	// account and can claw back its unvested coins.
+	FunderAddress string `protobuf:"bytes,2,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty"`
+	// start of the lockup and vesting schedules as unix time (in seconds).
+	StartTime      int64    `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	LockupPeriods  []Period `protobuf:"bytes,4,rep,name=lockup_periods,json=lockupPeriods,proto3" json:"lockup_periods"`
+	VestingPeriods []Period `protobuf:"bytes,5,rep,name=vesting_periods,json=vestingPeriods,proto3" json:"vesting_periods"`
+}
+
+func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
+func (*ClawbackVestingAccount) ProtoMessage() {}
+func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e80273ca606d6e, []int{8}
+}
+func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClawbackVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClawbackVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClawbackVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClawbackVestingAccount.Merge(m, src)
+}
+func (m *ClawbackVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClawbackVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClawbackVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClawbackVestingAccount proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*BaseVestingAccount)(nil), "cosmos.vesting.v1beta1.BaseVestingAccount")
 	proto.RegisterType((*ContinuousVestingAccount)(nil), "cosmos.vesting.v1beta1.ContinuousVestingAccount")
@@ -290,6 +445,9 @@ func init() {
 	proto.RegisterType((*Period)(nil), "cosmos.vesting.v1beta1.Period")
 	proto.RegisterType((*PeriodicVestingAccount)(nil), "cosmos.vesting.v1beta1.PeriodicVestingAccount")
 	proto.RegisterType((*PermanentLockedAccount)(nil), "cosmos.vesting.v1beta1.PermanentLockedAccount")
+	proto.RegisterType((*VestingGrant)(nil), "cosmos.vesting.v1beta1.VestingGrant")
+	proto.RegisterType((*GrantVestingAccount)(nil), "cosmos.vesting.v1beta1.GrantVestingAccount")
+	proto.RegisterType((*ClawbackVestingAccount)(nil), "cosmos.vesting.v1beta1.ClawbackVestingAccount")
 }
 
 func init() {
@@ -297,45 +455,55 @@ func init() {
 }
 
 var fileDescriptor_89e80273ca606d6e = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x21, 0xd0, 0x0b, 0xf4, 0x87, 0x55, 0xa2, 0xb4, 0x52, 0x9d, 0x60, 0x18, 0xa2,
-	0x48, 0xb5, 0x49, 0x11, 0x4b, 0x36, 0x52, 0x84, 0x84, 0x60, 0x40, 0x11, 0x62, 0x60, 0x89, 0xce,
-	0xf6, 0xe1, 0x9c, 0x1a, 0xdf, 0x45, 0xbe, 0x4b, 0xa1, 0x42, 0xec, 0x88, 0xa9, 0x23, 0x12, 0x03,
-	0x1d, 0x51, 0xc5, 0xd0, 0x3f, 0xa3, 0x1b, 0x19, 0x99, 0x0a, 0x4a, 0x86, 0xfe, 0x1b, 0xc8, 0xe7,
-	0xb3, 0x6b, 0x25, 0x69, 0xb7, 0x54, 0x2c, 0x89, 0xbf, 0x1f, 0xf7, 0xbd, 0xf7, 0x3e, 0x3f, 0xeb,
-	0xe0, 0x03, 0x97, 0xf1, 0x80, 0x71, 0x7b, 0x1f, 0x73, 0x41, 0xa8, 0x6f, 0xef, 0x37, 0x1d, 0x2c,
-	0x50, 0x33, 0x89, 0xad, 0x41, 0xc8, 0x04, 0xd3, 0xcb, 0x71, 0x97, 0x95, 0x64, 0x55, 0xd7, 0xe6,
-	0x1a, 0x0a, 0x08, 0x65, 0xb6, 0xfc, 0x8d, 0x5b, 0x37, 0xd7, 0x7d, 0xe6, 0x33, 0xf9, 0x68, 0x47,
-	0x4f, 0x2a, 0x6b, 0x28, 0x18, 0x07, 0x71, 0x9c, 0x62, 0xb8, 0x8c, 0xd0, 0xa9, 0x3a, 0x1a, 0x8a,
-	0x5e, 0x5a, 0x8f, 0x82, 0xb8, 0x6e, 0x7e, 0x2b, 0x40, 0xbd, 0x8d, 0x38, 0x7e, 0x13, 0x13, 0x78,
-	0xe2, 0xba, 0x6c, 0x48, 0x85, 0xfe, 0x1c, 0xde, 0x8e, 0x26, 0x76, 0x51, 0x1c, 0x57, 0x40, 0x0d,
-	0xd4, 0x4b, 0x3b, 0x35, 0x4b, 0xd1, 0x95, 0x03, 0xd4, 0x34, 0x2b, 0x3a, 0xae, 0xce, 0xb5, 0x0b,
-	0xa3, 0xb3, 0x2a, 0xe8, 0x94, 0x9c, 0x8b, 0x94, 0xfe, 0x11, 0xae, 0xb2, 0x90, 0xf8, 0x84, 0xa2,
-	0x7e, 0x57, 0xc9, 0xac, 0xe4, 0x6a, 0xf9, 0x7a, 0x69, 0x67, 0x23, 0x19, 0x17, 0xb5, 0xa7, 0xe3,
-	0x76, 0x19, 0xa1, 0xed, 0xc7, 0xa7, 0x67, 0x55, 0xed, 0xf8, 0x4f, 0xb5, 0xee, 0x13, 0xd1, 0x1b,
-	0x3a, 0x96, 0xcb, 0x02, 0x5b, 0x29, 0x89, 0xff, 0xb6, 0xb9, 0xb7, 0x67, 0x8b, 0x83, 0x01, 0xe6,
-	0xf2, 0x00, 0xff, 0x71, 0x7e, 0xd2, 0x00, 0x9d, 0x95, 0x04, 0x49, 0xc9, 0xd1, 0xdf, 0xc3, 0x65,
-	0x0f, 0xf7, 0xb1, 0x8f, 0x04, 0xf6, 0xba, 0xef, 0x42, 0x8c, 0x2b, 0xf9, 0x05, 0x41, 0xdf, 0x49,
-	0x71, 0x9e, 0x85, 0x18, 0xeb, 0x9f, 0xe0, 0xda, 0x05, 0x70, 0x22, 0xbb, 0xb0, 0x20, 0xec, 0xd5,
-	0x14, 0x2a, 0xd1, 0xbd, 0x01, 0x6f, 0x61, 0xea, 0x75, 0x05, 0x09, 0x70, 0xe5, 0x46, 0x0d, 0xd4,
-	0xf3, 0x9d, 0x9b, 0x98, 0x7a, 0xaf, 0x49, 0x80, 0x5b, 0x8d, 0xcf, 0x47, 0x55, 0xed, 0xeb, 0x51,
-	0x55, 0xfb, 0x72, 0x7e, 0xd2, 0xd8, 0xca, 0x8c, 0x9d, 0xb5, 0x81, 0xf9, 0x0b, 0xc0, 0xca, 0x2e,
-	0xa3, 0x82, 0xd0, 0x21, 0x1b, 0xf2, 0x29, 0x8f, 0x38, 0x70, 0x5d, 0x7a, 0x44, 0xa9, 0x9b, 0xf2,
-	0x4a, 0xc3, 0x9a, 0x6f, 0x6d, 0x6b, 0x16, 0x46, 0xb9, 0x46, 0x77, 0x66, 0x7d, 0xb8, 0x05, 0x21,
-	0x17, 0x28, 0x14, 0xb1, 0x92, 0x9c, 0x54, 0xb2, 0x24, 0x33, 0x52, 0xcb, 0xc3, 0xac, 0x96, 0xfb,
-	0x19, 0x2d, 0x97, 0x91, 0x36, 0x8f, 0x01, 0xbc, 0xfb, 0x14, 0xf7, 0xd1, 0x41, 0xba, 0xab, 0x6b,
-	0x94, 0xd3, 0xda, 0xce, 0xf2, 0xad, 0x65, 0xf8, 0xce, 0xa5, 0x64, 0x1e, 0x02, 0x58, 0x7c, 0x85,
-	0x43, 0xc2, 0x3c, 0xbd, 0x0c, 0x8b, 0x7d, 0x4c, 0x7d, 0xd1, 0x93, 0x7c, 0xf2, 0x1d, 0x15, 0xe9,
-	0x3d, 0x58, 0x44, 0x81, 0xe4, 0xb9, 0xa8, 0x6f, 0x4a, 0xcd, 0x6f, 0x15, 0x22, 0xde, 0xe6, 0xf7,
-	0x1c, 0x2c, 0xc7, 0x94, 0x88, 0xfb, 0xdf, 0xf9, 0x41, 0xef, 0xc0, 0x95, 0x04, 0x7d, 0x20, 0x49,
-	0x72, 0xf5, 0xbd, 0x1b, 0x97, 0xa1, 0xc7, 0x5a, 0xda, 0x4b, 0xd1, 0x6e, 0x62, 0xbd, 0xcb, 0xaa,
-	0x25, 0xae, 0xf0, 0x96, 0x95, 0x7d, 0x67, 0xf7, 0x32, 0x9b, 0x9a, 0xbf, 0x06, 0xf3, 0x27, 0x90,
-	0x1b, 0x0a, 0x10, 0xc5, 0x54, 0xbc, 0x64, 0xee, 0x1e, 0xf6, 0xae, 0xd3, 0x62, 0x57, 0xd1, 0x9d,
-	0xc3, 0xa9, 0xfd, 0xe2, 0x74, 0x6c, 0x80, 0xd1, 0xd8, 0x00, 0x7f, 0xc7, 0x06, 0x38, 0x9c, 0x18,
-	0xda, 0x68, 0x62, 0x68, 0xbf, 0x27, 0x86, 0xf6, 0xb6, 0x79, 0xa5, 0x4f, 0x3e, 0xa8, 0x2b, 0x45,
-	0x5d, 0x6f, 0xd2, 0x36, 0x4e, 0x51, 0x5e, 0x2a, 0x8f, 0xfe, 0x05, 0x00, 0x00, 0xff, 0xff, 0x18,
-	0x83, 0xdb, 0x67, 0xfd, 0x06, 0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x4f, 0x13, 0x4d,
+	0x18, 0xee, 0xb4, 0xa5, 0xdf, 0xc7, 0x00, 0x05, 0xf6, 0xe3, 0x6b, 0x0a, 0x09, 0xdb, 0xba, 0x72,
+	0x68, 0x6a, 0xd8, 0x0a, 0xc6, 0x4b, 0x2f, 0x86, 0x62, 0x24, 0x46, 0x0f, 0xa4, 0x1a, 0x0f, 0x5e,
+	0x9a, 0xd9, 0xdd, 0x61, 0xbb, 0x69, 0x3b, 0xd3, 0xec, 0x4c, 0x41, 0x62, 0xbc, 0x1b, 0x4f, 0x1c,
+	0x4d, 0x3c, 0xc8, 0xd1, 0x10, 0x0f, 0xc4, 0xf8, 0x0b, 0x3c, 0x71, 0x93, 0x78, 0xf2, 0x84, 0x06,
+	0x0e, 0x1c, 0xfd, 0x0b, 0x66, 0x67, 0xa6, 0x65, 0x6d, 0x17, 0x12, 0x63, 0x0a, 0x5e, 0xda, 0x9d,
+	0x79, 0xdf, 0x79, 0x9f, 0xe7, 0x79, 0xe7, 0x99, 0xc9, 0xc0, 0x05, 0x9b, 0xb2, 0x16, 0x65, 0xa5,
+	0x4d, 0xcc, 0xb8, 0x47, 0xdc, 0xd2, 0xe6, 0x92, 0x85, 0x39, 0x5a, 0xea, 0x8e, 0xcd, 0xb6, 0x4f,
+	0x39, 0xd5, 0x32, 0x32, 0xcb, 0xec, 0xce, 0xaa, 0xac, 0xb9, 0x69, 0xd4, 0xf2, 0x08, 0x2d, 0x89,
+	0x5f, 0x99, 0x3a, 0x37, 0xe3, 0x52, 0x97, 0x8a, 0xcf, 0x52, 0xf0, 0xa5, 0x66, 0x75, 0x05, 0x63,
+	0x21, 0x86, 0x7b, 0x18, 0x36, 0xf5, 0x48, 0x5f, 0x1c, 0x75, 0x78, 0xbd, 0x17, 0x0f, 0x06, 0x2a,
+	0x3e, 0x2b, 0xe3, 0x35, 0x59, 0x58, 0xb1, 0x11, 0x03, 0xe3, 0x4d, 0x12, 0x6a, 0x15, 0xc4, 0xf0,
+	0x13, 0xc9, 0x6d, 0xc5, 0xb6, 0x69, 0x87, 0x70, 0xed, 0x3e, 0x1c, 0x0f, 0xc0, 0x6a, 0x48, 0x8e,
+	0xb3, 0x20, 0x0f, 0x0a, 0x63, 0xcb, 0x79, 0x53, 0xad, 0x15, 0xb5, 0x15, 0x90, 0x19, 0x2c, 0x57,
+	0xeb, 0x2a, 0xc9, 0xc3, 0xa3, 0x1c, 0xa8, 0x8e, 0x59, 0x67, 0x53, 0xda, 0x73, 0x38, 0x45, 0x7d,
+	0xcf, 0xf5, 0x08, 0x6a, 0xd6, 0x54, 0x07, 0xb2, 0xf1, 0x7c, 0xa2, 0x30, 0xb6, 0x3c, 0xdb, 0x2d,
+	0x17, 0xa4, 0xf7, 0xca, 0xad, 0x52, 0x8f, 0x54, 0x6e, 0x1f, 0x1c, 0xe5, 0x62, 0x7b, 0xdf, 0x72,
+	0x05, 0xd7, 0xe3, 0xf5, 0x8e, 0x65, 0xda, 0xb4, 0xa5, 0x78, 0xab, 0xbf, 0x45, 0xe6, 0x34, 0x4a,
+	0x7c, 0xbb, 0x8d, 0x99, 0x58, 0xc0, 0xde, 0x9d, 0xee, 0x17, 0x41, 0x75, 0xb2, 0x8b, 0xa4, 0xe4,
+	0x68, 0x5b, 0x30, 0xed, 0xe0, 0x26, 0x76, 0x11, 0xc7, 0x4e, 0x6d, 0xc3, 0xc7, 0x38, 0x9b, 0x18,
+	0x12, 0xf4, 0x44, 0x0f, 0xe7, 0x9e, 0x8f, 0xb1, 0xf6, 0x02, 0x4e, 0x9f, 0x01, 0x77, 0x65, 0x27,
+	0x87, 0x84, 0x3d, 0xd5, 0x83, 0xea, 0xea, 0x9e, 0x85, 0xff, 0x62, 0xe2, 0xd4, 0xb8, 0xd7, 0xc2,
+	0xd9, 0x91, 0x3c, 0x28, 0x24, 0xaa, 0xff, 0x60, 0xe2, 0x3c, 0xf6, 0x5a, 0xb8, 0x5c, 0x7c, 0xb9,
+	0x9b, 0x8b, 0xbd, 0xde, 0xcd, 0xc5, 0x5e, 0x9d, 0xee, 0x17, 0xe7, 0x43, 0x65, 0x07, 0x6d, 0x60,
+	0x7c, 0x06, 0x30, 0xbb, 0x4a, 0x09, 0xf7, 0x48, 0x87, 0x76, 0x58, 0x9f, 0x47, 0x2c, 0x38, 0x23,
+	0x3c, 0xa2, 0xd4, 0xf5, 0x79, 0xa5, 0x68, 0x46, 0xbb, 0xde, 0x1c, 0x84, 0x51, 0xae, 0xd1, 0xac,
+	0x41, 0x1f, 0xce, 0x43, 0xc8, 0x38, 0xf2, 0xb9, 0x54, 0x12, 0x17, 0x4a, 0x46, 0xc5, 0x8c, 0xd0,
+	0x72, 0x33, 0xac, 0xe5, 0x7a, 0x48, 0xcb, 0x79, 0xa4, 0x8d, 0x3d, 0x00, 0xff, 0xbf, 0x8b, 0x9b,
+	0x68, 0x1b, 0x3b, 0xbf, 0x46, 0x2e, 0x43, 0x4e, 0x79, 0x31, 0xcc, 0x37, 0x1f, 0xe2, 0x1b, 0x49,
+	0xc9, 0xd8, 0x01, 0x30, 0xb5, 0x8e, 0x7d, 0x8f, 0x3a, 0x5a, 0x06, 0xa6, 0x9a, 0x98, 0xb8, 0xbc,
+	0x2e, 0xf8, 0x24, 0xaa, 0x6a, 0xa4, 0xd5, 0x61, 0x0a, 0xb5, 0x04, 0xcf, 0x61, 0x9d, 0x29, 0x55,
+	0xbf, 0x9c, 0x0c, 0x78, 0x1b, 0x6f, 0xe3, 0x30, 0x23, 0x29, 0x79, 0xf6, 0x5f, 0xe7, 0x07, 0xad,
+	0x0a, 0x27, 0xbb, 0xe8, 0x6d, 0x41, 0x92, 0xa9, 0xf3, 0xae, 0x9f, 0x87, 0x2e, 0xb5, 0x54, 0x46,
+	0x83, 0xde, 0x48, 0xbd, 0x69, 0x95, 0x22, 0x23, 0xac, 0x6c, 0x86, 0xf7, 0xec, 0x5a, 0xa8, 0x53,
+	0xd1, 0x6d, 0x30, 0xde, 0x03, 0xd1, 0xa1, 0x16, 0x22, 0x98, 0xf0, 0x87, 0xd4, 0x6e, 0x60, 0xe7,
+	0x32, 0x2d, 0x76, 0x11, 0xdd, 0x08, 0x4e, 0xc6, 0x27, 0x00, 0xc7, 0x55, 0x89, 0x35, 0x1f, 0x11,
+	0xae, 0xdd, 0x81, 0xe9, 0x8d, 0x0e, 0x71, 0xb0, 0x5f, 0x43, 0x8e, 0xe3, 0x63, 0xc6, 0x04, 0xbd,
+	0xd1, 0x4a, 0xf6, 0xcb, 0xc7, 0xc5, 0x19, 0xc5, 0x70, 0x45, 0x46, 0x1e, 0x71, 0xdf, 0x23, 0x6e,
+	0x75, 0x42, 0xe6, 0xab, 0xc9, 0x2b, 0xd8, 0x23, 0xe3, 0x07, 0x80, 0xff, 0x09, 0xf6, 0x57, 0x60,
+	0xc9, 0x35, 0x98, 0x72, 0x03, 0x68, 0xa6, 0x4e, 0xe0, 0xc2, 0x79, 0x55, 0xc3, 0x5d, 0x0e, 0x8b,
+	0x51, 0xcb, 0xcb, 0x37, 0xc2, 0x3b, 0xa7, 0x87, 0x76, 0x2e, 0x42, 0x99, 0xf1, 0x21, 0x01, 0x33,
+	0xab, 0x4d, 0xb4, 0x65, 0x21, 0xbb, 0x71, 0x05, 0xa2, 0x07, 0x4d, 0x12, 0xff, 0x13, 0x93, 0x24,
+	0xfa, 0x4d, 0xb2, 0x0e, 0xd3, 0x4d, 0x6a, 0x37, 0x3a, 0xed, 0x9e, 0x47, 0x92, 0xbf, 0xeb, 0x91,
+	0x09, 0x59, 0x40, 0x06, 0x58, 0x94, 0xed, 0x46, 0x86, 0x76, 0x35, 0x44, 0xef, 0x4c, 0xe5, 0xc1,
+	0xc1, 0xb1, 0x0e, 0x0e, 0x8f, 0x75, 0xf0, 0xfd, 0x58, 0x07, 0x3b, 0x27, 0x7a, 0xec, 0xf0, 0x44,
+	0x8f, 0x7d, 0x3d, 0xd1, 0x63, 0x4f, 0x97, 0x2e, 0xbc, 0x93, 0x9f, 0xa9, 0x97, 0x9d, 0x7a, 0x65,
+	0x8a, 0x2b, 0xda, 0x4a, 0x89, 0x07, 0xdc, 0xad, 0x9f, 0x03, 0x00, 0x17, 0x74, 0xb3, 0x74, 0x84,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *BaseVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -626,6 +794,179 @@ func (m *PermanentLockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *VestingGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GrantVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClawbackVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClawbackVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LockupPeriods) > 0 {
+		for iNdEx := len(m.LockupPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockupPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintVesting(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BaseVestingAccount != nil {
+		{
+			size, err := m.BaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVesting(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVesting(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVesting(v)
 	base := offset
@@ -753,20 +1094,93 @@ func (m *PermanentLockedAccount) Size() (n int) {
 	return n
 }
 
-func sovVesting(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozVesting(x uint64) (n int) {
-	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *VestingGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
 }
-func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *GrantVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClawbackVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseVestingAccount != nil {
+		l = m.BaseVestingAccount.Size()
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovVesting(uint64(m.StartTime))
+	}
+	if len(m.LockupPeriods) > 0 {
+		for _, e := range m.LockupPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVesting(x uint64) (n int) {
+	return sovVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowVesting
 			}
 			if iNdEx >= l {
@@ -891,10 +1305,323 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedVesting = append(m.DelegatedVesting, types1.Coin{})
+			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseVestingAccount == nil {
+				m.BaseVestingAccount = &BaseVestingAccount{}
+			}
+			if err := m.BaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Period) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -921,30 +1648,11 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatedVesting = append(m.DelegatedVesting, types1.Coin{})
-			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			m.EndTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVesting
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -966,7 +1674,7 @@ func (m *BaseVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -989,10 +1697,10 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContinuousVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeriodicVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinuousVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1050,6 +1758,40 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
@@ -1071,7 +1813,7 @@ func (m *ContinuousVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1094,10 +1836,10 @@ func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelayedVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: PermanentLockedAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelayedVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1157,7 +1899,7 @@ func (m *DelayedVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Period) Unmarshal(dAtA []byte) error {
+func (m *VestingGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1180,17 +1922,17 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Period: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
 			}
-			m.Length = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowVesting
@@ -1200,14 +1942,46 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Length |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1234,8 +2008,8 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1260,7 +2034,7 @@ func (m *Period) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
+func (m *GrantVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,10 +2057,10 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeriodicVestingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: GrantVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GrantVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1326,27 +2100,8 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			m.StartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowVesting
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTime |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1373,8 +2128,8 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VestingPeriods = append(m.VestingPeriods, Period{})
-			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Grants = append(m.Grants, VestingGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1399,7 +2154,7 @@ func (m *PeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
+func (m *ClawbackVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1422,10 +2177,10 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PermanentLockedAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClawbackVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PermanentLockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClawbackVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1464,6 +2219,125 @@ func (m *PermanentLockedAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockupPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockupPeriods = append(m.LockupPeriods, Period{})
+			if err := m.LockupPeriods[len(m.LockupPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
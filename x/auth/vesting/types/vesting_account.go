@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"cosmossdk.io/math"
@@ -19,6 +20,8 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*GrantVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -122,6 +125,17 @@ func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
 	}
 }
 
+// TrackClawedBackDelegation tracks an amount of delegated vesting coins which
+// were clawed back, i.e. unbonded to another account, by decreasing the
+// delegated vesting coins. The delegated vesting coins exceeding the original
+// vesting coins left after the clawback were lost to slashing, and are not
+// tracked anymore.
+//
+// CONTRACT: The amount must be lower than the delegated vesting coins and sorted.
+func (bva *BaseVestingAccount) TrackClawedBackDelegation(amount sdk.Coins) {
+	bva.DelegatedVesting = bva.DelegatedVesting.Sub(amount...).Min(bva.OriginalVesting)
+}
+
 // GetOriginalVesting returns a vesting account's original vesting amount
 func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
 	return bva.OriginalVesting
@@ -163,8 +177,11 @@ type vestingAccountYAML struct {
 	EndTime          int64          `json:"end_time"`
 
 	// custom fields based on concrete vesting type which can be omitted
-	StartTime      int64   `json:"start_time,omitempty"`
-	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	StartTime      int64          `json:"start_time,omitempty"`
+	VestingPeriods Periods        `json:"vesting_periods,omitempty"`
+	Grants         []VestingGrant `json:"grants,omitempty"`
+	FunderAddress  string         `json:"funder_address,omitempty"`
+	LockupPeriods  Periods        `json:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -592,6 +609,382 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+// Grant Vesting Account
+
+var (
+	_ vestexported.ClawbackAccount = (*GrantVestingAccount)(nil)
+	_ authtypes.GenesisAccount     = (*GrantVestingAccount)(nil)
+)
+
+// NewGrantVestingAccount returns a new GrantVestingAccount without any vesting
+// grant, wrapping an existing base account.
+func NewGrantVestingAccount(baseAcc *authtypes.BaseAccount) *GrantVestingAccount {
+	return &GrantVestingAccount{
+		BaseVestingAccount: &BaseVestingAccount{
+			BaseAccount: baseAcc,
+		},
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins of all the grants.
+// If no coins are vested, nil is returned.
+func (gva GrantVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+	for _, grant := range gva.Grants {
+		if vested := grant.GetVestedCoins(blockTime); !vested.IsZero() {
+			vestedCoins = vestedCoins.Add(vested...)
+		}
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (gva GrantVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return gva.OriginalVesting.Sub(gva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (gva GrantVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return gva.BaseVestingAccount.LockedCoinsFromVesting(gva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (gva *GrantVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	gva.BaseVestingAccount.TrackDelegation(balance, gva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the earliest start time of the account grants, or zero
+// if the account has no grant.
+func (gva GrantVestingAccount) GetStartTime() int64 {
+	var startTime int64
+	for i, grant := range gva.Grants {
+		if i == 0 || grant.StartTime < startTime {
+			startTime = grant.StartTime
+		}
+	}
+
+	return startTime
+}
+
+// AddGrant adds a vesting grant to the account, increasing the original
+// vesting coins by the grant amount and extending the end time if needed.
+func (gva *GrantVestingAccount) AddGrant(grant VestingGrant) {
+	gva.Grants = append(gva.Grants, grant)
+	gva.OriginalVesting = gva.OriginalVesting.Add(grant.TotalAmount()...)
+	if endTime := grant.EndTime(); endTime > gva.EndTime {
+		gva.EndTime = endTime
+	}
+}
+
+// PruneVestedGrants removes the grants whose coins are all vested at the given
+// block time, so that only the grants still vesting are walked to compute the
+// locked coins. Their coins are removed from the original vesting coins, and
+// the delegated vesting coins exceeding the remaining original vesting coins
+// are tracked as delegated free coins.
+func (gva *GrantVestingAccount) PruneVestedGrants(blockTime time.Time) {
+	var (
+		vested  sdk.Coins
+		grants  []VestingGrant
+		endTime int64
+	)
+	for _, grant := range gva.Grants {
+		if grant.EndTime() <= blockTime.Unix() {
+			vested = vested.Add(grant.TotalAmount()...)
+			continue
+		}
+
+		grants = append(grants, grant)
+		if grant.EndTime() > endTime {
+			endTime = grant.EndTime()
+		}
+	}
+
+	if vested.IsZero() {
+		return
+	}
+
+	gva.Grants = grants
+	gva.OriginalVesting = gva.OriginalVesting.Sub(vested...)
+	gva.EndTime = endTime
+
+	delegatedVesting := gva.DelegatedVesting.Min(gva.OriginalVesting)
+	gva.DelegatedFree = gva.DelegatedFree.Add(gva.DelegatedVesting.Sub(delegatedVesting...)...)
+	gva.DelegatedVesting = delegatedVesting
+}
+
+// IsFundedBy returns true if the account holds at least one grant funded by
+// the given funder.
+func (gva GrantVestingAccount) IsFundedBy(funder string) bool {
+	for _, grant := range gva.Grants {
+		if grant.FunderAddress == funder {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Clawback removes the coins of all the grants funded by the given funder which
+// have not vested at the given block time, and returns them. The vesting
+// periods which have elapsed are kept, grants left without any period are
+// removed. The original vesting and end time of the account are updated
+// accordingly. It is the caller's responsibility to transfer the returned
+// coins out of the account.
+func (gva *GrantVestingAccount) Clawback(funder string, blockTime time.Time) sdk.Coins {
+	var (
+		clawedBack sdk.Coins
+		grants     []VestingGrant
+		endTime    int64
+	)
+	for _, grant := range gva.Grants {
+		if grant.FunderAddress == funder {
+			vested := elapsedPeriods(grant.StartTime, grant.VestingPeriods, blockTime)
+			clawedBack = clawedBack.Add(grant.TotalAmount().Sub(vested.TotalAmount()...)...)
+			if len(vested) == 0 {
+				continue
+			}
+			grant.VestingPeriods = vested
+		}
+
+		grants = append(grants, grant)
+		if grant.EndTime() > endTime {
+			endTime = grant.EndTime()
+		}
+	}
+
+	gva.Grants = grants
+	gva.OriginalVesting = gva.OriginalVesting.Sub(clawedBack...)
+	gva.EndTime = endTime
+
+	return clawedBack
+}
+
+// Validate checks for errors on the account fields
+func (gva GrantVestingAccount) Validate() error {
+	var endTime int64
+	originalVesting := sdk.NewCoins()
+	for _, grant := range gva.Grants {
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+		if grant.EndTime() > endTime {
+			endTime = grant.EndTime()
+		}
+		originalVesting = originalVesting.Add(grant.TotalAmount()...)
+	}
+	if endTime != gva.EndTime {
+		return errors.New("vesting end time does not match the end time of all vesting grants")
+	}
+	if !originalVesting.IsEqual(gva.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting grants")
+	}
+
+	return gva.BaseVestingAccount.Validate()
+}
+
+func (gva GrantVestingAccount) String() string {
+	out, _ := gva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a GrantVestingAccount.
+func (gva GrantVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(gva.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    gva.AccountNumber,
+		PubKey:           getPKString(gva),
+		Sequence:         gva.Sequence,
+		OriginalVesting:  gva.OriginalVesting,
+		DelegatedFree:    gva.DelegatedFree,
+		DelegatedVesting: gva.DelegatedVesting,
+		EndTime:          gva.EndTime,
+		StartTime:        gva.GetStartTime(),
+		Grants:           gva.Grants,
+	}
+	return marshalYaml(out)
+}
+
+// Clawback Vesting Account
+
+var (
+	_ vestexported.ClawbackAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount     = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. An empty
+// lockup or vesting schedule unlocks or vests all the coins at the start time.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	if len(lockupPeriods) == 0 {
+		lockupPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = Periods{{Length: 0, Amount: originalVesting}}
+	}
+
+	cva := &ClawbackVestingAccount{
+		BaseVestingAccount: &BaseVestingAccount{
+			BaseAccount:     baseAcc,
+			OriginalVesting: originalVesting,
+		},
+		FunderAddress:  funder.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+	}
+	cva.EndTime = cva.scheduleEndTime()
+
+	return cva
+}
+
+// GetUnlockedCoins returns the coins released by the lockup schedule at the
+// given block time, regardless of the vesting schedule.
+func (cva ClawbackVestingAccount) GetUnlockedCoins(blockTime time.Time) sdk.Coins {
+	return elapsedPeriods(cva.StartTime, cva.LockupPeriods, blockTime).TotalAmount()
+}
+
+// GetEarnedCoins returns the coins vested by the vesting schedule at the given
+// block time, regardless of the lockup schedule. These coins can't be clawed
+// back.
+func (cva ClawbackVestingAccount) GetEarnedCoins(blockTime time.Time) sdk.Coins {
+	return elapsedPeriods(cva.StartTime, cva.VestingPeriods, blockTime).TotalAmount()
+}
+
+// GetVestedCoins returns the total number of vested coins, defined as the
+// coins both earned and unlocked. If no coins are vested, nil is returned.
+func (cva ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	vestedCoins := cva.GetUnlockedCoins(blockTime).Min(cva.GetEarnedCoins(blockTime))
+	if vestedCoins.IsZero() {
+		return nil
+	}
+
+	return vestedCoins
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (cva ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (cva ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.BaseVestingAccount.LockedCoinsFromVesting(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (cva *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	cva.BaseVestingAccount.TrackDelegation(balance, cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when the lockup and vesting schedules start.
+func (cva ClawbackVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// IsFundedBy returns true if the given funder funded the account.
+func (cva ClawbackVestingAccount) IsFundedBy(funder string) bool {
+	return cva.FunderAddress == funder
+}
+
+// Clawback removes the coins which have not been earned at the given block
+// time from the account and returns them, if the given funder funded the
+// account. The elapsed vesting periods are kept and the lockup schedule is
+// capped to the remaining coins, so that they unlock as early as before. It is
+// the caller's responsibility to transfer the returned coins out of the
+// account.
+func (cva *ClawbackVestingAccount) Clawback(funder string, blockTime time.Time) sdk.Coins {
+	if !cva.IsFundedBy(funder) {
+		return nil
+	}
+
+	vestingPeriods := elapsedPeriods(cva.StartTime, cva.VestingPeriods, blockTime)
+	earned := vestingPeriods.TotalAmount()
+	clawedBack := cva.OriginalVesting.Sub(earned...)
+
+	cva.VestingPeriods = vestingPeriods
+	cva.LockupPeriods = capPeriods(cva.LockupPeriods, earned)
+	cva.OriginalVesting = earned
+	cva.EndTime = cva.scheduleEndTime()
+
+	return clawedBack
+}
+
+// scheduleEndTime returns the time at which both the lockup and vesting
+// schedules are over.
+func (cva ClawbackVestingAccount) scheduleEndTime() int64 {
+	lockupEnd := cva.StartTime + Periods(cva.LockupPeriods).TotalLength()
+	vestingEnd := cva.StartTime + Periods(cva.VestingPeriods).TotalLength()
+	if lockupEnd > vestingEnd {
+		return lockupEnd
+	}
+
+	return vestingEnd
+}
+
+// Validate checks for errors on the account fields
+func (cva ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cva.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	if cva.scheduleEndTime() != cva.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	for _, periods := range []Periods{cva.LockupPeriods, cva.VestingPeriods} {
+		for i, p := range periods {
+			if p.Length < 0 {
+				return fmt.Errorf("invalid period length of %d in period %d, length must not be negative", p.Length, i)
+			}
+		}
+		if !periods.TotalAmount().IsEqual(cva.OriginalVesting) {
+			return errors.New("original vesting coins does not match the sum of all coins in lockup and vesting periods")
+		}
+	}
+
+	return cva.BaseVestingAccount.Validate()
+}
+
+func (cva ClawbackVestingAccount) String() string {
+	out, _ := cva.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (cva ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(cva.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    cva.AccountNumber,
+		PubKey:           getPKString(cva),
+		Sequence:         cva.Sequence,
+		OriginalVesting:  cva.OriginalVesting,
+		DelegatedFree:    cva.DelegatedFree,
+		DelegatedVesting: cva.DelegatedVesting,
+		EndTime:          cva.EndTime,
+		StartTime:        cva.StartTime,
+		VestingPeriods:   cva.VestingPeriods,
+		FunderAddress:    cva.FunderAddress,
+		LockupPeriods:    cva.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
@@ -700,12 +700,223 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsGrantVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	funder1 := sdk.AccAddress([]byte("funder1_____________"))
+	funder2 := sdk.AccAddress([]byte("funder2_____________"))
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, _ := initBaseAccount()
+	gva := types.NewGrantVestingAccount(bacc)
+	gva.AddGrant(types.NewVestingGrant(funder1, now.Unix(), periods))
+	gva.AddGrant(types.NewVestingGrant(funder2, now.Add(12*time.Hour).Unix(), periods))
+	require.NoError(t, gva.Validate())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}, gva.OriginalVesting)
+	require.Equal(t, now.Unix(), gva.GetStartTime())
+	require.Equal(t, now.Add(36*time.Hour).Unix(), gva.GetEndTime())
+
+	// require no coins vested at the beginning of the vesting schedule
+	require.Nil(t, gva.GetVestedCoins(now))
+	require.Equal(t, gva.OriginalVesting, gva.LockedCoins(now))
+
+	// require the first period of the first grant to be vested
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, gva.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// require both grants to vest independently
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, gva.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, gva.GetVestingCoins(now.Add(24*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, gva.OriginalVesting, gva.GetVestedCoins(now.Add(36*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), gva.LockedCoins(now.Add(36*time.Hour)))
+}
+
+func TestTrackDelegationGrantVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	funder := sdk.AccAddress([]byte("funder______________"))
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	// the account holds 100 free coins on top of the grant
+	balance := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}
+
+	bacc, _ := initBaseAccount()
+	gva := types.NewGrantVestingAccount(bacc)
+	gva.AddGrant(types.NewVestingGrant(funder, now.Unix(), periods))
+
+	// require the maximum possible amount of vesting coins to be chosen for delegation
+	gva.TrackDelegation(now, balance, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, gva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, gva.DelegatedFree)
+	require.Equal(t, sdk.NewCoins(), gva.LockedCoins(now))
+
+	// require free coins to be undelegated first
+	gva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, gva.DelegatedVesting)
+	require.Empty(t, gva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, gva.LockedCoins(now))
+}
+
+func TestClawbackGrantVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	funder1 := sdk.AccAddress([]byte("funder1_____________"))
+	funder2 := sdk.AccAddress([]byte("funder2_____________"))
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, _ := initBaseAccount()
+	gva := types.NewGrantVestingAccount(bacc)
+	gva.AddGrant(types.NewVestingGrant(funder1, now.Unix(), periods))
+	gva.AddGrant(types.NewVestingGrant(funder2, now.Unix(), periods))
+	gva.AddGrant(types.NewVestingGrant(funder1, now.Add(12*time.Hour).Unix(), periods))
+	require.True(t, gva.IsFundedBy(funder1.String()))
+
+	// require the unvested coins of both grants of funder1 to be clawed back
+	clawedBack := gva.Clawback(funder1.String(), now.Add(18*time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, clawedBack)
+	require.NoError(t, gva.Validate())
+	require.Len(t, gva.Grants, 2)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, gva.OriginalVesting)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), gva.GetEndTime())
+
+	// require the vested coins to be kept and the grant of funder2 to keep vesting
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, gva.GetVestedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, gva.GetVestedCoins(now.Add(24*time.Hour)))
+
+	// require nothing more to be clawed back once everything vested
+	clawedBack = gva.Clawback(funder2.String(), now.Add(24*time.Hour))
+	require.True(t, clawedBack.IsZero())
+	require.NoError(t, gva.Validate())
+	require.False(t, gva.IsFundedBy(funder1.String()+"x"))
+}
+
+func TestPruneVestedGrantsGrantVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	funder := sdk.AccAddress([]byte("funder______________"))
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, _ := initBaseAccount()
+	gva := types.NewGrantVestingAccount(bacc)
+	gva.AddGrant(types.NewVestingGrant(funder, now.Unix(), periods))
+	gva.AddGrant(types.NewVestingGrant(funder, now.Add(12*time.Hour).Unix(), periods))
+	gva.TrackDelegation(now, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)})
+
+	// require no grant to be pruned while vesting
+	gva.PruneVestedGrants(now.Add(18 * time.Hour))
+	require.Len(t, gva.Grants, 2)
+
+	// require the vested grant to be pruned, and the delegated vesting coins
+	// exceeding the remaining original vesting to become delegated free
+	gva.PruneVestedGrants(now.Add(24 * time.Hour))
+	require.NoError(t, gva.Validate())
+	require.Len(t, gva.Grants, 1)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, gva.OriginalVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, gva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, gva.DelegatedFree)
+	require.Equal(t, now.Add(36*time.Hour).Unix(), gva.GetEndTime())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, gva.GetVestingCoins(now.Add(24*time.Hour)))
+
+	// require the account to be left without grant once everything vested
+	gva.PruneVestedGrants(now.Add(36 * time.Hour))
+	require.NoError(t, gva.Validate())
+	require.Empty(t, gva.Grants)
+	require.Empty(t, gva.OriginalVesting)
+	require.Empty(t, gva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, gva.DelegatedFree)
+}
+
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	funder := sdk.AccAddress([]byte("funder______________"))
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(24 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(24 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	bacc, _ := initBaseAccount()
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.NoError(t, cva.Validate())
+	require.Equal(t, now.Add(36*time.Hour).Unix(), cva.GetEndTime())
+
+	// require earned coins to stay locked until the end of the lockup
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetEarnedCoins(now.Add(12*time.Hour)))
+	require.Nil(t, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, cva.LockedCoins(now.Add(12*time.Hour)))
+
+	// require unlocked coins to stay locked until they are earned
+	require.Equal(t, origCoins, cva.GetUnlockedCoins(now.Add(24*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.LockedCoins(now.Add(24*time.Hour)))
+
+	// require all coins vested at the end of both schedules
+	require.Equal(t, origCoins, cva.GetVestedCoins(now.Add(36*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), cva.LockedCoins(now.Add(36*time.Hour)))
+
+	// require the vesting coins to be delegated first
+	cva.TrackDelegation(now.Add(24*time.Hour), origCoins, origCoins)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	funder := sdk.AccAddress([]byte("funder______________"))
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(24 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+
+	bacc, _ := initBaseAccount()
+	cva := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// require only the funder to claw back
+	require.False(t, cva.IsFundedBy(bacc.Address))
+	require.Nil(t, cva.Clawback(bacc.Address, now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, cva.OriginalVesting)
+
+	// require the unearned coins to be clawed back and the lockup to be capped
+	clawedBack := cva.Clawback(funder.String(), now.Add(12*time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, clawedBack)
+	require.NoError(t, cva.Validate())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.OriginalVesting)
+	require.Equal(t, types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}},
+		types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}},
+	}, types.Periods(cva.LockupPeriods))
+	require.Equal(t, now.Add(24*time.Hour).Unix(), cva.GetEndTime())
+
+	// require the earned coins to keep unlocking on the lockup schedule
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.GetVestedCoins(now.Add(24*time.Hour)))
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
 	baseAcc := authtypes.NewBaseAccount(addr, pubkey, 0, 0)
 	initialVesting := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
 	baseVestingWithCoins := types.NewBaseVestingAccount(baseAcc, initialVesting, 100)
+	grant := types.NewVestingGrant(addr, 0, types.Periods{types.Period{Length: int64(100), Amount: initialVesting}})
+	grantVestingAcc := types.NewGrantVestingAccount(baseAcc)
+	grantVestingAcc.AddGrant(grant)
 	tests := []struct {
 		name   string
 		acc    authtypes.GenesisAccount
@@ -765,6 +976,45 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid grant vesting account",
+			grantVestingAcc,
+			false,
+		},
+		{
+			"invalid grant vesting amounts",
+			&types.GrantVestingAccount{
+				BaseVestingAccount: types.NewBaseVestingAccount(baseAcc, initialVesting.Add(initialVesting...), 100),
+				Grants:             []types.VestingGrant{grant},
+			},
+			true,
+		},
+		{
+			"invalid grant vesting end time",
+			&types.GrantVestingAccount{
+				BaseVestingAccount: types.NewBaseVestingAccount(baseAcc, initialVesting, 200),
+				Grants:             []types.VestingGrant{grant},
+			},
+			true,
+		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, nil, types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting lockup amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0, types.Periods{types.Period{Length: int64(100), Amount: initialVesting.Add(initialVesting...)}}, nil),
+			true,
+		},
+		{
+			"invalid grant funder",
+			&types.GrantVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				Grants:             []types.VestingGrant{{FunderAddress: "invalid", VestingPeriods: grant.VestingPeriods}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -849,6 +1099,43 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestGrantVestingAccountMarshal() {
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	acc := types.NewGrantVestingAccount(baseAcc)
+	acc.AddGrant(types.NewVestingGrant(baseAcc.GetAddress(), time.Now().Unix(), types.Periods{types.Period{3600, coins}}))
+
+	bz, err := s.accountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := s.accountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.GrantVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = s.accountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	acc := types.NewClawbackVestingAccount(baseAcc, baseAcc.GetAddress(), coins, time.Now().Unix(), types.Periods{types.Period{7200, coins}}, types.Periods{types.Period{3600, coins}})
+
+	bz, err := s.accountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := s.accountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = s.accountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxVestingGrants is the maximum number of vesting grants held by a
+// GrantVestingAccount, which bounds the work done to compute its locked coins
+// on every transfer. Fully vested grants are pruned and not counted.
+const MaxVestingGrants = 10
+
+// MinVestingGrantAmount is the minimum amount of each denom of a vesting grant,
+// so that filling an account with dust grants is costly.
+var MinVestingGrantAmount = math.NewInt(1_000_000)
+
+// NewVestingGrant returns a new VestingGrant.
+func NewVestingGrant(funder sdk.AccAddress, startTime int64, periods Periods) VestingGrant {
+	return VestingGrant{
+		FunderAddress:  funder.String(),
+		StartTime:      startTime,
+		VestingPeriods: periods,
+	}
+}
+
+// EndTime returns the time at which all the coins of the grant are vested, as
+// unix timestamp (in seconds).
+func (g VestingGrant) EndTime() int64 {
+	return g.StartTime + Periods(g.VestingPeriods).TotalLength()
+}
+
+// TotalAmount returns the sum of the coins of all the grant periods.
+func (g VestingGrant) TotalAmount() sdk.Coins {
+	return Periods(g.VestingPeriods).TotalAmount()
+}
+
+// GetVestedCoins returns the coins of the grant which are vested at the given
+// block time. If no coins are vested, nil is returned.
+func (g VestingGrant) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+	for _, period := range elapsedPeriods(g.StartTime, g.VestingPeriods, blockTime) {
+		vestedCoins = vestedCoins.Add(period.Amount...)
+	}
+
+	return vestedCoins
+}
+
+// Validate performs a stateless validation of the grant fields.
+func (g VestingGrant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(g.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	if len(g.VestingPeriods) == 0 {
+		return fmt.Errorf("vesting grant from %s has no vesting period", g.FunderAddress)
+	}
+
+	for i, period := range g.VestingPeriods {
+		if period.Length < 0 {
+			return fmt.Errorf("invalid period length of %d in period %d, length must not be negative", period.Length, i)
+		}
+
+		if !period.Amount.IsValid() {
+			return fmt.Errorf("invalid amount %s in period %d", period.Amount, i)
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package authz
 
 import (
+	"time"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,6 +26,19 @@ type Authorization interface {
 	ValidateBasic() error
 }
 
+// SpendLimitAuthorization is an Authorization limiting the amount of coins the
+// grantee can spend, possibly per period of time. It is used to report the
+// allowance left in a grant.
+type SpendLimitAuthorization interface {
+	Authorization
+
+	// SpendAllowance returns the coins left to spend at the given block time, in
+	// total and in the current period, along with the time the period resets.
+	// An empty spendLimit means there is no total cap, and a zero periodReset
+	// means the authorization has no period.
+	SpendAllowance(blockTime time.Time) (spendLimit, periodCanSpend sdk.Coins, periodReset time.Time)
+}
+
 // AcceptResponse instruments the controller of an authz message if the request is accepted
 // and if it should be updated or deleted.
 type AcceptResponse struct {
@@ -6,11 +6,14 @@ package authz
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -70,10 +73,153 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// FieldConstraintAuthorization gives the grantee permissions to execute the
+// provided method on behalf of the granter's account, as long as the executed
+// message satisfies every field constraint.
+type FieldConstraintAuthorization struct {
+	// Msg, identified by it's type URL, to grant constrained permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// constraints are the field constraints the executed message must satisfy.
+	Constraints []FieldConstraint `protobuf:"bytes,2,rep,name=constraints,proto3" json:"constraints"`
+}
+
+func (m *FieldConstraintAuthorization) Reset()         { *m = FieldConstraintAuthorization{} }
+func (m *FieldConstraintAuthorization) String() string { return proto.CompactTextString(m) }
+func (*FieldConstraintAuthorization) ProtoMessage()    {}
+func (*FieldConstraintAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *FieldConstraintAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldConstraintAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldConstraintAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldConstraintAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldConstraintAuthorization.Merge(m, src)
+}
+func (m *FieldConstraintAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldConstraintAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldConstraintAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldConstraintAuthorization proto.InternalMessageInfo
+
+// FieldConstraint constrains the value of a single field of a message. Every
+// check set on a constraint must pass for the message to be accepted.
+type FieldConstraint struct {
+	// field is the dot-separated path of proto field names leading to the
+	// constrained field, e.g. "proposal_id" or "amount.denom".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// equals, if set, is the only value the field may hold.
+	Equals string `protobuf:"bytes,2,opt,name=equals,proto3" json:"equals,omitempty"`
+	// allowed, if set, is the list of values the field may hold.
+	Allowed []string `protobuf:"bytes,3,rep,name=allowed,proto3" json:"allowed,omitempty"`
+	// max, if set, is the inclusive ceiling of an integer field.
+	Max string `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`
+	// max_coins, if set, is the inclusive ceiling of a coin field for a single
+	// message.
+	MaxCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=max_coins,json=maxCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_coins"`
+	// period_limit, if set, caps the amount of a coin field spent within a period.
+	PeriodLimit *PeriodicSpendLimit `protobuf:"bytes,6,opt,name=period_limit,json=periodLimit,proto3" json:"period_limit,omitempty"`
+}
+
+func (m *FieldConstraint) Reset()         { *m = FieldConstraint{} }
+func (m *FieldConstraint) String() string { return proto.CompactTextString(m) }
+func (*FieldConstraint) ProtoMessage()    {}
+func (*FieldConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *FieldConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FieldConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FieldConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FieldConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldConstraint.Merge(m, src)
+}
+func (m *FieldConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *FieldConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldConstraint proto.InternalMessageInfo
+
+// PeriodicSpendLimit caps the total amount of coins that can be spent in a
+// period of time, reset at the start of every period.
+type PeriodicSpendLimit struct {
+	// period specifies the time duration in which limit coins can be spent
+	// before can_spend is reset.
+	Period time.Duration `protobuf:"bytes,1,opt,name=period,proto3,stdduration" json:"period"`
+	// limit specifies the maximum number of coins that can be spent in the period.
+	Limit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=limit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"limit"`
+	// can_spend is the number of coins left to be spent before the period_reset time.
+	CanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=can_spend,json=canSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"can_spend"`
+	// period_reset is the time at which this period resets and a new one begins.
+	// If left unset, the first period starts with the first accepted message.
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *PeriodicSpendLimit) Reset()         { *m = PeriodicSpendLimit{} }
+func (m *PeriodicSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*PeriodicSpendLimit) ProtoMessage()    {}
+func (*PeriodicSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{3}
+}
+func (m *PeriodicSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicSpendLimit.Merge(m, src)
+}
+func (m *PeriodicSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicSpendLimit proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
-	Authorization *types.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Authorization *types1.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
 	// time when the grant will expire and will be pruned. If null, then the grant
 	// doesn't have a time expiration (other conditions  in `authorization`
 	// may apply to invalidate the grant)
@@ -84,7 +230,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -116,17 +262,17 @@ var xxx_messageInfo_Grant proto.InternalMessageInfo
 // GrantAuthorization extends a grant with both the addresses of the grantee and granter.
 // It is used in genesis.proto and query.proto
 type GrantAuthorization struct {
-	Granter       string     `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
-	Grantee       string     `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
-	Authorization *types.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
-	Expiration    *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	Granter       string      `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee       string      `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Authorization *types1.Any `protobuf:"bytes,3,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	Expiration    *time.Time  `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +311,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{6}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +342,9 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*FieldConstraintAuthorization)(nil), "cosmos.authz.v1beta1.FieldConstraintAuthorization")
+	proto.RegisterType((*FieldConstraint)(nil), "cosmos.authz.v1beta1.FieldConstraint")
+	proto.RegisterType((*PeriodicSpendLimit)(nil), "cosmos.authz.v1beta1.PeriodicSpendLimit")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +353,54 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xeb, 0xf1, 0xe7, 0x7c, 0x3a, 0x04, 0x51, 0x86, 0xd2, 0x21, 0xa9, 0x22, 0x84,
-	0x4e, 0x48, 0x8d, 0x75, 0x07, 0x13, 0x13, 0x8d, 0x90, 0x4e, 0xb0, 0x11, 0x8e, 0x85, 0xa5, 0x72,
-	0x5a, 0xe3, 0x5a, 0xd4, 0x71, 0x64, 0x3b, 0xe8, 0x72, 0x1f, 0x81, 0xe9, 0x3e, 0x03, 0x9f, 0x00,
-	0xa4, 0x7e, 0x88, 0x8a, 0xa9, 0x62, 0x62, 0xe2, 0x4f, 0x3b, 0xf0, 0x35, 0x50, 0xed, 0x44, 0x34,
-	0xb4, 0x12, 0x1d, 0x58, 0x22, 0xdb, 0xef, 0xf3, 0xbc, 0xef, 0x93, 0x5f, 0x62, 0xd8, 0x1d, 0x0a,
-	0xc5, 0x85, 0x42, 0xb8, 0xd0, 0xe3, 0x4b, 0xf4, 0xee, 0x24, 0x25, 0x1a, 0x9f, 0xd8, 0x5d, 0x94,
-	0x4b, 0xa1, 0x85, 0xeb, 0x59, 0x45, 0x64, 0xcf, 0x2a, 0x45, 0xe7, 0x0e, 0xe6, 0x2c, 0x13, 0xc8,
-	0x3c, 0xad, 0xb0, 0x73, 0xd7, 0x0a, 0x07, 0x66, 0x87, 0x2a, 0x97, 0x2d, 0x05, 0x54, 0x08, 0x3a,
-	0x21, 0xc8, 0xec, 0xd2, 0xe2, 0x0d, 0xd2, 0x8c, 0x13, 0xa5, 0x31, 0xcf, 0x2b, 0x81, 0x47, 0x05,
-	0x15, 0xd6, 0xb8, 0x5a, 0xd5, 0x1d, 0xff, 0xb6, 0xe1, 0xac, 0xb4, 0xa5, 0x50, 0x43, 0xef, 0x8c,
-	0x64, 0x44, 0xb2, 0x61, 0xbf, 0xd0, 0x63, 0x21, 0xd9, 0x25, 0xd6, 0x4c, 0x64, 0xee, 0x6d, 0xd8,
-	0xe2, 0x8a, 0xb6, 0x41, 0x17, 0x1c, 0x1f, 0x24, 0xab, 0xe5, 0xe3, 0xe7, 0x9f, 0xa7, 0xbd, 0x70,
-	0xdb, 0x3b, 0x44, 0x0d, 0xe7, 0xfb, 0x5f, 0x1f, 0x1f, 0x04, 0x56, 0xd6, 0x53, 0xa3, 0xb7, 0x68,
-	0x5b, 0xf7, 0xf0, 0x13, 0x80, 0xd7, 0xce, 0x24, 0xce, 0xb4, 0x9b, 0xc2, 0x23, 0xbc, 0x5e, 0x32,
-	0x13, 0x0f, 0x4f, 0xbd, 0xc8, 0x46, 0x8e, 0xea, 0xc8, 0x51, 0x3f, 0x2b, 0xe3, 0xfb, 0xbb, 0x45,
-	0x48, 0x9a, 0x2d, 0xdd, 0xa7, 0x10, 0x92, 0x8b, 0x9c, 0x49, 0x3b, 0x60, 0xcf, 0x0c, 0xe8, 0x6c,
-	0x0c, 0x38, 0xaf, 0x51, 0xc6, 0x37, 0x67, 0xdf, 0x02, 0x70, 0xf5, 0x3d, 0x00, 0xc9, 0x9a, 0x2f,
-	0xfc, 0xb0, 0x07, 0x5d, 0x93, 0xb9, 0x09, 0xea, 0x14, 0xde, 0xa0, 0xab, 0x53, 0x22, 0x2d, 0xac,
-	0xb8, 0xfd, 0x65, 0xda, 0xab, 0xbf, 0x75, 0x7f, 0x34, 0x92, 0x44, 0xa9, 0x97, 0x5a, 0xb2, 0x8c,
-	0x26, 0xb5, 0xf0, 0x8f, 0x87, 0x98, 0x34, 0x3b, 0x78, 0xc8, 0x26, 0xa8, 0xd6, 0xff, 0x07, 0xf5,
-	0xa4, 0x01, 0x6a, 0xff, 0x9f, 0xa0, 0xf6, 0x37, 0x20, 0x3d, 0x82, 0xb7, 0x0c, 0xa3, 0x17, 0x05,
-	0x29, 0xc8, 0x33, 0x4d, 0xb8, 0x1b, 0xc2, 0x23, 0xae, 0xe8, 0x40, 0x97, 0x39, 0x19, 0x14, 0x72,
-	0xa2, 0xda, 0xa0, 0xdb, 0x3a, 0x3e, 0x48, 0x0e, 0xb9, 0xa2, 0xe7, 0x65, 0x4e, 0x5e, 0xc9, 0x89,
-	0x8a, 0xe3, 0xd9, 0x4f, 0xdf, 0x99, 0x2d, 0x7c, 0x30, 0x5f, 0xf8, 0xe0, 0xc7, 0xc2, 0x07, 0x57,
-	0x4b, 0xdf, 0x99, 0x2f, 0x7d, 0xe7, 0xeb, 0xd2, 0x77, 0x5e, 0xdf, 0xa3, 0x4c, 0x8f, 0x8b, 0x34,
-	0x1a, 0x0a, 0x5e, 0xdd, 0x06, 0xb4, 0xf6, 0x7f, 0x5d, 0xd8, 0x4b, 0x96, 0x5e, 0x37, 0xf9, 0x1e,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x50, 0x89, 0xaf, 0x02, 0x89, 0x03, 0x00, 0x00,
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3d, 0x4f, 0x1b, 0x4b,
+	0x14, 0xf5, 0xda, 0xd8, 0xe0, 0xf1, 0xe3, 0x7d, 0x8c, 0xac, 0xa7, 0x05, 0x3d, 0xad, 0x2d, 0xeb,
+	0x7d, 0x58, 0x48, 0x78, 0x1f, 0x7e, 0x2f, 0x0d, 0x15, 0x18, 0x14, 0x94, 0x84, 0x22, 0x59, 0x48,
+	0x93, 0xc6, 0x1a, 0xef, 0x0e, 0xeb, 0x51, 0x76, 0x77, 0x9c, 0x9d, 0xd9, 0xc4, 0xe6, 0x27, 0xa4,
+	0xa2, 0x4c, 0x9d, 0x2a, 0x4a, 0x45, 0x24, 0x7e, 0x84, 0x95, 0x0a, 0xa5, 0xa2, 0x82, 0x04, 0x0a,
+	0x7e, 0x44, 0x9a, 0x68, 0x66, 0x76, 0x1d, 0x7f, 0x09, 0x13, 0x29, 0x69, 0xac, 0xbd, 0x33, 0xe7,
+	0xdc, 0xb9, 0xe7, 0xdc, 0x3b, 0x63, 0x50, 0xb6, 0x29, 0xf3, 0x29, 0x33, 0x51, 0xc4, 0xdb, 0x87,
+	0xe6, 0xf3, 0xb5, 0x16, 0xe6, 0x68, 0x4d, 0x45, 0xb5, 0x4e, 0x48, 0x39, 0x85, 0x45, 0x85, 0xa8,
+	0xa9, 0xb5, 0x18, 0xb1, 0xfc, 0x1b, 0xf2, 0x49, 0x40, 0x4d, 0xf9, 0xab, 0x80, 0xcb, 0x4b, 0x0a,
+	0xd8, 0x94, 0x91, 0x19, 0xb3, 0xd4, 0x56, 0xc9, 0xa5, 0xd4, 0xf5, 0xb0, 0x29, 0xa3, 0x56, 0x74,
+	0x60, 0x72, 0xe2, 0x63, 0xc6, 0x91, 0xdf, 0x89, 0x01, 0x45, 0x97, 0xba, 0x54, 0x11, 0xc5, 0x57,
+	0x92, 0x71, 0x9c, 0x86, 0x82, 0x5e, 0xbc, 0x65, 0x8c, 0x6f, 0x39, 0x51, 0x88, 0x38, 0xa1, 0x41,
+	0xb2, 0x1f, 0xeb, 0x6a, 0x21, 0x86, 0x07, 0xb2, 0x6c, 0x4a, 0xe2, 0xfd, 0x0a, 0x07, 0xc5, 0x1d,
+	0x1c, 0xe0, 0x90, 0xd8, 0x9b, 0x11, 0x6f, 0xd3, 0x90, 0x1c, 0x4a, 0x36, 0xfc, 0x15, 0x64, 0x7c,
+	0xe6, 0xea, 0x5a, 0x59, 0xab, 0xe6, 0x2d, 0xf1, 0xb9, 0x7e, 0xff, 0xfd, 0xc9, 0x6a, 0x65, 0x9a,
+	0x07, 0xb5, 0x11, 0xe6, 0xcb, 0xeb, 0xe3, 0x95, 0x92, 0x82, 0xad, 0x32, 0xe7, 0xa9, 0x39, 0x2d,
+	0x7b, 0xe5, 0x4c, 0x03, 0x7f, 0xdc, 0x25, 0xd8, 0x73, 0xb6, 0x68, 0xc0, 0x78, 0x88, 0x48, 0xc0,
+	0x67, 0x1c, 0x0f, 0x2d, 0x50, 0xb0, 0x07, 0x60, 0xa6, 0xa7, 0xcb, 0x99, 0x6a, 0xa1, 0xfe, 0x57,
+	0x6d, 0x6a, 0x41, 0x63, 0xa9, 0x1b, 0xf9, 0xfe, 0x79, 0x29, 0xf5, 0xe6, 0xfa, 0x78, 0x45, 0xb3,
+	0x86, 0x93, 0xac, 0x5b, 0xb7, 0x97, 0xf4, 0xcf, 0x90, 0xa4, 0x9b, 0x2a, 0xaf, 0xbc, 0x4e, 0x83,
+	0x5f, 0xc6, 0x00, 0xb0, 0x08, 0xb2, 0x07, 0x62, 0x29, 0xd6, 0xa3, 0x02, 0xf8, 0x3b, 0xc8, 0xe1,
+	0x67, 0x11, 0xf2, 0x84, 0x18, 0xb1, 0x1c, 0x47, 0x50, 0x07, 0xf3, 0xc8, 0xf3, 0xe8, 0x0b, 0xec,
+	0xe8, 0x99, 0x72, 0xa6, 0x9a, 0xb7, 0x92, 0x50, 0xba, 0x82, 0xba, 0xfa, 0x5c, 0xec, 0x0a, 0xea,
+	0xc2, 0x36, 0xc8, 0xfb, 0xa8, 0xdb, 0x14, 0x0d, 0x65, 0x7a, 0x56, 0x7a, 0xb2, 0x94, 0x78, 0x22,
+	0x5a, 0x3e, 0x10, 0xb4, 0x45, 0x49, 0xd0, 0xf8, 0x57, 0xf8, 0xf0, 0xf6, 0xa2, 0x54, 0x75, 0x09,
+	0x6f, 0x47, 0xad, 0x9a, 0x4d, 0xfd, 0x78, 0x3e, 0xcd, 0x21, 0x79, 0xbc, 0xd7, 0xc1, 0x4c, 0x12,
+	0x98, 0xb5, 0xe0, 0xa3, 0xae, 0xfc, 0x82, 0x0f, 0xc0, 0x4f, 0x1d, 0x1c, 0x12, 0xea, 0x34, 0x3d,
+	0xe2, 0x13, 0xae, 0xe7, 0xca, 0x5a, 0xb5, 0x50, 0xaf, 0x4e, 0x6f, 0xc0, 0x43, 0x89, 0x24, 0xf6,
+	0x5e, 0x07, 0x07, 0xce, 0xae, 0xc0, 0x5b, 0x05, 0xc5, 0x96, 0x41, 0xe5, 0x73, 0x1a, 0xc0, 0x49,
+	0x0c, 0xdc, 0x00, 0x39, 0x85, 0x92, 0x46, 0x09, 0x29, 0x6a, 0xba, 0x6b, 0xc9, 0x74, 0xd7, 0xb6,
+	0xe3, 0xe9, 0x6e, 0x2c, 0x0a, 0x29, 0xaf, 0x2e, 0x4a, 0x9a, 0x6a, 0x6b, 0xcc, 0x83, 0x07, 0x20,
+	0xab, 0xca, 0x4b, 0xcf, 0xf2, 0xe2, 0xce, 0xb7, 0x7a, 0xa1, 0x0e, 0x52, 0xe9, 0xa1, 0x0f, 0xf2,
+	0x36, 0x0a, 0x9a, 0x4c, 0xd4, 0xae, 0x67, 0x7e, 0xd0, 0x59, 0x0b, 0x36, 0x0a, 0xa4, 0x3b, 0x70,
+	0x77, 0x60, 0x7e, 0x88, 0x19, 0xe6, 0x72, 0x02, 0x0a, 0xf5, 0xe5, 0x09, 0x7b, 0xf6, 0x93, 0xe7,
+	0x44, 0xf9, 0x73, 0x34, 0xf0, 0x27, 0x76, 0xdf, 0x12, 0xec, 0xca, 0x3b, 0x0d, 0x64, 0x77, 0x42,
+	0x14, 0x70, 0xd8, 0x02, 0x8b, 0x68, 0x78, 0x7a, 0x63, 0xdf, 0x8b, 0x13, 0x89, 0x37, 0x83, 0x5e,
+	0xe3, 0xef, 0xdb, 0xdd, 0x16, 0x6b, 0x34, 0x25, 0xdc, 0x06, 0x00, 0x77, 0x3b, 0x44, 0xf5, 0x4d,
+	0x4f, 0xcf, 0xac, 0x7c, 0xa1, 0x7f, 0x5e, 0xd2, 0x44, 0xe5, 0xd6, 0x10, 0x4f, 0x5c, 0x2b, 0x28,
+	0x6b, 0x1e, 0x7d, 0x27, 0xea, 0x60, 0xde, 0x15, 0xab, 0x38, 0x54, 0x77, 0xab, 0xa1, 0x7f, 0x38,
+	0x59, 0x4d, 0x5e, 0xea, 0x4d, 0xc7, 0x09, 0x31, 0x63, 0x7b, 0x3c, 0x24, 0x81, 0x6b, 0x25, 0xc0,
+	0xaf, 0x1c, 0xac, 0xa7, 0x6f, 0xc7, 0xc1, 0x93, 0x46, 0x65, 0xbe, 0xbf, 0x51, 0x1b, 0x23, 0x46,
+	0xcd, 0x6e, 0xf1, 0xdc, 0x84, 0x49, 0xff, 0x83, 0x9f, 0xa5, 0x47, 0x8f, 0x22, 0x1c, 0xe1, 0x7b,
+	0x1c, 0xfb, 0xb0, 0x02, 0x16, 0x7d, 0xe6, 0x36, 0xc5, 0x68, 0x35, 0xa3, 0xd0, 0x63, 0xba, 0x26,
+	0x5f, 0x94, 0x82, 0xcf, 0xdc, 0xfd, 0x5e, 0x07, 0x3f, 0x0e, 0x3d, 0xd6, 0x68, 0xf4, 0x3f, 0x19,
+	0xa9, 0xfe, 0xa5, 0xa1, 0x9d, 0x5e, 0x1a, 0xda, 0xc7, 0x4b, 0x43, 0x3b, 0xba, 0x32, 0x52, 0xa7,
+	0x57, 0x46, 0xea, 0xec, 0xca, 0x48, 0x3d, 0xf9, 0xf3, 0xc6, 0x99, 0xed, 0xaa, 0xbf, 0xc8, 0x56,
+	0x4e, 0xd6, 0xf7, 0xdf, 0x97, 0x01, 0x00, 0x96, 0x89, 0x76, 0x9a, 0x47, 0x07, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +433,196 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FieldConstraintAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldConstraintAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldConstraintAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Constraints) > 0 {
+		for iNdEx := len(m.Constraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Constraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FieldConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FieldConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodLimit != nil {
+		{
+			size, err := m.PeriodLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MaxCoins) > 0 {
+		for iNdEx := len(m.MaxCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Allowed) > 0 {
+		for iNdEx := len(m.Allowed) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowed[iNdEx])
+			copy(dAtA[i:], m.Allowed[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Allowed[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Equals) > 0 {
+		i -= len(m.Equals)
+		copy(dAtA[i:], m.Equals)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Equals)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodicSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.CanSpend) > 0 {
+		for iNdEx := len(m.CanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Limit) > 0 {
+		for iNdEx := len(m.Limit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Limit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuthz(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -286,12 +644,12 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n1
-		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i -= n4
+		i = encodeVarintAuthz(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -331,12 +689,12 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err6 != nil {
+			return 0, err6
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n6
+		i = encodeVarintAuthz(dAtA, i, uint64(n6))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -425,6 +783,87 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *FieldConstraintAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Constraints) > 0 {
+		for _, e := range m.Constraints {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FieldConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Equals)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Allowed) > 0 {
+		for _, s := range m.Allowed {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.MaxCoins) > 0 {
+		for _, e := range m.MaxCoins {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.PeriodLimit != nil {
+		l = m.PeriodLimit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodicSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.Limit) > 0 {
+		for _, e := range m.Limit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.CanSpend) > 0 {
+		for _, e := range m.CanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +1009,554 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FieldConstraintAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldConstraintAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldConstraintAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Constraints = append(m.Constraints, FieldConstraint{})
+			if err := m.Constraints[len(m.Constraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equals = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowed = append(m.Allowed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxCoins = append(m.MaxCoins, types.Coin{})
+			if err := m.MaxCoins[len(m.MaxCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodLimit == nil {
+				m.PeriodLimit = &PeriodicSpendLimit{}
+			}
+			if err := m.PeriodLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = append(m.Limit, types.Coin{})
+			if err := m.Limit[len(m.Limit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanSpend = append(m.CanSpend, types.Coin{})
+			if err := m.CanSpend[len(m.CanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -629,7 +1616,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
+				m.Authorization = &types1.Any{}
 			}
 			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -815,7 +1802,7 @@ func (m *GrantAuthorization) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Authorization == nil {
-				m.Authorization = &types.Any{}
+				m.Authorization = &types1.Any{}
 			}
 			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
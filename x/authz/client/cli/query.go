@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -29,6 +30,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryGrants(),
 		GetQueryGranterGrants(),
 		GetQueryGranteeGrants(),
+		GetCmdQuerySpendAllowance(),
+		GetCmdQueryExpiringGrants(),
 	)
 
 	return authorizationQueryCmd
@@ -192,3 +195,102 @@ $ %s q %s grants-by-grantee cosmos1skj..
 	flags.AddPaginationFlagsToCmd(cmd, "grantee-grants")
 	return cmd
 }
+
+// GetCmdQuerySpendAllowance implements the query spend allowance command.
+func GetCmdQuerySpendAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "spend-allowance [granter-addr] [grantee-addr] [msg-type-url]",
+		Args:  cobra.ExactArgs(3),
+		Short: "query the coins left to spend under a grant and the time its period resets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the coins the grantee can still spend under the grant of the given
+msg type, in total and in the current period, and the time left until the period resets.
+Examples:
+$ %s query %s spend-allowance cosmos1skjw.. cosmos1skjwj.. %s
+`,
+				version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL()),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := authz.NewQueryClient(clientCtx)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SpendAllowance(
+				cmd.Context(),
+				&authz.QuerySpendAllowanceRequest{
+					Granter:    granter.String(),
+					Grantee:    grantee.String(),
+					MsgTypeUrl: args[2],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryExpiringGrants implements the query expiring grants command.
+func GetCmdQueryExpiringGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-grants [window]",
+		Args:  cobra.ExactArgs(1),
+		Short: "query authorization grants expiring within a time window",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the authorization grants expiring after the latest block time and
+within the given window, ordered by expiration.
+Examples:
+$ %s query %s expiring-grants 24h
+`,
+				version.AppName, authz.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			window, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := authz.NewQueryClient(clientCtx)
+			res, err := queryClient.ExpiringGrants(
+				cmd.Context(),
+				&authz.QueryExpiringGrantsRequest{
+					Window:     window,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring grants")
+	return cmd
+}
@@ -237,3 +237,120 @@ func (s *CLITestSuite) TestQueryGranterGrants() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestQuerySpendAllowance() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	grantee := s.grantee[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			"Error: Invalid grantee",
+			[]string{
+				val[0].Address.String(),
+				"invalid grantee",
+				typeMsgSend,
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			true,
+			"decoding bech32 failed: invalid character in string: ' '",
+		},
+		{
+			"Error: Missing msg type url",
+			[]string{
+				val[0].Address.String(),
+				grantee.String(),
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			true,
+			"accepts 3 arg(s), received 2",
+		},
+		{
+			"Valid txn (json)",
+			[]string{
+				val[0].Address.String(),
+				grantee.String(),
+				typeMsgSend,
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+			``,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySpendAllowance()
+			resp, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				var res authz.QuerySpendAllowanceResponse
+				err = s.clientCtx.Codec.UnmarshalJSON(resp.Bytes(), &res)
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestQueryExpiringGrants() {
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			"Error: Invalid window",
+			[]string{
+				"one-day",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			true,
+			"invalid duration",
+		},
+		{
+			"Valid txn (json)",
+			[]string{
+				"24h",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+			``,
+		},
+		{
+			"Valid txn with pagination (json)",
+			[]string{
+				"24h",
+				"--limit=2",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			false,
+			``,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryExpiringGrants()
+			resp, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				var res authz.QueryExpiringGrantsResponse
+				err = s.clientCtx.Codec.UnmarshalJSON(resp.Bytes(), &res)
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
@@ -27,6 +30,10 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagConstraints       = "constraints"
+	FlagPeriod            = "period"
+	FlagPeriodLimit       = "period-limit"
+	FlagRecipientLimit    = "recipient-limit"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -46,6 +53,7 @@ func GetTxCmd() *cobra.Command {
 	AuthorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(),
 		NewCmdRevokeAuthorization(),
+		NewCmdRevokeAllAuthorizations(),
 		NewCmdExecAuthorization(),
 	)
 
@@ -55,15 +63,24 @@ func GetTxCmd() *cobra.Command {
 // NewCmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant <grantee> <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
+		Use:   "grant <grantee> <authorization_type=\"send\"|\"periodic-send\"|\"generic\"|\"constraint\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from <granter>",
 		Short: "Grant authorization to an address",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 
 Examples:
  $ %s tx %s grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
+ $ %s tx %s grant cosmos1skjw.. periodic-send --period=24h --period-limit=100stake --spend-limit=1000stake --from=cosmos1skl..
  $ %s tx %s grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
-	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+ $ %s tx %s grant cosmos1skjw.. constraint --msg-type=/cosmos.gov.v1.MsgVote --constraints=constraints.json --from=cosmos1sk..
+
+Where constraints.json contains the list of field constraints of the message, e.g.:
+
+[
+  {"field": "proposal_id", "equals": "1"},
+  {"field": "option", "allowed": ["VOTE_OPTION_YES", "VOTE_OPTION_ABSTAIN"]}
+]
+	`, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -106,6 +123,12 @@ Examples:
 
 				authorization = bank.NewSendAuthorization(spendLimit, allowed)
 
+			case "periodic-send":
+				authorization, err = parsePeriodicSendAuthorization(cmd)
+				if err != nil {
+					return err
+				}
+
 			case "generic":
 				msgType, err := cmd.Flags().GetString(FlagMsgType)
 				if err != nil {
@@ -113,6 +136,21 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+			case "constraint":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				constraintsFile, err := cmd.Flags().GetString(FlagConstraints)
+				if err != nil {
+					return err
+				}
+
+				authorization, err = parseFieldConstraintAuthorization(clientCtx.Codec, msgType, constraintsFile)
+				if err != nil {
+					return err
+				}
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -192,8 +230,12 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
-	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization or FieldConstraintAuthorization")
+	cmd.Flags().String(FlagConstraints, "", "Path to a JSON file with the field constraints of a FieldConstraintAuthorization")
+	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend. Optional total cap for Periodic Send Authorization")
+	cmd.Flags().Duration(FlagPeriod, 0, "Period of a Periodic Send Authorization, after which the period limit is reset, e.g. 24h")
+	cmd.Flags().String(FlagPeriodLimit, "", "Coins allowed to spend in every period of a Periodic Send Authorization")
+	cmd.Flags().String(FlagRecipientLimit, "", "Optional coins allowed to send to a single recipient in every period of a Periodic Send Authorization")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
@@ -201,6 +243,85 @@ Examples:
 	return cmd
 }
 
+// parsePeriodicSendAuthorization builds a PeriodicSendAuthorization from the
+// command flags.
+func parsePeriodicSendAuthorization(cmd *cobra.Command) (*bank.PeriodicSendAuthorization, error) {
+	period, err := cmd.Flags().GetDuration(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	if period <= 0 {
+		return nil, fmt.Errorf("period should be greater than zero")
+	}
+
+	periodLimit, err := getCoinsFlag(cmd, FlagPeriodLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	if !periodLimit.IsAllPositive() {
+		return nil, fmt.Errorf("period-limit should be greater than zero")
+	}
+
+	spendLimit, err := getCoinsFlag(cmd, FlagSpendLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientLimit, err := getCoinsFlag(cmd, FlagRecipientLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	allowList, err := cmd.Flags().GetStringSlice(FlagAllowList)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := bech32toAccAddresses(allowList)
+	if err != nil {
+		return nil, err
+	}
+
+	return bank.NewPeriodicSendAuthorization(spendLimit, period, periodLimit, recipientLimit, allowed), nil
+}
+
+func getCoinsFlag(cmd *cobra.Command, flag string) (sdk.Coins, error) {
+	coins, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ParseCoinsNormalized(coins)
+}
+
+// parseFieldConstraintAuthorization reads the JSON list of field constraints
+// from the given file and builds a FieldConstraintAuthorization for msgType.
+func parseFieldConstraintAuthorization(cdc codec.Codec, msgType, constraintsFile string) (*authz.FieldConstraintAuthorization, error) {
+	if constraintsFile == "" {
+		return nil, fmt.Errorf("--%s is required for a constraint authorization", FlagConstraints)
+	}
+
+	contents, err := os.ReadFile(constraintsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeJSON, err := json.Marshal(msgType)
+	if err != nil {
+		return nil, err
+	}
+
+	authorization := &authz.FieldConstraintAuthorization{}
+	bz := []byte(fmt.Sprintf(`{"msg":%s,"constraints":%s}`, msgTypeJSON, contents))
+	if err := cdc.UnmarshalJSON(bz, authorization); err != nil {
+		return nil, fmt.Errorf("invalid constraints: %w", err)
+	}
+
+	return authorization, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -247,6 +368,33 @@ Example:
 	return cmd
 }
 
+// NewCmdRevokeAllAuthorizations returns a CLI command handler for creating a MsgRevokeAll transaction.
+func NewCmdRevokeAllAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all --from=[granter]",
+		Short: "revoke all authorizations granted by the granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`revoke all the authorizations granted by a granter, to any grantee:
+Example:
+ $ %s tx %s revoke-all --from=cosmos1skj..
+			`, version.AppName, authz.ModuleName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgRevokeAll(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdExecAuthorization returns a CLI command handler for creating a MsgExec transaction.
 func NewCmdExecAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
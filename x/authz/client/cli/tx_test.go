@@ -188,6 +188,12 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 	twoHours := time.Now().Add(time.Minute * 120).Unix()
 	pastHour := time.Now().Add(-time.Minute * 60).Unix()
 
+	constraintsFile := testutil.WriteToNewTempFile(s.T(), `[
+		{"field": "proposal_id", "equals": "1"},
+		{"field": "option", "allowed": ["VOTE_OPTION_YES", "VOTE_OPTION_ABSTAIN"]}
+	]`)
+	invalidConstraintsFile := testutil.WriteToNewTempFile(s.T(), `[{"field": "proposal"}]`)
+
 	testCases := []struct {
 		name      string
 		args      []string
@@ -390,6 +396,101 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 			false,
 			"",
 		},
+		{
+			"Valid tx periodic send authorization",
+			[]string{
+				grantee.String(),
+				"periodic-send",
+				fmt.Sprintf("--%s=24h", cli.FlagPeriod),
+				fmt.Sprintf("--%s=100stake", cli.FlagPeriodLimit),
+				fmt.Sprintf("--%s=1000stake", cli.FlagSpendLimit),
+				fmt.Sprintf("--%s=50stake", cli.FlagRecipientLimit),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			false,
+			"",
+		},
+		{
+			"Invalid tx periodic send authorization without period",
+			[]string{
+				grantee.String(),
+				"periodic-send",
+				fmt.Sprintf("--%s=100stake", cli.FlagPeriodLimit),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			true,
+			"period should be greater than zero",
+		},
+		{
+			"Invalid tx periodic send authorization without period limit",
+			[]string{
+				grantee.String(),
+				"periodic-send",
+				fmt.Sprintf("--%s=24h", cli.FlagPeriod),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			true,
+			"period-limit should be greater than zero",
+		},
+		{
+			"Valid tx constraint authorization",
+			[]string{
+				grantee.String(),
+				"constraint",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgVote),
+				fmt.Sprintf("--%s=%s", cli.FlagConstraints, constraintsFile.Name()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			false,
+			"",
+		},
+		{
+			"Invalid tx constraint authorization without constraints",
+			[]string{
+				grantee.String(),
+				"constraint",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgVote),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			true,
+			"--constraints is required",
+		},
+		{
+			"Invalid tx constraint authorization with unknown field",
+			[]string{
+				grantee.String(),
+				"constraint",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgVote),
+				fmt.Sprintf("--%s=%s", cli.FlagConstraints, invalidConstraintsFile.Name()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			true,
+			"unknown field proposal",
+		},
 		{
 			"fail when granter = grantee",
 			[]string{
@@ -576,6 +677,74 @@ func (s *CLITestSuite) TestCmdRevokeAuthorizations() {
 	}
 }
 
+func (s *CLITestSuite) TestCmdRevokeAllAuthorizations() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		respType  proto.Message
+		expectErr bool
+	}{
+		{
+			"unexpected argument",
+			[]string{
+				s.grantee[0].String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			},
+			nil,
+			true,
+		},
+		{
+			"invalid granter address",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, "granter"),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			},
+			nil,
+			true,
+		},
+		{
+			"Valid tx",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+			},
+			&sdk.TxResponse{},
+			false,
+		},
+		{
+			"Valid tx with amino",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagSignMode, flags.SignModeLegacyAminoJSON),
+			},
+			&sdk.TxResponse{},
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.NewCmdRevokeAllAuthorizations()
+
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestExecAuthorizationWithExpiration() {
 	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
 
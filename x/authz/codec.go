@@ -16,10 +16,12 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAll{}, "cosmos-sdk/MsgRevokeAll")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&FieldConstraintAuthorization{}, "cosmos-sdk/FieldConstraintAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -27,6 +29,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrant{},
 		&MsgRevoke{},
+		&MsgRevokeAll{},
 		&MsgExec{},
 	)
 
@@ -34,6 +37,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		"cosmos.authz.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&FieldConstraintAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, MsgServiceDesc())
@@ -0,0 +1,436 @@
+package authz
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerConstraint is the gas consumed for every field constraint checked
+// when accepting a message.
+const gasCostPerConstraint = uint64(10)
+
+// coinMessageName is the full name of the proto message fields must have to
+// be constrained by coin ceilings and periodic spend limits.
+const coinMessageName protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+
+var _ Authorization = &FieldConstraintAuthorization{}
+
+// NewFieldConstraintAuthorization creates a new FieldConstraintAuthorization object.
+func NewFieldConstraintAuthorization(msgTypeURL string, constraints ...FieldConstraint) *FieldConstraintAuthorization {
+	return &FieldConstraintAuthorization{
+		Msg:         msgTypeURL,
+		Constraints: constraints,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FieldConstraintAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept. The message is accepted if it
+// satisfies every constraint. Constraints with a periodic spend limit are
+// charged the amount of their field, in which case the updated authorization
+// is returned.
+func (a FieldConstraintAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	m, err := reflectMsg(msg)
+	if err != nil {
+		return AcceptResponse{}, err
+	}
+
+	updated := false
+	constraints := make([]FieldConstraint, len(a.Constraints))
+	copy(constraints, a.Constraints)
+	for i, c := range constraints {
+		ctx.GasMeter().ConsumeGas(gasCostPerConstraint, "field constraint authorization")
+
+		fds, err := fieldPath(m.Descriptor(), c.Field)
+		if err != nil {
+			return AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		fd, v := fieldValue(m, fds)
+		if err := c.check(fd, v); err != nil {
+			return AcceptResponse{}, err
+		}
+
+		if c.PeriodLimit == nil {
+			continue
+		}
+
+		coins, err := fieldCoins(fd, v)
+		if err != nil {
+			return AcceptResponse{}, sdkerrors.ErrInvalidCoins.Wrapf("field %s: %s", c.Field, err)
+		}
+
+		limit := *c.PeriodLimit
+		limit.tryResetPeriod(ctx.BlockTime())
+
+		canSpend, isNegative := limit.CanSpend.SafeSub(coins...)
+		if isNegative {
+			return AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("field %s: %s is more than the period spend limit left", c.Field, coins)
+		}
+
+		limit.CanSpend = canSpend
+		constraints[i].PeriodLimit = &limit
+		updated = true
+	}
+
+	if !updated {
+		return AcceptResponse{Accept: true}, nil
+	}
+
+	return AcceptResponse{Accept: true, Updated: &FieldConstraintAuthorization{Msg: a.Msg, Constraints: constraints}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a FieldConstraintAuthorization) ValidateBasic() error {
+	if a.Msg == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg type url cannot be empty")
+	}
+
+	if len(a.Constraints) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("constraints cannot be empty")
+	}
+
+	md, err := msgDescriptor(protoreflect.FullName(strings.TrimPrefix(a.Msg, "/")))
+	if err != nil {
+		return sdkerrors.ErrInvalidType.Wrapf("unknown msg type %s", a.Msg)
+	}
+
+	for _, c := range a.Constraints {
+		if err := c.validate(md); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validate checks that the constraint sets at least one check and that its
+// checks fit the kind of the field it targets in the given message.
+func (c FieldConstraint) validate(md protoreflect.MessageDescriptor) error {
+	fds, err := fieldPath(md, c.Field)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	fd := fds[len(fds)-1]
+
+	if c.Equals == "" && len(c.Allowed) == 0 && c.Max == "" && len(c.MaxCoins) == 0 && c.PeriodLimit == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("field %s: constraint must set at least one check", c.Field)
+	}
+
+	if (c.Equals != "" || len(c.Allowed) > 0) && fd.Message() != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("field %s: equals and allowed require a scalar field", c.Field)
+	}
+
+	found := make(map[string]bool, len(c.Allowed))
+	for _, v := range c.Allowed {
+		if found[v] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("field %s: duplicate allowed value %s", c.Field, v)
+		}
+		found[v] = true
+	}
+
+	if c.Max != "" {
+		if !isIntegerKind(fd.Kind()) && fd.Kind() != protoreflect.StringKind {
+			return sdkerrors.ErrInvalidRequest.Wrapf("field %s: max requires an integer field", c.Field)
+		}
+		if max, ok := math.NewIntFromString(c.Max); !ok || max.IsNegative() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("field %s: invalid max %s", c.Field, c.Max)
+		}
+	}
+
+	if (len(c.MaxCoins) > 0 || c.PeriodLimit != nil) && !isCoinField(fd) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("field %s: max_coins and period_limit require a coin field", c.Field)
+	}
+
+	if len(c.MaxCoins) > 0 {
+		if err := c.MaxCoins.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("field %s: %s", c.Field, err)
+		}
+	}
+
+	if c.PeriodLimit != nil {
+		if err := c.PeriodLimit.ValidateBasic(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("field %s: %s", c.Field, err)
+		}
+	}
+
+	return nil
+}
+
+// check verifies the value of the constrained field against the equality,
+// allow-list and ceiling checks of the constraint.
+func (c FieldConstraint) check(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	var max math.Int
+	if c.Max != "" {
+		var ok bool
+		if max, ok = math.NewIntFromString(c.Max); !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("field %s: invalid max %s", c.Field, c.Max)
+		}
+	}
+
+	if c.Equals != "" || len(c.Allowed) > 0 || c.Max != "" {
+		for _, s := range fieldStrings(fd, v) {
+			if c.Equals != "" && s != c.Equals {
+				return sdkerrors.ErrUnauthorized.Wrapf("field %s: %s is not allowed", c.Field, s)
+			}
+
+			if len(c.Allowed) > 0 && !containsString(c.Allowed, s) {
+				return sdkerrors.ErrUnauthorized.Wrapf("field %s: %s is not allowed", c.Field, s)
+			}
+
+			if c.Max != "" {
+				n, ok := math.NewIntFromString(s)
+				if !ok {
+					return sdkerrors.ErrUnauthorized.Wrapf("field %s: %s is not an integer", c.Field, s)
+				}
+				if n.GT(max) {
+					return sdkerrors.ErrUnauthorized.Wrapf("field %s: %s is more than %s", c.Field, s, c.Max)
+				}
+			}
+		}
+	}
+
+	if len(c.MaxCoins) > 0 {
+		coins, err := fieldCoins(fd, v)
+		if err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("field %s: %s", c.Field, err)
+		}
+		if !coins.IsAllLTE(c.MaxCoins) {
+			return sdkerrors.ErrInsufficientFunds.Wrapf("field %s: %s is more than %s", c.Field, coins, c.MaxCoins)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic checks the period is positive and the spend limits are valid.
+func (l PeriodicSpendLimit) ValidateBasic() error {
+	if l.Period <= 0 {
+		return fmt.Errorf("period must be positive, got %s", l.Period)
+	}
+
+	if len(l.Limit) == 0 {
+		return fmt.Errorf("limit cannot be empty")
+	}
+
+	if err := l.Limit.Validate(); err != nil {
+		return err
+	}
+
+	if err := l.CanSpend.Validate(); err != nil {
+		return err
+	}
+
+	if !l.CanSpend.IsAllLTE(l.Limit) {
+		return fmt.Errorf("can spend %s is more than limit %s", l.CanSpend, l.Limit)
+	}
+
+	return nil
+}
+
+// tryResetPeriod refills CanSpend and moves PeriodReset forward once the
+// current period is over. Periods follow each other from the last reset as
+// long as one message is accepted in every period, otherwise the new period
+// starts at the given block time.
+func (l *PeriodicSpendLimit) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(l.PeriodReset) {
+		return
+	}
+
+	l.CanSpend = l.Limit
+	l.PeriodReset = l.PeriodReset.Add(l.Period)
+	if blockTime.After(l.PeriodReset) {
+		l.PeriodReset = blockTime.Add(l.Period)
+	}
+}
+
+// msgDescriptor returns the descriptor of the named message, preferring the
+// gogoproto registry sdk.Msg types are generated with.
+func msgDescriptor(name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	desc, err := proto.GogoResolver.FindDescriptorByName(name)
+	if err != nil {
+		desc, err = proto.HybridResolver.FindDescriptorByName(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	return md, nil
+}
+
+// reflectMsg decodes the gogoproto message into a dynamic message which
+// fields can be inspected through proto reflection.
+func reflectMsg(msg sdk.Msg) (protoreflect.Message, error) {
+	md, err := msgDescriptor(protoreflect.FullName(proto.MessageName(msg)))
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("unknown msg type %s", sdk.MsgTypeURL(msg))
+	}
+
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	m := dynamicpb.NewMessage(md)
+	if err := protov2.Unmarshal(bz, m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// fieldPath resolves the dot-separated path of field names from the given
+// message descriptor. Every field but the last one must be a singular message.
+func fieldPath(md protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	names := strings.Split(path, ".")
+	fds := make([]protoreflect.FieldDescriptor, 0, len(names))
+	for i, name := range names {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %s in %s", name, md.FullName())
+		}
+
+		if fd.IsMap() {
+			return nil, fmt.Errorf("map field %s cannot be constrained", fd.FullName())
+		}
+
+		if i < len(names)-1 {
+			if fd.IsList() || fd.Message() == nil {
+				return nil, fmt.Errorf("field %s must be a singular message", fd.FullName())
+			}
+			md = fd.Message()
+		}
+
+		fds = append(fds, fd)
+	}
+
+	return fds, nil
+}
+
+// fieldValue returns the descriptor and value of the last field of the path.
+func fieldValue(m protoreflect.Message, fds []protoreflect.FieldDescriptor) (protoreflect.FieldDescriptor, protoreflect.Value) {
+	for _, fd := range fds[:len(fds)-1] {
+		m = m.Get(fd).Message()
+	}
+
+	fd := fds[len(fds)-1]
+	return fd, m.Get(fd)
+}
+
+// fieldStrings returns the string representation of every value of a scalar
+// field. Enums are represented by their value name and bytes are base64
+// encoded, as in the proto JSON mapping.
+func fieldStrings(fd protoreflect.FieldDescriptor, v protoreflect.Value) []string {
+	if !fd.IsList() {
+		return []string{scalarString(fd, v)}
+	}
+
+	list := v.List()
+	strs := make([]string, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		strs[i] = scalarString(fd, list.Get(i))
+	}
+
+	return strs
+}
+
+func scalarString(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return fmt.Sprint(v.Enum())
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	default:
+		return v.String()
+	}
+}
+
+// fieldCoins returns the coins held by a Coin or repeated Coin field.
+func fieldCoins(fd protoreflect.FieldDescriptor, v protoreflect.Value) (sdk.Coins, error) {
+	var msgs []protoreflect.Message
+	if fd.IsList() {
+		list := v.List()
+		for i := 0; i < list.Len(); i++ {
+			msgs = append(msgs, list.Get(i).Message())
+		}
+	} else {
+		msgs = append(msgs, v.Message())
+	}
+
+	coins := sdk.NewCoins()
+	for _, m := range msgs {
+		fields := m.Descriptor().Fields()
+		denom := m.Get(fields.ByName("denom")).String()
+		amountStr := m.Get(fields.ByName("amount")).String()
+		if denom == "" && amountStr == "" {
+			continue
+		}
+
+		amount, ok := math.NewIntFromString(amountStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %s%s", amountStr, denom)
+		}
+
+		coin := sdk.Coin{Denom: denom, Amount: amount}
+		if err := coin.Validate(); err != nil {
+			return nil, err
+		}
+
+		coins = coins.Add(coin)
+	}
+
+	return coins, nil
+}
+
+func isCoinField(fd protoreflect.FieldDescriptor) bool {
+	return fd.Message() != nil && fd.Message().FullName() == coinMessageName
+}
+
+func isIntegerKind(kind protoreflect.Kind) bool {
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	default:
+		return false
+	}
+}
+
+func containsString(strs []string, s string) bool {
+	for _, str := range strs {
+		if str == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,257 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	granterAddr = sdk.AccAddress("_______granter_____")
+	otherAddr   = sdk.AccAddress("________other______")
+	valAddr     = sdk.ValAddress("_____validator_____")
+	otherVal    = sdk.ValAddress("___other_validator_")
+)
+
+func TestFieldConstraintAuthorizationValidateBasic(t *testing.T) {
+	voteURL := sdk.MsgTypeURL(&govv1.MsgVote{})
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		name   string
+		auth   *authz.FieldConstraintAuthorization
+		expErr string
+	}{
+		{
+			"valid equals and allowed",
+			authz.NewFieldConstraintAuthorization(voteURL,
+				authz.FieldConstraint{Field: "proposal_id", Equals: "1"},
+				authz.FieldConstraint{Field: "option", Allowed: []string{"VOTE_OPTION_YES", "VOTE_OPTION_NO"}},
+			),
+			"",
+		},
+		{
+			"valid coin limits",
+			authz.NewFieldConstraintAuthorization(sendURL,
+				authz.FieldConstraint{Field: "amount", MaxCoins: coins, PeriodLimit: &authz.PeriodicSpendLimit{Period: time.Hour, Limit: coins}},
+			),
+			"",
+		},
+		{
+			"empty msg",
+			authz.NewFieldConstraintAuthorization("", authz.FieldConstraint{Field: "proposal_id", Equals: "1"}),
+			"msg type url cannot be empty",
+		},
+		{
+			"no constraints",
+			authz.NewFieldConstraintAuthorization(voteURL),
+			"constraints cannot be empty",
+		},
+		{
+			"unknown msg type",
+			authz.NewFieldConstraintAuthorization("/cosmos.unknown.v1.MsgUnknown", authz.FieldConstraint{Field: "proposal_id", Equals: "1"}),
+			"unknown msg type",
+		},
+		{
+			"unknown field",
+			authz.NewFieldConstraintAuthorization(voteURL, authz.FieldConstraint{Field: "proposal", Equals: "1"}),
+			"unknown field proposal",
+		},
+		{
+			"path through scalar field",
+			authz.NewFieldConstraintAuthorization(voteURL, authz.FieldConstraint{Field: "voter.address", Equals: "1"}),
+			"must be a singular message",
+		},
+		{
+			"no check",
+			authz.NewFieldConstraintAuthorization(voteURL, authz.FieldConstraint{Field: "proposal_id"}),
+			"at least one check",
+		},
+		{
+			"equals on message field",
+			authz.NewFieldConstraintAuthorization(sendURL, authz.FieldConstraint{Field: "amount", Equals: "1stake"}),
+			"require a scalar field",
+		},
+		{
+			"duplicate allowed value",
+			authz.NewFieldConstraintAuthorization(voteURL, authz.FieldConstraint{Field: "option", Allowed: []string{"VOTE_OPTION_YES", "VOTE_OPTION_YES"}}),
+			"duplicate allowed value",
+		},
+		{
+			"max on bool field",
+			authz.NewFieldConstraintAuthorization(sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}), authz.FieldConstraint{Field: "expedited", Max: "1"}),
+			"max requires an integer field",
+		},
+		{
+			"negative max",
+			authz.NewFieldConstraintAuthorization(voteURL, authz.FieldConstraint{Field: "proposal_id", Max: "-1"}),
+			"invalid max",
+		},
+		{
+			"max coins on scalar field",
+			authz.NewFieldConstraintAuthorization(voteURL, authz.FieldConstraint{Field: "proposal_id", MaxCoins: coins}),
+			"require a coin field",
+		},
+		{
+			"invalid period",
+			authz.NewFieldConstraintAuthorization(sendURL,
+				authz.FieldConstraint{Field: "amount", PeriodLimit: &authz.PeriodicSpendLimit{Limit: coins}},
+			),
+			"period must be positive",
+		},
+		{
+			"can spend more than limit",
+			authz.NewFieldConstraintAuthorization(sendURL,
+				authz.FieldConstraint{Field: "amount", PeriodLimit: &authz.PeriodicSpendLimit{Period: time.Hour, Limit: coins, CanSpend: coins.Add(coins...)}},
+			),
+			"more than limit",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFieldConstraintAuthorizationAccept(t *testing.T) {
+	ctx := testutil.DefaultContextWithDB(t, sdk.NewKVStoreKey(authz.ModuleName), sdk.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeader(tmproto.Header{})
+
+	t.Log("verify vote constrained to a proposal and options")
+	auth := authz.NewFieldConstraintAuthorization(sdk.MsgTypeURL(&govv1.MsgVote{}),
+		authz.FieldConstraint{Field: "proposal_id", Equals: "1"},
+		authz.FieldConstraint{Field: "option", Allowed: []string{"VOTE_OPTION_YES", "VOTE_OPTION_ABSTAIN"}},
+	)
+	require.NoError(t, auth.ValidateBasic())
+
+	resp, err := auth.Accept(ctx, govv1.NewMsgVote(granterAddr, 1, govv1.OptionYes, ""))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	_, err = auth.Accept(ctx, govv1.NewMsgVote(granterAddr, 2, govv1.OptionYes, ""))
+	require.ErrorContains(t, err, "field proposal_id: 2 is not allowed")
+
+	_, err = auth.Accept(ctx, govv1.NewMsgVote(granterAddr, 1, govv1.OptionNo, ""))
+	require.ErrorContains(t, err, "field option: VOTE_OPTION_NO is not allowed")
+
+	_, err = auth.Accept(ctx, banktypes.NewMsgSend(granterAddr, otherAddr, nil))
+	require.ErrorContains(t, err, "type mismatch")
+
+	t.Log("verify reward withdrawal constrained to a validator")
+	auth = authz.NewFieldConstraintAuthorization(sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+		authz.FieldConstraint{Field: "validator_address", Equals: valAddr.String()},
+	)
+	require.NoError(t, auth.ValidateBasic())
+
+	_, err = auth.Accept(ctx, distrtypes.NewMsgWithdrawDelegatorReward(granterAddr, valAddr))
+	require.NoError(t, err)
+
+	_, err = auth.Accept(ctx, distrtypes.NewMsgWithdrawDelegatorReward(granterAddr, otherVal))
+	require.ErrorContains(t, err, "is not allowed")
+
+	t.Log("verify integer ceiling")
+	auth = authz.NewFieldConstraintAuthorization(sdk.MsgTypeURL(&govv1.MsgVote{}),
+		authz.FieldConstraint{Field: "proposal_id", Max: "10"},
+	)
+	require.NoError(t, auth.ValidateBasic())
+
+	_, err = auth.Accept(ctx, govv1.NewMsgVote(granterAddr, 10, govv1.OptionYes, ""))
+	require.NoError(t, err)
+
+	_, err = auth.Accept(ctx, govv1.NewMsgVote(granterAddr, 11, govv1.OptionYes, ""))
+	require.ErrorContains(t, err, "field proposal_id: 11 is more than 10")
+
+	t.Log("verify nested field and coin ceiling")
+	auth = authz.NewFieldConstraintAuthorization(sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		authz.FieldConstraint{Field: "amount.denom", Equals: "stake"},
+		authz.FieldConstraint{Field: "amount", MaxCoins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+	)
+	require.NoError(t, auth.ValidateBasic())
+
+	_, err = auth.Accept(ctx, stakingtypes.NewMsgDelegate(granterAddr, valAddr, sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, err)
+
+	_, err = auth.Accept(ctx, stakingtypes.NewMsgDelegate(granterAddr, valAddr, sdk.NewInt64Coin("stake", 101)))
+	require.ErrorContains(t, err, "101stake is more than 100stake")
+
+	_, err = auth.Accept(ctx, stakingtypes.NewMsgDelegate(granterAddr, valAddr, sdk.NewInt64Coin("atom", 1)))
+	require.ErrorContains(t, err, "field amount.denom: atom is not allowed")
+}
+
+func TestFieldConstraintAuthorizationPeriodLimit(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := testutil.DefaultContextWithDB(t, sdk.NewKVStoreKey(authz.ModuleName), sdk.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeader(tmproto.Header{Time: now})
+
+	limit := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	auth := authz.NewFieldConstraintAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		authz.FieldConstraint{Field: "to_address", Equals: otherAddr.String()},
+		authz.FieldConstraint{Field: "amount", PeriodLimit: &authz.PeriodicSpendLimit{Period: time.Hour, Limit: limit}},
+	)
+	require.NoError(t, auth.ValidateBasic())
+
+	t.Log("verify first message starts the period")
+	resp, err := auth.Accept(ctx, banktypes.NewMsgSend(granterAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.NotNil(t, resp.Updated)
+
+	updated := resp.Updated.(*authz.FieldConstraintAuthorization)
+	require.Equal(t, auth.Constraints[0], updated.Constraints[0])
+	periodLimit := updated.Constraints[1].PeriodLimit
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), periodLimit.CanSpend)
+	require.Equal(t, now.Add(time.Hour), periodLimit.PeriodReset)
+	require.True(t, auth.Constraints[1].PeriodLimit.CanSpend.IsZero(), "original authorization must not be modified")
+
+	t.Log("verify spend above what is left in the period is rejected")
+	_, err = updated.Accept(ctx, banktypes.NewMsgSend(granterAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 41))))
+	require.ErrorContains(t, err, "more than the period spend limit left")
+
+	_, err = updated.Accept(ctx, banktypes.NewMsgSend(granterAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("atom", 1))))
+	require.ErrorContains(t, err, "more than the period spend limit left")
+
+	resp, err = updated.Accept(ctx, banktypes.NewMsgSend(granterAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 40))))
+	require.NoError(t, err)
+	updated = resp.Updated.(*authz.FieldConstraintAuthorization)
+	require.True(t, updated.Constraints[1].PeriodLimit.CanSpend.IsZero())
+
+	t.Log("verify limit is refilled in the next period")
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	resp, err = updated.Accept(ctx, banktypes.NewMsgSend(granterAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	require.NoError(t, err)
+	updated = resp.Updated.(*authz.FieldConstraintAuthorization)
+	require.True(t, updated.Constraints[1].PeriodLimit.CanSpend.IsZero())
+	require.Equal(t, now.Add(2*time.Hour), updated.Constraints[1].PeriodLimit.PeriodReset)
+
+	t.Log("verify a skipped period starts the new one at block time")
+	later := now.Add(5 * time.Hour)
+	ctx = ctx.WithBlockTime(later)
+	resp, err = updated.Accept(ctx, banktypes.NewMsgSend(granterAddr, otherAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.NoError(t, err)
+	updated = resp.Updated.(*authz.FieldConstraintAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), updated.Constraints[1].PeriodLimit.CanSpend)
+	require.Equal(t, later.Add(time.Hour), updated.Constraints[1].PeriodLimit.PeriodReset)
+
+	t.Log("verify other constraints are still enforced")
+	_, err = updated.Accept(ctx, banktypes.NewMsgSend(granterAddr, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+	require.ErrorContains(t, err, "field to_address")
+}
@@ -182,3 +182,114 @@ func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRe
 		Pagination: pageRes,
 	}, nil
 }
+
+// ExpiringGrants implements the Query/ExpiringGrants gRPC method.
+// It returns the grants expiring after the current block time and within the
+// requested window, ordered by expiration.
+func (k Keeper) ExpiringGrants(c context.Context, req *authz.QueryExpiringGrantsRequest) (*authz.QueryExpiringGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Window <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "window must be positive")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	now := ctx.BlockTime()
+	end := now.Add(req.Window)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), GrantQueuePrefix)
+
+	var grants []*authz.GrantAuthorization
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		expiration, granter, grantee, err := parseGrantQueueKey(append(GrantQueuePrefix, key...))
+		if err != nil {
+			return false, err
+		}
+
+		// expired grants not pruned yet are not returned
+		if !expiration.After(now) || expiration.After(end) {
+			return false, nil
+		}
+
+		if accumulate {
+			var queueItem authz.GrantQueueItem
+			if err := k.cdc.Unmarshal(value, &queueItem); err != nil {
+				return false, err
+			}
+
+			for _, typeURL := range queueItem.MsgTypeUrls {
+				grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, typeURL))
+				if !found {
+					continue
+				}
+
+				grants = append(grants, &authz.GrantAuthorization{
+					Authorization: grant.Authorization,
+					Expiration:    grant.Expiration,
+					Granter:       granter.String(),
+					Grantee:       grantee.String(),
+				})
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryExpiringGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SpendAllowance implements the Query/SpendAllowance gRPC method.
+// It returns the coins left to spend under the grant of the given msg type,
+// which authorization must implement authz.SpendLimitAuthorization.
+func (k Keeper) SpendAllowance(c context.Context, req *authz.QuerySpendAllowanceRequest) (*authz.QuerySpendAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.MsgTypeUrl == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty msg type url")
+	}
+
+	granter, err := sdk.AccAddressFromBech32(req.Granter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid granter address: %s", err.Error())
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	authorization, _ := k.GetAuthorization(ctx, grantee, granter, req.MsgTypeUrl)
+	if authorization == nil {
+		return nil, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "authorization not found for %s type", req.MsgTypeUrl)
+	}
+
+	spendLimitAuth, ok := authorization.(authz.SpendLimitAuthorization)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "authorization %T has no spend limit", authorization)
+	}
+
+	blockTime := ctx.BlockTime()
+	spendLimit, periodCanSpend, periodReset := spendLimitAuth.SpendAllowance(blockTime)
+
+	res := &authz.QuerySpendAllowanceResponse{
+		SpendLimit:     spendLimit,
+		PeriodCanSpend: periodCanSpend,
+	}
+	if !periodReset.IsZero() {
+		timeToReset := periodReset.Sub(blockTime)
+		res.PeriodReset = &periodReset
+		res.TimeToReset = &timeToReset
+	}
+
+	return res, nil
+}
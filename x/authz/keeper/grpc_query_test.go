@@ -6,7 +6,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -302,6 +305,214 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQuerySpendAllowance() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+	granter, grantee := addrs[0], addrs[1]
+	msgTypeURL := banktypes.SendAuthorization{}.MsgTypeURL()
+	now := suite.ctx.BlockTime()
+	exp := now.Add(24 * time.Hour)
+	coins100 := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+	coins10 := sdk.NewCoins(sdk.NewInt64Coin("steak", 10))
+
+	testCases := []struct {
+		msg      string
+		malleate func() *authz.QuerySpendAllowanceRequest
+		expError string
+		expCode  codes.Code
+		expRes   *authz.QuerySpendAllowanceResponse
+	}{
+		{
+			"fail empty msg type url",
+			func() *authz.QuerySpendAllowanceRequest {
+				return &authz.QuerySpendAllowanceRequest{Granter: granter.String(), Grantee: grantee.String()}
+			},
+			"empty msg type url",
+			codes.InvalidArgument,
+			nil,
+		},
+		{
+			"fail invalid granter addr",
+			func() *authz.QuerySpendAllowanceRequest {
+				return &authz.QuerySpendAllowanceRequest{Grantee: grantee.String(), MsgTypeUrl: msgTypeURL}
+			},
+			"invalid granter address",
+			codes.InvalidArgument,
+			nil,
+		},
+		{
+			"fail invalid grantee addr",
+			func() *authz.QuerySpendAllowanceRequest {
+				return &authz.QuerySpendAllowanceRequest{Granter: granter.String(), Grantee: "invalid", MsgTypeUrl: msgTypeURL}
+			},
+			"invalid grantee address",
+			codes.InvalidArgument,
+			nil,
+		},
+		{
+			"fail no authorization",
+			func() *authz.QuerySpendAllowanceRequest {
+				return &authz.QuerySpendAllowanceRequest{Granter: granter.String(), Grantee: grantee.String(), MsgTypeUrl: msgTypeURL}
+			},
+			"authorization not found",
+			codes.OK,
+			nil,
+		},
+		{
+			"fail authorization without spend limit",
+			func() *authz.QuerySpendAllowanceRequest {
+				err := suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authz.NewGenericAuthorization(msgTypeURL), &exp)
+				require.NoError(err)
+				return &authz.QuerySpendAllowanceRequest{Granter: granter.String(), Grantee: grantee.String(), MsgTypeUrl: msgTypeURL}
+			},
+			"has no spend limit",
+			codes.InvalidArgument,
+			nil,
+		},
+		{
+			"send authorization",
+			func() *authz.QuerySpendAllowanceRequest {
+				err := suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, banktypes.NewSendAuthorization(coins100, nil), &exp)
+				require.NoError(err)
+				return &authz.QuerySpendAllowanceRequest{Granter: granter.String(), Grantee: grantee.String(), MsgTypeUrl: msgTypeURL}
+			},
+			"",
+			codes.OK,
+			&authz.QuerySpendAllowanceResponse{SpendLimit: coins100},
+		},
+		{
+			"periodic send authorization",
+			func() *authz.QuerySpendAllowanceRequest {
+				a := banktypes.NewPeriodicSendAuthorization(coins100, time.Hour, coins10, nil, nil)
+				a.PeriodCanSpend = sdk.NewCoins(sdk.NewInt64Coin("steak", 4))
+				a.PeriodReset = now.Add(30 * time.Minute)
+				err := suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, a, &exp)
+				require.NoError(err)
+				return &authz.QuerySpendAllowanceRequest{Granter: granter.String(), Grantee: grantee.String(), MsgTypeUrl: msgTypeURL}
+			},
+			"",
+			codes.OK,
+			&authz.QuerySpendAllowanceResponse{
+				SpendLimit:     coins100,
+				PeriodCanSpend: sdk.NewCoins(sdk.NewInt64Coin("steak", 4)),
+				PeriodReset:    func() *time.Time { t := now.Add(30 * time.Minute); return &t }(),
+				TimeToReset:    func() *time.Duration { d := 30 * time.Minute; return &d }(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			req := tc.malleate()
+			res, err := queryClient.SpendAllowance(gocontext.Background(), req)
+			if tc.expError != "" {
+				require.ErrorContains(err, tc.expError)
+				if tc.expCode != codes.OK {
+					require.Equal(tc.expCode, status.Code(err))
+				}
+				return
+			}
+
+			require.NoError(err)
+			require.Equal(tc.expRes.SpendLimit, res.SpendLimit)
+			require.Equal(tc.expRes.PeriodCanSpend, res.PeriodCanSpend)
+			if tc.expRes.PeriodReset == nil {
+				require.Nil(res.PeriodReset)
+				require.Nil(res.TimeToReset)
+			} else {
+				require.True(tc.expRes.PeriodReset.Equal(*res.PeriodReset))
+				require.Equal(*tc.expRes.TimeToReset, *res.TimeToReset)
+			}
+		})
+	}
+}
+
+func (suite *TestSuite) TestGRPCQueryExpiringGrants() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+	now := suite.ctx.BlockTime()
+
+	authorization := &banktypes.SendAuthorization{SpendLimit: coins100}
+	for i, grantee := range addrs[1:4] {
+		exp := now.Add(time.Duration(i+1) * time.Hour)
+		err := suite.authzKeeper.SaveGrant(suite.ctx, grantee, addrs[0], authorization, &exp)
+		require.NoError(err)
+	}
+	exp := now.Add(time.Hour)
+	err := suite.authzKeeper.SaveGrant(suite.ctx, addrs[1], addrs[0], authz.NewGenericAuthorization("/cosmos.gov.v1.MsgVote"), &exp)
+	require.NoError(err)
+	// grant without expiration is never returned
+	err = suite.authzKeeper.SaveGrant(suite.ctx, addrs[5], addrs[0], authorization, nil)
+	require.NoError(err)
+
+	testCases := []struct {
+		msg      string
+		expError string
+		request  authz.QueryExpiringGrantsRequest
+		numItems int
+	}{
+		{
+			"fail zero window",
+			"window must be positive",
+			authz.QueryExpiringGrantsRequest{},
+			0,
+		},
+		{
+			"valid case, grants in the same queue item",
+			"",
+			authz.QueryExpiringGrantsRequest{Window: time.Hour},
+			2,
+		},
+		{
+			"valid case, multiple queue items",
+			"",
+			authz.QueryExpiringGrantsRequest{Window: 2 * time.Hour},
+			3,
+		},
+		{
+			"valid case, all expiring grants",
+			"",
+			authz.QueryExpiringGrantsRequest{Window: 24 * time.Hour},
+			4,
+		},
+		{
+			"valid case, pagination",
+			"",
+			authz.QueryExpiringGrantsRequest{
+				Window: 24 * time.Hour,
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := queryClient.ExpiringGrants(gocontext.Background(), &tc.request)
+			if tc.expError != "" {
+				require.ErrorContains(err, tc.expError)
+			} else {
+				require.NoError(err)
+				require.Len(result.Grants, tc.numItems)
+				for _, grant := range result.Grants {
+					require.Equal(addrs[0].String(), grant.Granter)
+					require.False(grant.Expiration.After(now.Add(tc.request.Window)))
+				}
+			}
+		})
+	}
+
+	suite.T().Log("verify expired grants not pruned yet are not returned")
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx.WithBlockTime(now.Add(time.Hour)), suite.encCfg.InterfaceRegistry)
+	authz.RegisterQueryServer(queryHelper, suite.authzKeeper)
+	result, err := authz.NewQueryClient(queryHelper).ExpiringGrants(gocontext.Background(), &authz.QueryExpiringGrantsRequest{Window: time.Hour})
+	require.NoError(err)
+	require.Len(result.Grants, 1)
+	require.Equal(addrs[2].String(), result.Grants[0].Grantee)
+}
+
 func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.BlockHeader().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -227,6 +228,32 @@ func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk
 	})
 }
 
+// DeleteAllGrants revokes all the authorizations granted by the granter and
+// returns the number of revoked grants.
+func (k Keeper) DeleteAllGrants(ctx sdk.Context, granter sdk.AccAddress) (uint64, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, granterStoreKey(granter))
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	if len(keys) == 0 {
+		return 0, sdkerrors.Wrapf(authz.ErrNoAuthorizationFound, "no authorization granted by %s", granter)
+	}
+
+	for _, key := range keys {
+		_, grantee, msgType := parseGrantStoreKey(key)
+		if err := k.DeleteGrant(ctx, grantee, granter, msgType); err != nil {
+			return 0, err
+		}
+	}
+
+	return uint64(len(keys)), nil
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress) ([]authz.Authorization, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -375,13 +402,21 @@ func (k Keeper) removeFromGrantQueue(ctx sdk.Context, grantKey []byte, granter,
 }
 
 // DequeueAndDeleteExpiredGrants deletes expired grants from the state and grant queue.
-func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
+// At most limit grant queue items are processed, the remaining expired grants
+// are deleted in the next calls.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context, limit int) error {
 	store := ctx.KVStore(k.storeKey)
 
 	iterator := store.Iterator(GrantQueuePrefix, sdk.InclusiveEndBytes(GrantQueueTimePrefix(ctx.BlockTime())))
 	defer iterator.Close()
 
+	count, pruned := 0, 0
 	for ; iterator.Valid(); iterator.Next() {
+		// limit the amount of iterations to avoid taking too much time
+		if count >= limit {
+			break
+		}
+
 		var queueItem authz.GrantQueueItem
 		if err := k.cdc.Unmarshal(iterator.Value(), &queueItem); err != nil {
 			return err
@@ -397,7 +432,12 @@ func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context) error {
 		for _, typeURL := range queueItem.MsgTypeUrls {
 			store.Delete(grantStoreKey(grantee, granter, typeURL))
 		}
+
+		count++
+		pruned += len(queueItem.MsgTypeUrls)
 	}
 
+	telemetry.IncrCounter(float32(pruned), authz.ModuleName, "pruned_grants")
+
 	return nil
 }
@@ -273,6 +273,60 @@ func (s *TestSuite) TestDispatchAction() {
 				require.Len(authzs, 0)
 			},
 		},
+		{
+			"expect error field constraint violated",
+			authz.NewMsgExec(granteeAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterAddr.String(),
+					ToAddress:   granteeAddr.String(),
+				},
+			}),
+			true,
+			"field to_address",
+			func() sdk.Context {
+				e := now.AddDate(0, 1, 0)
+				fa := authz.NewFieldConstraintAuthorization(bankSendAuthMsgType,
+					authz.FieldConstraint{Field: "to_address", Equals: recipientAddr.String()},
+				)
+				err := s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, fa, &e)
+				require.NoError(err)
+				return s.ctx
+			},
+			func() {},
+		},
+		{
+			"valid test verify field constraint period spend left",
+			authz.NewMsgExec(granteeAddr, []sdk.Msg{
+				&banktypes.MsgSend{
+					Amount:      coins10,
+					FromAddress: granterAddr.String(),
+					ToAddress:   recipientAddr.String(),
+				},
+			}),
+			false,
+			"",
+			func() sdk.Context {
+				e := now.AddDate(0, 1, 0)
+				fa := authz.NewFieldConstraintAuthorization(bankSendAuthMsgType,
+					authz.FieldConstraint{Field: "to_address", Equals: recipientAddr.String()},
+					authz.FieldConstraint{Field: "amount", PeriodLimit: &authz.PeriodicSpendLimit{Period: time.Hour, Limit: coins100}},
+				)
+				err := s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, fa, &e)
+				require.NoError(err)
+				return s.ctx
+			},
+			func() {
+				authzs, err := s.authzKeeper.GetAuthorizations(s.ctx, granteeAddr, granterAddr)
+				require.NoError(err)
+				require.Len(authzs, 1)
+				authorization := authzs[0].(*authz.FieldConstraintAuthorization)
+				require.NotNil(authorization)
+				periodLimit := authorization.Constraints[1].PeriodLimit
+				require.Equal(coins100.Sub(coins10...), periodLimit.CanSpend)
+				require.Equal(now.Add(time.Hour), periodLimit.PeriodReset)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -370,7 +424,7 @@ func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require.NoError(err)
 
 	newCtx := s.ctx.WithBlockTime(exp.AddDate(1, 0, 0))
-	err = s.authzKeeper.DequeueAndDeleteExpiredGrants(newCtx)
+	err = s.authzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 200)
 	require.NoError(err)
 
 	s.T().Log("verify expired grants are pruned from the state")
@@ -391,6 +445,38 @@ func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require.Len(authzs, 1)
 }
 
+func (s *TestSuite) TestDequeueExpiredGrantsLimit() {
+	require := s.Require()
+	granter := s.addrs[0]
+	a := banktypes.SendAuthorization{SpendLimit: coins100}
+
+	// each grantee has its own grant queue item
+	for i, grantee := range s.addrs[1:4] {
+		exp := s.ctx.BlockTime().Add(time.Duration(i+1) * time.Hour)
+		err := s.authzKeeper.SaveGrant(s.ctx, grantee, granter, &a, &exp)
+		require.NoError(err)
+	}
+
+	newCtx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(24 * time.Hour))
+	err := s.authzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 2)
+	require.NoError(err)
+
+	s.T().Log("verify only the first expiring grants are pruned")
+	for i, grantee := range s.addrs[1:4] {
+		authorization, _ := s.authzKeeper.GetAuthorization(s.ctx, grantee, granter, bankSendAuthMsgType)
+		require.Equal(i >= 2, authorization != nil)
+	}
+
+	err = s.authzKeeper.DequeueAndDeleteExpiredGrants(newCtx, 2)
+	require.NoError(err)
+
+	s.T().Log("verify remaining grants are pruned in the next call")
+	for _, grantee := range s.addrs[1:4] {
+		authorization, _ := s.authzKeeper.GetAuthorization(s.ctx, grantee, granter, bankSendAuthMsgType)
+		require.Nil(authorization)
+	}
+}
+
 func (s *TestSuite) TestGetAuthorization() {
 	addr1 := s.addrs[3]
 	addr2 := s.addrs[4]
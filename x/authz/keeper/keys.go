@@ -38,6 +38,14 @@ func grantStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType strin
 	return key
 }
 
+// granterStoreKey - return the prefix of the authorization store keys of all
+// the grants issued by the granter
+//
+// - 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes>
+func granterStoreKey(granter sdk.AccAddress) []byte {
+	return sdk.AppendLengthPrefixedBytes(GrantKey, address.MustLengthPrefix(granter))
+}
+
 // parseGrantStoreKey - split granter, grantee address and msg type from the authorization key
 func parseGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, msgType string) {
 	// key is of format:
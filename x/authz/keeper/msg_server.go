@@ -68,6 +68,22 @@ func (k Keeper) Revoke(goCtx context.Context, msg *authz.MsgRevoke) (*authz.MsgR
 	return &authz.MsgRevokeResponse{}, nil
 }
 
+// RevokeAll implements the MsgServer.RevokeAll method.
+func (k Keeper) RevokeAll(goCtx context.Context, msg *authz.MsgRevokeAll) (*authz.MsgRevokeAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := k.DeleteAllGrants(ctx, granter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRevokeAllResponse{Revoked: revoked}, nil
+}
+
 // Exec implements the MsgServer.Exec method.
 func (k Keeper) Exec(goCtx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
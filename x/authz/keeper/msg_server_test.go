@@ -217,6 +217,71 @@ func (suite *TestSuite) TestRevoke() {
 	}
 }
 
+func (suite *TestSuite) TestRevokeAll() {
+	addrs := suite.createAccounts(2)
+
+	grantee, granter := addrs[0], addrs[1]
+
+	testCases := []struct {
+		name       string
+		malleate   func() *authz.MsgRevokeAll
+		expErr     bool
+		errMsg     string
+		expRevoked uint64
+	}{
+		{
+			name: "invalid granter",
+			malleate: func() *authz.MsgRevokeAll {
+				return &authz.MsgRevokeAll{Granter: "invalid"}
+			},
+			expErr: true,
+			errMsg: "invalid bech32 string",
+		},
+		{
+			name: "valid grants",
+			malleate: func() *authz.MsgRevokeAll {
+				suite.createSendAuthorization(grantee, granter)
+				err := suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authz.NewGenericAuthorization("/cosmos.gov.v1.MsgVote"), nil)
+				suite.Require().NoError(err)
+				// grant issued by the grantee must not be revoked
+				suite.createSendAuthorization(granter, grantee)
+
+				return &authz.MsgRevokeAll{Granter: granter.String()}
+			},
+			expRevoked: 2,
+		},
+		{
+			name: "no existing grant to revoke",
+			malleate: func() *authz.MsgRevokeAll {
+				return &authz.MsgRevokeAll{Granter: granter.String()}
+			},
+			expErr: true,
+			errMsg: "authorization not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.msgSrvr.RevokeAll(suite.ctx, tc.malleate())
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRevoked, res.Revoked)
+			}
+		})
+	}
+
+	authorizations, err := suite.authzKeeper.GetAuthorizations(suite.ctx, grantee, granter)
+	suite.Require().NoError(err)
+	suite.Require().Empty(authorizations)
+
+	authorizations, err = suite.authzKeeper.GetAuthorizations(suite.ctx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Len(authorizations, 1)
+}
+
 func (suite *TestSuite) TestExec() {
 	addrs := suite.createAccounts(2)
 
@@ -1,14 +1,23 @@
 package authz
 
 import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
 )
 
+// pruneLimit is the maximum number of grant queue items pruned in a block.
+const pruneLimit = 200
+
 // BeginBlocker is called at the beginning of every block
 func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
-	// delete all the mature grants
-	if err := keeper.DequeueAndDeleteExpiredGrants(ctx); err != nil {
+	defer telemetry.ModuleMeasureSince(authz.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// delete the mature grants, up to pruneLimit grant queue items per block
+	if err := keeper.DequeueAndDeleteExpiredGrants(ctx, pruneLimit); err != nil {
 		panic(err)
 	}
 }
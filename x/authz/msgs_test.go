@@ -77,6 +77,25 @@ func TestMsgRevokeAuthorization(t *testing.T) {
 	}
 }
 
+func TestMsgRevokeAllAuthorizations(t *testing.T) {
+	tests := []struct {
+		title      string
+		granter    sdk.AccAddress
+		expectPass bool
+	}{
+		{"nil Granter address", nil, false},
+		{"valid test case", granter, true},
+	}
+	for i, tc := range tests {
+		msg := authz.NewMsgRevokeAll(tc.granter)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // add time interval to a time object and returns a pointer
 func addDatePtr(t *time.Time, months, days int) *time.Time {
 	t2 := t.AddDate(0, months, days)
@@ -219,6 +238,13 @@ func TestAminoJSON(t *testing.T) {
 		string(legacytx.StdSignBytes("foo", 1, 1, 1, legacytx.StdFee{}, []sdk.Msg{msg}, "memo", nil)),
 	)
 
+	msg = &authz.MsgRevokeAll{Granter: "cosmos1abc"}
+	tx.Msgs = []sdk.Msg{msg}
+	require.Equal(t,
+		`{"account_number":"1","chain_id":"foo","fee":{"amount":[],"gas":"0"},"memo":"memo","msgs":[{"type":"cosmos-sdk/MsgRevokeAll","value":{"granter":"cosmos1abc"}}],"sequence":"1","timeout_height":"1"}`,
+		string(legacytx.StdSignBytes("foo", 1, 1, 1, legacytx.StdFee{}, []sdk.Msg{msg}, "memo", nil)),
+	)
+
 	msg = &authz.MsgExec{Grantee: "cosmos1def", Msgs: []*cdctypes.Any{msgSendAny}}
 	tx.Msgs = []sdk.Msg{msg}
 	require.Equal(t,
@@ -7,22 +7,30 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -374,6 +382,255 @@ func (m *QueryGranteeGrantsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryExpiringGrantsRequest is the request type for the Query/ExpiringGrants RPC method.
+type QueryExpiringGrantsRequest struct {
+	// window is the duration after the current block time within which the
+	// returned grants expire.
+	Window time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringGrantsRequest) Reset()         { *m = QueryExpiringGrantsRequest{} }
+func (m *QueryExpiringGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringGrantsRequest) ProtoMessage()    {}
+func (*QueryExpiringGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{6}
+}
+func (m *QueryExpiringGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringGrantsRequest.Merge(m, src)
+}
+func (m *QueryExpiringGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryExpiringGrantsRequest) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *QueryExpiringGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExpiringGrantsResponse is the response type for the Query/ExpiringGrants RPC method.
+type QueryExpiringGrantsResponse struct {
+	// grants is a list of grants expiring within the window, ordered by expiration.
+	Grants []*GrantAuthorization `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringGrantsResponse) Reset()         { *m = QueryExpiringGrantsResponse{} }
+func (m *QueryExpiringGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringGrantsResponse) ProtoMessage()    {}
+func (*QueryExpiringGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{7}
+}
+func (m *QueryExpiringGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExpiringGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringGrantsResponse.Merge(m, src)
+}
+func (m *QueryExpiringGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExpiringGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringGrantsResponse) GetGrants() []*GrantAuthorization {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryExpiringGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySpendAllowanceRequest is the request type for the Query/SpendAllowance RPC method.
+type QuerySpendAllowanceRequest struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// msg_type_url is the msg type of the grant.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QuerySpendAllowanceRequest) Reset()         { *m = QuerySpendAllowanceRequest{} }
+func (m *QuerySpendAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendAllowanceRequest) ProtoMessage()    {}
+func (*QuerySpendAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{8}
+}
+func (m *QuerySpendAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendAllowanceRequest.Merge(m, src)
+}
+func (m *QuerySpendAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendAllowanceRequest proto.InternalMessageInfo
+
+func (m *QuerySpendAllowanceRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QuerySpendAllowanceRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *QuerySpendAllowanceRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// QuerySpendAllowanceResponse is the response type for the Query/SpendAllowance RPC method.
+type QuerySpendAllowanceResponse struct {
+	// spend_limit is the amount of coins left to spend in total. It is empty
+	// when the authorization has no total cap.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period_can_spend is the amount of coins left to spend in the current
+	// period. It is empty when the authorization has no period.
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// period_reset is the time at which the current period ends, if the
+	// authorization has a period.
+	PeriodReset *time.Time `protobuf:"bytes,3,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset,omitempty"`
+	// time_to_reset is the time left until period_reset.
+	TimeToReset *time.Duration `protobuf:"bytes,4,opt,name=time_to_reset,json=timeToReset,proto3,stdduration" json:"time_to_reset,omitempty"`
+}
+
+func (m *QuerySpendAllowanceResponse) Reset()         { *m = QuerySpendAllowanceResponse{} }
+func (m *QuerySpendAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySpendAllowanceResponse) ProtoMessage()    {}
+func (*QuerySpendAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_376d714ffdeb1545, []int{9}
+}
+func (m *QuerySpendAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySpendAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySpendAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySpendAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySpendAllowanceResponse.Merge(m, src)
+}
+func (m *QuerySpendAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySpendAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySpendAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySpendAllowanceResponse proto.InternalMessageInfo
+
+func (m *QuerySpendAllowanceResponse) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *QuerySpendAllowanceResponse) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *QuerySpendAllowanceResponse) GetPeriodReset() *time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return nil
+}
+
+func (m *QuerySpendAllowanceResponse) GetTimeToReset() *time.Duration {
+	if m != nil {
+		return m.TimeToReset
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGrantsRequest")
 	proto.RegisterType((*QueryGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGrantsResponse")
@@ -381,46 +638,70 @@ func init() {
 	proto.RegisterType((*QueryGranterGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranterGrantsResponse")
 	proto.RegisterType((*QueryGranteeGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsRequest")
 	proto.RegisterType((*QueryGranteeGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryGranteeGrantsResponse")
+	proto.RegisterType((*QueryExpiringGrantsRequest)(nil), "cosmos.authz.v1beta1.QueryExpiringGrantsRequest")
+	proto.RegisterType((*QueryExpiringGrantsResponse)(nil), "cosmos.authz.v1beta1.QueryExpiringGrantsResponse")
+	proto.RegisterType((*QuerySpendAllowanceRequest)(nil), "cosmos.authz.v1beta1.QuerySpendAllowanceRequest")
+	proto.RegisterType((*QuerySpendAllowanceResponse)(nil), "cosmos.authz.v1beta1.QuerySpendAllowanceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/query.proto", fileDescriptor_376d714ffdeb1545) }
 
 var fileDescriptor_376d714ffdeb1545 = []byte{
-	// 529 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x41, 0x6f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xeb, 0xf6, 0xff, 0x2f, 0xc2, 0x83, 0x8b, 0xe1, 0x90, 0x85, 0x29, 0x8a, 0xaa, 0x09,
-	0x0a, 0xd2, 0xec, 0xad, 0x93, 0x38, 0x22, 0xb6, 0xc3, 0x76, 0x85, 0x00, 0x17, 0x2e, 0x55, 0xba,
-	0xbe, 0x72, 0x23, 0xda, 0x38, 0xb3, 0x1d, 0x44, 0x87, 0x76, 0x81, 0x2f, 0x80, 0xb4, 0x03, 0x1f,
-	0x01, 0x89, 0x33, 0x1f, 0x82, 0xe3, 0x04, 0x17, 0x8e, 0xa8, 0x45, 0xf0, 0x35, 0x50, 0x6d, 0x97,
-	0xae, 0x23, 0xdb, 0x02, 0xd3, 0x24, 0x4e, 0xad, 0xa3, 0xe7, 0x7d, 0xde, 0xdf, 0xfb, 0x38, 0x76,
-	0x70, 0xb8, 0x23, 0xd4, 0x40, 0x28, 0x16, 0xe7, 0xba, 0xb7, 0xc7, 0x9e, 0xaf, 0x75, 0x40, 0xc7,
-	0x6b, 0x6c, 0x37, 0x07, 0x39, 0xa4, 0x99, 0x14, 0x5a, 0x90, 0xeb, 0x56, 0x41, 0x8d, 0x82, 0x3a,
-	0x85, 0xbf, 0xc4, 0x85, 0xe0, 0x7d, 0x60, 0x71, 0x96, 0xb0, 0x38, 0x4d, 0x85, 0x8e, 0x75, 0x22,
-	0x52, 0x65, 0x6b, 0xfc, 0x3b, 0xce, 0xb5, 0x13, 0x2b, 0xb0, 0x66, 0xbf, 0xac, 0xb3, 0x98, 0x27,
-	0xa9, 0x11, 0x3b, 0x6d, 0x31, 0x81, 0xed, 0x66, 0x15, 0x8b, 0x56, 0xd1, 0x36, 0x2b, 0xe6, 0x70,
-	0xcc, 0xa2, 0xf1, 0x1d, 0x61, 0xf2, 0x70, 0xe2, 0xbf, 0x2d, 0xe3, 0x54, 0xab, 0x08, 0x76, 0x73,
-	0x50, 0x9a, 0xb4, 0xf0, 0x25, 0x3e, 0x79, 0x00, 0xd2, 0x43, 0x21, 0x6a, 0x5e, 0xde, 0xf4, 0x3e,
-	0x7d, 0x58, 0x99, 0x0e, 0xb2, 0xd1, 0xed, 0x4a, 0x50, 0xea, 0x91, 0x96, 0x49, 0xca, 0xa3, 0xa9,
-	0x70, 0x56, 0x03, 0x5e, 0xb5, 0x5c, 0x0d, 0x90, 0x10, 0x5f, 0x19, 0x28, 0xde, 0xd6, 0xc3, 0x0c,
-	0xda, 0xb9, 0xec, 0x7b, 0xb5, 0x49, 0x61, 0x84, 0x07, 0x8a, 0x3f, 0x1e, 0x66, 0xf0, 0x44, 0xf6,
-	0xc9, 0x16, 0xc6, 0xb3, 0x89, 0xbd, 0xff, 0x42, 0xd4, 0x5c, 0x68, 0xdd, 0xa4, 0xce, 0x75, 0x12,
-	0x0f, 0xb5, 0x59, 0xbb, 0xb9, 0xe9, 0x83, 0x98, 0x83, 0x9b, 0x22, 0x3a, 0x52, 0xd9, 0x38, 0x40,
-	0xf8, 0xda, 0xdc, 0xa0, 0x2a, 0x13, 0xa9, 0x02, 0xb2, 0x8e, 0xeb, 0x06, 0x46, 0x79, 0x28, 0xac,
-	0x35, 0x17, 0x5a, 0x37, 0x68, 0xd1, 0x76, 0x51, 0x53, 0x15, 0x39, 0x29, 0xd9, 0x9e, 0x83, 0xaa,
-	0x1a, 0xa8, 0x5b, 0x67, 0x42, 0xd9, 0x8e, 0x73, 0x54, 0x6f, 0x11, 0x5e, 0x9c, 0x51, 0x81, 0x3c,
-	0xff, 0x2e, 0x6c, 0x15, 0xa0, 0xfd, 0x4d, 0x5e, 0xef, 0x10, 0xf6, 0x8b, 0xc8, 0x5c, 0x6c, 0xf7,
-	0x8f, 0xc5, 0xd6, 0x3c, 0x25, 0xb6, 0x8d, 0x5c, 0xf7, 0x84, 0x4c, 0xf6, 0x8c, 0xf1, 0x85, 0x67,
-	0x08, 0x27, 0x64, 0x08, 0x65, 0x33, 0x84, 0x8b, 0xca, 0x10, 0xfe, 0xd9, 0x0c, 0x5b, 0x3f, 0x6a,
-	0xf8, 0x7f, 0x43, 0x4a, 0x5e, 0x23, 0x5c, 0xb7, 0x9c, 0xe4, 0x04, 0x9e, 0xdf, 0xaf, 0x0b, 0xff,
-	0x76, 0x09, 0xa5, 0xed, 0xda, 0x58, 0x7e, 0xf5, 0xf9, 0xdb, 0x41, 0x35, 0x20, 0x4b, 0xac, 0xf0,
-	0xda, 0x72, 0x83, 0xbd, 0x47, 0xf8, 0xea, 0xdc, 0x8b, 0x47, 0xd8, 0x59, 0x2d, 0x8e, 0x1d, 0x1e,
-	0x7f, 0xb5, 0x7c, 0x81, 0x43, 0xbb, 0x6b, 0xd0, 0x56, 0x09, 0x3d, 0x0d, 0x8d, 0xb9, 0x83, 0xc6,
-	0x5e, 0xba, 0x3f, 0xfb, 0x47, 0x60, 0xa1, 0x34, 0x2c, 0xfc, 0x29, 0x2c, 0x9c, 0x03, 0x16, 0xa6,
-	0xb0, 0xb0, 0xbf, 0x79, 0xef, 0xe3, 0x28, 0x40, 0x87, 0xa3, 0x00, 0x7d, 0x1d, 0x05, 0xe8, 0xcd,
-	0x38, 0xa8, 0x1c, 0x8e, 0x83, 0xca, 0x97, 0x71, 0x50, 0x79, 0xba, 0xcc, 0x13, 0xdd, 0xcb, 0x3b,
-	0x74, 0x47, 0x0c, 0xa6, 0x9e, 0xf6, 0x67, 0x45, 0x75, 0x9f, 0xb1, 0x17, 0xb6, 0x41, 0xa7, 0x6e,
-	0xbe, 0x1b, 0xeb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x8b, 0x47, 0x69, 0xf8, 0x06, 0x00,
-	0x00,
+	// 850 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xc7, 0x3d, 0xc6, 0x75, 0xdb, 0x31, 0xa0, 0x6a, 0xca, 0xc1, 0x5e, 0xd0, 0xda, 0xb2, 0x68,
+	0x71, 0x2b, 0xb1, 0x8b, 0x8d, 0x54, 0xa9, 0x42, 0xaa, 0x6a, 0xbb, 0x85, 0x4b, 0x0f, 0xed, 0x42,
+	0x2f, 0xb9, 0x58, 0x6b, 0x7b, 0xb2, 0xac, 0x62, 0xef, 0x2c, 0x3b, 0xb3, 0xe1, 0x47, 0xc4, 0x25,
+	0xb9, 0xe6, 0x80, 0xc4, 0x21, 0xb9, 0x46, 0x8a, 0x92, 0x88, 0x73, 0x0e, 0xf9, 0x13, 0xc8, 0x0d,
+	0x25, 0x97, 0x9c, 0x42, 0x04, 0x51, 0xfe, 0x8e, 0x68, 0x67, 0x66, 0x63, 0x2f, 0x2c, 0xc6, 0x04,
+	0x90, 0x38, 0x79, 0xd7, 0xf3, 0xbe, 0xef, 0x7d, 0xe6, 0x3b, 0x33, 0x6f, 0x16, 0x16, 0x5a, 0x84,
+	0x76, 0x09, 0xd5, 0x4d, 0x9f, 0xad, 0x6e, 0xe9, 0x77, 0xcb, 0x4d, 0xcc, 0xcc, 0xb2, 0xbe, 0xe6,
+	0x63, 0x6f, 0x53, 0x73, 0x3d, 0xc2, 0x08, 0x9a, 0x10, 0x11, 0x1a, 0x8f, 0xd0, 0x64, 0x84, 0x32,
+	0x65, 0x11, 0x62, 0x75, 0xb0, 0x6e, 0xba, 0xb6, 0x6e, 0x3a, 0x0e, 0x61, 0x26, 0xb3, 0x89, 0x43,
+	0x85, 0x46, 0xf9, 0x55, 0x66, 0x6d, 0x9a, 0x14, 0x8b, 0x64, 0x5f, 0x52, 0xbb, 0xa6, 0x65, 0x3b,
+	0x3c, 0x58, 0xc6, 0xc6, 0x13, 0x88, 0x6a, 0x22, 0x22, 0x27, 0x22, 0x1a, 0xfc, 0x4d, 0x97, 0x38,
+	0x62, 0x48, 0xed, 0x2f, 0x14, 0x6a, 0x5b, 0xc4, 0x0e, 0x93, 0x4f, 0x58, 0xc4, 0x22, 0x42, 0x17,
+	0x3c, 0x85, 0x2a, 0x09, 0xcf, 0xdf, 0x9a, 0xfe, 0x6d, 0xbd, 0xed, 0x7b, 0xfd, 0x48, 0xf9, 0x93,
+	0xe3, 0xcc, 0xee, 0x62, 0xca, 0xcc, 0xae, 0x2b, 0x02, 0x8a, 0x9f, 0x00, 0x44, 0xff, 0x05, 0xd3,
+	0x5a, 0xf2, 0x4c, 0x87, 0x51, 0x03, 0xaf, 0xf9, 0x98, 0x32, 0x54, 0x81, 0xdf, 0x5a, 0xc1, 0x1f,
+	0xd8, 0xcb, 0x82, 0x02, 0x28, 0x7d, 0x5f, 0xcb, 0xbe, 0x79, 0x39, 0x1b, 0xfa, 0x57, 0x6d, 0xb7,
+	0x3d, 0x4c, 0xe9, 0x32, 0xf3, 0x6c, 0xc7, 0x32, 0xc2, 0xc0, 0x9e, 0x06, 0x67, 0x93, 0xc3, 0x69,
+	0x30, 0x2a, 0xc0, 0xd1, 0x2e, 0xb5, 0x1a, 0x6c, 0xd3, 0xc5, 0x0d, 0xdf, 0xeb, 0x64, 0x47, 0x02,
+	0xa1, 0x01, 0xbb, 0xd4, 0x5a, 0xd9, 0x74, 0xf1, 0xff, 0x5e, 0x07, 0x2d, 0x42, 0xd8, 0x33, 0x3a,
+	0x9b, 0x2a, 0x80, 0x52, 0xa6, 0xf2, 0xb3, 0x26, 0xb3, 0x06, 0x66, 0x69, 0x62, 0x89, 0xa5, 0x65,
+	0xda, 0xbf, 0xa6, 0x85, 0xe5, 0x2c, 0x8c, 0x3e, 0x65, 0x71, 0x17, 0xc0, 0x1f, 0x23, 0x13, 0xa5,
+	0x2e, 0x71, 0x28, 0x46, 0xf3, 0x30, 0xcd, 0x61, 0x68, 0x16, 0x14, 0x46, 0x4a, 0x99, 0xca, 0xa4,
+	0x16, 0xb7, 0x4b, 0x34, 0xae, 0x32, 0x64, 0x28, 0x5a, 0x8a, 0x40, 0x25, 0x39, 0xd4, 0xcc, 0xb9,
+	0x50, 0xa2, 0x62, 0x84, 0xea, 0x11, 0x80, 0xb9, 0x1e, 0x15, 0xf6, 0x2e, 0xbf, 0x0a, 0x8b, 0x31,
+	0x68, 0x5f, 0xe3, 0xd7, 0x73, 0x00, 0x95, 0x38, 0x32, 0x69, 0xdb, 0x9f, 0x27, 0x6c, 0x2b, 0x0d,
+	0xb0, 0xad, 0xea, 0xb3, 0x55, 0xe2, 0xd9, 0x5b, 0x3c, 0xf1, 0xb5, 0x7b, 0x88, 0xcf, 0xf0, 0x10,
+	0x0f, 0xeb, 0x21, 0xbe, 0x2e, 0x0f, 0xf1, 0xcd, 0xf5, 0xf0, 0x49, 0x48, 0xfa, 0xf7, 0x86, 0x6b,
+	0x07, 0x66, 0x44, 0x4d, 0x5c, 0x80, 0xe9, 0x75, 0xdb, 0x69, 0x93, 0x75, 0xee, 0x61, 0xa6, 0x92,
+	0xd3, 0x44, 0x5f, 0xd1, 0xc2, 0xbe, 0xa2, 0xfd, 0x25, 0xfb, 0x4e, 0xed, 0xbb, 0xfd, 0xf7, 0xf9,
+	0xc4, 0xe3, 0xc3, 0x3c, 0x30, 0xa4, 0xe4, 0xca, 0xdc, 0x7c, 0x01, 0xe0, 0x64, 0x2c, 0xe3, 0xcd,
+	0xb3, 0xf3, 0x59, 0x68, 0xe7, 0xb2, 0x8b, 0x9d, 0x76, 0xb5, 0xd3, 0x21, 0xeb, 0xa6, 0xd3, 0xc2,
+	0x37, 0xae, 0xbb, 0x16, 0x1f, 0x8e, 0xc0, 0xc9, 0x58, 0x50, 0xe9, 0x69, 0x07, 0x66, 0x68, 0x30,
+	0xd2, 0xe8, 0xd8, 0x5d, 0x9b, 0x49, 0x63, 0x73, 0x11, 0x4b, 0x42, 0x33, 0xea, 0xc4, 0x76, 0x6a,
+	0x73, 0xc1, 0xea, 0xef, 0x1d, 0xe6, 0x4b, 0x96, 0xcd, 0x56, 0xfd, 0xa6, 0xd6, 0x22, 0x5d, 0x79,
+	0xcd, 0xc9, 0x9f, 0x59, 0xda, 0xbe, 0xa3, 0x07, 0x40, 0x94, 0x0b, 0xa8, 0x01, 0x79, 0xfe, 0x7f,
+	0x82, 0xf4, 0xc8, 0x87, 0x3f, 0xb8, 0xd8, 0xb3, 0x49, 0xbb, 0xd1, 0x32, 0x9d, 0x06, 0x1f, 0xc8,
+	0x26, 0xaf, 0xbe, 0xe4, 0xb8, 0x28, 0x52, 0x37, 0x1d, 0x3e, 0x6b, 0x54, 0x87, 0xa3, 0xb2, 0xac,
+	0x87, 0x29, 0x66, 0xdc, 0xa6, 0x4c, 0x45, 0x39, 0xb5, 0xc7, 0x57, 0xc2, 0xbb, 0xb3, 0x96, 0xda,
+	0x09, 0x36, 0x78, 0x46, 0xa8, 0x8c, 0x40, 0x84, 0xea, 0x70, 0x2c, 0xb8, 0x5b, 0x1b, 0x8c, 0xc8,
+	0x2c, 0xa9, 0xf3, 0x4e, 0x4a, 0x8a, 0x9f, 0x92, 0x4c, 0xa0, 0x5a, 0x21, 0x3c, 0x49, 0xe5, 0x75,
+	0x1a, 0x7e, 0xc3, 0x97, 0x03, 0x3d, 0x00, 0x30, 0x2d, 0xf6, 0x37, 0x3a, 0x63, 0x1f, 0x9f, 0xbe,
+	0xb5, 0x95, 0x5f, 0x86, 0x88, 0x14, 0x0b, 0x5b, 0x9c, 0xbe, 0xff, 0xf6, 0xe3, 0x6e, 0x52, 0x45,
+	0x53, 0x7a, 0xec, 0x47, 0x8b, 0x3c, 0x10, 0x7b, 0x00, 0x8e, 0x45, 0xfa, 0x3f, 0xd2, 0xcf, 0x2b,
+	0x71, 0xe2, 0x0e, 0x53, 0xe6, 0x86, 0x17, 0x48, 0xb4, 0xdf, 0x38, 0xda, 0x1c, 0xd2, 0x06, 0xa1,
+	0xe9, 0xf2, 0x5c, 0xe8, 0xf7, 0xe4, 0xc3, 0x76, 0x1f, 0x2c, 0x1e, 0x1a, 0x16, 0x5f, 0x14, 0x16,
+	0x5f, 0x02, 0x16, 0x87, 0xb0, 0x78, 0x1b, 0x3d, 0x05, 0x70, 0x3c, 0xda, 0xc7, 0xd0, 0xa0, 0xe2,
+	0xb1, 0x6d, 0x59, 0x29, 0x5f, 0x40, 0x21, 0x79, 0x67, 0x39, 0xef, 0x0c, 0xfa, 0x69, 0x20, 0x2f,
+	0x96, 0x62, 0xf4, 0x0a, 0xc0, 0xf1, 0x68, 0x6b, 0x18, 0x88, 0x19, 0xdb, 0xee, 0x94, 0xf2, 0x05,
+	0x14, 0x12, 0xb3, 0xca, 0x31, 0x17, 0xd0, 0xef, 0xf1, 0x98, 0xa2, 0x27, 0x99, 0xa1, 0xac, 0xb7,
+	0x09, 0x7a, 0x0e, 0xd7, 0xfe, 0xd8, 0x3f, 0x52, 0xc1, 0xc1, 0x91, 0x0a, 0x3e, 0x1c, 0xa9, 0x60,
+	0xe7, 0x58, 0x4d, 0x1c, 0x1c, 0xab, 0x89, 0x77, 0xc7, 0x6a, 0xe2, 0xd6, 0xf4, 0xc0, 0x4e, 0xb1,
+	0x21, 0x6a, 0x35, 0xd3, 0xfc, 0xc4, 0xce, 0x7f, 0x1e, 0x00, 0x6a, 0xf1, 0x4d, 0x4f, 0x58, 0x0c,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -445,6 +726,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(ctx context.Context, in *QueryGranteeGrantsRequest, opts ...grpc.CallOption) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` expiring within the
+	// given window after the current block time.
+	ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error)
+	// SpendAllowance returns the coins the grantee can still spend under a grant
+	// which authorization limits spending, and the time its period resets.
+	SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error)
 }
 
 type queryClient struct {
@@ -482,6 +769,24 @@ func (c *queryClient) GranteeGrants(ctx context.Context, in *QueryGranteeGrantsR
 	return out, nil
 }
 
+func (c *queryClient) ExpiringGrants(ctx context.Context, in *QueryExpiringGrantsRequest, opts ...grpc.CallOption) (*QueryExpiringGrantsResponse, error) {
+	out := new(QueryExpiringGrantsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/ExpiringGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SpendAllowance(ctx context.Context, in *QuerySpendAllowanceRequest, opts ...grpc.CallOption) (*QuerySpendAllowanceResponse, error) {
+	out := new(QuerySpendAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Query/SpendAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Returns list of `Authorization`, granted to the grantee by the granter.
@@ -494,6 +799,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	GranteeGrants(context.Context, *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error)
+	// ExpiringGrants returns a list of `GrantAuthorization` expiring within the
+	// given window after the current block time.
+	ExpiringGrants(context.Context, *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error)
+	// SpendAllowance returns the coins the grantee can still spend under a grant
+	// which authorization limits spending, and the time its period resets.
+	SpendAllowance(context.Context, *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -509,6 +820,12 @@ func (*UnimplementedQueryServer) GranterGrants(ctx context.Context, req *QueryGr
 func (*UnimplementedQueryServer) GranteeGrants(ctx context.Context, req *QueryGranteeGrantsRequest) (*QueryGranteeGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GranteeGrants not implemented")
 }
+func (*UnimplementedQueryServer) ExpiringGrants(ctx context.Context, req *QueryExpiringGrantsRequest) (*QueryExpiringGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringGrants not implemented")
+}
+func (*UnimplementedQueryServer) SpendAllowance(ctx context.Context, req *QuerySpendAllowanceRequest) (*QuerySpendAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpendAllowance not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -568,6 +885,42 @@ func _Query_GranteeGrants_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExpiringGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/ExpiringGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringGrants(ctx, req.(*QueryExpiringGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SpendAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpendAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SpendAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Query/SpendAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SpendAllowance(ctx, req.(*QuerySpendAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -584,6 +937,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GranteeGrants",
 			Handler:    _Query_GranteeGrants_Handler,
 		},
+		{
+			MethodName: "ExpiringGrants",
+			Handler:    _Query_ExpiringGrants_Handler,
+		},
+		{
+			MethodName: "SpendAllowance",
+			Handler:    _Query_SpendAllowance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/query.proto",
@@ -876,48 +1237,255 @@ func (m *QueryGranteeGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryExpiringGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGrantsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryExpiringGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGrantsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExpiringGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpendAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpendAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpendAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeToReset != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.TimeToReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TimeToReset):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintQuery(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PeriodReset != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodReset):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Grants) > 0 {
 		for _, e := range m.Grants {
 			l = e.Size()
@@ -1003,13 +1571,517 @@ func (m *QueryGranteeGrantsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryExpiringGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpendAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PeriodReset != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodReset)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TimeToReset != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TimeToReset)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, &Grant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGranterGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGranterGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1032,17 +2104,17 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranterGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranterGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1052,29 +2124,31 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Grants = append(m.Grants, &GrantAuthorization{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1084,27 +2158,81 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1132,9 +2260,9 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1191,7 +2319,7 @@ func (m *QueryGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1214,10 +2342,10 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1249,7 +2377,7 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, &Grant{})
+			m.Grants = append(m.Grants, &GrantAuthorization{})
 			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1311,7 +2439,7 @@ func (m *QueryGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryExpiringGrantsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1334,17 +2462,17 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranterGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpiringGrantsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranterGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpiringGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1354,23 +2482,24 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1429,7 +2558,7 @@ func (m *QueryGranterGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryExpiringGrantsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1452,10 +2581,10 @@ func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranterGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryExpiringGrantsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranterGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryExpiringGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1549,7 +2678,7 @@ func (m *QueryGranterGrantsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1572,15 +2701,15 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteeGrantsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteeGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1608,13 +2737,13 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1624,27 +2753,55 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1667,7 +2824,7 @@ func (m *QueryGranteeGrantsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySpendAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1690,15 +2847,15 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGranteeGrantsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySpendAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGranteeGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySpendAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1725,14 +2882,14 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, &GrantAuthorization{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1759,10 +2916,80 @@ func (m *QueryGranteeGrantsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodReset == nil {
+				m.PeriodReset = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeToReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeToReset == nil {
+				m.TimeToReset = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.TimeToReset, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
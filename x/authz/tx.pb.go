@@ -270,6 +270,84 @@ func (m *MsgRevokeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 
+// MsgRevokeAll revokes all the authorizations granted by the granter, whatever
+// their grantee and sdk.Msg type.
+type MsgRevokeAll struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *MsgRevokeAll) Reset()         { *m = MsgRevokeAll{} }
+func (m *MsgRevokeAll) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAll) ProtoMessage()    {}
+func (*MsgRevokeAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
+}
+func (m *MsgRevokeAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAll.Merge(m, src)
+}
+func (m *MsgRevokeAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAll proto.InternalMessageInfo
+
+// MsgRevokeAllResponse defines the Msg/MsgRevokeAllResponse response type.
+type MsgRevokeAllResponse struct {
+	// revoked is the number of revoked grants.
+	Revoked uint64 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *MsgRevokeAllResponse) Reset()         { *m = MsgRevokeAllResponse{} }
+func (m *MsgRevokeAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllResponse) ProtoMessage()    {}
+func (*MsgRevokeAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{7}
+}
+func (m *MsgRevokeAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
@@ -277,47 +355,52 @@ func init() {
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
+	proto.RegisterType((*MsgRevokeAll)(nil), "cosmos.authz.v1beta1.MsgRevokeAll")
+	proto.RegisterType((*MsgRevokeAllResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeAllResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x6f, 0x12, 0x4f,
-	0x18, 0x66, 0x4a, 0x29, 0x3f, 0xa6, 0x4d, 0x7e, 0x76, 0x4b, 0xe2, 0x76, 0x9b, 0x6e, 0x37, 0x6b,
-	0xab, 0x04, 0xc3, 0x4c, 0xc0, 0x1b, 0xf1, 0x52, 0x92, 0xc6, 0x8b, 0xc4, 0x64, 0xd5, 0x8b, 0x17,
-	0xb2, 0xc0, 0x38, 0x25, 0x65, 0x77, 0xc8, 0xce, 0x42, 0xc0, 0x93, 0xf1, 0xe8, 0xc9, 0x8f, 0xa1,
-	0x37, 0x0e, 0x3d, 0xfa, 0x01, 0x88, 0xa7, 0xc6, 0x83, 0xf1, 0x64, 0x14, 0x0e, 0x7c, 0x0c, 0xcd,
-	0xce, 0x1f, 0xa4, 0x86, 0xd6, 0x9e, 0xbc, 0xc0, 0xbc, 0xef, 0xf3, 0xbc, 0xb3, 0xef, 0xf3, 0x3e,
-	0x6f, 0x06, 0xee, 0xb7, 0x18, 0x0f, 0x18, 0xc7, 0x7e, 0x3f, 0x3e, 0x7d, 0x85, 0x07, 0xe5, 0x26,
-	0x89, 0xfd, 0x32, 0x8e, 0x87, 0xa8, 0x17, 0xb1, 0x98, 0x19, 0x79, 0x09, 0x23, 0x01, 0x23, 0x05,
-	0x5b, 0xbb, 0x32, 0xdb, 0x10, 0x1c, 0xac, 0x28, 0x22, 0xb0, 0xf2, 0x94, 0x51, 0x26, 0xf3, 0xc9,
-	0x49, 0x65, 0x77, 0x29, 0x63, 0xb4, 0x4b, 0xb0, 0x88, 0x9a, 0xfd, 0x97, 0xd8, 0x0f, 0x47, 0x0a,
-	0x72, 0x56, 0x36, 0x20, 0xbf, 0x27, 0x19, 0xb7, 0x15, 0x23, 0xe0, 0x14, 0x0f, 0xca, 0xc9, 0x9f,
-	0x02, 0xb6, 0xfd, 0xa0, 0x13, 0x32, 0x2c, 0x7e, 0x65, 0xca, 0xfd, 0x02, 0xe0, 0x7f, 0x75, 0x4e,
-	0x1f, 0x45, 0x7e, 0x18, 0x1b, 0x15, 0x98, 0xa5, 0xc9, 0x81, 0x44, 0x26, 0x70, 0x40, 0x21, 0x57,
-	0x33, 0x3f, 0x9f, 0x97, 0xb4, 0xa2, 0xe3, 0x76, 0x3b, 0x22, 0x9c, 0x3f, 0x8d, 0xa3, 0x4e, 0x48,
-	0x3d, 0x4d, 0xfc, 0x5d, 0x43, 0xcc, 0xb5, 0x9b, 0xd5, 0x10, 0xe3, 0x21, 0xcc, 0x88, 0xa3, 0x99,
-	0x76, 0x40, 0x61, 0xb3, 0xb2, 0x87, 0x56, 0x0d, 0x0d, 0x89, 0x9e, 0x6a, 0xb9, 0xc9, 0xb7, 0x83,
-	0xd4, 0xfb, 0xf9, 0xb8, 0x08, 0x3c, 0x59, 0x54, 0x3d, 0x7c, 0x33, 0x1f, 0x17, 0xf5, 0xf7, 0xdf,
-	0xce, 0xc7, 0xc5, 0x1d, 0x59, 0x5e, 0xe2, 0xed, 0x33, 0xac, 0xb5, 0xb8, 0xf7, 0xe1, 0xff, 0x75,
-	0x4e, 0x4f, 0x86, 0xa4, 0xe5, 0x11, 0xde, 0x63, 0x21, 0x27, 0x86, 0x09, 0xb3, 0x11, 0xe1, 0xfd,
-	0x6e, 0xcc, 0x4d, 0xe0, 0xa4, 0x0b, 0x5b, 0x9e, 0x0e, 0xdd, 0x0f, 0x00, 0x66, 0x15, 0x7b, 0x59,
-	0x10, 0xb8, 0xa9, 0xa0, 0x13, 0xb8, 0x1e, 0x70, 0xca, 0xcd, 0x35, 0x27, 0x5d, 0xd8, 0xac, 0xe4,
-	0x91, 0x74, 0x0f, 0x69, 0xf7, 0xd0, 0x71, 0x38, 0xaa, 0xed, 0x7d, 0x3a, 0x2f, 0x29, 0x67, 0x50,
-	0xd3, 0xe7, 0x64, 0xa1, 0xb3, 0xce, 0xa9, 0x27, 0xca, 0xab, 0x77, 0x96, 0x94, 0x91, 0x44, 0x99,
-	0x71, 0x59, 0x59, 0xd2, 0x9f, 0x6b, 0xc0, 0x5b, 0x5a, 0xa4, 0x56, 0xe6, 0x7e, 0x04, 0x30, 0x97,
-	0x5c, 0x43, 0x06, 0xec, 0x8c, 0xfc, 0x33, 0x1b, 0x1d, 0xb8, 0x15, 0x70, 0xda, 0x88, 0x47, 0x3d,
-	0xd2, 0xe8, 0x47, 0x5d, 0xe1, 0x66, 0xce, 0x83, 0x01, 0xa7, 0xcf, 0x46, 0x3d, 0xf2, 0x3c, 0xea,
-	0x56, 0x8f, 0xfe, 0xb4, 0x2a, 0x7f, 0x59, 0x90, 0x6c, 0xd8, 0xdd, 0x81, 0xdb, 0x8b, 0x40, 0x6b,
-	0xaa, 0xfc, 0x04, 0x30, 0x5d, 0xe7, 0xd4, 0x78, 0x02, 0x33, 0x72, 0x3b, 0xed, 0xd5, 0x6b, 0xa2,
-	0x87, 0x61, 0xdd, 0xbd, 0x1e, 0x5f, 0xac, 0xc1, 0x63, 0xb8, 0x2e, 0x8c, 0xde, 0xbf, 0x92, 0x9f,
-	0xc0, 0xd6, 0xd1, 0xb5, 0xf0, 0xe2, 0x36, 0x0f, 0x6e, 0xa8, 0xb1, 0x1f, 0x5c, 0x59, 0x20, 0x09,
-	0xd6, 0xbd, 0xbf, 0x10, 0xf4, 0x9d, 0x56, 0xe6, 0x75, 0xb2, 0xef, 0xb5, 0xda, 0xe4, 0x87, 0x9d,
-	0x9a, 0x4c, 0x6d, 0x70, 0x31, 0xb5, 0xc1, 0xf7, 0xa9, 0x0d, 0xde, 0xcd, 0xec, 0xd4, 0xc5, 0xcc,
-	0x4e, 0x7d, 0x9d, 0xd9, 0xa9, 0x17, 0x87, 0xb4, 0x13, 0x9f, 0xf6, 0x9b, 0xa8, 0xc5, 0x02, 0xf5,
-	0xa2, 0xe0, 0xa5, 0xe1, 0x0e, 0xe5, 0x8b, 0xd0, 0xdc, 0x10, 0x3b, 0xf8, 0xe0, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xbb, 0x9d, 0x9d, 0x9e, 0xb7, 0x04, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0x66, 0x4a, 0x29, 0x32, 0x25, 0xd1, 0x6e, 0x49, 0xba, 0xdd, 0xa6, 0xdb, 0xcd, 0xda, 0x2a,
+	0xc1, 0xb0, 0x2b, 0x78, 0x23, 0x5e, 0x20, 0x69, 0xbc, 0x48, 0x4c, 0x56, 0xbd, 0xe8, 0x81, 0x2c,
+	0x30, 0x4e, 0x49, 0x77, 0x77, 0xc8, 0xce, 0x42, 0xc0, 0x93, 0xf1, 0xe8, 0xc9, 0x9f, 0xa1, 0x37,
+	0x0e, 0x3d, 0xfa, 0x03, 0x88, 0xa7, 0xc6, 0x83, 0xf1, 0x60, 0x8c, 0xc2, 0x81, 0xbf, 0x61, 0x76,
+	0x66, 0x67, 0x0b, 0x0d, 0x2d, 0x8d, 0x07, 0x2f, 0x30, 0xef, 0x7d, 0xdf, 0x9b, 0x37, 0xdf, 0x7e,
+	0x6f, 0x06, 0xee, 0xb7, 0x08, 0x75, 0x09, 0x35, 0xed, 0x5e, 0x70, 0xf2, 0xd6, 0xec, 0x97, 0x9a,
+	0x28, 0xb0, 0x4b, 0x66, 0x30, 0x30, 0xba, 0x3e, 0x09, 0x88, 0x94, 0xe3, 0xb0, 0xc1, 0x60, 0x23,
+	0x82, 0x95, 0x5d, 0x9e, 0x6d, 0x30, 0x8e, 0x19, 0x51, 0x58, 0xa0, 0xe4, 0x30, 0xc1, 0x84, 0xe7,
+	0xc3, 0x55, 0x94, 0xdd, 0xc5, 0x84, 0x60, 0x07, 0x99, 0x2c, 0x6a, 0xf6, 0xde, 0x98, 0xb6, 0x37,
+	0x8c, 0x20, 0x6d, 0xe9, 0x01, 0x78, 0x3f, 0xce, 0xd8, 0x89, 0x18, 0x2e, 0xc5, 0x66, 0xbf, 0x14,
+	0xfe, 0x45, 0xc0, 0x96, 0xed, 0x76, 0x3c, 0x62, 0xb2, 0x5f, 0x9e, 0xd2, 0xbf, 0x03, 0x78, 0xab,
+	0x4e, 0xf1, 0x13, 0xdf, 0xf6, 0x02, 0xa9, 0x0c, 0xd3, 0x38, 0x5c, 0x20, 0x5f, 0x06, 0x1a, 0xc8,
+	0x67, 0x6a, 0xf2, 0xb7, 0xb3, 0xa2, 0x50, 0x54, 0x6d, 0xb7, 0x7d, 0x44, 0xe9, 0xf3, 0xc0, 0xef,
+	0x78, 0xd8, 0x12, 0xc4, 0x8b, 0x1a, 0x24, 0xaf, 0xdd, 0xac, 0x06, 0x49, 0x8f, 0x61, 0x8a, 0x2d,
+	0xe5, 0xa4, 0x06, 0xf2, 0x9b, 0xe5, 0x3d, 0x63, 0xd9, 0x47, 0x33, 0xd8, 0x99, 0x6a, 0x99, 0xf1,
+	0xaf, 0x83, 0xc4, 0xa7, 0xd9, 0xa8, 0x00, 0x2c, 0x5e, 0x54, 0x39, 0x7c, 0x3f, 0x1b, 0x15, 0x44,
+	0xff, 0x0f, 0xb3, 0x51, 0x61, 0x9b, 0x97, 0x17, 0x69, 0xfb, 0xd4, 0x14, 0x5a, 0xf4, 0x07, 0xf0,
+	0x76, 0x9d, 0xe2, 0xe3, 0x01, 0x6a, 0x59, 0x88, 0x76, 0x89, 0x47, 0x91, 0x24, 0xc3, 0xb4, 0x8f,
+	0x68, 0xcf, 0x09, 0xa8, 0x0c, 0xb4, 0x64, 0x3e, 0x6b, 0x89, 0x50, 0xff, 0x0c, 0x60, 0x3a, 0x62,
+	0xcf, 0x0b, 0x02, 0x37, 0x15, 0x74, 0x0c, 0xd7, 0x5d, 0x8a, 0xa9, 0xbc, 0xa6, 0x25, 0xf3, 0x9b,
+	0xe5, 0x9c, 0xc1, 0xdd, 0x33, 0x84, 0x7b, 0x46, 0xd5, 0x1b, 0xd6, 0xf6, 0xbe, 0x9e, 0x15, 0x23,
+	0x67, 0x8c, 0xa6, 0x4d, 0x51, 0xac, 0xb3, 0x4e, 0xb1, 0xc5, 0xca, 0x2b, 0x77, 0xe7, 0x94, 0xa1,
+	0x50, 0x99, 0xb4, 0xa8, 0x2c, 0x3c, 0x9f, 0x2e, 0xc1, 0x3b, 0x42, 0xa4, 0x50, 0xa6, 0x7f, 0x01,
+	0x30, 0x13, 0x6e, 0x83, 0xfa, 0xe4, 0x14, 0xfd, 0x37, 0x1b, 0x35, 0x98, 0x75, 0x29, 0x6e, 0x04,
+	0xc3, 0x2e, 0x6a, 0xf4, 0x7c, 0x87, 0xb9, 0x99, 0xb1, 0xa0, 0x4b, 0xf1, 0x8b, 0x61, 0x17, 0xbd,
+	0xf4, 0x9d, 0xca, 0xd1, 0x65, 0xab, 0x72, 0x8b, 0x82, 0xf8, 0x81, 0xf5, 0x6d, 0xb8, 0x15, 0x07,
+	0xb1, 0x26, 0x07, 0x66, 0xe3, 0x64, 0xd5, 0x71, 0xfe, 0x45, 0x55, 0x25, 0x7f, 0xb9, 0xff, 0xce,
+	0xb2, 0xfe, 0x55, 0xc7, 0xd1, 0x1f, 0xc2, 0xdc, 0x7c, 0xbc, 0x38, 0x33, 0x61, 0xb2, 0xcd, 0xba,
+	0xae, 0x5b, 0x22, 0x2c, 0xff, 0x5c, 0x83, 0xc9, 0x3a, 0xc5, 0xd2, 0x33, 0x98, 0xe2, 0xb7, 0x47,
+	0x5d, 0x3e, 0xc6, 0xc2, 0x2c, 0xe5, 0xde, 0xf5, 0x78, 0xdc, 0xf2, 0x29, 0x5c, 0x67, 0x83, 0xb8,
+	0x7f, 0x25, 0x3f, 0x84, 0x95, 0xa3, 0x6b, 0xe1, 0x78, 0x37, 0x0b, 0x6e, 0x44, 0x63, 0x71, 0x70,
+	0x65, 0x01, 0x27, 0x28, 0xf7, 0x57, 0x10, 0xe2, 0x3d, 0x5f, 0xc3, 0xcc, 0x85, 0x2f, 0xfa, 0x8a,
+	0xaa, 0xaa, 0xe3, 0x28, 0x85, 0xd5, 0x1c, 0xb1, 0xb9, 0x92, 0x7a, 0x17, 0x5e, 0xf6, 0x5a, 0x6d,
+	0xfc, 0x47, 0x4d, 0x8c, 0x27, 0x2a, 0x38, 0x9f, 0xa8, 0xe0, 0xf7, 0x44, 0x05, 0x1f, 0xa7, 0x6a,
+	0xe2, 0x7c, 0xaa, 0x26, 0x7e, 0x4c, 0xd5, 0xc4, 0xab, 0x43, 0xdc, 0x09, 0x4e, 0x7a, 0x4d, 0xa3,
+	0x45, 0xdc, 0xe8, 0x39, 0x35, 0xe7, 0x9c, 0x1d, 0xf0, 0xe7, 0xb0, 0xb9, 0xc1, 0x2e, 0xe0, 0xa3,
+	0xbf, 0x03, 0x00, 0x92, 0xd4, 0x48, 0x33, 0xb4, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -344,6 +427,8 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter.
+	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
 }
 
 type msgClient struct {
@@ -381,6 +466,15 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error) {
+	out := new(MsgRevokeAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -395,6 +489,8 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all the authorizations granted by the granter.
+	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -410,6 +506,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (*UnimplementedMsgServer) RevokeAll(ctx context.Context, req *MsgRevokeAll) (*MsgRevokeAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAll not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -469,6 +568,24 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAll(ctx, req.(*MsgRevokeAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -485,6 +602,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _Msg_RevokeAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -703,6 +824,64 @@ func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevokeAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Revoked))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -806,6 +985,31 @@ func (m *MsgRevokeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevokeAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		n += 1 + sovTx(uint64(m.Revoked))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1403,6 +1607,157 @@ func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			m.Revoked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revoked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
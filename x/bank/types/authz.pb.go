@@ -11,15 +11,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -87,36 +92,227 @@ func (m *SendAuthorization) GetAllowList() []string {
 	return nil
 }
 
+// PeriodicSendAuthorization allows the grantee to spend up to period_spend_limit
+// coins from the granter's account in every period, optionally capped by a total
+// spend_limit and by a limit on the coins sent to a single recipient per period.
+type PeriodicSendAuthorization struct {
+	// spend_limit specifies the maximum amount of coins that can be spent in
+	// total, and is updated as coins are spent. If empty, there is no total cap.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period specifies the time duration in which period_spend_limit coins can
+	// be spent before that allowance is reset.
+	Period time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+	// period_spend_limit specifies the maximum number of coins that can be spent
+	// in the period.
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// period_can_spend is the number of coins left to be spent before the period_reset time.
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// period_reset is the time at which this period resets and a new one begins.
+	// If left unset, the first period starts with the first send.
+	PeriodReset time.Time `protobuf:"bytes,5,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+	// allow_list specifies an optional list of addresses to whom the grantee can send tokens on behalf of the
+	// granter. If omitted, any recipient is allowed.
+	AllowList []string `protobuf:"bytes,6,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+	// recipient_spend_limit specifies the maximum amount of coins that can be
+	// sent to a single recipient in the period. If empty, there is no
+	// per-recipient limit.
+	RecipientSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=recipient_spend_limit,json=recipientSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recipient_spend_limit"`
+	// recipient_spent tracks the coins sent to each recipient in the current
+	// period, when recipient_spend_limit is set.
+	RecipientSpent []RecipientSpent `protobuf:"bytes,8,rep,name=recipient_spent,json=recipientSpent,proto3" json:"recipient_spent"`
+}
+
+func (m *PeriodicSendAuthorization) Reset()         { *m = PeriodicSendAuthorization{} }
+func (m *PeriodicSendAuthorization) String() string { return proto.CompactTextString(m) }
+func (*PeriodicSendAuthorization) ProtoMessage()    {}
+func (*PeriodicSendAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4d2a37888ea779f, []int{1}
+}
+func (m *PeriodicSendAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicSendAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicSendAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicSendAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicSendAuthorization.Merge(m, src)
+}
+func (m *PeriodicSendAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicSendAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicSendAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicSendAuthorization proto.InternalMessageInfo
+
+func (m *PeriodicSendAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicSendAuthorization) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicSendAuthorization) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicSendAuthorization) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *PeriodicSendAuthorization) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
+func (m *PeriodicSendAuthorization) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func (m *PeriodicSendAuthorization) GetRecipientSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecipientSpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicSendAuthorization) GetRecipientSpent() []RecipientSpent {
+	if m != nil {
+		return m.RecipientSpent
+	}
+	return nil
+}
+
+// RecipientSpent is the amount of coins sent to a recipient in the current
+// period of a PeriodicSendAuthorization.
+type RecipientSpent struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Spent   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *RecipientSpent) Reset()         { *m = RecipientSpent{} }
+func (m *RecipientSpent) String() string { return proto.CompactTextString(m) }
+func (*RecipientSpent) ProtoMessage()    {}
+func (*RecipientSpent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4d2a37888ea779f, []int{2}
+}
+func (m *RecipientSpent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipientSpent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipientSpent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecipientSpent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientSpent.Merge(m, src)
+}
+func (m *RecipientSpent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipientSpent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientSpent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipientSpent proto.InternalMessageInfo
+
+func (m *RecipientSpent) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RecipientSpent) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SendAuthorization)(nil), "cosmos.bank.v1beta1.SendAuthorization")
+	proto.RegisterType((*PeriodicSendAuthorization)(nil), "cosmos.bank.v1beta1.PeriodicSendAuthorization")
+	proto.RegisterType((*RecipientSpent)(nil), "cosmos.bank.v1beta1.RecipientSpent")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/authz.proto", fileDescriptor_a4d2a37888ea779f) }
 
 var fileDescriptor_a4d2a37888ea779f = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0x75, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0x84, 0xe8,
-	0x8e, 0x87, 0x48, 0x40, 0x8d, 0x82, 0x48, 0xc9, 0xc1, 0x6d, 0x2e, 0x4e, 0x85, 0xdb, 0x9c, 0x9c,
-	0x9f, 0x99, 0x07, 0x91, 0x57, 0xea, 0x60, 0xe2, 0x12, 0x0c, 0x4e, 0xcd, 0x4b, 0x71, 0x2c, 0x2d,
-	0xc9, 0xc8, 0x2f, 0xca, 0xac, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0x13, 0x2a, 0xe4, 0xe2, 0x2e, 0x2e,
-	0x48, 0xcd, 0x4b, 0x89, 0xcf, 0xc9, 0xcc, 0xcd, 0x2c, 0x91, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36,
-	0x92, 0xd4, 0x83, 0x3b, 0xb2, 0x38, 0x15, 0xe6, 0x48, 0x3d, 0xe7, 0xfc, 0xcc, 0x3c, 0x27, 0xd3,
-	0x13, 0xf7, 0xe4, 0x19, 0x56, 0xdd, 0x97, 0xd7, 0x48, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0x85, 0x3a, 0x03, 0x4a, 0xe9, 0x16, 0xa7, 0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16,
-	0x83, 0x35, 0x14, 0xaf, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x88, 0x0b, 0x6c, 0x89, 0x0f, 0xc8, 0x0e,
-	0x21, 0x73, 0x2e, 0xae, 0xc4, 0x9c, 0x9c, 0xfc, 0xf2, 0xf8, 0x9c, 0xcc, 0xe2, 0x12, 0x09, 0x26,
-	0x05, 0x66, 0x0d, 0x4e, 0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x96, 0x3a, 0xa6, 0xa4, 0x14,
-	0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x71, 0x82, 0xd5, 0xfa, 0x64, 0x16,
-	0x97, 0x58, 0xb9, 0x9f, 0xda, 0xa2, 0xab, 0x04, 0x55, 0x04, 0x09, 0x52, 0x98, 0xd3, 0x50, 0xfc,
-	0xd4, 0xf5, 0x7c, 0x83, 0x96, 0x0c, 0x92, 0x63, 0x30, 0x3c, 0xed, 0xe4, 0x7c, 0xe2, 0x91, 0x1c,
-	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0x78, 0xbd, 0x55, 0x01, 0x89, 0x56, 0xb0, 0xef,
-	0x92, 0xd8, 0xc0, 0xc1, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x78, 0xc2, 0x7d, 0xd9, 0xf2,
-	0x01, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0x8f, 0x93, 0x36, 0xa5, 0x17, 0x08, 0xd4, 0x2d, 0x92, 0x13, 0x21, 0x27, 0x0a, 0x0c, 0xa1,
+	0x52, 0x6c, 0x5a, 0x84, 0x90, 0x3a, 0xd1, 0x04, 0x89, 0x25, 0x12, 0xc8, 0x61, 0x62, 0x89, 0x1c,
+	0xfb, 0xea, 0x9c, 0x6a, 0xdf, 0x19, 0xdf, 0x85, 0x3f, 0x19, 0x60, 0x45, 0x4c, 0x1d, 0xf9, 0x08,
+	0x08, 0x31, 0x74, 0xe8, 0x87, 0xa8, 0x98, 0x2a, 0x26, 0x26, 0x8a, 0x92, 0xa1, 0x5f, 0x80, 0x0f,
+	0x80, 0x7c, 0x77, 0x0e, 0x71, 0x03, 0x55, 0x2b, 0x35, 0x4b, 0x72, 0xf1, 0xfb, 0xbd, 0xf7, 0xfb,
+	0xbd, 0xdf, 0x7b, 0x17, 0x83, 0x8a, 0x43, 0x68, 0x40, 0xa8, 0xd9, 0xb3, 0xf1, 0xae, 0xf9, 0x6a,
+	0xa3, 0x07, 0x99, 0xbd, 0x61, 0xda, 0x03, 0xd6, 0x1f, 0x1a, 0x61, 0x44, 0x18, 0x51, 0x57, 0x05,
+	0xc0, 0x88, 0x01, 0x86, 0x04, 0x94, 0x57, 0xec, 0x00, 0x61, 0x62, 0xf2, 0x4f, 0x81, 0x2b, 0xaf,
+	0x79, 0xc4, 0x23, 0xfc, 0x68, 0xc6, 0x27, 0xf9, 0xb4, 0x24, 0xb2, 0xbb, 0x22, 0x20, 0x4b, 0x89,
+	0x90, 0x3e, 0x61, 0xa6, 0x70, 0xc2, 0xec, 0x10, 0x84, 0x93, 0xb8, 0x47, 0x88, 0xe7, 0x43, 0x93,
+	0xff, 0xea, 0x0d, 0x76, 0x4c, 0x77, 0x10, 0xd9, 0x0c, 0x91, 0x24, 0x5e, 0x39, 0x1d, 0x67, 0x28,
+	0x80, 0x94, 0xd9, 0x41, 0x28, 0x00, 0xb5, 0x0f, 0x59, 0xb0, 0xd2, 0x81, 0xd8, 0xdd, 0x1e, 0xb0,
+	0x3e, 0x89, 0xd0, 0x90, 0x27, 0xab, 0x2f, 0x41, 0x81, 0x86, 0x10, 0xbb, 0x5d, 0x1f, 0x05, 0x88,
+	0x69, 0x4a, 0x35, 0x57, 0x2f, 0x6c, 0x96, 0x8c, 0x49, 0x97, 0x14, 0x26, 0x5d, 0x1a, 0x2d, 0x82,
+	0x70, 0xf3, 0xc1, 0xe1, 0xcf, 0x4a, 0xe6, 0xcb, 0x71, 0xa5, 0xee, 0x21, 0xd6, 0x1f, 0xf4, 0x0c,
+	0x87, 0x04, 0xb2, 0x0f, 0xf9, 0xd5, 0xa0, 0xee, 0xae, 0xc9, 0xde, 0x86, 0x90, 0xf2, 0x04, 0xfa,
+	0xf9, 0x64, 0x7f, 0x5d, 0xb1, 0x00, 0x27, 0x69, 0xc7, 0x1c, 0xea, 0x43, 0x00, 0x6c, 0xdf, 0x27,
+	0xaf, 0xbb, 0x3e, 0xa2, 0x4c, 0xcb, 0x56, 0x73, 0xf5, 0xe5, 0xa6, 0xf6, 0xfd, 0xa0, 0xb1, 0x26,
+	0x49, 0xb7, 0x5d, 0x37, 0x82, 0x94, 0x76, 0x58, 0x84, 0xb0, 0x67, 0x2d, 0x73, 0x6c, 0x1b, 0x51,
+	0xb6, 0xf5, 0xe4, 0xdb, 0x41, 0xa3, 0x26, 0x41, 0x62, 0x26, 0x89, 0xb4, 0x54, 0x4f, 0x1f, 0x4f,
+	0xf6, 0xd7, 0x6f, 0x4d, 0x89, 0x99, 0x69, 0xba, 0xf6, 0x3b, 0x0f, 0x4a, 0xcf, 0x60, 0x84, 0x88,
+	0x8b, 0x9c, 0x59, 0x4b, 0xfc, 0x0b, 0x5a, 0x72, 0xef, 0xa2, 0x96, 0xa4, 0xdc, 0x78, 0x04, 0xf2,
+	0x21, 0x97, 0xa2, 0x65, 0xab, 0x0a, 0x27, 0x12, 0x83, 0x34, 0x92, 0x41, 0x1a, 0x8f, 0xe5, 0xa0,
+	0x9b, 0xd7, 0x62, 0xa2, 0x4f, 0xc7, 0x15, 0x45, 0x78, 0x2a, 0xf3, 0xd4, 0x77, 0x40, 0x15, 0xa7,
+	0xee, 0xb4, 0xec, 0xdc, 0x9c, 0x26, 0x79, 0x43, 0x70, 0x75, 0xfe, 0x76, 0x30, 0x04, 0xf2, 0x59,
+	0xd7, 0xb1, 0xb1, 0xd0, 0xa0, 0x2d, 0xcc, 0x89, 0xbd, 0x28, 0x98, 0x5a, 0x36, 0xe6, 0x02, 0xd4,
+	0x36, 0xb8, 0x2a, 0xb9, 0x23, 0x48, 0x21, 0xd3, 0x16, 0xb9, 0x87, 0xe5, 0x19, 0x0f, 0x9f, 0x27,
+	0x97, 0x41, 0x98, 0xb8, 0x37, 0x31, 0xb1, 0x20, 0xd2, 0xad, 0x38, 0xfb, 0xd4, 0x66, 0xe6, 0xcf,
+	0xbd, 0x99, 0xea, 0x7b, 0x70, 0x33, 0x82, 0x0e, 0x0a, 0x11, 0xc4, 0x2c, 0x35, 0x85, 0xa5, 0xcb,
+	0x5f, 0x9e, 0xd5, 0x09, 0xd3, 0xd4, 0x0c, 0x2c, 0x70, 0x3d, 0x2d, 0x80, 0x69, 0x57, 0x38, 0xf5,
+	0x6d, 0xe3, 0x1f, 0x7f, 0x58, 0x86, 0x35, 0x5d, 0x82, 0x35, 0x17, 0x62, 0x11, 0x56, 0x31, 0x55,
+	0x98, 0x6d, 0x3d, 0x3d, 0xff, 0x75, 0xbb, 0x33, 0xa5, 0xf5, 0xbf, 0x17, 0xab, 0xf6, 0x55, 0x01,
+	0xc5, 0x34, 0xb3, 0xba, 0x09, 0x96, 0x6c, 0x61, 0xaa, 0xa6, 0x54, 0x95, 0x33, 0xed, 0x4e, 0x80,
+	0xea, 0x0e, 0x58, 0x14, 0x1d, 0x66, 0xe7, 0xb4, 0x64, 0xa2, 0x7c, 0xb3, 0x75, 0x38, 0xd2, 0x95,
+	0xa3, 0x91, 0xae, 0xfc, 0x1a, 0xe9, 0xca, 0xde, 0x58, 0xcf, 0x1c, 0x8d, 0xf5, 0xcc, 0x8f, 0xb1,
+	0x9e, 0x79, 0x71, 0xf7, 0xcc, 0x7a, 0x6f, 0xc4, 0xdb, 0x83, 0x97, 0xed, 0xe5, 0xf9, 0x0a, 0xde,
+	0xff, 0x33, 0x00, 0x86, 0x6c, 0xee, 0x8a, 0x59, 0x06, 0x00, 0x00,
 }
 
 func (m *SendAuthorization) Marshal() (dAtA []byte, err error) {
@@ -165,6 +361,168 @@ func (m *SendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PeriodicSendAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicSendAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicSendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecipientSpent) > 0 {
+		for iNdEx := len(m.RecipientSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RecipientSpendLimit) > 0 {
+		for iNdEx := len(m.RecipientSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipientSpent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientSpent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientSpent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -197,39 +555,107 @@ func (m *SendAuthorization) Size() (n int) {
 	return n
 }
 
-func sovAuthz(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuthz(x uint64) (n int) {
-	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *SendAuthorization) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuthz
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+func (m *PeriodicSendAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
 		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SendAuthorization: wiretype end group for non-group")
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.RecipientSpendLimit) > 0 {
+		for _, e := range m.RecipientSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.RecipientSpent) > 0 {
+		for _, e := range m.RecipientSpent {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecipientSpent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SendAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -319,6 +745,440 @@ func (m *SendAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PeriodicSendAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicSendAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicSendAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientSpendLimit = append(m.RecipientSpendLimit, types.Coin{})
+			if err := m.RecipientSpendLimit[len(m.RecipientSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientSpent = append(m.RecipientSpent, RecipientSpent{})
+			if err := m.RecipientSpent[len(m.RecipientSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipientSpent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipientSpent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipientSpent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthz(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
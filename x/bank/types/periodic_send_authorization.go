@@ -0,0 +1,197 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var (
+	_ authz.Authorization           = &PeriodicSendAuthorization{}
+	_ authz.SpendLimitAuthorization = &PeriodicSendAuthorization{}
+)
+
+// NewPeriodicSendAuthorization creates a new PeriodicSendAuthorization object.
+// The first period starts with the first send.
+func NewPeriodicSendAuthorization(spendLimit sdk.Coins, period time.Duration, periodSpendLimit, recipientSpendLimit sdk.Coins, allowed []sdk.AccAddress) *PeriodicSendAuthorization {
+	return &PeriodicSendAuthorization{
+		SpendLimit:          spendLimit,
+		Period:              period,
+		PeriodSpendLimit:    periodSpendLimit,
+		AllowList:           toBech32Addresses(allowed),
+		RecipientSpendLimit: recipientSpendLimit,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PeriodicSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept.
+func (a PeriodicSendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	toAddr := mSend.ToAddress
+	if len(a.AllowList) > 0 {
+		isAddrExists := false
+		for _, addr := range a.AllowList {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "periodic send authorization")
+			if addr == toAddr {
+				isAddrExists = true
+				break
+			}
+		}
+
+		if !isAddrExists {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s address", toAddr)
+		}
+	}
+
+	a.tryResetPeriod(ctx.BlockTime())
+
+	var isNegative bool
+	a.PeriodCanSpend, isNegative = a.PeriodCanSpend.SafeSub(mSend.Amount...)
+	if isNegative {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than period spend limit")
+	}
+
+	if len(a.RecipientSpendLimit) > 0 {
+		recipientSpent := make([]RecipientSpent, len(a.RecipientSpent), len(a.RecipientSpent)+1)
+		copy(recipientSpent, a.RecipientSpent)
+
+		idx := -1
+		for i, spent := range recipientSpent {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "periodic send authorization")
+			if spent.Address == toAddr {
+				idx = i
+				break
+			}
+		}
+
+		if idx < 0 {
+			recipientSpent = append(recipientSpent, RecipientSpent{Address: toAddr})
+			idx = len(recipientSpent) - 1
+		}
+
+		spent := recipientSpent[idx].Spent.Add(mSend.Amount...)
+		if !spent.IsAllLTE(a.RecipientSpendLimit) {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than recipient spend limit for %s", toAddr)
+		}
+
+		recipientSpent[idx].Spent = spent
+		a.RecipientSpent = recipientSpent
+	}
+
+	if len(a.SpendLimit) > 0 {
+		a.SpendLimit, isNegative = a.SpendLimit.SafeSub(mSend.Amount...)
+		if isNegative {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+		}
+
+		if a.SpendLimit.IsZero() {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &a}, nil
+}
+
+// SpendAllowance implements authz.SpendLimitAuthorization.SpendAllowance.
+func (a PeriodicSendAuthorization) SpendAllowance(blockTime time.Time) (sdk.Coins, sdk.Coins, time.Time) {
+	a.tryResetPeriod(blockTime)
+	return a.SpendLimit, a.PeriodCanSpend, a.PeriodReset
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PeriodicSendAuthorization) ValidateBasic() error {
+	if len(a.SpendLimit) > 0 {
+		if err := a.SpendLimit.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("spend limit is invalid: %s", err)
+		}
+	}
+
+	if a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+
+	if len(a.PeriodSpendLimit) == 0 {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit cannot be nil")
+	}
+	if err := a.PeriodSpendLimit.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit is invalid: %s", err)
+	}
+
+	// We allow an empty PeriodCanSpend
+	if err := a.PeriodCanSpend.Validate(); err != nil {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend is invalid: %s", err)
+	}
+	if !a.PeriodCanSpend.IsAllLTE(a.PeriodSpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("period can spend must not exceed period spend limit")
+	}
+
+	// ensure PeriodSpendLimit can be subtracted from total (same coin types)
+	if len(a.SpendLimit) > 0 && !a.PeriodSpendLimit.DenomsSubsetOf(a.SpendLimit) {
+		return sdkerrors.ErrInvalidCoins.Wrap("period spend limit has different currency than spend limit")
+	}
+
+	if len(a.RecipientSpendLimit) > 0 {
+		if err := a.RecipientSpendLimit.Validate(); err != nil {
+			return sdkerrors.ErrInvalidCoins.Wrapf("recipient spend limit is invalid: %s", err)
+		}
+	}
+
+	found := make(map[string]bool, 0)
+	for i := 0; i < len(a.AllowList); i++ {
+		if found[a.AllowList[i]] {
+			return ErrDuplicateEntry
+		}
+
+		found[a.AllowList[i]] = true
+	}
+
+	found = make(map[string]bool, 0)
+	for _, spent := range a.RecipientSpent {
+		if found[spent.Address] {
+			return ErrDuplicateEntry.Wrapf("recipient %s", spent.Address)
+		}
+
+		found[spent.Address] = true
+	}
+
+	return nil
+}
+
+// tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
+// If we hit the reset period, it will top up the PeriodCanSpend amount to
+// min(PeriodSpendLimit, SpendLimit), per denom, so it is never more than the maximum allowed.
+// It also clears the coins spent by recipient in the previous period.
+// It will also update the PeriodReset. If we are within one Period, it will update from the
+// last PeriodReset (eg. if you always do one tx per day, it will always reset the same time)
+// If we are more than one period out (eg. no activity in a week), reset is one Period from the execution of this method
+func (a *PeriodicSendAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	// set PeriodCanSpend to the lesser of SpendLimit and PeriodSpendLimit, per
+	// denom, an empty SpendLimit being unlimited
+	if len(a.SpendLimit) > 0 {
+		a.PeriodCanSpend = a.PeriodSpendLimit.Min(a.SpendLimit)
+	} else {
+		a.PeriodCanSpend = a.PeriodSpendLimit
+	}
+	a.RecipientSpent = nil
+
+	// If we are within the period, step from expiration (eg. if you always do one tx per day, it will always reset the same time)
+	// If we are more then one period out (eg. no activity in a week), reset is one period from this time
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
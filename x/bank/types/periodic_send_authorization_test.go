@@ -0,0 +1,177 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+var (
+	coins100  = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	coins40   = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(40)))
+	coins60   = sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(60)))
+	otherAddr = sdk.AccAddress("______other________")
+)
+
+func TestPeriodicSendAuthorizationValidateBasic(t *testing.T) {
+	atoms := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(100)))
+
+	testCases := []struct {
+		name   string
+		auth   *types.PeriodicSendAuthorization
+		expErr string
+	}{
+		{"valid", types.NewPeriodicSendAuthorization(coins1000, time.Hour, coins100, coins60, []sdk.AccAddress{toAddr}), ""},
+		{"valid without total and recipient limits", types.NewPeriodicSendAuthorization(nil, time.Hour, coins100, nil, nil), ""},
+		{"zero period", types.NewPeriodicSendAuthorization(nil, 0, coins100, nil, nil), "period must be positive"},
+		{"empty period limit", types.NewPeriodicSendAuthorization(coins1000, time.Hour, nil, nil, nil), "period spend limit cannot be nil"},
+		{"period limit currency not in spend limit", types.NewPeriodicSendAuthorization(coins1000, time.Hour, atoms, nil, nil), "different currency"},
+		{"invalid spend limit", types.NewPeriodicSendAuthorization(sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}, time.Hour, coins100, nil, nil), "spend limit is invalid"},
+		{"invalid recipient limit", types.NewPeriodicSendAuthorization(nil, time.Hour, coins100, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}, nil), "recipient spend limit is invalid"},
+		{"duplicate allow list", types.NewPeriodicSendAuthorization(nil, time.Hour, coins100, nil, []sdk.AccAddress{toAddr, toAddr}), "duplicate entry"},
+		{
+			"period can spend above period limit",
+			&types.PeriodicSendAuthorization{Period: time.Hour, PeriodSpendLimit: coins100, PeriodCanSpend: coins500},
+			"must not exceed period spend limit",
+		},
+		{
+			"duplicate recipient spent",
+			&types.PeriodicSendAuthorization{
+				Period:              time.Hour,
+				PeriodSpendLimit:    coins100,
+				RecipientSpendLimit: coins60,
+				RecipientSpent:      []types.RecipientSpent{{Address: toAddr.String()}, {Address: toAddr.String()}},
+			},
+			"duplicate entry",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPeriodicSendAuthorization(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := testutil.DefaultContextWithDB(t, sdk.NewKVStoreKey(types.StoreKey), sdk.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeader(tmproto.Header{Time: now})
+	authorization := types.NewPeriodicSendAuthorization(coins500, time.Hour, coins100, nil, nil)
+	require.Equal(t, authorization.MsgTypeURL(), "/cosmos.bank.v1beta1.MsgSend")
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify allowance before the first send")
+	spendLimit, periodCanSpend, periodReset := authorization.SpendAllowance(now)
+	require.Equal(t, coins500, spendLimit)
+	require.Equal(t, coins100, periodCanSpend)
+	require.Equal(t, now.Add(time.Hour), periodReset)
+
+	t.Log("verify first send starts the period")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins60))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.NotNil(t, resp.Updated)
+	updated := resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, coins500.Sub(coins60...), updated.SpendLimit)
+	require.Equal(t, coins40, updated.PeriodCanSpend)
+	require.Equal(t, now.Add(time.Hour), updated.PeriodReset)
+	require.True(t, authorization.PeriodCanSpend.Empty(), "original authorization must not be modified")
+
+	t.Log("verify send above the period limit left is rejected")
+	_, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins60))
+	require.ErrorContains(t, err, "requested amount is more than period spend limit")
+
+	t.Log("verify period limit is refilled in the next period")
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	spendLimit, periodCanSpend, periodReset = updated.SpendAllowance(ctx.BlockTime())
+	require.Equal(t, coins500.Sub(coins60...), spendLimit)
+	require.Equal(t, coins100, periodCanSpend)
+	require.Equal(t, now.Add(2*time.Hour), periodReset)
+
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins100))
+	require.NoError(t, err)
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(340))), updated.SpendLimit)
+	require.True(t, updated.PeriodCanSpend.IsZero())
+	require.Equal(t, now.Add(2*time.Hour), updated.PeriodReset)
+
+	t.Log("verify period can spend is capped by the total spend limit left")
+	updated.SpendLimit = coins40
+	ctx = ctx.WithBlockTime(now.Add(10 * time.Hour))
+	_, periodCanSpend, periodReset = updated.SpendAllowance(ctx.BlockTime())
+	require.Equal(t, coins40, periodCanSpend)
+	require.Equal(t, now.Add(11*time.Hour), periodReset)
+
+	t.Log("verify authorization is deleted when the total spend limit is used up")
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins40))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+}
+
+func TestPeriodicSendAuthorizationMultipleDenoms(t *testing.T) {
+	now := time.Now().UTC()
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 500))
+	periodSpendLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 100))
+	authorization := types.NewPeriodicSendAuthorization(spendLimit, time.Hour, periodSpendLimit, nil, nil)
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify period can spend is capped per denom by the total spend limit left")
+	_, periodCanSpend, _ := authorization.SpendAllowance(now)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 100)), periodCanSpend)
+}
+
+func TestPeriodicSendAuthorizationRecipientLimit(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := testutil.DefaultContextWithDB(t, sdk.NewKVStoreKey(types.StoreKey), sdk.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeader(tmproto.Header{Time: now})
+	authorization := types.NewPeriodicSendAuthorization(nil, time.Hour, coins100, coins60, []sdk.AccAddress{toAddr, otherAddr})
+	require.NoError(t, authorization.ValidateBasic())
+
+	t.Log("verify send to address not in allow list is rejected")
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, unknownAddr, coins40))
+	require.ErrorContains(t, err, "cannot send to")
+
+	t.Log("verify coins sent to a recipient are tracked")
+	resp, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins40))
+	require.NoError(t, err)
+	updated := resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Empty(t, updated.SpendLimit)
+	require.Equal(t, []types.RecipientSpent{{Address: toAddr.String(), Spent: coins40}}, updated.RecipientSpent)
+
+	_, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins40))
+	require.ErrorContains(t, err, "requested amount is more than recipient spend limit")
+
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, otherAddr, coins60))
+	require.NoError(t, err)
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Len(t, updated.RecipientSpent, 2)
+	require.True(t, updated.PeriodCanSpend.IsZero())
+
+	t.Log("verify recipient spends are cleared in the next period")
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+	resp, err = updated.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins60))
+	require.NoError(t, err)
+	updated = resp.Updated.(*types.PeriodicSendAuthorization)
+	require.Equal(t, []types.RecipientSpent{{Address: toAddr.String(), Spent: coins60}}, updated.RecipientSpent)
+	require.Equal(t, coins40, updated.PeriodCanSpend)
+}
+
+func TestSendAuthorizationSpendAllowance(t *testing.T) {
+	authorization := types.NewSendAuthorization(coins1000, nil)
+	spendLimit, periodCanSpend, periodReset := authorization.SpendAllowance(time.Now())
+	require.Equal(t, coins1000, spendLimit)
+	require.Empty(t, periodCanSpend)
+	require.True(t, periodReset.IsZero())
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -11,7 +13,10 @@ import (
 // Ref: https://github.com/cosmos/cosmos-sdk/discussions/9072
 const gasCostPerIteration = uint64(10)
 
-var _ authz.Authorization = &SendAuthorization{}
+var (
+	_ authz.Authorization           = &SendAuthorization{}
+	_ authz.SpendLimitAuthorization = &SendAuthorization{}
+)
 
 // NewSendAuthorization creates a new SendAuthorization object.
 func NewSendAuthorization(spendLimit sdk.Coins, allowed []sdk.AccAddress) *SendAuthorization {
@@ -60,6 +65,12 @@ func (a SendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptRes
 	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft, AllowList: allowedList}}, nil
 }
 
+// SpendAllowance implements authz.SpendLimitAuthorization.SpendAllowance.
+// SendAuthorization has no period.
+func (a SendAuthorization) SpendAllowance(_ time.Time) (sdk.Coins, sdk.Coins, time.Time) {
+	return a.SpendLimit, nil, time.Time{}
+}
+
 // ValidateBasic implements Authorization.ValidateBasic.
 func (a SendAuthorization) ValidateBasic() error {
 	if len(a.SpendLimit) == 0 {
@@ -7,7 +7,6 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/suite"
 
-	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -27,19 +26,11 @@ type CapabilityTestSuite struct {
 	cdc    codec.Codec
 	ctx    sdk.Context
 	keeper *keeper.Keeper
-	memKey *storetypes.MemoryStoreKey
 }
 
 func (suite *CapabilityTestSuite) SetupTest() {
-	suite.memKey = storetypes.NewMemoryStoreKey("testingkey")
-
-	startupCfg := simtestutil.DefaultStartUpConfig()
-	startupCfg.BaseAppOption = func(ba *baseapp.BaseApp) {
-		ba.MountStores(suite.memKey)
-	}
-
 	app, err := simtestutil.SetupWithConfiguration(testutil.AppConfig,
-		startupCfg,
+		simtestutil.DefaultStartUpConfig(),
 		&suite.cdc,
 		&suite.keeper,
 	)
@@ -49,54 +40,44 @@ func (suite *CapabilityTestSuite) SetupTest() {
 	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
 }
 
-// The following test case mocks a specific bug discovered in https://github.com/cosmos/cosmos-sdk/issues/9800
-// and ensures that the current code successfully fixes the issue.
-func (suite *CapabilityTestSuite) TestInitializeMemStore() {
+// TestRestart ensures that capabilities can be retrieved and authenticated by a
+// keeper which only shares the persistent state, as it happens after a restart
+// or a state sync, without any initialization in BeginBlock.
+func (suite *CapabilityTestSuite) TestRestart() {
 	sk1 := suite.keeper.ScopeToModule(banktypes.ModuleName)
 
 	cap1, err := sk1.NewCapability(suite.ctx, "transfer")
 	suite.Require().NoError(err)
 	suite.Require().NotNil(cap1)
 
-	// mock statesync by creating new keeper that shares persistent state but loses in-memory map
-	newKeeper := keeper.NewKeeper(suite.cdc, suite.app.UnsafeFindStoreKey(types.StoreKey).(*storetypes.KVStoreKey), suite.memKey)
+	// mock a restart by creating a new keeper that shares persistent state but
+	// loses all the capability references.
+	newKeeper := keeper.NewKeeper(suite.cdc, suite.app.UnsafeFindStoreKey(types.StoreKey).(*storetypes.KVStoreKey), nil)
 	newSk1 := newKeeper.ScopeToModule(banktypes.ModuleName)
-
-	// Mock App startup
-	ctx := suite.app.BaseApp.NewUncachedContext(false, tmproto.Header{})
 	newKeeper.Seal()
-	suite.Require().False(newKeeper.IsInitialized(ctx), "memstore initialized flag set before BeginBlock")
 
-	// Mock app beginblock and ensure that no gas has been consumed and memstore is initialized
-	ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockGasMeter(sdk.NewGasMeter(50))
-	prevGas := ctx.BlockGasMeter().GasConsumed()
-	restartedModule := capability.NewAppModule(suite.cdc, *newKeeper, true)
-	restartedModule.BeginBlock(ctx, abci.RequestBeginBlock{})
-	suite.Require().True(newKeeper.IsInitialized(ctx), "memstore initialized flag not set")
-	gasUsed := ctx.BlockGasMeter().GasConsumed()
-
-	suite.Require().Equal(prevGas, gasUsed, "beginblocker consumed gas during execution")
+	// a reference from before the restart is not known to the new keeper
+	suite.Require().False(newSk1.AuthenticateCapability(suite.ctx, cap1, "transfer"))
 
 	// Mock the first transaction getting capability and subsequently failing
 	// by using a cached context and discarding all cached writes.
-	cacheCtx, _ := ctx.CacheContext()
-	_, ok := newSk1.GetCapability(cacheCtx, "transfer")
+	cacheCtx, _ := suite.ctx.CacheContext()
+	cacheCap, ok := newSk1.GetCapability(cacheCtx, "transfer")
 	suite.Require().True(ok)
+	suite.Require().Equal(cap1.GetIndex(), cacheCap.GetIndex())
 
 	// Ensure that the second transaction can still receive capability even if first tx fails.
-	ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
-
-	cap1, ok = newSk1.GetCapability(ctx, "transfer")
+	recap, ok := newSk1.GetCapability(suite.ctx, "transfer")
 	suite.Require().True(ok)
+	suite.Require().True(cacheCap == recap, "expected memory addresses to be equal")
+	suite.Require().True(newSk1.AuthenticateCapability(suite.ctx, recap, "transfer"))
 
-	// Ensure the capabilities don't get reinitialized on next BeginBlock
-	// by testing to see if capability returns same pointer
-	// also check that initialized flag is still set
-	restartedModule.BeginBlock(ctx, abci.RequestBeginBlock{})
-	recap, ok := newSk1.GetCapability(ctx, "transfer")
+	// BeginBlock has no state to initialize
+	restartedModule := capability.NewAppModule(suite.cdc, *newKeeper, true)
+	restartedModule.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+	got, ok := newSk1.GetCapability(suite.ctx, "transfer")
 	suite.Require().True(ok)
-	suite.Require().Equal(cap1, recap, "capabilities got reinitialized after second BeginBlock")
-	suite.Require().True(newKeeper.IsInitialized(ctx), "memstore initialized flag not set")
+	suite.Require().True(recap == got, "capabilities got reinitialized after BeginBlock")
 }
 
 func TestCapabilityTestSuite(t *testing.T) {
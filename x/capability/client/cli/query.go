@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	capabilityQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the capability module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	capabilityQueryCmd.AddCommand(
+		GetCmdQueryCapabilityOwners(),
+		GetCmdQueryModuleCapabilities(),
+	)
+	return capabilityQueryCmd
+}
+
+// GetCmdQueryCapabilityOwners implements the query capability owners command.
+func GetCmdQueryCapabilityOwners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "owners [index]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the owners of a capability by its index",
+		Example: fmt.Sprintf(`$ %s query %s owners 1`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			index, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid capability index %s: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CapabilityOwners(cmd.Context(), &types.QueryCapabilityOwnersRequest{
+				Index: index,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryModuleCapabilities implements the query module capabilities command.
+func GetCmdQueryModuleCapabilities() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "module-capabilities [module]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the capabilities owned by a module",
+		Example: fmt.Sprintf(`$ %s query %s module-capabilities ibc`, version.AppName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleCapabilities(cmd.Context(), &types.QueryModuleCapabilitiesRequest{
+				Module:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "module-capabilities")
+	return cmd
+}
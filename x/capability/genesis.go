@@ -13,12 +13,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		panic(err)
 	}
 
-	// set owners for each index
+	// set owners and owner mappings for each index
 	for _, genOwner := range genState.Owners {
-		k.SetOwners(ctx, genOwner.Index, genOwner.IndexOwners)
+		k.InitializeCapability(ctx, genOwner.Index, genOwner.IndexOwners)
 	}
-	// initialize in-memory capabilities
-	k.InitMemStore(ctx)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
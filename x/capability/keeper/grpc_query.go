@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// CapabilityOwners returns the owners of the capability with the given index.
+func (k Keeper) CapabilityOwners(c context.Context, req *types.QueryCapabilityOwnersRequest) (*types.QueryCapabilityOwnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owners, ok := k.GetOwners(ctx, req.Index)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrCapabilityOwnersNotFound, "index %d", req.Index)
+	}
+
+	return &types.QueryCapabilityOwnersResponse{Owners: owners}, nil
+}
+
+// ModuleCapabilities returns all the capabilities owned by a module.
+func (k Keeper) ModuleCapabilities(c context.Context, req *types.QueryModuleCapabilitiesRequest) (*types.QueryModuleCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Module == "" {
+		return nil, status.Error(codes.InvalidArgument, "module name cannot be empty")
+	}
+
+	if len(req.Module) > address.MaxAddrLen {
+		return nil, status.Errorf(codes.InvalidArgument, "module name cannot be longer than %d bytes", address.MaxAddrLen)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevCapabilityPrefix(req.Module))
+
+	var capabilities []types.ModuleCapability
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		capabilities = append(capabilities, types.ModuleCapability{
+			Name:  string(key),
+			Index: types.IndexFromKey(value),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModuleCapabilitiesResponse{Capabilities: capabilities, Pagination: pageRes}, nil
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, nil)
+	types.RegisterQueryServer(queryHelper, suite.keeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	sk1 := suite.keeper.ScopeToModule(bankModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingModuleName)
+
+	cap1, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap1, "transfer"))
+
+	cap2, err := sk1.NewCapability(suite.ctx, "send")
+	suite.Require().NoError(err)
+
+	ownersRes, err := queryClient.CapabilityOwners(suite.ctx, &types.QueryCapabilityOwnersRequest{Index: cap1.GetIndex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Owner{
+		types.NewOwner(bankModuleName, "transfer"),
+		types.NewOwner(stakingModuleName, "transfer"),
+	}, ownersRes.Owners.Owners)
+
+	_, err = queryClient.CapabilityOwners(suite.ctx, &types.QueryCapabilityOwnersRequest{Index: 100})
+	suite.Require().Error(err)
+
+	capsRes, err := queryClient.ModuleCapabilities(suite.ctx, &types.QueryModuleCapabilitiesRequest{Module: bankModuleName})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ModuleCapability{
+		{Name: "send", Index: cap2.GetIndex()},
+		{Name: "transfer", Index: cap1.GetIndex()},
+	}, capsRes.Capabilities)
+
+	capsRes, err = queryClient.ModuleCapabilities(suite.ctx, &types.QueryModuleCapabilitiesRequest{
+		Module:     bankModuleName,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(capsRes.Capabilities, 1)
+	suite.Require().Equal(uint64(2), capsRes.Pagination.Total)
+
+	_, err = queryClient.ModuleCapabilities(suite.ctx, &types.QueryModuleCapabilitiesRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ModuleCapabilities(suite.ctx, &types.QueryModuleCapabilitiesRequest{Module: strings.Repeat("a", 256)})
+	suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
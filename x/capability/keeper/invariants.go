@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// RegisterInvariants registers the capability module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "ownership", OwnershipInvariant(k))
+}
+
+// OwnershipInvariant checks that the capability owner sets and the forward and
+// reverse owner mappings are consistent with each other, and that every owned
+// capability has an index below the global index.
+func OwnershipInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		store := ctx.KVStore(k.storeKey)
+		latest := k.GetLatestIndex(ctx)
+
+		k.IterateCapabilityOwners(ctx, func(index uint64, owners types.CapabilityOwners) bool {
+			if index >= latest {
+				count++
+				msg += fmt.Sprintf("\tcapability %d is not below the global index %d\n", index, latest)
+			}
+
+			if len(owners.Owners) == 0 {
+				count++
+				msg += fmt.Sprintf("\tcapability %d has an empty owner set\n", index)
+			}
+
+			for _, owner := range owners.Owners {
+				revIndex := store.Get(types.RevCapabilityKey(owner.Module, owner.Name))
+				if len(revIndex) == 0 || types.IndexFromKey(revIndex) != index {
+					count++
+					msg += fmt.Sprintf("\tcapability %d owned by %s has no matching reverse mapping\n", index, owner.Key())
+				}
+
+				if !store.Has(types.FwdCapabilityKey(owner.Module, index)) {
+					count++
+					msg += fmt.Sprintf("\tcapability %d owned by %s has no forward mapping\n", index, owner.Key())
+				}
+			}
+
+			return false
+		})
+
+		iterateMappings(store, types.KeyPrefixRevCapability, func(key, value []byte) {
+			module, name := types.SplitRevCapabilityKey(key)
+			index := types.IndexFromKey(value)
+
+			owners, ok := k.GetOwners(ctx, index)
+			if _, owned := owners.Get(types.NewOwner(module, name)); !ok || !owned {
+				count++
+				msg += fmt.Sprintf("\treverse mapping %s/%s points to capability %d which it does not own\n", module, name, index)
+			}
+		})
+
+		iterateMappings(store, types.KeyPrefixFwdCapability, func(key, value []byte) {
+			module, index := types.SplitFwdCapabilityKey(key)
+
+			owners, ok := k.GetOwners(ctx, index)
+			if _, owned := owners.Get(types.NewOwner(module, string(value))); !ok || !owned {
+				count++
+				msg += fmt.Sprintf("\tforward mapping of capability %d to %s/%s is not an owner\n", index, module, value)
+			}
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "ownership",
+			fmt.Sprintf("amount of inconsistent capability ownerships found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+func iterateMappings(store sdk.KVStore, keyPrefix []byte, cb func(key, value []byte)) {
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		cb(iterator.Key(), iterator.Value())
+	}
+}
@@ -20,17 +20,17 @@ type (
 	// initialization, the keeper can be hooked up to modules through unique function
 	// references so that it can identify the calling module when later invoked.
 	//
-	// When the initial state is loaded from disk, the keeper allows the ability to
-	// create new capability keys for all previously allocated capability identifiers
-	// (allocated during execution of past transactions and assigned to particular modes),
-	// and keep them in a memory-only store while the chain is running.
+	// All owner to capability mappings are kept in the persistent store, so the
+	// keeper needs no initialization after a restart or a state sync. The only
+	// runtime state is a cache of the capability references handed out to
+	// modules, which is filled lazily and is used to tell genuine capabilities
+	// apart from forged ones.
 	//
 	// The keeper allows the ability to create scoped sub-keepers which are tied to
 	// a single specific module.
 	Keeper struct {
 		cdc           codec.BinaryCodec
 		storeKey      storetypes.StoreKey
-		memKey        storetypes.StoreKey
 		capMap        map[uint64]*types.Capability
 		scopedModules map[string]struct{}
 		sealed        bool
@@ -45,19 +45,18 @@ type (
 	ScopedKeeper struct {
 		cdc      codec.BinaryCodec
 		storeKey storetypes.StoreKey
-		memKey   storetypes.StoreKey
 		capMap   map[uint64]*types.Capability
 		module   string
 	}
 )
 
 // NewKeeper constructs a new CapabilityKeeper instance and initializes maps
-// for capability map and scopedModules map.
-func NewKeeper(cdc codec.BinaryCodec, storeKey, memKey storetypes.StoreKey) *Keeper {
+// for capability map and scopedModules map. The memory store key is no longer
+// used and is only kept for compatibility with existing app wiring, it may be nil.
+func NewKeeper(cdc codec.BinaryCodec, storeKey, _ storetypes.StoreKey) *Keeper {
 	return &Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
-		memKey:        memKey,
 		capMap:        make(map[uint64]*types.Capability),
 		scopedModules: make(map[string]struct{}),
 		sealed:        false,
@@ -84,7 +83,6 @@ func (k *Keeper) ScopeToModule(moduleName string) ScopedKeeper {
 	return ScopedKeeper{
 		cdc:      k.cdc,
 		storeKey: k.storeKey,
-		memKey:   k.memKey,
 		capMap:   k.capMap,
 		module:   moduleName,
 	}
@@ -105,49 +103,18 @@ func (k *Keeper) IsSealed() bool {
 	return k.sealed
 }
 
-// InitMemStore will assure that the module store is a memory store (it will panic if it's not)
-// and willl initialize it. The function is safe to be called multiple times.
-// InitMemStore must be called every time the app starts before the keeper is used (so
-// `BeginBlock` or `InitChain` - whichever is first). We need access to the store so we
-// can't initialize it in a constructor.
-func (k *Keeper) InitMemStore(ctx sdk.Context) {
-	memStore := ctx.KVStore(k.memKey)
-	memStoreType := memStore.GetStoreType()
-
-	if memStoreType != storetypes.StoreTypeMemory {
-		panic(fmt.Sprintf("invalid memory store type; got %s, expected: %s", memStoreType, storetypes.StoreTypeMemory))
-	}
-
-	// create context with no block gas meter to ensure we do not consume gas during local initialization logic.
-	noGasCtx := ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter())
-
-	// check if memory store has not been initialized yet by checking if initialized flag is nil.
-	if !k.IsInitialized(noGasCtx) {
-		prefixStore := prefix.NewStore(noGasCtx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
-		iterator := sdk.KVStorePrefixIterator(prefixStore, nil)
-
-		// initialize the in-memory store for all persisted capabilities
-		defer iterator.Close()
-
-		for ; iterator.Valid(); iterator.Next() {
-			index := types.IndexFromKey(iterator.Key())
-
-			var capOwners types.CapabilityOwners
-
-			k.cdc.MustUnmarshal(iterator.Value(), &capOwners)
-			k.InitializeCapability(noGasCtx, index, capOwners)
-		}
-
-		// set the initialized flag so we don't rerun initialization logic
-		memStore := noGasCtx.KVStore(k.memKey)
-		memStore.Set(types.KeyMemInitialized, []byte{1})
-	}
-}
+// InitMemStore is a no-op, the capability indexes are kept in the persistent
+// store and need no initialization.
+//
+// Deprecated: the capability keeper no longer uses a memory store.
+func (k *Keeper) InitMemStore(_ sdk.Context) {}
 
-// IsInitialized returns true if the keeper is properly initialized, and false otherwise.
-func (k *Keeper) IsInitialized(ctx sdk.Context) bool {
-	memStore := ctx.KVStore(k.memKey)
-	return memStore.Get(types.KeyMemInitialized) != nil
+// IsInitialized always returns true, the capability indexes are kept in the
+// persistent store and need no initialization.
+//
+// Deprecated: the capability keeper no longer uses a memory store.
+func (k *Keeper) IsInitialized(_ sdk.Context) bool {
+	return true
 }
 
 // InitializeIndex sets the index to one (or greater) in InitChain according
@@ -198,35 +165,42 @@ func (k Keeper) GetOwners(ctx sdk.Context, index uint64) (types.CapabilityOwners
 	return owners, true
 }
 
-// InitializeCapability takes in an index and an owners array. It creates the capability in memory
-// and sets the fwd and reverse keys for each owner in the memstore.
-// It is used during initialization from genesis.
+// InitializeCapability takes in an index and an owners array. It sets the
+// owners of the capability along with the forward and reverse keys for each
+// owner in the persistent store. It is used during initialization from genesis.
 func (k Keeper) InitializeCapability(ctx sdk.Context, index uint64, owners types.CapabilityOwners) {
-	memStore := ctx.KVStore(k.memKey)
+	k.SetOwners(ctx, index, owners)
 
-	cap := types.NewCapability(index)
+	store := ctx.KVStore(k.storeKey)
 	for _, owner := range owners.Owners {
-		// Set the forward mapping between the module and capability tuple and the
-		// capability name in the memKVStore
-		memStore.Set(types.FwdCapabilityKey(owner.Module, cap), []byte(owner.Name))
+		setCapabilityMappings(store, owner.Module, owner.Name, index)
+	}
+}
 
-		// Set the reverse mapping between the module and capability name and the
-		// index in the in-memory store. Since marshalling and unmarshalling into a store
-		// will change memory address of capability, we simply store index as value here
-		// and retrieve the in-memory pointer to the capability from our map
-		memStore.Set(types.RevCapabilityKey(owner.Module, owner.Name), sdk.Uint64ToBigEndian(index))
+// IterateCapabilityOwners iterates over all the capability owner sets in the
+// store and calls the provided callback with each index and its owners. The
+// iteration stops when the callback returns true.
+func (k Keeper) IterateCapabilityOwners(ctx sdk.Context, cb func(index uint64, owners types.CapabilityOwners) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixIndexCapability)
+	iterator := prefixStore.Iterator(nil, nil)
+	defer iterator.Close()
 
-		// Set the mapping from index from index to in-memory capability in the go map
-		k.capMap[index] = cap
+	for ; iterator.Valid(); iterator.Next() {
+		var owners types.CapabilityOwners
+		k.cdc.MustUnmarshal(iterator.Value(), &owners)
+
+		if cb(types.IndexFromKey(iterator.Key()), owners) {
+			break
+		}
 	}
 }
 
 // NewCapability attempts to create a new capability with a given name. If the
-// capability already exists in the in-memory store, an error will be returned.
-// Otherwise, a new capability is created with the current global unique index.
-// The newly created capability has the scoped module name and capability name
-// tuple set as the initial owner. Finally, the global index is incremented along
-// with forward and reverse indexes set in the in-memory store.
+// capability already exists, an error will be returned. Otherwise, a new
+// capability is created with the current global unique index. The newly created
+// capability has the scoped module name and capability name tuple set as the
+// initial owner. Finally, the global index is incremented along with forward and
+// reverse indexes set in the persistent store.
 //
 // Note, namespacing is completely local, which is safe since records are prefixed
 // with the module name and no two ScopedKeeper can have the same module name.
@@ -252,19 +226,11 @@ func (sk ScopedKeeper) NewCapability(ctx sdk.Context, name string) (*types.Capab
 	// increment global index
 	store.Set(types.KeyIndex, types.IndexToKey(index+1))
 
-	memStore := ctx.KVStore(sk.memKey)
+	setCapabilityMappings(store, sk.module, name, index)
 
-	// Set the forward mapping between the module and capability tuple and the
-	// capability name in the memKVStore
-	memStore.Set(types.FwdCapabilityKey(sk.module, cap), []byte(name))
-
-	// Set the reverse mapping between the module and capability name and the
-	// index in the in-memory store. Since marshalling and unmarshalling into a store
-	// will change memory address of capability, we simply store index as value here
-	// and retrieve the in-memory pointer to the capability from our map
-	memStore.Set(types.RevCapabilityKey(sk.module, name), sdk.Uint64ToBigEndian(index))
-
-	// Set the mapping from index from index to in-memory capability in the go map
+	// Hand out a fresh reference for the index. If a previous transaction creating
+	// a capability with the same index got reverted, its reference is replaced and
+	// can no longer be authenticated.
 	sk.capMap[index] = cap
 
 	logger(ctx).Info("created new capability", "module", sk.module, "name", name)
@@ -274,12 +240,10 @@ func (sk ScopedKeeper) NewCapability(ctx sdk.Context, name string) (*types.Capab
 
 // AuthenticateCapability attempts to authenticate a given capability and name
 // from a caller. It allows for a caller to check that a capability does in fact
-// correspond to a particular name. The scoped keeper will lookup the capability
-// from the internal in-memory store and check against the provided name. It returns
-// true upon success and false upon failure.
-//
-// Note, the capability's forward mapping is indexed by a string which should
-// contain its unique memory reference.
+// correspond to a particular name. The scoped keeper will check that the
+// capability is a genuine reference handed out by the keeper and lookup the
+// name under which the module owns it. It returns true upon success and false
+// upon failure.
 func (sk ScopedKeeper) AuthenticateCapability(ctx sdk.Context, cap *types.Capability, name string) bool {
 	if strings.TrimSpace(name) == "" || cap == nil {
 		return false
@@ -299,22 +263,15 @@ func (sk ScopedKeeper) ClaimCapability(ctx sdk.Context, cap *types.Capability, n
 	if strings.TrimSpace(name) == "" {
 		return sdkerrors.Wrap(types.ErrInvalidCapabilityName, "capability name cannot be empty")
 	}
+	if !sk.isGenuine(cap) {
+		return sdkerrors.Wrapf(types.ErrCapabilityNotFound, "cannot claim unknown capability %d", cap.GetIndex())
+	}
 	// update capability owner set
 	if err := sk.addOwner(ctx, cap, name); err != nil {
 		return err
 	}
 
-	memStore := ctx.KVStore(sk.memKey)
-
-	// Set the forward mapping between the module and capability tuple and the
-	// capability name in the memKVStore
-	memStore.Set(types.FwdCapabilityKey(sk.module, cap), []byte(name))
-
-	// Set the reverse mapping between the module and capability name and the
-	// index in the in-memory store. Since marshalling and unmarshalling into a store
-	// will change memory address of capability, we simply store index as value here
-	// and retrieve the in-memory pointer to the capability from our map
-	memStore.Set(types.RevCapabilityKey(sk.module, name), sdk.Uint64ToBigEndian(cap.GetIndex()))
+	setCapabilityMappings(ctx.KVStore(sk.storeKey), sk.module, name, cap.GetIndex())
 
 	logger(ctx).Info("claimed capability", "module", sk.module, "name", name, "capability", cap.GetIndex())
 
@@ -333,21 +290,18 @@ func (sk ScopedKeeper) ReleaseCapability(ctx sdk.Context, cap *types.Capability)
 		return sdkerrors.Wrap(types.ErrCapabilityNotOwned, sk.module)
 	}
 
-	memStore := ctx.KVStore(sk.memKey)
-
-	// Delete the forward mapping between the module and capability tuple and the
-	// capability name in the memKVStore
-	memStore.Delete(types.FwdCapabilityKey(sk.module, cap))
+	store := ctx.KVStore(sk.storeKey)
 
-	// Delete the reverse mapping between the module and capability name and the
-	// index in the in-memory store.
-	memStore.Delete(types.RevCapabilityKey(sk.module, name))
+	// Delete the forward and reverse mappings between the module and the
+	// capability.
+	store.Delete(types.FwdCapabilityKey(sk.module, cap.GetIndex()))
+	store.Delete(types.RevCapabilityKey(sk.module, name))
 
 	// remove owner
 	capOwners := sk.getOwners(ctx, cap)
 	capOwners.Remove(types.NewOwner(sk.module, name))
 
-	prefixStore := prefix.NewStore(ctx.KVStore(sk.storeKey), types.KeyPrefixIndexCapability)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixIndexCapability)
 	indexKey := types.IndexToKey(cap.GetIndex())
 
 	if len(capOwners.Owners) == 0 {
@@ -370,26 +324,21 @@ func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capab
 	if strings.TrimSpace(name) == "" {
 		return nil, false
 	}
-	memStore := ctx.KVStore(sk.memKey)
-
-	key := types.RevCapabilityKey(sk.module, name)
-	indexBytes := memStore.Get(key)
-	index := sdk.BigEndianToUint64(indexBytes)
+	store := ctx.KVStore(sk.storeKey)
 
+	indexBytes := store.Get(types.RevCapabilityKey(sk.module, name))
 	if len(indexBytes) == 0 {
-		// If a tx failed and NewCapability got reverted, it is possible
-		// to still have the capability in the go map since changes to
-		// go map do not automatically get reverted on tx failure,
-		// so we delete here to remove unnecessary values in map
-		// TODO: Delete index correctly from capMap by storing some reverse lookup
-		// in-memory map. Issue: https://github.com/cosmos/cosmos-sdk/issues/7805
-
 		return nil, false
 	}
 
-	cap := sk.capMap[index]
-	if cap == nil {
-		panic("capability found in memstore is missing from map")
+	index := types.IndexFromKey(indexBytes)
+
+	// The reference is created on first use after a restart, so there is no
+	// state to rebuild when the application starts.
+	cap, ok := sk.capMap[index]
+	if !ok {
+		cap = types.NewCapability(index)
+		sk.capMap[index] = cap
 	}
 
 	return cap, true
@@ -398,12 +347,12 @@ func (sk ScopedKeeper) GetCapability(ctx sdk.Context, name string) (*types.Capab
 // GetCapabilityName allows a module to retrieve the name under which it stored a given
 // capability given the capability
 func (sk ScopedKeeper) GetCapabilityName(ctx sdk.Context, cap *types.Capability) string {
-	if cap == nil {
+	if !sk.isGenuine(cap) {
 		return ""
 	}
-	memStore := ctx.KVStore(sk.memKey)
+	store := ctx.KVStore(sk.storeKey)
 
-	return string(memStore.Get(types.FwdCapabilityKey(sk.module, cap)))
+	return string(store.Get(types.FwdCapabilityKey(sk.module, cap.GetIndex())))
 }
 
 // GetOwners all the Owners that own the capability associated with the name this ScopedKeeper uses
@@ -435,7 +384,7 @@ func (sk ScopedKeeper) GetOwners(ctx sdk.Context, name string) (*types.Capabilit
 // LookupModules returns all the module owners for a given capability
 // as a string array and the capability itself.
 // The method returns an error if either the capability or the owners cannot be
-// retreived from the memstore.
+// retrieved from the store.
 func (sk ScopedKeeper) LookupModules(ctx sdk.Context, name string) ([]string, *types.Capability, error) {
 	if strings.TrimSpace(name) == "" {
 		return nil, nil, sdkerrors.Wrap(types.ErrInvalidCapabilityName, "cannot lookup modules with empty capability name")
@@ -489,6 +438,21 @@ func (sk ScopedKeeper) getOwners(ctx sdk.Context, cap *types.Capability) *types.
 	return &capOwners
 }
 
+// isGenuine returns true if the given capability is the reference handed out by
+// the keeper for its index. Capabilities constructed outside of the keeper, e.g.
+// from a known index, are never genuine.
+func (sk ScopedKeeper) isGenuine(cap *types.Capability) bool {
+	return cap != nil && sk.capMap[cap.GetIndex()] == cap
+}
+
+// setCapabilityMappings sets the forward mapping between the module and
+// capability index tuple and the capability name, and the reverse mapping
+// between the module and capability name tuple and the capability index.
+func setCapabilityMappings(store storetypes.KVStore, module, name string, index uint64) {
+	store.Set(types.FwdCapabilityKey(module, index), []byte(name))
+	store.Set(types.RevCapabilityKey(module, name), types.IndexToKey(index))
+}
+
 func logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -31,7 +31,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	testCtx := testutil.DefaultContextWithDB(suite.T(), key, sdk.NewTransientStoreKey("transient_test"))
 	suite.ctx = testCtx.Ctx
 	encCfg := moduletestutil.MakeTestEncodingConfig(capability.AppModuleBasic{})
-	suite.keeper = keeper.NewKeeper(encCfg.Codec, key, key)
+	suite.keeper = keeper.NewKeeper(encCfg.Codec, key, nil)
 }
 
 func (suite *KeeperTestSuite) TestSeal() {
@@ -173,6 +173,11 @@ func (suite *KeeperTestSuite) TestClaimCapability() {
 
 	suite.Require().Error(sk3.ClaimCapability(suite.ctx, cap, "  "))
 	suite.Require().Error(sk3.ClaimCapability(suite.ctx, nil, "transfer"))
+
+	forgedCap := types.NewCapability(cap.Index)
+	suite.Require().ErrorIs(sk3.ClaimCapability(suite.ctx, forgedCap, "transfer"), types.ErrCapabilityNotFound)
+	_, ok = sk3.GetCapability(suite.ctx, "transfer")
+	suite.Require().False(ok)
 }
 
 func (suite *KeeperTestSuite) TestGetOwners() {
@@ -303,6 +308,28 @@ func (suite KeeperTestSuite) TestRevertCapability() {
 	suite.Require().Equal(cap, got, "did not get correct capability from context")
 }
 
+func (suite *KeeperTestSuite) TestOwnershipInvariant() {
+	sk1 := suite.keeper.ScopeToModule(bankModuleName)
+	sk2 := suite.keeper.ScopeToModule(stakingModuleName)
+
+	cap, err := sk1.NewCapability(suite.ctx, "transfer")
+	suite.Require().NoError(err)
+	suite.Require().NoError(sk2.ClaimCapability(suite.ctx, cap, "transfer"))
+
+	_, broken := keeper.OwnershipInvariant(*suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// drop one of the owners without removing its mappings
+	owners, ok := suite.keeper.GetOwners(suite.ctx, cap.GetIndex())
+	suite.Require().True(ok)
+	owners.Remove(types.NewOwner(stakingModuleName, "transfer"))
+	suite.keeper.SetOwners(suite.ctx, cap.GetIndex(), owners)
+
+	msg, broken := keeper.OwnershipInvariant(*suite.keeper)(suite.ctx)
+	suite.Require().True(broken)
+	suite.Require().Contains(msg, "amount of inconsistent capability ownerships found 2")
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,32 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	// KeyPrefixIndexCapability is the prefix of the index to capability owners
+	// mappings, which is unchanged from v1.
+	KeyPrefixIndexCapability = []byte("capability_index")
+
+	// KeyPrefixFwdCapability is the prefix of the module and capability index to
+	// capability name mappings, which were kept in the memory store in v1.
+	KeyPrefixFwdCapability = []byte("fwd_capability")
+
+	// KeyPrefixRevCapability is the prefix of the module and capability name to
+	// capability index mappings, which were kept in the memory store in v1.
+	KeyPrefixRevCapability = []byte("rev_capability")
+)
+
+// FwdCapabilityKey returns the forward lookup key of a module and capability index.
+func FwdCapabilityKey(module string, index uint64) []byte {
+	key := append(append([]byte{}, KeyPrefixFwdCapability...), address.MustLengthPrefix([]byte(module))...)
+	return append(key, sdk.Uint64ToBigEndian(index)...)
+}
+
+// RevCapabilityKey returns the reverse lookup key of a module and capability name.
+func RevCapabilityKey(module, name string) []byte {
+	key := append(append([]byte{}, KeyPrefixRevCapability...), address.MustLengthPrefix([]byte(module))...)
+	return append(key, name...)
+}
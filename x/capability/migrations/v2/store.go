@@ -0,0 +1,49 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration includes:
+//
+// - Persisting the forward and reverse owner to capability mappings, which were
+// previously rebuilt in the memory store on every start, from the capability
+// owner sets.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	type ownership struct {
+		index  uint64
+		owners types.CapabilityOwners
+	}
+
+	// collect the owner sets first so that the store is not written while it
+	// is being iterated.
+	var ownerships []ownership
+
+	iterator := prefix.NewStore(store, KeyPrefixIndexCapability).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var owners types.CapabilityOwners
+		if err := cdc.Unmarshal(iterator.Value(), &owners); err != nil {
+			return err
+		}
+
+		ownerships = append(ownerships, ownership{index: sdk.BigEndianToUint64(iterator.Key()), owners: owners})
+	}
+
+	for _, o := range ownerships {
+		for _, owner := range o.owners.Owners {
+			store.Set(FwdCapabilityKey(owner.Module, o.index), []byte(owner.Name))
+			store.Set(RevCapabilityKey(owner.Module, owner.Name), sdk.Uint64ToBigEndian(o.index))
+		}
+	}
+
+	return nil
+}
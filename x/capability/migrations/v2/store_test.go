@@ -0,0 +1,43 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/capability"
+	v2 "github.com/cosmos/cosmos-sdk/x/capability/migrations/v2"
+	"github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+func TestMigration(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(capability.AppModuleBasic{}).Codec
+	capabilityKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(capabilityKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(capabilityKey)
+
+	// v1 layout: only the global index and the capability owner sets are persisted
+	owners := map[uint64][]types.Owner{
+		1: {types.NewOwner("ibc", "ports/transfer"), types.NewOwner("transfer", "ports/transfer")},
+		3: {types.NewOwner("ibc", "capabilities/ports/transfer/channels/channel-0")},
+	}
+	store.Set(types.KeyIndex, types.IndexToKey(4))
+	ownersStore := prefix.NewStore(store, v2.KeyPrefixIndexCapability)
+	for index, o := range owners {
+		ownersStore.Set(types.IndexToKey(index), cdc.MustMarshal(&types.CapabilityOwners{Owners: o}))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, capabilityKey, cdc))
+
+	for index, o := range owners {
+		for _, owner := range o {
+			require.Equal(t, []byte(owner.Name), store.Get(v2.FwdCapabilityKey(owner.Module, index)))
+			require.Equal(t, types.IndexToKey(index), store.Get(v2.RevCapabilityKey(owner.Module, owner.Name)))
+		}
+	}
+	require.Equal(t, types.IndexToKey(4), store.Get(types.KeyIndex))
+}
@@ -26,6 +26,14 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &capOwnersB)
 			return fmt.Sprintf("CapabilityOwners A: %v\nCapabilityOwners B: %v\n", capOwnersA, capOwnersB)
 
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixFwdCapability):
+			return fmt.Sprintf("Name A: %s\nName B: %s\n", kvA.Value, kvB.Value)
+
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixRevCapability):
+			idxA := sdk.BigEndianToUint64(kvA.Value)
+			idxB := sdk.BigEndianToUint64(kvB.Value)
+			return fmt.Sprintf("Index A: %d\nIndex B: %d\n", idxA, idxB)
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
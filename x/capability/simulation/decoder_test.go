@@ -36,6 +36,14 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.KeyPrefixIndexCapability,
 				Value: cdc.MustMarshal(&capOwners),
 			},
+			{
+				Key:   types.FwdCapabilityKey("transfer", 1),
+				Value: []byte("ports/transfer"),
+			},
+			{
+				Key:   types.RevCapabilityKey("transfer", "ports/transfer"),
+				Value: sdk.Uint64ToBigEndian(1),
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -48,6 +56,8 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Index", "Index A: 10\nIndex B: 10\n"},
 		{"CapabilityOwners", fmt.Sprintf("CapabilityOwners A: %v\nCapabilityOwners B: %v\n", capOwners, capOwners)},
+		{"FwdCapability", "Name A: ports/transfer\nName B: ports/transfer\n"},
+		{"RevCapability", "Index A: 1\nIndex B: 1\n"},
 		{"other", ""},
 	}
 
@@ -1,9 +1,8 @@
 package types
 
 import (
-	"fmt"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -13,7 +12,9 @@ const (
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
-	// MemStoreKey defines the in-memory store key
+	// MemStoreKey defines the in-memory store key.
+	//
+	// Deprecated: the capability keeper no longer uses a memory store.
 	MemStoreKey = "memory:capability"
 )
 
@@ -26,20 +27,48 @@ var (
 	// owners mappings.
 	KeyPrefixIndexCapability = []byte("capability_index")
 
-	// KeyMemInitialized defines the key that stores the initialized flag in the memory store
-	KeyMemInitialized = []byte("mem_initialized")
+	// KeyPrefixFwdCapability defines a key prefix that stores the forward mapping
+	// from a module and capability index tuple to the capability name.
+	KeyPrefixFwdCapability = []byte("fwd_capability")
+
+	// KeyPrefixRevCapability defines a key prefix that stores the reverse mapping
+	// from a module and capability name tuple to the capability index.
+	KeyPrefixRevCapability = []byte("rev_capability")
 )
 
 // RevCapabilityKey returns a reverse lookup key for a given module and capability
 // name.
 func RevCapabilityKey(module, name string) []byte {
-	return []byte(fmt.Sprintf("%s/rev/%s", module, name))
+	return append(RevCapabilityPrefix(module), name...)
+}
+
+// RevCapabilityPrefix returns the prefix of all reverse lookup keys of a given
+// module.
+func RevCapabilityPrefix(module string) []byte {
+	return append(append([]byte{}, KeyPrefixRevCapability...), address.MustLengthPrefix([]byte(module))...)
 }
 
 // FwdCapabilityKey returns a forward lookup key for a given module and capability
-// reference.
-func FwdCapabilityKey(module string, cap *Capability) []byte {
-	return []byte(fmt.Sprintf("%s/fwd/%#016p", module, cap))
+// index.
+func FwdCapabilityKey(module string, index uint64) []byte {
+	key := append(append([]byte{}, KeyPrefixFwdCapability...), address.MustLengthPrefix([]byte(module))...)
+	return append(key, IndexToKey(index)...)
+}
+
+// SplitRevCapabilityKey splits a reverse lookup key into its module and
+// capability name.
+func SplitRevCapabilityKey(key []byte) (module, name string) {
+	key = key[len(KeyPrefixRevCapability):]
+	moduleLen := int(key[0])
+	return string(key[1 : 1+moduleLen]), string(key[1+moduleLen:])
+}
+
+// SplitFwdCapabilityKey splits a forward lookup key into its module and
+// capability index.
+func SplitFwdCapabilityKey(key []byte) (module string, index uint64) {
+	key = key[len(KeyPrefixFwdCapability):]
+	moduleLen := int(key[0])
+	return string(key[1 : 1+moduleLen]), IndexFromKey(key[1+moduleLen:])
 }
 
 // IndexToKey returns bytes to be used as a key for a given capability index.
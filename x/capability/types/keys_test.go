@@ -1,7 +1,6 @@
 package types_test
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -10,14 +9,22 @@ import (
 )
 
 func TestRevCapabilityKey(t *testing.T) {
-	expected := []byte("bank/rev/send")
+	expected := append([]byte("rev_capability\x04bank"), []byte("send")...)
 	require.Equal(t, expected, types.RevCapabilityKey("bank", "send"))
+	require.Equal(t, []byte("rev_capability\x04bank"), types.RevCapabilityPrefix("bank"))
+
+	module, name := types.SplitRevCapabilityKey(types.RevCapabilityKey("bank", "send"))
+	require.Equal(t, "bank", module)
+	require.Equal(t, "send", name)
 }
 
 func TestFwdCapabilityKey(t *testing.T) {
-	cap := types.NewCapability(23)
-	expected := []byte(fmt.Sprintf("bank/fwd/%#016p", cap))
-	require.Equal(t, expected, types.FwdCapabilityKey("bank", cap))
+	expected := append([]byte("fwd_capability\x04bank"), 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x17)
+	require.Equal(t, expected, types.FwdCapabilityKey("bank", 23))
+
+	module, index := types.SplitFwdCapabilityKey(expected)
+	require.Equal(t, "bank", module)
+	require.Equal(t, uint64(23), index)
 }
 
 func TestIndexToKey(t *testing.T) {
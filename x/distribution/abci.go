@@ -11,8 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-// BeginBlocker sets the proposer for determining distribution during endblock
-// and distribute rewards for the previous block.
+// BeginBlocker sets the proposer for determining distribution during endblock,
+// distribute rewards for the previous block and pays out the continuous funds
+// from the community pool.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -31,4 +32,14 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
+
+	k.PayContinuousFunds(ctx)
+}
+
+// EndBlocker compounds the staking rewards of delegators who opted in to
+// auto-compounding.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.AutoCompound(ctx)
 }
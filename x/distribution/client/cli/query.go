@@ -32,6 +32,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryAutoCompound(),
+		GetCmdQueryContinuousFund(),
+		GetCmdQueryContinuousFunds(),
 	)
 
 	return distQueryCmd
@@ -364,3 +367,132 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryAutoCompound returns the command for fetching the auto-compounding
+// status of a delegator.
+func GetCmdQueryAutoCompound() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the auto-compounding status of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether auto-compounding is enabled for a delegator and the earliest
+height at which its rewards are compounded next.
+
+Example:
+$ %s query distribution auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AutoCompound(
+				cmd.Context(),
+				&types.QueryAutoCompoundRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContinuousFund returns the command for fetching a continuous fund.
+func GetCmdQueryContinuousFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-fund [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a continuous fund paid from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a continuous fund paid from the community pool by its id.
+
+Example:
+$ %s query distribution continuous-fund 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFund(cmd.Context(), &types.QueryContinuousFundRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Fund)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContinuousFunds returns the command for fetching all active
+// continuous funds.
+func GetCmdQueryContinuousFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-funds",
+		Args:  cobra.NoArgs,
+		Short: "Query all active continuous funds paid from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all active continuous funds paid from the community pool.
+
+Example:
+$ %s query distribution continuous-funds
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFunds(cmd.Context(), &types.QueryContinuousFundsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "continuous funds")
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -40,6 +41,7 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSetAutoCompoundCmd(),
 	)
 
 	return distTxCmd
@@ -254,3 +256,43 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 
 	return cmd
 }
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [enabled]",
+		Short: "Enable or disable auto-compounding of staking rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable auto-compounding of the staking rewards of a delegator.
+When enabled, rewards are periodically withdrawn and delegated to the same
+validators. Rewards are only compounded if the withdraw address of the
+delegator is the delegator address itself.
+
+Example:
+$ %s tx distribution set-auto-compound true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoCompound(delAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
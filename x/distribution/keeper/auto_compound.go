@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// HasAutoCompound returns true if the delegator has auto-compounding enabled.
+func (k Keeper) HasAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// SetAutoCompound enables auto-compounding for a delegator.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundDelegatorKey(delAddr), []byte{})
+}
+
+// DeleteAutoCompound disables auto-compounding for a delegator.
+func (k Keeper) DeleteAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// IterateAutoCompoundDelegators iterates over all delegators with
+// auto-compounding enabled, in store order.
+func (k Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, handler func(delAddr sdk.AccAddress) (stop bool)) {
+	k.iterateAutoCompoundDelegatorsAfter(ctx, nil, handler)
+}
+
+// iterateAutoCompoundDelegatorsAfter iterates over the delegators with
+// auto-compounding enabled that come after the given delegator in store order.
+// A nil delegator starts the iteration from the beginning.
+func (k Keeper) iterateAutoCompoundDelegatorsAfter(ctx sdk.Context, after sdk.AccAddress, handler func(delAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.AutoCompoundDelegatorPrefix
+	if after != nil {
+		// the smallest key greater than the key of the given delegator
+		start = append(types.GetAutoCompoundDelegatorKey(after), 0x00)
+	}
+
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundDelegatorPrefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetAutoCompoundDelegatorAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// GetAllAutoCompoundDelegators returns all delegators with auto-compounding
+// enabled.
+func (k Keeper) GetAllAutoCompoundDelegators(ctx sdk.Context) (delegators []sdk.AccAddress) {
+	k.IterateAutoCompoundDelegators(ctx, func(delAddr sdk.AccAddress) (stop bool) {
+		delegators = append(delegators, delAddr)
+		return false
+	})
+	return delegators
+}
+
+// GetAutoCompoundCursor returns the last delegator compounded in the round in
+// progress, or nil if no round is in progress.
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.AutoCompoundCursorKey)
+	if bz == nil {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
+
+// SetAutoCompoundCursor sets the last delegator compounded in the round in
+// progress.
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoCompoundCursorKey, delAddr.Bytes())
+}
+
+// DeleteAutoCompoundCursor marks the round in progress as finished.
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundCursorKey)
+}
+
+// NextAutoCompoundHeight returns the earliest height at which the rewards of
+// the given delegator will be compounded, or zero if auto-compounding is
+// disabled for the chain or the delegator.
+func (k Keeper) NextAutoCompoundHeight(ctx sdk.Context, delAddr sdk.AccAddress) int64 {
+	interval := int64(k.GetParams(ctx).AutoCompoundInterval)
+	if interval <= 0 || !k.HasAutoCompound(ctx, delAddr) {
+		return 0
+	}
+
+	height := ctx.BlockHeight()
+
+	// delegators not yet reached by the round in progress are compounded in
+	// one of the following blocks
+	cursor := k.GetAutoCompoundCursor(ctx)
+	if cursor != nil && bytes.Compare(types.GetAutoCompoundDelegatorKey(delAddr), types.GetAutoCompoundDelegatorKey(cursor)) > 0 {
+		return height + 1
+	}
+
+	return (height/interval + 1) * interval
+}
+
+// AutoCompound restakes the outstanding staking rewards of the delegators with
+// auto-compounding enabled. A round starts every AutoCompoundInterval blocks
+// and is processed in batches of at most AutoCompoundBatchSize delegators per
+// block, consuming at most AutoCompoundMaxGas gas per block. Delegators that do
+// not fit into the gas limit of a block are compounded in the next block.
+func (k Keeper) AutoCompound(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoCompoundInterval == 0 {
+		k.DeleteAutoCompoundCursor(ctx)
+		return
+	}
+
+	cursor := k.GetAutoCompoundCursor(ctx)
+	if cursor == nil && ctx.BlockHeight()%int64(params.AutoCompoundInterval) != 0 {
+		return
+	}
+
+	gasMeter := sdk.NewGasMeter(params.AutoCompoundMaxGas)
+
+	var (
+		processed uint32
+		last      sdk.AccAddress
+		exhausted = true
+	)
+
+	k.iterateAutoCompoundDelegatorsAfter(ctx, cursor, func(delAddr sdk.AccAddress) (stop bool) {
+		if processed == params.AutoCompoundBatchSize || gasMeter.IsOutOfGas() {
+			exhausted = false
+			return true
+		}
+
+		// a delegator that exceeds the gas limit on its own is skipped,
+		// otherwise it is retried in the next block
+		if k.compoundDelegatorWithGasLimit(ctx, delAddr, gasMeter) && processed > 0 {
+			exhausted = false
+			return true
+		}
+
+		processed++
+		last = delAddr
+
+		return false
+	})
+
+	if exhausted {
+		k.DeleteAutoCompoundCursor(ctx)
+		return
+	}
+
+	if last != nil {
+		k.SetAutoCompoundCursor(ctx, last)
+	}
+}
+
+// compoundDelegatorWithGasLimit compounds the rewards of a delegator in a
+// cached context limited to the gas remaining in the given meter. State
+// changes are only written if compounding succeeds. It returns true if the
+// delegator ran out of gas.
+func (k Keeper) compoundDelegatorWithGasLimit(ctx sdk.Context, delAddr sdk.AccAddress, gasMeter sdk.GasMeter) (outOfGas bool) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gasMeter.GasRemaining()))
+
+	defer func() {
+		gasMeter.ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "auto-compound")
+
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			k.Logger(ctx).Info("out of gas while auto-compounding rewards", "delegator", delAddr.String())
+			outOfGas = true
+		}
+	}()
+
+	if err := k.compoundDelegator(cacheCtx, delAddr); err != nil {
+		k.Logger(ctx).Error("failed to auto-compound rewards", "delegator", delAddr.String(), "err", err)
+		return false
+	}
+
+	write()
+	return false
+}
+
+// compoundDelegator withdraws the rewards of all delegations of a delegator and
+// delegates the rewards in the bond denom to the same validators. Delegators
+// who withdraw their rewards to a different address are skipped.
+func (k Keeper) compoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) error {
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return nil
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+
+	for _, delegation := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, delAddr) {
+		valAddr := delegation.GetValidatorAddr()
+
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return err
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return types.ErrNoValidatorExists
+		}
+
+		if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoCompound,
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetContinuousFund returns a continuous fund by its id.
+func (k Keeper) GetContinuousFund(ctx sdk.Context, id uint64) (fund types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetContinuousFundKey(id))
+	if bz == nil {
+		return fund, false
+	}
+	k.cdc.MustUnmarshal(bz, &fund)
+	return fund, true
+}
+
+// SetContinuousFund sets a continuous fund.
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContinuousFundKey(fund.Id), k.cdc.MustMarshal(&fund))
+}
+
+// DeleteContinuousFund deletes a continuous fund.
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContinuousFundKey(id))
+}
+
+// IterateContinuousFunds iterates over all continuous funds in the order of
+// their ids.
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(fund types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ContinuousFundPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshal(iter.Value(), &fund)
+		if handler(fund) {
+			break
+		}
+	}
+}
+
+// GetAllContinuousFunds returns all continuous funds.
+func (k Keeper) GetAllContinuousFunds(ctx sdk.Context) (funds []types.ContinuousFund) {
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		funds = append(funds, fund)
+		return false
+	})
+	return funds
+}
+
+// GetNextContinuousFundID returns the id assigned to the next continuous fund.
+func (k Keeper) GetNextContinuousFundID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextContinuousFundIDKey)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextContinuousFundID sets the id assigned to the next continuous fund.
+func (k Keeper) SetNextContinuousFundID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextContinuousFundIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// CreateContinuousFund assigns an id to the given fund, starts it at the
+// current height and stores it.
+func (k Keeper) CreateContinuousFund(ctx sdk.Context, fund types.ContinuousFund) (uint64, error) {
+	recipient, err := sdk.AccAddressFromBech32(fund.Recipient)
+	if err != nil {
+		return 0, err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, types.ErrInvalidContinuousFund.Wrapf("%s is not allowed to receive external funds", fund.Recipient)
+	}
+
+	if fund.Expiry != nil && !fund.Expiry.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidContinuousFund.Wrapf("expiry %s must be after the block time", fund.Expiry)
+	}
+
+	fund.Id = k.GetNextContinuousFundID(ctx)
+	fund.StartHeight = ctx.BlockHeight()
+	fund.Paid = sdk.NewCoins()
+	if fund.Percentage.IsNil() {
+		fund.Percentage = sdk.ZeroDec()
+	}
+
+	if err := fund.Validate(); err != nil {
+		return 0, err
+	}
+
+	k.SetContinuousFund(ctx, fund)
+	k.SetNextContinuousFundID(ctx, fund.Id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyContinuousFundID, strconv.FormatUint(fund.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+		),
+	)
+
+	return fund.Id, nil
+}
+
+// CancelContinuousFund stops a continuous fund and returns the total amount it
+// paid out.
+func (k Keeper) CancelContinuousFund(ctx sdk.Context, id uint64) (sdk.Coins, error) {
+	fund, found := k.GetContinuousFund(ctx, id)
+	if !found {
+		return nil, types.ErrContinuousFundNotFound.Wrapf("id %d", id)
+	}
+
+	k.DeleteContinuousFund(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelContinuousFund,
+			sdk.NewAttribute(types.AttributeKeyContinuousFundID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fund.Paid.String()),
+		),
+	)
+
+	return fund.Paid, nil
+}
+
+// PayContinuousFunds makes the payments of all continuous funds that are due
+// at the current height and removes the funds that expired or reached their
+// cap. If the community pool holds less than a payment, only the available
+// amount is paid out and the shortfall is not carried over to later payments.
+func (k Keeper) PayContinuousFunds(ctx sdk.Context) {
+	for _, fund := range k.GetAllContinuousFunds(ctx) {
+		if fund.Expiry != nil && !ctx.BlockTime().Before(*fund.Expiry) {
+			k.endContinuousFund(ctx, fund, types.AttributeValueExpired)
+			continue
+		}
+
+		if !fund.IsDue(ctx.BlockHeight()) {
+			continue
+		}
+
+		if err := k.payContinuousFund(ctx, &fund); err != nil {
+			k.Logger(ctx).Error("failed to pay continuous fund", "id", fund.Id, "recipient", fund.Recipient, "err", err)
+			continue
+		}
+
+		if !fund.Cap.Empty() && fund.Paid.IsAllGTE(fund.Cap) {
+			k.endContinuousFund(ctx, fund, types.AttributeValueCapReached)
+			continue
+		}
+
+		k.SetContinuousFund(ctx, fund)
+	}
+}
+
+// payContinuousFund pays out a single payment of a continuous fund, limited
+// by its remaining cap and the coins available in the community pool.
+func (k Keeper) payContinuousFund(ctx sdk.Context, fund *types.ContinuousFund) error {
+	recipient, err := sdk.AccAddressFromBech32(fund.Recipient)
+	if err != nil {
+		return err
+	}
+
+	pool := k.GetFeePool(ctx).CommunityPool
+
+	due := fund.Amount
+	if fund.IsPercentage() {
+		due, _ = pool.MulDecTruncate(fund.Percentage).TruncateDecimal()
+	}
+
+	if !fund.Cap.Empty() {
+		remaining := sdk.NewCoins()
+		for _, coin := range fund.Cap {
+			if left := coin.Amount.Sub(fund.Paid.AmountOf(coin.Denom)); left.IsPositive() {
+				remaining = remaining.Add(sdk.NewCoin(coin.Denom, left))
+			}
+		}
+		due = due.Min(remaining)
+	}
+
+	available, _ := pool.TruncateDecimal()
+	payout := due.Min(available)
+	shortfall := due.Sub(payout...)
+
+	if !payout.IsZero() {
+		if err := k.DistributeFromFeePool(ctx, payout, recipient); err != nil {
+			return err
+		}
+		fund.Paid = fund.Paid.Add(payout...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContinuousFundPayout,
+			sdk.NewAttribute(types.AttributeKeyContinuousFundID, strconv.FormatUint(fund.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, payout.String()),
+			sdk.NewAttribute(types.AttributeKeyShortfall, shortfall.String()),
+		),
+	)
+
+	return nil
+}
+
+func (k Keeper) endContinuousFund(ctx sdk.Context, fund types.ContinuousFund, reason string) {
+	k.DeleteContinuousFund(ctx, fund.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeContinuousFundEnded,
+			sdk.NewAttribute(types.AttributeKeyContinuousFundID, strconv.FormatUint(fund.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fund.Paid.String()),
+		),
+	)
+}
@@ -23,6 +23,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetDelegatorWithdrawAddr(ctx, delegatorAddress, withdrawAddress)
 	}
 
+	for _, del := range data.AutoCompoundDelegators {
+		k.SetAutoCompound(ctx, sdk.MustAccAddressFromBech32(del))
+	}
+
+	for _, fund := range data.ContinuousFunds {
+		k.SetContinuousFund(ctx, fund)
+	}
+	if data.NextContinuousFundId > 0 {
+		k.SetNextContinuousFundID(ctx, data.NextContinuousFundId)
+	}
+
 	var previousProposer sdk.ConsAddress
 	if data.PreviousProposer != "" {
 		var err error
@@ -184,5 +195,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompound := make([]string, 0)
+	k.IterateAutoCompoundDelegators(ctx, func(del sdk.AccAddress) (stop bool) {
+		autoCompound = append(autoCompound, del.String())
+		return false
+	})
+
+	gs := types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	gs.AutoCompoundDelegators = autoCompound
+
+	gs.ContinuousFunds = k.GetAllContinuousFunds(ctx)
+	if gs.ContinuousFunds == nil {
+		gs.ContinuousFunds = []types.ContinuousFund{}
+	}
+	gs.NextContinuousFundId = k.GetNextContinuousFundID(ctx)
+
+	return gs
 }
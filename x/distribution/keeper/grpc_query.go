@@ -293,3 +293,69 @@ func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// AutoCompound queries whether auto-compounding is enabled for a delegator and
+// the earliest height at which its rewards are compounded next
+func (k Querier) AutoCompound(c context.Context, req *types.QueryAutoCompoundRequest) (*types.QueryAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAutoCompoundResponse{
+		Enabled:       k.HasAutoCompound(ctx, delAdr),
+		NextRunHeight: k.NextAutoCompoundHeight(ctx, delAdr),
+	}, nil
+}
+
+// ContinuousFund queries a continuous fund by its id
+func (k Querier) ContinuousFund(c context.Context, req *types.QueryContinuousFundRequest) (*types.QueryContinuousFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fund, found := k.GetContinuousFund(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "continuous fund %d not found", req.Id)
+	}
+
+	return &types.QueryContinuousFundResponse{Fund: fund}, nil
+}
+
+// ContinuousFunds queries all active continuous funds
+func (k Querier) ContinuousFunds(c context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	fundsStore := prefix.NewStore(store, types.ContinuousFundPrefix)
+
+	funds := []types.ContinuousFund{}
+	pageRes, err := query.Paginate(fundsStore, req.Pagination, func(key []byte, value []byte) error {
+		var fund types.ContinuousFund
+		if err := k.cdc.Unmarshal(value, &fund); err != nil {
+			return err
+		}
+		funds = append(funds, fund)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContinuousFundsResponse{Funds: funds, Pagination: pageRes}, nil
+}
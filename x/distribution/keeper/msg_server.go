@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 
@@ -158,3 +159,68 @@ func (k msgServer) CommunityPoolSpend(goCtx context.Context, req *types.MsgCommu
 
 	return &types.MsgCommunityPoolSpendResponse{}, nil
 }
+
+func (k msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Enabled {
+		if k.GetParams(ctx).AutoCompoundInterval == 0 {
+			return nil, types.ErrAutoCompoundDisabled
+		}
+		k.Keeper.SetAutoCompound(ctx, delegatorAddress)
+	} else {
+		k.DeleteAutoCompound(ctx, delegatorAddress)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	)
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (k msgServer) CreateContinuousFund(goCtx context.Context, req *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	id, err := k.Keeper.CreateContinuousFund(ctx, types.ContinuousFund{
+		Recipient:   req.Recipient,
+		Percentage:  req.Percentage,
+		Amount:      req.Amount,
+		EpochBlocks: req.EpochBlocks,
+		Expiry:      req.Expiry,
+		Cap:         req.Cap,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateContinuousFundResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelContinuousFund(goCtx context.Context, req *types.MsgCancelContinuousFund) (*types.MsgCancelContinuousFundResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	paid, err := k.Keeper.CancelContinuousFund(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelContinuousFundResponse{Paid: paid}, nil
+}
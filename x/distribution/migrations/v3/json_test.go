@@ -41,13 +41,19 @@ func TestMigrateJSON(t *testing.T) {
 	require.NoError(t, err)
 
 	expected := `{
+	"auto_compound_delegators": [],
+	"continuous_funds": [],
 	"delegator_starting_infos": [],
 	"delegator_withdraw_infos": [],
 	"fee_pool": {
 		"community_pool": []
 	},
+	"next_continuous_fund_id": "1",
 	"outstanding_rewards": [],
 	"params": {
+		"auto_compound_batch_size": 100,
+		"auto_compound_interval": "100",
+		"auto_compound_max_gas": "10000000",
 		"base_proposer_reward": "0.000000000000000000",
 		"bonus_proposer_reward": "0.000000000000000000",
 		"community_tax": "0.020000000000000000",
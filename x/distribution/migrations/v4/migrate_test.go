@@ -0,0 +1,39 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	v4 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(v4.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	oldParams := types.DefaultParams()
+	oldParams.CommunityTax = sdk.NewDecWithPrec(5, 2)
+	oldParams.AutoCompoundInterval = 0
+	oldParams.AutoCompoundBatchSize = 0
+	oldParams.AutoCompoundMaxGas = 0
+	store.Set(v4.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	bz := store.Get(v4.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, oldParams.CommunityTax, res.CommunityTax)
+	require.Equal(t, types.DefaultAutoCompoundInterval, res.AutoCompoundInterval)
+	require.Equal(t, types.DefaultAutoCompoundBatchSize, res.AutoCompoundBatchSize)
+	require.Equal(t, types.DefaultAutoCompoundMaxGas, res.AutoCompoundMaxGas)
+}
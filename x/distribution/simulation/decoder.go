@@ -33,6 +33,21 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 		case bytes.Equal(kvA.Key[:1], types.DelegatorWithdrawAddrPrefix):
 			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
 
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundDelegatorPrefix):
+			return fmt.Sprintf("%v\n%v", types.GetAutoCompoundDelegatorAddress(kvA.Key), types.GetAutoCompoundDelegatorAddress(kvB.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.AutoCompoundCursorKey):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ContinuousFundPrefix):
+			var fundA, fundB types.ContinuousFund
+			cdc.MustUnmarshal(kvA.Value, &fundA)
+			cdc.MustUnmarshal(kvB.Value, &fundB)
+			return fmt.Sprintf("%v\n%v", fundA, fundB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextContinuousFundIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
 		case bytes.Equal(kvA.Key[:1], types.DelegatorStartingInfoPrefix):
 			var infoA, infoB types.DelegatorStartingInfo
 			cdc.MustUnmarshal(kvA.Value, &infoA)
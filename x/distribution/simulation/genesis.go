@@ -46,8 +46,11 @@ func RandomizedGenState(simState *module.SimulationState) {
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:          communityTax,
+			WithdrawAddrEnabled:   withdrawEnabled,
+			AutoCompoundInterval:  types.DefaultAutoCompoundInterval,
+			AutoCompoundBatchSize: types.DefaultAutoCompoundBatchSize,
+			AutoCompoundMaxGas:    types.DefaultAutoCompoundMaxGas,
 		},
 	}
 
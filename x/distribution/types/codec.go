@@ -23,6 +23,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "cosmos-sdk/distr/MsgCommunityPoolSpend")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "cosmos-sdk/distr/MsgSetAutoCompound")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateContinuousFund{}, "cosmos-sdk/MsgCreateContinuousFund")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelContinuousFund{}, "cosmos-sdk/MsgCancelContinuousFund")
 
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/distribution/Params", nil)
 }
@@ -36,6 +39,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgFundCommunityPool{},
 		&MsgUpdateParams{},
 		&MsgCommunityPoolSpend{},
+		&MsgSetAutoCompound{},
+		&MsgCreateContinuousFund{},
+		&MsgCancelContinuousFund{},
 	)
 
 	registry.RegisterImplementations(
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of a continuous fund.
+func (f ContinuousFund) Validate() error {
+	if f.Id == 0 {
+		return ErrInvalidContinuousFund.Wrap("id must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(f.Recipient); err != nil {
+		return ErrInvalidContinuousFund.Wrapf("invalid recipient address: %s", err)
+	}
+	if err := f.Paid.Validate(); err != nil {
+		return ErrInvalidContinuousFund.Wrapf("invalid paid amount: %s", err)
+	}
+
+	return validateContinuousFundTerms(f.Percentage, f.Amount, f.EpochBlocks, f.Cap)
+}
+
+// IsDue returns true if a payment of the fund is due at the given height.
+func (f ContinuousFund) IsDue(height int64) bool {
+	elapsed := height - f.StartHeight
+	return elapsed > 0 && uint64(elapsed)%f.EpochBlocks == 0
+}
+
+// IsPercentage returns true if the fund pays out a share of the community pool
+// rather than a fixed amount.
+func (f ContinuousFund) IsPercentage() bool {
+	return !f.Percentage.IsNil() && f.Percentage.IsPositive()
+}
+
+// validateContinuousFundTerms validates the payment terms shared by a
+// continuous fund and the message creating it.
+func validateContinuousFundTerms(percentage sdk.Dec, amount sdk.Coins, epochBlocks uint64, cap sdk.Coins) error {
+	hasPercentage := !percentage.IsNil() && !percentage.IsZero()
+
+	switch {
+	case hasPercentage && !amount.Empty():
+		return ErrInvalidContinuousFund.Wrap("percentage and amount are mutually exclusive")
+	case !hasPercentage && amount.Empty():
+		return ErrInvalidContinuousFund.Wrap("either percentage or amount must be set")
+	case hasPercentage && (percentage.IsNegative() || percentage.GT(sdk.OneDec())):
+		return ErrInvalidContinuousFund.Wrapf("percentage must be positive and not greater than one: %s", percentage)
+	}
+
+	if err := amount.Validate(); err != nil {
+		return ErrInvalidContinuousFund.Wrapf("invalid amount: %s", err)
+	}
+	if err := cap.Validate(); err != nil {
+		return ErrInvalidContinuousFund.Wrapf("invalid cap: %s", err)
+	}
+	if epochBlocks == 0 {
+		return ErrInvalidContinuousFund.Wrap("epoch blocks must be positive")
+	}
+
+	return nil
+}
+
+// validateContinuousFunds validates the continuous funds of a genesis state.
+func validateContinuousFunds(funds []ContinuousFund, nextID uint64) error {
+	ids := make(map[uint64]bool, len(funds))
+	for _, fund := range funds {
+		if err := fund.Validate(); err != nil {
+			return err
+		}
+		if ids[fund.Id] {
+			return fmt.Errorf("duplicate continuous fund id %d", fund.Id)
+		}
+		if fund.Id >= nextID {
+			return fmt.Errorf("continuous fund id %d must be lower than the next id %d", fund.Id, nextID)
+		}
+		ids[fund.Id] = true
+	}
+
+	return nil
+}
@@ -11,15 +11,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,6 +41,15 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_interval is the number of blocks between the starts of two
+	// auto-compounding rounds. Auto-compounding is disabled if it is zero.
+	AutoCompoundInterval uint64 `protobuf:"varint,5,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3" json:"auto_compound_interval,omitempty"`
+	// auto_compound_batch_size is the maximum number of delegators whose
+	// rewards are compounded in a single block.
+	AutoCompoundBatchSize uint32 `protobuf:"varint,6,opt,name=auto_compound_batch_size,json=autoCompoundBatchSize,proto3" json:"auto_compound_batch_size,omitempty"`
+	// auto_compound_max_gas is the maximum amount of gas spent on
+	// auto-compounding in a single block.
+	AutoCompoundMaxGas uint64 `protobuf:"varint,7,opt,name=auto_compound_max_gas,json=autoCompoundMaxGas,proto3" json:"auto_compound_max_gas,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -78,6 +91,27 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundInterval() uint64 {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundBatchSize() uint32 {
+	if m != nil {
+		return m.AutoCompoundBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundMaxGas() uint64 {
+	if m != nil {
+		return m.AutoCompoundMaxGas
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -619,6 +653,125 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// ContinuousFund defines a stream of payments from the community pool to a
+// recipient, created by governance. Every epoch_blocks blocks, either the
+// percentage of the community pool or the fixed amount is paid out, until the
+// fund expires, its cap is reached or it is cancelled by governance.
+type ContinuousFund struct {
+	// id is the unique identifier of the fund.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// recipient is the address receiving the payments.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// percentage is the share of the community pool paid out per epoch. It is
+	// mutually exclusive with amount.
+	Percentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=percentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percentage"`
+	// amount is the fixed amount paid out per epoch. It is mutually exclusive
+	// with percentage.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// epoch_blocks is the number of blocks between two payments.
+	EpochBlocks uint64 `protobuf:"varint,5,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	// start_height is the height at which the fund was created. The first
+	// payment is made epoch_blocks blocks later.
+	StartHeight int64 `protobuf:"varint,6,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// expiry is the time after which no more payments are made. A nil expiry
+	// means the fund does not expire.
+	Expiry *time.Time `protobuf:"bytes,7,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+	// cap is the maximum total amount paid out by the fund. Only the denoms of
+	// the cap are paid out if it is set.
+	Cap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=cap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cap"`
+	// paid is the total amount paid out by the fund so far.
+	Paid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=paid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid"`
+}
+
+func (m *ContinuousFund) Reset()         { *m = ContinuousFund{} }
+func (m *ContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*ContinuousFund) ProtoMessage()    {}
+func (*ContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousFund.Merge(m, src)
+}
+func (m *ContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousFund proto.InternalMessageInfo
+
+func (m *ContinuousFund) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ContinuousFund) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ContinuousFund) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ContinuousFund) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func (m *ContinuousFund) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *ContinuousFund) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *ContinuousFund) GetCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Cap
+	}
+	return nil
+}
+
+func (m *ContinuousFund) GetPaid() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Paid
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -632,6 +785,7 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.distribution.v1beta1.ContinuousFund")
 }
 
 func init() {
@@ -639,69 +793,85 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x34, 0x8e, 0x93, 0x4c, 0x69, 0x42, 0x27, 0x4e, 0xea, 0xb8, 0x95, 0x6d, 0xad, 0x44,
-	0x49, 0x43, 0x63, 0x93, 0x22, 0x24, 0x64, 0x21, 0xa4, 0xd8, 0x0e, 0x82, 0x53, 0xa3, 0x0d, 0x02,
-	0xc4, 0x65, 0x35, 0xde, 0x9d, 0xd8, 0xa3, 0xee, 0xce, 0x2c, 0x33, 0x63, 0x27, 0x3d, 0x70, 0xaf,
-	0x7a, 0x00, 0x8e, 0x15, 0xa7, 0x08, 0x2e, 0x15, 0xa7, 0x1c, 0xf2, 0x21, 0x2a, 0x4e, 0x55, 0x0f,
-	0x80, 0x2a, 0x14, 0x50, 0x72, 0x08, 0xe2, 0x53, 0xa0, 0xd9, 0x19, 0xaf, 0x9d, 0x10, 0xa2, 0x4a,
-	0xad, 0xd5, 0x4b, 0xe2, 0x79, 0x6f, 0xf7, 0xfd, 0xfe, 0xcc, 0x9b, 0x37, 0x0b, 0xab, 0x3e, 0x97,
-	0x11, 0x97, 0xb5, 0x80, 0x4a, 0x25, 0x68, 0xbb, 0xa7, 0x28, 0x67, 0xb5, 0xfe, 0x5a, 0x9b, 0x28,
-	0xbc, 0x76, 0x2a, 0x58, 0x8d, 0x05, 0x57, 0x1c, 0x5d, 0x37, 0xcf, 0x57, 0x4f, 0xa5, 0xec, 0xf3,
-	0xc5, 0x7c, 0x87, 0x77, 0x78, 0xf2, 0x5c, 0x4d, 0xff, 0x32, 0xaf, 0x14, 0x4b, 0x16, 0xa2, 0x8d,
-	0x25, 0x49, 0x4b, 0xfb, 0x9c, 0xda, 0x92, 0xc5, 0x25, 0x93, 0xf7, 0xcc, 0x8b, 0xb6, 0xbe, 0x49,
-	0x5d, 0xc5, 0x11, 0x65, 0xbc, 0x96, 0xfc, 0x35, 0x21, 0x67, 0x7f, 0x02, 0xe6, 0x36, 0xb1, 0xc0,
-	0x91, 0x44, 0x18, 0x5e, 0xf1, 0x79, 0x14, 0xf5, 0x18, 0x55, 0xf7, 0x3d, 0x85, 0x77, 0x0b, 0xa0,
-	0x02, 0x96, 0x67, 0x1a, 0x1f, 0x3e, 0x39, 0x2c, 0x67, 0x9e, 0x1f, 0x96, 0x6f, 0x76, 0xa8, 0xea,
-	0xf6, 0xda, 0x55, 0x9f, 0x47, 0xb6, 0xaa, 0xfd, 0xb7, 0x2a, 0x83, 0x7b, 0x35, 0x75, 0x3f, 0x26,
-	0xb2, 0xda, 0x22, 0xfe, 0xb3, 0x83, 0x55, 0x68, 0x41, 0x5b, 0xc4, 0x77, 0xdf, 0x48, 0x4b, 0x7e,
-	0x86, 0x77, 0x51, 0x0c, 0xf3, 0x9a, 0xb6, 0xe6, 0x16, 0x73, 0x49, 0x84, 0x27, 0xc8, 0x0e, 0x16,
-	0x41, 0xe1, 0x52, 0x82, 0xf4, 0xd1, 0xcb, 0x20, 0x15, 0x80, 0x8b, 0x74, 0xed, 0x4d, 0x5b, 0xda,
-	0x4d, 0x2a, 0x23, 0x01, 0x17, 0xda, 0x9c, 0xf5, 0xe4, 0x7f, 0x20, 0x27, 0x5e, 0x09, 0xe4, 0x7c,
-	0x52, 0xfc, 0x0c, 0xe6, 0x1d, 0xb8, 0xb0, 0x43, 0x55, 0x37, 0x10, 0x78, 0xc7, 0xc3, 0x41, 0x20,
-	0x3c, 0xc2, 0x70, 0x3b, 0x24, 0x41, 0x21, 0x5b, 0x01, 0xcb, 0xd3, 0xee, 0xfc, 0x20, 0xb9, 0x1e,
-	0x04, 0x62, 0xc3, 0xa4, 0xea, 0xb7, 0x1e, 0xed, 0x95, 0x33, 0x0f, 0x4f, 0xf6, 0x57, 0x2a, 0x23,
-	0xb8, 0xbb, 0xa7, 0xfb, 0xc8, 0xec, 0x93, 0xf3, 0x2b, 0x80, 0xc5, 0xcf, 0x71, 0x48, 0x03, 0xac,
-	0xb8, 0xf8, 0x84, 0x4a, 0xc5, 0x05, 0xf5, 0x71, 0x68, 0xc0, 0x25, 0xfa, 0x16, 0xc0, 0x6b, 0x7e,
-	0x2f, 0xea, 0x85, 0x58, 0xd1, 0x3e, 0xb1, 0x72, 0x3d, 0x81, 0x15, 0xe5, 0x05, 0x50, 0x99, 0x58,
-	0xbe, 0x7c, 0xe7, 0x86, 0xed, 0xd2, 0xaa, 0xf6, 0x6b, 0xd0, 0x6d, 0x5a, 0x50, 0x93, 0x53, 0xd6,
-	0xf8, 0x40, 0x5b, 0xf2, 0xf3, 0x9f, 0xe5, 0x77, 0x5e, 0xcc, 0x12, 0xfd, 0x8e, 0x7c, 0x7c, 0xb2,
-	0xbf, 0x02, 0xdc, 0x85, 0x21, 0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x36, 0x9c, 0x13, 0x64, 0x9b,
-	0x08, 0xc2, 0x7c, 0xe2, 0xf9, 0xbc, 0xc7, 0x54, 0xb2, 0xdf, 0x57, 0xdc, 0xd9, 0x34, 0xdc, 0xd4,
-	0x51, 0xe7, 0x27, 0x00, 0xaf, 0xa5, 0xc2, 0x9a, 0x3d, 0x21, 0x08, 0x53, 0x03, 0x55, 0x31, 0x9c,
-	0x32, 0x4a, 0xe4, 0x98, 0x45, 0x0c, 0x60, 0xd0, 0x22, 0xcc, 0xc5, 0x44, 0x50, 0x6e, 0xba, 0x33,
-	0xeb, 0xda, 0x95, 0xf3, 0x08, 0xc0, 0x52, 0xca, 0x72, 0xdd, 0xb7, 0x9a, 0x49, 0xd0, 0xe4, 0x51,
-	0x44, 0xa5, 0xa4, 0x9c, 0xa1, 0x3e, 0x84, 0x7e, 0xba, 0x1a, 0x33, 0xdf, 0x11, 0x24, 0xe7, 0x3b,
-	0x00, 0xaf, 0xa7, 0xd4, 0xee, 0xf6, 0x94, 0x54, 0x98, 0x05, 0x94, 0x75, 0x5e, 0x9b, 0x89, 0xce,
-	0x0f, 0x00, 0xce, 0xa7, 0x8c, 0xb6, 0x42, 0x2c, 0xbb, 0x1b, 0x7d, 0xc2, 0x14, 0xba, 0x05, 0xdf,
-	0xec, 0x0f, 0xc2, 0x9e, 0xb5, 0x19, 0x24, 0x36, 0xcf, 0xa5, 0xf1, 0xcd, 0x24, 0x8c, 0xbe, 0x84,
-	0xd3, 0xdb, 0x02, 0xfb, 0xfa, 0x04, 0xd8, 0x39, 0xf1, 0x72, 0x13, 0x29, 0xad, 0xa6, 0xed, 0xca,
-	0x9f, 0x43, 0x4e, 0xa2, 0xaf, 0xe1, 0xe2, 0x90, 0x9d, 0xd4, 0x09, 0x8f, 0x24, 0x19, 0x6b, 0xdb,
-	0xbb, 0xd5, 0x0b, 0xc6, 0x76, 0xf5, 0x9c, 0x92, 0x8d, 0x19, 0x4d, 0xd9, 0x78, 0x93, 0xef, 0x9f,
-	0x03, 0x59, 0xcf, 0xea, 0xf3, 0xef, 0x3c, 0x00, 0x70, 0xea, 0x63, 0x42, 0x36, 0x39, 0x0f, 0xd1,
-	0x37, 0x70, 0x76, 0x38, 0x8e, 0x63, 0xce, 0xc3, 0x31, 0xef, 0xd9, 0x70, 0xf8, 0x6b, 0x78, 0xe7,
-	0xe1, 0x25, 0x58, 0x6c, 0x8e, 0x46, 0xb6, 0x62, 0xc2, 0x02, 0x33, 0xe9, 0x70, 0x88, 0xf2, 0x70,
-	0x52, 0x51, 0x15, 0x12, 0x73, 0x49, 0xb8, 0x66, 0x81, 0x2a, 0xf0, 0x72, 0x40, 0xa4, 0x2f, 0x68,
-	0x3c, 0xdc, 0x2e, 0x77, 0x34, 0x84, 0x6e, 0xc0, 0x19, 0x41, 0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x99,
-	0xc1, 0xee, 0x30, 0x80, 0xba, 0x30, 0x87, 0xa3, 0x64, 0x42, 0x64, 0x13, 0xad, 0x4b, 0xe7, 0x6a,
-	0x4d, 0x84, 0xbe, 0x6f, 0x85, 0x2e, 0xbf, 0x80, 0xd0, 0x11, 0x95, 0xb6, 0x7e, 0xfd, 0xf6, 0x83,
-	0xbd, 0x72, 0x46, 0x7b, 0xfe, 0xf7, 0x5e, 0x39, 0xf3, 0xcb, 0xc1, 0x6a, 0xd1, 0x02, 0x75, 0x78,
-	0x7f, 0x04, 0x87, 0x29, 0x4d, 0x13, 0x38, 0xcf, 0x01, 0x5c, 0x68, 0x91, 0x90, 0x74, 0x92, 0x6d,
-	0x53, 0x58, 0x28, 0xca, 0x3a, 0x9f, 0xb2, 0xed, 0x64, 0xb8, 0xc5, 0x82, 0xf4, 0x29, 0xd7, 0x57,
-	0xcc, 0x68, 0x1f, 0xcf, 0x0e, 0xc2, 0xb6, 0x8d, 0x5d, 0x38, 0x29, 0x15, 0xbe, 0x47, 0x5e, 0x49,
-	0x0f, 0x9b, 0x52, 0xa8, 0x05, 0x73, 0x5d, 0x42, 0x3b, 0x5d, 0xe3, 0x64, 0xb6, 0x71, 0xfb, 0x9f,
-	0xc3, 0xf2, 0x9c, 0x2f, 0x88, 0x1e, 0xbb, 0xcc, 0x33, 0xa9, 0x1f, 0x4f, 0xf6, 0x57, 0xce, 0xc6,
-	0xac, 0x15, 0x66, 0xe1, 0xfc, 0x01, 0xe0, 0x92, 0x15, 0x47, 0x39, 0x4b, 0x65, 0xda, 0xcb, 0x6c,
-	0x03, 0x5e, 0x1d, 0x9e, 0x05, 0x7d, 0x9b, 0x11, 0x29, 0xed, 0x97, 0x41, 0xe1, 0xd9, 0xc1, 0x6a,
-	0xde, 0xb2, 0x5a, 0x37, 0x99, 0x2d, 0x25, 0xf4, 0xbc, 0x19, 0x1e, 0x6e, 0x1b, 0x47, 0x0c, 0xe6,
-	0xd2, 0xbb, 0x7e, 0x9c, 0x5d, 0x6c, 0x51, 0xea, 0xd3, 0x76, 0x7f, 0x81, 0xf3, 0x1b, 0x80, 0x6f,
-	0xfd, 0x7f, 0x23, 0x7f, 0x41, 0x55, 0xb7, 0x45, 0x62, 0x2e, 0xa9, 0x1a, 0x53, 0x4f, 0x2f, 0x8e,
-	0xf4, 0xb4, 0x4e, 0xd9, 0x15, 0x2a, 0xc0, 0xa9, 0xc0, 0x00, 0x17, 0x26, 0x93, 0xc4, 0x60, 0x59,
-	0xbf, 0x39, 0xe0, 0x7e, 0x71, 0x5f, 0x36, 0xee, 0x3e, 0x3e, 0x2a, 0x81, 0x27, 0x47, 0x25, 0xf0,
-	0xf4, 0xa8, 0x04, 0xfe, 0x3a, 0x2a, 0x81, 0xef, 0x8f, 0x4b, 0x99, 0xa7, 0xc7, 0xa5, 0xcc, 0xef,
-	0xc7, 0xa5, 0xcc, 0x57, 0x6b, 0x17, 0x7a, 0x77, 0xe6, 0xd3, 0x22, 0xb1, 0xb2, 0x9d, 0x4b, 0xbe,
-	0x09, 0xdf, 0xfb, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb9, 0x9e, 0xb6, 0x03, 0xc6, 0x0a, 0x00, 0x00,
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xf7, 0x24, 0xc6, 0x49, 0x26, 0x10, 0xca, 0xe0, 0x84, 0xc5, 0x20, 0xdb, 0xb5, 0x54, 0x1a,
+	0x28, 0xb1, 0x1b, 0xfa, 0x0f, 0x45, 0x55, 0x25, 0x9c, 0x40, 0xe1, 0x50, 0x11, 0x6d, 0x50, 0x5b,
+	0x55, 0x95, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x62, 0x77, 0x66, 0x3b, 0x33, 0x6b, 0x0c, 0x52, 0xef,
+	0x88, 0x43, 0xcb, 0x11, 0xf5, 0x84, 0xda, 0x0b, 0xea, 0x89, 0x03, 0x1f, 0x02, 0xf5, 0x84, 0x38,
+	0xb4, 0x15, 0xaa, 0xa0, 0x0a, 0x07, 0x2a, 0x3e, 0x45, 0x35, 0xb3, 0xe3, 0xf5, 0x86, 0xa6, 0x14,
+	0xa9, 0x71, 0x7b, 0xb1, 0x77, 0x7e, 0x6f, 0xe6, 0xfd, 0xde, 0xdf, 0x99, 0x07, 0x9b, 0x3e, 0x97,
+	0x11, 0x97, 0xad, 0x80, 0x4a, 0x25, 0x68, 0x27, 0x51, 0x94, 0xb3, 0x56, 0x7f, 0xb9, 0x43, 0x14,
+	0x5e, 0xde, 0x06, 0x36, 0x63, 0xc1, 0x15, 0x47, 0x47, 0xd2, 0xfd, 0xcd, 0x6d, 0x22, 0xbb, 0xbf,
+	0x52, 0xee, 0xf2, 0x2e, 0x37, 0xfb, 0x5a, 0xfa, 0x2b, 0x3d, 0x52, 0xa9, 0x5a, 0x8a, 0x0e, 0x96,
+	0x24, 0x53, 0xed, 0x73, 0x6a, 0x55, 0x56, 0x0e, 0xa7, 0x72, 0x2f, 0x3d, 0x68, 0xf5, 0xa7, 0xa2,
+	0x03, 0x38, 0xa2, 0x8c, 0xb7, 0xcc, 0xaf, 0x85, 0x6a, 0x5d, 0xce, 0xbb, 0x21, 0x69, 0x99, 0x55,
+	0x27, 0xd9, 0x6c, 0x29, 0x1a, 0x11, 0xa9, 0x70, 0x14, 0xa7, 0x1b, 0x1a, 0x0f, 0x8b, 0xb0, 0xb4,
+	0x8e, 0x05, 0x8e, 0x24, 0xc2, 0x70, 0x9f, 0xcf, 0xa3, 0x28, 0x61, 0x54, 0x5d, 0xf5, 0x14, 0x1e,
+	0x38, 0xa0, 0x0e, 0x16, 0x67, 0xda, 0x1f, 0xde, 0x7f, 0x5c, 0x2b, 0x3c, 0x7a, 0x5c, 0x3b, 0xd6,
+	0xa5, 0xaa, 0x97, 0x74, 0x9a, 0x3e, 0x8f, 0x2c, 0xad, 0xfd, 0x5b, 0x92, 0xc1, 0xe5, 0x96, 0xba,
+	0x1a, 0x13, 0xd9, 0x5c, 0x23, 0xfe, 0xc3, 0x7b, 0x4b, 0xd0, 0x5a, 0xb5, 0x46, 0x7c, 0x77, 0x6f,
+	0xa6, 0xf2, 0x12, 0x1e, 0xa0, 0x18, 0x96, 0xb5, 0x5f, 0xda, 0xf8, 0x98, 0x4b, 0x22, 0x3c, 0x41,
+	0xae, 0x60, 0x11, 0x38, 0x13, 0x86, 0xe9, 0xa3, 0x7f, 0xc3, 0xe4, 0x00, 0x17, 0x69, 0xdd, 0xeb,
+	0x56, 0xb5, 0x6b, 0x34, 0x23, 0x01, 0xe7, 0x3b, 0x9c, 0x25, 0xf2, 0x2f, 0x94, 0x93, 0xbb, 0x42,
+	0x79, 0xd0, 0x28, 0x7f, 0x81, 0xf3, 0x14, 0x9c, 0xbf, 0x42, 0x55, 0x2f, 0x10, 0xf8, 0x8a, 0x87,
+	0x83, 0x40, 0x78, 0x84, 0xe1, 0x4e, 0x48, 0x02, 0xa7, 0x58, 0x07, 0x8b, 0xd3, 0xee, 0xc1, 0xa1,
+	0xf0, 0x4c, 0x10, 0x88, 0xb3, 0xa9, 0x08, 0xbd, 0x0b, 0x17, 0x70, 0xa2, 0xb8, 0xe7, 0xf3, 0x28,
+	0xe6, 0x09, 0x0b, 0x3c, 0xca, 0x14, 0x11, 0x7d, 0x1c, 0x3a, 0x7b, 0xea, 0x60, 0xb1, 0xe8, 0x96,
+	0xb5, 0x74, 0xd5, 0x0a, 0x2f, 0x58, 0x19, 0xfa, 0x00, 0x3a, 0xdb, 0x4f, 0x75, 0xb0, 0xf2, 0x7b,
+	0x9e, 0xa4, 0xd7, 0x88, 0x53, 0xaa, 0x83, 0xc5, 0x7d, 0xee, 0x7c, 0xfe, 0x5c, 0x5b, 0x4b, 0x37,
+	0xe8, 0x35, 0x82, 0x96, 0xe1, 0xfc, 0xf6, 0x83, 0x11, 0x1e, 0x78, 0x5d, 0x2c, 0x9d, 0x29, 0xc3,
+	0x86, 0xf2, 0xa7, 0x3e, 0xc1, 0x83, 0x8f, 0xb1, 0x5c, 0x39, 0x7e, 0xeb, 0x76, 0xad, 0x70, 0xe3,
+	0xd9, 0xdd, 0x13, 0xf5, 0x5c, 0x64, 0x06, 0xdb, 0x5b, 0x21, 0xad, 0xa4, 0xc6, 0xcf, 0x00, 0x56,
+	0x3e, 0xc5, 0x21, 0x0d, 0xb0, 0xe2, 0xe2, 0x3c, 0x95, 0x8a, 0x0b, 0xea, 0xe3, 0x30, 0x0d, 0x8f,
+	0x44, 0xdf, 0x00, 0x78, 0xc8, 0x4f, 0xa2, 0x24, 0xc4, 0x8a, 0xf6, 0x89, 0x4d, 0x88, 0x27, 0xb0,
+	0xa2, 0xdc, 0x01, 0xf5, 0xc9, 0xc5, 0xd9, 0x53, 0x47, 0x6d, 0xa3, 0x35, 0x75, 0x46, 0x87, 0x0d,
+	0xa3, 0x43, 0xbe, 0xca, 0x29, 0x6b, 0x9f, 0xd6, 0x49, 0xfb, 0xf1, 0x49, 0xed, 0xad, 0x57, 0x4b,
+	0x9a, 0x3e, 0x23, 0xef, 0x3c, 0xbb, 0x7b, 0x02, 0xb8, 0xf3, 0x23, 0xda, 0xd4, 0x18, 0x57, 0x93,
+	0xa2, 0x37, 0xe1, 0x7e, 0x41, 0x36, 0x89, 0x20, 0xcc, 0x27, 0x9e, 0xcf, 0x13, 0xa6, 0x4c, 0x45,
+	0xee, 0x73, 0xe7, 0x32, 0x78, 0x55, 0xa3, 0x8d, 0x1f, 0x00, 0x3c, 0x94, 0x39, 0xb6, 0x9a, 0x08,
+	0x41, 0x98, 0x1a, 0x7a, 0x15, 0xc3, 0xa9, 0xd4, 0x13, 0x39, 0x66, 0x27, 0x86, 0x34, 0x68, 0x01,
+	0x96, 0x62, 0x22, 0x28, 0x4f, 0xfb, 0xa7, 0xe8, 0xda, 0x55, 0xe3, 0x16, 0x80, 0xd5, 0xcc, 0xca,
+	0x33, 0xbe, 0xf5, 0x99, 0x04, 0xab, 0x3c, 0x8a, 0xa8, 0x94, 0x94, 0x33, 0xd4, 0x87, 0xd0, 0xcf,
+	0x56, 0x63, 0xb6, 0x37, 0xc7, 0xd4, 0xf8, 0x16, 0xc0, 0x23, 0x99, 0x69, 0x17, 0x13, 0x25, 0x15,
+	0x66, 0x01, 0x65, 0xdd, 0xff, 0x2d, 0x88, 0x8d, 0xef, 0x00, 0x3c, 0x98, 0x59, 0xb4, 0x11, 0x62,
+	0xd9, 0x3b, 0xdb, 0x27, 0x4c, 0xa1, 0xe3, 0xf0, 0xb5, 0xfe, 0x10, 0xf6, 0x6c, 0x98, 0x81, 0x09,
+	0xf3, 0xfe, 0x0c, 0x5f, 0x37, 0x30, 0xfa, 0x1c, 0x4e, 0x6f, 0x0a, 0xec, 0xeb, 0x0e, 0x70, 0x26,
+	0x76, 0xe1, 0xce, 0xcc, 0xb4, 0xe9, 0x70, 0x95, 0x77, 0x30, 0x4e, 0xa2, 0xaf, 0xe0, 0xc2, 0xc8,
+	0x3a, 0xa9, 0x05, 0x1e, 0x31, 0x12, 0x1b, 0xb6, 0xb7, 0x9b, 0x2f, 0x79, 0x79, 0x9a, 0x3b, 0xa8,
+	0x6c, 0xcf, 0x68, 0x93, 0xd3, 0xd8, 0x94, 0xfb, 0x3b, 0x50, 0xae, 0x14, 0x75, 0xff, 0x37, 0xae,
+	0x03, 0x38, 0x75, 0x8e, 0x90, 0x75, 0xce, 0x43, 0xf4, 0x35, 0x9c, 0x1b, 0x3d, 0x18, 0x31, 0xe7,
+	0xe1, 0x98, 0x73, 0x36, 0x7a, 0x9e, 0x34, 0x7d, 0xe3, 0xc6, 0x04, 0xac, 0xac, 0xe6, 0x91, 0x8d,
+	0x98, 0xb0, 0x20, 0xbd, 0x8b, 0x71, 0x88, 0xca, 0x70, 0x8f, 0xa2, 0x2a, 0x24, 0xe9, 0x33, 0xe6,
+	0xa6, 0x0b, 0x54, 0x87, 0xb3, 0x01, 0x91, 0xbe, 0xa0, 0xf1, 0x28, 0x5d, 0x6e, 0x1e, 0x42, 0x47,
+	0xe1, 0x8c, 0x20, 0x3e, 0x8d, 0x29, 0x61, 0x2a, 0x7d, 0x25, 0xdc, 0x11, 0x80, 0x7a, 0xb0, 0x84,
+	0x23, 0x73, 0x43, 0x14, 0x8d, 0xaf, 0x87, 0x77, 0xf4, 0xd5, 0x38, 0xfa, 0x9e, 0x75, 0x74, 0xf1,
+	0x15, 0x1c, 0xcd, 0x79, 0x69, 0xf5, 0xaf, 0x9c, 0xbc, 0x7e, 0xbb, 0x56, 0xd0, 0x31, 0xff, 0xe3,
+	0x76, 0xad, 0xf0, 0xd3, 0xbd, 0xa5, 0x8a, 0x25, 0xea, 0xf2, 0x7e, 0x8e, 0x87, 0x29, 0x6d, 0x26,
+	0x68, 0x3c, 0x02, 0x70, 0x7e, 0x8d, 0x84, 0xa4, 0x6b, 0xd2, 0xa6, 0xb0, 0x50, 0x94, 0x75, 0x2f,
+	0xb0, 0x4d, 0x73, 0xb9, 0xc5, 0x82, 0xf4, 0x29, 0xd7, 0x8f, 0x60, 0xbe, 0x8e, 0xe7, 0x86, 0xb0,
+	0x2d, 0x63, 0x17, 0xee, 0x91, 0x0a, 0x5f, 0x26, 0xbb, 0x52, 0xc3, 0xa9, 0x2a, 0xb4, 0x06, 0x4b,
+	0x3d, 0x42, 0xbb, 0xbd, 0x34, 0x92, 0xc5, 0xf6, 0xc9, 0xe7, 0x8f, 0x6b, 0xfb, 0x7d, 0x41, 0xf4,
+	0xb5, 0xcb, 0xbc, 0x54, 0xf4, 0xfd, 0xb3, 0xbb, 0x27, 0x5e, 0xc4, 0x6c, 0x28, 0xd2, 0x45, 0xe3,
+	0x37, 0x00, 0x0f, 0x5b, 0xe7, 0x28, 0x67, 0x99, 0x9b, 0xf6, 0xb9, 0x3d, 0x0b, 0x0f, 0x8c, 0x7a,
+	0x41, 0xbf, 0xb7, 0x44, 0x4a, 0x3b, 0xbb, 0x38, 0x0f, 0xef, 0x2d, 0x95, 0xad, 0x55, 0x67, 0x52,
+	0xc9, 0x86, 0x12, 0xfa, 0xbe, 0x19, 0x35, 0xb7, 0xc5, 0x11, 0x83, 0xa5, 0x6c, 0x1a, 0x19, 0x67,
+	0x15, 0x5b, 0x96, 0x95, 0x69, 0x9b, 0x5f, 0xd0, 0xf8, 0x05, 0xc0, 0x37, 0xfe, 0xbe, 0x90, 0x3f,
+	0xa3, 0xaa, 0xb7, 0x46, 0x62, 0x2e, 0xa9, 0x1a, 0x53, 0x4d, 0x2f, 0xe4, 0x6a, 0x5a, 0x8b, 0xec,
+	0x0a, 0x39, 0x70, 0x2a, 0x48, 0x89, 0xcd, 0x10, 0x32, 0xe3, 0x0e, 0x97, 0x2b, 0xc7, 0x86, 0xb6,
+	0xbf, 0xbc, 0x2e, 0x1b, 0xcf, 0x8b, 0x70, 0x4e, 0x7f, 0x53, 0x96, 0xf0, 0x44, 0x9e, 0x4b, 0x58,
+	0x80, 0xe6, 0xe0, 0x04, 0x1d, 0x56, 0xe0, 0x04, 0x0d, 0xd0, 0xfb, 0x79, 0xd3, 0x26, 0xfe, 0x21,
+	0x6b, 0x39, 0xa3, 0xbf, 0x84, 0x30, 0x26, 0xc2, 0x27, 0x4c, 0xe1, 0x2e, 0x71, 0x26, 0x77, 0xa1,
+	0x64, 0x73, 0xfa, 0xfe, 0xbb, 0x36, 0x47, 0xaf, 0xc3, 0xbd, 0x24, 0xe6, 0x7e, 0xcf, 0xeb, 0x84,
+	0xdc, 0xbf, 0x2c, 0xed, 0xb8, 0x37, 0x6b, 0xb0, 0xb6, 0x81, 0xf4, 0x16, 0xa9, 0x3b, 0xda, 0xf6,
+	0x86, 0x99, 0xec, 0x26, 0xdd, 0x59, 0x83, 0x9d, 0x37, 0x10, 0x3a, 0x0d, 0x4b, 0x64, 0x10, 0x53,
+	0x71, 0xd5, 0x0c, 0x70, 0xb3, 0xa7, 0x2a, 0xcd, 0x74, 0xf0, 0x6f, 0x0e, 0x07, 0xff, 0xe6, 0xa5,
+	0xe1, 0xe0, 0xdf, 0x2e, 0xde, 0x7c, 0x52, 0x03, 0xae, 0xdd, 0x8f, 0x3a, 0x70, 0xd2, 0xc7, 0xb1,
+	0x33, 0x3d, 0x26, 0x37, 0xb5, 0x72, 0x14, 0xc0, 0x62, 0x8c, 0x69, 0xe0, 0xcc, 0x8c, 0x89, 0xc4,
+	0x68, 0x6f, 0x5f, 0xbc, 0xb3, 0x55, 0x05, 0xf7, 0xb7, 0xaa, 0xe0, 0xc1, 0x56, 0x15, 0xfc, 0xbe,
+	0x55, 0x05, 0x37, 0x9f, 0x56, 0x0b, 0x0f, 0x9e, 0x56, 0x0b, 0xbf, 0x3e, 0xad, 0x16, 0xbe, 0x58,
+	0x7e, 0xa9, 0xca, 0x17, 0xe6, 0x58, 0xc3, 0xd0, 0x29, 0x99, 0xe0, 0xbd, 0xf3, 0xe7, 0x00, 0x37,
+	0xd6, 0x84, 0x46, 0xf6, 0x0d, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -735,6 +905,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if this.AutoCompoundBatchSize != that1.AutoCompoundBatchSize {
+		return false
+	}
+	if this.AutoCompoundMaxGas != that1.AutoCompoundMaxGas {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1042,6 +1221,73 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *ContinuousFund) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContinuousFund)
+	if !ok {
+		that2, ok := that.(ContinuousFund)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Percentage.Equal(that1.Percentage) {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	if this.EpochBlocks != that1.EpochBlocks {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if that1.Expiry == nil {
+		if this.Expiry != nil {
+			return false
+		}
+	} else if !this.Expiry.Equal(*that1.Expiry) {
+		return false
+	}
+	if len(this.Cap) != len(that1.Cap) {
+		return false
+	}
+	for i := range this.Cap {
+		if !this.Cap[i].Equal(&that1.Cap[i]) {
+			return false
+		}
+	}
+	if len(this.Paid) != len(that1.Paid) {
+		return false
+	}
+	for i := range this.Paid {
+		if !this.Paid[i].Equal(&that1.Paid[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1062,6 +1308,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundMaxGas != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AutoCompoundInterval != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundInterval))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1578,6 +1839,113 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Paid) > 0 {
+		for iNdEx := len(m.Paid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Cap) > 0 {
+		for iNdEx := len(m.Cap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Cap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Expiry != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintDistribution(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EpochBlocks != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDistribution(v)
 	base := offset
@@ -1604,6 +1972,15 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	if m.AutoCompoundInterval != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundInterval))
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBatchSize))
+	}
+	if m.AutoCompoundMaxGas != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxGas))
+	}
 	return n
 }
 
@@ -1809,6 +2186,52 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovDistribution(uint64(m.Id))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.EpochBlocks != 0 {
+		n += 1 + sovDistribution(uint64(m.EpochBlocks))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovDistribution(uint64(m.StartHeight))
+	}
+	if m.Expiry != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.Cap) > 0 {
+		for _, e := range m.Cap {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if len(m.Paid) > 0 {
+		for _, e := range m.Paid {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1966,14 +2389,71 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDistribution(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDistribution
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			m.AutoCompoundInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBatchSize", wireType)
+			}
+			m.AutoCompoundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxGas", wireType)
+			}
+			m.AutoCompoundMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -3260,6 +3740,317 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cap = append(m.Cap, types.Coin{})
+			if err := m.Cap[len(m.Cap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = append(m.Paid, types.Coin{})
+			if err := m.Paid[len(m.Paid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
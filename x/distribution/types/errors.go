@@ -18,4 +18,7 @@ var (
 	ErrEmptyProposalRecipient  = sdkerrors.Register(ModuleName, 11, "invalid community pool spend proposal recipient")
 	ErrNoValidatorExists       = sdkerrors.Register(ModuleName, 12, "validator does not exist")
 	ErrNoDelegationExists      = sdkerrors.Register(ModuleName, 13, "delegation does not exist")
+	ErrAutoCompoundDisabled    = sdkerrors.Register(ModuleName, 14, "auto-compounding is disabled")
+	ErrContinuousFundNotFound  = sdkerrors.Register(ModuleName, 15, "continuous fund not found")
+	ErrInvalidContinuousFund   = sdkerrors.Register(ModuleName, 16, "invalid continuous fund")
 )
@@ -8,8 +8,24 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetAutoCompound    = "set_auto_compound"
+	EventTypeAutoCompound       = "auto_compound"
+
+	EventTypeCreateContinuousFund = "create_continuous_fund"
+	EventTypeCancelContinuousFund = "cancel_continuous_fund"
+	EventTypeContinuousFundPayout = "continuous_fund_payout"
+	EventTypeContinuousFundEnded  = "continuous_fund_ended"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+
+	AttributeKeyContinuousFundID = "continuous_fund_id"
+	AttributeKeyRecipient        = "recipient"
+	AttributeKeyShortfall        = "shortfall"
+	AttributeKeyReason           = "reason"
+
+	AttributeValueExpired    = "expired"
+	AttributeValueCapReached = "cap_reached"
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -37,6 +39,9 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegators:          []string{},
+		ContinuousFunds:                 []ContinuousFund{},
+		NextContinuousFundId:            1,
 	}
 }
 
@@ -45,5 +50,13 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, del := range gs.AutoCompoundDelegators {
+		if _, err := sdk.AccAddressFromBech32(del); err != nil {
+			return fmt.Errorf("invalid auto-compound delegator address %s: %w", del, err)
+		}
+	}
+	if err := validateContinuousFunds(gs.ContinuousFunds, gs.NextContinuousFundId); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -41,6 +41,14 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes>: []byte{}
+//
+// - 0x0B: sdk.AccAddress
+//
+// - 0x0C<fundID_Bytes>: ContinuousFund
+//
+// - 0x0D: uint64
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +62,12 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	AutoCompoundDelegatorPrefix = []byte{0x0A} // key for delegators with auto-compounding enabled
+	AutoCompoundCursorKey       = []byte{0x0B} // key for the last delegator compounded in the current round
+
+	ContinuousFundPrefix    = []byte{0x0C} // key for continuous funds
+	NextContinuousFundIDKey = []byte{0x0D} // key for the identifier of the next continuous fund
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -214,3 +228,28 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundDelegatorAddress creates an address from a delegator's
+// auto-compounding key.
+func GetAutoCompoundDelegatorAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0A<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
+
+// GetAutoCompoundDelegatorKey creates the key for a delegator with
+// auto-compounding enabled.
+func GetAutoCompoundDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundDelegatorPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetContinuousFundKey creates the key for a continuous fund.
+func GetContinuousFundKey(id uint64) []byte {
+	return append(ContinuousFundPrefix, sdk.Uint64ToBigEndian(id)...)
+}
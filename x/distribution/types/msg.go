@@ -15,6 +15,9 @@ const (
 	TypeMsgFundCommunityPool           = "fund_community_pool"
 	TypeMsgUpdateParams                = "update_params"
 	TypeMsgCommunityPoolSpend          = "community_pool_spend"
+	TypeMsgSetAutoCompound             = "set_auto_compound"
+	TypeMsgCreateContinuousFund        = "create_continuous_fund"
+	TypeMsgCancelContinuousFund        = "cancel_continuous_fund"
 )
 
 // Verify interface at compile time
@@ -24,6 +27,9 @@ var (
 	_ sdk.Msg = (*MsgWithdrawValidatorCommission)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgCommunityPoolSpend)(nil)
+	_ sdk.Msg = (*MsgSetAutoCompound)(nil)
+	_ sdk.Msg = (*MsgCreateContinuousFund)(nil)
+	_ sdk.Msg = (*MsgCancelContinuousFund)(nil)
 )
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
@@ -224,3 +230,95 @@ func (msg MsgCommunityPoolSpend) ValidateBasic() error {
 
 	return msg.Amount.Validate()
 }
+
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+func (msg MsgSetAutoCompound) Type() string  { return TypeMsgSetAutoCompound }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return nil
+}
+
+// Route returns the MsgCreateContinuousFund message route.
+func (msg MsgCreateContinuousFund) Route() string { return ModuleName }
+
+// Type returns the MsgCreateContinuousFund message type.
+func (msg MsgCreateContinuousFund) Type() string { return TypeMsgCreateContinuousFund }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgCreateContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCreateContinuousFund message that
+// the expected signer needs to sign.
+func (msg MsgCreateContinuousFund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCreateContinuousFund message validation.
+func (msg MsgCreateContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return validateContinuousFundTerms(msg.Percentage, msg.Amount, msg.EpochBlocks, msg.Cap)
+}
+
+// Route returns the MsgCancelContinuousFund message route.
+func (msg MsgCancelContinuousFund) Route() string { return ModuleName }
+
+// Type returns the MsgCancelContinuousFund message type.
+func (msg MsgCancelContinuousFund) Type() string { return TypeMsgCancelContinuousFund }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes, which is the authority.
+func (msg MsgCancelContinuousFund) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes returns the raw bytes for a MsgCancelContinuousFund message that
+// the expected signer needs to sign.
+func (msg MsgCancelContinuousFund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgCancelContinuousFund message validation.
+func (msg MsgCancelContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return nil
+}
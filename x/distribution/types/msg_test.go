@@ -93,3 +93,42 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgCreateContinuousFund
+func TestMsgCreateContinuousFund(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	tests := []struct {
+		recipient   sdk.AccAddress
+		percentage  sdk.Dec
+		amount      sdk.Coins
+		epochBlocks uint64
+		cap         sdk.Coins
+		expectPass  bool
+	}{
+		{delAddr1, sdk.NewDecWithPrec(1, 2), nil, 1, nil, true},
+		{delAddr1, sdk.Dec{}, coins, 10, coins, true},
+		{delAddr1, sdk.ZeroDec(), coins, 10, nil, true},
+		{emptyDelAddr, sdk.NewDecWithPrec(1, 2), nil, 1, nil, false},
+		{delAddr1, sdk.NewDecWithPrec(1, 2), coins, 1, nil, false},
+		{delAddr1, sdk.ZeroDec(), nil, 1, nil, false},
+		{delAddr1, sdk.NewDecWithPrec(11, 1), nil, 1, nil, false},
+		{delAddr1, sdk.NewDecWithPrec(-1, 2), nil, 1, nil, false},
+		{delAddr1, sdk.NewDecWithPrec(1, 2), nil, 0, nil, false},
+		{delAddr1, sdk.Dec{}, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.ZeroInt()}}, 1, nil, false},
+	}
+	for i, tc := range tests {
+		msg := MsgCreateContinuousFund{
+			Authority:   delAddr2.String(),
+			Recipient:   tc.recipient.String(),
+			Percentage:  tc.percentage,
+			Amount:      tc.amount,
+			EpochBlocks: tc.epochBlocks,
+			Cap:         tc.cap,
+		}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -9,13 +9,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Default auto-compounding parameter values
+const (
+	DefaultAutoCompoundInterval  uint64 = 100
+	DefaultAutoCompoundBatchSize uint32 = 100
+	DefaultAutoCompoundMaxGas    uint64 = 10_000_000
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.ZeroDec(),            // deprecated
-		BonusProposerReward: sdk.ZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:          sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:    sdk.ZeroDec(),            // deprecated
+		BonusProposerReward:   sdk.ZeroDec(),            // deprecated
+		WithdrawAddrEnabled:   true,
+		AutoCompoundInterval:  DefaultAutoCompoundInterval,
+		AutoCompoundBatchSize: DefaultAutoCompoundBatchSize,
+		AutoCompoundMaxGas:    DefaultAutoCompoundMaxGas,
 	}
 }
 
@@ -32,6 +42,17 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
+	// an interval of zero disables auto-compounding, in which case the batch
+	// size and gas limit are not used
+	if p.AutoCompoundInterval > 0 {
+		if p.AutoCompoundBatchSize == 0 {
+			return fmt.Errorf("auto-compound batch size must be positive when auto-compounding is enabled")
+		}
+		if p.AutoCompoundMaxGas == 0 {
+			return fmt.Errorf("auto-compound max gas must be positive when auto-compounding is enabled")
+		}
+	}
+
 	return nil
 }
 
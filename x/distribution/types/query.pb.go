@@ -952,6 +952,301 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryAutoCompoundRequest is the request type for the Query/AutoCompound RPC
+// method.
+type QueryAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryAutoCompoundRequest) Reset()         { *m = QueryAutoCompoundRequest{} }
+func (m *QueryAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryAutoCompoundResponse is the response type for the Query/AutoCompound
+// RPC method.
+type QueryAutoCompoundResponse struct {
+	// enabled defines whether auto-compounding is enabled for the delegator.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// next_run_height is the height at which the rewards of the delegator are
+	// expected to be compounded next. It is zero if auto-compounding is
+	// disabled for the delegator or the chain.
+	NextRunHeight int64 `protobuf:"varint,2,opt,name=next_run_height,json=nextRunHeight,proto3" json:"next_run_height,omitempty"`
+}
+
+func (m *QueryAutoCompoundResponse) Reset()         { *m = QueryAutoCompoundResponse{} }
+func (m *QueryAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryAutoCompoundResponse) GetNextRunHeight() int64 {
+	if m != nil {
+		return m.NextRunHeight
+	}
+	return 0
+}
+
+// QueryContinuousFundRequest is the request type for the Query/ContinuousFund
+// RPC method.
+type QueryContinuousFundRequest struct {
+	// id defines the identifier of the fund to query for.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryContinuousFundRequest) Reset()         { *m = QueryContinuousFundRequest{} }
+func (m *QueryContinuousFundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContinuousFundRequest) ProtoMessage()    {}
+func (*QueryContinuousFundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryContinuousFundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContinuousFundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContinuousFundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContinuousFundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContinuousFundRequest.Merge(m, src)
+}
+func (m *QueryContinuousFundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContinuousFundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContinuousFundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContinuousFundRequest proto.InternalMessageInfo
+
+func (m *QueryContinuousFundRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryContinuousFundResponse is the response type for the
+// Query/ContinuousFund RPC method.
+type QueryContinuousFundResponse struct {
+	// fund defines the queried continuous fund.
+	Fund ContinuousFund `protobuf:"bytes,1,opt,name=fund,proto3" json:"fund"`
+}
+
+func (m *QueryContinuousFundResponse) Reset()         { *m = QueryContinuousFundResponse{} }
+func (m *QueryContinuousFundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContinuousFundResponse) ProtoMessage()    {}
+func (*QueryContinuousFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryContinuousFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContinuousFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContinuousFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContinuousFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContinuousFundResponse.Merge(m, src)
+}
+func (m *QueryContinuousFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContinuousFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContinuousFundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContinuousFundResponse proto.InternalMessageInfo
+
+func (m *QueryContinuousFundResponse) GetFund() ContinuousFund {
+	if m != nil {
+		return m.Fund
+	}
+	return ContinuousFund{}
+}
+
+// QueryContinuousFundsRequest is the request type for the
+// Query/ContinuousFunds RPC method.
+type QueryContinuousFundsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContinuousFundsRequest) Reset()         { *m = QueryContinuousFundsRequest{} }
+func (m *QueryContinuousFundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContinuousFundsRequest) ProtoMessage()    {}
+func (*QueryContinuousFundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{24}
+}
+func (m *QueryContinuousFundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContinuousFundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContinuousFundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContinuousFundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContinuousFundsRequest.Merge(m, src)
+}
+func (m *QueryContinuousFundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContinuousFundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContinuousFundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContinuousFundsRequest proto.InternalMessageInfo
+
+func (m *QueryContinuousFundsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryContinuousFundsResponse is the response type for the
+// Query/ContinuousFunds RPC method.
+type QueryContinuousFundsResponse struct {
+	// funds defines the active continuous funds.
+	Funds []ContinuousFund `protobuf:"bytes,1,rep,name=funds,proto3" json:"funds"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContinuousFundsResponse) Reset()         { *m = QueryContinuousFundsResponse{} }
+func (m *QueryContinuousFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContinuousFundsResponse) ProtoMessage()    {}
+func (*QueryContinuousFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{25}
+}
+func (m *QueryContinuousFundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContinuousFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContinuousFundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContinuousFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContinuousFundsResponse.Merge(m, src)
+}
+func (m *QueryContinuousFundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContinuousFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContinuousFundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContinuousFundsResponse proto.InternalMessageInfo
+
+func (m *QueryContinuousFundsResponse) GetFunds() []ContinuousFund {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+func (m *QueryContinuousFundsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -973,6 +1268,12 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRequest")
+	proto.RegisterType((*QueryAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundResponse")
+	proto.RegisterType((*QueryContinuousFundRequest)(nil), "cosmos.distribution.v1beta1.QueryContinuousFundRequest")
+	proto.RegisterType((*QueryContinuousFundResponse)(nil), "cosmos.distribution.v1beta1.QueryContinuousFundResponse")
+	proto.RegisterType((*QueryContinuousFundsRequest)(nil), "cosmos.distribution.v1beta1.QueryContinuousFundsRequest")
+	proto.RegisterType((*QueryContinuousFundsResponse)(nil), "cosmos.distribution.v1beta1.QueryContinuousFundsResponse")
 }
 
 func init() {
@@ -980,86 +1281,101 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xf6, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x99, 0x46, 0xc8, 0xd9, 0x04, 0x3b, 0xda,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x93, 0x48, 0xa5, 0x34, 0x54, 0x10, 0x3b, 0x09, 0x45, 0xad, 0xfa,
-	0xc7, 0x2d, 0x44, 0x80, 0x2a, 0x6b, 0xed, 0xdd, 0xac, 0x17, 0xec, 0x1d, 0x67, 0x67, 0x9d, 0x10,
-	0x55, 0xbd, 0x14, 0x21, 0x15, 0x4e, 0x08, 0x2e, 0x3d, 0xe6, 0x88, 0x38, 0x71, 0x00, 0x71, 0xe4,
-	0xc2, 0xa1, 0xc7, 0x0a, 0x24, 0xc4, 0x09, 0x50, 0x02, 0xa2, 0x1c, 0x90, 0xb8, 0x71, 0x45, 0x9e,
-	0x99, 0xb5, 0x77, 0xe3, 0xf5, 0xfa, 0x5f, 0x7c, 0x49, 0x56, 0x6f, 0xe6, 0x7d, 0xef, 0x7d, 0xef,
-	0xcd, 0x9b, 0xf9, 0x12, 0x98, 0xcd, 0x13, 0x5a, 0x22, 0x54, 0xd1, 0x4c, 0xea, 0xd8, 0x66, 0xae,
-	0xe2, 0x98, 0xc4, 0x52, 0x76, 0x16, 0x73, 0xba, 0xa3, 0x2e, 0x2a, 0xdb, 0x15, 0xdd, 0xde, 0x4b,
-	0x96, 0x6d, 0xe2, 0x10, 0x3c, 0xc9, 0x37, 0x26, 0xbd, 0x1b, 0x93, 0x62, 0xa3, 0x74, 0x5e, 0xa0,
-	0xe4, 0x54, 0xaa, 0x73, 0xaf, 0x1a, 0x46, 0x59, 0x35, 0x4c, 0x4b, 0x65, 0xbb, 0x19, 0x90, 0x34,
-	0x6e, 0x10, 0x83, 0xb0, 0x4f, 0xa5, 0xfa, 0x25, 0xac, 0x53, 0x06, 0x21, 0x46, 0x51, 0x57, 0xd4,
-	0xb2, 0xa9, 0xa8, 0x96, 0x45, 0x1c, 0xe6, 0x42, 0xc5, 0x6a, 0xdc, 0x8b, 0xef, 0x22, 0xe7, 0x89,
-	0xe9, 0x62, 0x26, 0xc3, 0x58, 0xf8, 0x32, 0xe6, 0xfb, 0x27, 0xf8, 0xfe, 0x2c, 0x4f, 0x43, 0x30,
-	0xe3, 0x4b, 0x63, 0x6a, 0xc9, 0xb4, 0x88, 0xc2, 0x7e, 0x72, 0x93, 0x3c, 0x0e, 0xf8, 0x76, 0x95,
-	0xd3, 0x2d, 0xd5, 0x56, 0x4b, 0x34, 0xa3, 0x6f, 0x57, 0x74, 0xea, 0xc8, 0xf7, 0xe0, 0x8c, 0xcf,
-	0x4a, 0xcb, 0xc4, 0xa2, 0x3a, 0xde, 0x80, 0xa1, 0x32, 0xb3, 0xc4, 0xd0, 0x34, 0x9a, 0x3b, 0xb5,
-	0x34, 0x93, 0x0c, 0x29, 0x5c, 0x92, 0x3b, 0xa7, 0x86, 0x9f, 0xfc, 0x92, 0x88, 0x7c, 0xf1, 0xe7,
-	0x57, 0xe7, 0x51, 0x46, 0x78, 0xcb, 0x16, 0x9c, 0x65, 0xf0, 0x6f, 0xa9, 0x45, 0x53, 0x53, 0x1d,
-	0x62, 0xaf, 0x79, 0xfc, 0xdf, 0xb0, 0xb6, 0x88, 0xc8, 0x03, 0xaf, 0xc3, 0xd8, 0x8e, 0xbb, 0x27,
-	0xab, 0x6a, 0x9a, 0xad, 0x53, 0x1e, 0x7b, 0x38, 0x15, 0xfb, 0xe1, 0xeb, 0x85, 0x71, 0x11, 0x7e,
-	0x95, 0xaf, 0xdc, 0x71, 0x6c, 0xd3, 0x32, 0x32, 0xa3, 0x35, 0x17, 0x61, 0x97, 0xff, 0x88, 0xc2,
-	0xb9, 0x56, 0x01, 0x05, 0xc5, 0x34, 0x8c, 0x92, 0xb2, 0x6e, 0x77, 0x14, 0x70, 0xc4, 0xf5, 0x10,
-	0x66, 0xfc, 0x10, 0xc1, 0x18, 0xd5, 0x8b, 0x5b, 0xd9, 0x1c, 0xb1, 0xb4, 0xac, 0xad, 0xef, 0xaa,
-	0xb6, 0x46, 0x63, 0xd1, 0xe9, 0x81, 0xb9, 0x53, 0x4b, 0x53, 0x6e, 0xcd, 0xaa, 0xfd, 0xae, 0xd5,
-	0x6a, 0x4d, 0xcf, 0xa7, 0x89, 0x69, 0xa5, 0x2e, 0x55, 0x8b, 0xf5, 0xe5, 0xaf, 0x89, 0x79, 0xc3,
-	0x74, 0x0a, 0x95, 0x5c, 0x32, 0x4f, 0x4a, 0xa2, 0x85, 0xe2, 0xd7, 0x02, 0xd5, 0xde, 0x57, 0x9c,
-	0xbd, 0xb2, 0x4e, 0x5d, 0x1f, 0xca, 0x6b, 0x3b, 0x52, 0x0d, 0x98, 0x22, 0x96, 0x96, 0xe1, 0xe1,
-	0xf0, 0x36, 0x40, 0x9e, 0x94, 0x4a, 0x26, 0xa5, 0x26, 0xb1, 0x62, 0x03, 0x6d, 0x04, 0x5f, 0xee,
-	0x22, 0x78, 0xc6, 0x13, 0x44, 0x2e, 0xc3, 0xac, 0xbf, 0xcc, 0x37, 0x2b, 0x0e, 0x75, 0x54, 0x4b,
-	0xab, 0x56, 0x89, 0xa7, 0x75, 0xcc, 0x9d, 0xfd, 0x18, 0xc1, 0x5c, 0xeb, 0x90, 0xa2, 0xb7, 0xf7,
-	0xe0, 0xa4, 0xdb, 0x0b, 0x7e, 0x7e, 0x2f, 0x85, 0x9e, 0xdf, 0x10, 0x48, 0xef, 0xa1, 0x76, 0x31,
-	0xe5, 0x02, 0x24, 0xfc, 0xa9, 0xa4, 0x6b, 0x95, 0x39, 0x66, 0xd6, 0x9f, 0x20, 0x98, 0x6e, 0x1e,
-	0x4a, 0xb0, 0xdd, 0xf2, 0xf5, 0x9f, 0x13, 0x5e, 0x69, 0x8f, 0xf0, 0x6a, 0x3e, 0x5f, 0x29, 0x55,
-	0x8a, 0xaa, 0xa3, 0x6b, 0x75, 0x60, 0x2f, 0x67, 0x6f, 0xd3, 0x3f, 0x8a, 0xc2, 0x94, 0x3f, 0x99,
-	0x3b, 0x45, 0x95, 0x16, 0xf4, 0x63, 0x6e, 0x35, 0x9e, 0x85, 0x11, 0xea, 0xa8, 0xb6, 0x63, 0x5a,
-	0x46, 0xb6, 0xa0, 0x9b, 0x46, 0xc1, 0x89, 0x45, 0xa7, 0xd1, 0xdc, 0x60, 0xe6, 0x39, 0xd7, 0x7c,
-	0x95, 0x59, 0xf1, 0x0c, 0x9c, 0xd6, 0x59, 0xb3, 0xdc, 0x6d, 0x03, 0x6c, 0xdb, 0xff, 0xb9, 0x51,
-	0x6c, 0xda, 0x00, 0xa8, 0xdf, 0xde, 0xb1, 0x41, 0x56, 0x9d, 0x73, 0xbe, 0xe9, 0xe0, 0x0f, 0x44,
-	0xfd, 0x32, 0x33, 0x74, 0x41, 0x28, 0xe3, 0xf1, 0xbc, 0xfc, 0xbf, 0x47, 0xfb, 0x89, 0xc8, 0xe3,
-	0xfd, 0x04, 0x92, 0xbf, 0x43, 0xf0, 0x42, 0x93, 0x3a, 0x88, 0x8e, 0xbc, 0x09, 0x27, 0x29, 0x37,
-	0xc5, 0x10, 0x1b, 0xc7, 0x0b, 0xed, 0xb5, 0x83, 0xe1, 0xac, 0xef, 0xe8, 0x96, 0xe3, 0x3b, 0x77,
-	0x02, 0x0b, 0xbf, 0xee, 0xa3, 0x12, 0x65, 0x54, 0x66, 0x5b, 0x52, 0xe1, 0x39, 0x79, 0xb9, 0xc8,
-	0xdf, 0xba, 0x0c, 0xd6, 0xf4, 0xa2, 0x6e, 0x30, 0x5b, 0xe3, 0xd4, 0x6a, 0x7c, 0xad, 0x93, 0x56,
-	0xd6, 0x5c, 0xdc, 0x56, 0x06, 0x9e, 0x88, 0x68, 0xa7, 0x27, 0x82, 0xd7, 0xfe, 0xd9, 0x7e, 0x22,
-	0x22, 0x7f, 0x86, 0x20, 0xde, 0x2c, 0x73, 0x51, 0xfc, 0xb2, 0x77, 0xf8, 0xfb, 0x79, 0x11, 0xd7,
-	0xee, 0x83, 0x0a, 0xc8, 0x47, 0x72, 0xba, 0x4b, 0x1c, 0xb5, 0xd8, 0x97, 0x92, 0x7a, 0x6a, 0xf1,
-	0x0f, 0x82, 0x99, 0xd0, 0xb8, 0xa2, 0x20, 0xef, 0x1e, 0x2d, 0xc8, 0xc5, 0xd0, 0xd3, 0x58, 0x47,
-	0x5b, 0x73, 0x63, 0x73, 0xc4, 0xa0, 0xbb, 0x10, 0x17, 0xe1, 0x84, 0x53, 0x0d, 0xda, 0xe7, 0x47,
-	0x8f, 0x07, 0x91, 0x6d, 0x71, 0xf3, 0xd6, 0x32, 0xab, 0x8d, 0x4e, 0xff, 0xca, 0x7c, 0x5d, 0x5c,
-	0xc1, 0x81, 0x31, 0x45, 0x89, 0xe3, 0x00, 0xb5, 0x43, 0xcb, 0xab, 0x3c, 0x9c, 0xf1, 0x58, 0x3c,
-	0x68, 0xbb, 0xf0, 0xa2, 0x1f, 0x6d, 0xd3, 0x74, 0x0a, 0x9a, 0xad, 0xee, 0x8a, 0xc0, 0x7d, 0xa3,
-	0xb1, 0x23, 0xa4, 0x58, 0xf3, 0xc0, 0x75, 0x61, 0xb4, 0x2b, 0x96, 0xda, 0x17, 0x46, 0xbb, 0x7e,
-	0x30, 0x4f, 0xdc, 0x49, 0x98, 0x60, 0x71, 0xab, 0xef, 0x4b, 0xc5, 0x32, 0x9d, 0xbd, 0x5b, 0x84,
-	0x14, 0x5d, 0xf9, 0xf9, 0x08, 0x81, 0x14, 0xb4, 0x2a, 0x52, 0x79, 0x0f, 0x06, 0xcb, 0x84, 0x14,
-	0xfb, 0x3c, 0xc7, 0x2c, 0xc6, 0xd2, 0xf7, 0x63, 0x70, 0x82, 0xa5, 0x82, 0x1f, 0x23, 0x18, 0xe2,
-	0x92, 0x16, 0x2b, 0xa1, 0x93, 0xd2, 0xa8, 0xa7, 0xa5, 0x0b, 0xed, 0x3b, 0x70, 0x8e, 0xf2, 0xfc,
-	0xc3, 0x1f, 0x7f, 0xff, 0x3c, 0x7a, 0x16, 0xcf, 0x28, 0x61, 0xf2, 0x9f, 0xeb, 0x69, 0xfc, 0x17,
-	0x82, 0x89, 0xa6, 0xd2, 0x16, 0xa7, 0x5a, 0x07, 0x6f, 0x25, 0xc4, 0xa5, 0x74, 0x4f, 0x18, 0x82,
-	0x53, 0x9a, 0x71, 0xba, 0x82, 0x57, 0x42, 0x39, 0xd5, 0xe7, 0x43, 0xb9, 0xdf, 0xf0, 0x4a, 0x3c,
-	0xc0, 0x1f, 0x46, 0x61, 0x32, 0x44, 0x99, 0xe1, 0xb5, 0x0e, 0x32, 0x6d, 0x2a, 0x4f, 0xa5, 0xf5,
-	0x1e, 0x51, 0x04, 0xe3, 0x4d, 0xc6, 0xf8, 0x36, 0xbe, 0xd9, 0x03, 0x63, 0x85, 0xd4, 0xf1, 0xdd,
-	0xbf, 0x25, 0xf0, 0x01, 0x82, 0x33, 0x01, 0xe2, 0x0f, 0xbf, 0xd2, 0x41, 0xde, 0x0d, 0xf2, 0x54,
-	0xba, 0xd2, 0xa5, 0xb7, 0x60, 0x7b, 0x83, 0xb1, 0xbd, 0x8a, 0x37, 0x7a, 0x61, 0x5b, 0x57, 0x96,
-	0xf8, 0x27, 0x04, 0xa3, 0x47, 0xc5, 0x14, 0x7e, 0xb9, 0x83, 0x1c, 0xfd, 0x42, 0x54, 0xba, 0xdc,
-	0x8d, 0xab, 0xe0, 0x76, 0x8d, 0x71, 0x5b, 0xc7, 0xe9, 0x5e, 0xb8, 0xb9, 0x8a, 0xed, 0x6f, 0x04,
-	0x63, 0x0d, 0x4a, 0x05, 0xb7, 0x91, 0x5e, 0x33, 0x61, 0x26, 0xad, 0x74, 0xe5, 0x2b, 0xb8, 0x65,
-	0x19, 0xb7, 0xb7, 0xf1, 0x66, 0x28, 0xb7, 0xda, 0x23, 0x42, 0x95, 0xfb, 0x0d, 0x6f, 0xd0, 0x03,
-	0x45, 0x9c, 0xcc, 0xc0, 0x99, 0x7d, 0x86, 0xe0, 0xf9, 0x60, 0x35, 0x82, 0x5f, 0xed, 0x24, 0xf1,
-	0x00, 0xfd, 0x24, 0xbd, 0xd6, 0x3d, 0x40, 0x47, 0xad, 0x6d, 0x8f, 0x3e, 0x1b, 0xcc, 0x00, 0x49,
-	0xd0, 0xce, 0x60, 0x36, 0x57, 0x2f, 0xed, 0x0c, 0x66, 0x88, 0x0e, 0x69, 0x73, 0x30, 0x5b, 0x30,
-	0xac, 0x9f, 0x6d, 0xfc, 0x2f, 0x82, 0x58, 0x33, 0xc1, 0x80, 0x57, 0x3b, 0xc8, 0x35, 0x58, 0xe5,
-	0x48, 0xa9, 0x5e, 0x20, 0x04, 0xe7, 0xbb, 0x8c, 0xf3, 0x0d, 0x7c, 0xbd, 0x17, 0xce, 0x47, 0x15,
-	0x0f, 0xfe, 0x06, 0xc1, 0x69, 0x9f, 0x28, 0xc1, 0x17, 0x5b, 0xe7, 0x1a, 0xa4, 0x71, 0xa4, 0x97,
-	0x3a, 0xf6, 0x13, 0xc4, 0x96, 0x19, 0xb1, 0x05, 0x3c, 0x1f, 0x4a, 0x2c, 0xef, 0xfa, 0x66, 0xab,
-	0x32, 0x26, 0x75, 0xed, 0xc9, 0x41, 0x1c, 0x3d, 0x3d, 0x88, 0xa3, 0xdf, 0x0e, 0xe2, 0xe8, 0xd3,
-	0xc3, 0x78, 0xe4, 0xe9, 0x61, 0x3c, 0xf2, 0xf3, 0x61, 0x3c, 0xf2, 0xce, 0x62, 0xa8, 0x30, 0xfa,
-	0xc0, 0x8f, 0xce, 0x74, 0x52, 0x6e, 0x88, 0xfd, 0xeb, 0x70, 0xf9, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x58, 0xdf, 0x9a, 0x20, 0x60, 0x15, 0x00, 0x00,
+	// 1493 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4d, 0x6c, 0x13, 0xc7,
+	0x17, 0xcf, 0x38, 0xe1, 0xeb, 0xf1, 0x11, 0x32, 0x20, 0xe4, 0x6c, 0xf2, 0x77, 0xa2, 0xcd, 0x1f,
+	0x12, 0x91, 0x26, 0x0b, 0xa1, 0x50, 0x3e, 0x8a, 0xda, 0xd8, 0x49, 0x0a, 0x05, 0xf1, 0x61, 0x68,
+	0x51, 0x5b, 0x21, 0x6b, 0xed, 0xdd, 0x38, 0x5b, 0xec, 0x19, 0xb3, 0x3b, 0x9b, 0x80, 0x10, 0x17,
+	0xaa, 0x4a, 0xb4, 0xa7, 0xaa, 0xbd, 0xd0, 0x1b, 0xc7, 0xaa, 0xa7, 0x1e, 0x8a, 0x7a, 0xaa, 0xb8,
+	0x72, 0x44, 0xad, 0x54, 0xf5, 0xd4, 0x56, 0xa1, 0x55, 0xe9, 0xa1, 0x52, 0x6f, 0xbd, 0x56, 0x9e,
+	0x99, 0x5d, 0xef, 0x26, 0xeb, 0xb5, 0xd7, 0x4e, 0x2e, 0x60, 0xde, 0xce, 0xfb, 0xbd, 0xdf, 0xef,
+	0xbd, 0xf9, 0x78, 0x4f, 0xc0, 0x78, 0x89, 0x3a, 0x55, 0xea, 0x68, 0x86, 0xe5, 0x30, 0xdb, 0x2a,
+	0xba, 0xcc, 0xa2, 0x44, 0x5b, 0x3e, 0x5a, 0x34, 0x99, 0x7e, 0x54, 0xbb, 0xed, 0x9a, 0xf6, 0xdd,
+	0xe9, 0x9a, 0x4d, 0x19, 0xc5, 0x43, 0x62, 0xe1, 0x74, 0x70, 0xe1, 0xb4, 0x5c, 0xa8, 0x1c, 0x96,
+	0x28, 0x45, 0xdd, 0x31, 0x85, 0x97, 0x8f, 0x51, 0xd3, 0xcb, 0x16, 0xd1, 0xf9, 0x6a, 0x0e, 0xa4,
+	0xec, 0x2f, 0xd3, 0x32, 0xe5, 0x3f, 0xb5, 0xfa, 0x2f, 0x69, 0x1d, 0x2e, 0x53, 0x5a, 0xae, 0x98,
+	0x9a, 0x5e, 0xb3, 0x34, 0x9d, 0x10, 0xca, 0xb8, 0x8b, 0x23, 0xbf, 0x66, 0x82, 0xf8, 0x1e, 0x72,
+	0x89, 0x5a, 0x1e, 0xe6, 0x74, 0x9c, 0x8a, 0x10, 0x63, 0xb1, 0x7e, 0x50, 0xac, 0x2f, 0x08, 0x1a,
+	0x52, 0x99, 0xf8, 0x34, 0xa0, 0x57, 0x2d, 0x42, 0x35, 0xfe, 0xa7, 0x30, 0xa9, 0xfb, 0x01, 0x5f,
+	0xad, 0x6b, 0xba, 0xa2, 0xdb, 0x7a, 0xd5, 0xc9, 0x9b, 0xb7, 0x5d, 0xd3, 0x61, 0xea, 0x4d, 0xd8,
+	0x17, 0xb2, 0x3a, 0x35, 0x4a, 0x1c, 0x13, 0x2f, 0xc0, 0xd6, 0x1a, 0xb7, 0xa4, 0xd1, 0x28, 0x9a,
+	0xd8, 0x39, 0x33, 0x36, 0x1d, 0x93, 0xb8, 0x69, 0xe1, 0x9c, 0xdd, 0xf1, 0xec, 0x97, 0x91, 0x9e,
+	0xaf, 0xfe, 0xfc, 0xe6, 0x30, 0xca, 0x4b, 0x6f, 0x95, 0xc0, 0x41, 0x0e, 0xff, 0xae, 0x5e, 0xb1,
+	0x0c, 0x9d, 0x51, 0x7b, 0x2e, 0xe0, 0x7f, 0x9e, 0x2c, 0x52, 0xc9, 0x03, 0xcf, 0xc3, 0xc0, 0xb2,
+	0xb7, 0xa6, 0xa0, 0x1b, 0x86, 0x6d, 0x3a, 0x22, 0xf6, 0x8e, 0x6c, 0xfa, 0x87, 0x6f, 0xa7, 0xf6,
+	0xcb, 0xf0, 0xb3, 0xe2, 0xcb, 0x35, 0x66, 0x5b, 0xa4, 0x9c, 0xdf, 0xeb, 0xbb, 0x48, 0xbb, 0xfa,
+	0x47, 0x0a, 0x0e, 0xb5, 0x0a, 0x28, 0x25, 0xe6, 0x60, 0x2f, 0xad, 0x99, 0x76, 0xa2, 0x80, 0xfd,
+	0x9e, 0x87, 0x34, 0xe3, 0x07, 0x08, 0x06, 0x1c, 0xb3, 0xb2, 0x58, 0x28, 0x52, 0x62, 0x14, 0x6c,
+	0x73, 0x45, 0xb7, 0x0d, 0x27, 0x9d, 0x1a, 0xed, 0x9d, 0xd8, 0x39, 0x33, 0xec, 0xe5, 0xac, 0x5e,
+	0x6f, 0x3f, 0x57, 0x73, 0x66, 0x29, 0x47, 0x2d, 0x92, 0x3d, 0x59, 0x4f, 0xd6, 0xd7, 0xbf, 0x8e,
+	0x4c, 0x96, 0x2d, 0xb6, 0xe4, 0x16, 0xa7, 0x4b, 0xb4, 0x2a, 0x4b, 0x28, 0xff, 0x9a, 0x72, 0x8c,
+	0x5b, 0x1a, 0xbb, 0x5b, 0x33, 0x1d, 0xcf, 0xc7, 0x11, 0xb9, 0xed, 0xaf, 0x07, 0xcc, 0x52, 0x62,
+	0xe4, 0x45, 0x38, 0x7c, 0x1b, 0xa0, 0x44, 0xab, 0x55, 0xcb, 0x71, 0x2c, 0x4a, 0xd2, 0xbd, 0x6d,
+	0x04, 0x3f, 0xd6, 0x41, 0xf0, 0x7c, 0x20, 0x88, 0x5a, 0x83, 0xf1, 0x70, 0x9a, 0x2f, 0xbb, 0xcc,
+	0x61, 0x3a, 0x31, 0xea, 0x59, 0x12, 0xb4, 0x36, 0xb8, 0xb2, 0x9f, 0x20, 0x98, 0x68, 0x1d, 0x52,
+	0xd6, 0xf6, 0x26, 0x6c, 0xf3, 0x6a, 0x21, 0xf6, 0xef, 0xc9, 0xd8, 0xfd, 0x1b, 0x03, 0x19, 0xdc,
+	0xd4, 0x1e, 0xa6, 0xba, 0x04, 0x23, 0x61, 0x2a, 0x39, 0x3f, 0x33, 0x1b, 0xac, 0xfa, 0x53, 0x04,
+	0xa3, 0xcd, 0x43, 0x49, 0xb5, 0x8b, 0xa1, 0xfa, 0x0b, 0xc1, 0x67, 0xda, 0x13, 0x3c, 0x5b, 0x2a,
+	0xb9, 0x55, 0xb7, 0xa2, 0x33, 0xd3, 0x68, 0x00, 0x07, 0x35, 0x07, 0x8b, 0xfe, 0x71, 0x0a, 0x86,
+	0xc3, 0x64, 0xae, 0x55, 0x74, 0x67, 0xc9, 0xdc, 0xe0, 0x52, 0xe3, 0x71, 0xe8, 0x77, 0x98, 0x6e,
+	0x33, 0x8b, 0x94, 0x0b, 0x4b, 0xa6, 0x55, 0x5e, 0x62, 0xe9, 0xd4, 0x28, 0x9a, 0xe8, 0xcb, 0xef,
+	0xf1, 0xcc, 0xe7, 0xb8, 0x15, 0x8f, 0xc1, 0x6e, 0x93, 0x18, 0x81, 0x65, 0xbd, 0x7c, 0xd9, 0x2e,
+	0x61, 0x94, 0x8b, 0x16, 0x00, 0x1a, 0xb7, 0x77, 0xba, 0x8f, 0x67, 0xe7, 0x50, 0xe8, 0x74, 0x88,
+	0x07, 0xa2, 0x71, 0x99, 0x95, 0x4d, 0x29, 0x28, 0x1f, 0xf0, 0x3c, 0xbd, 0xfd, 0xe1, 0xe3, 0x91,
+	0x9e, 0x47, 0x8f, 0x47, 0x90, 0xfa, 0x14, 0xc1, 0xff, 0x9a, 0xe4, 0x41, 0x56, 0xe4, 0x1d, 0xd8,
+	0xe6, 0x08, 0x53, 0x1a, 0xf1, 0xe3, 0x78, 0xa4, 0xbd, 0x72, 0x70, 0x9c, 0xf9, 0x65, 0x93, 0xb0,
+	0xd0, 0xbe, 0x93, 0x58, 0xf8, 0xad, 0x90, 0x94, 0x14, 0x97, 0x32, 0xde, 0x52, 0x8a, 0xe0, 0x14,
+	0xd4, 0xa2, 0x7e, 0xe7, 0x29, 0x98, 0x33, 0x2b, 0x66, 0x99, 0xdb, 0xd6, 0x9f, 0x5a, 0x43, 0x7c,
+	0x4b, 0x52, 0x4a, 0xdf, 0xc5, 0x2b, 0x65, 0xe4, 0x8e, 0x48, 0x25, 0xdd, 0x11, 0x22, 0xf7, 0x2f,
+	0x1f, 0x8f, 0xf4, 0xa8, 0x9f, 0x23, 0xc8, 0x34, 0x63, 0x2e, 0x93, 0x5f, 0x0b, 0x1e, 0xfe, 0xcd,
+	0xbc, 0x88, 0xfd, 0xfb, 0xc0, 0x05, 0x75, 0x0d, 0xa7, 0xeb, 0x94, 0xe9, 0x95, 0x4d, 0x49, 0x69,
+	0x20, 0x17, 0xff, 0x20, 0x18, 0x8b, 0x8d, 0x2b, 0x13, 0xf2, 0xc1, 0xda, 0x84, 0x9c, 0x88, 0xdd,
+	0x8d, 0x0d, 0xb4, 0x39, 0x2f, 0xb6, 0x40, 0x8c, 0xba, 0x0b, 0x71, 0x05, 0xb6, 0xb0, 0x7a, 0xd0,
+	0x4d, 0x7e, 0xf4, 0x44, 0x10, 0xd5, 0x96, 0x37, 0xaf, 0xcf, 0xcc, 0x3f, 0x3a, 0x9b, 0x97, 0xe6,
+	0x8b, 0x30, 0xda, 0x3c, 0xa6, 0x4c, 0x71, 0x06, 0xc0, 0xdf, 0xb4, 0x22, 0xcb, 0x3b, 0xf2, 0x01,
+	0x4b, 0x00, 0x6d, 0x05, 0xfe, 0x1f, 0x46, 0xbb, 0x61, 0xb1, 0x25, 0xc3, 0xd6, 0x57, 0x64, 0xe0,
+	0x4d, 0x93, 0xb1, 0x0c, 0x07, 0x5b, 0x04, 0x6e, 0x34, 0x46, 0x2b, 0xf2, 0x53, 0xfb, 0x8d, 0xd1,
+	0x4a, 0x18, 0x2c, 0x10, 0x77, 0x08, 0x06, 0x79, 0xdc, 0xfa, 0xfb, 0xe2, 0x12, 0x8b, 0xdd, 0xbd,
+	0x42, 0x69, 0xc5, 0x6b, 0x3f, 0x1f, 0x22, 0x50, 0xa2, 0xbe, 0x4a, 0x2a, 0x1f, 0x42, 0x5f, 0x8d,
+	0xd2, 0xca, 0x26, 0x9f, 0x63, 0x1e, 0x43, 0xbd, 0x05, 0x69, 0xce, 0x64, 0xd6, 0x65, 0x34, 0x47,
+	0xab, 0x35, 0xea, 0x12, 0x43, 0xd2, 0xdc, 0xf8, 0x62, 0xdc, 0x84, 0xc1, 0x88, 0x60, 0x52, 0x75,
+	0x1a, 0xb6, 0x99, 0x44, 0x2f, 0x56, 0x4c, 0x83, 0xc7, 0xd8, 0x9e, 0xf7, 0xfe, 0x89, 0x0f, 0x41,
+	0x3f, 0x31, 0xef, 0xb0, 0x82, 0xed, 0x92, 0xe0, 0xcb, 0xd8, 0x9b, 0xdf, 0x5d, 0x37, 0xe7, 0x5d,
+	0x22, 0xde, 0x3c, 0xf5, 0x15, 0x3f, 0xab, 0x84, 0x59, 0xc4, 0xa5, 0xae, 0xb3, 0x10, 0x50, 0xb3,
+	0x07, 0x52, 0x96, 0x80, 0xee, 0xcb, 0xa7, 0x2c, 0x43, 0xb5, 0x60, 0x28, 0x72, 0xb5, 0xa4, 0xf3,
+	0x36, 0xf4, 0x2d, 0xba, 0xc4, 0x90, 0x8d, 0xc5, 0x64, 0xec, 0xdd, 0x11, 0x86, 0x08, 0x5e, 0x18,
+	0x1c, 0x43, 0x35, 0x23, 0x43, 0xf9, 0x9b, 0x3e, 0xfc, 0x56, 0xa3, 0x4e, 0xdf, 0x6a, 0xf5, 0x09,
+	0x82, 0xe1, 0xe8, 0x38, 0x52, 0xd3, 0x45, 0xd8, 0x52, 0xe7, 0xe3, 0x5d, 0x88, 0x9d, 0x8a, 0x12,
+	0x20, 0x1b, 0xf6, 0x2e, 0xcf, 0x7c, 0x79, 0x00, 0xb6, 0x70, 0xde, 0xf8, 0x11, 0x82, 0xad, 0x62,
+	0xac, 0xc2, 0x5a, 0x2c, 0xb9, 0xf5, 0x33, 0x9d, 0x72, 0xa4, 0x7d, 0x07, 0xc1, 0x41, 0x9d, 0x7c,
+	0xf0, 0xe3, 0xef, 0x5f, 0xa4, 0x0e, 0xe2, 0x31, 0x2d, 0x6e, 0x04, 0x15, 0x33, 0x1d, 0xfe, 0x0b,
+	0xc1, 0x60, 0xd3, 0xf1, 0x0a, 0x67, 0x5b, 0x07, 0x6f, 0x35, 0x0c, 0x2a, 0xb9, 0xae, 0x30, 0xa4,
+	0xa6, 0x1c, 0xd7, 0x74, 0x16, 0x9f, 0x89, 0xd5, 0xd4, 0xb8, 0xa3, 0xb5, 0x7b, 0xeb, 0x3a, 0x95,
+	0xfb, 0xf8, 0xa3, 0x14, 0x0c, 0xc5, 0x4c, 0x07, 0x78, 0x2e, 0x01, 0xd3, 0xa6, 0x23, 0x92, 0x32,
+	0xdf, 0x25, 0x8a, 0x54, 0x7c, 0x83, 0x2b, 0xbe, 0x8a, 0x2f, 0x77, 0xa1, 0x58, 0xa3, 0x0d, 0x7c,
+	0x6f, 0x9e, 0xc5, 0xab, 0x08, 0xf6, 0x45, 0x0c, 0x20, 0xf8, 0xf5, 0x04, 0xbc, 0xd7, 0x8d, 0x48,
+	0xca, 0xd9, 0x0e, 0xbd, 0xa5, 0xda, 0x4b, 0x5c, 0xed, 0x39, 0xbc, 0xd0, 0x8d, 0xda, 0xc6, 0x74,
+	0x83, 0x7f, 0x42, 0xb0, 0x77, 0x6d, 0x43, 0x8f, 0x4f, 0x25, 0xe0, 0x18, 0x1e, 0x86, 0x94, 0xd3,
+	0x9d, 0xb8, 0x4a, 0x6d, 0x17, 0xb8, 0xb6, 0x79, 0x9c, 0xeb, 0x46, 0x9b, 0x37, 0x35, 0xfc, 0x8d,
+	0x60, 0x60, 0x5d, 0xb7, 0x8c, 0xdb, 0xa0, 0xd7, 0x6c, 0x38, 0x50, 0xce, 0x74, 0xe4, 0x2b, 0xb5,
+	0x15, 0xb8, 0xb6, 0xf7, 0xf0, 0x8d, 0x58, 0x6d, 0xfe, 0xdb, 0xe9, 0x68, 0xf7, 0xd6, 0x3d, 0xbd,
+	0xf7, 0x35, 0xb9, 0x33, 0x23, 0xcf, 0xec, 0x4b, 0x04, 0x07, 0xa2, 0x3b, 0x62, 0xfc, 0x46, 0x12,
+	0xe2, 0x11, 0x3d, 0xbc, 0xf2, 0x66, 0xe7, 0x00, 0x89, 0x4a, 0xdb, 0x9e, 0x7c, 0x7e, 0x30, 0x23,
+	0xda, 0xd2, 0x76, 0x0e, 0x66, 0xf3, 0x0e, 0x5a, 0x39, 0xdb, 0xa1, 0x77, 0xa2, 0x83, 0xd9, 0x42,
+	0x61, 0x63, 0x6f, 0xe3, 0x7f, 0x11, 0xa4, 0x9b, 0x35, 0xad, 0x78, 0x36, 0x01, 0xd7, 0xe8, 0x4e,
+	0x5b, 0xc9, 0x76, 0x03, 0x21, 0x35, 0x5f, 0xe7, 0x9a, 0x2f, 0xe1, 0x8b, 0xdd, 0x68, 0x5e, 0xdb,
+	0x75, 0xe3, 0x27, 0x08, 0x76, 0x87, 0x1a, 0x63, 0x7c, 0xa2, 0x35, 0xd7, 0xa8, 0x3e, 0x5b, 0x79,
+	0x2d, 0xb1, 0x9f, 0x14, 0x76, 0x8c, 0x0b, 0x9b, 0xc2, 0x93, 0xb1, 0xc2, 0x4a, 0x9e, 0x6f, 0xa1,
+	0xde, 0x4a, 0xe3, 0xe7, 0x08, 0x76, 0x05, 0x3b, 0x5b, 0x7c, 0xbc, 0x75, 0xf8, 0x88, 0xb6, 0x5b,
+	0x39, 0x91, 0xd4, 0x4d, 0x92, 0xbe, 0xca, 0x49, 0x5f, 0xc0, 0xe7, 0xbb, 0xa9, 0x86, 0xee, 0x32,
+	0x5a, 0x28, 0x79, 0x0a, 0x9e, 0x22, 0xd8, 0x13, 0xee, 0x03, 0x71, 0x5b, 0x39, 0x8d, 0xe8, 0xbf,
+	0x95, 0x93, 0xc9, 0x1d, 0xa5, 0xb0, 0xd3, 0x5c, 0xd8, 0xab, 0x78, 0xa6, 0x45, 0x35, 0x3c, 0xe7,
+	0x02, 0xef, 0x4f, 0xb5, 0x7b, 0x96, 0x71, 0x1f, 0x7f, 0x8f, 0xa0, 0x3f, 0x0c, 0xeb, 0xe0, 0xc4,
+	0x4c, 0xfc, 0x43, 0x73, 0xaa, 0x03, 0x4f, 0x29, 0xe2, 0x38, 0x17, 0xa1, 0xe1, 0xa9, 0x44, 0x22,
+	0xb2, 0x17, 0x9e, 0xad, 0x66, 0xd0, 0xf3, 0xd5, 0x0c, 0xfa, 0x6d, 0x35, 0x83, 0x3e, 0x7b, 0x91,
+	0xe9, 0x79, 0xfe, 0x22, 0xd3, 0xf3, 0xf3, 0x8b, 0x4c, 0xcf, 0xfb, 0x47, 0x63, 0x27, 0xbe, 0x3b,
+	0x61, 0x7c, 0x3e, 0x00, 0x16, 0xb7, 0xf2, 0xff, 0x13, 0x39, 0xf6, 0xdf, 0x00, 0x93, 0x7f, 0x4e,
+	0x84, 0x39, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1095,6 +1411,13 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries the auto-compounding setting of a delegator and the
+	// height at which its rewards are compounded next.
+	AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error)
+	// ContinuousFund queries a continuous fund by its id.
+	ContinuousFund(ctx context.Context, in *QueryContinuousFundRequest, opts ...grpc.CallOption) (*QueryContinuousFundResponse, error)
+	// ContinuousFunds queries all active continuous funds.
+	ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error)
 }
 
 type queryClient struct {
@@ -1195,6 +1518,33 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error) {
+	out := new(QueryAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContinuousFund(ctx context.Context, in *QueryContinuousFundRequest, opts ...grpc.CallOption) (*QueryContinuousFundResponse, error) {
+	out := new(QueryContinuousFundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ContinuousFund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error) {
+	out := new(QueryContinuousFundsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ContinuousFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1218,6 +1568,13 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries the auto-compounding setting of a delegator and the
+	// height at which its rewards are compounded next.
+	AutoCompound(context.Context, *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error)
+	// ContinuousFund queries a continuous fund by its id.
+	ContinuousFund(context.Context, *QueryContinuousFundRequest) (*QueryContinuousFundResponse, error)
+	// ContinuousFunds queries all active continuous funds.
+	ContinuousFunds(context.Context, *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1254,6 +1611,15 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) AutoCompound(ctx context.Context, req *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompound not implemented")
+}
+func (*UnimplementedQueryServer) ContinuousFund(ctx context.Context, req *QueryContinuousFundRequest) (*QueryContinuousFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFund not implemented")
+}
+func (*UnimplementedQueryServer) ContinuousFunds(ctx context.Context, req *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFunds not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1439,6 +1805,60 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompound(ctx, req.(*QueryAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContinuousFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContinuousFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ContinuousFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContinuousFund(ctx, req.(*QueryContinuousFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContinuousFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContinuousFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContinuousFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ContinuousFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContinuousFunds(ctx, req.(*QueryContinuousFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1483,6 +1903,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCompound",
+			Handler:    _Query_AutoCompound_Handler,
+		},
+		{
+			MethodName: "ContinuousFund",
+			Handler:    _Query_ContinuousFund_Handler,
+		},
+		{
+			MethodName: "ContinuousFunds",
+			Handler:    _Query_ContinuousFunds_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2196,27 +2628,240 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
+func (m *QueryAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRunHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextRunHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContinuousFundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContinuousFundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContinuousFundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContinuousFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContinuousFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContinuousFundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContinuousFundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContinuousFundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContinuousFundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContinuousFundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContinuousFundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2499,6 +3144,89 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.NextRunHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextRunHeight))
+	}
+	return n
+}
+
+func (m *QueryContinuousFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryContinuousFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fund.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContinuousFundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContinuousFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4336,6 +5064,535 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunHeight", wireType)
+			}
+			m.NextRunHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRunHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContinuousFundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContinuousFundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContinuousFundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContinuousFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContinuousFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContinuousFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContinuousFundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContinuousFundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContinuousFundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContinuousFundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContinuousFundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContinuousFundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, ContinuousFund{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
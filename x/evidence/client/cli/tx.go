@@ -1,10 +1,19 @@
 package cli
 
 import (
-	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"fmt"
+	"os"
+	"strings"
 
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
 // GetTxCmd returns a CLI command that has all the native evidence module tx
@@ -22,11 +31,12 @@ func GetTxCmd(childCmds []*cobra.Command) *cobra.Command {
 	}
 
 	submitEvidenceCmd := SubmitEvidenceCmd()
+	submitEvidenceCmd.AddCommand(SubmitLightClientAttackEvidenceCmd())
 	for _, childCmd := range childCmds {
 		submitEvidenceCmd.AddCommand(childCmd)
 	}
 
-	// TODO: Add tx commands.
+	cmd.AddCommand(submitEvidenceCmd)
 
 	return cmd
 }
@@ -36,9 +46,76 @@ func GetTxCmd(childCmds []*cobra.Command) *cobra.Command {
 // under this command.
 func SubmitEvidenceCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "submit",
-		Short: "Submit arbitrary evidence of misbehavior",
+		Use:                        "submit",
+		Short:                      "Submit arbitrary evidence of misbehavior",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
 	}
 
 	return cmd
 }
+
+// SubmitLightClientAttackEvidenceCmd returns a CLI command handler for creating
+// a MsgSubmitEvidence transaction with light client attack evidence.
+func SubmitLightClientAttackEvidenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "light-client-attack [signed-header-file] [trusted-commit-file]",
+		Short: "Submit evidence of a light client attack",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit evidence of a light client attack, that is a header conflicting with
+the header committed at the same height, along with the commit of the
+validators who signed it, and the commit of the committed header in the same
+round. The signed header and the trusted commit are read from JSON files in the
+CometBFT encoding, as returned in the signed_header field of the RPC commit
+endpoint and in its commit field respectively.
+
+Example:
+$ %s tx %s submit light-client-attack signed_header.json trusted_commit.json --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var signedHeader tmtypes.SignedHeader
+			if err := tmjson.Unmarshal(bz, &signedHeader); err != nil {
+				return fmt.Errorf("failed to parse signed header: %w", err)
+			}
+
+			bz, err = os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var trustedCommit tmtypes.Commit
+			if err := tmjson.Unmarshal(bz, &trustedCommit); err != nil {
+				return fmt.Errorf("failed to parse trusted commit: %w", err)
+			}
+
+			evidence := &types.LightClientAttackEvidence{
+				ConflictingHeader: signedHeader.ToProto(),
+				TrustedCommit:     trustedCommit.ToProto(),
+			}
+			msg, err := types.NewMsgSubmitEvidence(clientCtx.GetFromAddress(), evidence)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
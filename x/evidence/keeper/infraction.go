@@ -1,10 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -50,6 +53,17 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		}
 	}
 
+	// the infraction may have been committed with a consensus key the
+	// validator has since rotated away from, signing info is tracked under the
+	// validator's current key
+	if !validator.GetOperator().Empty() {
+		currentConsAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+		consAddr = currentConsAddr
+	}
+
 	// calculate the age of the evidence
 	infractionHeight := evidence.GetHeight()
 	infractionTime := evidence.GetTime()
@@ -126,3 +140,156 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 	k.slashingKeeper.Tombstone(ctx, consAddr)
 	k.SetEvidence(ctx, evidence)
 }
+
+// HandleLightClientAttackEvidence implements a light client attack evidence
+// handler. The trusted commit is verified to commit the header tracked by the
+// staking historical info at the height of the conflicting header, and every
+// validator of the historical validator set with valid signatures of both the
+// committed and the conflicting block, in the same round, is handled as having
+// committed an equivocation, that is slashed, jailed and tombstoned. Under the
+// CometBFT locking rules, validators can legitimately precommit different
+// blocks in different rounds, so signing both blocks in the same round is
+// required to prove an equivocation. Signatures that do not verify are
+// skipped.
+//
+// The evidence is considered invalid if:
+// - it fails its stateless validation, e.g. the commits are of different rounds
+// - the conflicting header is of another chain
+// - the historical info at the conflicting header height does not exist
+// - the trusted commit does not commit the historical header
+// - the conflicting header is the committed header
+// - no validator of the historical validator set signed both blocks
+func (k Keeper) HandleLightClientAttackEvidence(ctx sdk.Context, evidence *types.LightClientAttackEvidence) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+
+	signedHeader, err := tmtypes.SignedHeaderFromProto(evidence.ConflictingHeader)
+	if err != nil {
+		return err
+	}
+
+	trustedCommit, err := tmtypes.CommitFromProto(evidence.TrustedCommit)
+	if err != nil {
+		return err
+	}
+
+	if signedHeader.ChainID != ctx.ChainID() {
+		return fmt.Errorf("conflicting header chain ID %s does not match chain ID %s", signedHeader.ChainID, ctx.ChainID())
+	}
+
+	historicalInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, signedHeader.Height)
+	if !found {
+		return fmt.Errorf("no historical info found at conflicting header height %d", signedHeader.Height)
+	}
+
+	trustedHeader, err := tmtypes.HeaderFromProto(&historicalInfo.Header)
+	if err != nil {
+		return err
+	}
+
+	// a header without validators hash has no hash, no commit can be verified
+	// to commit it
+	trustedHash := trustedHeader.Hash()
+	if len(trustedHash) == 0 {
+		return fmt.Errorf("committed header at height %d has no hash", signedHeader.Height)
+	}
+	if !bytes.Equal(trustedCommit.BlockID.Hash, trustedHash) {
+		return fmt.Errorf("trusted commit does not commit the header committed at height %d", signedHeader.Height)
+	}
+	if bytes.Equal(signedHeader.Hash(), trustedHash) {
+		return fmt.Errorf("conflicting header at height %d matches the committed header", signedHeader.Height)
+	}
+
+	validators := make(map[string]stakingtypes.Validator, len(historicalInfo.Valset))
+	for _, validator := range historicalInfo.Valset {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		validators[consAddr.String()] = validator
+	}
+
+	trustedSigners := make(map[string]bool)
+	for _, consAddr := range k.verifiedCommitSigners(ctx, signedHeader.ChainID, trustedCommit, validators) {
+		trustedSigners[consAddr.String()] = true
+	}
+
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	var equivocations []*types.Equivocation
+	for _, consAddr := range k.verifiedCommitSigners(ctx, signedHeader.ChainID, signedHeader.Commit, validators) {
+		if !trustedSigners[consAddr.String()] {
+			continue
+		}
+
+		validator := validators[consAddr.String()]
+		equivocations = append(equivocations, &types.Equivocation{
+			Height:           signedHeader.Height,
+			Time:             historicalInfo.Header.Time,
+			Power:            validator.ConsensusPower(powerReduction),
+			ConsensusAddress: consAddr.String(),
+		})
+	}
+
+	if len(equivocations) == 0 {
+		return fmt.Errorf("no validator signed both the committed and the conflicting block at height %d", signedHeader.Height)
+	}
+
+	k.Logger(ctx).Info(
+		"confirmed light client attack",
+		"height", signedHeader.Height,
+		"round", signedHeader.Commit.Round,
+		"signers", len(equivocations),
+	)
+
+	for _, equivocation := range equivocations {
+		k.HandleEquivocationEvidence(ctx, equivocation)
+	}
+
+	return nil
+}
+
+// verifiedCommitSigners returns the consensus addresses of the validators of
+// the given validator set whose signatures of the block of a commit are valid.
+// Signatures that do not verify are skipped, so that a single bad signature
+// cannot shield the other signers.
+func (k Keeper) verifiedCommitSigners(ctx sdk.Context, chainID string, commit *tmtypes.Commit, validators map[string]stakingtypes.Validator) []sdk.ConsAddress {
+	var signers []sdk.ConsAddress
+	for idx, sig := range commit.Signatures {
+		if !sig.ForBlock() {
+			continue
+		}
+
+		consAddr := sdk.ConsAddress(sig.ValidatorAddress)
+		validator, ok := validators[consAddr.String()]
+		if !ok {
+			continue
+		}
+
+		pubKey, err := validator.ConsPubKey()
+		if err != nil {
+			continue
+		}
+
+		signBytes := commit.VoteSignBytes(chainID, int32(idx))
+		if !pubKey.VerifySignature(signBytes, sig.Signature) {
+			k.Logger(ctx).Info("skipping invalid commit signature", "validator", consAddr, "height", commit.Height)
+			continue
+		}
+
+		signers = append(signers, consAddr)
+	}
+
+	return signers
+}
+
+// handleLightClientAttack is the Handler of the light client attack evidence
+// route.
+func (k Keeper) handleLightClientAttack(ctx sdk.Context, evidence exported.Evidence) error {
+	lightClientAttack, ok := evidence.(*types.LightClientAttackEvidence)
+	if !ok {
+		return fmt.Errorf("unexpected evidence type %T for route %s", evidence, types.RouteLightClientAttack)
+	}
+
+	return k.HandleLightClientAttackEvidence(ctx, lightClientAttack)
+}
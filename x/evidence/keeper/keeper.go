@@ -63,13 +63,17 @@ func (k *Keeper) SetRouter(rtr types.Router) {
 }
 
 // GetEvidenceHandler returns a registered Handler for a given Evidence type. If
-// no handler exists, an error is returned.
+// no handler exists, an error is returned. The light client attack route is
+// handled by the keeper unless a Handler is registered for it on the router.
 func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error) {
-	if !k.router.HasRoute(evidenceRoute) {
-		return nil, sdkerrors.Wrap(types.ErrNoEvidenceHandlerExists, evidenceRoute)
+	if k.router != nil && k.router.HasRoute(evidenceRoute) {
+		return k.router.GetRoute(evidenceRoute), nil
+	}
+	if evidenceRoute == types.RouteLightClientAttack {
+		return k.handleLightClientAttack, nil
 	}
 
-	return k.router.GetRoute(evidenceRoute), nil
+	return nil, sdkerrors.Wrap(types.ErrNoEvidenceHandlerExists, evidenceRoute)
 }
 
 // SubmitEvidence attempts to match evidence against the keepers router and execute
@@ -80,11 +84,11 @@ func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) erro
 	if _, ok := k.GetEvidence(ctx, evidence.Hash()); ok {
 		return sdkerrors.Wrap(types.ErrEvidenceExists, evidence.Hash().String())
 	}
-	if !k.router.HasRoute(evidence.Route()) {
-		return sdkerrors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
+	handler, err := k.GetEvidenceHandler(evidence.Route())
+	if err != nil {
+		return err
 	}
 
-	handler := k.router.GetRoute(evidence.Route())
 	if err := handler(ctx, evidence); err != nil {
 		return sdkerrors.Wrap(types.ErrInvalidEvidence, err.Error())
 	}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/version"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
@@ -20,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetestutil "github.com/cosmos/cosmos-sdk/x/evidence/testutil"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var (
@@ -216,7 +220,206 @@ func (suite *KeeperTestSuite) TestGetEvidenceHandler() {
 	suite.NoError(err)
 	suite.NotNil(handler)
 
+	handler, err = suite.evidenceKeeper.GetEvidenceHandler((&types.LightClientAttackEvidence{}).Route())
+	suite.NoError(err)
+	suite.NotNil(handler)
+
 	handler, err = suite.evidenceKeeper.GetEvidenceHandler("invalidHandler")
 	suite.Error(err)
 	suite.Nil(handler)
 }
+
+func (suite *KeeperTestSuite) TestSubmitLightClientAttackEvidence() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithChainID("test-chain").WithBlockHeight(10)
+
+	signer, otherSigner := ed25519.GenPrivKey(), ed25519.GenPrivKey()
+	var valset []stakingtypes.Validator
+	for _, key := range []cryptotypes.PrivKey{signer, otherSigner} {
+		validator, err := stakingtypes.NewValidator(sdk.ValAddress(key.PubKey().Address()), key.PubKey(), stakingtypes.Description{})
+		suite.Require().NoError(err)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+		valset = append(valset, validator)
+	}
+
+	trusted := tmproto.Header{
+		Version:         tmversion.Consensus{Block: version.BlockProtocol},
+		ChainID:         "test-chain",
+		Height:          5,
+		Time:            time.Now().UTC(),
+		ValidatorsHash:  tmhash.Sum([]byte("validators hash")),
+		ProposerAddress: signer.PubKey().Address(),
+	}
+	historicalInfo := stakingtypes.HistoricalInfo{Header: trusted, Valset: valset}
+
+	trustedCommit, err := evidencetestutil.TrustedCommit(trusted, 0, signer)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.LightClientAttackEvidence
+		expErr   bool
+	}{
+		{
+			"header of another chain",
+			func() *types.LightClientAttackEvidence {
+				header := trusted
+				header.ChainID = "other-chain"
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(header, 0, signer)
+				suite.Require().NoError(err)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: trustedCommit}
+			},
+			true,
+		},
+		{
+			"commits of different rounds",
+			func() *types.LightClientAttackEvidence {
+				// the signer locked on the conflicting block in round 1 after
+				// precommitting the committed block in round 0, which is not an
+				// equivocation
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(trusted, 1, signer)
+				suite.Require().NoError(err)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: trustedCommit}
+			},
+			true,
+		},
+		{
+			"no historical info",
+			func() *types.LightClientAttackEvidence {
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(trusted, 0, signer)
+				suite.Require().NoError(err)
+
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(ctx, trusted.Height).Return(stakingtypes.HistoricalInfo{}, false)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: trustedCommit}
+			},
+			true,
+		},
+		{
+			"trusted commit of another header",
+			func() *types.LightClientAttackEvidence {
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(trusted, 0, signer)
+				suite.Require().NoError(err)
+
+				other := trusted
+				other.AppHash = tmhash.Sum([]byte("other app hash"))
+				otherCommit, err := evidencetestutil.TrustedCommit(other, 0, signer)
+				suite.Require().NoError(err)
+
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(ctx, trusted.Height).Return(historicalInfo, true)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: otherCommit}
+			},
+			true,
+		},
+		{
+			"committed header",
+			func() *types.LightClientAttackEvidence {
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(trusted, 0, signer)
+				suite.Require().NoError(err)
+
+				committed := historicalInfo
+				committed.Header = *signedHeader.Header
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(ctx, trusted.Height).Return(committed, true)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: signedHeader.Commit}
+			},
+			true,
+		},
+		{
+			"not signed by any validator",
+			func() *types.LightClientAttackEvidence {
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(trusted, 0, ed25519.GenPrivKey())
+				suite.Require().NoError(err)
+
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(ctx, trusted.Height).Return(historicalInfo, true)
+				suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: trustedCommit}
+			},
+			true,
+		},
+		{
+			"conflicting block signer did not sign the committed block",
+			func() *types.LightClientAttackEvidence {
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(trusted, 0, otherSigner)
+				suite.Require().NoError(err)
+
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(ctx, trusted.Height).Return(historicalInfo, true)
+				suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: trustedCommit}
+			},
+			true,
+		},
+		{
+			"invalid signature",
+			func() *types.LightClientAttackEvidence {
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(trusted, 0, signer)
+				suite.Require().NoError(err)
+				signedHeader.Commit.Signatures[0].Signature[0] ^= 0xff
+
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(ctx, trusted.Height).Return(historicalInfo, true)
+				suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: trustedCommit}
+			},
+			true,
+		},
+		{
+			"invalid signature skipped",
+			func() *types.LightClientAttackEvidence {
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(trusted, 0, signer, otherSigner)
+				suite.Require().NoError(err)
+				signedHeader.Commit.Signatures[0].Signature[0] ^= 0xff
+
+				bothCommit, err := evidencetestutil.TrustedCommit(trusted, 0, signer, otherSigner)
+				suite.Require().NoError(err)
+
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(ctx, trusted.Height).Return(historicalInfo, true)
+				suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction)
+				// only the other signer is handled, it is not a validator
+				// anymore, the equivocation is ignored
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, sdk.ConsAddress(otherSigner.PubKey().Address())).Return(nil)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: bothCommit}
+			},
+			false,
+		},
+		{
+			"valid",
+			func() *types.LightClientAttackEvidence {
+				signedHeader, err := evidencetestutil.ConflictingSignedHeader(trusted, 0, signer)
+				suite.Require().NoError(err)
+
+				suite.stakingKeeper.EXPECT().GetHistoricalInfo(ctx, trusted.Height).Return(historicalInfo, true)
+				suite.stakingKeeper.EXPECT().PowerReduction(ctx).Return(sdk.DefaultPowerReduction)
+				// the signer is not a validator anymore, the equivocation is ignored
+				suite.stakingKeeper.EXPECT().ValidatorByConsAddr(ctx, sdk.ConsAddress(signer.PubKey().Address())).Return(nil)
+
+				return &types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: trustedCommit}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			e := tc.malleate()
+			err := suite.evidenceKeeper.SubmitEvidence(ctx, e)
+
+			res, ok := suite.evidenceKeeper.GetEvidence(ctx, e.Hash())
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, types.ErrInvalidEvidence)
+				suite.Require().False(ok)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().True(ok)
+				suite.Require().Equal(e, res)
+			}
+		})
+	}
+}
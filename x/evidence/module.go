@@ -110,7 +110,10 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 type AppModule struct {
 	AppModuleBasic
 
-	keeper keeper.Keeper
+	keeper        keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
 }
 
 // NewAppModule creates a new AppModule object.
@@ -121,6 +124,18 @@ func NewAppModule(keeper keeper.Keeper) AppModule {
 	}
 }
 
+// NewAppModuleWithKeepers creates a new AppModule object along with the
+// account, bank and staking keepers used by its simulation operations.
+func NewAppModuleWithKeepers(keeper keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		stakingKeeper:  sk,
+	}
+}
+
 var _ appmodule.AppModule = AppModule{}
 
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
@@ -181,9 +196,16 @@ func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simulation.NewDecodeStore(am.keeper)
 }
 
-// WeightedOperations returns the all the gov module operations with their respective weights.
+// WeightedOperations returns the all the evidence module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+	// the simulation operations need the keepers of NewAppModuleWithKeepers
+	if am.accountKeeper == nil || am.bankKeeper == nil || am.stakingKeeper == nil {
+		return nil
+	}
+
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.stakingKeeper,
+	)
 }
 
 //
@@ -202,6 +224,8 @@ type EvidenceInputs struct {
 	Key *store.KVStoreKey
 	Cdc codec.Codec
 
+	AccountKeeper  types.AccountKeeper
+	BankKeeper     types.BankKeeper
 	StakingKeeper  types.StakingKeeper
 	SlashingKeeper types.SlashingKeeper
 }
@@ -215,7 +239,7 @@ type EvidenceOutputs struct {
 
 func ProvideModule(in EvidenceInputs) EvidenceOutputs {
 	k := keeper.NewKeeper(in.Cdc, in.Key, in.StakingKeeper, in.SlashingKeeper)
-	m := NewAppModule(*k)
+	m := NewAppModuleWithKeepers(*k, in.AccountKeeper, in.BankKeeper, in.StakingKeeper)
 
 	return EvidenceOutputs{EvidenceKeeper: *k, Module: m}
 }
@@ -0,0 +1,199 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmtypes "github.com/cometbft/cometbft/types"
+	tmversion "github.com/cometbft/cometbft/version"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSubmitEvidence = "op_weight_msg_submit_evidence" //nolint:gosec
+
+	// evidence slashes and tombstones validators, keep it rare so that the
+	// simulation does not run out of validators
+	DefaultWeightMsgSubmitEvidence = 5
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, sk types.StakingKeeper,
+) simulation.WeightedOperations {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+
+	var weightMsgSubmitEvidence int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitEvidence, &weightMsgSubmitEvidence, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitEvidence = DefaultWeightMsgSubmitEvidence
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSubmitEvidence,
+			SimulateMsgSubmitEvidence(codec.NewProtoCodec(interfaceRegistry), ak, bk, sk),
+		),
+	}
+}
+
+// SimulateMsgSubmitEvidence generates a MsgSubmitEvidence with light client
+// attack evidence: a header conflicting with a header tracked in the staking
+// historical info, signed along with the tracked header by a random validator
+// of the historical validator set.
+func SimulateMsgSubmitEvidence(cdc *codec.ProtoCodec, ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		entries := int64(sk.GetParams(ctx).HistoricalEntries)
+		if entries == 0 || ctx.BlockHeight() < 1 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "no historical info tracked"), nil, nil // skip
+		}
+		if entries > ctx.BlockHeight() {
+			entries = ctx.BlockHeight()
+		}
+
+		height := ctx.BlockHeight() - r.Int63n(entries)
+		historicalInfo, found := sk.GetHistoricalInfo(ctx, height)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "historical info not found"), nil, nil // skip
+		}
+
+		validator, ok := testutil.RandSliceElem(r, historicalInfo.Valset)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "empty historical validator set"), nil, nil // skip
+		}
+
+		consPubKey, err := validator.ConsPubKey()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "unable to get validator consensus key"), nil, err
+		}
+
+		var signer simtypes.Account
+		for _, acc := range accs {
+			if acc.ConsKey != nil && acc.ConsKey.PubKey().Equals(consPubKey) {
+				signer = acc
+				break
+			}
+		}
+		if signer.ConsKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "unable to find validator consensus key"), nil, nil // skip
+		}
+
+		trustedHeader, err := tmtypes.HeaderFromProto(&historicalInfo.Header)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "invalid historical header"), nil, nil // skip
+		}
+		if len(trustedHeader.Hash()) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "historical header has no hash"), nil, nil // skip
+		}
+
+		trustedCommit, err := signCommit(trustedHeader, signer.ConsKey)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "unable to sign trusted commit"), nil, err
+		}
+
+		header := historicalInfo.Header
+		header.Version.Block = tmversion.BlockProtocol
+		header.ProposerAddress = consPubKey.Address().Bytes()
+		header.AppHash = tmhash.Sum([]byte(simtypes.RandStringOfLength(r, 32)))
+		if len(header.ValidatorsHash) == 0 {
+			header.ValidatorsHash = tmhash.Sum(header.AppHash)
+		}
+
+		conflictingHeader, err := tmtypes.HeaderFromProto(&header)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "invalid conflicting header"), nil, err
+		}
+
+		commit, err := signCommit(conflictingHeader, signer.ConsKey)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "unable to sign conflicting commit"), nil, err
+		}
+
+		signedHeader := tmtypes.SignedHeader{Header: &conflictingHeader, Commit: commit}
+		evidence := &types.LightClientAttackEvidence{
+			ConflictingHeader: signedHeader.ToProto(),
+			TrustedCommit:     trustedCommit.ToProto(),
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		account := ak.GetAccount(ctx, simAccount.Address)
+		if account == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "unable to find account"), nil, nil // skip
+		}
+
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "unable to generate fees"), nil, err
+		}
+
+		msg, err := types.NewMsgSubmitEvidence(simAccount.Address, evidence)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "unable to create msg"), nil, err
+		}
+
+		txGen := tx.NewTxConfig(cdc, tx.DefaultSignModes)
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			simtestutil.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "unable to generate mock tx"), nil, err
+		}
+
+		if _, _, err := app.SimDeliver(txGen.TxEncoder(), tx); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSubmitEvidence, "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
+
+// signCommit returns a commit of a header, at round 0, signed by the given
+// consensus key.
+func signCommit(header tmtypes.Header, consKey cryptotypes.PrivKey) (*tmtypes.Commit, error) {
+	commit := &tmtypes.Commit{
+		Height: header.Height,
+		BlockID: tmtypes.BlockID{
+			Hash:          header.Hash(),
+			PartSetHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum(header.AppHash)},
+		},
+		Signatures: []tmtypes.CommitSig{{
+			BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+			ValidatorAddress: consKey.PubKey().Address().Bytes(),
+			Timestamp:        header.Time,
+		}},
+	}
+
+	var err error
+	commit.Signatures[0].Signature, err = consKey.Sign(commit.VoteSignBytes(header.ChainID, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return commit, nil
+}
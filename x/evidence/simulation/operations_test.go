@@ -0,0 +1,137 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	"github.com/cosmos/cosmos-sdk/x/evidence/simulation"
+	"github.com/cosmos/cosmos-sdk/x/evidence/testutil"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	r        *rand.Rand
+	accounts []simtypes.Account
+
+	app               *runtime.App
+	codec             codec.Codec
+	interfaceRegistry codectypes.InterfaceRegistry
+	accountKeeper     authkeeper.AccountKeeper
+	bankKeeper        bankkeeper.Keeper
+	stakingKeeper     *stakingkeeper.Keeper
+	slashingKeeper    slashingkeeper.Keeper
+	evidenceKeeper    keeper.Keeper
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	suite.r = rand.New(rand.NewSource(1))
+	suite.accounts = simtypes.RandomAccounts(suite.r, 3)
+
+	// the genesis validator signs with the consensus key of the first account
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.ValidatorSet = func() (*tmtypes.ValidatorSet, error) {
+		tmPk, err := cryptocodec.ToTmPubKeyInterface(suite.accounts[0].ConsKey.PubKey())
+		if err != nil {
+			return nil, err
+		}
+
+		return tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(tmPk, 1)}), nil
+	}
+
+	app, err := simtestutil.SetupWithConfiguration(
+		testutil.AppConfig,
+		startupCfg,
+		&suite.codec,
+		&suite.interfaceRegistry,
+		&suite.accountKeeper,
+		&suite.bankKeeper,
+		&suite.stakingKeeper,
+		&suite.slashingKeeper,
+		&suite.evidenceKeeper,
+	)
+	suite.Require().NoError(err)
+	suite.app = app
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	initCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.stakingKeeper.TokensFromConsensusPower(ctx, 200)))
+	for _, account := range suite.accounts {
+		acc := suite.accountKeeper.NewAccountWithAddress(ctx, account.Address)
+		suite.accountKeeper.SetAccount(ctx, acc)
+		suite.Require().NoError(banktestutil.FundAccount(suite.bankKeeper, ctx, account.Address, initCoins))
+	}
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
+
+// TestWeightedOperations tests the weights of the operations.
+func (suite *SimTestSuite) TestWeightedOperations() {
+	appParams := make(simtypes.AppParams)
+
+	weightedOps := simulation.WeightedOperations(appParams, suite.codec, suite.accountKeeper, suite.bankKeeper, suite.stakingKeeper)
+	suite.Require().Len(weightedOps, 1)
+	suite.Require().Equal(simulation.DefaultWeightMsgSubmitEvidence, weightedOps[0].Weight())
+}
+
+// TestSimulateMsgSubmitEvidence tests the normal scenario of a valid message of
+// type types.MsgSubmitEvidence, the genesis validator signing a header
+// conflicting with the header of the current block.
+func (suite *SimTestSuite) TestSimulateMsgSubmitEvidence() {
+	// the header must be a valid header with a validators hash, for the
+	// committed block to have a hash that a trusted commit can sign
+	header := tmproto.Header{
+		Version:         tmversion.Consensus{Block: version.BlockProtocol},
+		Height:          suite.app.LastBlockHeight() + 1,
+		AppHash:         suite.app.LastCommitID().Hash,
+		Time:            time.Now().UTC(),
+		ValidatorsHash:  tmhash.Sum([]byte("validators hash")),
+		ProposerAddress: suite.accounts[0].ConsKey.PubKey().Address(),
+	}
+
+	// begin a new block, tracking its header in the staking historical info
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := suite.app.BaseApp.NewContext(false, header)
+
+	_, found := suite.stakingKeeper.GetHistoricalInfo(ctx, header.Height)
+	suite.Require().True(found)
+
+	consAddr := sdk.ConsAddress(suite.accounts[0].ConsKey.PubKey().Address())
+	suite.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+
+	op := simulation.SimulateMsgSubmitEvidence(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.stakingKeeper)
+	operationMsg, futureOperations, err := op(suite.r, suite.app.BaseApp, ctx, suite.accounts, "")
+	suite.Require().NoError(err)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal(types.TypeMsgSubmitEvidence, operationMsg.Name)
+	suite.Require().Len(futureOperations, 0)
+
+	// the light client attack evidence and the equivocation of its signer
+	suite.Require().Len(suite.evidenceKeeper.GetAllEvidence(ctx), 2)
+	suite.Require().True(suite.slashingKeeper.IsTombstoned(ctx, consAddr))
+}
@@ -0,0 +1,87 @@
+package testutil
+
+import (
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// ConflictingSignedHeader returns a header conflicting with the given trusted
+// header, at the same height and of the same chain, along with a commit of the
+// conflicting block at the given round signed by each of the given consensus
+// keys.
+func ConflictingSignedHeader(trusted tmproto.Header, round int32, signers ...cryptotypes.PrivKey) (*tmproto.SignedHeader, error) {
+	conflicting := trusted
+	conflicting.Version.Block = version.BlockProtocol
+	conflicting.AppHash = tmhash.Sum([]byte("conflicting app hash"))
+	if len(conflicting.ValidatorsHash) == 0 {
+		conflicting.ValidatorsHash = tmhash.Sum([]byte("conflicting validators hash"))
+	}
+	if len(conflicting.ProposerAddress) != tmhash.TruncatedSize && len(signers) > 0 {
+		conflicting.ProposerAddress = signers[0].PubKey().Address().Bytes()
+	}
+
+	header, err := tmtypes.HeaderFromProto(&conflicting)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := signCommit(header, round, signers)
+	if err != nil {
+		return nil, err
+	}
+
+	signedHeader := tmtypes.SignedHeader{Header: &header, Commit: commit}
+	return signedHeader.ToProto(), nil
+}
+
+// TrustedCommit returns a commit of the given trusted header at the given
+// round signed by each of the given consensus keys. The trusted header must be
+// a valid header with a validators hash, as a header without one has no hash.
+func TrustedCommit(trusted tmproto.Header, round int32, signers ...cryptotypes.PrivKey) (*tmproto.Commit, error) {
+	header, err := tmtypes.HeaderFromProto(&trusted)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, err := signCommit(header, round, signers)
+	if err != nil {
+		return nil, err
+	}
+
+	return commit.ToProto(), nil
+}
+
+// signCommit returns a commit of the given header at the given round signed by
+// each of the given consensus keys.
+func signCommit(header tmtypes.Header, round int32, signers []cryptotypes.PrivKey) (*tmtypes.Commit, error) {
+	commit := &tmtypes.Commit{
+		Height: header.Height,
+		Round:  round,
+		BlockID: tmtypes.BlockID{
+			Hash:          header.Hash(),
+			PartSetHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum(header.AppHash)},
+		},
+		Signatures: make([]tmtypes.CommitSig, len(signers)),
+	}
+	for i, signer := range signers {
+		commit.Signatures[i] = tmtypes.CommitSig{
+			BlockIDFlag:      tmtypes.BlockIDFlagCommit,
+			ValidatorAddress: signer.PubKey().Address().Bytes(),
+			Timestamp:        header.Time,
+		}
+	}
+
+	var err error
+	for i, signer := range signers {
+		commit.Signatures[i].Signature, err = signer.Sign(commit.VoteSignBytes(header.ChainID, int32(i)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return commit, nil
+}
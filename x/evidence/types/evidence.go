@@ -7,6 +7,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
+	tmtypes "github.com/cometbft/cometbft/types"
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,11 +16,16 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "lightclientattack"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence = &Equivocation{}
+	_ exported.Evidence = &LightClientAttackEvidence{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -101,3 +107,75 @@ func FromABCIEvidence(e abci.Misbehavior) exported.Evidence {
 		Time:             e.Time,
 	}
 }
+
+// Route returns the Evidence Handler route for a LightClientAttackEvidence type.
+func (e *LightClientAttackEvidence) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttackEvidence type.
+func (e *LightClientAttackEvidence) Type() string { return TypeLightClientAttack }
+
+func (e *LightClientAttackEvidence) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttackEvidence object.
+func (e *LightClientAttackEvidence) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a
+// LightClientAttackEvidence object, that is the conflicting header must be a
+// valid header committed by its commit, and the trusted commit must be a valid
+// commit at the same height and in the same round.
+func (e *LightClientAttackEvidence) ValidateBasic() error {
+	if e.ConflictingHeader == nil {
+		return fmt.Errorf("missing light client attack conflicting header")
+	}
+
+	signedHeader, err := tmtypes.SignedHeaderFromProto(e.ConflictingHeader)
+	if err != nil {
+		return fmt.Errorf("invalid light client attack conflicting header: %w", err)
+	}
+	// a header without validators hash has no hash, its commit would not
+	// commit to any block
+	if len(signedHeader.ValidatorsHash) == 0 {
+		return fmt.Errorf("invalid light client attack conflicting header: missing validators hash")
+	}
+	if err := signedHeader.ValidateBasic(signedHeader.ChainID); err != nil {
+		return fmt.Errorf("invalid light client attack conflicting header: %w", err)
+	}
+
+	if e.TrustedCommit == nil {
+		return fmt.Errorf("missing light client attack trusted commit")
+	}
+
+	trustedCommit, err := tmtypes.CommitFromProto(e.TrustedCommit)
+	if err != nil {
+		return fmt.Errorf("invalid light client attack trusted commit: %w", err)
+	}
+	if trustedCommit.Height != signedHeader.Height {
+		return fmt.Errorf("trusted commit height %d does not match conflicting header height %d", trustedCommit.Height, signedHeader.Height)
+	}
+	// under the CometBFT locking rules, validators can legitimately precommit
+	// different blocks in different rounds, only signing both blocks in the
+	// same round is an equivocation
+	if trustedCommit.Round != signedHeader.Commit.Round {
+		return fmt.Errorf("trusted commit round %d does not match conflicting commit round %d", trustedCommit.Round, signedHeader.Commit.Round)
+	}
+
+	return nil
+}
+
+// GetHeight returns the height of the conflicting header of a
+// LightClientAttackEvidence.
+func (e LightClientAttackEvidence) GetHeight() int64 {
+	if e.ConflictingHeader == nil || e.ConflictingHeader.Header == nil {
+		return 0
+	}
+	return e.ConflictingHeader.Header.Height
+}
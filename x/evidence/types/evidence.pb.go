@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -74,8 +75,53 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// LightClientAttackEvidence implements the Evidence interface and defines
+// evidence of a light client attack, that is of validators signing a block
+// conflicting with the block committed at the same height, in the same round.
+type LightClientAttackEvidence struct {
+	// conflicting_header is the header of the conflicting block along with the
+	// commit of the validators who signed it.
+	ConflictingHeader *types.SignedHeader `protobuf:"bytes,1,opt,name=conflicting_header,json=conflictingHeader,proto3" json:"conflicting_header,omitempty"`
+	// trusted_commit is the commit of the block committed at the height of the
+	// conflicting header, in the same round as the conflicting commit.
+	TrustedCommit *types.Commit `protobuf:"bytes,2,opt,name=trusted_commit,json=trustedCommit,proto3" json:"trusted_commit,omitempty"`
+}
+
+func (m *LightClientAttackEvidence) Reset()      { *m = LightClientAttackEvidence{} }
+func (*LightClientAttackEvidence) ProtoMessage() {}
+func (*LightClientAttackEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *LightClientAttackEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttackEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttackEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttackEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttackEvidence.Merge(m, src)
+}
+func (m *LightClientAttackEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttackEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttackEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttackEvidence proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*LightClientAttackEvidence)(nil), "cosmos.evidence.v1beta1.LightClientAttackEvidence")
 }
 
 func init() {
@@ -83,30 +129,37 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 361 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xcb, 0x4c, 0x49, 0xcd, 0x4b, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0x84, 0x0b, 0xe8, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x43, 0xd4, 0xe9,
-	0xc1, 0x85, 0xa1, 0xea, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0xad,
-	0x94, 0x48, 0x7a, 0x7e, 0x7a, 0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x45, 0xe5, 0xd3, 0xf3, 0xf3,
-	0xd3, 0x73, 0x52, 0xf5, 0xc1, 0xbc, 0xa4, 0xd2, 0x34, 0xfd, 0x92, 0xcc, 0xdc, 0xd4, 0xe2, 0x92,
-	0xc4, 0xdc, 0x02, 0xa8, 0x02, 0x49, 0x88, 0x15, 0xf1, 0x10, 0x9d, 0x50, 0xfb, 0xc0, 0x1c, 0xa5,
-	0x37, 0x8c, 0x5c, 0x3c, 0xae, 0x85, 0xa5, 0x99, 0x65, 0xf9, 0xc9, 0x89, 0x25, 0x99, 0xf9, 0x79,
-	0x42, 0x62, 0x5c, 0x6c, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc,
-	0x41, 0x50, 0x9e, 0x90, 0x2d, 0x17, 0x0b, 0xc8, 0x58, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23,
-	0x29, 0x3d, 0x88, 0x9d, 0x7a, 0x30, 0x3b, 0xf5, 0x42, 0x60, 0x76, 0x3a, 0xf1, 0x9e, 0xb8, 0x27,
-	0xcf, 0x30, 0xe1, 0xbe, 0x3c, 0xe3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xc0, 0xda, 0x84, 0x44,
-	0xb8, 0x58, 0x0b, 0xf2, 0xcb, 0x53, 0x8b, 0x24, 0x98, 0xc1, 0xa6, 0x42, 0x38, 0x42, 0xae, 0x5c,
-	0x82, 0xc9, 0xf9, 0x79, 0xc5, 0xa9, 0x79, 0xc5, 0xa5, 0xc5, 0xf1, 0x89, 0x29, 0x29, 0x45, 0xa9,
-	0xc5, 0xc5, 0x12, 0x2c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x12, 0x97, 0xb6, 0xe8, 0x8a, 0x40, 0x9d,
-	0xea, 0x08, 0x91, 0x09, 0x2e, 0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x12, 0x80, 0x6b, 0x81, 0x8a, 0x5b,
-	0x69, 0x74, 0x2c, 0x90, 0x67, 0x98, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x86, 0xae, 0xe7,
-	0x1b, 0xb4, 0xa0, 0x61, 0xaa, 0x5b, 0x9c, 0x92, 0xad, 0x8f, 0xec, 0x3b, 0x27, 0xef, 0x15, 0x8f,
-	0xe4, 0x18, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x37, 0x3d, 0xb3,
-	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x17, 0x1a, 0x4a, 0xfa, 0x48, 0x06, 0x55, 0x20, 0xa2,
-	0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x79, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x8d, 0x61, 0xa8, 0x30, 0xea, 0x01, 0x00, 0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xde, 0xb1, 0xb5, 0xe0, 0xd6, 0x8a, 0x59, 0x82, 0x6e, 0x83, 0xec, 0x86, 0x22, 0x12, 0x0a,
+	0xd9, 0xa1, 0x15, 0x2f, 0x05, 0x91, 0xa6, 0x04, 0x04, 0xf5, 0x92, 0x7a, 0xf2, 0x12, 0x36, 0xb3,
+	0xaf, 0x93, 0xa1, 0xd9, 0x99, 0xb8, 0xf3, 0x36, 0xea, 0x7f, 0x20, 0x9e, 0x7a, 0xf4, 0x98, 0x63,
+	0x8f, 0x3d, 0xf8, 0x47, 0xf4, 0x58, 0x3c, 0x79, 0x52, 0xd9, 0x1c, 0xea, 0xc1, 0x3f, 0x42, 0x32,
+	0x33, 0x4d, 0x83, 0xe2, 0x65, 0x98, 0xf7, 0xbd, 0xef, 0xfd, 0xf8, 0xbe, 0x19, 0xff, 0x11, 0x53,
+	0x3a, 0x57, 0x9a, 0xc2, 0x44, 0x64, 0x20, 0x19, 0xd0, 0xc9, 0xce, 0x00, 0x30, 0xdd, 0x59, 0x00,
+	0xc9, 0xb8, 0x50, 0xa8, 0x82, 0xfb, 0x96, 0x97, 0x2c, 0x60, 0xc7, 0x6b, 0xd4, 0xd2, 0x5c, 0x48,
+	0x45, 0xcd, 0x69, 0xb9, 0x8d, 0x3a, 0x57, 0x5c, 0x99, 0x2b, 0x9d, 0xdf, 0x1c, 0x1a, 0x73, 0xa5,
+	0xf8, 0x08, 0xa8, 0x89, 0x06, 0xe5, 0x11, 0x45, 0x91, 0x83, 0xc6, 0x34, 0x1f, 0x3b, 0xc2, 0xa6,
+	0x1d, 0xd1, 0xb7, 0x95, 0x6e, 0x9e, 0x4d, 0x3d, 0x40, 0x90, 0x19, 0x14, 0xb9, 0x90, 0x48, 0xf1,
+	0xc3, 0x18, 0xb4, 0x3d, 0x6d, 0x76, 0xeb, 0x37, 0xf1, 0x6f, 0x77, 0xdf, 0x96, 0x62, 0xa2, 0x58,
+	0x8a, 0x42, 0xc9, 0xe0, 0x9e, 0xbf, 0x36, 0x04, 0xc1, 0x87, 0x18, 0x92, 0x26, 0x69, 0xad, 0xf4,
+	0x5c, 0x14, 0x3c, 0xf5, 0x57, 0xe7, 0x43, 0xc3, 0x1b, 0x4d, 0xd2, 0x5a, 0xdf, 0x6d, 0x24, 0x76,
+	0xa3, 0xe4, 0x6a, 0xa3, 0xe4, 0xf5, 0xd5, 0x46, 0x9d, 0x8d, 0xf3, 0xef, 0xb1, 0x77, 0xf2, 0x23,
+	0x26, 0xa7, 0x97, 0x67, 0xdb, 0xa4, 0x67, 0xca, 0x82, 0xba, 0x7f, 0x73, 0xac, 0xde, 0x41, 0x11,
+	0xae, 0x98, 0xae, 0x36, 0x08, 0xba, 0x7e, 0x8d, 0x29, 0xa9, 0x41, 0xea, 0x52, 0xf7, 0xd3, 0x2c,
+	0x2b, 0x40, 0xeb, 0x70, 0xb5, 0x49, 0x5a, 0xb7, 0x3a, 0xe1, 0xd7, 0x2f, 0xed, 0xba, 0x13, 0xb2,
+	0x6f, 0x33, 0x87, 0x58, 0x08, 0xc9, 0x7b, 0x77, 0x17, 0x25, 0x0e, 0xdf, 0x6b, 0x7d, 0x9c, 0xc6,
+	0xde, 0xe7, 0x69, 0xec, 0xfd, 0x9a, 0xc6, 0xde, 0xa7, 0xcb, 0xb3, 0x6d, 0xe7, 0x78, 0x5b, 0x67,
+	0xc7, 0x74, 0x59, 0xdd, 0x56, 0x45, 0xfc, 0xcd, 0x97, 0x73, 0x3d, 0x07, 0x23, 0x01, 0x12, 0xf7,
+	0x11, 0x53, 0x76, 0xdc, 0x75, 0xef, 0x12, 0xbc, 0xf2, 0x03, 0xa6, 0xe4, 0xd1, 0x48, 0x30, 0x14,
+	0x92, 0xf7, 0x87, 0x90, 0x66, 0x50, 0x18, 0x1f, 0xd6, 0x77, 0xa3, 0xe4, 0xda, 0xc7, 0xc4, 0x3a,
+	0x78, 0x28, 0xb8, 0x84, 0xec, 0xb9, 0x61, 0xf5, 0x6a, 0x4b, 0x95, 0x16, 0x0a, 0x9e, 0xf9, 0x77,
+	0xb0, 0x28, 0x35, 0x42, 0xd6, 0x67, 0x2a, 0xcf, 0x05, 0x3a, 0xf3, 0xc2, 0x7f, 0x5b, 0x1d, 0x98,
+	0x7c, 0x6f, 0xc3, 0xf1, 0x6d, 0xb8, 0xf7, 0xe4, 0x6f, 0x5d, 0x0f, 0x97, 0x74, 0xfd, 0x57, 0x46,
+	0xe7, 0xc5, 0x69, 0x15, 0x91, 0xf3, 0x2a, 0x22, 0x17, 0x55, 0x44, 0x7e, 0x56, 0x11, 0x39, 0x99,
+	0x45, 0xde, 0xc5, 0x2c, 0xf2, 0xbe, 0xcd, 0x22, 0xef, 0x4d, 0x9b, 0x0b, 0x1c, 0x96, 0x83, 0x84,
+	0xa9, 0xdc, 0x7d, 0x14, 0xba, 0xd4, 0xf5, 0xfd, 0xf5, 0x6f, 0x36, 0x9b, 0x0d, 0xd6, 0xcc, 0x0b,
+	0x3f, 0xfe, 0x33, 0x00, 0xe6, 0xa0, 0x75, 0x08, 0xed, 0x02, 0x00, 0x00,
 }
 
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
@@ -157,6 +210,53 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttackEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttackEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttackEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrustedCommit != nil {
+		{
+			size, err := m.TrustedCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConflictingHeader != nil {
+		{
+			size, err := m.ConflictingHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -189,6 +289,23 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttackEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConflictingHeader != nil {
+		l = m.ConflictingHeader.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.TrustedCommit != nil {
+		l = m.TrustedCommit.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -348,6 +465,128 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttackEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttackEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingHeader == nil {
+				m.ConflictingHeader = &types.SignedHeader{}
+			}
+			if err := m.ConflictingHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrustedCommit == nil {
+				m.TrustedCommit = &types.Commit{}
+			}
+			if err := m.TrustedCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
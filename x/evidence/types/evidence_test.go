@@ -5,9 +5,16 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	"github.com/cometbft/cometbft/version"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/testutil"
 	"github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
@@ -70,6 +77,115 @@ func TestEquivocationValidateBasic(t *testing.T) {
 	}
 }
 
+func TestLightClientAttackEvidence_Valid(t *testing.T) {
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	trusted := tmproto.Header{
+		ChainID:         "test-chain",
+		Height:          100,
+		Time:            n,
+		Version:         tmversion.Consensus{Block: version.BlockProtocol},
+		ProposerAddress: tmhash.SumTruncated([]byte("proposer")),
+		ValidatorsHash:  tmhash.Sum([]byte("validators hash")),
+	}
+	signers := []cryptotypes.PrivKey{ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+	signedHeader, err := testutil.ConflictingSignedHeader(trusted, 0, signers...)
+	require.NoError(t, err)
+	trustedCommit, err := testutil.TrustedCommit(trusted, 0, signers...)
+	require.NoError(t, err)
+
+	e := types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: trustedCommit}
+
+	require.Equal(t, int64(100), e.GetHeight())
+	require.Equal(t, types.TypeLightClientAttack, e.Type())
+	require.Equal(t, types.RouteLightClientAttack, e.Route())
+	bz, err := e.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, tmhash.Sum(bz), e.Hash().Bytes())
+	require.Contains(t, e.String(), "conflicting_header")
+	require.NoError(t, e.ValidateBasic())
+}
+
+func TestLightClientAttackEvidenceValidateBasic(t *testing.T) {
+	n, _ := time.Parse(time.RFC3339, "2006-01-02T15:04:05Z")
+	trusted := tmproto.Header{
+		ChainID:         "test-chain",
+		Height:          100,
+		Time:            n,
+		Version:         tmversion.Consensus{Block: version.BlockProtocol},
+		ProposerAddress: tmhash.SumTruncated([]byte("proposer")),
+		ValidatorsHash:  tmhash.Sum([]byte("validators hash")),
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(*types.LightClientAttackEvidence)
+		expectErr bool
+	}{
+		{
+			"valid",
+			func(*types.LightClientAttackEvidence) {},
+			false,
+		},
+		{
+			"missing conflicting header",
+			func(e *types.LightClientAttackEvidence) { e.ConflictingHeader = nil },
+			true,
+		},
+		{
+			"missing commit",
+			func(e *types.LightClientAttackEvidence) { e.ConflictingHeader.Commit = nil },
+			true,
+		},
+		{
+			"missing validators hash",
+			func(e *types.LightClientAttackEvidence) { e.ConflictingHeader.Header.ValidatorsHash = nil },
+			true,
+		},
+		{
+			"commit height mismatch",
+			func(e *types.LightClientAttackEvidence) { e.ConflictingHeader.Commit.Height++ },
+			true,
+		},
+		{
+			"commit of another block",
+			func(e *types.LightClientAttackEvidence) {
+				e.ConflictingHeader.Header.DataHash = tmhash.Sum([]byte("data"))
+			},
+			true,
+		},
+		{
+			"missing trusted commit",
+			func(e *types.LightClientAttackEvidence) { e.TrustedCommit = nil },
+			true,
+		},
+		{
+			"trusted commit height mismatch",
+			func(e *types.LightClientAttackEvidence) { e.TrustedCommit.Height++ },
+			true,
+		},
+		{
+			"trusted commit round mismatch",
+			func(e *types.LightClientAttackEvidence) { e.TrustedCommit.Round++ },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			signer := ed25519.GenPrivKey()
+			signedHeader, err := testutil.ConflictingSignedHeader(trusted, 0, signer)
+			require.NoError(t, err)
+			trustedCommit, err := testutil.TrustedCommit(trusted, 0, signer)
+			require.NoError(t, err)
+
+			e := types.LightClientAttackEvidence{ConflictingHeader: signedHeader, TrustedCommit: trustedCommit}
+			tc.malleate(&e)
+			require.Equal(t, tc.expectErr, e.ValidateBasic() != nil)
+		})
+	}
+}
+
 func TestEvidenceAddressConversion(t *testing.T) {
 	sdk.GetConfig().SetBech32PrefixForConsensusNode("testcnclcons", "testcnclconspub")
 	tmEvidence := abci.Misbehavior{
@@ -3,6 +3,8 @@ package types
 import (
 	"time"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -16,6 +18,8 @@ type (
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
 		GetParams(ctx sdk.Context) (params stakingtypes.Params)
+		GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+		PowerReduction(ctx sdk.Context) math.Int
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -34,6 +38,7 @@ type (
 
 	// AccountKeeper define the account keeper interface contracted needed by the evidence module
 	AccountKeeper interface {
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 		SetAccount(ctx sdk.Context, acc types.AccountI)
 	}
 
@@ -42,5 +47,6 @@ type (
 		MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 		SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 		GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	}
 )
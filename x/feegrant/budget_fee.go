@@ -0,0 +1,243 @@
+package feegrant
+
+import (
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*BudgetAllowance)(nil)
+
+// NewBudgetAllowance creates a new BudgetAllowance.
+// A zero maxGasPerTx means there is no gas limit cap.
+func NewBudgetAllowance(budgets []MsgTypeBudget, maxGasPerTx uint64, expiration *time.Time) *BudgetAllowance {
+	return &BudgetAllowance{
+		Budgets:     budgets,
+		MaxGasPerTx: maxGasPerTx,
+		Expiration:  expiration,
+	}
+}
+
+// Accept can use fee payment requested as well as timestamp of the current block
+// to determine whether or not to process this. This is checked in
+// Keeper.UseGrantedFees and the return values should match how it is handled there.
+//
+// The fee is split between the messages of the transaction, and the share of the
+// messages of each type is deducted from the budget of that type. The fee is
+// rejected if any budget can't cover its share, and no budget is updated in
+// that case.
+//
+// A budget whose spend limit is used up is removed, and remove is true once the
+// allowance has no budget left.
+func (a *BudgetAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if a.Expiration != nil && a.Expiration.Before(ctx.BlockTime()) {
+		return true, sdkerrors.Wrap(ErrFeeLimitExpired, "msg budget allowance")
+	}
+
+	// the gas meter is infinite in simulation and in the genesis block
+	if gasLimit := ctx.GasMeter().Limit(); a.MaxGasPerTx > 0 && gasLimit != math.MaxUint64 && gasLimit > a.MaxGasPerTx {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "gas limit %d is more than max gas per tx %d", gasLimit, a.MaxGasPerTx)
+	}
+
+	if len(msgs) == 0 {
+		return false, sdkerrors.Wrap(ErrNoMessages, "no message to pay the fee for")
+	}
+
+	// budgets are updated on a copy, so that nothing is deducted if the fee is rejected
+	budgets := make([]MsgTypeBudget, len(a.Budgets))
+	copy(budgets, a.Budgets)
+
+	msgTypes, shares := splitFee(fee, msgs)
+	usedUp := make(map[string]bool)
+	for _, msgType := range msgTypes {
+		idx := -1
+		for i, budget := range budgets {
+			ctx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+			if budget.MsgTypeUrl == msgType {
+				idx = i
+				break
+			}
+		}
+
+		if idx < 0 {
+			return false, sdkerrors.Wrapf(ErrMessageNotAllowed, "no budget for message %s", msgType)
+		}
+
+		var err error
+		usedUp[msgType], err = budgets[idx].spend(ctx.BlockTime(), shares[msgType])
+		if err != nil {
+			return false, err
+		}
+	}
+
+	a.Budgets = make([]MsgTypeBudget, 0, len(budgets))
+	for _, budget := range budgets {
+		if usedUp[budget.MsgTypeUrl] {
+			continue
+		}
+
+		a.Budgets = append(a.Budgets, budget)
+	}
+
+	return len(a.Budgets) == 0, nil
+}
+
+// splitFee splits the fee evenly between the messages and returns the share of
+// the messages of each type, along with the types in the order of the messages.
+// The remainder of the division is charged to the type of the first message.
+func splitFee(fee sdk.Coins, msgs []sdk.Msg) ([]string, map[string]sdk.Coins) {
+	var msgTypes []string
+	counts := make(map[string]int64)
+	for _, msg := range msgs {
+		msgType := sdk.MsgTypeURL(msg)
+		if _, ok := counts[msgType]; !ok {
+			msgTypes = append(msgTypes, msgType)
+		}
+		counts[msgType]++
+	}
+
+	total := sdk.NewInt(int64(len(msgs)))
+	shares := make(map[string]sdk.Coins, len(msgTypes))
+	charged := sdk.NewCoins()
+	for _, msgType := range msgTypes {
+		share := sdk.NewCoins()
+		for _, coin := range fee {
+			amount := coin.Amount.MulRaw(counts[msgType]).Quo(total)
+			share = share.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+
+		shares[msgType] = share
+		charged = charged.Add(share...)
+	}
+
+	shares[msgTypes[0]] = shares[msgTypes[0]].Add(fee.Sub(charged...)...)
+
+	return msgTypes, shares
+}
+
+// spend deducts the fee from both the current period and the spend limit of
+// the budget. It returns true if the spend limit is used up.
+func (b *MsgTypeBudget) spend(blockTime time.Time, fee sdk.Coins) (bool, error) {
+	var isNeg bool
+	if b.Period > 0 {
+		b.tryResetPeriod(blockTime)
+
+		b.PeriodCanSpend, isNeg = b.PeriodCanSpend.SafeSub(fee...)
+		if isNeg {
+			return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "period limit of %s", b.MsgTypeUrl)
+		}
+	}
+
+	if b.SpendLimit.Empty() {
+		return false, nil
+	}
+
+	b.SpendLimit, isNeg = b.SpendLimit.SafeSub(fee...)
+	if isNeg {
+		return false, sdkerrors.Wrapf(ErrFeeLimitExceeded, "absolute limit of %s", b.MsgTypeUrl)
+	}
+
+	return b.SpendLimit.IsZero(), nil
+}
+
+// tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
+// If we hit the reset period, it will top up the PeriodCanSpend amount to
+// min(PeriodSpendLimit, SpendLimit), per denom, so it is never more than the maximum allowed.
+// It will also update the PeriodReset. If we are within one Period, it will update from the
+// last PeriodReset (eg. if you always do one tx per day, it will always reset the same time)
+// If we are more then one period out (eg. no activity in a week), reset is one Period from the execution of this method
+func (b *MsgTypeBudget) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(b.PeriodReset) {
+		return
+	}
+
+	// set PeriodCanSpend to the lesser of SpendLimit and PeriodSpendLimit, per
+	// denom, an empty SpendLimit being unlimited
+	if !b.SpendLimit.Empty() {
+		b.PeriodCanSpend = b.PeriodSpendLimit.Min(b.SpendLimit)
+	} else {
+		b.PeriodCanSpend = b.PeriodSpendLimit
+	}
+
+	// If we are within the period, step from expiration (eg. if you always do one tx per day, it will always reset the same time)
+	// If we are more then one period out (eg. no activity in a week), reset is one period from this time
+	b.PeriodReset = b.PeriodReset.Add(b.Period)
+	if blockTime.After(b.PeriodReset) {
+		b.PeriodReset = blockTime.Add(b.Period)
+	}
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a BudgetAllowance) ValidateBasic() error {
+	if len(a.Budgets) == 0 {
+		return sdkerrors.Wrap(ErrNoMessages, "budgets shouldn't be empty")
+	}
+
+	seen := make(map[string]bool, len(a.Budgets))
+	for _, budget := range a.Budgets {
+		if budget.MsgTypeUrl == "" {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "budget msg type url cannot be empty")
+		}
+		if seen[budget.MsgTypeUrl] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate budget for %s", budget.MsgTypeUrl)
+		}
+		seen[budget.MsgTypeUrl] = true
+
+		if err := budget.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if a.Expiration != nil && a.Expiration.Unix() < 0 {
+		return sdkerrors.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs basic sanity checks on the budget.
+func (b MsgTypeBudget) ValidateBasic() error {
+	if !b.SpendLimit.Empty() {
+		if !b.SpendLimit.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend amount of %s is invalid: %s", b.MsgTypeUrl, b.SpendLimit)
+		}
+		if !b.SpendLimit.IsAllPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit of %s must be positive", b.MsgTypeUrl)
+		}
+	}
+
+	if b.Period < 0 {
+		return sdkerrors.Wrapf(ErrInvalidDuration, "negative clock step of %s", b.MsgTypeUrl)
+	}
+
+	if b.Period == 0 {
+		if !b.PeriodSpendLimit.Empty() || !b.PeriodCanSpend.Empty() {
+			return sdkerrors.Wrapf(ErrInvalidDuration, "period of %s must be set with a period spend limit", b.MsgTypeUrl)
+		}
+
+		return nil
+	}
+
+	if !b.PeriodSpendLimit.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period spend amount of %s is invalid: %s", b.MsgTypeUrl, b.PeriodSpendLimit)
+	}
+	if b.PeriodSpendLimit.Empty() || !b.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period spend limit of %s must be positive", b.MsgTypeUrl)
+	}
+	if !b.PeriodCanSpend.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "can spend amount of %s is invalid: %s", b.MsgTypeUrl, b.PeriodCanSpend)
+	}
+
+	// ensure PeriodSpendLimit can be subtracted from total (same coin types)
+	if !b.SpendLimit.Empty() && !b.PeriodSpendLimit.DenomsSubsetOf(b.SpendLimit) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "period spend limit of %s has different currency than spend limit", b.MsgTypeUrl)
+	}
+
+	return nil
+}
+
+func (a BudgetAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Expiration, nil
+}
@@ -0,0 +1,272 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestBudgetAllowanceValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 10))
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	now := time.Now()
+	negative := time.Unix(-1, 0)
+
+	cases := map[string]struct {
+		budgets    []feegrant.MsgTypeBudget
+		expiration *time.Time
+		expErr     string
+	}{
+		"valid": {
+			budgets: []feegrant.MsgTypeBudget{
+				{MsgTypeUrl: sendURL, SpendLimit: atom, Period: time.Hour, PeriodSpendLimit: atom},
+				{MsgTypeUrl: "/cosmos.gov.v1.MsgVote"},
+			},
+			expiration: &now,
+		},
+		"no budget": {
+			expErr: "budgets shouldn't be empty",
+		},
+		"empty msg type url": {
+			budgets: []feegrant.MsgTypeBudget{{SpendLimit: atom}},
+			expErr:  "msg type url cannot be empty",
+		},
+		"duplicate msg type url": {
+			budgets: []feegrant.MsgTypeBudget{{MsgTypeUrl: sendURL}, {MsgTypeUrl: sendURL, SpendLimit: atom}},
+			expErr:  "duplicate budget",
+		},
+		"invalid spend limit": {
+			budgets: []feegrant.MsgTypeBudget{{MsgTypeUrl: sendURL, SpendLimit: sdk.Coins{sdk.NewInt64Coin("atom", 0)}}},
+			expErr:  "spend amount of /cosmos.bank.v1beta1.MsgSend is invalid",
+		},
+		"negative period": {
+			budgets: []feegrant.MsgTypeBudget{{MsgTypeUrl: sendURL, Period: -time.Hour, PeriodSpendLimit: atom}},
+			expErr:  "negative clock step",
+		},
+		"period spend limit without period": {
+			budgets: []feegrant.MsgTypeBudget{{MsgTypeUrl: sendURL, PeriodSpendLimit: atom}},
+			expErr:  "must be set with a period spend limit",
+		},
+		"period without period spend limit": {
+			budgets: []feegrant.MsgTypeBudget{{MsgTypeUrl: sendURL, Period: time.Hour}},
+			expErr:  "period spend limit of /cosmos.bank.v1beta1.MsgSend must be positive",
+		},
+		"period spend limit currency not in spend limit": {
+			budgets: []feegrant.MsgTypeBudget{{MsgTypeUrl: sendURL, SpendLimit: atom, Period: time.Hour, PeriodSpendLimit: eth}},
+			expErr:  "different currency",
+		},
+		"negative expiration": {
+			budgets:    []feegrant.MsgTypeBudget{{MsgTypeUrl: sendURL}},
+			expiration: &negative,
+			expErr:     "expiration time cannot be negative",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := feegrant.NewBudgetAllowance(tc.budgets, 0, tc.expiration).ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestBudgetAllowanceAccept(t *testing.T) {
+	key := sdk.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{})
+
+	now := time.Now().UTC()
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: now})
+
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+	send := &banktypes.MsgSend{}
+	vote := &govv1.MsgVote{}
+	sendURL, voteURL := sdk.MsgTypeURL(send), sdk.MsgTypeURL(vote)
+	oneHour := now.Add(time.Hour)
+
+	newAllowance := func() *feegrant.BudgetAllowance {
+		return feegrant.NewBudgetAllowance([]feegrant.MsgTypeBudget{
+			{MsgTypeUrl: sendURL, SpendLimit: atom(100)},
+			{MsgTypeUrl: voteURL, Period: time.Hour, PeriodSpendLimit: atom(10)},
+		}, 200000, &oneHour)
+	}
+
+	cases := map[string]struct {
+		msgs      []sdk.Msg
+		fee       sdk.Coins
+		gasLimit  uint64
+		blockTime time.Time
+		expErr    string
+		remove    bool
+		remains   []feegrant.MsgTypeBudget
+	}{
+		"single msg type": {
+			msgs: []sdk.Msg{send},
+			fee:  atom(40),
+			remains: []feegrant.MsgTypeBudget{
+				{MsgTypeUrl: sendURL, SpendLimit: atom(60)},
+				{MsgTypeUrl: voteURL, Period: time.Hour, PeriodSpendLimit: atom(10)},
+			},
+		},
+		"fee split between msg types": {
+			msgs: []sdk.Msg{send, vote, send},
+			fee:  atom(10),
+			remains: []feegrant.MsgTypeBudget{
+				{MsgTypeUrl: sendURL, SpendLimit: atom(93)},
+				{MsgTypeUrl: voteURL, Period: time.Hour, PeriodSpendLimit: atom(10), PeriodCanSpend: atom(7), PeriodReset: now.Add(time.Hour)},
+			},
+		},
+		"remainder charged to first msg type": {
+			msgs: []sdk.Msg{vote, send},
+			fee:  atom(5),
+			remains: []feegrant.MsgTypeBudget{
+				{MsgTypeUrl: sendURL, SpendLimit: atom(98)},
+				{MsgTypeUrl: voteURL, Period: time.Hour, PeriodSpendLimit: atom(10), PeriodCanSpend: atom(7), PeriodReset: now.Add(time.Hour)},
+			},
+		},
+		"used up budget is removed": {
+			msgs: []sdk.Msg{send},
+			fee:  atom(100),
+			remains: []feegrant.MsgTypeBudget{
+				{MsgTypeUrl: voteURL, Period: time.Hour, PeriodSpendLimit: atom(10)},
+			},
+		},
+		"msg type without budget": {
+			msgs:   []sdk.Msg{send, &banktypes.MsgMultiSend{}},
+			fee:    atom(2),
+			expErr: "no budget for message /cosmos.bank.v1beta1.MsgMultiSend",
+		},
+		"period limit exceeded": {
+			msgs:   []sdk.Msg{send, vote},
+			fee:    atom(30),
+			expErr: "period limit of /cosmos.gov.v1.MsgVote",
+		},
+		"absolute limit exceeded": {
+			msgs:   []sdk.Msg{send},
+			fee:    atom(101),
+			expErr: "absolute limit of /cosmos.bank.v1beta1.MsgSend",
+		},
+		"gas limit above cap": {
+			msgs:     []sdk.Msg{send},
+			fee:      atom(1),
+			gasLimit: 200001,
+			expErr:   "gas limit 200001 is more than max gas per tx 200000",
+		},
+		"expired": {
+			msgs:      []sdk.Msg{send},
+			fee:       atom(1),
+			blockTime: now.Add(2 * time.Hour),
+			expErr:    "fee allowance expired",
+			remove:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			allowance := newAllowance()
+			require.NoError(t, allowance.ValidateBasic())
+
+			ctx := ctx.WithGasMeter(sdk.NewGasMeter(200000))
+			if tc.gasLimit != 0 {
+				ctx = ctx.WithGasMeter(sdk.NewGasMeter(tc.gasLimit))
+			}
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(tc.blockTime)
+			}
+
+			removed, err := allowance.Accept(ctx, tc.fee, tc.msgs)
+			require.Equal(t, tc.remove, removed)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				require.Equal(t, newAllowance(), allowance, "allowance must not be updated")
+				return
+			}
+			require.NoError(t, err)
+
+			// mimic save & load process
+			grant, err := feegrant.NewGrant(sdk.AccAddress("granter"), sdk.AccAddress("grantee"), allowance)
+			require.NoError(t, err)
+			bz, err := encCfg.Codec.Marshal(&grant)
+			require.NoError(t, err)
+			var loadedGrant feegrant.Grant
+			require.NoError(t, encCfg.Codec.Unmarshal(bz, &loadedGrant))
+			loaded, err := loadedGrant.GetGrant()
+			require.NoError(t, err)
+
+			budgets := loaded.(*feegrant.BudgetAllowance).Budgets
+			require.Len(t, budgets, len(tc.remains))
+			for i, budget := range tc.remains {
+				require.Equal(t, budget.MsgTypeUrl, budgets[i].MsgTypeUrl)
+				require.True(t, budget.SpendLimit.IsEqual(budgets[i].SpendLimit), budgets[i].SpendLimit)
+				require.True(t, budget.PeriodCanSpend.IsEqual(budgets[i].PeriodCanSpend), budgets[i].PeriodCanSpend)
+				require.True(t, budget.PeriodReset.Equal(budgets[i].PeriodReset), budgets[i].PeriodReset)
+			}
+		})
+	}
+}
+
+func TestBudgetAllowancePeriodReset(t *testing.T) {
+	key := sdk.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+
+	now := time.Now().UTC()
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: now}).WithGasMeter(sdk.NewGasMeter(200000))
+
+	send := &banktypes.MsgSend{}
+	allowance := feegrant.NewBudgetAllowance([]feegrant.MsgTypeBudget{{
+		MsgTypeUrl:       sdk.MsgTypeURL(send),
+		SpendLimit:       sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 500)),
+		Period:           time.Hour,
+		PeriodSpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 10), sdk.NewInt64Coin("stake", 100)),
+	}}, 200000, nil)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// the period can spend is capped per denom by the spend limit left
+	removed, err := allowance.Accept(ctx, sdk.NewCoins(sdk.NewInt64Coin("atom", 3)), []sdk.Msg{send})
+	require.NoError(t, err)
+	require.False(t, removed)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 2), sdk.NewInt64Coin("stake", 100)), allowance.Budgets[0].PeriodCanSpend)
+}
+
+func TestBudgetAllowanceRemove(t *testing.T) {
+	key := sdk.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+	send, vote := &banktypes.MsgSend{}, &govv1.MsgVote{}
+	allowance := feegrant.NewBudgetAllowance([]feegrant.MsgTypeBudget{
+		{MsgTypeUrl: sdk.MsgTypeURL(send), SpendLimit: atom},
+		{MsgTypeUrl: sdk.MsgTypeURL(vote), SpendLimit: atom},
+	}, 0, nil)
+
+	t.Log("verify the allowance is kept while a budget is left")
+	removed, err := allowance.Accept(ctx, atom, []sdk.Msg{send})
+	require.NoError(t, err)
+	require.False(t, removed)
+	require.Len(t, allowance.Budgets, 1)
+
+	t.Log("verify the used up msg type is not allowed anymore")
+	_, err = allowance.Accept(ctx, atom, []sdk.Msg{send})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+	t.Log("verify the allowance is removed once no budget is left")
+	removed, err = allowance.Accept(ctx, atom, []sdk.Msg{vote})
+	require.NoError(t, err)
+	require.True(t, removed)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
@@ -22,6 +24,8 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagMsgBudgets  = "msg-budgets"
+	FlagMaxGasPerTx = "max-gas-per-tx"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -57,8 +61,16 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --msg-budgets budgets.json --max-gas-per-tx 200000 --expiration 2022-01-30T15:04:05Z
+
+Where budgets.json contains the budget of each allowed message type:
+[
+  {"msg_type_url": "/cosmos.bank.v1beta1.MsgSend", "spend_limit": [{"denom": "stake", "amount": "100"}]},
+  {"msg_type_url": "/cosmos.gov.v1.MsgVote", "period": "3600s", "period_spend_limit": [{"denom": "stake", "amount": "10"}]}
+]
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -104,6 +116,41 @@ Examples:
 				basic.Expiration = &expiresAtTime
 			}
 
+			msgBudgetsFile, err := cmd.Flags().GetString(FlagMsgBudgets)
+			if err != nil {
+				return err
+			}
+
+			maxGasPerTx, err := cmd.Flags().GetUint64(FlagMaxGasPerTx)
+			if err != nil {
+				return err
+			}
+
+			// the budgets replace the spend limits and allowed messages of the other allowances
+			if msgBudgetsFile != "" {
+				for _, flag := range []string{FlagSpendLimit, FlagPeriod, FlagPeriodLimit, FlagAllowedMsgs} {
+					if cmd.Flags().Changed(flag) {
+						return fmt.Errorf("--%s cannot be used with --%s", flag, FlagMsgBudgets)
+					}
+				}
+
+				grant, err := parseBudgetAllowance(clientCtx.Codec, msgBudgetsFile, maxGasPerTx, basic.Expiration)
+				if err != nil {
+					return err
+				}
+
+				msg, err := feegrant.NewMsgGrantAllowance(grant, granter, grantee)
+				if err != nil {
+					return err
+				}
+
+				return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			}
+
+			if maxGasPerTx > 0 {
+				return fmt.Errorf("--%s can only be used with --%s", FlagMaxGasPerTx, FlagMsgBudgets)
+			}
+
 			var grant feegrant.FeeAllowanceI
 			grant = &basic
 
@@ -175,10 +222,28 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().String(FlagMsgBudgets, "", "Path to a JSON file with the fee budgets of the allowed message types")
+	cmd.Flags().Uint64(FlagMaxGasPerTx, 0, "max gas per tx specifies the maximum gas limit of a transaction paid by the msg budgets allowance, if not mentioned there is no limit")
 
 	return cmd
 }
 
+// parseBudgetAllowance reads the budgets of a BudgetAllowance from a JSON file.
+func parseBudgetAllowance(cdc codec.Codec, budgetsFile string, maxGasPerTx uint64, expiration *time.Time) (*feegrant.BudgetAllowance, error) {
+	contents, err := os.ReadFile(budgetsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	allowance := &feegrant.BudgetAllowance{}
+	bz := []byte(fmt.Sprintf(`{"budgets":%s}`, contents))
+	if err := cdc.UnmarshalJSON(bz, allowance); err != nil {
+		return nil, fmt.Errorf("invalid msg budgets: %w", err)
+	}
+
+	return feegrant.NewBudgetAllowance(allowance.Budgets, maxGasPerTx, expiration), nil
+}
+
 // NewCmdRevokeFeegrant returns a CLI command handler for creating a MsgRevokeAllowance transaction.
 func NewCmdRevokeFeegrant() *cobra.Command {
 	cmd := &cobra.Command{
@@ -149,6 +149,14 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
 	}
 
+	budgetsFile := testutil.WriteToNewTempFile(s.T(), `[
+		{"msg_type_url": "/cosmos.bank.v1beta1.MsgSend", "spend_limit": [{"denom": "stake", "amount": "100"}]},
+		{"msg_type_url": "/cosmos.gov.v1.MsgVote", "period": "3600s", "period_spend_limit": [{"denom": "stake", "amount": "10"}]}
+	]`)
+	defer budgetsFile.Close()
+	invalidBudgetsFile := testutil.WriteToNewTempFile(s.T(), `{"msg_type_url": "/cosmos.bank.v1beta1.MsgSend"}`)
+	defer invalidBudgetsFile.Close()
+
 	testCases := []struct {
 		name         string
 		args         []string
@@ -391,6 +399,62 @@ func (s *CLITestSuite) TestNewCmdFeeGrant() {
 			),
 			false, 0, &sdk.TxResponse{},
 		},
+		{
+			"valid msg budgets fee grant",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagMsgBudgets, budgetsFile.Name()),
+					fmt.Sprintf("--%s=%d", cli.FlagMaxGasPerTx, 200000),
+					fmt.Sprintf("--%s=%s", cli.FlagExpiration, getFormattedExpiration(oneYear)),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			false, 0, &sdk.TxResponse{},
+		},
+		{
+			"msg budgets with spend limit",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagMsgBudgets, budgetsFile.Name()),
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"invalid msg budgets file",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagMsgBudgets, invalidBudgetsFile.Name()),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
+		{
+			"max gas per tx without msg budgets",
+			append(
+				[]string{
+					granter.String(),
+					"cosmos1vevyks8pthkscvgazc97qyfjt40m6g9xe85ry8",
+					fmt.Sprintf("--%s=%s", cli.FlagSpendLimit, "100stake"),
+					fmt.Sprintf("--%s=%d", cli.FlagMaxGasPerTx, 200000),
+					fmt.Sprintf("--%s=%s", flags.FlagFrom, granter),
+				},
+				commonFlags...,
+			),
+			true, 0, nil,
+		},
 		{
 			"invalid expiration",
 			append(
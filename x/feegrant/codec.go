@@ -22,6 +22,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
 	cdc.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance", nil)
 	cdc.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance", nil)
+	cdc.RegisterConcrete(&BudgetAllowance{}, "cosmos-sdk/BudgetAllowance", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -37,6 +38,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&BasicAllowance{},
 		&PeriodicAllowance{},
 		&AllowedMsgAllowance{},
+		&BudgetAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -219,6 +219,150 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// BudgetAllowance creates allowance only for specified message types, with a
+// separate budget for each message type.
+type BudgetAllowance struct {
+	// budgets are the budgets of the message types for which the grantee has the access.
+	Budgets []MsgTypeBudget `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets"`
+	// max_gas_per_tx specifies the maximum gas limit of a transaction paid by this
+	// allowance. If it is zero, there is no gas limit cap.
+	MaxGasPerTx uint64 `protobuf:"varint,2,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty"`
+	// expiration specifies an optional time when this allowance expires
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *BudgetAllowance) Reset()         { *m = BudgetAllowance{} }
+func (m *BudgetAllowance) String() string { return proto.CompactTextString(m) }
+func (*BudgetAllowance) ProtoMessage()    {}
+func (*BudgetAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *BudgetAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BudgetAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BudgetAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BudgetAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetAllowance.Merge(m, src)
+}
+func (m *BudgetAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BudgetAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BudgetAllowance proto.InternalMessageInfo
+
+// MsgTypeBudget defines the fees that can be spent for a message type, in total
+// and in a period.
+type MsgTypeBudget struct {
+	// msg_type_url is the type URL of the message the budget applies to.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// spend_limit specifies the maximum amount of coins that can be spent for
+	// the message type and will be updated as coins are spent. If it is empty,
+	// there is no spend limit and any amount of coins can be spent.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// period specifies the time duration in which period_spend_limit coins can
+	// be spent before that budget is reset. If it is zero, the budget has no
+	// period limit.
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period"`
+	// period_spend_limit specifies the maximum number of coins that can be spent
+	// in the period
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// period_can_spend is the number of coins left to be spent before the period_reset time
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// period_reset is the time at which this period resets and a new one begins,
+	// it is calculated from the start time of the first transaction after the
+	// last period ended
+	PeriodReset time.Time `protobuf:"bytes,6,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *MsgTypeBudget) Reset()         { *m = MsgTypeBudget{} }
+func (m *MsgTypeBudget) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeBudget) ProtoMessage()    {}
+func (*MsgTypeBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *MsgTypeBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeBudget.Merge(m, src)
+}
+func (m *MsgTypeBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeBudget proto.InternalMessageInfo
+
+func (m *MsgTypeBudget) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeBudget) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *MsgTypeBudget) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *MsgTypeBudget) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *MsgTypeBudget) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *MsgTypeBudget) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +377,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{5}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +431,8 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*BudgetAllowance)(nil), "cosmos.feegrant.v1beta1.BudgetAllowance")
+	proto.RegisterType((*MsgTypeBudget)(nil), "cosmos.feegrant.v1beta1.MsgTypeBudget")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,47 +441,56 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0x9b, 0xb6, 0x28, 0x17, 0x28, 0xad, 0xa9, 0x84, 0x53, 0x21, 0xbb, 0x8a, 0x04, 0x4d,
-	0x2b, 0xd5, 0x56, 0x8b, 0x58, 0x3a, 0x35, 0x2e, 0xa2, 0x80, 0x5a, 0xa9, 0x72, 0x99, 0x90, 0x50,
-	0x74, 0xb6, 0xaf, 0xe6, 0x44, 0xec, 0x33, 0x3e, 0x17, 0x1a, 0x06, 0x66, 0xc4, 0x80, 0x32, 0x32,
-	0x32, 0x22, 0xa6, 0x0e, 0xe5, 0x3b, 0x54, 0x0c, 0xa8, 0x62, 0x62, 0x22, 0x28, 0x19, 0x3a, 0xf3,
-	0x0d, 0x90, 0xef, 0xce, 0x8e, 0x9b, 0x50, 0x68, 0x25, 0xba, 0x24, 0x77, 0xef, 0xde, 0xfb, 0xfd,
-	0x79, 0xef, 0x45, 0x01, 0xb7, 0x1c, 0x42, 0x7d, 0x42, 0x8d, 0x1d, 0x84, 0xbc, 0x08, 0x06, 0xb1,
-	0xf1, 0x62, 0xc9, 0x46, 0x31, 0x5c, 0xca, 0x02, 0x7a, 0x18, 0x91, 0x98, 0xc8, 0xd7, 0x79, 0x9e,
-	0x9e, 0x85, 0x45, 0xde, 0xcc, 0xb4, 0x47, 0x3c, 0xc2, 0x72, 0x8c, 0xe4, 0xc4, 0xd3, 0x67, 0x2a,
-	0x1e, 0x21, 0x5e, 0x13, 0x19, 0xec, 0x66, 0xef, 0xee, 0x18, 0x30, 0x68, 0xa5, 0x4f, 0x1c, 0xa9,
-	0xc1, 0x6b, 0x04, 0x2c, 0x7f, 0x52, 0x85, 0x18, 0x1b, 0x52, 0x94, 0x09, 0x71, 0x08, 0x0e, 0xc4,
-	0xfb, 0x14, 0xf4, 0x71, 0x40, 0x0c, 0xf6, 0x29, 0x42, 0xda, 0x20, 0x51, 0x8c, 0x7d, 0x44, 0x63,
-	0xe8, 0x87, 0x29, 0xe6, 0x60, 0x82, 0xbb, 0x1b, 0xc1, 0x18, 0x13, 0x81, 0x59, 0x7d, 0x37, 0x02,
-	0x26, 0x4c, 0x48, 0xb1, 0x53, 0x6f, 0x36, 0xc9, 0x4b, 0x18, 0x38, 0x48, 0x7e, 0x0e, 0xca, 0x34,
-	0x44, 0x81, 0xdb, 0x68, 0x62, 0x1f, 0xc7, 0x8a, 0x34, 0x5b, 0xac, 0x95, 0x97, 0x2b, 0xba, 0x90,
-	0x9a, 0x88, 0x4b, 0xdd, 0xeb, 0x6b, 0x04, 0x07, 0xe6, 0x9d, 0xc3, 0x1f, 0x5a, 0xe1, 0x53, 0x47,
-	0xab, 0x79, 0x38, 0x7e, 0xba, 0x6b, 0xeb, 0x0e, 0xf1, 0x85, 0x2f, 0xf1, 0xb5, 0x48, 0xdd, 0x67,
-	0x46, 0xdc, 0x0a, 0x11, 0x65, 0x05, 0xf4, 0xe3, 0xf1, 0xfe, 0x82, 0x64, 0x01, 0x46, 0xb2, 0x91,
-	0x70, 0xc8, 0xab, 0x00, 0xa0, 0xbd, 0x10, 0x73, 0x65, 0xca, 0xc8, 0xac, 0x54, 0x2b, 0x2f, 0xcf,
-	0xe8, 0x5c, 0xba, 0x9e, 0x4a, 0xd7, 0x1f, 0xa5, 0xde, 0xcc, 0xd1, 0x76, 0x47, 0x93, 0xac, 0x5c,
-	0xcd, 0xca, 0xfa, 0x97, 0x83, 0xc5, 0x9b, 0xa7, 0x0c, 0x49, 0xbf, 0x87, 0x50, 0x66, 0xef, 0xc1,
-	0xdb, 0xe3, 0xfd, 0x85, 0x4a, 0x4e, 0xd8, 0x49, 0xf7, 0xd5, 0xcf, 0xa3, 0x60, 0x6a, 0x0b, 0x45,
-	0x98, 0xb8, 0xf9, 0x9e, 0xdc, 0x07, 0x63, 0x76, 0x92, 0xa7, 0x48, 0x4c, 0xdb, 0x9c, 0x7e, 0x1a,
-	0xd5, 0x49, 0x34, 0xb3, 0x94, 0xf4, 0x86, 0xfb, 0xe5, 0x00, 0xf2, 0x2a, 0x18, 0x0f, 0x19, 0xbc,
-	0xb0, 0x59, 0x19, 0xb2, 0x79, 0x57, 0x4c, 0xc8, 0xbc, 0x92, 0x14, 0xbf, 0xef, 0x68, 0x12, 0x07,
-	0x10, 0x75, 0xf2, 0x6b, 0x20, 0xf3, 0x53, 0x23, 0x3f, 0xa6, 0xe2, 0x05, 0x8d, 0x69, 0x92, 0x73,
-	0x6d, 0xf7, 0x87, 0xf5, 0x0a, 0x88, 0x58, 0xc3, 0x81, 0x01, 0xd7, 0xa0, 0x8c, 0x5e, 0x10, 0xfb,
-	0x04, 0x67, 0x5a, 0x83, 0x01, 0x13, 0x20, 0x6f, 0x80, 0xcb, 0x82, 0x3b, 0x42, 0x14, 0xc5, 0xca,
-	0xd8, 0x3f, 0x57, 0x85, 0x35, 0xb1, 0x9d, 0x35, 0xb1, 0xcc, 0xcb, 0xad, 0xa4, 0x7a, 0xe5, 0xe1,
-	0xb9, 0x96, 0xe6, 0x46, 0x4e, 0xe8, 0xd0, 0x86, 0x54, 0x7f, 0x49, 0xe0, 0x1a, 0xbb, 0x21, 0x77,
-	0x93, 0x7a, 0xfd, 0xcd, 0x79, 0x02, 0x4a, 0x30, 0xbd, 0x88, 0xed, 0x99, 0x1e, 0x92, 0x5b, 0x0f,
-	0x5a, 0xe6, 0xfc, 0x99, 0xc5, 0x58, 0x7d, 0x44, 0x79, 0x1e, 0x4c, 0x42, 0xce, 0xda, 0xf0, 0x11,
-	0xa5, 0xd0, 0x43, 0x54, 0x19, 0x99, 0x2d, 0xd6, 0x4a, 0xd6, 0x55, 0x11, 0xdf, 0x14, 0xe1, 0x95,
-	0xad, 0x37, 0x1f, 0xb4, 0xc2, 0xb9, 0x1c, 0xab, 0x39, 0xc7, 0x7f, 0xf0, 0x56, 0xfd, 0x2a, 0x81,
-	0xb1, 0xf5, 0x04, 0x42, 0x5e, 0x06, 0x97, 0x18, 0x16, 0x8a, 0x98, 0xc7, 0x92, 0xa9, 0x7c, 0x3b,
-	0x58, 0x9c, 0x16, 0x44, 0x75, 0xd7, 0x8d, 0x10, 0xa5, 0xdb, 0x71, 0x84, 0x03, 0xcf, 0x4a, 0x13,
-	0xfb, 0x35, 0x88, 0xfd, 0x14, 0xce, 0x50, 0x33, 0xd0, 0xcd, 0xe2, 0xff, 0xee, 0xa6, 0x59, 0x3f,
-	0xec, 0xaa, 0xd2, 0x51, 0x57, 0x95, 0x7e, 0x76, 0x55, 0xa9, 0xdd, 0x53, 0x0b, 0x47, 0x3d, 0xb5,
-	0xf0, 0xbd, 0xa7, 0x16, 0x1e, 0xcf, 0xfd, 0x75, 0x6f, 0xf7, 0xb2, 0xff, 0x0b, 0x7b, 0x9c, 0xc9,
-	0xb8, 0xfd, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x3d, 0x09, 0x1d, 0x5a, 0x06, 0x00, 0x00,
+	// 777 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0xd3, 0x4a,
+	0x14, 0x8d, 0xf3, 0xd1, 0x2a, 0x93, 0x7e, 0xfa, 0x55, 0x7a, 0x4e, 0xf4, 0xe4, 0x44, 0x79, 0xa2,
+	0x4d, 0x2b, 0xd5, 0x51, 0x8b, 0xd8, 0x74, 0xd5, 0xb8, 0x88, 0xf2, 0xd1, 0x4a, 0x95, 0x5b, 0x36,
+	0x48, 0xc8, 0x1a, 0xc7, 0x53, 0x63, 0x11, 0x7b, 0x8c, 0xc7, 0x81, 0x84, 0x05, 0x6b, 0xc4, 0x02,
+	0x55, 0x62, 0xc3, 0x92, 0x25, 0x62, 0xd5, 0x45, 0xf9, 0x0f, 0x15, 0x0b, 0x54, 0xb1, 0x62, 0x45,
+	0x51, 0xbb, 0xe8, 0x9a, 0x7f, 0x80, 0x3c, 0x33, 0x4e, 0x9c, 0x94, 0x42, 0x0b, 0x2d, 0xdd, 0xb4,
+	0xf6, 0xf5, 0xbd, 0xe7, 0x9e, 0x73, 0xe7, 0xce, 0x51, 0xc0, 0x64, 0x1d, 0x13, 0x07, 0x93, 0xea,
+	0x26, 0x42, 0x96, 0x0f, 0xdd, 0xa0, 0xfa, 0x78, 0xce, 0x40, 0x01, 0x9c, 0xeb, 0x04, 0x14, 0xcf,
+	0xc7, 0x01, 0x16, 0xff, 0x65, 0x79, 0x4a, 0x27, 0xcc, 0xf3, 0x0a, 0x13, 0x16, 0xb6, 0x30, 0xcd,
+	0xa9, 0x86, 0x4f, 0x2c, 0xbd, 0x90, 0xb7, 0x30, 0xb6, 0x1a, 0xa8, 0x4a, 0xdf, 0x8c, 0xe6, 0x66,
+	0x15, 0xba, 0xed, 0xe8, 0x13, 0x43, 0xd2, 0x59, 0x0d, 0x87, 0x65, 0x9f, 0x64, 0x4e, 0xc6, 0x80,
+	0x04, 0x75, 0x88, 0xd4, 0xb1, 0xed, 0xf2, 0xef, 0xe3, 0xd0, 0xb1, 0x5d, 0x5c, 0xa5, 0x7f, 0x79,
+	0xa8, 0xd8, 0xdf, 0x28, 0xb0, 0x1d, 0x44, 0x02, 0xe8, 0x78, 0x11, 0x66, 0x7f, 0x82, 0xd9, 0xf4,
+	0x61, 0x60, 0x63, 0x8e, 0x59, 0x7e, 0x99, 0x04, 0x23, 0x2a, 0x24, 0x76, 0xbd, 0xd6, 0x68, 0xe0,
+	0x27, 0xd0, 0xad, 0x23, 0xf1, 0x11, 0xc8, 0x11, 0x0f, 0xb9, 0xa6, 0xde, 0xb0, 0x1d, 0x3b, 0x90,
+	0x84, 0x52, 0xaa, 0x92, 0x9b, 0xcf, 0x2b, 0x9c, 0x6a, 0x48, 0x2e, 0x52, 0xaf, 0x2c, 0x61, 0xdb,
+	0x55, 0xaf, 0xed, 0x7e, 0x29, 0x26, 0xde, 0xed, 0x17, 0x2b, 0x96, 0x1d, 0x3c, 0x68, 0x1a, 0x4a,
+	0x1d, 0x3b, 0x5c, 0x17, 0xff, 0x37, 0x4b, 0xcc, 0x87, 0xd5, 0xa0, 0xed, 0x21, 0x42, 0x0b, 0xc8,
+	0xdb, 0xa3, 0xed, 0x19, 0x41, 0x03, 0xb4, 0xc9, 0x4a, 0xd8, 0x43, 0x5c, 0x04, 0x00, 0xb5, 0x3c,
+	0x9b, 0x31, 0x93, 0x92, 0x25, 0xa1, 0x92, 0x9b, 0x2f, 0x28, 0x8c, 0xba, 0x12, 0x51, 0x57, 0x36,
+	0x22, 0x6d, 0x6a, 0x7a, 0x6b, 0xbf, 0x28, 0x68, 0xb1, 0x9a, 0x85, 0xe5, 0x0f, 0x3b, 0xb3, 0x57,
+	0x4e, 0x38, 0x24, 0xe5, 0x06, 0x42, 0x1d, 0x79, 0xb7, 0x5e, 0x1c, 0x6d, 0xcf, 0xe4, 0x63, 0xc4,
+	0x7a, 0xd5, 0x97, 0xdf, 0xa7, 0xc1, 0xf8, 0x1a, 0xf2, 0x6d, 0x6c, 0xc6, 0x67, 0x72, 0x13, 0x64,
+	0x8c, 0x30, 0x4f, 0x12, 0x28, 0xb7, 0x29, 0xe5, 0xa4, 0x56, 0xbd, 0x68, 0x6a, 0x36, 0x9c, 0x0d,
+	0xd3, 0xcb, 0x00, 0xc4, 0x45, 0x30, 0xe0, 0x51, 0x78, 0x2e, 0x33, 0x7f, 0x4c, 0xe6, 0x75, 0x7e,
+	0x42, 0xea, 0x70, 0x58, 0xfc, 0x7a, 0xbf, 0x28, 0x30, 0x00, 0x5e, 0x27, 0x3e, 0x03, 0x22, 0x7b,
+	0xd2, 0xe3, 0xc7, 0x94, 0xba, 0xa0, 0x63, 0x1a, 0x63, 0xbd, 0xd6, 0xbb, 0x87, 0xf5, 0x14, 0xf0,
+	0x98, 0x5e, 0x87, 0x2e, 0xe3, 0x20, 0xa5, 0x2f, 0xa8, 0xfb, 0x08, 0xeb, 0xb4, 0x04, 0x5d, 0x4a,
+	0x40, 0x5c, 0x01, 0x43, 0xbc, 0xb7, 0x8f, 0x08, 0x0a, 0xa4, 0xcc, 0x2f, 0x57, 0x85, 0x0e, 0x71,
+	0xab, 0x33, 0xc4, 0x1c, 0x2b, 0xd7, 0xc2, 0xea, 0x85, 0xdb, 0x67, 0x5a, 0x9a, 0xff, 0x62, 0x44,
+	0x8f, 0x6d, 0x48, 0xf9, 0x9b, 0x00, 0xfe, 0xa1, 0x6f, 0xc8, 0x5c, 0x25, 0x56, 0x77, 0x73, 0xee,
+	0x83, 0x2c, 0x8c, 0x5e, 0xf8, 0xf6, 0x4c, 0x1c, 0xa3, 0x5b, 0x73, 0xdb, 0xea, 0xf4, 0xa9, 0xc9,
+	0x68, 0x5d, 0x44, 0x71, 0x1a, 0x8c, 0x41, 0xd6, 0x55, 0x77, 0x10, 0x21, 0xd0, 0x42, 0x44, 0x4a,
+	0x96, 0x52, 0x95, 0xac, 0x36, 0xca, 0xe3, 0xab, 0x3c, 0xbc, 0xb0, 0xf6, 0xfc, 0x4d, 0x31, 0x71,
+	0x26, 0xc5, 0x72, 0x4c, 0xf1, 0x0f, 0xb4, 0x95, 0x5f, 0x25, 0xc1, 0xa8, 0xda, 0x34, 0x2d, 0x14,
+	0x74, 0xf5, 0xde, 0x01, 0x83, 0x06, 0x0d, 0x11, 0xee, 0x1c, 0x93, 0x27, 0xde, 0x95, 0x55, 0x62,
+	0x6d, 0xb4, 0x3d, 0xc4, 0x10, 0xe2, 0x57, 0x25, 0x42, 0x10, 0xff, 0x07, 0x23, 0x0e, 0x6c, 0xe9,
+	0x16, 0x24, 0xba, 0x87, 0x7c, 0x3d, 0x68, 0xd1, 0x4b, 0x93, 0xd6, 0x72, 0x0e, 0x6c, 0x2d, 0x43,
+	0xb2, 0x86, 0xfc, 0x8d, 0x56, 0x9f, 0x79, 0xa4, 0x7e, 0xc3, 0x3c, 0x56, 0xce, 0x3c, 0x99, 0x42,
+	0xdc, 0x40, 0x7a, 0x27, 0x50, 0xde, 0x4e, 0x83, 0xe1, 0x1e, 0x69, 0x62, 0x09, 0x0c, 0x39, 0xc4,
+	0xd2, 0xc3, 0xed, 0xd6, 0x9b, 0x7e, 0x83, 0xae, 0x41, 0x56, 0x03, 0x0e, 0x4b, 0xba, 0xeb, 0x37,
+	0xfa, 0x3d, 0x37, 0xf9, 0x57, 0x3c, 0x37, 0x32, 0xa2, 0xd4, 0xb9, 0x1a, 0x51, 0xfa, 0x52, 0x8d,
+	0x28, 0x73, 0x49, 0x46, 0x34, 0xf0, 0x27, 0x46, 0x54, 0xfe, 0x28, 0x80, 0xcc, 0x72, 0xb8, 0x71,
+	0xe2, 0x3c, 0x18, 0xa4, 0xab, 0x87, 0x7c, 0xb6, 0x25, 0xaa, 0xf4, 0x69, 0x67, 0x76, 0x82, 0xab,
+	0xa9, 0x99, 0xa6, 0x8f, 0x08, 0x59, 0x0f, 0x7c, 0xdb, 0xb5, 0xb4, 0x28, 0xb1, 0x5b, 0x83, 0xa4,
+	0xe4, 0xe9, 0x6a, 0xfa, 0x6c, 0x29, 0x75, 0xde, 0xb6, 0xa4, 0xd6, 0x76, 0x0f, 0x64, 0x61, 0xef,
+	0x40, 0x16, 0xbe, 0x1e, 0xc8, 0xc2, 0xd6, 0xa1, 0x9c, 0xd8, 0x3b, 0x94, 0x13, 0x9f, 0x0f, 0xe5,
+	0xc4, 0xbd, 0xa9, 0x9f, 0xce, 0xbd, 0xd5, 0xf9, 0xe1, 0x65, 0x0c, 0x50, 0x1a, 0x57, 0xbf, 0x0f,
+	0x00, 0xba, 0xaf, 0xb4, 0x8d, 0xa3, 0x09, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -506,6 +661,146 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BudgetAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintFeegrant(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Budgets) > 0 {
+		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTypeBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintFeegrant(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintFeegrant(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -631,6 +926,63 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
+func (m *BudgetAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxGasPerTx))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTypeBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1113,6 +1465,395 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BudgetAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BudgetAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BudgetAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budgets = append(m.Budgets, MsgTypeBudget{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
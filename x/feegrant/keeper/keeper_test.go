@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	"github.com/cosmos/cosmos-sdk/x/feegrant/module"
@@ -253,6 +254,46 @@ func (suite *KeeperTestSuite) TestUseGrantedFee() {
 	suite.Contains(err.Error(), "fee-grant not found")
 }
 
+func (suite *KeeperTestSuite) TestUseGrantedFeeMsgBudget() {
+	granter, grantee := suite.addrs[0], suite.addrs[3]
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+	send, multiSend := &banktypes.MsgSend{}, &banktypes.MsgMultiSend{}
+
+	allowance := feegrant.NewBudgetAllowance([]feegrant.MsgTypeBudget{
+		{MsgTypeUrl: sdk.MsgTypeURL(send), SpendLimit: atom(10)},
+		{MsgTypeUrl: sdk.MsgTypeURL(multiSend), SpendLimit: atom(20)},
+	}, 0, nil)
+	err := suite.feegrantKeeper.GrantAllowance(suite.ctx, granter, grantee, allowance)
+	suite.Require().NoError(err)
+
+	suite.T().Log("verify the fee is deducted from the budget of each msg type")
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, atom(8), []sdk.Msg{send, multiSend})
+	suite.Require().NoError(err)
+
+	loaded, err := suite.feegrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.Require().NoError(err)
+	budgets := loaded.(*feegrant.BudgetAllowance).Budgets
+	suite.Require().Equal(atom(6), budgets[0].SpendLimit)
+	suite.Require().Equal(atom(16), budgets[1].SpendLimit)
+
+	suite.T().Log("verify a rejected fee leaves every budget unchanged")
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, atom(14), []sdk.Msg{multiSend, send})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+
+	loaded, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(budgets, loaded.(*feegrant.BudgetAllowance).Budgets)
+
+	suite.T().Log("verify the allowance is revoked once every budget is used up")
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, atom(12), []sdk.Msg{send, multiSend})
+	suite.Require().NoError(err)
+	err = suite.feegrantKeeper.UseGrantedFees(suite.ctx, granter, grantee, atom(10), []sdk.Msg{multiSend})
+	suite.Require().NoError(err)
+
+	_, err = suite.feegrantKeeper.GetAllowance(suite.ctx, granter, grantee)
+	suite.Require().ErrorContains(err, "fee-grant not found")
+}
+
 func (suite *KeeperTestSuite) TestIterateGrants() {
 	eth := sdk.NewCoins(sdk.NewInt64Coin("eth", 123))
 	exp := suite.ctx.BlockTime().AddDate(1, 0, 0)
@@ -6,11 +6,62 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
+// BeginBlocker executes the params updates scheduled at the current block
+// height, before the other modules' begin blockers use their params.
+func BeginBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	logger := keeper.Logger(ctx)
+
+	keeper.IterateDueScheduledParamsUpdates(ctx, ctx.BlockHeight(), func(update v1.ScheduledParamsUpdate) bool {
+		keeper.DeleteScheduledParamsUpdate(ctx, update)
+
+		// The params update may mutate state thus we use a cached context. If
+		// its handler fails, no state mutation is written and the error message
+		// is logged.
+		var res *sdk.Result
+		cacheCtx, writeCache := ctx.CacheContext()
+		msg, err := update.GetParamsUpdateMsg()
+		if err == nil {
+			if handler := keeper.Router().Handler(msg); handler != nil {
+				res, err = handler(cacheCtx, msg)
+			} else {
+				err = sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+			}
+		}
+
+		tagValue := types.AttributeValueParamsUpdateExecuted
+		if err == nil {
+			// write state to the underlying multi-store
+			writeCache()
+
+			// propagate the msg events to the current context
+			ctx.EventManager().EmitEvents(res.GetEvents())
+
+			logger.Info("scheduled params update executed", "id", update.Id, "msg", update.Msg.TypeUrl)
+		} else {
+			tagValue = types.AttributeValueParamsUpdateFailed
+			logger.Error("scheduled params update failed on execution", "id", update.Id, "msg", update.Msg.TypeUrl, "err", err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteParamsUpdate,
+				sdk.NewAttribute(types.AttributeKeyParamsUpdateID, fmt.Sprintf("%d", update.Id)),
+				sdk.NewAttribute(types.AttributeKeyParamsUpdateMsg, update.Msg.TypeUrl),
+				sdk.NewAttribute(types.AttributeKeyParamsUpdateResult, tagValue),
+			),
+		)
+		return false
+	})
+}
+
 // EndBlocker called every block, process inflation, update validator set.
 func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
@@ -54,7 +105,43 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// Tally deletes the votes of the proposal. An expedited proposal that
+		// fails is converted into a regular proposal and keeps its votes, so it
+		// is tallied in a cached context that is only written if it is final.
+		tallyCtx, writeTally := ctx.CacheContext()
+		passes, burnDeposits, tallyResults := keeper.Tally(tallyCtx, proposal)
+
+		if proposal.Expedited && !passes {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+			// the proposal gets the remaining time of the regular voting period
+			// of its messages and is tallied again with the regular threshold
+			// once it ends
+			votingPeriod := keeper.GetProposalMessageBasedParams(ctx, proposal).VotingPeriod
+			endTime := proposal.VotingStartTime.Add(*votingPeriod)
+			proposal.VotingEndTime = &endTime
+			proposal.Expedited = false
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+			logger.Info(
+				"expedited proposal converted to regular",
+				"proposal", proposal.Id,
+				"voting_end_time", endTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		writeTally()
 
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
@@ -95,6 +182,10 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 				proposal.Status = v1.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
+				if proposal.Optimistic {
+					tagValue = types.AttributeValueOptimisticProposalPassed
+					logMsg = "passed optimistically"
+				}
 
 				// write state to the underlying multi-store
 				writeCache()
@@ -110,6 +201,10 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) {
 			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
 			logMsg = "rejected"
+			if proposal.Optimistic {
+				tagValue = types.AttributeValueOptimisticProposalRejected
+				logMsg = "rejected by optimistic rejected threshold"
+			}
 		}
 
 		proposal.FinalTallyResult = &tallyResults
@@ -44,6 +44,8 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -100,6 +102,8 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -126,6 +130,8 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -187,6 +193,8 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -240,7 +248,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 5))}
-	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "", "Proposal", "description of proposal")
+	newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "", "Proposal", "description of proposal", false, false)
 	require.NoError(t, err)
 
 	wrapCtx := sdk.WrapSDKContext(ctx)
@@ -309,7 +317,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0])
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", "title", "summary", addrs[0], false, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -358,7 +366,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	staking.EndBlocker(ctx, suite.StakingKeeper)
 
 	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))))
-	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "Bank Msg Send", "send message", addrs[0])
+	proposal, err := suite.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", "Bank Msg Send", "send message", addrs[0], false, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -384,6 +392,292 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestBeginBlockerScheduledParamsUpdates(t *testing.T) {
+	suite := createTestSuite(t)
+	app := suite.App
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 1, valTokens)
+	authority := suite.GovKeeper.GetAuthority()
+
+	bankParams := suite.BankKeeper.GetParams(ctx)
+	require.True(t, bankParams.DefaultSendEnabled)
+	bankParams.DefaultSendEnabled = false
+
+	_, err := suite.GovKeeper.ScheduleParamsUpdate(ctx, ctx.BlockHeight()+2, &banktypes.MsgUpdateParams{Authority: authority, Params: bankParams})
+	require.NoError(t, err)
+
+	// a params update with a wrong authority fails on execution
+	failedUpdate, err := v1.NewScheduledParamsUpdate(100, ctx.BlockHeight()+2, &banktypes.MsgUpdateParams{Authority: addrs[0].String(), Params: bankParams})
+	require.NoError(t, err)
+	suite.GovKeeper.SetScheduledParamsUpdate(ctx, failedUpdate)
+
+	cancelledID, err := suite.GovKeeper.ScheduleParamsUpdate(ctx, ctx.BlockHeight()+2, &banktypes.MsgUpdateParams{Authority: authority, Params: banktypes.DefaultParams()})
+	require.NoError(t, err)
+	require.NoError(t, suite.GovKeeper.CancelScheduledParamsUpdate(ctx, cancelledID))
+
+	// nothing is executed before the scheduled height
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	gov.BeginBlocker(ctx, suite.GovKeeper)
+	require.True(t, suite.BankKeeper.GetParams(ctx).DefaultSendEnabled)
+	require.Len(t, suite.GovKeeper.GetAllScheduledParamsUpdates(ctx), 2)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	gov.BeginBlocker(ctx, suite.GovKeeper)
+	require.False(t, suite.BankKeeper.GetParams(ctx).DefaultSendEnabled)
+	require.Empty(t, suite.GovKeeper.GetAllScheduledParamsUpdates(ctx))
+
+	var results []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeExecuteParamsUpdate {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyParamsUpdateResult {
+				results = append(results, attr.Value)
+			}
+		}
+	}
+	require.Equal(t, []string{types.AttributeValueParamsUpdateExecuted, types.AttributeValueParamsUpdateFailed}, results)
+}
+
+func TestExpeditedProposalPassAndConversionToRegular(t *testing.T) {
+	testcases := map[string]struct {
+		// votes of the three validators during the expedited voting period
+		expeditedVotes []v1.VoteOption
+		// vote of the last validator after the conversion to a regular proposal
+		regularVote        v1.VoteOption
+		expeditedPasses    bool
+		expectedFinalState v1.ProposalStatus
+	}{
+		"expedited passes": {
+			expeditedVotes:     []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionYes},
+			expeditedPasses:    true,
+			expectedFinalState: v1.StatusPassed,
+		},
+		"expedited fails, converted to regular, regular passes with the votes kept": {
+			expeditedVotes:     []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo},
+			expectedFinalState: v1.StatusPassed,
+		},
+		"expedited fails, converted to regular, regular passes with a new vote": {
+			expeditedVotes:     []v1.VoteOption{v1.OptionYes, v1.OptionNo},
+			regularVote:        v1.OptionYes,
+			expectedFinalState: v1.StatusPassed,
+		},
+		"expedited fails, converted to regular, regular is rejected": {
+			expeditedVotes:     []v1.VoteOption{v1.OptionYes, v1.OptionNo},
+			regularVote:        v1.OptionNo,
+			expectedFinalState: v1.StatusRejected,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+			createValidators(t, stakingMsgSvr, ctx, valAddrs, []int64{10, 10, 10})
+			staking.EndBlocker(ctx, suite.StakingKeeper)
+
+			params := suite.GovKeeper.GetParams(ctx)
+			params.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, suite.StakingKeeper.TokensFromConsensusPower(ctx, 20)))
+			require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+			macc := suite.GovKeeper.GetGovernanceAccount(ctx)
+			initialModuleAccCoins := suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
+
+			// a deposit high enough for a regular proposal does not start the
+			// voting period of an expedited proposal
+			msg, err := v1.NewMsgSubmitProposal(
+				[]sdk.Msg{mkTestLegacyContent(t)},
+				params.MinDeposit,
+				addrs[0].String(),
+				"",
+				"Proposal",
+				"description of proposal",
+				true,
+				false,
+			)
+			require.NoError(t, err)
+
+			res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			require.NoError(t, err)
+			proposalID := res.ProposalId
+
+			proposal, ok := suite.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.True(t, proposal.Expedited)
+			require.Equal(t, v1.StatusDepositPeriod, proposal.Status)
+
+			_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), v1.NewMsgDeposit(addrs[1], proposalID, params.ExpeditedMinDeposit))
+			require.NoError(t, err)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, proposal.VotingStartTime.Add(*params.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+			for i, option := range tc.expeditedVotes {
+				require.NoError(t, suite.GovKeeper.AddVote(ctx, proposalID, addrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			// end the expedited voting period
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+
+			if tc.expeditedPasses {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+				return
+			}
+
+			// the proposal is converted to a regular proposal, keeping its votes
+			// and deposits
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+			require.Len(t, suite.GovKeeper.GetVotes(ctx, proposalID), len(tc.expeditedVotes))
+			require.False(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+
+			if tc.regularVote != v1.OptionEmpty {
+				require.NoError(t, suite.GovKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(tc.regularVote), ""))
+			}
+
+			// end the regular voting period
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.Equal(t, tc.expectedFinalState, proposal.Status)
+			require.True(t, suite.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
+		})
+	}
+}
+
+func TestOptimisticProposal(t *testing.T) {
+	testcases := map[string]struct {
+		// votes of the three validators
+		votes            []v1.VoteOption
+		expectedStatus   v1.ProposalStatus
+		expectedTagValue string
+	}{
+		"no votes, passes": {
+			expectedStatus:   v1.StatusPassed,
+			expectedTagValue: types.AttributeValueOptimisticProposalPassed,
+		},
+		"yes and abstain votes are not counted, passes": {
+			votes:            []v1.VoteOption{v1.OptionYes, v1.OptionAbstain},
+			expectedStatus:   v1.StatusPassed,
+			expectedTagValue: types.AttributeValueOptimisticProposalPassed,
+		},
+		"no votes reach the rejected threshold, rejected": {
+			votes:            []v1.VoteOption{v1.OptionYes, v1.OptionYes, v1.OptionNo},
+			expectedStatus:   v1.StatusRejected,
+			expectedTagValue: types.AttributeValueOptimisticProposalRejected,
+		},
+		"no with veto votes reach the rejected threshold, rejected": {
+			votes:            []v1.VoteOption{v1.OptionNoWithVeto},
+			expectedStatus:   v1.StatusRejected,
+			expectedTagValue: types.AttributeValueOptimisticProposalRejected,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			suite := createTestSuite(t)
+			app := suite.App
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simtestutil.AddTestAddrs(suite.BankKeeper, suite.StakingKeeper, ctx, 3, valTokens)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(suite.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(suite.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+			createValidators(t, stakingMsgSvr, ctx, valAddrs, []int64{10, 10, 10})
+			staking.EndBlocker(ctx, suite.StakingKeeper)
+
+			params := suite.GovKeeper.GetParams(ctx)
+			params.OptimisticAuthorizedAddresses = []string{addrs[0].String()}
+			params.OptimisticAllowedMsgUrls = []string{sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})}
+			params.OptimisticRejectedThreshold = "0.3"
+			require.NoError(t, suite.GovKeeper.SetParams(ctx, params))
+
+			msg, err := v1.NewMsgSubmitProposal(
+				[]sdk.Msg{mkTestLegacyContent(t)},
+				params.MinDeposit,
+				addrs[0].String(),
+				"",
+				"Proposal",
+				"description of proposal",
+				false,
+				true,
+			)
+			require.NoError(t, err)
+
+			res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), msg)
+			require.NoError(t, err)
+			proposalID := res.ProposalId
+
+			proposal, ok := suite.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.True(t, proposal.Optimistic)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+
+			for i, option := range tc.votes {
+				require.NoError(t, suite.GovKeeper.AddVote(ctx, proposalID, addrs[i], v1.NewNonSplitVoteOption(option), ""))
+			}
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+
+			gov.EndBlocker(ctx, suite.GovKeeper)
+
+			proposal, ok = suite.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.Equal(t, tc.expectedStatus, proposal.Status)
+
+			var tagValue string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypeActiveProposal {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					if attr.Key == types.AttributeKeyProposalResult {
+						tagValue = attr.Value
+					}
+				}
+			}
+			require.Equal(t, tc.expectedTagValue, tagValue)
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -38,6 +38,14 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDeposit(),
 		GetCmdQueryDeposits(),
 		GetCmdQueryTally(),
+		GetCmdQueryMessageBasedParams(),
+		GetCmdQueryProposalMessageBasedParams(),
+		GetCmdQueryGovernor(),
+		GetCmdQueryGovernors(),
+		GetCmdQueryGovernanceDelegation(),
+		GetCmdQueryGovernanceDelegations(),
+		GetCmdQueryScheduledParamsUpdate(),
+		GetCmdQueryScheduledParamsUpdates(),
 	)
 
 	return govQueryCmd
@@ -508,6 +516,94 @@ $ %s query gov tally 1
 	return cmd
 }
 
+// GetCmdQueryMessageBasedParams implements the query message based params command.
+func GetCmdQueryMessageBasedParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "message-based-params [msg-url]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the voting period and tally params of a message type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting period and tally params that apply to proposals
+containing a message type. The default params are returned if the message type
+has no params of its own.
+
+Example:
+$ %s query gov message-based-params /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MessageBasedParams(
+				cmd.Context(),
+				&v1.QueryMessageBasedParamsRequest{MsgUrl: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryProposalMessageBasedParams implements the query proposal message based params command.
+func GetCmdQueryProposalMessageBasedParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposal-message-based-params [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the voting period and tally params of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting period and tally params that apply to a proposal,
+which are the strictest params of the messages it contains. You can find the
+proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s query gov proposal-message-based-params 1
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			res, err := queryClient.ProposalMessageBasedParams(
+				cmd.Context(),
+				&v1.QueryProposalMessageBasedParamsRequest{ProposalId: proposalID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 //
 //nolint:staticcheck // this function contains deprecated commands that we need.
@@ -652,3 +748,261 @@ $ %s query gov proposer 1
 
 	return cmd
 }
+
+// GetCmdQueryGovernor implements the query governor command.
+func GetCmdQueryGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governor [governor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of a governor, including the voting power delegated to it.
+
+Example:
+$ %s query gov governor cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Governor(
+				cmd.Context(),
+				&v1.QueryGovernorRequest{GovernorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernors implements the query governors command.
+func GetCmdQueryGovernors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governors",
+		Args:  cobra.NoArgs,
+		Short: "Query all governors",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all governors with pagination.
+
+Example:
+$ %s query gov governors
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Governors(
+				cmd.Context(),
+				&v1.QueryGovernorsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "governors")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernanceDelegation implements the query governance delegation command.
+func GetCmdQueryGovernanceDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governance-delegation [delegator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governance delegation of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governor a delegator delegates its governance voting power to.
+
+Example:
+$ %s query gov governance-delegation cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GovernanceDelegation(
+				cmd.Context(),
+				&v1.QueryGovernanceDelegationRequest{DelegatorAddress: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryGovernanceDelegations implements the query governance delegations command.
+func GetCmdQueryGovernanceDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governance-delegations [governor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the governance delegations to a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the governance delegations to a governor with pagination.
+
+Example:
+$ %s query gov governance-delegations cosmos1...
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GovernanceDelegations(
+				cmd.Context(),
+				&v1.QueryGovernanceDelegationsRequest{GovernorAddress: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "governance delegations")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryScheduledParamsUpdate implements the query scheduled params update command.
+func GetCmdQueryScheduledParamsUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-params-update [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a scheduled params update",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of a params update scheduled by governance, including its execution height.
+
+Example:
+$ %s query gov scheduled-params-update 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			// validate that the id is a uint
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("id %s not a valid uint, please input a valid scheduled params update id", args[0])
+			}
+
+			res, err := queryClient.ScheduledParamsUpdate(
+				cmd.Context(),
+				&v1.QueryScheduledParamsUpdateRequest{Id: id},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryScheduledParamsUpdates implements the query scheduled params updates command.
+func GetCmdQueryScheduledParamsUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "scheduled-params-updates",
+		Args:  cobra.NoArgs,
+		Short: "Query all scheduled params updates",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all params updates scheduled by governance with pagination.
+
+Example:
+$ %s query gov scheduled-params-updates
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := v1.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ScheduledParamsUpdates(
+				cmd.Context(),
+				&v1.QueryScheduledParamsUpdatesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "scheduled params updates")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
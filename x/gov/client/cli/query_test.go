@@ -381,3 +381,138 @@ func (s *CLITestSuite) TestCmdQueryVote() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestCmdQueryMessageBasedParams() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"msg url",
+			[]string{
+				"/cosmos.bank.v1beta1.MsgSend",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"/cosmos.bank.v1beta1.MsgSend --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryMessageBasedParams()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryProposalMessageBasedParams() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"proposal id",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"1 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryProposalMessageBasedParams()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryGovernor() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"governor address",
+			[]string{
+				"cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryGovernor()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryGovernanceDelegations() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"governor address",
+			[]string{
+				"cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryGovernanceDelegations()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCmdQueryScheduledParamsUpdate() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"scheduled params update id",
+			[]string{
+				"1",
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+			},
+			"1 --output=json",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryScheduledParamsUpdate()
+			cmd.SetArgs(tc.args)
+			s.Require().Contains(fmt.Sprint(cmd), strings.TrimSpace(tc.expCmdOutput))
+		})
+	}
+}
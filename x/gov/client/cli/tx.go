@@ -31,6 +31,8 @@ const (
 	flagStatus       = "status"
 	FlagMetadata     = "metadata"
 	FlagSummary      = "summary"
+	FlagExpedited    = "expedited"
+	FlagOptimistic   = "optimistic"
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 )
@@ -71,6 +73,12 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
+		NewCmdCancelProposal(),
+		NewCmdCreateGovernor(),
+		NewCmdEditGovernor(),
+		NewCmdRemoveGovernor(),
+		NewCmdDelegateGovernance(),
+		NewCmdUndelegateGovernance(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -109,7 +117,13 @@ Where proposal.json contains:
   "metadata: "4pIMOgIGx1vZGU=",
   "deposit": "10stake"
   "title: "My proposal"
-  "summary": "A short summary of my proposal"
+  "summary": "A short summary of my proposal",
+  // expedited proposals have a shorter voting period, a higher threshold and
+  // a higher minimum deposit
+  "expedited": false,
+  // optimistic proposals pass at the end of their voting period unless enough
+  // stake votes against them; only allow-listed proposers and messages
+  "optimistic": false
 }
 
 metadata example: 
@@ -131,12 +145,12 @@ metadata example:
 				return err
 			}
 
-			msgs, metadata, title, summary, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			proposal, msgs, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
 			if err != nil {
 				return err
 			}
 
-			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary)
+			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), proposal.Metadata, proposal.Title, proposal.Summary, proposal.Expedited, proposal.Optimistic)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
@@ -219,6 +233,50 @@ $ %s tx gov submit-legacy-proposal --title="Test Proposal" --description="My awe
 	return cmd
 }
 
+// NewCmdCancelProposal implements canceling a proposal in its voting period.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a governance proposal in its voting period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a governance proposal in its voting period. Only the proposer
+of the proposal can cancel it. A part of the deposits, defined by the
+proposal_cancel_ratio param, is burned or sent to the proposal_cancel_dest
+address, and the rest is refunded to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// Get proposer address
+			from := clientCtx.GetFromAddress()
+
+			msg := v1.NewMsgCancelProposal(proposalID, from.String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdDeposit implements depositing tokens for an active proposal.
 func NewCmdDeposit() *cobra.Command {
 	cmd := &cobra.Command{
@@ -374,3 +432,185 @@ $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from
 
 	return cmd
 }
+
+// NewCmdCreateGovernor implements registering the sender as a governor.
+func NewCmdCreateGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-governor [metadata]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Register as a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register the sender as a governor, to whom other accounts can delegate
+their governance voting power. Accounts delegating their governance voting
+power cannot register as governors.
+
+Example:
+$ %s tx gov create-governor "ipfs://CID" --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var metadata string
+			if len(args) > 0 {
+				metadata = args[0]
+			}
+
+			msg := v1.NewMsgCreateGovernor(clientCtx.GetFromAddress(), metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdEditGovernor implements editing the metadata of the sender governor.
+func NewCmdEditGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-governor [metadata]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Edit the metadata of a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit the metadata of the sender governor.
+
+Example:
+$ %s tx gov edit-governor "ipfs://CID" --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var metadata string
+			if len(args) > 0 {
+				metadata = args[0]
+			}
+
+			msg := v1.NewMsgEditGovernor(clientCtx.GetFromAddress(), metadata)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdRemoveGovernor implements unregistering the sender as a governor.
+func NewCmdRemoveGovernor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-governor",
+		Args:  cobra.NoArgs,
+		Short: "Unregister as a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unregister the sender as a governor. The governance delegations to the
+sender are removed, so its delegators inherit the votes of their validators
+again.
+
+Example:
+$ %s tx gov remove-governor --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgRemoveGovernor(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdDelegateGovernance implements delegating governance voting power to a governor.
+func NewCmdDelegateGovernance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate-governance [governor-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delegate governance voting power to a governor",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate the governance voting power of the sender to a governor, who
+then votes with the bonded stake of the sender on the proposals the sender
+doesn't vote on. Any previous governance delegation of the sender is replaced.
+
+Example:
+$ %s tx gov delegate-governance cosmos1... --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			governorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgDelegateGovernance(clientCtx.GetFromAddress(), governorAddr)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCmdUndelegateGovernance implements removing the governance delegation of the sender.
+func NewCmdUndelegateGovernance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate-governance",
+		Args:  cobra.NoArgs,
+		Short: "Remove the governance delegation of the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the governance delegation of the sender, whose voting power is
+then inherited by their validators again.
+
+Example:
+$ %s tx gov undelegate-governance --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := v1.NewMsgUndelegateGovernance(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -82,25 +82,27 @@ func parseSubmitLegacyProposal(fs *pflag.FlagSet) (*legacyProposal, error) {
 // proposal defines the new Msg-based proposal.
 type proposal struct {
 	// Msgs defines an array of sdk.Msgs proto-JSON-encoded as Anys.
-	Messages []json.RawMessage `json:"messages,omitempty"`
-	Metadata string            `json:"metadata"`
-	Deposit  string            `json:"deposit"`
-	Title    string            `json:"title"`
-	Summary  string            `json:"summary"`
+	Messages   []json.RawMessage `json:"messages,omitempty"`
+	Metadata   string            `json:"metadata"`
+	Deposit    string            `json:"deposit"`
+	Title      string            `json:"title"`
+	Summary    string            `json:"summary"`
+	Expedited  bool              `json:"expedited"`
+	Optimistic bool              `json:"optimistic"`
 }
 
 // parseSubmitProposal reads and parses the proposal.
-func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, string, string, sdk.Coins, error) {
+func parseSubmitProposal(cdc codec.Codec, path string) (proposal, []sdk.Msg, sdk.Coins, error) {
 	var proposal proposal
 
 	contents, err := os.ReadFile(path)
 	if err != nil {
-		return nil, "", "", "", nil, err
+		return proposal, nil, nil, err
 	}
 
 	err = json.Unmarshal(contents, &proposal)
 	if err != nil {
-		return nil, "", "", "", nil, err
+		return proposal, nil, nil, err
 	}
 
 	msgs := make([]sdk.Msg, len(proposal.Messages))
@@ -108,7 +110,7 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, strin
 		var msg sdk.Msg
 		err := cdc.UnmarshalInterfaceJSON(anyJSON, &msg)
 		if err != nil {
-			return nil, "", "", "", nil, err
+			return proposal, nil, nil, err
 		}
 
 		msgs[i] = msg
@@ -116,10 +118,10 @@ func parseSubmitProposal(cdc codec.Codec, path string) ([]sdk.Msg, string, strin
 
 	deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
 	if err != nil {
-		return nil, "", "", "", nil, err
+		return proposal, nil, nil, err
 	}
 
-	return msgs, proposal.Metadata, proposal.Title, proposal.Summary, deposit, nil
+	return proposal, msgs, deposit, nil
 }
 
 // AddGovPropFlagsToCmd adds flags for defining MsgSubmitProposal fields.
@@ -130,6 +132,8 @@ func AddGovPropFlagsToCmd(cmd *cobra.Command) {
 	cmd.Flags().String(FlagMetadata, "", "The metadata to include with the governance proposal")
 	cmd.Flags().String(FlagTitle, "", "The title to put on the governance proposal")
 	cmd.Flags().String(FlagSummary, "", "The summary to include with the governance proposal")
+	cmd.Flags().Bool(FlagExpedited, false, "Whether to submit the governance proposal as an expedited proposal")
+	cmd.Flags().Bool(FlagOptimistic, false, "Whether to submit the governance proposal as an optimistic proposal")
 }
 
 // ReadGovPropFlags parses a MsgSubmitProposal from the provided context and flags.
@@ -165,6 +169,16 @@ func ReadGovPropFlags(clientCtx client.Context, flagSet *pflag.FlagSet) (*govv1.
 		return nil, fmt.Errorf("could not read summary: %w", err)
 	}
 
+	rv.Expedited, err = flagSet.GetBool(FlagExpedited)
+	if err != nil {
+		return nil, fmt.Errorf("could not read expedited: %w", err)
+	}
+
+	rv.Optimistic, err = flagSet.GetBool(FlagOptimistic)
+	if err != nil {
+		return nil, fmt.Errorf("could not read optimistic: %w", err)
+	}
+
 	rv.Proposer = clientCtx.GetFromAddress().String()
 
 	return rv, nil
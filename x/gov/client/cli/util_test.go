@@ -163,25 +163,26 @@ func TestParseSubmitProposal(t *testing.T) {
 	"metadata": "%s",
 	"title": "My awesome title",
 	"summary": "My awesome summary",
-	"deposit": "1000test"
+	"deposit": "1000test",
+	"expedited": true
 }
 `, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata)))
 
 	badJSON := testutil.WriteToNewTempFile(t, "bad json")
 
 	// nonexistent json
-	_, _, _, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist") //nolint: dogsled
+	_, _, _, err := parseSubmitProposal(cdc, "fileDoesNotExist") //nolint: dogsled
 	require.Error(t, err)
 
 	// invalid json
-	_, _, _, _, _, err = parseSubmitProposal(cdc, badJSON.Name()) //nolint: dogsled
+	_, _, _, err = parseSubmitProposal(cdc, badJSON.Name()) //nolint: dogsled
 	require.Error(t, err)
 
 	// ok json
-	msgs, metadata, title, summary, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
+	proposal, msgs, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
 	require.NoError(t, err, "unexpected error")
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))), deposit)
-	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), metadata)
+	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), proposal.Metadata)
 	require.Len(t, msgs, 3)
 	msg1, ok := msgs[0].(*banktypes.MsgSend)
 	require.True(t, ok)
@@ -200,8 +201,9 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "My awesome title", textProp.Title)
 	require.Equal(t, "My awesome description", textProp.Description)
-	require.Equal(t, "My awesome title", title)
-	require.Equal(t, "My awesome summary", summary)
+	require.Equal(t, "My awesome title", proposal.Title)
+	require.Equal(t, "My awesome summary", proposal.Summary)
+	require.True(t, proposal.Expedited)
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
@@ -262,12 +264,14 @@ func TestAddGovPropFlagsToCmd(t *testing.T) {
 	expMetadataDesc := "The metadata to include with the governance proposal"
 	expTitleDesc := "The title to put on the governance proposal"
 	expSummaryDesc := "The summary to include with the governance proposal"
+	expExpeditedDesc := "Whether to submit the governance proposal as an expedited proposal"
 	// Regexp notes: (?m:...) = multi-line mode so ^ and $ match the beginning and end of each line.
 	// Each regexp assertion checks for a line containing only a specific flag and its description.
 	assert.Regexp(t, `(?m:^\s+--`+FlagDeposit+` string\s+`+expDepositDesc+`$)`, help, "help output")
 	assert.Regexp(t, `(?m:^\s+--`+FlagMetadata+` string\s+`+expMetadataDesc+`$)`, help, "help output")
 	assert.Regexp(t, `(?m:^\s+--`+FlagTitle+` string\s+`+expTitleDesc+`$)`, help, "help output")
 	assert.Regexp(t, `(?m:^\s+--`+FlagSummary+` string\s+`+expSummaryDesc+`$)`, help, "help output")
+	assert.Regexp(t, `(?m:^\s+--`+FlagExpedited+`\s+`+expExpeditedDesc+`$)`, help, "help output")
 }
 
 func TestReadGovPropFlags(t *testing.T) {
@@ -276,6 +280,7 @@ func TestReadGovPropFlags(t *testing.T) {
 	argMetadata := "--" + FlagMetadata
 	argTitle := "--" + FlagTitle
 	argSummary := "--" + FlagSummary
+	argExpedited := "--" + FlagExpedited
 
 	// cz is a shorter way to define coins objects for these tests.
 	cz := func(coins string) sdk.Coins {
@@ -512,6 +517,33 @@ func TestReadGovPropFlags(t *testing.T) {
 		// As far as I can tell, there's no way to make flagSet.GetString return an error for a defined string flag.
 		// So I don't have a test for the "could not read summary" error case.
 
+		// only expedited tests.
+		{
+			name:     "only expedited",
+			fromAddr: nil,
+			args:     []string{argExpedited},
+			exp: &v1.MsgSubmitProposal{
+				InitialDeposit: nil,
+				Proposer:       "",
+				Metadata:       "",
+				Title:          "",
+				Summary:        "",
+				Expedited:      true,
+			},
+		},
+		{
+			name:     "only expedited false",
+			fromAddr: nil,
+			args:     []string{argExpedited + "=false"},
+			exp: &v1.MsgSubmitProposal{
+				InitialDeposit: nil,
+				Proposer:       "",
+				Metadata:       "",
+				Title:          "",
+				Summary:        "",
+			},
+		},
+
 		// Combo tests.
 		{
 			name:     "all together order 1",
@@ -14,6 +14,27 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 	k.SetProposalID(ctx, data.StartingProposalId)
 	k.SetParams(ctx, *data.Params)
 
+	for _, entry := range data.MessageBasedParams {
+		k.SetMessageBasedParams(ctx, entry.MsgUrl, entry.Params)
+	}
+
+	for _, governor := range data.Governors {
+		k.SetGovernor(ctx, governor)
+	}
+
+	for _, delegation := range data.GovernanceDelegations {
+		k.SetGovernanceDelegation(ctx, delegation)
+	}
+
+	nextUpdateID := uint64(1)
+	for _, update := range data.ScheduledParamsUpdates {
+		k.SetScheduledParamsUpdate(ctx, update)
+		if update.Id >= nextUpdateID {
+			nextUpdateID = update.Id + 1
+		}
+	}
+	k.SetNextScheduledParamsUpdateID(ctx, nextUpdateID)
+
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
 	if moduleAcc == nil {
@@ -69,10 +90,14 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *v1.GenesisState {
 	}
 
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
+		StartingProposalId:     startingProposalID,
+		Deposits:               proposalsDeposits,
+		Votes:                  proposalsVotes,
+		Proposals:              proposals,
+		Params:                 &params,
+		MessageBasedParams:     k.GetAllMessageBasedParams(ctx),
+		Governors:              k.GetAllGovernors(ctx),
+		GovernanceDelegations:  k.GetAllGovernanceDelegations(ctx),
+		ScheduledParamsUpdates: k.GetAllScheduledParamsUpdates(ctx),
 	}
 }
@@ -131,7 +131,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetParams(ctx).MinDeposit) {
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(proposal.GetMinDepositFromParams(keeper.GetParams(ctx))) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -179,10 +179,63 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 	})
 }
 
+// ChargeDeposit charges the given ratio of all the deposits on a specific
+// proposal, refunds the rest to the depositors and deletes the deposits. The
+// charged coins are sent to destAddress, or burned if destAddress is empty.
+func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, destAddress, proposalCancelRatio string) error {
+	rate, err := sdk.NewDecFromStr(proposalCancelRatio)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	var cancellationCharges sdk.Coins
+
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return err
+		}
+
+		var remainingAmount sdk.Coins
+		for _, coin := range deposit.Amount {
+			burnAmount := sdk.NewDecFromInt(coin.Amount).Mul(rate).TruncateInt()
+			// remaining amount = deposits amount - burn amount
+			remainingAmount = remainingAmount.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(burnAmount)))
+			cancellationCharges = cancellationCharges.Add(sdk.NewCoin(coin.Denom, burnAmount))
+		}
+
+		if !remainingAmount.IsZero() {
+			if err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, remainingAmount); err != nil {
+				return err
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+	}
+
+	if cancellationCharges.IsZero() {
+		return nil
+	}
+
+	// burn the cancellation charges if no destination address is set
+	if len(destAddress) == 0 {
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, cancellationCharges)
+	}
+
+	destAccAddress, err := sdk.AccAddressFromBech32(destAddress)
+	if err != nil {
+		return err
+	}
+
+	return keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, destAccAddress, cancellationCharges)
+}
+
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
-// the deposit parameters. Returns nil on success, error otherwise.
-func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins) error {
+// the deposit parameters and depends on whether the proposal is expedited. Returns nil on
+// success, error otherwise.
+func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins, expedited bool) error {
 	params := keeper.GetParams(ctx)
 	minInitialDepositRatio, err := sdk.NewDecFromStr(params.MinInitialDepositRatio)
 	if err != nil {
@@ -192,6 +245,9 @@ func (keeper Keeper) validateInitialDeposit(ctx sdk.Context, initialDeposit sdk.
 		return nil
 	}
 	minDepositCoins := params.MinDeposit
+	if expedited {
+		minDepositCoins = params.ExpeditedMinDeposit
+	}
 	for i := range minDepositCoins {
 		minDepositCoins[i].Amount = sdk.NewDecFromInt(minDepositCoins[i].Amount).Mul(minInitialDepositRatio).RoundInt()
 	}
@@ -21,7 +21,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0])
+	proposal, err := govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], false, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -105,7 +105,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0])
+	proposal, err = govKeeper.SubmitProposal(ctx, tp, "", "title", "description", TestAddrs[0], false, false)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = govKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -116,11 +116,64 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr0Initial.Sub(fourStake...), bankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
 
+func TestChargeDeposit(t *testing.T) {
+	testCases := []struct {
+		name                string
+		proposalCancelRatio string
+		proposalCancelDest  bool
+		expRefund           int64
+		expDest             int64
+	}{
+		{"burn half of the deposits", "0.5", false, 5, 0},
+		{"send half of the deposits to the destination", "0.5", true, 5, 5},
+		{"burn all of the deposits", "1", false, 0, 0},
+		{"refund all of the deposits", "0", true, 10, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
+			trackMockBalances(bankKeeper)
+			TestAddrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(10000000))
+
+			params := v1.DefaultParams()
+			params.ProposalCancelRatio = tc.proposalCancelRatio
+			if tc.proposalCancelDest {
+				params.ProposalCancelDest = TestAddrs[2].String()
+			}
+			require.NoError(t, govKeeper.SetParams(ctx, params))
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "description", TestAddrs[0], false, false)
+			require.NoError(t, err)
+
+			tenStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			addr1Initial := bankKeeper.GetAllBalances(ctx, TestAddrs[1])
+			destInitial := bankKeeper.GetAllBalances(ctx, TestAddrs[2])
+
+			_, err = govKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[1], tenStake)
+			require.NoError(t, err)
+
+			err = govKeeper.ChargeDeposit(ctx, proposal.Id, params.ProposalCancelDest, params.ProposalCancelRatio)
+			require.NoError(t, err)
+
+			_, found := govKeeper.GetDeposit(ctx, proposal.Id, TestAddrs[1])
+			require.False(t, found)
+
+			expRefund := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, tc.expRefund)))
+			require.Equal(t, addr1Initial.Sub(tenStake...).Add(expRefund...), bankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+
+			expDest := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, stakingKeeper.TokensFromConsensusPower(ctx, tc.expDest)))
+			require.Equal(t, destInitial.Add(expDest...), bankKeeper.GetAllBalances(ctx, TestAddrs[2]))
+		})
+	}
+}
+
 func TestValidateInitialDeposit(t *testing.T) {
 	testcases := map[string]struct {
 		minDeposit               sdk.Coins
 		minInitialDepositPercent int64
 		initialDeposit           sdk.Coins
+		expedited                bool
 
 		expectError bool
 	}{
@@ -184,6 +237,20 @@ func TestValidateInitialDeposit(t *testing.T) {
 				sdk.NewCoin("uosmo", sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1)),
 			),
 		},
+		"min deposit * initial percent == initial deposit (expedited): success": {
+			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100))),
+			expedited:                true,
+		},
+		"min deposit * initial percent > initial deposit (expedited): error": {
+			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
+			minInitialDepositPercent: baseDepositTestPercent,
+			initialDeposit:           sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount*baseDepositTestPercent/100-1))),
+			expedited:                true,
+
+			expectError: true,
+		},
 		"0 initial percent: success": {
 			minDeposit:               sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(baseDepositTestAmount))),
 			minInitialDepositPercent: 0,
@@ -196,12 +263,16 @@ func TestValidateInitialDeposit(t *testing.T) {
 			govKeeper, _, _, _, _, ctx := setupGovKeeper(t)
 
 			params := v1.DefaultParams()
-			params.MinDeposit = tc.minDeposit
+			if tc.expedited {
+				params.ExpeditedMinDeposit = tc.minDeposit
+			} else {
+				params.MinDeposit = tc.minDeposit
+			}
 			params.MinInitialDepositRatio = sdk.NewDec(tc.minInitialDepositPercent).Quo(sdk.NewDec(100)).String()
 
 			govKeeper.SetParams(ctx, params)
 
-			err := govKeeper.ValidateInitialDeposit(ctx, tc.initialDeposit)
+			err := govKeeper.ValidateInitialDeposit(ctx, tc.initialDeposit, tc.expedited)
 
 			if tc.expectError {
 				require.Error(t, err)
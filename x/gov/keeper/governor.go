@@ -0,0 +1,299 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// CreateGovernor registers an address as a governor. Addresses delegating
+// their governance voting power cannot become governors.
+func (keeper Keeper) CreateGovernor(ctx sdk.Context, governorAddr sdk.AccAddress, metadata string) error {
+	if err := keeper.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	if _, found := keeper.GetGovernor(ctx, governorAddr); found {
+		return sdkerrors.Wrap(types.ErrGovernorExists, governorAddr.String())
+	}
+
+	if _, found := keeper.GetGovernanceDelegation(ctx, governorAddr); found {
+		return sdkerrors.Wrap(types.ErrInvalidGovernanceDelegation, "address delegating governance cannot become a governor")
+	}
+
+	keeper.SetGovernor(ctx, v1.Governor{GovernorAddress: governorAddr.String(), Metadata: metadata})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGovernor,
+			sdk.NewAttribute(types.AttributeKeyGovernor, governorAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// EditGovernor edits the metadata of a governor.
+func (keeper Keeper) EditGovernor(ctx sdk.Context, governorAddr sdk.AccAddress, metadata string) error {
+	if err := keeper.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	governor, found := keeper.GetGovernor(ctx, governorAddr)
+	if !found {
+		return sdkerrors.Wrap(types.ErrUnknownGovernor, governorAddr.String())
+	}
+
+	governor.Metadata = metadata
+	keeper.SetGovernor(ctx, governor)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEditGovernor,
+			sdk.NewAttribute(types.AttributeKeyGovernor, governorAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// RemoveGovernor unregisters a governor. The governance delegations to the
+// governor are removed, so its delegators inherit the votes of their
+// validators again.
+func (keeper Keeper) RemoveGovernor(ctx sdk.Context, governorAddr sdk.AccAddress) error {
+	if _, found := keeper.GetGovernor(ctx, governorAddr); !found {
+		return sdkerrors.Wrap(types.ErrUnknownGovernor, governorAddr.String())
+	}
+
+	var delegatorAddrs []sdk.AccAddress
+	keeper.IterateGovernorDelegators(ctx, governorAddr, func(delegatorAddr sdk.AccAddress) bool {
+		delegatorAddrs = append(delegatorAddrs, delegatorAddr)
+		return false
+	})
+
+	for _, delegatorAddr := range delegatorAddrs {
+		keeper.DeleteGovernanceDelegation(ctx, delegatorAddr)
+	}
+
+	keeper.DeleteGovernor(ctx, governorAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveGovernor,
+			sdk.NewAttribute(types.AttributeKeyGovernor, governorAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// DelegateGovernance delegates the governance voting power of a delegator to
+// a governor, replacing any previous governance delegation of the delegator.
+// Governors cannot delegate their governance voting power.
+func (keeper Keeper) DelegateGovernance(ctx sdk.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	if _, found := keeper.GetGovernor(ctx, governorAddr); !found {
+		return sdkerrors.Wrap(types.ErrUnknownGovernor, governorAddr.String())
+	}
+
+	if _, found := keeper.GetGovernor(ctx, delegatorAddr); found {
+		return sdkerrors.Wrap(types.ErrInvalidGovernanceDelegation, "governors cannot delegate governance")
+	}
+
+	keeper.SetGovernanceDelegation(ctx, v1.GovernanceDelegation{
+		DelegatorAddress: delegatorAddr.String(),
+		GovernorAddress:  governorAddr.String(),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateGovernance,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyGovernor, governorAddr.String()),
+		),
+	)
+
+	return nil
+}
+
+// UndelegateGovernance removes the governance delegation of a delegator.
+func (keeper Keeper) UndelegateGovernance(ctx sdk.Context, delegatorAddr sdk.AccAddress) error {
+	delegation, found := keeper.GetGovernanceDelegation(ctx, delegatorAddr)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidGovernanceDelegation, "%s has no governance delegation", delegatorAddr)
+	}
+
+	keeper.DeleteGovernanceDelegation(ctx, delegatorAddr)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateGovernance,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyGovernor, delegation.GovernorAddress),
+		),
+	)
+
+	return nil
+}
+
+// GetGovernor gets a governor from the store.
+func (keeper Keeper) GetGovernor(ctx sdk.Context, governorAddr sdk.AccAddress) (governor v1.Governor, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovernorKey(governorAddr))
+	if bz == nil {
+		return governor, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &governor)
+	return governor, true
+}
+
+// SetGovernor sets a governor in the store.
+func (keeper Keeper) SetGovernor(ctx sdk.Context, governor v1.Governor) {
+	store := ctx.KVStore(keeper.storeKey)
+	governorAddr := sdk.MustAccAddressFromBech32(governor.GovernorAddress)
+	store.Set(types.GovernorKey(governorAddr), keeper.cdc.MustMarshal(&governor))
+}
+
+// DeleteGovernor deletes a governor from the store.
+func (keeper Keeper) DeleteGovernor(ctx sdk.Context, governorAddr sdk.AccAddress) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GovernorKey(governorAddr))
+}
+
+// IterateGovernors iterates over all the governors and performs a callback
+// function.
+func (keeper Keeper) IterateGovernors(ctx sdk.Context, cb func(governor v1.Governor) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GovernorsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var governor v1.Governor
+		keeper.cdc.MustUnmarshal(iterator.Value(), &governor)
+
+		if cb(governor) {
+			break
+		}
+	}
+}
+
+// GetAllGovernors returns all the governors.
+func (keeper Keeper) GetAllGovernors(ctx sdk.Context) (governors []v1.Governor) {
+	keeper.IterateGovernors(ctx, func(governor v1.Governor) bool {
+		governors = append(governors, governor)
+		return false
+	})
+	return
+}
+
+// GetGovernanceDelegation gets the governance delegation of a delegator from
+// the store.
+func (keeper Keeper) GetGovernanceDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress) (delegation v1.GovernanceDelegation, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.GovernanceDelegationKey(delegatorAddr))
+	if bz == nil {
+		return delegation, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &delegation)
+	return delegation, true
+}
+
+// SetGovernanceDelegation sets the governance delegation of a delegator in the
+// store, replacing any previous delegation of the delegator.
+func (keeper Keeper) SetGovernanceDelegation(ctx sdk.Context, delegation v1.GovernanceDelegation) {
+	delegatorAddr := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
+	keeper.DeleteGovernanceDelegation(ctx, delegatorAddr)
+
+	store := ctx.KVStore(keeper.storeKey)
+	governorAddr := sdk.MustAccAddressFromBech32(delegation.GovernorAddress)
+	store.Set(types.GovernanceDelegationKey(delegatorAddr), keeper.cdc.MustMarshal(&delegation))
+	store.Set(types.GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr), []byte{})
+}
+
+// DeleteGovernanceDelegation deletes the governance delegation of a delegator
+// from the store, if any.
+func (keeper Keeper) DeleteGovernanceDelegation(ctx sdk.Context, delegatorAddr sdk.AccAddress) {
+	delegation, found := keeper.GetGovernanceDelegation(ctx, delegatorAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	governorAddr := sdk.MustAccAddressFromBech32(delegation.GovernorAddress)
+	store.Delete(types.GovernanceDelegationKey(delegatorAddr))
+	store.Delete(types.GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr))
+}
+
+// IterateGovernanceDelegations iterates over all the governance delegations
+// and performs a callback function.
+func (keeper Keeper) IterateGovernanceDelegations(ctx sdk.Context, cb func(delegation v1.GovernanceDelegation) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GovernanceDelegationsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation v1.GovernanceDelegation
+		keeper.cdc.MustUnmarshal(iterator.Value(), &delegation)
+
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+// GetAllGovernanceDelegations returns all the governance delegations.
+func (keeper Keeper) GetAllGovernanceDelegations(ctx sdk.Context) (delegations []v1.GovernanceDelegation) {
+	keeper.IterateGovernanceDelegations(ctx, func(delegation v1.GovernanceDelegation) bool {
+		delegations = append(delegations, delegation)
+		return false
+	})
+	return
+}
+
+// IterateGovernorDelegators iterates over the addresses of the delegators of a
+// governor and performs a callback function.
+func (keeper Keeper) IterateGovernorDelegators(ctx sdk.Context, governorAddr sdk.AccAddress, cb func(delegatorAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := types.GovernanceDelegationsByGovernorKey(governorAddr)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// the key suffix is the length-prefixed delegator address
+		delegatorAddr := sdk.AccAddress(iterator.Key()[len(prefix)+1:])
+
+		if cb(delegatorAddr) {
+			break
+		}
+	}
+}
+
+// GetGovernorDelegatedPower returns the voting power delegated to a governor,
+// that is the bonded stake of its delegators. The power a governor votes with
+// is further capped by the max governor voting power ratio param.
+func (keeper Keeper) GetGovernorDelegatedPower(ctx sdk.Context, governorAddr sdk.AccAddress) math.LegacyDec {
+	validators := make(map[string]stakingtypes.ValidatorI)
+	keeper.sk.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		validators[validator.GetOperator().String()] = validator
+		return false
+	})
+
+	power := math.LegacyZeroDec()
+	keeper.IterateGovernorDelegators(ctx, governorAddr, func(delegatorAddr sdk.AccAddress) bool {
+		keeper.sk.IterateDelegations(ctx, delegatorAddr, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+			if validator, ok := validators[delegation.GetValidatorAddr().String()]; ok {
+				// delegation shares * bonded / total shares
+				power = power.Add(delegation.GetShares().MulInt(validator.GetBondedTokens()).Quo(validator.GetDelegatorShares()))
+			}
+			return false
+		})
+		return false
+	})
+
+	return power
+}
@@ -281,6 +281,207 @@ func (q Keeper) TallyResult(c context.Context, req *v1.QueryTallyResultRequest)
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// MessageBasedParams implements the Query/MessageBasedParams gRPC method
+func (q Keeper) MessageBasedParams(c context.Context, req *v1.QueryMessageBasedParamsRequest) (*v1.QueryMessageBasedParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MsgUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "msg url can not be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params, custom := q.EffectiveMessageBasedParams(ctx, req.MsgUrl)
+
+	return &v1.QueryMessageBasedParamsResponse{Params: params, Custom: custom}, nil
+}
+
+// ProposalMessageBasedParams implements the Query/ProposalMessageBasedParams gRPC method
+func (q Keeper) ProposalMessageBasedParams(c context.Context, req *v1.QueryProposalMessageBasedParamsRequest) (*v1.QueryProposalMessageBasedParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, ok := q.GetProposal(ctx, req.ProposalId)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+	}
+
+	return &v1.QueryProposalMessageBasedParamsResponse{Params: q.GetProposalMessageBasedParams(ctx, proposal)}, nil
+}
+
+// Governor implements the Query/Governor gRPC method
+func (q Keeper) Governor(c context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := sdk.AccAddressFromBech32(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	governor, found := q.GetGovernor(ctx, governorAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "governor %s doesn't exist", req.GovernorAddress)
+	}
+
+	return &v1.QueryGovernorResponse{
+		Governor:             governor,
+		DelegatedVotingPower: q.GetGovernorDelegatedPower(ctx, governorAddr).String(),
+	}, nil
+}
+
+// Governors implements the Query/Governors gRPC method
+func (q Keeper) Governors(c context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var governors []v1.Governor
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	governorStore := prefix.NewStore(store, types.GovernorsKeyPrefix)
+
+	pageRes, err := query.Paginate(governorStore, req.Pagination, func(key []byte, value []byte) error {
+		var governor v1.Governor
+		if err := q.cdc.Unmarshal(value, &governor); err != nil {
+			return err
+		}
+
+		governors = append(governors, governor)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
+// GovernanceDelegation implements the Query/GovernanceDelegation gRPC method
+func (q Keeper) GovernanceDelegation(c context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegation, found := q.GetGovernanceDelegation(ctx, delegatorAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "governance delegation of %s doesn't exist", req.DelegatorAddress)
+	}
+
+	return &v1.QueryGovernanceDelegationResponse{Delegation: delegation}, nil
+}
+
+// GovernanceDelegations implements the Query/GovernanceDelegations gRPC method
+func (q Keeper) GovernanceDelegations(c context.Context, req *v1.QueryGovernanceDelegationsRequest) (*v1.QueryGovernanceDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := sdk.AccAddressFromBech32(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	var delegations []v1.GovernanceDelegation
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	delegationStore := prefix.NewStore(store, types.GovernanceDelegationsByGovernorKey(governorAddr))
+
+	pageRes, err := query.Paginate(delegationStore, req.Pagination, func(key []byte, value []byte) error {
+		// the key is the length-prefixed delegator address
+		delegations = append(delegations, v1.GovernanceDelegation{
+			DelegatorAddress: sdk.AccAddress(key[1:]).String(),
+			GovernorAddress:  req.GovernorAddress,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernanceDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// ScheduledParamsUpdate implements the Query/ScheduledParamsUpdate gRPC method
+func (q Keeper) ScheduledParamsUpdate(c context.Context, req *v1.QueryScheduledParamsUpdateRequest) (*v1.QueryScheduledParamsUpdateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "scheduled params update id can not be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	update, found := q.GetScheduledParamsUpdate(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "scheduled params update %d doesn't exist", req.Id)
+	}
+
+	return &v1.QueryScheduledParamsUpdateResponse{Update: update}, nil
+}
+
+// ScheduledParamsUpdates implements the Query/ScheduledParamsUpdates gRPC method
+func (q Keeper) ScheduledParamsUpdates(c context.Context, req *v1.QueryScheduledParamsUpdatesRequest) (*v1.QueryScheduledParamsUpdatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var updates []v1.ScheduledParamsUpdate
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(q.storeKey)
+	updateStore := prefix.NewStore(store, types.ScheduledParamsUpdatesKeyPrefix)
+
+	pageRes, err := query.Paginate(updateStore, req.Pagination, func(key []byte, value []byte) error {
+		var update v1.ScheduledParamsUpdate
+		if err := q.cdc.Unmarshal(value, &update); err != nil {
+			return err
+		}
+
+		updates = append(updates, update)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryScheduledParamsUpdatesResponse{Updates: updates, Pagination: pageRes}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct {
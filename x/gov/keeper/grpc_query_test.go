@@ -10,6 +10,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -57,7 +58,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -132,7 +133,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -198,7 +199,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1.NewMsgVote(govAddress, uint64(i), v1.OptionYes, ""),
 					}
-					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+					proposal, err := suite.govKeeper.SubmitProposal(ctx, testProposal, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -336,7 +337,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposals() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+				submittedProposal, err := suite.govKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 			},
@@ -417,7 +418,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVoteRequest{
@@ -531,7 +532,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVoteRequest{
@@ -637,7 +638,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryVotesRequest{
@@ -739,7 +740,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -1024,7 +1025,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1125,7 +1126,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -1215,7 +1216,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false, false)
 				suite.Require().NoError(err)
 
 				req = &v1.QueryDepositsRequest{
@@ -1309,7 +1310,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0])
+				proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", addrs[0], false, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryDepositsRequest{
@@ -1633,3 +1634,132 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryTallyResult() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryMessageBasedParams() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	defaultParams := suite.govKeeper.GetParams(ctx).ToMessageBasedParams()
+	msgSendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	// the bank MsgSend of the test proposal gets a higher quorum and threshold
+	// but a lower veto threshold than the default params
+	votingPeriod := *defaultParams.VotingPeriod / 2
+	msgSendParams := v1.MessageBasedParams{
+		VotingPeriod:  &votingPeriod,
+		Quorum:        "0.5",
+		Threshold:     "0.75",
+		VetoThreshold: "0.5",
+	}
+	suite.govKeeper.SetMessageBasedParams(ctx, msgSendURL, msgSendParams)
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal[:1], "", "test", "summary", suite.addrs[0], false, false)
+	suite.Require().NoError(err)
+
+	bundledProposal, err := suite.govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", suite.addrs[0], false, false)
+	suite.Require().NoError(err)
+
+	_, err = queryClient.MessageBasedParams(gocontext.Background(), &v1.QueryMessageBasedParamsRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.MessageBasedParams(gocontext.Background(), &v1.QueryMessageBasedParamsRequest{MsgUrl: msgSendURL})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Custom)
+	suite.Require().Equal(msgSendParams, res.Params)
+
+	res, err = queryClient.MessageBasedParams(gocontext.Background(), &v1.QueryMessageBasedParamsRequest{MsgUrl: sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Custom)
+	suite.Require().Equal(defaultParams, res.Params)
+
+	_, err = queryClient.ProposalMessageBasedParams(gocontext.Background(), &v1.QueryProposalMessageBasedParamsRequest{ProposalId: 100})
+	suite.Require().Error(err)
+
+	proposalRes, err := queryClient.ProposalMessageBasedParams(gocontext.Background(), &v1.QueryProposalMessageBasedParamsRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.MessageBasedParams{
+		VotingPeriod:  msgSendParams.VotingPeriod,
+		Quorum:        sdk.MustNewDecFromStr(msgSendParams.Quorum).String(),
+		Threshold:     sdk.MustNewDecFromStr(msgSendParams.Threshold).String(),
+		VetoThreshold: sdk.MustNewDecFromStr(msgSendParams.VetoThreshold).String(),
+	}, proposalRes.Params)
+
+	// the test proposal bundles the bank MsgSend with a legacy content message
+	// with the default params, so the strictest value of each param is taken
+	// from either set
+	proposalRes, err = queryClient.ProposalMessageBasedParams(gocontext.Background(), &v1.QueryProposalMessageBasedParamsRequest{ProposalId: bundledProposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.MessageBasedParams{
+		VotingPeriod:  defaultParams.VotingPeriod,
+		Quorum:        sdk.MustNewDecFromStr(msgSendParams.Quorum).String(),
+		Threshold:     sdk.MustNewDecFromStr(msgSendParams.Threshold).String(),
+		VetoThreshold: defaultParams.VetoThreshold,
+	}, proposalRes.Params)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernors() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	governor, delegator := suite.addrs[0], suite.addrs[1]
+
+	_, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: governor.String()})
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.govKeeper.CreateGovernor(ctx, governor, "metadata"))
+	suite.Require().NoError(suite.govKeeper.DelegateGovernance(ctx, delegator, governor))
+
+	res, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: governor.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.Governor{GovernorAddress: governor.String(), Metadata: "metadata"}, res.Governor)
+	suite.Require().Equal(math.LegacyZeroDec().String(), res.DelegatedVotingPower)
+
+	governorsRes, err := queryClient.Governors(gocontext.Background(), &v1.QueryGovernorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]v1.Governor{res.Governor}, governorsRes.Governors)
+
+	_, err = queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: governor.String()})
+	suite.Require().Error(err)
+
+	expDelegation := v1.GovernanceDelegation{DelegatorAddress: delegator.String(), GovernorAddress: governor.String()}
+	delegationRes, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: delegator.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expDelegation, delegationRes.Delegation)
+
+	_, err = queryClient.GovernanceDelegations(gocontext.Background(), &v1.QueryGovernanceDelegationsRequest{})
+	suite.Require().Error(err)
+
+	delegationsRes, err := queryClient.GovernanceDelegations(gocontext.Background(), &v1.QueryGovernanceDelegationsRequest{GovernorAddress: governor.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]v1.GovernanceDelegation{expDelegation}, delegationsRes.Delegations)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryScheduledParamsUpdates() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+
+	_, err := queryClient.ScheduledParamsUpdate(gocontext.Background(), &v1.QueryScheduledParamsUpdateRequest{})
+	suite.Require().Error(err)
+
+	_, err = queryClient.ScheduledParamsUpdate(gocontext.Background(), &v1.QueryScheduledParamsUpdateRequest{Id: 1})
+	suite.Require().Error(err)
+
+	paramsMsg := &v1.MsgUpdateParams{Authority: suite.govKeeper.GetAuthority(), Params: v1.DefaultParams()}
+	id, err := suite.govKeeper.ScheduleParamsUpdate(ctx, 10, paramsMsg)
+	suite.Require().NoError(err)
+
+	res, err := queryClient.ScheduledParamsUpdate(gocontext.Background(), &v1.QueryScheduledParamsUpdateRequest{Id: id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(id, res.Update.Id)
+	suite.Require().Equal(int64(10), res.Update.Height)
+	msg, err := res.Update.GetParamsUpdateMsg()
+	suite.Require().NoError(err)
+	suite.Require().Equal(paramsMsg, msg)
+
+	updatesRes, err := queryClient.ScheduledParamsUpdates(gocontext.Background(), &v1.QueryScheduledParamsUpdatesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(updatesRes.Updates, 1)
+	suite.Require().Equal(id, updatesRes.Updates[0].Id)
+}
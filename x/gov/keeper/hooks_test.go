@@ -23,6 +23,7 @@ type MockGovHooksReceiver struct {
 	AfterProposalVoteValid              bool
 	AfterProposalFailedMinDepositValid  bool
 	AfterProposalVotingPeriodEndedValid bool
+	AfterProposalCanceledValid          bool
 }
 
 func (h *MockGovHooksReceiver) AfterProposalSubmission(ctx sdk.Context, proposalID uint64) {
@@ -45,6 +46,10 @@ func (h *MockGovHooksReceiver) AfterProposalVotingPeriodEnded(ctx sdk.Context, p
 	h.AfterProposalVotingPeriodEndedValid = true
 }
 
+func (h *MockGovHooksReceiver) AfterProposalCanceled(ctx sdk.Context, proposalID uint64) {
+	h.AfterProposalCanceledValid = true
+}
+
 func TestHooks(t *testing.T) {
 	minDeposit := v1.DefaultParams().MinDeposit
 	govKeeper, _, bankKeeper, stakingKeeper, _, ctx := setupGovKeeper(t)
@@ -61,9 +66,10 @@ func TestHooks(t *testing.T) {
 	require.False(t, govHooksReceiver.AfterProposalVoteValid)
 	require.False(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+	require.False(t, govHooksReceiver.AfterProposalCanceledValid)
 
 	tp := TestProposal
-	_, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	_, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 	require.NoError(t, err)
 	require.True(t, govHooksReceiver.AfterProposalSubmissionValid)
 
@@ -74,7 +80,7 @@ func TestHooks(t *testing.T) {
 
 	require.True(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 
-	p2, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	p2, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 	require.NoError(t, err)
 
 	activated, err := govKeeper.AddDeposit(ctx, p2.Id, addrs[0], minDeposit)
@@ -91,4 +97,15 @@ func TestHooks(t *testing.T) {
 	ctx = ctx.WithBlockHeader(newHeader)
 	gov.EndBlocker(ctx, govKeeper)
 	require.True(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
+
+	p3, err := govKeeper.SubmitProposal(ctx, tp, "", "test", "summary", addrs[0], false, false)
+	require.NoError(t, err)
+
+	activated, err = govKeeper.AddDeposit(ctx, p3.Id, addrs[0], minDeposit)
+	require.True(t, activated)
+	require.NoError(t, err)
+
+	err = govKeeper.CancelProposal(ctx, p3.Id, addrs[0].String())
+	require.NoError(t, err)
+	require.True(t, govHooksReceiver.AfterProposalCanceledValid)
 }
@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// GetMessageBasedParams gets the params of a message type URL, if it has
+// params of its own.
+func (keeper Keeper) GetMessageBasedParams(ctx sdk.Context, msgURL string) (params v1.MessageBasedParams, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.MessageBasedParamsKey(msgURL))
+	if bz == nil {
+		return params, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &params)
+	return params, true
+}
+
+// SetMessageBasedParams sets the params of a message type URL.
+func (keeper Keeper) SetMessageBasedParams(ctx sdk.Context, msgURL string, params v1.MessageBasedParams) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.MessageBasedParamsKey(msgURL), keeper.cdc.MustMarshal(&params))
+}
+
+// DeleteMessageBasedParams deletes the params of a message type URL, which
+// then falls back to the default params.
+func (keeper Keeper) DeleteMessageBasedParams(ctx sdk.Context, msgURL string) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.MessageBasedParamsKey(msgURL))
+}
+
+// IterateMessageBasedParams iterates over the params of all message type URLs
+// and performs a callback function.
+func (keeper Keeper) IterateMessageBasedParams(ctx sdk.Context, cb func(entry v1.MessageBasedParamsEntry) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MessageBasedParamsKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		entry := v1.MessageBasedParamsEntry{MsgUrl: string(iterator.Key()[len(types.MessageBasedParamsKeyPrefix):])}
+		keeper.cdc.MustUnmarshal(iterator.Value(), &entry.Params)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// GetAllMessageBasedParams returns the params of all message type URLs.
+func (keeper Keeper) GetAllMessageBasedParams(ctx sdk.Context) (entries []v1.MessageBasedParamsEntry) {
+	keeper.IterateMessageBasedParams(ctx, func(entry v1.MessageBasedParamsEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	return
+}
+
+// EffectiveMessageBasedParams returns the params that apply to proposals
+// containing a message type URL: its own params if set, and the voting period
+// and tally params of the governance params otherwise. The returned boolean
+// reports whether the message type has params of its own.
+func (keeper Keeper) EffectiveMessageBasedParams(ctx sdk.Context, msgURL string) (v1.MessageBasedParams, bool) {
+	if params, found := keeper.GetMessageBasedParams(ctx, msgURL); found {
+		return params, true
+	}
+
+	return keeper.GetParams(ctx).ToMessageBasedParams(), false
+}
+
+// GetProposalMessageBasedParams returns the params that apply to a proposal:
+// the strictest of the effective params of its messages, so that bundling
+// messages can't give any of them looser params than its own. A proposal
+// without messages uses the default params.
+func (keeper Keeper) GetProposalMessageBasedParams(ctx sdk.Context, proposal v1.Proposal) v1.MessageBasedParams {
+	defaultParams := keeper.GetParams(ctx).ToMessageBasedParams()
+	if len(proposal.Messages) == 0 {
+		return defaultParams
+	}
+
+	params := make([]v1.MessageBasedParams, 0, len(proposal.Messages))
+	for _, msg := range proposal.Messages {
+		params = append(params, keeper.proposalMsgParams(ctx, defaultParams, msg.TypeUrl, msg.GetCachedValue()))
+	}
+
+	return v1.StrictestMessageBasedParams(params...)
+}
+
+// proposalMsgParams returns the effective params of a proposal message. A
+// scheduled params update counts with the params of the params update message
+// it wraps as well, so scheduling can't loosen them.
+func (keeper Keeper) proposalMsgParams(ctx sdk.Context, defaultParams v1.MessageBasedParams, msgURL string, msg interface{}) v1.MessageBasedParams {
+	msgURLs := []string{msgURL}
+	if scheduleMsg, ok := msg.(*v1.MsgScheduleParamsUpdate); ok && scheduleMsg.Msg != nil {
+		msgURLs = append(msgURLs, scheduleMsg.Msg.TypeUrl)
+	}
+
+	params := make([]v1.MessageBasedParams, 0, len(msgURLs))
+	for _, msgURL := range msgURLs {
+		msgParams, found := keeper.GetMessageBasedParams(ctx, msgURL)
+		if !found {
+			msgParams = defaultParams
+		}
+		params = append(params, msgParams)
+	}
+
+	return v1.StrictestMessageBasedParams(params...)
+}
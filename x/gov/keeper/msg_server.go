@@ -33,7 +33,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 
 	initialDeposit := msg.GetInitialDeposit()
 
-	if err := k.validateInitialDeposit(ctx, initialDeposit); err != nil {
+	if err := k.validateInitialDeposit(ctx, initialDeposit, msg.Expedited); err != nil {
 		return nil, err
 	}
 
@@ -47,7 +47,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer)
+	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited, msg.Optimistic)
 	if err != nil {
 		return nil, err
 	}
@@ -195,6 +195,14 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Params.ExpeditedVotingPeriod != nil {
+		for _, entry := range k.GetAllMessageBasedParams(ctx) {
+			if err := entry.Params.ValidateExpeditedVotingPeriod(*msg.Params.ExpeditedVotingPeriod); err != nil {
+				return nil, fmt.Errorf("invalid message based params for %s: %w", entry.MsgUrl, err)
+			}
+		}
+	}
+
 	if err := k.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -202,6 +210,167 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// CancelProposal implements the MsgServer.CancelProposal method.
+func (k msgServer) CancelProposal(goCtx context.Context, msg *v1.MsgCancelProposal) (*v1.MsgCancelProposalResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.CancelProposal(ctx, msg.ProposalId, msg.Proposer); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelProposalResponse{
+		ProposalId:     msg.ProposalId,
+		CanceledTime:   ctx.BlockTime(),
+		CanceledHeight: uint64(ctx.BlockHeight()),
+	}, nil
+}
+
+// UpdateMessageParams implements the MsgServer.UpdateMessageParams method.
+func (k msgServer) UpdateMessageParams(goCtx context.Context, msg *v1.MsgUpdateMessageParams) (*v1.MsgUpdateMessageParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Params == nil {
+		k.DeleteMessageBasedParams(ctx, msg.MsgUrl)
+		return &v1.MsgUpdateMessageParamsResponse{}, nil
+	}
+
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.ValidateExpeditedVotingPeriod(*k.GetParams(ctx).ExpeditedVotingPeriod); err != nil {
+		return nil, err
+	}
+
+	if k.router.HandlerByTypeURL(msg.MsgUrl) == nil {
+		return nil, errors.Wrap(govtypes.ErrUnroutableProposalMsg, msg.MsgUrl)
+	}
+
+	k.SetMessageBasedParams(ctx, msg.MsgUrl, *msg.Params)
+
+	return &v1.MsgUpdateMessageParamsResponse{}, nil
+}
+
+// CreateGovernor implements the MsgServer.CreateGovernor method.
+func (k msgServer) CreateGovernor(goCtx context.Context, msg *v1.MsgCreateGovernor) (*v1.MsgCreateGovernorResponse, error) {
+	governorAddr, err := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.CreateGovernor(ctx, governorAddr, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateGovernorResponse{}, nil
+}
+
+// EditGovernor implements the MsgServer.EditGovernor method.
+func (k msgServer) EditGovernor(goCtx context.Context, msg *v1.MsgEditGovernor) (*v1.MsgEditGovernorResponse, error) {
+	governorAddr, err := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.EditGovernor(ctx, governorAddr, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgEditGovernorResponse{}, nil
+}
+
+// RemoveGovernor implements the MsgServer.RemoveGovernor method.
+func (k msgServer) RemoveGovernor(goCtx context.Context, msg *v1.MsgRemoveGovernor) (*v1.MsgRemoveGovernorResponse, error) {
+	governorAddr, err := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RemoveGovernor(ctx, governorAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgRemoveGovernorResponse{}, nil
+}
+
+// DelegateGovernance implements the MsgServer.DelegateGovernance method.
+func (k msgServer) DelegateGovernance(goCtx context.Context, msg *v1.MsgDelegateGovernance) (*v1.MsgDelegateGovernanceResponse, error) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	governorAddr, err := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.DelegateGovernance(ctx, delegatorAddr, governorAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateGovernanceResponse{}, nil
+}
+
+// UndelegateGovernance implements the MsgServer.UndelegateGovernance method.
+func (k msgServer) UndelegateGovernance(goCtx context.Context, msg *v1.MsgUndelegateGovernance) (*v1.MsgUndelegateGovernanceResponse, error) {
+	delegatorAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UndelegateGovernance(ctx, delegatorAddr); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateGovernanceResponse{}, nil
+}
+
+// ScheduleParamsUpdate implements the MsgServer.ScheduleParamsUpdate method.
+func (k msgServer) ScheduleParamsUpdate(goCtx context.Context, msg *v1.MsgScheduleParamsUpdate) (*v1.MsgScheduleParamsUpdateResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	paramsMsg, err := msg.GetParamsUpdateMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := k.Keeper.ScheduleParamsUpdate(ctx, msg.Height, paramsMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgScheduleParamsUpdateResponse{Id: id}, nil
+}
+
+// CancelScheduledParamsUpdate implements the MsgServer.CancelScheduledParamsUpdate method.
+func (k msgServer) CancelScheduledParamsUpdate(goCtx context.Context, msg *v1.MsgCancelScheduledParamsUpdate) (*v1.MsgCancelScheduledParamsUpdateResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.CancelScheduledParamsUpdate(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCancelScheduledParamsUpdateResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
@@ -228,6 +397,8 @@ func (k legacyMsgServer) SubmitProposal(goCtx context.Context, msg *v1beta1.MsgS
 		"",
 		msg.GetContent().GetTitle(),
 		msg.GetContent().GetDescription(),
+		false, // legacy proposals cannot be expedited
+		false, // nor optimistic
 	)
 	if err != nil {
 		return nil, err
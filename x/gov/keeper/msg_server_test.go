@@ -41,6 +41,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					strings.Repeat("1", 300),
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 			},
 			expErr:    true,
@@ -55,6 +57,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 			},
 			expErr:    true,
@@ -69,6 +73,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 			},
 			expErr:    true,
@@ -83,6 +89,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 			},
 			expErr:    true,
@@ -97,6 +105,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 			},
 			expErr: false,
@@ -110,6 +120,8 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 			},
 			expErr: false,
@@ -153,6 +165,8 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -178,6 +192,8 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -221,6 +237,8 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -272,6 +290,8 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -298,6 +318,8 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -341,6 +363,8 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -391,6 +415,8 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -442,6 +468,126 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 }
 
 // legacy msg server tests
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	suite.reset()
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	minDeposit := suite.govKeeper.GetParams(suite.ctx).MinDeposit
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	submitProposal := func(deposit sdk.Coins) uint64 {
+		msg, err := v1.NewMsgSubmitProposal(
+			[]sdk.Msg{bankMsg},
+			deposit,
+			proposer.String(),
+			"",
+			"Proposal",
+			"description of proposal",
+			false,
+			false,
+		)
+		suite.Require().NoError(err)
+
+		res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+		suite.Require().NoError(err)
+		suite.Require().NotNil(res.ProposalId)
+		return res.ProposalId
+	}
+	proposalID := submitProposal(minDeposit)
+
+	cases := map[string]struct {
+		preRun    func() uint64
+		proposer  string
+		expErr    bool
+		expErrMsg string
+	}{
+		"invalid proposer address": {
+			preRun: func() uint64 {
+				return proposalID
+			},
+			proposer:  "invalid",
+			expErr:    true,
+			expErrMsg: "decoding bech32 failed",
+		},
+		"unknown proposal": {
+			preRun: func() uint64 {
+				return 100
+			},
+			proposer:  proposer.String(),
+			expErr:    true,
+			expErrMsg: "unknown proposal",
+		},
+		"not the proposer": {
+			preRun: func() uint64 {
+				return proposalID
+			},
+			proposer:  addrs[1].String(),
+			expErr:    true,
+			expErrMsg: "invalid proposer",
+		},
+		"proposal in deposit period": {
+			preRun: func() uint64 {
+				return submitProposal(nil)
+			},
+			proposer:  proposer.String(),
+			expErr:    true,
+			expErrMsg: "inactive proposal",
+		},
+		"voting period ended": {
+			preRun: func() uint64 {
+				proposalID := submitProposal(minDeposit)
+				proposal, found := suite.govKeeper.GetProposal(suite.ctx, proposalID)
+				suite.Require().True(found)
+				votingEndTime := suite.ctx.BlockTime()
+				proposal.VotingEndTime = &votingEndTime
+				suite.govKeeper.SetProposal(suite.ctx, proposal)
+				return proposalID
+			},
+			proposer:  proposer.String(),
+			expErr:    true,
+			expErrMsg: "voting period already ended",
+		},
+		"all good": {
+			preRun: func() uint64 {
+				proposalID := submitProposal(minDeposit)
+				err := suite.govKeeper.AddVote(suite.ctx, proposalID, proposer, v1.NewNonSplitVoteOption(v1.OptionYes), "")
+				suite.Require().NoError(err)
+				return proposalID
+			},
+			proposer: proposer.String(),
+			expErr:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		suite.Run(name, func() {
+			proposalID := tc.preRun()
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, v1.NewMsgCancelProposal(proposalID, tc.proposer))
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(proposalID, res.ProposalId)
+			suite.Require().Equal(uint64(suite.ctx.BlockHeight()), res.CanceledHeight)
+
+			_, found := suite.govKeeper.GetProposal(suite.ctx, proposalID)
+			suite.Require().False(found)
+			suite.Require().Empty(suite.govKeeper.GetDeposits(suite.ctx, proposalID))
+			suite.Require().Empty(suite.govKeeper.GetVotes(suite.ctx, proposalID))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
 	proposer := addrs[0]
@@ -511,6 +657,8 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -536,6 +684,8 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -569,6 +719,8 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -620,6 +772,8 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -646,6 +800,8 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -679,6 +835,8 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					"",
 					"Proposal",
 					"description of proposal",
+					false,
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -729,6 +887,8 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		"",
 		"Proposal",
 		"description of proposal",
+		false,
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -1024,6 +1184,25 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 			expErr:    true,
 			expErrMsg: "voting period must be positive",
 		},
+		{
+			name: "expedited voting period not shorter than a message based voting period",
+			input: func() *v1.MsgUpdateParams {
+				votingPeriod := *params.ExpeditedVotingPeriod * 3 / 2
+				msgParams := params.ToMessageBasedParams()
+				msgParams.VotingPeriod = &votingPeriod
+				suite.govKeeper.SetMessageBasedParams(suite.ctx, sdk.MsgTypeURL(&banktypes.MsgSend{}), msgParams)
+
+				params1 := params
+				params1.ExpeditedVotingPeriod = &votingPeriod
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid message based params for /cosmos.bank.v1beta1.MsgSend",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1052,6 +1231,424 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgUpdateMessageParams() {
+	suite.reset()
+	authority := suite.govKeeper.GetAuthority()
+	msgURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	params := suite.govKeeper.GetParams(suite.ctx).ToMessageBasedParams()
+	params.Threshold = "0.75"
+
+	testCases := []struct {
+		name      string
+		input     *v1.MsgUpdateMessageParams
+		expErr    bool
+		expErrMsg string
+		expCustom bool
+	}{
+		{
+			name: "invalid authority",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: suite.addrs[0].String(),
+				MsgUrl:    msgURL,
+				Params:    &params,
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "unroutable msg url",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: authority,
+				MsgUrl:    "/cosmos.unknown.v1.MsgUnknown",
+				Params:    &params,
+			},
+			expErr:    true,
+			expErrMsg: "proposal message not recognized by router",
+		},
+		{
+			name: "invalid params",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: authority,
+				MsgUrl:    msgURL,
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:  params.VotingPeriod,
+					Quorum:        params.Quorum,
+					Threshold:     "1.1",
+					VetoThreshold: params.VetoThreshold,
+				},
+			},
+			expErr:    true,
+			expErrMsg: "vote threshold too large",
+		},
+		{
+			name: "voting period not longer than the expedited voting period",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: authority,
+				MsgUrl:    msgURL,
+				Params: &v1.MessageBasedParams{
+					VotingPeriod:  suite.govKeeper.GetParams(suite.ctx).ExpeditedVotingPeriod,
+					Quorum:        params.Quorum,
+					Threshold:     params.Threshold,
+					VetoThreshold: params.VetoThreshold,
+				},
+			},
+			expErr:    true,
+			expErrMsg: "must be strictly less than the voting period",
+		},
+		{
+			name: "set params",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: authority,
+				MsgUrl:    msgURL,
+				Params:    &params,
+			},
+			expCustom: true,
+		},
+		{
+			name: "remove params",
+			input: &v1.MsgUpdateMessageParams{
+				Authority: authority,
+				MsgUrl:    msgURL,
+			},
+			expCustom: false,
+		},
+	}
+
+	// the cases depend on each other, so they run in order
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UpdateMessageParams(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+			_, found := suite.govKeeper.GetMessageBasedParams(suite.ctx, msgURL)
+			suite.Require().Equal(tc.expCustom, found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGovernorReqs() {
+	suite.reset()
+	governor, delegator, other := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+
+	testCases := []struct {
+		name      string
+		exec      func() error
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "delegate to unknown governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.DelegateGovernance(suite.ctx, v1.NewMsgDelegateGovernance(delegator, governor))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "unknown governor",
+		},
+		{
+			name: "edit unknown governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.EditGovernor(suite.ctx, v1.NewMsgEditGovernor(governor, "metadata"))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "unknown governor",
+		},
+		{
+			name: "create governor with too long metadata",
+			exec: func() error {
+				_, err := suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(governor, strings.Repeat("1", 300)))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "metadata too long",
+		},
+		{
+			name: "create governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(governor, "metadata"))
+				return err
+			},
+		},
+		{
+			name: "create existing governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(governor, "metadata"))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "governor already exists",
+		},
+		{
+			name: "edit governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.EditGovernor(suite.ctx, v1.NewMsgEditGovernor(governor, "new metadata"))
+				return err
+			},
+		},
+		{
+			name: "governor delegates governance",
+			exec: func() error {
+				_, err := suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(other, ""))
+				suite.Require().NoError(err)
+				_, err = suite.msgSrvr.DelegateGovernance(suite.ctx, v1.NewMsgDelegateGovernance(governor, other))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "governors cannot delegate governance",
+		},
+		{
+			name: "delegate governance",
+			exec: func() error {
+				_, err := suite.msgSrvr.DelegateGovernance(suite.ctx, v1.NewMsgDelegateGovernance(delegator, governor))
+				return err
+			},
+		},
+		{
+			name: "delegator creates governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(delegator, ""))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "address delegating governance cannot become a governor",
+		},
+		{
+			name: "redelegate governance",
+			exec: func() error {
+				_, err := suite.msgSrvr.DelegateGovernance(suite.ctx, v1.NewMsgDelegateGovernance(delegator, other))
+				if err != nil {
+					return err
+				}
+
+				// the redelegation moves the delegator out of the index of the previous governor
+				suite.govKeeper.IterateGovernorDelegators(suite.ctx, governor, func(sdk.AccAddress) bool {
+					suite.Fail("unexpected delegator of previous governor")
+					return true
+				})
+				var delegators []sdk.AccAddress
+				suite.govKeeper.IterateGovernorDelegators(suite.ctx, other, func(delegatorAddr sdk.AccAddress) bool {
+					delegators = append(delegators, delegatorAddr)
+					return false
+				})
+				suite.Require().Equal([]sdk.AccAddress{delegator}, delegators)
+				return nil
+			},
+		},
+		{
+			name: "undelegate governance",
+			exec: func() error {
+				_, err := suite.msgSrvr.UndelegateGovernance(suite.ctx, v1.NewMsgUndelegateGovernance(delegator))
+				return err
+			},
+		},
+		{
+			name: "undelegate governance without delegation",
+			exec: func() error {
+				_, err := suite.msgSrvr.UndelegateGovernance(suite.ctx, v1.NewMsgUndelegateGovernance(delegator))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "has no governance delegation",
+		},
+		{
+			name: "remove governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.DelegateGovernance(suite.ctx, v1.NewMsgDelegateGovernance(delegator, other))
+				suite.Require().NoError(err)
+				_, err = suite.msgSrvr.RemoveGovernor(suite.ctx, v1.NewMsgRemoveGovernor(other))
+				if err != nil {
+					return err
+				}
+
+				// the governance delegations to the removed governor are removed
+				_, found := suite.govKeeper.GetGovernor(suite.ctx, other)
+				suite.Require().False(found)
+				_, found = suite.govKeeper.GetGovernanceDelegation(suite.ctx, delegator)
+				suite.Require().False(found)
+				suite.govKeeper.IterateGovernorDelegators(suite.ctx, other, func(sdk.AccAddress) bool {
+					suite.Fail("unexpected delegator of removed governor")
+					return true
+				})
+				return nil
+			},
+		},
+		{
+			name: "remove unknown governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.RemoveGovernor(suite.ctx, v1.NewMsgRemoveGovernor(other))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "unknown governor",
+		},
+	}
+
+	// the cases depend on each other, so they run in order
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.exec()
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+		})
+	}
+
+	govInfo, found := suite.govKeeper.GetGovernor(suite.ctx, governor)
+	suite.Require().True(found)
+	suite.Require().Equal("new metadata", govInfo.Metadata)
+	suite.Require().Len(suite.govKeeper.GetAllGovernors(suite.ctx), 1)
+	suite.Require().Empty(suite.govKeeper.GetAllGovernanceDelegations(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestScheduleParamsUpdateReqs() {
+	suite.reset()
+	authority := suite.govKeeper.GetAuthority()
+	params := v1.DefaultParams()
+	params.MinInitialDepositRatio = "0.1"
+	paramsMsg := &v1.MsgUpdateParams{Authority: authority, Params: params}
+
+	newScheduleMsg := func(authority string, height int64, msg sdk.Msg) *v1.MsgScheduleParamsUpdate {
+		scheduleMsg, err := v1.NewMsgScheduleParamsUpdate(authority, height, msg)
+		suite.Require().NoError(err)
+		return scheduleMsg
+	}
+
+	testCases := []struct {
+		name      string
+		exec      func() error
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			exec: func() error {
+				_, err := suite.msgSrvr.ScheduleParamsUpdate(suite.ctx, newScheduleMsg(suite.addrs[0].String(), 10, paramsMsg))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "not a params update msg",
+			exec: func() error {
+				msg := banktypes.NewMsgSend(govAcct, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000))))
+				_, err := suite.msgSrvr.ScheduleParamsUpdate(suite.ctx, newScheduleMsg(authority, 10, msg))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "is not a params update msg",
+		},
+		{
+			name: "params update msg not signed by gov account",
+			exec: func() error {
+				msg := &v1.MsgUpdateParams{Authority: suite.addrs[0].String(), Params: params}
+				_, err := suite.msgSrvr.ScheduleParamsUpdate(suite.ctx, newScheduleMsg(authority, 10, msg))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "expected gov account as only signer",
+		},
+		{
+			name: "height not in the future",
+			exec: func() error {
+				_, err := suite.msgSrvr.ScheduleParamsUpdate(suite.ctx, newScheduleMsg(authority, suite.ctx.BlockHeight(), paramsMsg))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "must be greater than the current block height",
+		},
+		{
+			name: "schedule params update",
+			exec: func() error {
+				res, err := suite.msgSrvr.ScheduleParamsUpdate(suite.ctx, newScheduleMsg(authority, 10, paramsMsg))
+				if err == nil {
+					suite.Require().Equal(uint64(1), res.Id)
+				}
+				return err
+			},
+		},
+		{
+			name: "schedule another params update",
+			exec: func() error {
+				res, err := suite.msgSrvr.ScheduleParamsUpdate(suite.ctx, newScheduleMsg(authority, 5, paramsMsg))
+				if err == nil {
+					suite.Require().Equal(uint64(2), res.Id)
+				}
+				return err
+			},
+		},
+		{
+			name: "cancel with invalid authority",
+			exec: func() error {
+				_, err := suite.msgSrvr.CancelScheduledParamsUpdate(suite.ctx, v1.NewMsgCancelScheduledParamsUpdate(suite.addrs[0].String(), 1))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "cancel unknown params update",
+			exec: func() error {
+				_, err := suite.msgSrvr.CancelScheduledParamsUpdate(suite.ctx, v1.NewMsgCancelScheduledParamsUpdate(authority, 3))
+				return err
+			},
+			expErr:    true,
+			expErrMsg: "unknown scheduled params update",
+		},
+		{
+			name: "cancel params update",
+			exec: func() error {
+				_, err := suite.msgSrvr.CancelScheduledParamsUpdate(suite.ctx, v1.NewMsgCancelScheduledParamsUpdate(authority, 1))
+				return err
+			},
+		},
+	}
+
+	// the cases depend on each other, so they run in order
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			err := tc.exec()
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+
+			suite.Require().NoError(err)
+		})
+	}
+
+	_, found := suite.govKeeper.GetScheduledParamsUpdate(suite.ctx, 1)
+	suite.Require().False(found)
+
+	var due []uint64
+	suite.govKeeper.IterateDueScheduledParamsUpdates(suite.ctx, 10, func(update v1.ScheduledParamsUpdate) bool {
+		due = append(due, update.Id)
+		return false
+	})
+	suite.Require().Equal([]uint64{2}, due)
+
+	due = nil
+	suite.govKeeper.IterateDueScheduledParamsUpdates(suite.ctx, 4, func(update v1.ScheduledParamsUpdate) bool {
+		due = append(due, update.Id)
+		return false
+	})
+	suite.Require().Empty(due)
+}
+
 func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 	const meetsDepositValue = baseDepositTestAmount * baseDepositTestPercent / 100
 	baseDepositRatioDec := sdk.NewDec(baseDepositTestPercent).Quo(sdk.NewDec(100))
@@ -1107,7 +1704,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 			params.MinInitialDepositRatio = tc.minInitialDepositRatio.String()
 			govKeeper.SetParams(ctx, params)
 
-			msg, err := v1.NewMsgSubmitProposal(TestProposal, tc.initialDeposit, address.String(), "test", "Proposal", "description of proposal")
+			msg, err := v1.NewMsgSubmitProposal(TestProposal, tc.initialDeposit, address.String(), "test", "Proposal", "description of proposal", false, false)
 			suite.Require().NoError(err)
 
 			// System under test
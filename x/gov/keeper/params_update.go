@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// ScheduleParamsUpdate schedules the execution of the MsgUpdateParams message
+// of a module at the beginning of a future block height, and returns the id of
+// the scheduled params update.
+func (keeper Keeper) ScheduleParamsUpdate(ctx sdk.Context, height int64, msg sdk.Msg) (uint64, error) {
+	if height <= ctx.BlockHeight() {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidParamsUpdate, "height %d must be greater than the current block height %d", height, ctx.BlockHeight())
+	}
+
+	signers := msg.GetSigners()
+	if len(signers) != 1 {
+		return 0, types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the
+	// message, as it is executed on its behalf
+	if !signers[0].Equals(keeper.GetGovernanceAccount(ctx).GetAddress()) {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidSigner, signers[0].String())
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	if keeper.router.Handler(msg) == nil {
+		return 0, sdkerrors.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+
+	id := keeper.GetNextScheduledParamsUpdateID(ctx)
+	update, err := v1.NewScheduledParamsUpdate(id, height, msg)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := update.ValidateBasic(); err != nil {
+		return 0, err
+	}
+
+	keeper.SetScheduledParamsUpdate(ctx, update)
+	keeper.SetNextScheduledParamsUpdateID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleParamsUpdate,
+			sdk.NewAttribute(types.AttributeKeyParamsUpdateID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyParamsUpdateHeight, fmt.Sprintf("%d", height)),
+			sdk.NewAttribute(types.AttributeKeyParamsUpdateMsg, sdk.MsgTypeURL(msg)),
+		),
+	)
+
+	return id, nil
+}
+
+// CancelScheduledParamsUpdate removes a scheduled params update before its
+// execution.
+func (keeper Keeper) CancelScheduledParamsUpdate(ctx sdk.Context, id uint64) error {
+	update, found := keeper.GetScheduledParamsUpdate(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownParamsUpdate, "%d", id)
+	}
+
+	keeper.DeleteScheduledParamsUpdate(ctx, update)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelParamsUpdate,
+			sdk.NewAttribute(types.AttributeKeyParamsUpdateID, fmt.Sprintf("%d", id)),
+		),
+	)
+
+	return nil
+}
+
+// GetScheduledParamsUpdate gets a scheduled params update by its id.
+func (keeper Keeper) GetScheduledParamsUpdate(ctx sdk.Context, id uint64) (update v1.ScheduledParamsUpdate, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ScheduledParamsUpdateKey(id))
+	if bz == nil {
+		return update, false
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &update)
+	return update, true
+}
+
+// SetScheduledParamsUpdate sets a scheduled params update and inserts it into
+// the scheduled params update queue at its height.
+func (keeper Keeper) SetScheduledParamsUpdate(ctx sdk.Context, update v1.ScheduledParamsUpdate) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ScheduledParamsUpdateKey(update.Id), keeper.cdc.MustMarshal(&update))
+	store.Set(types.ScheduledParamsUpdateQueueKey(update.Id, update.Height), sdk.Uint64ToBigEndian(update.Id))
+}
+
+// DeleteScheduledParamsUpdate deletes a scheduled params update and removes it
+// from the scheduled params update queue.
+func (keeper Keeper) DeleteScheduledParamsUpdate(ctx sdk.Context, update v1.ScheduledParamsUpdate) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.ScheduledParamsUpdateKey(update.Id))
+	store.Delete(types.ScheduledParamsUpdateQueueKey(update.Id, update.Height))
+}
+
+// GetNextScheduledParamsUpdateID gets the id of the next scheduled params
+// update.
+func (keeper Keeper) GetNextScheduledParamsUpdateID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.NextScheduledParamsUpdateIDKey)
+	if bz == nil {
+		return 1
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextScheduledParamsUpdateID sets the id of the next scheduled params
+// update.
+func (keeper Keeper) SetNextScheduledParamsUpdateID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.NextScheduledParamsUpdateIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// IterateScheduledParamsUpdates iterates over all the scheduled params updates,
+// ordered by id, and performs a callback function.
+func (keeper Keeper) IterateScheduledParamsUpdates(ctx sdk.Context, cb func(update v1.ScheduledParamsUpdate) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ScheduledParamsUpdatesKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var update v1.ScheduledParamsUpdate
+		keeper.cdc.MustUnmarshal(iterator.Value(), &update)
+
+		if cb(update) {
+			break
+		}
+	}
+}
+
+// GetAllScheduledParamsUpdates returns all the scheduled params updates.
+func (keeper Keeper) GetAllScheduledParamsUpdates(ctx sdk.Context) (updates []v1.ScheduledParamsUpdate) {
+	keeper.IterateScheduledParamsUpdates(ctx, func(update v1.ScheduledParamsUpdate) bool {
+		updates = append(updates, update)
+		return false
+	})
+	return
+}
+
+// IterateDueScheduledParamsUpdates iterates over the scheduled params updates
+// in the scheduled params update queue whose height is at most the given
+// height, and performs a callback function.
+func (keeper Keeper) IterateDueScheduledParamsUpdates(ctx sdk.Context, height int64, cb func(update v1.ScheduledParamsUpdate) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.Iterator(types.ScheduledParamsUpdateQueuePrefix, sdk.PrefixEndBytes(types.ScheduledParamsUpdateByHeightKey(height)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Value())
+		update, found := keeper.GetScheduledParamsUpdate(ctx, id)
+		if !found {
+			panic(fmt.Sprintf("scheduled params update %d does not exist", id))
+		}
+
+		if cb(update) {
+			break
+		}
+	}
+}
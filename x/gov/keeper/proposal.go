@@ -12,12 +12,25 @@ import (
 )
 
 // SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited, optimistic bool) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
 
+	params := keeper.GetParams(ctx)
+
+	if optimistic {
+		if expedited {
+			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalType, "proposal cannot be both expedited and optimistic")
+		}
+
+		// only allow-listed proposers can submit optimistic proposals
+		if !params.IsOptimisticAuthorizedAddress(proposer.String()) {
+			return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not allowed to submit optimistic proposals", proposer)
+		}
+	}
+
 	// assert summary is no longer than predefined max length of metadata
 	err = keeper.assertMetadataLength(summary)
 	if err != nil {
@@ -38,6 +51,22 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	for _, msg := range messages {
 		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
 
+		// optimistic proposals can only contain allow-listed message types. A
+		// scheduled params update is checked against the params update message
+		// it wraps as well, so scheduling can't bypass the allow-list.
+		if optimistic {
+			msgURLs := []string{sdk.MsgTypeURL(msg)}
+			if scheduleMsg, ok := msg.(*v1.MsgScheduleParamsUpdate); ok && scheduleMsg.Msg != nil {
+				msgURLs = append(msgURLs, scheduleMsg.Msg.TypeUrl)
+			}
+
+			for _, msgURL := range msgURLs {
+				if !params.IsOptimisticAllowedMsgURL(msgURL) {
+					return v1.Proposal{}, sdkerrors.Wrapf(types.ErrInvalidProposalMsg, "%s is not allowed in optimistic proposals", msgURL)
+				}
+			}
+		}
+
 		// perform a basic validation of the message
 		if err := msg.ValidateBasic(); err != nil {
 			return v1.Proposal{}, sdkerrors.Wrap(types.ErrInvalidProposalMsg, err.Error())
@@ -82,12 +111,14 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	}
 
 	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
+	depositPeriod := params.MaxDepositPeriod
 
 	proposal, err := v1.NewProposal(messages, proposalID, submitTime, submitTime.Add(*depositPeriod), metadata, title, summary, proposer)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.Expedited = expedited
+	proposal.Optimistic = optimistic
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -107,6 +138,61 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// CancelProposal cancels a proposal in its voting period on behalf of its
+// proposer. A ProposalCancelRatio share of the deposits is burned or sent to
+// ProposalCancelDest, the rest is refunded, and the proposal and its votes are
+// deleted.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, found := keeper.GetProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	// proposals submitted before v0.47 don't record their proposer
+	if proposal.Proposer == "" {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "proposal %d doesn't have a proposer", proposalID)
+	}
+
+	if proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "invalid proposer %s", proposer)
+	}
+
+	if proposal.Status != v1.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "proposal %d is not in its voting period", proposalID)
+	}
+
+	if proposal.VotingEndTime != nil && !ctx.BlockTime().Before(*proposal.VotingEndTime) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "voting period of proposal %d ended at %s", proposalID, proposal.VotingEndTime)
+	}
+
+	params := keeper.GetParams(ctx)
+	if err := keeper.ChargeDeposit(ctx, proposalID, params.ProposalCancelDest, params.ProposalCancelRatio); err != nil {
+		return err
+	}
+
+	keeper.deleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	// called right after a proposal is canceled
+	keeper.Hooks().AfterProposalCanceled(ctx, proposalID)
+
+	keeper.Logger(ctx).Info(
+		"proposal is canceled by proposer",
+		"proposal", proposalID,
+		"proposer", proposer,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalProposer, proposer),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal gets a proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -262,7 +348,10 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetParams(ctx).VotingPeriod
+	votingPeriod := keeper.GetProposalMessageBasedParams(ctx, proposal).VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = keeper.GetParams(ctx).ExpeditedVotingPeriod
+	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
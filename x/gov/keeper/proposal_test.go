@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -18,7 +19,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -36,7 +37,7 @@ func (suite *KeeperTestSuite) TestDeleteProposal() {
 		},
 	)
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.govKeeper.SetProposal(suite.ctx, proposal)
@@ -47,7 +48,7 @@ func (suite *KeeperTestSuite) TestDeleteProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -71,10 +72,67 @@ func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	}, "")
 }
 
+func (suite *KeeperTestSuite) TestActivateVotingPeriodMessageBasedParams() {
+	suite.reset()
+	params := suite.govKeeper.GetParams(suite.ctx)
+	msgSendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	// the bank MsgSend of the test proposal gets a longer voting period
+	votingPeriod := *params.VotingPeriod * 2
+	msgParams := params.ToMessageBasedParams()
+	msgParams.VotingPeriod = &votingPeriod
+	suite.govKeeper.SetMessageBasedParams(suite.ctx, msgSendURL, msgParams)
+
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, TestProposal[:1], "", "test", "summary", suite.addrs[0], false, false)
+	suite.Require().NoError(err)
+
+	suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+	proposal, ok := suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().True(ok)
+	suite.Require().Equal(proposal.VotingStartTime.Add(votingPeriod), *proposal.VotingEndTime)
+
+	// the legacy content message of the test proposal has the default params,
+	// and the bundle is voted on for the longest voting period of its messages
+	proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], false, false)
+	suite.Require().NoError(err)
+
+	suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+	proposal, ok = suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().True(ok)
+	suite.Require().Equal(proposal.VotingStartTime.Add(votingPeriod), *proposal.VotingEndTime)
+
+	// a shorter voting period for the bank MsgSend doesn't shorten the one of
+	// the bundle
+	shortVotingPeriod := (*params.VotingPeriod + *params.ExpeditedVotingPeriod) / 2
+	msgParams.VotingPeriod = &shortVotingPeriod
+	suite.govKeeper.SetMessageBasedParams(suite.ctx, msgSendURL, msgParams)
+
+	proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal, "", "test", "summary", suite.addrs[0], false, false)
+	suite.Require().NoError(err)
+
+	suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+	proposal, ok = suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().True(ok)
+	suite.Require().Equal(proposal.VotingStartTime.Add(*params.VotingPeriod), *proposal.VotingEndTime)
+
+	// expedited proposals keep the expedited voting period
+	proposal, err = suite.govKeeper.SubmitProposal(suite.ctx, TestProposal[:1], "", "test", "summary", suite.addrs[0], true, false)
+	suite.Require().NoError(err)
+
+	suite.govKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+
+	proposal, ok = suite.govKeeper.GetProposal(suite.ctx, proposal.Id)
+	suite.Require().True(ok)
+	suite.Require().Equal(proposal.VotingStartTime.Add(*params.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+}
+
 func (suite *KeeperTestSuite) TestDeleteProposalInVotingPeriod() {
 	suite.reset()
 	tp := TestProposal
-	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+	proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tp, "", "test", "summary", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 	suite.Require().NoError(err)
 	suite.Require().Nil(proposal.VotingStartTime)
 
@@ -137,11 +195,59 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"))
+		_, err = suite.govKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, "title", "", sdk.AccAddress("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"), false, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposal() {
+	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	legacyContent, err := v1.NewLegacyContent(&v1beta1.TextProposal{Title: "title", Description: "description"}, govAcct.String())
+	suite.Require().NoError(err)
+	send := banktypes.NewMsgSend(govAcct, suite.addrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	params := suite.govKeeper.GetParams(suite.ctx)
+	params.OptimisticAuthorizedAddresses = []string{suite.addrs[0].String()}
+
+	// only the bank params can be updated through a scheduled params update
+	bankParamsMsg := &banktypes.MsgUpdateParams{Authority: govAcct.String(), Params: banktypes.DefaultParams()}
+	scheduleBankParams, err := v1.NewMsgScheduleParamsUpdate(govAcct.String(), 100, bankParamsMsg)
+	suite.Require().NoError(err)
+	scheduleGovParams, err := v1.NewMsgScheduleParamsUpdate(govAcct.String(), 100, &v1.MsgUpdateParams{Authority: govAcct.String(), Params: params})
+	suite.Require().NoError(err)
+
+	params.OptimisticAllowedMsgUrls = []string{sdk.MsgTypeURL(legacyContent), sdk.MsgTypeURL(scheduleBankParams), sdk.MsgTypeURL(bankParamsMsg)}
+	suite.Require().NoError(suite.govKeeper.SetParams(suite.ctx, params))
+
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		proposer    sdk.AccAddress
+		expedited   bool
+		expectedErr error
+	}{
+		{"authorized proposer and allowed msgs", []sdk.Msg{legacyContent}, suite.addrs[0], false, nil},
+		{"no msgs", nil, suite.addrs[0], false, nil},
+		{"unauthorized proposer", []sdk.Msg{legacyContent}, suite.addrs[1], false, types.ErrInvalidProposer},
+		{"msg not allowed", []sdk.Msg{legacyContent, send}, suite.addrs[0], false, types.ErrInvalidProposalMsg},
+		{"scheduled params update of an allowed msg", []sdk.Msg{scheduleBankParams}, suite.addrs[0], false, nil},
+		{"scheduled params update of a msg not allowed", []sdk.Msg{scheduleGovParams}, suite.addrs[0], false, types.ErrInvalidProposalMsg},
+		{"expedited", []sdk.Msg{legacyContent}, suite.addrs[0], true, types.ErrInvalidProposalType},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal, err := suite.govKeeper.SubmitProposal(suite.ctx, tc.msgs, "metadata", "title", "summary", tc.proposer, tc.expedited, true)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(proposal.Optimistic)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
@@ -34,10 +34,23 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false
 	})
 
+	params := keeper.GetParams(ctx)
+	totalBonded := sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx))
+
+	// voters who voted directly, whose votes take precedence over the votes of
+	// the governors they delegate their governance voting power to
+	voted := make(map[string]bool)
+	var governorVotes []v1.Vote
+
 	keeper.IterateVotes(ctx, proposal.Id, func(vote v1.Vote) bool {
 		// if validator, just record it in the map
 		voter := sdk.MustAccAddressFromBech32(vote.Voter)
 
+		voted[vote.Voter] = true
+		if _, ok := keeper.GetGovernor(ctx, voter); ok {
+			governorVotes = append(governorVotes, vote)
+		}
+
 		valAddrStr := sdk.ValAddress(voter.Bytes()).String()
 		if val, ok := currValidators[valAddrStr]; ok {
 			val.Vote = vote.Options
@@ -72,6 +85,58 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		return false
 	})
 
+	// tally the voting power delegated to the governors who voted, which is the
+	// stake of their delegators who didn't vote themselves, up to the max
+	// governor voting power ratio of the bonded stake. The stake the governor
+	// votes with is deducted from the validators, whose votes it would
+	// otherwise inherit, while the stake above the cap still inherits them.
+	maxGovernorVotingPowerRatio, _ := sdk.NewDecFromStr(params.MaxGovernorVotingPowerRatio)
+	maxGovernorVotingPower := totalBonded.Mul(maxGovernorVotingPowerRatio)
+	for _, vote := range governorVotes {
+		governorPower := math.LegacyZeroDec()
+		var delegations []stakingtypes.DelegationI
+
+		keeper.IterateGovernorDelegators(ctx, sdk.MustAccAddressFromBech32(vote.Voter), func(delegatorAddr sdk.AccAddress) bool {
+			if voted[delegatorAddr.String()] {
+				return false
+			}
+
+			keeper.sk.IterateDelegations(ctx, delegatorAddr, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+				if val, ok := currValidators[delegation.GetValidatorAddr().String()]; ok {
+					delegations = append(delegations, delegation)
+
+					// delegation shares * bonded / total shares
+					governorPower = governorPower.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+				}
+
+				return false
+			})
+
+			return false
+		})
+
+		if !governorPower.IsPositive() {
+			continue
+		}
+
+		votingPower := math.LegacyMinDec(governorPower, maxGovernorVotingPower)
+		for _, option := range vote.Options {
+			weight, _ := sdk.NewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+
+		// deduct the share of each delegation the governor votes with
+		countedRatio := votingPower.Quo(governorPower)
+		for _, delegation := range delegations {
+			valAddrStr := delegation.GetValidatorAddr().String()
+			val := currValidators[valAddrStr]
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares().Mul(countedRatio))
+			currValidators[valAddrStr] = val
+		}
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range currValidators {
 		if len(val.Vote) == 0 {
@@ -89,18 +154,30 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	params := keeper.GetParams(ctx)
+	msgParams := keeper.GetProposalMessageBasedParams(ctx, proposal)
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
 	// If there is no staked coins, the proposal fails
-	if keeper.sk.TotalBondedTokens(ctx).IsZero() {
+	if totalBonded.IsZero() {
 		return false, false, tallyResults
 	}
 
+	// Optimistic proposals pass unless the No and NoWithVeto votes reach the
+	// optimistic rejected threshold of the bonded stake. Quorum and thresholds
+	// do not apply to them.
+	if proposal.Optimistic {
+		rejectedThreshold, _ := sdk.NewDecFromStr(params.OptimisticRejectedThreshold)
+		if results[v1.OptionNo].Add(results[v1.OptionNoWithVeto]).Quo(totalBonded).GTE(rejectedThreshold) {
+			return false, false, tallyResults
+		}
+
+		return true, false, tallyResults
+	}
+
 	// If there is not enough quorum of votes, the proposal fails
-	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
-	quorum, _ := sdk.NewDecFromStr(params.Quorum)
+	percentVoting := totalVotingPower.Quo(totalBonded)
+	quorum, _ := sdk.NewDecFromStr(msgParams.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults
 	}
@@ -111,13 +188,19 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
-	vetoThreshold, _ := sdk.NewDecFromStr(params.VetoThreshold)
+	vetoThreshold, _ := sdk.NewDecFromStr(msgParams.VetoThreshold)
 	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
 		return false, params.BurnVoteVeto, tallyResults
 	}
 
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	threshold, _ := sdk.NewDecFromStr(params.Threshold)
+	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes.
+	// Expedited proposals must reach the higher expedited threshold instead,
+	// unless the messages of the proposal require an even higher one.
+	threshold, _ := sdk.NewDecFromStr(msgParams.Threshold)
+	if proposal.Expedited {
+		expeditedThreshold, _ := sdk.NewDecFromStr(params.ExpeditedThreshold)
+		threshold = math.LegacyMaxDec(threshold, expeditedThreshold)
+	}
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
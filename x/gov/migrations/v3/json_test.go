@@ -83,10 +83,14 @@ func TestMigrateJSON(t *testing.T) {
 		]
 	},
 	"deposits": [],
+	"governance_delegations": [],
+	"governors": [],
+	"message_based_params": [],
 	"params": null,
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain_count": "0",
 				"no_count": "0",
@@ -106,6 +110,7 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"optimistic": false,
 			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
@@ -121,6 +126,7 @@ func TestMigrateJSON(t *testing.T) {
 			"voting_start_time": "2001-09-09T01:46:40Z"
 		}
 	],
+	"scheduled_params_updates": [],
 	"starting_proposal_id": "1",
 	"tally_params": {
 		"quorum": "0.334000000000000000",
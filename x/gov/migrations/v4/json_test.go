@@ -41,6 +41,14 @@ func TestMigrateJSON(t *testing.T) {
 
 	migrated, err := v4.MigrateJSON(oldGovState)
 	require.NoError(t, err)
+	// the parameters added after v0.47 are set by the v5 store migration
+	params := govGenState.Params
+	expectedParams := v1.NewParams(
+		params.MinDeposit, *params.MaxDepositPeriod, *params.VotingPeriod,
+		params.Quorum, params.Threshold, params.VetoThreshold, params.MinInitialDepositRatio,
+		params.BurnProposalDepositPrevote, params.BurnVoteQuorum, params.BurnVoteVeto,
+	)
+	govGenState.Params = &expectedParams
 	require.Equal(t, migrated, govGenState)
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
@@ -58,11 +66,18 @@ func TestMigrateJSON(t *testing.T) {
 	expected := `{
 	"deposit_params": null,
 	"deposits": [],
+	"governance_delegations": [],
+	"governors": [],
+	"message_based_params": [],
 	"params": {
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
 		"burn_vote_veto": true,
+		"expedited_min_deposit": [],
+		"expedited_threshold": "",
+		"expedited_voting_period": null,
 		"max_deposit_period": "172800s",
+		"max_governor_voting_power_ratio": "",
 		"min_deposit": [
 			{
 				"amount": "10000000",
@@ -70,12 +85,18 @@ func TestMigrateJSON(t *testing.T) {
 			}
 		],
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"optimistic_allowed_msg_urls": [],
+		"optimistic_authorized_addresses": [],
+		"optimistic_rejected_threshold": "",
+		"proposal_cancel_dest": "",
+		"proposal_cancel_ratio": "",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000",
 		"voting_period": "172800s"
 	},
 	"proposals": [],
+	"scheduled_params_updates": [],
 	"starting_proposal_id": "1",
 	"tally_params": null,
 	"votes": [],
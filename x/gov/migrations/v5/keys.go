@@ -0,0 +1,9 @@
+package v5
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "gov"
+)
+
+// ParamsKey is the key of x/gov params
+var ParamsKey = []byte{0x30}
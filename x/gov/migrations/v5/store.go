@@ -0,0 +1,72 @@
+package v5
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The
+// migration includes:
+//
+// Addition of the expedited proposal parameters. The expedited minimum deposit
+// is set to a multiple of the minimum deposit, and the expedited threshold and
+// voting period to their defaults, unless those would not be stricter than the
+// regular threshold and voting period of the chain.
+//
+// Addition of the proposal cancel ratio parameter, set to its default, with no
+// proposal cancel destination so that cancellation charges are burned.
+//
+// Addition of the optimistic proposal parameters. The optimistic rejected
+// threshold is set to its default, and no proposer nor message type is allowed
+// in optimistic proposals until governance sets them.
+//
+// Addition of the max governor voting power ratio parameter, set to its default.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params govv1.Params
+	if err := cdc.Unmarshal(store.Get(ParamsKey), &params); err != nil {
+		return err
+	}
+
+	expeditedMinDeposit := make(sdk.Coins, len(params.MinDeposit))
+	for i, coin := range params.MinDeposit {
+		expeditedMinDeposit[i] = sdk.NewCoin(coin.Denom, coin.Amount.Mul(govv1.DefaultMinExpeditedDepositTokensRatio))
+	}
+	params.ExpeditedMinDeposit = expeditedMinDeposit
+
+	threshold, err := sdk.NewDecFromStr(params.Threshold)
+	if err != nil {
+		return err
+	}
+	expeditedThreshold := govv1.DefaultExpeditedThreshold
+	if expeditedThreshold.LTE(threshold) {
+		expeditedThreshold = threshold.Add(math.LegacyOneDec()).QuoInt64(2)
+	}
+	params.ExpeditedThreshold = expeditedThreshold.String()
+
+	expeditedVotingPeriod := govv1.DefaultExpeditedPeriod
+	if expeditedVotingPeriod >= *params.VotingPeriod {
+		expeditedVotingPeriod = *params.VotingPeriod / 2
+	}
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+
+	params.ProposalCancelRatio = govv1.DefaultProposalCancelRatio.String()
+	params.ProposalCancelDest = govv1.DefaultProposalCancelDestAddress
+
+	params.OptimisticRejectedThreshold = govv1.DefaultOptimisticRejectedThreshold.String()
+	params.MaxGovernorVotingPowerRatio = govv1.DefaultMaxGovernorVotingPowerRatio.String()
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v5 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v5"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}).Codec
+
+	testCases := []struct {
+		name                  string
+		votingPeriod          time.Duration
+		threshold             string
+		expeditedVotingPeriod time.Duration
+		expeditedThreshold    string
+	}{
+		{
+			name:                  "defaults",
+			votingPeriod:          v1.DefaultPeriod,
+			threshold:             v1.DefaultThreshold.String(),
+			expeditedVotingPeriod: v1.DefaultExpeditedPeriod,
+			expeditedThreshold:    v1.DefaultExpeditedThreshold.String(),
+		},
+		{
+			name:                  "short voting period and high threshold",
+			votingPeriod:          time.Hour,
+			threshold:             "0.8",
+			expeditedVotingPeriod: 30 * time.Minute,
+			expeditedThreshold:    "0.900000000000000000",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			govKey := sdk.NewKVStoreKey("gov")
+			ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+			store := ctx.KVStore(govKey)
+
+			// params as stored before the expedited and cancel params were added
+			params := v1.DefaultParams()
+			params.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("uatom", 10))
+			params.VotingPeriod = &tc.votingPeriod
+			params.Threshold = tc.threshold
+			params.ExpeditedMinDeposit = nil
+			params.ExpeditedVotingPeriod = nil
+			params.ExpeditedThreshold = ""
+			params.ProposalCancelRatio = ""
+			params.OptimisticRejectedThreshold = ""
+			params.MaxGovernorVotingPowerRatio = ""
+			store.Set(v5.ParamsKey, cdc.MustMarshal(&params))
+
+			require.NoError(t, v5.MigrateStore(ctx, govKey, cdc))
+
+			var migrated v1.Params
+			require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &migrated))
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("uatom", 50)), sdk.NewCoins(migrated.ExpeditedMinDeposit...))
+			require.Equal(t, tc.expeditedVotingPeriod, *migrated.ExpeditedVotingPeriod)
+			require.Equal(t, tc.expeditedThreshold, migrated.ExpeditedThreshold)
+			require.Equal(t, v1.DefaultProposalCancelRatio.String(), migrated.ProposalCancelRatio)
+			require.Empty(t, migrated.ProposalCancelDest)
+			require.Equal(t, v1.DefaultOptimisticRejectedThreshold.String(), migrated.OptimisticRejectedThreshold)
+			require.Equal(t, v1.DefaultMaxGovernorVotingPowerRatio.String(), migrated.MaxGovernorVotingPowerRatio)
+			require.Empty(t, migrated.OptimisticAuthorizedAddresses)
+			require.Empty(t, migrated.OptimisticAllowedMsgUrls)
+			require.NoError(t, migrated.ValidateBasic())
+		})
+	}
+}
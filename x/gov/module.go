@@ -37,9 +37,10 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-const ConsensusVersion = 4
+const ConsensusVersion = 5
 
 var (
+	_ module.BeginBlockAppModule = AppModule{}
 	_ module.EndBlockAppModule   = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
@@ -294,6 +295,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to migrate x/gov from version 3 to 4: %v", err))
 	}
+	err = cfg.RegisterMigration(govtypes.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gov from version 4 to 5: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
@@ -315,6 +320,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// BeginBlock executes the params updates scheduled at the current block height.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	BeginBlocker(ctx, am.keeper)
+}
+
 // EndBlock returns the end blocker for the gov module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
@@ -19,13 +19,24 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	DepositMinInitialRatio     = "deposit_params_min_initial_ratio"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit           = "deposit_params_min_deposit"
+	DepositParamsExpeditedMinDeposit  = "deposit_params_expedited_min_deposit"
+	DepositParamsDepositPeriod        = "deposit_params_deposit_period"
+	DepositMinInitialRatio            = "deposit_params_min_initial_ratio"
+	VotingParamsVotingPeriod          = "voting_params_voting_period"
+	VotingParamsExpeditedVotingPeriod = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                 = "tally_params_quorum"
+	TallyParamsThreshold              = "tally_params_threshold"
+	TallyParamsExpeditedThreshold     = "tally_params_expedited_threshold"
+	TallyParamsVeto                   = "tally_params_veto"
+	ProposalCancelRate                = "proposal_cancel_rate"
+	OptimisticRejectedThreshold       = "optimistic_rejected_threshold"
+	MaxGovernorVotingPowerRatio       = "max_governor_voting_power_ratio"
+
+	// expeditedMaxVotingPeriod bounds the expedited voting period from above
+	// and the regular voting period from below, so that the former is always
+	// strictly shorter.
+	expeditedMaxVotingPeriod = 60 * 60 * 24 * 2
 )
 
 // GenDepositParamsDepositPeriod returns randomized DepositParamsDepositPeriod
@@ -38,6 +49,12 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsExpeditedMinDeposit returns randomized DepositParamsExpeditedMinDeposit,
+// which is always greater than the one returned by GenDepositParamsMinDeposit
+func GenDepositParamsExpeditedMinDeposit(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1e3, 2e3))))
+}
+
 // GenDepositMinInitialRatio returns randomized DepositMinInitialRatio
 func GenDepositMinInitialDepositRatio(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(int64(simulation.RandIntBetween(r, 0, 99))).Quo(sdk.NewDec(100))
@@ -45,7 +62,12 @@ func GenDepositMinInitialDepositRatio(r *rand.Rand) sdk.Dec {
 
 // GenVotingParamsVotingPeriod returns randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
-	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
+	return time.Duration(simulation.RandIntBetween(r, expeditedMaxVotingPeriod, 2*60*60*24*2)) * time.Second
+}
+
+// GenVotingParamsExpeditedVotingPeriod returns randomized VotingParamsExpeditedVotingPeriod
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, expeditedMaxVotingPeriod)) * time.Second
 }
 
 // GenTallyParamsQuorum returns randomized TallyParamsQuorum
@@ -58,11 +80,32 @@ func GenTallyParamsThreshold(r *rand.Rand) math.LegacyDec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 450, 550)), 3)
 }
 
+// GenTallyParamsExpeditedThreshold returns randomized TallyParamsExpeditedThreshold,
+// which is always greater than the one returned by GenTallyParamsThreshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) math.LegacyDec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 550, 700)), 3)
+}
+
 // GenTallyParamsVeto returns randomized TallyParamsVeto
 func GenTallyParamsVeto(r *rand.Rand) math.LegacyDec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenProposalCancelRate returns randomized ProposalCancelRate
+func GenProposalCancelRate(r *rand.Rand) math.LegacyDec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 100)), 2)
+}
+
+// GenOptimisticRejectedThreshold returns randomized OptimisticRejectedThreshold
+func GenOptimisticRejectedThreshold(r *rand.Rand) math.LegacyDec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 334)), 3)
+}
+
+// GenMaxGovernorVotingPowerRatio returns randomized MaxGovernorVotingPowerRatio
+func GenMaxGovernorVotingPowerRatio(r *rand.Rand) math.LegacyDec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 5, 100)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -109,11 +152,52 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
-	govGenesis := v1.NewGenesisState(
-		startingProposalID,
-		v1.NewParams(minDeposit, depositPeriod, votingPeriod, quorum.String(), threshold.String(), veto.String(), minInitialDepositRatio.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0),
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand,
+		func(r *rand.Rand) { expeditedMinDeposit = GenDepositParamsExpeditedMinDeposit(r) },
 	)
 
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedVotingPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r) },
+	)
+
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
+	var proposalCancelRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, ProposalCancelRate, &proposalCancelRate, simState.Rand,
+		func(r *rand.Rand) { proposalCancelRate = GenProposalCancelRate(r) },
+	)
+
+	var optimisticRejectedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OptimisticRejectedThreshold, &optimisticRejectedThreshold, simState.Rand,
+		func(r *rand.Rand) { optimisticRejectedThreshold = GenOptimisticRejectedThreshold(r) },
+	)
+
+	var maxGovernorVotingPowerRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxGovernorVotingPowerRatio, &maxGovernorVotingPowerRatio, simState.Rand,
+		func(r *rand.Rand) { maxGovernorVotingPowerRatio = GenMaxGovernorVotingPowerRatio(r) },
+	)
+
+	params := v1.NewParams(minDeposit, depositPeriod, votingPeriod, quorum.String(), threshold.String(), veto.String(), minInitialDepositRatio.String(), simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0, simState.Rand.Intn(2) == 0)
+	params.ExpeditedMinDeposit = expeditedMinDeposit
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	params.ExpeditedThreshold = expeditedThreshold.String()
+	params.ProposalCancelRatio = proposalCancelRate.String()
+	params.OptimisticRejectedThreshold = optimisticRejectedThreshold.String()
+	params.MaxGovernorVotingPowerRatio = maxGovernorVotingPowerRatio.String()
+
+	govGenesis := v1.NewGenesisState(startingProposalID, params)
+
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
 	if err != nil {
 		panic(err)
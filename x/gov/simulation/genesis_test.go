@@ -44,16 +44,29 @@ func TestRandomizedGenState(t *testing.T) {
 	const (
 		tallyQuorum          = "0.400000000000000000"
 		tallyThreshold       = "0.539000000000000000"
+		tallyExpeditedThresh = "0.645000000000000000"
 		tallyVetoThreshold   = "0.314000000000000000"
 		minInitialDepositDec = "0.590000000000000000"
+		proposalCancelRate   = "0.370000000000000000"
+		optimisticRejected   = "0.249000000000000000"
+		maxGovernorPower     = "0.850000000000000000"
 	)
 
 	require.Equal(t, "905stake", govGenesis.Params.MinDeposit[0].String())
 	require.Equal(t, "77h26m10s", govGenesis.Params.MaxDepositPeriod.String())
-	require.Equal(t, float64(275567), govGenesis.Params.VotingPeriod.Seconds())
+	require.Equal(t, "1274stake", govGenesis.Params.ExpeditedMinDeposit[0].String())
+	require.Equal(t, float64(270511), govGenesis.Params.VotingPeriod.Seconds())
+	require.Equal(t, float64(77129), govGenesis.Params.ExpeditedVotingPeriod.Seconds())
 	require.Equal(t, tallyQuorum, govGenesis.Params.Quorum)
 	require.Equal(t, tallyThreshold, govGenesis.Params.Threshold)
+	require.Equal(t, tallyExpeditedThresh, govGenesis.Params.ExpeditedThreshold)
 	require.Equal(t, tallyVetoThreshold, govGenesis.Params.VetoThreshold)
+	require.Equal(t, proposalCancelRate, govGenesis.Params.ProposalCancelRatio)
+	require.Empty(t, govGenesis.Params.ProposalCancelDest)
+	require.Equal(t, optimisticRejected, govGenesis.Params.OptimisticRejectedThreshold)
+	require.Empty(t, govGenesis.Params.OptimisticAuthorizedAddresses)
+	require.Empty(t, govGenesis.Params.OptimisticAllowedMsgUrls)
+	require.Equal(t, maxGovernorPower, govGenesis.Params.MaxGovernorVotingPowerRatio)
 	require.Equal(t, uint64(0x28), govGenesis.StartingProposalId)
 	require.Equal(t, []*v1.Deposit{}, govGenesis.Deposits)
 	require.Equal(t, []*v1.Vote{}, govGenesis.Votes)
@@ -2,23 +2,44 @@ package types
 
 // Governance module event types
 const (
-	EventTypeSubmitProposal   = "submit_proposal"
-	EventTypeProposalDeposit  = "proposal_deposit"
-	EventTypeProposalVote     = "proposal_vote"
-	EventTypeInactiveProposal = "inactive_proposal"
-	EventTypeActiveProposal   = "active_proposal"
-	EventTypeSignalProposal   = "signal_proposal"
+	EventTypeSubmitProposal       = "submit_proposal"
+	EventTypeProposalDeposit      = "proposal_deposit"
+	EventTypeProposalVote         = "proposal_vote"
+	EventTypeInactiveProposal     = "inactive_proposal"
+	EventTypeActiveProposal       = "active_proposal"
+	EventTypeSignalProposal       = "signal_proposal"
+	EventTypeCancelProposal       = "cancel_proposal"
+	EventTypeCreateGovernor       = "create_governor"
+	EventTypeEditGovernor         = "edit_governor"
+	EventTypeRemoveGovernor       = "remove_governor"
+	EventTypeDelegateGovernance   = "delegate_governance"
+	EventTypeUndelegateGovernance = "undelegate_governance"
+	EventTypeScheduleParamsUpdate = "schedule_params_update"
+	EventTypeCancelParamsUpdate   = "cancel_scheduled_params_update"
+	EventTypeExecuteParamsUpdate  = "execute_scheduled_params_update"
 
-	AttributeKeyProposalResult     = "proposal_result"
-	AttributeKeyOption             = "option"
-	AttributeKeyProposalID         = "proposal_id"
-	AttributeKeyProposalMessages   = "proposal_messages" // Msg type_urls in the proposal
-	AttributeKeyVotingPeriodStart  = "voting_period_start"
-	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
-	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
-	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
-	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
-	AttributeKeyProposalType       = "proposal_type"
-	AttributeSignalTitle           = "signal_title"
-	AttributeSignalDescription     = "signal_description"
+	AttributeKeyProposalResult               = "proposal_result"
+	AttributeKeyOption                       = "option"
+	AttributeKeyProposalID                   = "proposal_id"
+	AttributeKeyProposalMessages             = "proposal_messages" // Msg type_urls in the proposal
+	AttributeKeyVotingPeriodStart            = "voting_period_start"
+	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
+	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
+	AttributeValueProposalRejected           = "proposal_rejected"            // didn't meet vote quorum
+	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
+	AttributeValueExpeditedProposalRejected  = "expedited_proposal_rejected"  // didn't meet expedited vote threshold
+	AttributeValueOptimisticProposalPassed   = "optimistic_proposal_passed"   // not enough stake voted against
+	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // met optimistic rejected threshold
+	AttributeKeyProposalType                 = "proposal_type"
+	AttributeSignalTitle                     = "signal_title"
+	AttributeSignalDescription               = "signal_description"
+	AttributeKeyProposalProposer             = "proposal_proposer"
+	AttributeKeyGovernor                     = "governor"
+	AttributeKeyDelegator                    = "delegator"
+	AttributeKeyParamsUpdateID               = "params_update_id"
+	AttributeKeyParamsUpdateHeight           = "params_update_height"
+	AttributeKeyParamsUpdateMsg              = "params_update_msg" // Msg type_url of the params update
+	AttributeKeyParamsUpdateResult           = "params_update_result"
+	AttributeValueParamsUpdateExecuted       = "params_update_executed"
+	AttributeValueParamsUpdateFailed         = "params_update_failed" // error on params update handler
 )
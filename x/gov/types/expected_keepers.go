@@ -61,6 +61,7 @@ type GovHooks interface {
 	AfterProposalVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress)        // Must be called after a vote on a proposal is cast
 	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                      // Must be called when proposal fails to reach min deposit
 	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                     // Must be called when proposal's finishes it's voting period
+	AfterProposalCanceled(ctx sdk.Context, proposalID uint64)                              // Must be called after a proposal is canceled by its proposer
 }
 
 type GovHooksWrapper struct{ GovHooks }
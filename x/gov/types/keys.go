@@ -38,6 +38,20 @@ const (
 // - 0x20<proposalID_Bytes><voterAddrLen (1 Byte)><voterAddr_Bytes>: Voter
 //
 // - 0x30: Params
+//
+// - 0x31<msgURL_Bytes>: MessageBasedParams
+//
+// - 0x40<governorAddrLen (1 Byte)><governorAddr_Bytes>: Governor
+//
+// - 0x41<delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: GovernanceDelegation
+//
+// - 0x42<governorAddrLen (1 Byte)><governorAddr_Bytes><delegatorAddrLen (1 Byte)><delegatorAddr_Bytes>: []byte{}
+//
+// - 0x50<id_Bytes>: ScheduledParamsUpdate
+//
+// - 0x51<height_Bytes><id_Bytes>: scheduledParamsUpdateID
+//
+// - 0x52: nextScheduledParamsUpdateID
 var (
 	ProposalsKeyPrefix            = []byte{0x00}
 	ActiveProposalQueuePrefix     = []byte{0x01}
@@ -51,6 +65,16 @@ var (
 
 	// ParamsKey is the key to query all gov params
 	ParamsKey = []byte{0x30}
+
+	MessageBasedParamsKeyPrefix = []byte{0x31}
+
+	GovernorsKeyPrefix                       = []byte{0x40}
+	GovernanceDelegationsKeyPrefix           = []byte{0x41}
+	GovernanceDelegationsByGovernorKeyPrefix = []byte{0x42}
+
+	ScheduledParamsUpdatesKeyPrefix  = []byte{0x50}
+	ScheduledParamsUpdateQueuePrefix = []byte{0x51}
+	NextScheduledParamsUpdateIDKey   = []byte{0x52}
 )
 
 var lenTime = len(sdk.FormatTimeBytes(time.Now()))
@@ -72,6 +96,51 @@ func ProposalKey(proposalID uint64) []byte {
 	return append(ProposalsKeyPrefix, GetProposalIDBytes(proposalID)...)
 }
 
+// MessageBasedParamsKey gets the key of the params of a message type URL.
+func MessageBasedParamsKey(msgURL string) []byte {
+	return append(MessageBasedParamsKeyPrefix, []byte(msgURL)...)
+}
+
+// GovernorKey gets the key of a governor.
+func GovernorKey(governorAddr sdk.AccAddress) []byte {
+	return append(GovernorsKeyPrefix, address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernanceDelegationKey gets the key of the governance delegation of a
+// delegator.
+func GovernanceDelegationKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsKeyPrefix, address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// GovernanceDelegationsByGovernorKey gets the first part of the index key of
+// the governance delegations to a governor.
+func GovernanceDelegationsByGovernorKey(governorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsByGovernorKeyPrefix, address.MustLengthPrefix(governorAddr.Bytes())...)
+}
+
+// GovernanceDelegationByGovernorKey gets the index key of the governance
+// delegation of a delegator to a governor.
+func GovernanceDelegationByGovernorKey(governorAddr, delegatorAddr sdk.AccAddress) []byte {
+	return append(GovernanceDelegationsByGovernorKey(governorAddr), address.MustLengthPrefix(delegatorAddr.Bytes())...)
+}
+
+// ScheduledParamsUpdateKey gets the key of a scheduled params update.
+func ScheduledParamsUpdateKey(id uint64) []byte {
+	return append(ScheduledParamsUpdatesKeyPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// ScheduledParamsUpdateByHeightKey gets the scheduled params update queue key
+// by height.
+func ScheduledParamsUpdateByHeightKey(height int64) []byte {
+	return append(ScheduledParamsUpdateQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ScheduledParamsUpdateQueueKey returns the key of a scheduled params update in
+// the scheduled params update queue.
+func ScheduledParamsUpdateQueueKey(id uint64, height int64) []byte {
+	return append(ScheduledParamsUpdateByHeightKey(height), sdk.Uint64ToBigEndian(id)...)
+}
+
 // VotingPeriodProposalKey gets if a proposal is in voting period.
 func VotingPeriodProposalKey(proposalID uint64) []byte {
 	return append(VotingPeriodProposalKeyPrefix, GetProposalIDBytes(proposalID)...)
@@ -20,6 +20,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelProposal{}, "cosmos-sdk/v1/MsgCancelProposal")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMessageParams{}, "cosmos-sdk/v1/MsgUpdateMessageParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateGovernor{}, "cosmos-sdk/v1/MsgCreateGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgEditGovernor{}, "cosmos-sdk/v1/MsgEditGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveGovernor{}, "cosmos-sdk/v1/MsgRemoveGovernor")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateGovernance{}, "cosmos-sdk/v1/MsgDelegateGovernance")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateGovernance{}, "cosmos-sdk/v1/MsgUndelegateGovernance")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleParamsUpdate{}, "cosmos-sdk/v1/MsgScheduleParamsUpdate")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelScheduledParamsUpdate{}, "cosmos-sdk/v1/MsgCancelParamsUpdate")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -31,6 +40,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
 		&MsgUpdateParams{},
+		&MsgCancelProposal{},
+		&MsgUpdateMessageParams{},
+		&MsgCreateGovernor{},
+		&MsgEditGovernor{},
+		&MsgRemoveGovernor{},
+		&MsgDelegateGovernance{},
+		&MsgUndelegateGovernance{},
+		&MsgScheduleParamsUpdate{},
+		&MsgCancelScheduledParamsUpdate{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -2,8 +2,10 @@ package v1
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new genesis state for the governance module
@@ -33,7 +35,73 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
-	return data.Params.ValidateBasic()
+	if err := data.Params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgURLs := make(map[string]bool, len(data.MessageBasedParams))
+	for _, entry := range data.MessageBasedParams {
+		if len(entry.MsgUrl) == 0 {
+			return errors.New("message based params msg url cannot be empty")
+		}
+		if msgURLs[entry.MsgUrl] {
+			return fmt.Errorf("duplicate message based params for %s", entry.MsgUrl)
+		}
+		msgURLs[entry.MsgUrl] = true
+
+		if err := entry.Params.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid message based params for %s: %w", entry.MsgUrl, err)
+		}
+		if err := entry.Params.ValidateExpeditedVotingPeriod(*data.Params.ExpeditedVotingPeriod); err != nil {
+			return fmt.Errorf("invalid message based params for %s: %w", entry.MsgUrl, err)
+		}
+	}
+
+	governors := make(map[string]bool, len(data.Governors))
+	for _, governor := range data.Governors {
+		if _, err := sdk.AccAddressFromBech32(governor.GovernorAddress); err != nil {
+			return fmt.Errorf("invalid governor address %s: %w", governor.GovernorAddress, err)
+		}
+		if governors[governor.GovernorAddress] {
+			return fmt.Errorf("duplicate governor %s", governor.GovernorAddress)
+		}
+		governors[governor.GovernorAddress] = true
+	}
+
+	delegators := make(map[string]bool, len(data.GovernanceDelegations))
+	for _, delegation := range data.GovernanceDelegations {
+		if _, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid delegator address %s: %w", delegation.DelegatorAddress, err)
+		}
+		if delegators[delegation.DelegatorAddress] {
+			return fmt.Errorf("duplicate governance delegation for %s", delegation.DelegatorAddress)
+		}
+		delegators[delegation.DelegatorAddress] = true
+
+		if !governors[delegation.GovernorAddress] {
+			return fmt.Errorf("governance delegation of %s to unknown governor %s", delegation.DelegatorAddress, delegation.GovernorAddress)
+		}
+		if governors[delegation.DelegatorAddress] {
+			return fmt.Errorf("governor %s cannot delegate governance", delegation.DelegatorAddress)
+		}
+	}
+
+	updateIDs := make(map[uint64]bool, len(data.ScheduledParamsUpdates))
+	for _, update := range data.ScheduledParamsUpdates {
+		if update.Id == 0 {
+			return errors.New("scheduled params update id must be greater than 0")
+		}
+		if updateIDs[update.Id] {
+			return fmt.Errorf("duplicate scheduled params update %d", update.Id)
+		}
+		updateIDs[update.Id] = true
+
+		if err := update.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid scheduled params update %d: %w", update.Id, err)
+		}
+	}
+
+	return nil
 }
 
 var _ types.UnpackInterfacesMessage = GenesisState{}
@@ -46,5 +114,10 @@ func (data GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 			return err
 		}
 	}
+	for _, update := range data.ScheduledParamsUpdates {
+		if err := update.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ package v1
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -45,6 +46,17 @@ type GenesisState struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Params *Params `protobuf:"bytes,8,opt,name=params,proto3" json:"params,omitempty"`
+	// message_based_params defines the params of the message types that don't use
+	// the default tally params and voting period.
+	MessageBasedParams []MessageBasedParamsEntry `protobuf:"bytes,9,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params"`
+	// governors defines all the governors present at genesis.
+	Governors []Governor `protobuf:"bytes,10,rep,name=governors,proto3" json:"governors"`
+	// governance_delegations defines all the governance delegations present at
+	// genesis.
+	GovernanceDelegations []GovernanceDelegation `protobuf:"bytes,11,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations"`
+	// scheduled_params_updates defines all the params updates scheduled at
+	// genesis.
+	ScheduledParamsUpdates []ScheduledParamsUpdate `protobuf:"bytes,12,rep,name=scheduled_params_updates,json=scheduledParamsUpdates,proto3" json:"scheduled_params_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -139,6 +151,34 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetMessageBasedParams() []MessageBasedParamsEntry {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernors() []Governor {
+	if m != nil {
+		return m.Governors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernanceDelegations() []GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledParamsUpdates() []ScheduledParamsUpdate {
+	if m != nil {
+		return m.ScheduledParamsUpdates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -146,30 +186,39 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x4e, 0xfa, 0x40,
-	0x14, 0xc5, 0x19, 0xbe, 0xfe, 0xfc, 0x07, 0x70, 0x31, 0x7e, 0xd0, 0x80, 0x69, 0x88, 0x2b, 0x8c,
-	0xa1, 0x15, 0x8c, 0x0f, 0x20, 0xc1, 0x10, 0x77, 0xa4, 0x1a, 0x17, 0x6e, 0x48, 0xa1, 0x93, 0xda,
-	0x08, 0xdc, 0xa6, 0x77, 0x9c, 0xc8, 0x5b, 0xf8, 0x58, 0x2e, 0xd9, 0xe9, 0xd2, 0xc0, 0x8b, 0x18,
-	0x66, 0x5a, 0xc1, 0xea, 0x6a, 0x92, 0x7b, 0x7e, 0xe7, 0xcc, 0xc9, 0xcd, 0xa5, 0x8d, 0x09, 0xe0,
-	0x0c, 0xd0, 0xf6, 0x41, 0xda, 0xb2, 0x63, 0xfb, 0x7c, 0xce, 0x31, 0x40, 0x2b, 0x8c, 0x40, 0x00,
-	0xab, 0x6a, 0xd1, 0xf2, 0x41, 0x5a, 0xb2, 0x53, 0xaf, 0xa5, 0x58, 0x90, 0x9a, 0x3b, 0x79, 0xcf,
-	0xd1, 0xca, 0x40, 0x3b, 0x6f, 0x85, 0x2b, 0x38, 0x3b, 0xa7, 0x07, 0x28, 0xdc, 0x48, 0x04, 0x73,
-	0x7f, 0x14, 0x46, 0x10, 0x02, 0xba, 0xd3, 0x51, 0xe0, 0x19, 0xa4, 0x49, 0x5a, 0x79, 0x87, 0x25,
-	0xda, 0x30, 0x96, 0x6e, 0x3c, 0xd6, 0xa5, 0x25, 0x8f, 0x87, 0x80, 0x81, 0x40, 0x23, 0xdb, 0xcc,
-	0xb5, 0xca, 0xdd, 0x23, 0xeb, 0xc7, 0xef, 0x56, 0x5f, 0xcb, 0xce, 0x37, 0xc7, 0x4e, 0x69, 0x41,
-	0x82, 0xe0, 0x68, 0xe4, 0x94, 0x61, 0x3f, 0x65, 0xb8, 0x07, 0xc1, 0x1d, 0x4d, 0xb0, 0x4b, 0xfa,
-	0x3f, 0xe9, 0x81, 0x46, 0x5e, 0xe1, 0xb5, 0x14, 0x9e, 0x94, 0x71, 0xb6, 0x24, 0x1b, 0xd0, 0xbd,
-	0xf8, 0xb7, 0x51, 0xe8, 0x46, 0xee, 0x0c, 0x8d, 0x42, 0x93, 0xb4, 0xca, 0xdd, 0xe3, 0xbf, 0xbb,
-	0x0d, 0x15, 0xd3, 0xcb, 0x1a, 0xc4, 0xa9, 0x7a, 0xbb, 0x23, 0xd6, 0xa7, 0x55, 0x09, 0x7a, 0x1d,
-	0x3a, 0xa7, 0xa8, 0x72, 0x1a, 0xbf, 0x2b, 0x6f, 0xd6, 0xb2, 0x8d, 0xa9, 0xc8, 0x9d, 0x09, 0xbb,
-	0xa2, 0x15, 0xe1, 0x4e, 0xa7, 0x8b, 0x24, 0xe4, 0x9f, 0x0a, 0xa9, 0xa7, 0x42, 0xee, 0x36, 0xc8,
-	0x4e, 0x46, 0x59, 0x6c, 0x07, 0xac, 0x4d, 0x8b, 0xb1, 0xb9, 0xa4, 0xcc, 0x87, 0xe9, 0x2d, 0x28,
-	0xd1, 0x89, 0xa1, 0xde, 0xf5, 0xdb, 0xca, 0x24, 0xcb, 0x95, 0x49, 0x3e, 0x57, 0x26, 0x79, 0x5d,
-	0x9b, 0x99, 0xe5, 0xda, 0xcc, 0x7c, 0xac, 0xcd, 0xcc, 0xc3, 0x99, 0x1f, 0x88, 0xc7, 0xe7, 0xb1,
-	0x35, 0x81, 0x99, 0x1d, 0xdf, 0x85, 0x7e, 0xda, 0xe8, 0x3d, 0xd9, 0x2f, 0xea, 0x48, 0xc4, 0x22,
-	0xe4, 0x68, 0xcb, 0xce, 0xb8, 0xa8, 0xee, 0xe4, 0xe2, 0x2b, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xb2,
-	0x17, 0xb2, 0x6e, 0x02, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x36, 0x0d, 0xcd, 0x26, 0xe1, 0xb0, 0xa4, 0xa9, 0x95, 0x22, 0x13, 0x01, 0x42,
+	0x41, 0xa8, 0x36, 0x09, 0xe2, 0xc4, 0x89, 0x28, 0x55, 0xc4, 0x01, 0xa9, 0x72, 0x81, 0x03, 0x07,
+	0xcc, 0x26, 0xbb, 0xda, 0x5a, 0x24, 0x5e, 0xcb, 0xb3, 0x59, 0x91, 0xb7, 0xe0, 0x81, 0x78, 0x80,
+	0x1e, 0x7b, 0xe4, 0x84, 0x50, 0xf2, 0x22, 0xc8, 0xbb, 0x76, 0x92, 0xba, 0x3e, 0x79, 0x3d, 0xff,
+	0x37, 0xff, 0xcc, 0x8e, 0x3d, 0xe8, 0x6c, 0x26, 0x60, 0x21, 0xc0, 0xe3, 0x42, 0x79, 0x6a, 0xe0,
+	0x71, 0x16, 0x31, 0x08, 0xc1, 0x8d, 0x13, 0x21, 0x05, 0x6e, 0x19, 0xd1, 0xe5, 0x42, 0xb9, 0x6a,
+	0xd0, 0x3d, 0x2d, 0xb0, 0x42, 0x19, 0xae, 0xdb, 0xe6, 0x82, 0x0b, 0x7d, 0xf4, 0xd2, 0x93, 0x89,
+	0x3e, 0xfd, 0x5d, 0x43, 0xcd, 0x89, 0xf1, 0xbb, 0x92, 0x44, 0x32, 0xfc, 0x1a, 0xb5, 0x41, 0x92,
+	0x44, 0x86, 0x11, 0x0f, 0xe2, 0x44, 0xc4, 0x02, 0xc8, 0x3c, 0x08, 0xa9, 0x6d, 0xf5, 0xac, 0x7e,
+	0xd5, 0xc7, 0xb9, 0x76, 0x99, 0x49, 0x1f, 0x28, 0x1e, 0xa2, 0x63, 0xca, 0x62, 0x01, 0xa1, 0x04,
+	0xfb, 0xa0, 0x77, 0xd8, 0x6f, 0x0c, 0x3b, 0xee, 0x9d, 0x9e, 0xdc, 0xb1, 0x91, 0xfd, 0x2d, 0x87,
+	0x5f, 0xa2, 0x23, 0x25, 0x24, 0x03, 0xfb, 0x50, 0x27, 0x3c, 0x2a, 0x24, 0x7c, 0x11, 0x92, 0xf9,
+	0x86, 0xc0, 0x6f, 0x51, 0x3d, 0xef, 0x03, 0xec, 0xaa, 0xc6, 0x4f, 0x0b, 0x78, 0xde, 0x8c, 0xbf,
+	0x23, 0xf1, 0x04, 0x3d, 0xcc, 0xaa, 0x05, 0x31, 0x49, 0xc8, 0x02, 0xec, 0xa3, 0x9e, 0xd5, 0x6f,
+	0x0c, 0x1f, 0x97, 0xf7, 0x76, 0xa9, 0x99, 0xd1, 0x81, 0x6d, 0xf9, 0x2d, 0xba, 0x1f, 0xc2, 0x63,
+	0xd4, 0x52, 0xc2, 0x8c, 0xc3, 0xf8, 0xd4, 0xb4, 0xcf, 0xd9, 0xfd, 0x96, 0xd3, 0xb1, 0xec, 0x6c,
+	0x9a, 0x6a, 0x2f, 0x82, 0xdf, 0xa3, 0xa6, 0x24, 0xf3, 0xf9, 0x2a, 0x37, 0x79, 0xa0, 0x4d, 0xba,
+	0x05, 0x93, 0x4f, 0x29, 0xb2, 0xe7, 0xd1, 0x90, 0xbb, 0x00, 0x3e, 0x47, 0xb5, 0x2c, 0xf9, 0x58,
+	0x27, 0x9f, 0x14, 0xa7, 0xa0, 0x45, 0x3f, 0x83, 0xf0, 0x37, 0xd4, 0x5e, 0x30, 0x00, 0xc2, 0x59,
+	0x30, 0x25, 0xc0, 0x68, 0x5e, 0xb9, 0xae, 0x47, 0xf8, 0xa2, 0x90, 0xfc, 0xd1, 0xa0, 0xa3, 0x94,
+	0x34, 0x46, 0x17, 0x91, 0x4c, 0x56, 0xa3, 0xea, 0xcd, 0xdf, 0x27, 0x15, 0x1f, 0x2f, 0xee, 0xc9,
+	0xf8, 0x1d, 0xaa, 0x73, 0xa1, 0x58, 0x12, 0x89, 0x04, 0x6c, 0x54, 0xfa, 0x5d, 0x26, 0x99, 0x9e,
+	0xb9, 0xec, 0x78, 0xfc, 0x1d, 0x75, 0xcc, 0x0b, 0x89, 0x66, 0x2c, 0xa0, 0x6c, 0xce, 0x38, 0x91,
+	0xa1, 0x88, 0xc0, 0x6e, 0x68, 0xa7, 0x67, 0xa5, 0x4e, 0x29, 0x3c, 0xde, 0xb2, 0x99, 0xeb, 0x09,
+	0x2f, 0xd1, 0x00, 0x53, 0x64, 0xc3, 0xec, 0x9a, 0xd1, 0xe5, 0x7c, 0x7b, 0xf5, 0x60, 0x19, 0x53,
+	0x92, 0xfe, 0x74, 0x4d, 0x5d, 0xe3, 0x79, 0xa1, 0xc6, 0x55, 0x8e, 0x9b, 0x0b, 0x7e, 0xd6, 0x70,
+	0x56, 0xa4, 0x03, 0x65, 0x22, 0x8c, 0x2e, 0x6e, 0xd6, 0x8e, 0x75, 0xbb, 0x76, 0xac, 0x7f, 0x6b,
+	0xc7, 0xfa, 0xb5, 0x71, 0x2a, 0xb7, 0x1b, 0xa7, 0xf2, 0x67, 0xe3, 0x54, 0xbe, 0xbe, 0xe2, 0xa1,
+	0xbc, 0x5e, 0x4e, 0xdd, 0x99, 0x58, 0x78, 0xd9, 0x4a, 0x9a, 0xc7, 0x39, 0xd0, 0x1f, 0xde, 0x4f,
+	0xbd, 0x9f, 0x72, 0x15, 0x33, 0xf0, 0xd4, 0x60, 0x5a, 0xd3, 0xcb, 0xf8, 0xe6, 0xff, 0x00, 0xbb,
+	0xaf, 0x4c, 0xcb, 0xe9, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -192,6 +241,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ScheduledParamsUpdates) > 0 {
+		for iNdEx := len(m.ScheduledParamsUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledParamsUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Governors) > 0 {
+		for iNdEx := len(m.Governors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Governors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -344,6 +449,30 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Governors) > 0 {
+		for _, e := range m.Governors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernanceDelegations) > 0 {
+		for _, e := range m.GovernanceDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledParamsUpdates) > 0 {
+		for _, e := range m.ScheduledParamsUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -647,6 +776,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, MessageBasedParamsEntry{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Governors = append(m.Governors, Governor{})
+			if err := m.Governors[len(m.Governors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceDelegations = append(m.GovernanceDelegations, GovernanceDelegation{})
+			if err := m.GovernanceDelegations[len(m.GovernanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledParamsUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledParamsUpdates = append(m.ScheduledParamsUpdates, ScheduledParamsUpdate{})
+			if err := m.ScheduledParamsUpdates[len(m.ScheduledParamsUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
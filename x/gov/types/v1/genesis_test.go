@@ -18,6 +18,8 @@ func TestEmptyGenesis(t *testing.T) {
 
 func TestValidateGenesis(t *testing.T) {
 	params := v1.DefaultParams()
+	governor := sdk.AccAddress("governor").String()
+	delegator := sdk.AccAddress("delegator").String()
 
 	testCases := []struct {
 		name         string
@@ -90,6 +92,246 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "expedited min deposit not greater than min deposit",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedMinDeposit = params1.MinDeposit
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited threshold not greater than threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedThreshold = params1.Threshold
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited voting period not shorter than voting period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedVotingPeriod = params1.VotingPeriod
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid proposal cancel ratio",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ProposalCancelRatio = "1.1"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid proposal cancel destination",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ProposalCancelDest = "invalid"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid optimistic rejected threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.OptimisticRejectedThreshold = "0"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid optimistic authorized address",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.OptimisticAuthorizedAddresses = []string{"invalid"}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "empty optimistic allowed msg url",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.OptimisticAllowedMsgUrls = []string{""}
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "valid message based params",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MessageBasedParams = []v1.MessageBasedParamsEntry{
+					{MsgUrl: "/cosmos.bank.v1beta1.MsgSend", Params: params.ToMessageBasedParams()},
+				}
+
+				return state
+			},
+		},
+		{
+			name: "message based params with empty msg url",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MessageBasedParams = []v1.MessageBasedParamsEntry{
+					{MsgUrl: "", Params: params.ToMessageBasedParams()},
+				}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate message based params",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MessageBasedParams = []v1.MessageBasedParamsEntry{
+					{MsgUrl: "/cosmos.bank.v1beta1.MsgSend", Params: params.ToMessageBasedParams()},
+					{MsgUrl: "/cosmos.bank.v1beta1.MsgSend", Params: params.ToMessageBasedParams()},
+				}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid message based params",
+			genesisState: func() *v1.GenesisState {
+				msgParams := params.ToMessageBasedParams()
+				msgParams.Quorum = "2"
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MessageBasedParams = []v1.MessageBasedParamsEntry{
+					{MsgUrl: "/cosmos.bank.v1beta1.MsgSend", Params: msgParams},
+				}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "message based voting period not longer than the expedited voting period",
+			genesisState: func() *v1.GenesisState {
+				msgParams := params.ToMessageBasedParams()
+				msgParams.VotingPeriod = params.ExpeditedVotingPeriod
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MessageBasedParams = []v1.MessageBasedParamsEntry{
+					{MsgUrl: "/cosmos.bank.v1beta1.MsgSend", Params: msgParams},
+				}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "valid governance delegations",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []v1.Governor{{GovernorAddress: governor}}
+				state.GovernanceDelegations = []v1.GovernanceDelegation{{DelegatorAddress: delegator, GovernorAddress: governor}}
+
+				return state
+			},
+		},
+		{
+			name: "duplicate governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []v1.Governor{{GovernorAddress: governor}, {GovernorAddress: governor}}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "governance delegation to unknown governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernanceDelegations = []v1.GovernanceDelegation{{DelegatorAddress: delegator, GovernorAddress: governor}}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "governance delegation of a governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []v1.Governor{{GovernorAddress: governor}, {GovernorAddress: delegator}}
+				state.GovernanceDelegations = []v1.GovernanceDelegation{{DelegatorAddress: delegator, GovernorAddress: governor}}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid max governor voting power ratio",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MaxGovernorVotingPowerRatio = "1.1"
+
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "valid scheduled params updates",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ScheduledParamsUpdates = []v1.ScheduledParamsUpdate{newScheduledParamsUpdate(t, 1, 10, params)}
+
+				return state
+			},
+		},
+		{
+			name: "duplicate scheduled params update",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ScheduledParamsUpdates = []v1.ScheduledParamsUpdate{
+					newScheduledParamsUpdate(t, 1, 10, params),
+					newScheduledParamsUpdate(t, 1, 20, params),
+				}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "scheduled params update with invalid height",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ScheduledParamsUpdates = []v1.ScheduledParamsUpdate{newScheduledParamsUpdate(t, 1, 0, params)}
+
+				return state
+			},
+			expErr: true,
+		},
+		{
+			name: "scheduled params update with invalid params",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.Threshold = "1.1"
+
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.ScheduledParamsUpdates = []v1.ScheduledParamsUpdate{newScheduledParamsUpdate(t, 1, 10, params1)}
+
+				return state
+			},
+			expErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -104,3 +346,13 @@ func TestValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func newScheduledParamsUpdate(t *testing.T, id uint64, height int64, params v1.Params) v1.ScheduledParamsUpdate {
+	update, err := v1.NewScheduledParamsUpdate(id, height, &v1.MsgUpdateParams{
+		Authority: sdk.AccAddress("authority").String(),
+		Params:    params,
+	})
+	require.NoError(t, err)
+
+	return update
+}
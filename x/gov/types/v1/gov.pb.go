@@ -277,6 +277,11 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// expedited defines if the proposal is expedited
+	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// optimistic defines if the proposal is optimistic, i.e. passes at the end of
+	// its voting period unless enough stake votes against it
+	Optimistic bool `protobuf:"varint,15,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -403,6 +408,20 @@ func (m *Proposal) GetProposer() string {
 	return ""
 }
 
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
+func (m *Proposal) GetOptimistic() bool {
+	if m != nil {
+		return m.Optimistic
+	}
+	return false
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -729,15 +748,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -745,6 +764,30 @@ type Params struct {
 	BurnProposalDepositPrevote bool `protobuf:"varint,14,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
 	// burn deposits if quorum with vote type no_veto is met
 	BurnVoteVeto bool `protobuf:"varint,15,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	// Duration of the voting period of an expedited proposal.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,16,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
+	// Minimum proportion of Yes votes for an expedited proposal to pass. Must be
+	// greater than threshold. Default value: 0.667.
+	ExpeditedThreshold string `protobuf:"bytes,17,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	// Minimum expedited deposit for a proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,18,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
+	// The ratio of the deposits of a proposal that is burned or sent to
+	// proposal_cancel_dest when the proposal is canceled. The rest of the deposits
+	// is refunded.
+	ProposalCancelRatio string `protobuf:"bytes,19,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	// The address which receives the cancellation charges of canceled proposals.
+	// If empty, the charges are burned.
+	ProposalCancelDest string `protobuf:"bytes,20,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
+	// The addresses allowed to submit optimistic proposals.
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,21,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
+	// The type URLs of the messages allowed in optimistic proposals.
+	OptimisticAllowedMsgUrls []string `protobuf:"bytes,22,rep,name=optimistic_allowed_msg_urls,json=optimisticAllowedMsgUrls,proto3" json:"optimistic_allowed_msg_urls,omitempty"`
+	// Minimum proportion of No and NoWithVeto votes, over the total bonded stake,
+	// for an optimistic proposal to be rejected. Default value: 0.1.
+	OptimisticRejectedThreshold string `protobuf:"bytes,23,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
+	// Maximum proportion of the total bonded stake that a governor can vote with
+	// on behalf of its delegators. Default value: 0.1.
+	MaxGovernorVotingPowerRatio string `protobuf:"bytes,24,opt,name=max_governor_voting_power_ratio,json=maxGovernorVotingPowerRatio,proto3" json:"max_governor_voting_power_ratio,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -850,6 +893,378 @@ func (m *Params) GetBurnVoteVeto() bool {
 	return false
 }
 
+func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
+func (m *Params) GetProposalCancelDest() string {
+	if m != nil {
+		return m.ProposalCancelDest
+	}
+	return ""
+}
+
+func (m *Params) GetOptimisticAuthorizedAddresses() []string {
+	if m != nil {
+		return m.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticAllowedMsgUrls() []string {
+	if m != nil {
+		return m.OptimisticAllowedMsgUrls
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticRejectedThreshold() string {
+	if m != nil {
+		return m.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetMaxGovernorVotingPowerRatio() string {
+	if m != nil {
+		return m.MaxGovernorVotingPowerRatio
+	}
+	return ""
+}
+
+// MessageBasedParams defines the voting period and tally params that apply to
+// proposals containing a given message type, instead of the ones of Params.
+type MessageBasedParams struct {
+	// Duration of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	// Minimum percentage of total stake needed to vote for a result to be
+	// considered valid.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Minimum value of Veto votes to Total votes ratio for proposal to be
+	// vetoed.
+	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+}
+
+func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
+func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParams) ProtoMessage()    {}
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
+}
+func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+}
+func (m *MessageBasedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+
+func (m *MessageBasedParams) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *MessageBasedParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+// MessageBasedParamsEntry binds MessageBasedParams to a message type URL.
+type MessageBasedParamsEntry struct {
+	// msg_url is the type URL of the message, e.g. /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade.
+	MsgUrl string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty"`
+	// params are the params that apply to proposals containing the message.
+	Params MessageBasedParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MessageBasedParamsEntry) Reset()         { *m = MessageBasedParamsEntry{} }
+func (m *MessageBasedParamsEntry) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParamsEntry) ProtoMessage()    {}
+func (*MessageBasedParamsEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *MessageBasedParamsEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParamsEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParamsEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParamsEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParamsEntry.Merge(m, src)
+}
+func (m *MessageBasedParamsEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParamsEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParamsEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParamsEntry proto.InternalMessageInfo
+
+func (m *MessageBasedParamsEntry) GetMsgUrl() string {
+	if m != nil {
+		return m.MsgUrl
+	}
+	return ""
+}
+
+func (m *MessageBasedParamsEntry) GetParams() MessageBasedParams {
+	if m != nil {
+		return m.Params
+	}
+	return MessageBasedParams{}
+}
+
+// Governor defines an account that delegators can delegate their governance
+// voting power to, independently of their staking delegations.
+type Governor struct {
+	// governor_address is the account address of the governor.
+	GovernorAddress string `protobuf:"bytes,1,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+	// metadata is any arbitrary metadata describing the governor.
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+func (m *Governor) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// GovernanceDelegation defines the delegation of the governance voting power of
+// a delegator to a governor.
+type GovernanceDelegation struct {
+	// delegator_address is the account address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the account address of the governor.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernanceDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+// ScheduledParamsUpdate defines a params update message scheduled by governance
+// to be executed at a future block height.
+type ScheduledParamsUpdate struct {
+	// id is the unique id of the scheduled params update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// height is the block height at the beginning of which the message is
+	// executed.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// msg is the MsgUpdateParams message of a module executed at height.
+	Msg *types1.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *ScheduledParamsUpdate) Reset()         { *m = ScheduledParamsUpdate{} }
+func (m *ScheduledParamsUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsUpdate) ProtoMessage()    {}
+func (*ScheduledParamsUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
+}
+func (m *ScheduledParamsUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamsUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParamsUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsUpdate.Merge(m, src)
+}
+func (m *ScheduledParamsUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParamsUpdate proto.InternalMessageInfo
+
+func (m *ScheduledParamsUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledParamsUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduledParamsUpdate) GetMsg() *types1.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -862,92 +1277,123 @@ func init() {
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*MessageBasedParamsEntry)(nil), "cosmos.gov.v1.MessageBasedParamsEntry")
+	proto.RegisterType((*Governor)(nil), "cosmos.gov.v1.Governor")
+	proto.RegisterType((*GovernanceDelegation)(nil), "cosmos.gov.v1.GovernanceDelegation")
+	proto.RegisterType((*ScheduledParamsUpdate)(nil), "cosmos.gov.v1.ScheduledParamsUpdate")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1276 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x73, 0xd3, 0xc6,
-	0x17, 0x8f, 0x6c, 0xd9, 0x71, 0x9e, 0x63, 0x47, 0x2c, 0xf9, 0x82, 0x12, 0x88, 0x1d, 0x3c, 0x0c,
-	0x93, 0x2f, 0x3f, 0xec, 0x6f, 0xe0, 0x4b, 0x2f, 0xf4, 0xe2, 0xc4, 0xa2, 0x88, 0xa1, 0xb1, 0x2b,
-	0x8b, 0x30, 0xf4, 0xa2, 0x91, 0xa3, 0xc5, 0xd9, 0xa9, 0xa5, 0x75, 0xa5, 0xb5, 0xc1, 0x7f, 0x42,
-	0x6f, 0x1c, 0x3b, 0x3d, 0xf5, 0xd8, 0x63, 0x0f, 0x4c, 0xef, 0xbd, 0x71, 0x6a, 0x19, 0x2e, 0x6d,
-	0x2f, 0xb4, 0x03, 0x87, 0xce, 0xf0, 0x57, 0x74, 0x76, 0xb5, 0xb2, 0x1d, 0xc7, 0x9d, 0x04, 0x2e,
-	0xb1, 0xf4, 0xde, 0xe7, 0xf3, 0xde, 0xdb, 0xf7, 0x6b, 0x15, 0x38, 0x7f, 0x40, 0x23, 0x9f, 0x46,
-	0xb5, 0x2e, 0x1d, 0xd6, 0x86, 0xdb, 0xfc, 0xa7, 0xda, 0x0f, 0x29, 0xa3, 0xa8, 0x10, 0x2b, 0xaa,
-	0x5c, 0x32, 0xdc, 0x5e, 0x2f, 0x49, 0x5c, 0xc7, 0x8d, 0x70, 0x6d, 0xb8, 0xdd, 0xc1, 0xcc, 0xdd,
-	0xae, 0x1d, 0x50, 0x12, 0xc4, 0xf0, 0xf5, 0xd5, 0x2e, 0xed, 0x52, 0xf1, 0x58, 0xe3, 0x4f, 0x52,
-	0x5a, 0xee, 0x52, 0xda, 0xed, 0xe1, 0x9a, 0x78, 0xeb, 0x0c, 0x9e, 0xd4, 0x18, 0xf1, 0x71, 0xc4,
-	0x5c, 0xbf, 0x2f, 0x01, 0x6b, 0xb3, 0x00, 0x37, 0x18, 0x49, 0x55, 0x69, 0x56, 0xe5, 0x0d, 0x42,
-	0x97, 0x11, 0x9a, 0x78, 0x5c, 0x8b, 0x23, 0x72, 0x62, 0xa7, 0x32, 0xda, 0x58, 0x75, 0xc6, 0xf5,
-	0x49, 0x40, 0x6b, 0xe2, 0x6f, 0x2c, 0xaa, 0x50, 0x40, 0x8f, 0x30, 0xe9, 0x1e, 0x32, 0xec, 0xed,
-	0x53, 0x86, 0x9b, 0x7d, 0x6e, 0x09, 0x6d, 0x43, 0x96, 0x8a, 0x27, 0x5d, 0xd9, 0x54, 0xb6, 0x8a,
-	0x37, 0xd7, 0xaa, 0x47, 0x4e, 0x5d, 0x9d, 0x40, 0x2d, 0x09, 0x44, 0x57, 0x20, 0xfb, 0x54, 0x18,
-	0xd2, 0x53, 0x9b, 0xca, 0xd6, 0xd2, 0x4e, 0xf1, 0xf5, 0x8b, 0x1b, 0x20, 0x59, 0x0d, 0x7c, 0x60,
-	0x49, 0x6d, 0xe5, 0x7b, 0x05, 0x16, 0x1b, 0xb8, 0x4f, 0x23, 0xc2, 0x50, 0x19, 0xf2, 0xfd, 0x90,
-	0xf6, 0x69, 0xe4, 0xf6, 0x1c, 0xe2, 0x09, 0x5f, 0xaa, 0x05, 0x89, 0xc8, 0xf4, 0xd0, 0x27, 0xb0,
-	0xe4, 0xc5, 0x58, 0x1a, 0x4a, 0xbb, 0xfa, 0xeb, 0x17, 0x37, 0x56, 0xa5, 0xdd, 0xba, 0xe7, 0x85,
-	0x38, 0x8a, 0xda, 0x2c, 0x24, 0x41, 0xd7, 0x9a, 0x40, 0xd1, 0xa7, 0x90, 0x75, 0x7d, 0x3a, 0x08,
-	0x98, 0x9e, 0xde, 0x4c, 0x6f, 0xe5, 0x27, 0xf1, 0xf3, 0x32, 0x55, 0x65, 0x99, 0xaa, 0xbb, 0x94,
-	0x04, 0x3b, 0x4b, 0x2f, 0xdf, 0x94, 0x17, 0x7e, 0xf8, 0xfb, 0xc7, 0xab, 0x8a, 0x25, 0x39, 0x95,
-	0x9f, 0x33, 0x90, 0x6b, 0xc9, 0x20, 0x50, 0x11, 0x52, 0xe3, 0xd0, 0x52, 0xc4, 0x43, 0xff, 0x83,
-	0x9c, 0x8f, 0xa3, 0xc8, 0xed, 0xe2, 0x48, 0x4f, 0x09, 0xe3, 0xab, 0xd5, 0xb8, 0x22, 0xd5, 0xa4,
-	0x22, 0xd5, 0x7a, 0x30, 0xb2, 0xc6, 0x28, 0x74, 0x1b, 0xb2, 0x11, 0x73, 0xd9, 0x20, 0xd2, 0xd3,
-	0x22, 0x99, 0x1b, 0x33, 0xc9, 0x4c, 0x5c, 0xb5, 0x05, 0xc8, 0x92, 0x60, 0x74, 0x0f, 0xd0, 0x13,
-	0x12, 0xb8, 0x3d, 0x87, 0xb9, 0xbd, 0xde, 0xc8, 0x09, 0x71, 0x34, 0xe8, 0x31, 0x5d, 0xdd, 0x54,
-	0xb6, 0xf2, 0x37, 0xd7, 0x67, 0x4c, 0xd8, 0x1c, 0x62, 0x09, 0x84, 0xa5, 0x09, 0xd6, 0x94, 0x04,
-	0xd5, 0x21, 0x1f, 0x0d, 0x3a, 0x3e, 0x61, 0x0e, 0x6f, 0x33, 0x3d, 0x23, 0x4d, 0xcc, 0x46, 0x6d,
-	0x27, 0x3d, 0xb8, 0xa3, 0x3e, 0xff, 0xb3, 0xac, 0x58, 0x10, 0x93, 0xb8, 0x18, 0xdd, 0x07, 0x4d,
-	0x66, 0xd7, 0xc1, 0x81, 0x17, 0xdb, 0xc9, 0x9e, 0xd2, 0x4e, 0x51, 0x32, 0x8d, 0xc0, 0x13, 0xb6,
-	0x4c, 0x28, 0x30, 0xca, 0xdc, 0x9e, 0x23, 0xe5, 0xfa, 0xe2, 0x07, 0xd4, 0x68, 0x59, 0x50, 0x93,
-	0x06, 0x7a, 0x00, 0x67, 0x86, 0x94, 0x91, 0xa0, 0xeb, 0x44, 0xcc, 0x0d, 0xe5, 0xf9, 0x72, 0xa7,
-	0x8c, 0x6b, 0x25, 0xa6, 0xb6, 0x39, 0x53, 0x04, 0x76, 0x0f, 0xa4, 0x68, 0x72, 0xc6, 0xa5, 0x53,
-	0xda, 0x2a, 0xc4, 0xc4, 0xe4, 0x88, 0xeb, 0xbc, 0x49, 0x98, 0xeb, 0xb9, 0xcc, 0xd5, 0x81, 0xb7,
-	0xad, 0x35, 0x7e, 0x47, 0xab, 0x90, 0x61, 0x84, 0xf5, 0xb0, 0x9e, 0x17, 0x8a, 0xf8, 0x05, 0xe9,
-	0xb0, 0x18, 0x0d, 0x7c, 0xdf, 0x0d, 0x47, 0xfa, 0xb2, 0x90, 0x27, 0xaf, 0xe8, 0xff, 0x90, 0x8b,
-	0x27, 0x02, 0x87, 0x7a, 0xe1, 0x84, 0x11, 0x18, 0x23, 0x2b, 0xbf, 0x29, 0x90, 0x9f, 0xee, 0x81,
-	0x6b, 0xb0, 0x34, 0xc2, 0x91, 0x73, 0x20, 0x86, 0x42, 0x39, 0x36, 0xa1, 0x66, 0xc0, 0xac, 0xdc,
-	0x08, 0x47, 0xbb, 0x5c, 0x8f, 0x6e, 0x41, 0xc1, 0xed, 0x44, 0xcc, 0x25, 0x81, 0x24, 0xa4, 0xe6,
-	0x12, 0x96, 0x25, 0x28, 0x26, 0xfd, 0x17, 0x72, 0x01, 0x95, 0xf8, 0xf4, 0x5c, 0xfc, 0x62, 0x40,
-	0x63, 0xe8, 0x1d, 0x40, 0x01, 0x75, 0x9e, 0x12, 0x76, 0xe8, 0x0c, 0x31, 0x4b, 0x48, 0xea, 0x5c,
-	0xd2, 0x4a, 0x40, 0x1f, 0x11, 0x76, 0xb8, 0x8f, 0x59, 0x4c, 0xae, 0xfc, 0xa4, 0x80, 0xca, 0xf7,
-	0xcf, 0xc9, 0xdb, 0xa3, 0x0a, 0x99, 0x21, 0x65, 0xf8, 0xe4, 0xcd, 0x11, 0xc3, 0xd0, 0x1d, 0x58,
-	0x8c, 0x97, 0x59, 0xa4, 0xab, 0xa2, 0x25, 0x2f, 0xcd, 0x8c, 0xd9, 0xf1, 0x4d, 0x69, 0x25, 0x8c,
-	0x23, 0x25, 0xcf, 0x1c, 0x2d, 0xf9, 0x7d, 0x35, 0x97, 0xd6, 0xd4, 0xca, 0x1f, 0x0a, 0x14, 0x64,
-	0xe3, 0xb6, 0xdc, 0xd0, 0xf5, 0x23, 0xf4, 0x18, 0xf2, 0x3e, 0x09, 0xc6, 0x73, 0xa0, 0x9c, 0x34,
-	0x07, 0x1b, 0x7c, 0x0e, 0xde, 0xbf, 0x29, 0xff, 0x67, 0x8a, 0x75, 0x9d, 0xfa, 0x84, 0x61, 0xbf,
-	0xcf, 0x46, 0x16, 0xf8, 0x24, 0x48, 0x26, 0xc3, 0x07, 0xe4, 0xbb, 0xcf, 0x12, 0x90, 0xd3, 0xc7,
-	0x21, 0xa1, 0x9e, 0x48, 0x04, 0xf7, 0x30, 0xdb, 0xce, 0x0d, 0x79, 0x85, 0xec, 0x5c, 0x7e, 0xff,
-	0xa6, 0x7c, 0xf1, 0x38, 0x71, 0xe2, 0xe4, 0x5b, 0xde, 0xed, 0x9a, 0xef, 0x3e, 0x4b, 0x4e, 0x22,
-	0xf4, 0x15, 0x1b, 0x96, 0xf7, 0xc5, 0x04, 0xc8, 0x93, 0x35, 0x40, 0x4e, 0x44, 0xe2, 0x59, 0x39,
-	0xc9, 0xb3, 0x2a, 0x2c, 0x2f, 0xc7, 0x2c, 0x69, 0xf5, 0xbb, 0xa4, 0x89, 0xa5, 0xd5, 0x2b, 0x90,
-	0xfd, 0x7a, 0x40, 0xc3, 0x81, 0x3f, 0xa7, 0x83, 0xc5, 0x1d, 0x13, 0x6b, 0xd1, 0x75, 0x58, 0x62,
-	0x87, 0x21, 0x8e, 0x0e, 0x69, 0xcf, 0xfb, 0x97, 0xeb, 0x68, 0x02, 0x40, 0xb7, 0xa1, 0x28, 0xba,
-	0x70, 0x42, 0x49, 0xcf, 0xa5, 0x14, 0x38, 0xca, 0x4e, 0x40, 0x95, 0x5f, 0x55, 0xc8, 0xca, 0xb8,
-	0x8c, 0x0f, 0xac, 0xe3, 0xd4, 0x3e, 0x9b, 0xae, 0xd9, 0xe7, 0x1f, 0x57, 0x33, 0x75, 0x7e, 0x4d,
-	0x8e, 0xd7, 0x20, 0xfd, 0x11, 0x35, 0x98, 0xca, 0xb9, 0x7a, 0xfa, 0x9c, 0x67, 0x3e, 0x3c, 0xe7,
-	0xd9, 0x53, 0xe4, 0x1c, 0x99, 0xb0, 0xc6, 0x13, 0x4d, 0x02, 0xc2, 0xc8, 0xe4, 0x02, 0x71, 0x44,
-	0xf8, 0xfa, 0xe2, 0x5c, 0x0b, 0xe7, 0x7c, 0x12, 0x98, 0x31, 0x5e, 0xa6, 0xc7, 0xe2, 0x68, 0xb4,
-	0x05, 0x5a, 0x67, 0x10, 0x06, 0x0e, 0x1f, 0x7d, 0x47, 0x9e, 0x90, 0xaf, 0xd7, 0x9c, 0x55, 0xe4,
-	0x72, 0x3e, 0xe2, 0x5f, 0xc4, 0x27, 0xab, 0xc3, 0x86, 0x40, 0x8e, 0x97, 0xcd, 0xb8, 0x40, 0x21,
-	0xe6, 0x6c, 0xbd, 0x28, 0x68, 0xeb, 0x1c, 0x94, 0xdc, 0xe5, 0x49, 0x25, 0x62, 0x04, 0xba, 0x0c,
-	0xc5, 0x89, 0x33, 0x7e, 0x24, 0x7d, 0x45, 0x70, 0x96, 0x13, 0x57, 0x7c, 0xbd, 0x5d, 0xfd, 0x46,
-	0x01, 0x98, 0xfa, 0x08, 0xbb, 0x00, 0xe7, 0xf7, 0x9b, 0xb6, 0xe1, 0x34, 0x5b, 0xb6, 0xd9, 0xdc,
-	0x73, 0x1e, 0xee, 0xb5, 0x5b, 0xc6, 0xae, 0x79, 0xd7, 0x34, 0x1a, 0xda, 0x02, 0x3a, 0x0b, 0x2b,
-	0xd3, 0xca, 0xc7, 0x46, 0x5b, 0x53, 0xd0, 0x79, 0x38, 0x3b, 0x2d, 0xac, 0xef, 0xb4, 0xed, 0xba,
-	0xb9, 0xa7, 0xa5, 0x10, 0x82, 0xe2, 0xb4, 0x62, 0xaf, 0xa9, 0xa5, 0xd1, 0x45, 0xd0, 0x8f, 0xca,
-	0x9c, 0x47, 0xa6, 0x7d, 0xcf, 0xd9, 0x37, 0xec, 0xa6, 0xa6, 0x5e, 0xfd, 0x45, 0x81, 0xe2, 0xd1,
-	0x0f, 0x13, 0x54, 0x86, 0x0b, 0x2d, 0xab, 0xd9, 0x6a, 0xb6, 0xeb, 0x0f, 0x9c, 0xb6, 0x5d, 0xb7,
-	0x1f, 0xb6, 0x67, 0x62, 0xaa, 0x40, 0x69, 0x16, 0xd0, 0x30, 0x5a, 0xcd, 0xb6, 0x69, 0x3b, 0x2d,
-	0xc3, 0x32, 0x9b, 0x0d, 0x4d, 0x41, 0x97, 0x60, 0x63, 0x16, 0xb3, 0xdf, 0xb4, 0xcd, 0xbd, 0xcf,
-	0x12, 0x48, 0x0a, 0xad, 0xc3, 0xb9, 0x59, 0x48, 0xab, 0xde, 0x6e, 0x1b, 0x8d, 0x38, 0xe8, 0x59,
-	0x9d, 0x65, 0xdc, 0x37, 0x76, 0x6d, 0xa3, 0xa1, 0xa9, 0xf3, 0x98, 0x77, 0xeb, 0xe6, 0x03, 0xa3,
-	0xa1, 0x65, 0x76, 0x8c, 0x97, 0x6f, 0x4b, 0xca, 0xab, 0xb7, 0x25, 0xe5, 0xaf, 0xb7, 0x25, 0xe5,
-	0xf9, 0xbb, 0xd2, 0xc2, 0xab, 0x77, 0xa5, 0x85, 0xdf, 0xdf, 0x95, 0x16, 0xbe, 0xbc, 0xd6, 0x25,
-	0xec, 0x70, 0xd0, 0xa9, 0x1e, 0x50, 0x5f, 0x7e, 0x2e, 0xcb, 0x9f, 0x1b, 0x91, 0xf7, 0x55, 0xed,
-	0x99, 0xf8, 0x17, 0x80, 0x8d, 0xfa, 0x38, 0xe2, 0xdf, 0xf7, 0x59, 0x31, 0x35, 0xb7, 0xfe, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0xbc, 0x24, 0x6f, 0xb5, 0x20, 0x0c, 0x00, 0x00,
+	// 1689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
+	0x15, 0x17, 0x48, 0x8a, 0xa2, 0x9e, 0x24, 0x8a, 0x5e, 0x49, 0x16, 0x2c, 0x5b, 0xa4, 0xc2, 0xc9,
+	0x78, 0x54, 0x27, 0x26, 0xab, 0xa4, 0xe9, 0x25, 0xed, 0x74, 0x28, 0x11, 0x89, 0xe9, 0xb1, 0x45,
+	0x16, 0xa4, 0xe5, 0xa6, 0x17, 0x14, 0x22, 0x36, 0x20, 0x1a, 0x00, 0xcb, 0x62, 0x97, 0xb2, 0xd8,
+	0x6f, 0xd0, 0x5b, 0x8e, 0x9d, 0x1e, 0x3a, 0x9d, 0x9e, 0x7a, 0xec, 0x21, 0xd3, 0xcf, 0x90, 0x53,
+	0x27, 0x93, 0x4b, 0xdb, 0x8b, 0xdb, 0xb1, 0x0f, 0x99, 0xc9, 0x97, 0x68, 0x67, 0xff, 0x80, 0x00,
+	0x29, 0xa6, 0xa2, 0x3c, 0xb9, 0x48, 0xc4, 0xdb, 0xdf, 0xef, 0xbd, 0xb7, 0xef, 0xdf, 0x62, 0x01,
+	0xbb, 0x7d, 0x42, 0x03, 0x42, 0xeb, 0x2e, 0xb9, 0xa8, 0x5f, 0x1c, 0xf1, 0x7f, 0xb5, 0x61, 0x44,
+	0x18, 0x41, 0x1b, 0x72, 0xa1, 0xc6, 0x25, 0x17, 0x47, 0x7b, 0x65, 0x85, 0x3b, 0xb7, 0x29, 0xae,
+	0x5f, 0x1c, 0x9d, 0x63, 0x66, 0x1f, 0xd5, 0xfb, 0xc4, 0x0b, 0x25, 0x7c, 0x6f, 0xdb, 0x25, 0x2e,
+	0x11, 0x3f, 0xeb, 0xfc, 0x97, 0x92, 0x56, 0x5c, 0x42, 0x5c, 0x1f, 0xd7, 0xc5, 0xd3, 0xf9, 0xe8,
+	0xd3, 0x3a, 0xf3, 0x02, 0x4c, 0x99, 0x1d, 0x0c, 0x15, 0xe0, 0xce, 0x2c, 0xc0, 0x0e, 0xc7, 0x6a,
+	0xa9, 0x3c, 0xbb, 0xe4, 0x8c, 0x22, 0x9b, 0x79, 0x24, 0xb6, 0x78, 0x47, 0x7a, 0x64, 0x49, 0xa3,
+	0xca, 0x5b, 0xb9, 0x74, 0xcb, 0x0e, 0xbc, 0x90, 0xd4, 0xc5, 0x5f, 0x29, 0xaa, 0x12, 0x40, 0xcf,
+	0xb1, 0xe7, 0x0e, 0x18, 0x76, 0xce, 0x08, 0xc3, 0xed, 0x21, 0xd7, 0x84, 0x8e, 0x20, 0x4f, 0xc4,
+	0x2f, 0x5d, 0x3b, 0xd0, 0x0e, 0x8b, 0xef, 0xdd, 0xa9, 0x4d, 0xed, 0xba, 0x96, 0x40, 0x4d, 0x05,
+	0x44, 0xf7, 0x21, 0xff, 0x42, 0x28, 0xd2, 0x33, 0x07, 0xda, 0xe1, 0xea, 0x71, 0xf1, 0xeb, 0x2f,
+	0x1e, 0x82, 0x62, 0x35, 0x71, 0xdf, 0x54, 0xab, 0xd5, 0x3f, 0x69, 0xb0, 0xd2, 0xc4, 0x43, 0x42,
+	0x3d, 0x86, 0x2a, 0xb0, 0x36, 0x8c, 0xc8, 0x90, 0x50, 0xdb, 0xb7, 0x3c, 0x47, 0xd8, 0xca, 0x99,
+	0x10, 0x8b, 0x5a, 0x0e, 0xfa, 0x31, 0xac, 0x3a, 0x12, 0x4b, 0x22, 0xa5, 0x57, 0xff, 0xfa, 0x8b,
+	0x87, 0xdb, 0x4a, 0x6f, 0xc3, 0x71, 0x22, 0x4c, 0x69, 0x97, 0x45, 0x5e, 0xe8, 0x9a, 0x09, 0x14,
+	0xfd, 0x04, 0xf2, 0x76, 0x40, 0x46, 0x21, 0xd3, 0xb3, 0x07, 0xd9, 0xc3, 0xb5, 0xc4, 0x7f, 0x9e,
+	0xa6, 0x9a, 0x4a, 0x53, 0xed, 0x84, 0x78, 0xe1, 0xf1, 0xea, 0x97, 0x2f, 0x2b, 0x4b, 0x7f, 0xf9,
+	0xe6, 0xaf, 0x0f, 0x34, 0x53, 0x71, 0xaa, 0xff, 0x5d, 0x86, 0x42, 0x47, 0x39, 0x81, 0x8a, 0x90,
+	0x99, 0xb8, 0x96, 0xf1, 0x1c, 0xf4, 0x43, 0x28, 0x04, 0x98, 0x52, 0xdb, 0xc5, 0x54, 0xcf, 0x08,
+	0xe5, 0xdb, 0x35, 0x99, 0x91, 0x5a, 0x9c, 0x91, 0x5a, 0x23, 0x1c, 0x9b, 0x13, 0x14, 0xfa, 0x00,
+	0xf2, 0x94, 0xd9, 0x6c, 0x44, 0xf5, 0xac, 0x08, 0xe6, 0xfe, 0x4c, 0x30, 0x63, 0x53, 0x5d, 0x01,
+	0x32, 0x15, 0x18, 0x3d, 0x02, 0xf4, 0xa9, 0x17, 0xda, 0xbe, 0xc5, 0x6c, 0xdf, 0x1f, 0x5b, 0x11,
+	0xa6, 0x23, 0x9f, 0xe9, 0xb9, 0x03, 0xed, 0x70, 0xed, 0xbd, 0xbd, 0x19, 0x15, 0x3d, 0x0e, 0x31,
+	0x05, 0xc2, 0x2c, 0x09, 0x56, 0x4a, 0x82, 0x1a, 0xb0, 0x46, 0x47, 0xe7, 0x81, 0xc7, 0x2c, 0x5e,
+	0x66, 0xfa, 0xb2, 0x52, 0x31, 0xeb, 0x75, 0x2f, 0xae, 0xc1, 0xe3, 0xdc, 0xe7, 0xff, 0xae, 0x68,
+	0x26, 0x48, 0x12, 0x17, 0xa3, 0xc7, 0x50, 0x52, 0xd1, 0xb5, 0x70, 0xe8, 0x48, 0x3d, 0xf9, 0x05,
+	0xf5, 0x14, 0x15, 0xd3, 0x08, 0x1d, 0xa1, 0xab, 0x05, 0x1b, 0x8c, 0x30, 0xdb, 0xb7, 0x94, 0x5c,
+	0x5f, 0xb9, 0x41, 0x8e, 0xd6, 0x05, 0x35, 0x2e, 0xa0, 0x27, 0x70, 0xeb, 0x82, 0x30, 0x2f, 0x74,
+	0x2d, 0xca, 0xec, 0x48, 0xed, 0xaf, 0xb0, 0xa0, 0x5f, 0x9b, 0x92, 0xda, 0xe5, 0x4c, 0xe1, 0xd8,
+	0x23, 0x50, 0xa2, 0x64, 0x8f, 0xab, 0x0b, 0xea, 0xda, 0x90, 0xc4, 0x78, 0x8b, 0x7b, 0xbc, 0x48,
+	0x98, 0xed, 0xd8, 0xcc, 0xd6, 0x81, 0x97, 0xad, 0x39, 0x79, 0x46, 0xdb, 0xb0, 0xcc, 0x3c, 0xe6,
+	0x63, 0x7d, 0x4d, 0x2c, 0xc8, 0x07, 0xa4, 0xc3, 0x0a, 0x1d, 0x05, 0x81, 0x1d, 0x8d, 0xf5, 0x75,
+	0x21, 0x8f, 0x1f, 0xd1, 0x8f, 0xa0, 0x20, 0x3b, 0x02, 0x47, 0xfa, 0xc6, 0x35, 0x2d, 0x30, 0x41,
+	0xa2, 0x7b, 0xb0, 0x8a, 0x2f, 0x87, 0xd8, 0xf1, 0x18, 0x76, 0xf4, 0xe2, 0x81, 0x76, 0x58, 0x30,
+	0x13, 0x01, 0x2a, 0x03, 0xf0, 0xb6, 0x0d, 0x3c, 0xca, 0xbc, 0xbe, 0xbe, 0x29, 0x96, 0x53, 0x92,
+	0xea, 0x3f, 0x34, 0x58, 0x4b, 0x57, 0xd0, 0x3b, 0xb0, 0x3a, 0xc6, 0xd4, 0xea, 0x8b, 0x96, 0xd2,
+	0xae, 0xf4, 0x77, 0x2b, 0x64, 0x66, 0x61, 0x8c, 0xe9, 0x09, 0x5f, 0x47, 0xef, 0xc3, 0x86, 0x7d,
+	0x4e, 0x99, 0xed, 0x85, 0x8a, 0x90, 0x99, 0x4b, 0x58, 0x57, 0x20, 0x49, 0xfa, 0x01, 0x14, 0x42,
+	0xa2, 0xf0, 0xd9, 0xb9, 0xf8, 0x95, 0x90, 0x48, 0xe8, 0x87, 0x80, 0x42, 0x62, 0xbd, 0xf0, 0xd8,
+	0xc0, 0xba, 0xc0, 0x2c, 0x26, 0xe5, 0xe6, 0x92, 0x36, 0x43, 0xf2, 0xdc, 0x63, 0x83, 0x33, 0xcc,
+	0x24, 0xb9, 0xfa, 0x37, 0x0d, 0x72, 0x7c, 0x7a, 0x5d, 0x3f, 0x7b, 0x6a, 0xb0, 0x7c, 0x41, 0x18,
+	0xbe, 0x7e, 0xee, 0x48, 0x18, 0xfa, 0x10, 0x56, 0xe4, 0x28, 0xa4, 0x7a, 0x4e, 0x14, 0xf4, 0x5b,
+	0x33, 0x4d, 0x7a, 0x75, 0xce, 0x9a, 0x31, 0x63, 0xaa, 0x60, 0x96, 0xa7, 0x0b, 0xe6, 0x71, 0xae,
+	0x90, 0x2d, 0xe5, 0xaa, 0xff, 0xd2, 0x60, 0x43, 0x95, 0x7d, 0xc7, 0x8e, 0xec, 0x80, 0xa2, 0x4f,
+	0x60, 0x2d, 0xf0, 0xc2, 0x49, 0x17, 0x69, 0xd7, 0x75, 0xd1, 0x3e, 0xef, 0xa2, 0x6f, 0x5f, 0x56,
+	0x76, 0x52, 0xac, 0x77, 0x49, 0xe0, 0x31, 0x1c, 0x0c, 0xd9, 0xd8, 0x84, 0xc0, 0x0b, 0xe3, 0xbe,
+	0x0a, 0x00, 0x05, 0xf6, 0x65, 0x0c, 0xb2, 0x86, 0x38, 0xf2, 0x88, 0x23, 0x02, 0xc1, 0x2d, 0xcc,
+	0x36, 0x43, 0x53, 0x1d, 0x40, 0xc7, 0x6f, 0x7f, 0xfb, 0xb2, 0x72, 0xef, 0x2a, 0x31, 0x31, 0xf2,
+	0x7b, 0xde, 0x2b, 0xa5, 0xc0, 0xbe, 0x8c, 0x77, 0x22, 0xd6, 0xab, 0x3d, 0x58, 0x3f, 0x13, 0xfd,
+	0xa3, 0x76, 0xd6, 0x04, 0xd5, 0x4f, 0xb1, 0x65, 0xed, 0x3a, 0xcb, 0x39, 0xa1, 0x79, 0x5d, 0xb2,
+	0x94, 0xd6, 0x3f, 0xc4, 0x45, 0xac, 0xb4, 0xde, 0x87, 0xfc, 0x6f, 0x46, 0x24, 0x1a, 0x05, 0xba,
+	0x36, 0xff, 0x84, 0x92, 0xab, 0xe8, 0x5d, 0x58, 0x65, 0x83, 0x08, 0xd3, 0x01, 0xf1, 0x9d, 0xef,
+	0x38, 0xcc, 0x12, 0x00, 0xfa, 0x00, 0x8a, 0xa2, 0x0a, 0x13, 0x4a, 0x76, 0x2e, 0x65, 0x83, 0xa3,
+	0x7a, 0x31, 0xa8, 0xfa, 0x47, 0x80, 0xbc, 0xf2, 0xcb, 0xb8, 0x61, 0x1e, 0x53, 0xd3, 0x30, 0x9d,
+	0xb3, 0xa7, 0x6f, 0x96, 0xb3, 0xdc, 0xfc, 0x9c, 0x5c, 0xcd, 0x41, 0xf6, 0x0d, 0x72, 0x90, 0x8a,
+	0x79, 0x6e, 0xf1, 0x98, 0x2f, 0xdf, 0x3c, 0xe6, 0xf9, 0x05, 0x62, 0x8e, 0x5a, 0x70, 0x87, 0x07,
+	0xda, 0x0b, 0x3d, 0xe6, 0x25, 0xc7, 0x8f, 0x25, 0xdc, 0xd7, 0x57, 0xe6, 0x6a, 0xb8, 0x1d, 0x78,
+	0x61, 0x4b, 0xe2, 0x55, 0x78, 0x4c, 0x8e, 0x46, 0x87, 0x50, 0x3a, 0x1f, 0x45, 0xa1, 0xc5, 0x5b,
+	0xdf, 0x52, 0x3b, 0xdc, 0x10, 0x63, 0xb4, 0xc8, 0xe5, 0xbc, 0xc5, 0x7f, 0x2e, 0x77, 0xd6, 0x80,
+	0x7d, 0x81, 0x9c, 0x0c, 0x9b, 0x49, 0x82, 0x22, 0xcc, 0xd9, 0x6a, 0x38, 0xef, 0x71, 0x50, 0xfc,
+	0x26, 0x10, 0x67, 0x42, 0x22, 0xd0, 0xdb, 0x50, 0x4c, 0x8c, 0xf1, 0x2d, 0xa9, 0x89, 0xbd, 0x1e,
+	0x9b, 0xe2, 0xe3, 0x0d, 0x3d, 0x87, 0xdd, 0xc9, 0x80, 0xb7, 0xa6, 0x53, 0x57, 0x5a, 0x2c, 0x75,
+	0x3b, 0x13, 0xfe, 0x59, 0x3a, 0x87, 0x3f, 0x83, 0xad, 0x44, 0x71, 0x12, 0xf2, 0x5b, 0x73, 0x03,
+	0x86, 0x26, 0xd0, 0x24, 0xee, 0xbf, 0x80, 0x44, 0xb3, 0x95, 0x2e, 0x75, 0x74, 0x83, 0x52, 0x4f,
+	0x7c, 0x78, 0x9a, 0xd4, 0xfc, 0x31, 0xec, 0x4c, 0xe2, 0xda, 0xb7, 0xc3, 0x3e, 0xf6, 0x55, 0x36,
+	0xb7, 0xe6, 0x3a, 0xb7, 0x15, 0x83, 0x4f, 0x04, 0x56, 0xa6, 0xf2, 0x31, 0x6c, 0xcf, 0xea, 0x70,
+	0x30, 0x65, 0xfa, 0xf6, 0x35, 0x63, 0x1f, 0x4d, 0x2b, 0x6b, 0x62, 0xca, 0xd0, 0xaf, 0xa0, 0x92,
+	0x9c, 0xa2, 0x96, 0x3d, 0x62, 0x03, 0x12, 0x79, 0xbf, 0xc5, 0x8e, 0x65, 0x4b, 0x2a, 0xa6, 0xfa,
+	0xce, 0x41, 0xf6, 0xff, 0xaa, 0xdd, 0x4f, 0x14, 0x34, 0x26, 0xfc, 0x46, 0x4c, 0x47, 0x3f, 0x85,
+	0xbb, 0x69, 0x0b, 0xbe, 0x4f, 0x5e, 0xf0, 0xa0, 0x52, 0xd7, 0x1a, 0x45, 0x3e, 0xd5, 0x6f, 0x73,
+	0xed, 0xa6, 0x9e, 0xd2, 0x21, 0x11, 0x4f, 0xa9, 0xfb, 0x2c, 0xf2, 0x29, 0x32, 0x21, 0xa5, 0xdf,
+	0x8a, 0xf0, 0xaf, 0x71, 0x7f, 0x3a, 0xab, 0xbb, 0x73, 0x03, 0x97, 0xb2, 0x69, 0x2a, 0x4e, 0x92,
+	0xde, 0x1e, 0x54, 0xf8, 0xe0, 0x71, 0xc9, 0x05, 0x8e, 0x42, 0x12, 0x4d, 0x6a, 0x8f, 0xbc, 0xc0,
+	0x91, 0x4a, 0x87, 0x3e, 0x5f, 0x6b, 0x60, 0x5f, 0x7e, 0xac, 0x58, 0xaa, 0xe2, 0x38, 0x47, 0xa4,
+	0xa5, 0xfa, 0x8d, 0x06, 0xe8, 0xa9, 0x7c, 0x85, 0x3e, 0xb6, 0x29, 0x76, 0xbe, 0xcf, 0xa3, 0x21,
+	0x35, 0x96, 0x32, 0x8b, 0x8f, 0xa5, 0xec, 0xcd, 0xc7, 0x52, 0x6e, 0x91, 0xa3, 0xe0, 0x12, 0x76,
+	0xaf, 0x6e, 0xd4, 0x08, 0x59, 0x34, 0x46, 0xbb, 0xb0, 0xa2, 0x52, 0x2b, 0xcf, 0x2c, 0x33, 0x1f,
+	0x88, 0x44, 0xa2, 0x26, 0xe4, 0x87, 0x02, 0xa7, 0x06, 0xfc, 0xec, 0xbb, 0xc6, 0x55, 0x85, 0x53,
+	0x17, 0x1d, 0xc9, 0xad, 0x7e, 0x06, 0x85, 0x38, 0xfe, 0xe8, 0x04, 0x4a, 0x93, 0x0c, 0xaa, 0x6a,
+	0x55, 0xe7, 0xe4, 0x77, 0xd7, 0xea, 0x66, 0xcc, 0x50, 0xe2, 0xa9, 0xd7, 0x98, 0xcc, 0xf4, 0x6b,
+	0x4c, 0xf5, 0xcf, 0x1a, 0x6c, 0x4b, 0x6b, 0xbc, 0x5f, 0x9a, 0xd8, 0xc7, 0xae, 0x48, 0x10, 0x32,
+	0xe0, 0x96, 0x23, 0x9f, 0x6e, 0x60, 0xba, 0x34, 0xa1, 0xc4, 0xb6, 0xe7, 0x6d, 0x20, 0x73, 0xc3,
+	0x0d, 0x54, 0x5d, 0xd8, 0xe9, 0xf6, 0x07, 0xd8, 0x19, 0xf9, 0x71, 0xdc, 0x9e, 0x0d, 0x1d, 0x9b,
+	0xe1, 0x2b, 0xd7, 0xc0, 0xdb, 0x90, 0x1f, 0x24, 0xd7, 0xdd, 0xac, 0xa9, 0x9e, 0xd0, 0x7d, 0xc8,
+	0x06, 0xd4, 0x55, 0x87, 0xe5, 0xfc, 0x9b, 0x21, 0x07, 0x3c, 0xf8, 0x9d, 0x06, 0x90, 0xba, 0x70,
+	0xdf, 0x85, 0xdd, 0xb3, 0x76, 0xcf, 0xb0, 0xda, 0x9d, 0x5e, 0xab, 0x7d, 0x6a, 0x3d, 0x3b, 0xed,
+	0x76, 0x8c, 0x93, 0xd6, 0x47, 0x2d, 0xa3, 0x59, 0x5a, 0x42, 0x5b, 0xb0, 0x99, 0x5e, 0xfc, 0xc4,
+	0xe8, 0x96, 0x34, 0xb4, 0x0b, 0x5b, 0x69, 0x61, 0xe3, 0xb8, 0xdb, 0x6b, 0xb4, 0x4e, 0x4b, 0x19,
+	0x84, 0xa0, 0x98, 0x5e, 0x38, 0x6d, 0x97, 0xb2, 0xe8, 0x1e, 0xe8, 0xd3, 0x32, 0xeb, 0x79, 0xab,
+	0xf7, 0xc8, 0x3a, 0x33, 0x7a, 0xed, 0x52, 0xee, 0xc1, 0xdf, 0x35, 0x28, 0x4e, 0x5f, 0x42, 0x51,
+	0x05, 0xee, 0x76, 0xcc, 0x76, 0xa7, 0xdd, 0x6d, 0x3c, 0xb1, 0xba, 0xbd, 0x46, 0xef, 0x59, 0x77,
+	0xc6, 0xa7, 0x2a, 0x94, 0x67, 0x01, 0x4d, 0xa3, 0xd3, 0xee, 0xb6, 0x7a, 0x56, 0xc7, 0x30, 0x5b,
+	0xed, 0x66, 0x49, 0x43, 0x6f, 0xc1, 0xfe, 0x2c, 0xe6, 0xac, 0xdd, 0x6b, 0x9d, 0x7e, 0x1c, 0x43,
+	0x32, 0x68, 0x0f, 0x6e, 0xcf, 0x42, 0x3a, 0x8d, 0x6e, 0xd7, 0x68, 0x4a, 0xa7, 0x67, 0xd7, 0x4c,
+	0xe3, 0xb1, 0x71, 0xd2, 0x33, 0x9a, 0xa5, 0xdc, 0x3c, 0xe6, 0x47, 0x8d, 0xd6, 0x13, 0xa3, 0x59,
+	0x5a, 0x3e, 0x36, 0xbe, 0x7c, 0x55, 0xd6, 0xbe, 0x7a, 0x55, 0xd6, 0xfe, 0xf3, 0xaa, 0xac, 0x7d,
+	0xfe, 0xba, 0xbc, 0xf4, 0xd5, 0xeb, 0xf2, 0xd2, 0x3f, 0x5f, 0x97, 0x97, 0x7e, 0xf9, 0x8e, 0xeb,
+	0xb1, 0xc1, 0xe8, 0xbc, 0xd6, 0x27, 0x81, 0xfa, 0x34, 0xa2, 0xfe, 0x3d, 0xa4, 0xce, 0x67, 0xf5,
+	0x4b, 0xf1, 0xb9, 0x87, 0x8d, 0x87, 0x98, 0xf2, 0x6f, 0x39, 0x79, 0x91, 0xb6, 0xf7, 0xff, 0x37,
+	0x00, 0xb7, 0xc2, 0xcb, 0xe0, 0x0c, 0x12, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1054,17 +1500,37 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+	if m.Optimistic {
 		i--
-		dAtA[i] = 0x6a
+		if m.Optimistic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
 	}
-	if len(m.Summary) > 0 {
-		i -= len(m.Summary)
-		copy(dAtA[i:], m.Summary)
-		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Summary)))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -1426,6 +1892,101 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxGovernorVotingPowerRatio) > 0 {
+		i -= len(m.MaxGovernorVotingPowerRatio)
+		copy(dAtA[i:], m.MaxGovernorVotingPowerRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MaxGovernorVotingPowerRatio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.OptimisticRejectedThreshold) > 0 {
+		i -= len(m.OptimisticRejectedThreshold)
+		copy(dAtA[i:], m.OptimisticRejectedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.OptimisticAllowedMsgUrls) > 0 {
+		for iNdEx := len(m.OptimisticAllowedMsgUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAllowedMsgUrls[iNdEx])
+			copy(dAtA[i:], m.OptimisticAllowedMsgUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAllowedMsgUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
+			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.BurnVoteVeto {
 		i--
 		if m.BurnVoteVeto {
@@ -1485,22 +2046,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1521,6 +2082,219 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VotingPeriod != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageBasedParamsEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParamsEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParamsEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgUrl) > 0 {
+		i -= len(m.MsgUrl)
+		copy(dAtA[i:], m.MsgUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledParamsUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamsUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1630,6 +2404,12 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
+	if m.Optimistic {
+		n += 2
+	}
 	return n
 }
 
@@ -1782,21 +2562,156 @@ func (m *Params) Size() (n int) {
 	if m.BurnVoteVeto {
 		n += 2
 	}
-	return n
-}
-
-func sovGov(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGov(x uint64) (n int) {
-	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for _, s := range m.OptimisticAuthorizedAddresses {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.OptimisticAllowedMsgUrls) > 0 {
+		for _, s := range m.OptimisticAllowedMsgUrls {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	l = len(m.OptimisticRejectedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.MaxGovernorVotingPowerRatio)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageBasedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageBasedParamsEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledParamsUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGov(uint64(m.Id))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGov(uint64(m.Height))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func sovGov(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGov(x uint64) (n int) {
+	return sovGov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowGov
@@ -2470,6 +3385,46 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Optimistic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Optimistic = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3511,6 +4466,949 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.BurnVoteVeto = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAllowedMsgUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticAllowedMsgUrls = append(m.OptimisticAllowedMsgUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGovernorVotingPowerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGovernorVotingPowerRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParamsEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParamsEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParamsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Governor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledParamsUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParamsUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParamsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types1.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -16,19 +16,21 @@ import (
 
 var (
 	_, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}
-	_, _             codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _          codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}, &MsgScheduleParamsUpdate{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
 //
 //nolint:interfacer
-func NewMsgSubmitProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer, metadata, title, summary string) (*MsgSubmitProposal, error) {
+func NewMsgSubmitProposal(messages []sdk.Msg, initialDeposit sdk.Coins, proposer, metadata, title, summary string, expedited, optimistic bool) (*MsgSubmitProposal, error) {
 	m := &MsgSubmitProposal{
 		InitialDeposit: initialDeposit,
 		Proposer:       proposer,
 		Metadata:       metadata,
 		Title:          title,
 		Summary:        summary,
+		Expedited:      expedited,
+		Optimistic:     optimistic,
 	}
 
 	anys, err := sdktx.SetMsgs(messages)
@@ -74,6 +76,10 @@ func (m MsgSubmitProposal) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, deposit.String())
 	}
 
+	if m.Expedited && m.Optimistic {
+		return sdkerrors.Wrap(types.ErrInvalidProposalType, "proposal cannot be both expedited and optimistic")
+	}
+
 	// Check that either metadata or Msgs length is non nil.
 	if len(m.Messages) == 0 && len(m.Metadata) == 0 {
 		return sdkerrors.Wrap(types.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
@@ -309,3 +315,362 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{authority}
 }
+
+// NewMsgCancelProposal creates a new MsgCancelProposal instance
+//
+//nolint:interfacer
+func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal {
+	return &MsgCancelProposal{
+		ProposalId: proposalID,
+		Proposer:   proposer,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelProposal) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelProposal) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCancelProposal.
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUpdateMessageParams) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUpdateMessageParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUpdateMessageParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if len(msg.MsgUrl) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("msg url cannot be empty")
+	}
+
+	if msg.Params == nil {
+		return nil
+	}
+
+	return msg.Params.ValidateBasic()
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUpdateMessageParams) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgUpdateMessageParams.
+func (msg MsgUpdateMessageParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgCreateGovernor creates a new MsgCreateGovernor instance
+//
+//nolint:interfacer
+func NewMsgCreateGovernor(governor sdk.AccAddress, metadata string) *MsgCreateGovernor {
+	return &MsgCreateGovernor{
+		GovernorAddress: governor.String(),
+		Metadata:        metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCreateGovernor) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCreateGovernor) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCreateGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GovernorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateGovernor) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCreateGovernor.
+func (msg MsgCreateGovernor) GetSigners() []sdk.AccAddress {
+	governor, _ := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	return []sdk.AccAddress{governor}
+}
+
+// NewMsgEditGovernor creates a new MsgEditGovernor instance
+//
+//nolint:interfacer
+func NewMsgEditGovernor(governor sdk.AccAddress, metadata string) *MsgEditGovernor {
+	return &MsgEditGovernor{
+		GovernorAddress: governor.String(),
+		Metadata:        metadata,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgEditGovernor) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgEditGovernor) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgEditGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GovernorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgEditGovernor) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgEditGovernor.
+func (msg MsgEditGovernor) GetSigners() []sdk.AccAddress {
+	governor, _ := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	return []sdk.AccAddress{governor}
+}
+
+// NewMsgRemoveGovernor creates a new MsgRemoveGovernor instance
+//
+//nolint:interfacer
+func NewMsgRemoveGovernor(governor sdk.AccAddress) *MsgRemoveGovernor {
+	return &MsgRemoveGovernor{
+		GovernorAddress: governor.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRemoveGovernor) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRemoveGovernor) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRemoveGovernor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.GovernorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRemoveGovernor) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgRemoveGovernor.
+func (msg MsgRemoveGovernor) GetSigners() []sdk.AccAddress {
+	governor, _ := sdk.AccAddressFromBech32(msg.GovernorAddress)
+	return []sdk.AccAddress{governor}
+}
+
+// NewMsgDelegateGovernance creates a new MsgDelegateGovernance instance
+//
+//nolint:interfacer
+func NewMsgDelegateGovernance(delegator, governor sdk.AccAddress) *MsgDelegateGovernance {
+	return &MsgDelegateGovernance{
+		DelegatorAddress: delegator.String(),
+		GovernorAddress:  governor.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgDelegateGovernance) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgDelegateGovernance) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgDelegateGovernance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.GovernorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if msg.DelegatorAddress == msg.GovernorAddress {
+		return types.ErrInvalidGovernanceDelegation.Wrap("cannot delegate governance to self")
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgDelegateGovernance) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgDelegateGovernance.
+func (msg MsgDelegateGovernance) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgUndelegateGovernance creates a new MsgUndelegateGovernance instance
+//
+//nolint:interfacer
+func NewMsgUndelegateGovernance(delegator sdk.AccAddress) *MsgUndelegateGovernance {
+	return &MsgUndelegateGovernance{
+		DelegatorAddress: delegator.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgUndelegateGovernance) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgUndelegateGovernance) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgUndelegateGovernance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUndelegateGovernance) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgUndelegateGovernance.
+func (msg MsgUndelegateGovernance) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// NewMsgScheduleParamsUpdate creates a new MsgScheduleParamsUpdate instance
+func NewMsgScheduleParamsUpdate(authority string, height int64, msg sdk.Msg) (*MsgScheduleParamsUpdate, error) {
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgScheduleParamsUpdate{
+		Authority: authority,
+		Height:    height,
+		Msg:       msgAny,
+	}, nil
+}
+
+// GetParamsUpdateMsg returns the unpacked params update message.
+func (msg MsgScheduleParamsUpdate) GetParamsUpdateMsg() (sdk.Msg, error) {
+	return unpackParamsUpdateMsg(msg.Msg)
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgScheduleParamsUpdate) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgScheduleParamsUpdate) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgScheduleParamsUpdate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if msg.Height <= 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidParamsUpdate, "height must be positive, got %d", msg.Height)
+	}
+
+	paramsMsg, err := msg.GetParamsUpdateMsg()
+	if err != nil {
+		return err
+	}
+
+	return validateParamsUpdateMsg(paramsMsg)
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgScheduleParamsUpdate) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgScheduleParamsUpdate.
+func (msg MsgScheduleParamsUpdate) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgScheduleParamsUpdate) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var paramsMsg sdk.Msg
+	return unpacker.UnpackAny(msg.Msg, &paramsMsg)
+}
+
+// NewMsgCancelScheduledParamsUpdate creates a new MsgCancelScheduledParamsUpdate instance
+func NewMsgCancelScheduledParamsUpdate(authority string, id uint64) *MsgCancelScheduledParamsUpdate {
+	return &MsgCancelScheduledParamsUpdate{
+		Authority: authority,
+		Id:        id,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgCancelScheduledParamsUpdate) Route() string { return types.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgCancelScheduledParamsUpdate) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgCancelScheduledParamsUpdate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCancelScheduledParamsUpdate) GetSignBytes() []byte {
+	bz := codec.ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the expected signers for a MsgCancelScheduledParamsUpdate.
+func (msg MsgCancelScheduledParamsUpdate) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
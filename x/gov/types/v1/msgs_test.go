@@ -88,6 +88,114 @@ func TestMsgVote(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID uint64
+		proposer   string
+		expectPass bool
+	}{
+		{1, addrs[0].String(), true},
+		{1, "", false},
+		{1, "invalid", false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgCancelProposal(tc.proposalID, tc.proposer)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgDelegateGovernance(t *testing.T) {
+	tests := []struct {
+		delegator  sdk.AccAddress
+		governor   sdk.AccAddress
+		expectPass bool
+	}{
+		{addrs[0], addrs[1], true},
+		{addrs[0], addrs[0], false},
+		{sdk.AccAddress{}, addrs[1], false},
+		{addrs[0], sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgDelegateGovernance(tc.delegator, tc.governor)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgScheduleParamsUpdate
+func TestMsgScheduleParamsUpdate(t *testing.T) {
+	authority := addrs[0].String()
+	paramsMsg := &v1.MsgUpdateParams{Authority: authority, Params: v1.DefaultParams()}
+	invalidParams := v1.DefaultParams()
+	invalidParams.Threshold = "1.1"
+
+	tests := []struct {
+		authority  string
+		height     int64
+		msg        sdk.Msg
+		expectPass bool
+	}{
+		{authority, 10, paramsMsg, true},
+		{"", 10, paramsMsg, false},
+		{authority, 0, paramsMsg, false},
+		{authority, 10, banktypes.NewMsgSend(addrs[0], addrs[1], coinsPos), false},
+		{authority, 10, &v1.MsgUpdateParams{Authority: authority, Params: invalidParams}, false},
+	}
+
+	for i, tc := range tests {
+		msg, err := v1.NewMsgScheduleParamsUpdate(tc.authority, tc.height, tc.msg)
+		require.NoError(t, err)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	require.NotNil(t, v1.MsgScheduleParamsUpdate{Authority: authority, Height: 10}.ValidateBasic())
+}
+
+// test ValidateBasic for MsgUpdateMessageParams
+func TestMsgUpdateMessageParams(t *testing.T) {
+	authority := addrs[0].String()
+	msgURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	params := v1.DefaultParams().ToMessageBasedParams()
+	invalidParams := params
+	invalidParams.Threshold = "1.1"
+
+	tests := []struct {
+		authority  string
+		msgURL     string
+		params     *v1.MessageBasedParams
+		expectPass bool
+	}{
+		{authority, msgURL, &params, true},
+		{authority, msgURL, nil, true},
+		{"invalid", msgURL, &params, false},
+		{authority, "", &params, false},
+		{authority, msgURL, &invalidParams, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.MsgUpdateMessageParams{Authority: tc.authority, MsgUrl: tc.msgURL, Params: tc.params}
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgVoteWeighted
 func TestMsgVoteWeighted(t *testing.T) {
 	metadata := "metadata"
@@ -163,7 +271,7 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		msg, err := v1.NewMsgSubmitProposal(tc.messages, tc.initialDeposit, tc.proposer, tc.metadata, tc.title, tc.summary)
+		msg, err := v1.NewMsgSubmitProposal(tc.messages, tc.initialDeposit, tc.proposer, tc.metadata, tc.title, tc.summary, false, false)
 		require.NoError(t, err)
 		if tc.expErr {
 			require.Error(t, msg.ValidateBasic(), "test: %s", tc.name)
@@ -171,6 +279,11 @@ func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
 			require.NoError(t, msg.ValidateBasic(), "test: %s", tc.name)
 		}
 	}
+
+	// a proposal cannot be both expedited and optimistic
+	msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{msg1}, coinsPos, addrs[0].String(), metadata, "Title", "Summary", true, true)
+	require.NoError(t, err)
+	require.Error(t, msg.ValidateBasic())
 }
 
 // this tests that Amino JSON MsgSubmitProposal.GetSignBytes() still works with Content as Any using the ModuleCdc
@@ -200,7 +313,7 @@ func TestMsgSubmitProposal_GetSignBytes(t *testing.T) {
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
-			msg, err := v1.NewMsgSubmitProposal(tc.proposal, sdk.NewCoins(), sdk.AccAddress{}.String(), "", tc.title, tc.summary)
+			msg, err := v1.NewMsgSubmitProposal(tc.proposal, sdk.NewCoins(), sdk.AccAddress{}.String(), "", tc.title, tc.summary, false, false)
 			require.NoError(t, err)
 			var bz []byte
 			require.NotPanics(t, func() {
@@ -11,19 +11,26 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens       = sdk.NewInt(10000000)
-	DefaultQuorum                 = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold              = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold          = sdk.NewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio = sdk.ZeroDec()
-	DefaultBurnProposalPrevote    = false // set to false to replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteQuorom         = false // set to false to  replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteVeto           = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositTokens               = sdk.NewInt(10000000)
+	DefaultMinExpeditedDepositTokensRatio = sdk.NewInt(5)
+	DefaultQuorum                         = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                      = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold             = sdk.NewDecWithPrec(667, 3)
+	DefaultVetoThreshold                  = sdk.NewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio         = sdk.ZeroDec()
+	DefaultProposalCancelRatio            = sdk.NewDecWithPrec(5, 1)
+	DefaultProposalCancelDestAddress      = ""
+	DefaultOptimisticRejectedThreshold    = sdk.NewDecWithPrec(1, 1)
+	DefaultMaxGovernorVotingPowerRatio    = sdk.NewDecWithPrec(1, 1)
+	DefaultBurnProposalPrevote            = false // set to false to replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteQuorom                 = false // set to false to  replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteVeto                   = true  // set to true to replicate behavior of when this change was made (0.47)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -50,7 +57,8 @@ func NewVotingParams(votingPeriod *time.Duration) VotingParams {
 	}
 }
 
-// NewParams creates a new Params instance with given values.
+// NewParams creates a new Params instance with given values. The parameters
+// added after v0.47 are left unset and must be set on the returned Params.
 func NewParams(
 	minDeposit sdk.Coins, maxDepositPeriod, votingPeriod time.Duration,
 	quorum, threshold, vetoThreshold, minInitialDepositRatio string, burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
@@ -71,7 +79,7 @@ func NewParams(
 
 // DefaultParams returns the default governance params
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
 		DefaultPeriod,
@@ -83,6 +91,17 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 	)
+
+	expeditedVotingPeriod := DefaultExpeditedPeriod
+	params.ExpeditedMinDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens.Mul(DefaultMinExpeditedDepositTokensRatio)))
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	params.ExpeditedThreshold = DefaultExpeditedThreshold.String()
+	params.ProposalCancelRatio = DefaultProposalCancelRatio.String()
+	params.ProposalCancelDest = DefaultProposalCancelDestAddress
+	params.OptimisticRejectedThreshold = DefaultOptimisticRejectedThreshold.String()
+	params.MaxGovernorVotingPowerRatio = DefaultMaxGovernorVotingPowerRatio.String()
+
+	return params
 }
 
 // ValidateBasic performs basic validation on governance parameters.
@@ -91,6 +110,12 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("invalid minimum deposit: %s", minDeposit)
 	}
 
+	if minExpeditedDeposit := sdk.Coins(p.ExpeditedMinDeposit); minExpeditedDeposit.Empty() || !minExpeditedDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", minExpeditedDeposit)
+	} else if minExpeditedDeposit.IsAllLTE(p.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit must be greater than minimum deposit: %s", minExpeditedDeposit)
+	}
+
 	if p.MaxDepositPeriod == nil {
 		return fmt.Errorf("maximum deposit period must not be nil: %d", p.MaxDepositPeriod)
 	}
@@ -121,6 +146,17 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
+	expeditedThreshold, err := sdk.NewDecFromStr(p.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited vote threshold %s must be greater than the regular threshold %s", expeditedThreshold, threshold)
+	}
+	if expeditedThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", expeditedThreshold)
+	}
+
 	vetoThreshold, err := sdk.NewDecFromStr(p.VetoThreshold)
 	if err != nil {
 		return fmt.Errorf("invalid vetoThreshold string: %w", err)
@@ -140,5 +176,192 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("voting period must be positive: %s", p.VotingPeriod)
 	}
 
+	if p.ExpeditedVotingPeriod == nil {
+		return fmt.Errorf("expedited voting period must not be nil: %d", p.ExpeditedVotingPeriod)
+	}
+
+	if p.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", p.ExpeditedVotingPeriod)
+	}
+
+	if p.ExpeditedVotingPeriod.Seconds() >= p.VotingPeriod.Seconds() {
+		return fmt.Errorf("expedited voting period %s must be strictly less than the regular voting period %s", p.ExpeditedVotingPeriod, p.VotingPeriod)
+	}
+
+	proposalCancelRatio, err := sdk.NewDecFromStr(p.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio string: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio must be positive: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+
+	if len(p.ProposalCancelDest) != 0 {
+		if _, err := sdk.AccAddressFromBech32(p.ProposalCancelDest); err != nil {
+			return fmt.Errorf("invalid proposal cancel destination address: %w", err)
+		}
+	}
+
+	optimisticRejectedThreshold, err := sdk.NewDecFromStr(p.OptimisticRejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+	}
+	if !optimisticRejectedThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+	}
+	if optimisticRejectedThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+	}
+
+	maxGovernorVotingPowerRatio, err := sdk.NewDecFromStr(p.MaxGovernorVotingPowerRatio)
+	if err != nil {
+		return fmt.Errorf("invalid max governor voting power ratio string: %w", err)
+	}
+	if !maxGovernorVotingPowerRatio.IsPositive() {
+		return fmt.Errorf("max governor voting power ratio must be positive: %s", maxGovernorVotingPowerRatio)
+	}
+	if maxGovernorVotingPowerRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("max governor voting power ratio too large: %s", maxGovernorVotingPowerRatio)
+	}
+
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid optimistic authorized address: %w", err)
+		}
+	}
+
+	for _, msgURL := range p.OptimisticAllowedMsgUrls {
+		if len(msgURL) == 0 {
+			return fmt.Errorf("optimistic allowed msg url cannot be empty")
+		}
+	}
+
+	return nil
+}
+
+// IsOptimisticAuthorizedAddress returns true if the address is allowed to
+// submit optimistic proposals.
+func (p Params) IsOptimisticAuthorizedAddress(addr string) bool {
+	for _, authorized := range p.OptimisticAuthorizedAddresses {
+		if authorized == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsOptimisticAllowedMsgURL returns true if messages of the given type URL are
+// allowed in optimistic proposals.
+func (p Params) IsOptimisticAllowedMsgURL(msgURL string) bool {
+	for _, allowed := range p.OptimisticAllowedMsgUrls {
+		if allowed == msgURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ToMessageBasedParams returns the voting period and tally params of the
+// governance params, which apply to the message types without params of
+// their own.
+func (p Params) ToMessageBasedParams() MessageBasedParams {
+	return MessageBasedParams{
+		VotingPeriod:  p.VotingPeriod,
+		Quorum:        p.Quorum,
+		Threshold:     p.Threshold,
+		VetoThreshold: p.VetoThreshold,
+	}
+}
+
+// ValidateBasic performs basic validation on message based params.
+func (p MessageBasedParams) ValidateBasic() error {
+	if p.VotingPeriod == nil {
+		return fmt.Errorf("voting period must not be nil: %d", p.VotingPeriod)
+	}
+
+	if p.VotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("voting period must be positive: %s", p.VotingPeriod)
+	}
+
+	quorum, err := sdk.NewDecFromStr(p.Quorum)
+	if err != nil {
+		return fmt.Errorf("invalid quorum string: %w", err)
+	}
+	if quorum.IsNegative() {
+		return fmt.Errorf("quorom cannot be negative: %s", quorum)
+	}
+	if quorum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("quorom too large: %s", p.Quorum)
+	}
+
+	threshold, err := sdk.NewDecFromStr(p.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold string: %w", err)
+	}
+	if !threshold.IsPositive() {
+		return fmt.Errorf("vote threshold must be positive: %s", threshold)
+	}
+	if threshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("vote threshold too large: %s", threshold)
+	}
+
+	vetoThreshold, err := sdk.NewDecFromStr(p.VetoThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid vetoThreshold string: %w", err)
+	}
+	if !vetoThreshold.IsPositive() {
+		return fmt.Errorf("veto threshold must be positive: %s", vetoThreshold)
+	}
+	if vetoThreshold.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("veto threshold too large: %s", vetoThreshold)
+	}
+
+	return nil
+}
+
+// ValidateExpeditedVotingPeriod checks that the voting period is strictly longer
+// than the expedited voting period of the governance params, as required for
+// the voting period of the governance params themselves.
+func (p MessageBasedParams) ValidateExpeditedVotingPeriod(expeditedVotingPeriod time.Duration) error {
+	if p.VotingPeriod == nil {
+		return fmt.Errorf("voting period must not be nil: %d", p.VotingPeriod)
+	}
+
+	if expeditedVotingPeriod >= *p.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be strictly less than the voting period %s", expeditedVotingPeriod, p.VotingPeriod)
+	}
+
 	return nil
 }
+
+// StrictestMessageBasedParams combines the given params into the strictest
+// ones: the longest voting period, the highest quorum and threshold, and the
+// lowest veto threshold. It panics if no params are given.
+func StrictestMessageBasedParams(params ...MessageBasedParams) MessageBasedParams {
+	strictest := params[0]
+	votingPeriod := *strictest.VotingPeriod
+	quorum := sdk.MustNewDecFromStr(strictest.Quorum)
+	threshold := sdk.MustNewDecFromStr(strictest.Threshold)
+	vetoThreshold := sdk.MustNewDecFromStr(strictest.VetoThreshold)
+
+	for _, p := range params[1:] {
+		if *p.VotingPeriod > votingPeriod {
+			votingPeriod = *p.VotingPeriod
+		}
+		quorum = math.LegacyMaxDec(quorum, sdk.MustNewDecFromStr(p.Quorum))
+		threshold = math.LegacyMaxDec(threshold, sdk.MustNewDecFromStr(p.Threshold))
+		vetoThreshold = math.LegacyMinDec(vetoThreshold, sdk.MustNewDecFromStr(p.VetoThreshold))
+	}
+
+	return MessageBasedParams{
+		VotingPeriod:  &votingPeriod,
+		Quorum:        quorum.String(),
+		Threshold:     threshold.String(),
+		VetoThreshold: vetoThreshold.String(),
+	}
+}
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var (
+	_ codectypes.UnpackInterfacesMessage = ScheduledParamsUpdate{}
+	_ codectypes.UnpackInterfacesMessage = &QueryScheduledParamsUpdateResponse{}
+	_ codectypes.UnpackInterfacesMessage = &QueryScheduledParamsUpdatesResponse{}
+)
+
+// IsParamsUpdateMsgURL returns true if the message type URL is the one of the
+// MsgUpdateParams message of a module.
+func IsParamsUpdateMsgURL(msgURL string) bool {
+	return strings.HasSuffix(msgURL, ".MsgUpdateParams")
+}
+
+// NewScheduledParamsUpdate creates a new ScheduledParamsUpdate instance.
+func NewScheduledParamsUpdate(id uint64, height int64, msg sdk.Msg) (ScheduledParamsUpdate, error) {
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return ScheduledParamsUpdate{}, err
+	}
+
+	return ScheduledParamsUpdate{
+		Id:     id,
+		Height: height,
+		Msg:    msgAny,
+	}, nil
+}
+
+// GetParamsUpdateMsg returns the unpacked params update message.
+func (u ScheduledParamsUpdate) GetParamsUpdateMsg() (sdk.Msg, error) {
+	return unpackParamsUpdateMsg(u.Msg)
+}
+
+// ValidateBasic performs basic validation of a scheduled params update.
+func (u ScheduledParamsUpdate) ValidateBasic() error {
+	if u.Height <= 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidParamsUpdate, "height must be positive, got %d", u.Height)
+	}
+
+	msg, err := u.GetParamsUpdateMsg()
+	if err != nil {
+		return err
+	}
+
+	return validateParamsUpdateMsg(msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (u ScheduledParamsUpdate) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(u.Msg, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryScheduledParamsUpdateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return m.Update.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m *QueryScheduledParamsUpdatesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, update := range m.Updates {
+		if err := update.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unpackParamsUpdateMsg returns the cached sdk.Msg of a params update message.
+func unpackParamsUpdateMsg(msgAny *codectypes.Any) (sdk.Msg, error) {
+	if msgAny == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidParamsUpdate, "params update msg cannot be empty")
+	}
+
+	msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (sdk.Msg)(nil), msgAny.GetCachedValue())
+	}
+
+	return msg, nil
+}
+
+// validateParamsUpdateMsg checks that a message is the valid MsgUpdateParams
+// message of a module.
+func validateParamsUpdateMsg(msg sdk.Msg) error {
+	if !IsParamsUpdateMsgURL(sdk.MsgTypeURL(msg)) {
+		return sdkerrors.Wrapf(types.ErrInvalidParamsUpdate, "%s is not a params update msg", sdk.MsgTypeURL(msg))
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidParamsUpdate, err.Error())
+	}
+
+	return nil
+}
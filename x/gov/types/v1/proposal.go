@@ -47,6 +47,15 @@ func NewProposal(messages []sdk.Msg, id uint64, submitTime, depositEndTime time.
 	return p, nil
 }
 
+// GetMinDepositFromParams returns the minimum deposit required for the
+// proposal to enter the voting period, depending on whether it is expedited.
+func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
+	if p.Expedited {
+		return params.ExpeditedMinDeposit
+	}
+	return params.MinDeposit
+}
+
 // GetMessages returns the proposal messages
 func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
@@ -35,6 +35,7 @@ func QueryCmd(name string) *cobra.Command {
 		QueryGroupsByMemberCmd(),
 		QueryTallyResultCmd(),
 		QueryGroupsCmd(),
+		QueryExecutionLogsByProposalCmd(),
 	)
 
 	return queryCmd
@@ -553,3 +554,45 @@ func QueryGroupsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// QueryExecutionLogsByProposalCmd creates a CLI command for Query/ExecutionLogsByProposal.
+func QueryExecutionLogsByProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execution-logs-by-proposal [proposal-id]",
+		Short: "Query for execution logs by proposal id with pagination flags",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := group.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ExecutionLogsByProposal(cmd.Context(), &group.QueryExecutionLogsByProposalRequest{
+				ProposalId: proposalID,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "execution-logs-by-proposal")
+
+	return cmd
+}
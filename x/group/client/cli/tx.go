@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -19,6 +20,10 @@ const (
 	FlagExec               = "exec"
 	ExecTry                = "try"
 	FlagGroupPolicyAsAdmin = "group-policy-as-admin"
+
+	FlagTimelock             = "timelock"
+	FlagVetoAddress          = "veto-address"
+	FlagMaxExecutionAttempts = "max-execution-attempts"
 )
 
 // TxCmd returns a root CLI command handler for all x/group transaction commands.
@@ -41,7 +46,9 @@ func TxCmd(name string) *cobra.Command {
 		MsgUpdateGroupPolicyAdminCmd(),
 		MsgUpdateGroupPolicyDecisionPolicyCmd(),
 		MsgUpdateGroupPolicyMetadataCmd(),
+		MsgUpdateGroupPolicyExecutionSettingsCmd(),
 		MsgWithdrawProposalCmd(),
+		MsgVetoProposalCmd(),
 		MsgSubmitProposalCmd(),
 		MsgVoteCmd(),
 		MsgExecCmd(),
@@ -380,6 +387,21 @@ Here, we can use percentage decision policy when needed, where 0 < percentage <=
         "voting_period": "120h",
         "min_execution_period": "0s"
     }
+}
+
+Or a token weighted decision policy, where the voting power of the members is
+their bank balance of a denom (VOTING_POWER_SOURCE_BANK) or their bonded
+amount (VOTING_POWER_SOURCE_STAKING), snapshotted at proposal submission:
+
+{
+    "@type": "/cosmos.group.v1.TokenWeightedDecisionPolicy",
+    "percentage": "0.5",
+    "source": "VOTING_POWER_SOURCE_BANK",
+    "denom": "stake",
+    "windows": {
+        "voting_period": "120h",
+        "min_execution_period": "0s"
+    }
 }`, version.AppName),
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -544,6 +566,74 @@ func MsgUpdateGroupPolicyMetadataCmd() *cobra.Command {
 	return cmd
 }
 
+// MsgUpdateGroupPolicyExecutionSettingsCmd creates a CLI command for Msg/UpdateGroupPolicyExecutionSettings.
+func MsgUpdateGroupPolicyExecutionSettingsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-group-policy-execution-settings [admin] [group-policy-account]",
+		Short: "Update a group policy's execution settings",
+		Long: fmt.Sprintf(`Update a group policy's execution settings.
+
+The timelock is the duration after a proposal is accepted during which it can't be
+executed and can be vetoed by the veto address. If none of the flags is set, the
+execution settings of the group policy are removed.
+
+Example:
+$ %s tx group update-group-policy-execution-settings [admin] [group-policy-account] --timelock=24h --veto-address=[veto-address] --max-execution-attempts=3
+`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timelock, err := cmd.Flags().GetDuration(FlagTimelock)
+			if err != nil {
+				return err
+			}
+
+			vetoAddress, err := cmd.Flags().GetString(FlagVetoAddress)
+			if err != nil {
+				return err
+			}
+
+			maxExecutionAttempts, err := cmd.Flags().GetUint32(FlagMaxExecutionAttempts)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgUpdateGroupPolicyExecutionSettings{
+				Admin:              clientCtx.GetFromAddress().String(),
+				GroupPolicyAddress: args[1],
+			}
+			if timelock != 0 || vetoAddress != "" || maxExecutionAttempts != 0 {
+				msg.ExecutionSettings = &group.ExecutionSettings{
+					Timelock:             timelock,
+					VetoAddress:          vetoAddress,
+					MaxExecutionAttempts: maxExecutionAttempts,
+				}
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagTimelock, time.Duration(0), "Duration after acceptance during which a proposal can't be executed")
+	cmd.Flags().String(FlagVetoAddress, "", "Address allowed to veto the proposals of the group policy")
+	cmd.Flags().Uint32(FlagMaxExecutionAttempts, 0, "Maximum number of execution attempts of a proposal, 0 for unlimited")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgSubmitProposalCmd creates a CLI command for Msg/SubmitProposal.
 func MsgSubmitProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -690,6 +780,52 @@ Parameters:
 	return cmd
 }
 
+// MsgVetoProposalCmd creates a CLI command for Msg/VetoProposal.
+func MsgVetoProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "veto-proposal [proposal-id] [vetoer]",
+		Short: "Veto a proposal before its execution",
+		Long: `Veto a proposal before its execution.
+
+Parameters:
+			proposal-id: unique ID of the proposal.
+			vetoer: veto address of the group policy of the proposal.
+			Note: --from flag will be ignored here.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgVetoProposal{
+				ProposalId: proposalID,
+				Vetoer:     clientCtx.GetFromAddress().String(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // MsgVoteCmd creates a CLI command for Msg/Vote.
 func MsgVoteCmd() *cobra.Command {
 	cmd := &cobra.Command{
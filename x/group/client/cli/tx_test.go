@@ -1796,3 +1796,74 @@ func (s *CLITestSuite) TestTxWithdrawProposal() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestTxVetoProposal() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	cmd := groupcli.MsgVetoProposalCmd()
+	cmd.SetOutput(io.Discard)
+
+	testCases := []struct {
+		name         string
+		ctxGen       func() client.Context
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			"correct data",
+			func() client.Context {
+				bz, _ := s.encCfg.Codec.Marshal(&sdk.TxResponse{})
+				c := clitestutil.NewMockTendermintRPC(abci.ResponseQuery{
+					Value: bz,
+				})
+				return s.baseCtx.WithClient(c)
+			},
+			append(
+				[]string{
+					"1",
+					accounts[0].Address.String(),
+				},
+				s.commonFlags...,
+			),
+			fmt.Sprintf("%s %s", "1", accounts[0].Address.String()),
+		},
+		{
+			"wrong vetoer",
+			func() client.Context {
+				bz, _ := s.encCfg.Codec.Marshal(&sdk.TxResponse{})
+				c := clitestutil.NewMockTendermintRPC(abci.ResponseQuery{
+					Value: bz,
+				})
+				return s.baseCtx.WithClient(c)
+			},
+			append(
+				[]string{
+					"2",
+					"wrongVetoer",
+				},
+				s.commonFlags...,
+			),
+			fmt.Sprintf("%s %s", "2", "wrongVetoer"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			var outBuf bytes.Buffer
+
+			clientCtx := tc.ctxGen().WithOutput(&outBuf)
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(clientCtx, cmd))
+
+			if len(tc.args) != 0 {
+				s.Require().Contains(fmt.Sprint(cmd), tc.expCmdOutput)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ func RegisterLegacyAminoCodec(cdc *codectypes.LegacyAmino) {
 	cdc.RegisterInterface((*DecisionPolicy)(nil), nil)
 	cdc.RegisterConcrete(&ThresholdDecisionPolicy{}, "cosmos-sdk/ThresholdDecisionPolicy", nil)
 	cdc.RegisterConcrete(&PercentageDecisionPolicy{}, "cosmos-sdk/PercentageDecisionPolicy", nil)
+	cdc.RegisterConcrete(&TokenWeightedDecisionPolicy{}, "cosmos-sdk/TokenWeightedDecisionPolicy", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgCreateGroup{}, "cosmos-sdk/MsgCreateGroup")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupMembers{}, "cosmos-sdk/MsgUpdateGroupMembers")
@@ -33,6 +34,8 @@ func RegisterLegacyAminoCodec(cdc *codectypes.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/group/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/group/MsgExec")
 	legacy.RegisterAminoMsg(cdc, &MsgLeaveGroup{}, "cosmos-sdk/group/MsgLeaveGroup")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateGroupPolicyExecutionSettings{}, "cosmos-sdk/MsgUpdateGroupExecSettings")
+	legacy.RegisterAminoMsg(cdc, &MsgVetoProposal{}, "cosmos-sdk/group/MsgVetoProposal")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -52,6 +55,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgVote{},
 		&MsgExec{},
 		&MsgLeaveGroup{},
+		&MsgUpdateGroupPolicyExecutionSettings{},
+		&MsgVetoProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -61,6 +66,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*DecisionPolicy)(nil),
 		&ThresholdDecisionPolicy{},
 		&PercentageDecisionPolicy{},
+		&TokenWeightedDecisionPolicy{},
 	)
 }
 
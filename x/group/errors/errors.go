@@ -16,4 +16,5 @@ var (
 	ErrUnauthorized = errors.Register(groupCodespace, 7, "unauthorized")
 	ErrModified     = errors.Register(groupCodespace, 8, "modified")
 	ErrExpired      = errors.Register(groupCodespace, 9, "expired")
+	ErrTimelocked   = errors.Register(groupCodespace, 10, "timelocked")
 )
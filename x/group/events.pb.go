@@ -299,6 +299,52 @@ func (m *EventWithdrawProposal) GetProposalId() uint64 {
 	return 0
 }
 
+// EventVetoProposal is an event emitted when a proposal is vetoed.
+type EventVetoProposal struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *EventVetoProposal) Reset()         { *m = EventVetoProposal{} }
+func (m *EventVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*EventVetoProposal) ProtoMessage()    {}
+func (*EventVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8d753981546f032, []int{6}
+}
+func (m *EventVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVetoProposal.Merge(m, src)
+}
+func (m *EventVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVetoProposal proto.InternalMessageInfo
+
+func (m *EventVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
 // EventVote is an event emitted when a voter votes on a proposal.
 type EventVote struct {
 	// proposal_id is the unique ID of the proposal.
@@ -309,7 +355,7 @@ func (m *EventVote) Reset()         { *m = EventVote{} }
 func (m *EventVote) String() string { return proto.CompactTextString(m) }
 func (*EventVote) ProtoMessage()    {}
 func (*EventVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8d753981546f032, []int{6}
+	return fileDescriptor_e8d753981546f032, []int{7}
 }
 func (m *EventVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +405,7 @@ func (m *EventExec) Reset()         { *m = EventExec{} }
 func (m *EventExec) String() string { return proto.CompactTextString(m) }
 func (*EventExec) ProtoMessage()    {}
 func (*EventExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8d753981546f032, []int{7}
+	return fileDescriptor_e8d753981546f032, []int{8}
 }
 func (m *EventExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -421,7 +467,7 @@ func (m *EventLeaveGroup) Reset()         { *m = EventLeaveGroup{} }
 func (m *EventLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*EventLeaveGroup) ProtoMessage()    {}
 func (*EventLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8d753981546f032, []int{8}
+	return fileDescriptor_e8d753981546f032, []int{9}
 }
 func (m *EventLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +514,7 @@ func (m *EventLeaveGroup) GetAddress() string {
 type EventProposalPruned struct {
 	// proposal_id is the unique ID of the proposal.
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
-	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN).
+	// status is the proposal status (UNSPECIFIED, SUBMITTED, ACCEPTED, REJECTED, ABORTED, WITHDRAWN, VETOED).
 	Status ProposalStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.group.v1.ProposalStatus" json:"status,omitempty"`
 	// tally_result is the proposal tally result (when applicable).
 	TallyResult *TallyResult `protobuf:"bytes,3,opt,name=tally_result,json=tallyResult,proto3" json:"tally_result,omitempty"`
@@ -478,7 +524,7 @@ func (m *EventProposalPruned) Reset()         { *m = EventProposalPruned{} }
 func (m *EventProposalPruned) String() string { return proto.CompactTextString(m) }
 func (*EventProposalPruned) ProtoMessage()    {}
 func (*EventProposalPruned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e8d753981546f032, []int{9}
+	return fileDescriptor_e8d753981546f032, []int{10}
 }
 func (m *EventProposalPruned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,6 +581,7 @@ func init() {
 	proto.RegisterType((*EventUpdateGroupPolicy)(nil), "cosmos.group.v1.EventUpdateGroupPolicy")
 	proto.RegisterType((*EventSubmitProposal)(nil), "cosmos.group.v1.EventSubmitProposal")
 	proto.RegisterType((*EventWithdrawProposal)(nil), "cosmos.group.v1.EventWithdrawProposal")
+	proto.RegisterType((*EventVetoProposal)(nil), "cosmos.group.v1.EventVetoProposal")
 	proto.RegisterType((*EventVote)(nil), "cosmos.group.v1.EventVote")
 	proto.RegisterType((*EventExec)(nil), "cosmos.group.v1.EventExec")
 	proto.RegisterType((*EventLeaveGroup)(nil), "cosmos.group.v1.EventLeaveGroup")
@@ -544,35 +591,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/events.proto", fileDescriptor_e8d753981546f032) }
 
 var fileDescriptor_e8d753981546f032 = []byte{
-	// 442 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0xef, 0xd2, 0x30,
-	0x18, 0xc7, 0xe9, 0x4f, 0x02, 0x52, 0x8c, 0x98, 0xfa, 0x27, 0x03, 0xc9, 0x20, 0xc4, 0x44, 0x0e,
-	0xb2, 0x05, 0x4c, 0xd4, 0x93, 0x44, 0x0c, 0x31, 0x24, 0x1c, 0xc8, 0xf0, 0x4f, 0xe2, 0x05, 0xc7,
-	0xda, 0x8c, 0xc5, 0x41, 0x97, 0xb6, 0x9b, 0x70, 0xf4, 0x1d, 0xf8, 0x52, 0x3c, 0xf8, 0x22, 0x3c,
-	0x12, 0x4f, 0x1e, 0x0d, 0xbc, 0x11, 0xb3, 0xae, 0x03, 0x82, 0x31, 0x23, 0xf9, 0x9d, 0x68, 0xfb,
-	0xfd, 0x7c, 0xbf, 0x3c, 0x4f, 0x9f, 0x15, 0xd6, 0x1d, 0xca, 0x97, 0x94, 0x9b, 0x2e, 0xa3, 0x61,
-	0x60, 0x46, 0x5d, 0x93, 0x44, 0x64, 0x25, 0xb8, 0x11, 0x30, 0x2a, 0x28, 0xaa, 0x24, 0xaa, 0x21,
-	0x55, 0x23, 0xea, 0xd6, 0xaa, 0xc9, 0xc1, 0x4c, 0xca, 0xa6, 0x52, 0xe5, 0xa6, 0xf6, 0xf0, 0x3c,
-	0x49, 0x6c, 0x02, 0xa2, 0xc4, 0x56, 0x07, 0xde, 0x19, 0xc6, 0xc1, 0xaf, 0x19, 0xb1, 0x05, 0x79,
-	0x13, 0x23, 0xa8, 0x0a, 0x6f, 0x4a, 0x76, 0xe6, 0x61, 0x0d, 0x34, 0x41, 0x3b, 0x6f, 0x15, 0xe5,
-	0x7e, 0x84, 0x0f, 0xf8, 0xbb, 0x00, 0x5f, 0x82, 0x8f, 0xe1, 0x83, 0xf3, 0xf4, 0x09, 0xf5, 0x3d,
-	0x67, 0x83, 0x7a, 0xb0, 0x68, 0x63, 0xcc, 0x08, 0xe7, 0xd2, 0x53, 0x1a, 0x68, 0xbf, 0x7e, 0x74,
-	0xee, 0xa9, 0xba, 0x5f, 0x25, 0xca, 0x54, 0x30, 0x6f, 0xe5, 0x5a, 0x29, 0x78, 0x48, 0x3b, 0xf9,
-	0xf3, 0x6b, 0xa4, 0x3d, 0x83, 0x77, 0x65, 0xda, 0x34, 0x9c, 0x2f, 0x3d, 0x31, 0x61, 0x34, 0xa0,
-	0xdc, 0xf6, 0x51, 0x03, 0x96, 0x03, 0xb5, 0x3e, 0x36, 0x04, 0xd3, 0xa3, 0x11, 0x6e, 0xbd, 0x80,
-	0xf7, 0xa5, 0xef, 0x83, 0x27, 0x16, 0x98, 0xd9, 0x5f, 0x2e, 0x77, 0x3e, 0x81, 0x25, 0xe9, 0x7c,
-	0x4f, 0x05, 0xc9, 0xa6, 0xbf, 0x02, 0x85, 0x0f, 0xd7, 0xc4, 0xc9, 0xc4, 0x51, 0x1f, 0x16, 0x18,
-	0xe1, 0xa1, 0x2f, 0xb4, 0xab, 0x26, 0x68, 0xdf, 0xee, 0x3d, 0x36, 0xce, 0x3e, 0x11, 0x23, 0x2d,
-	0x34, 0xce, 0x0b, 0x05, 0x65, 0x96, 0xc4, 0x2d, 0x65, 0x43, 0x08, 0xe6, 0x7d, 0xea, 0x72, 0xed,
-	0x46, 0x7c, 0x81, 0x96, 0x5c, 0xb7, 0x3e, 0xc1, 0x8a, 0x2c, 0x61, 0x4c, 0xec, 0x28, 0x73, 0xda,
-	0xa7, 0x53, 0xb8, 0xba, 0x74, 0x0a, 0xdf, 0x81, 0x1a, 0x43, 0x5a, 0xdd, 0x84, 0x85, 0x2b, 0x82,
-	0xb3, 0xfb, 0x7d, 0x0e, 0x0b, 0x5c, 0xd8, 0x22, 0xe4, 0xaa, 0xdf, 0xc6, 0x7f, 0xfb, 0x9d, 0x4a,
-	0xcc, 0x52, 0x38, 0xea, 0xc3, 0x5b, 0xc2, 0xf6, 0xfd, 0xcd, 0x4c, 0x5d, 0x57, 0xdc, 0x6f, 0xb9,
-	0x57, 0xff, 0xc7, 0xfe, 0x36, 0x86, 0xd4, 0x1d, 0x95, 0xc5, 0x71, 0x33, 0x78, 0xf9, 0x73, 0xa7,
-	0x83, 0xed, 0x4e, 0x07, 0x7f, 0x76, 0x3a, 0xf8, 0xb6, 0xd7, 0x73, 0xdb, 0xbd, 0x9e, 0xfb, 0xbd,
-	0xd7, 0x73, 0x1f, 0x1f, 0xb9, 0x9e, 0x58, 0x84, 0x73, 0xc3, 0xa1, 0x4b, 0xf5, 0x04, 0xd5, 0x4f,
-	0x87, 0xe3, 0xcf, 0xe6, 0x3a, 0x79, 0x81, 0xf3, 0x82, 0x7c, 0x79, 0x4f, 0xff, 0x06, 0x00, 0x00,
-	0xff, 0xff, 0xa5, 0x1a, 0x1c, 0xb9, 0xe2, 0x03, 0x00, 0x00,
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0x33, 0xa5, 0x4a, 0xe9, 0x09, 0xa2, 0x30, 0x5c, 0xe4, 0x96, 0xca, 0xad, 0x22, 0x24,
+	0xba, 0x20, 0xb6, 0x1a, 0x10, 0xb0, 0xa2, 0xa2, 0xa8, 0x42, 0x95, 0xba, 0x88, 0x1c, 0x2e, 0x12,
+	0x9b, 0xe0, 0x78, 0x46, 0xae, 0x85, 0x93, 0xb1, 0x66, 0x8e, 0x4d, 0xb3, 0xe4, 0x0d, 0x78, 0x14,
+	0x16, 0x3c, 0x04, 0xcb, 0x8a, 0x15, 0x4b, 0x94, 0xbc, 0x08, 0xf2, 0x78, 0x9c, 0x44, 0x41, 0xc8,
+	0x96, 0xba, 0xf2, 0xcc, 0xfc, 0xdf, 0xff, 0xfb, 0x9c, 0xb9, 0xc0, 0x6e, 0x20, 0xd4, 0x48, 0x28,
+	0x37, 0x94, 0x22, 0x4d, 0xdc, 0xec, 0xd0, 0xe5, 0x19, 0x1f, 0xa3, 0x72, 0x12, 0x29, 0x50, 0xd0,
+	0xad, 0x42, 0x75, 0xb4, 0xea, 0x64, 0x87, 0x3b, 0xdb, 0xc5, 0xc2, 0x40, 0xcb, 0xae, 0x51, 0xf5,
+	0x64, 0xe7, 0xc1, 0x6a, 0x12, 0x4e, 0x12, 0x6e, 0xc4, 0x76, 0x07, 0x6e, 0x9d, 0xe4, 0xc1, 0xaf,
+	0x25, 0xf7, 0x91, 0xbf, 0xc9, 0x11, 0xba, 0x0d, 0xd7, 0x35, 0x3b, 0x88, 0x98, 0x45, 0xf6, 0xc9,
+	0xc1, 0xba, 0xb7, 0xa1, 0xe7, 0xa7, 0x6c, 0x8e, 0xbf, 0x4b, 0x58, 0x1d, 0xfc, 0x0c, 0xee, 0xaf,
+	0xa6, 0xf7, 0x44, 0x1c, 0x05, 0x13, 0xda, 0x85, 0x0d, 0x9f, 0x31, 0xc9, 0x95, 0xd2, 0x9e, 0xcd,
+	0x63, 0xeb, 0xd7, 0x8f, 0xce, 0x5d, 0x53, 0xf7, 0xab, 0x42, 0xe9, 0xa3, 0x8c, 0xc6, 0xa1, 0x57,
+	0x82, 0xf3, 0xb4, 0xa5, 0x9f, 0x5f, 0x21, 0xed, 0x19, 0xdc, 0xd1, 0x69, 0xfd, 0x74, 0x38, 0x8a,
+	0xb0, 0x27, 0x45, 0x22, 0x94, 0x1f, 0xd3, 0x3d, 0x68, 0x25, 0x66, 0xbc, 0x68, 0x08, 0xca, 0xa5,
+	0x53, 0xd6, 0x7e, 0x01, 0xf7, 0xb4, 0xef, 0x43, 0x84, 0xe7, 0x4c, 0xfa, 0x5f, 0xea, 0x3b, 0x9f,
+	0xc2, 0x6d, 0xed, 0x7c, 0xcf, 0x51, 0xd4, 0x77, 0x3d, 0x86, 0xcd, 0xc2, 0x25, 0x90, 0x57, 0xd3,
+	0x5f, 0x89, 0xc1, 0x4f, 0x2e, 0x78, 0x50, 0x89, 0xd3, 0x23, 0x68, 0x4a, 0xae, 0xd2, 0x18, 0xad,
+	0xb5, 0x7d, 0x72, 0x70, 0xb3, 0xfb, 0xc8, 0x59, 0xb9, 0x58, 0x4e, 0x59, 0x68, 0x9e, 0x97, 0xa2,
+	0x90, 0x9e, 0xc6, 0x3d, 0x63, 0xa3, 0x14, 0xd6, 0x63, 0x11, 0x2a, 0xeb, 0x5a, 0xbe, 0xed, 0x9e,
+	0x1e, 0xb7, 0x3f, 0xc1, 0x96, 0x2e, 0xe1, 0x8c, 0xfb, 0x59, 0xe5, 0x1d, 0x59, 0x3e, 0xbb, 0xb5,
+	0xba, 0x67, 0xf7, 0x9d, 0x98, 0xc3, 0x2b, 0xab, 0xeb, 0xc9, 0x74, 0xcc, 0x59, 0x75, 0xbf, 0xcf,
+	0xa1, 0xa9, 0xd0, 0xc7, 0x54, 0x99, 0x7e, 0xf7, 0xfe, 0xdb, 0x6f, 0x5f, 0x63, 0x9e, 0xc1, 0xe9,
+	0x11, 0xdc, 0x40, 0x3f, 0x8e, 0x27, 0x03, 0xb3, 0x5d, 0x79, 0xbf, 0xad, 0xee, 0xee, 0x3f, 0xf6,
+	0xb7, 0x39, 0x64, 0xf6, 0xa8, 0x85, 0x8b, 0xc9, 0xf1, 0xcb, 0x9f, 0x53, 0x9b, 0x5c, 0x4e, 0x6d,
+	0xf2, 0x67, 0x6a, 0x93, 0x6f, 0x33, 0xbb, 0x71, 0x39, 0xb3, 0x1b, 0xbf, 0x67, 0x76, 0xe3, 0xe3,
+	0xc3, 0x30, 0xc2, 0xf3, 0x74, 0xe8, 0x04, 0x62, 0x64, 0x1e, 0xae, 0xf9, 0x74, 0x14, 0xfb, 0xec,
+	0x5e, 0x14, 0xef, 0x76, 0xd8, 0xd4, 0xef, 0xf5, 0xc9, 0xdf, 0x01, 0x00, 0x29, 0x4a, 0xdf, 0xad,
+	0x18, 0x04, 0x00, 0x00,
 }
 
 func (m *EventCreateGroup) Marshal() (dAtA []byte, err error) {
@@ -747,6 +795,34 @@ func (m *EventWithdrawProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventVote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -980,6 +1056,18 @@ func (m *EventWithdrawProposal) Size() (n int) {
 	return n
 }
 
+func (m *EventVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvents(uint64(m.ProposalId))
+	}
+	return n
+}
+
 func (m *EventVote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1492,6 +1580,75 @@ func (m *EventWithdrawProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package group
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -21,4 +23,11 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
+// StakingKeeper defines the expected interface needed to retrieve the bonded
+// amount of delegators.
+type StakingKeeper interface {
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int
 }
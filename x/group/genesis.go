@@ -77,6 +77,30 @@ func (s GenesisState) Validate() error {
 			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", v.ProposalId))
 		}
 	}
+
+	for _, snapshot := range s.VotingPowerSnapshots {
+
+		if err := snapshot.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "VotingPowerSnapshot validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[snapshot.ProposalId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", snapshot.ProposalId))
+		}
+	}
+
+	for _, l := range s.ExecutionLogs {
+
+		if err := l.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "ExecutionLog validation failed")
+		}
+
+		// check that proposal exists
+		if _, exists := proposals[l.ProposalId]; !exists {
+			return sdkerrors.Wrap(sdkerrors.ErrNotFound, fmt.Sprintf("proposal with ProposalId %d doesn't exist", l.ProposalId))
+		}
+	}
 	return nil
 }
 
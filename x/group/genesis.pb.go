@@ -43,6 +43,11 @@ type GenesisState struct {
 	Proposals []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	// votes is the list of votes.
 	Votes []*Vote `protobuf:"bytes,8,rep,name=votes,proto3" json:"votes,omitempty"`
+	// voting_power_snapshots is the list of voting power snapshots of proposals
+	// with a token weighted decision policy.
+	VotingPowerSnapshots []*VotingPowerSnapshot `protobuf:"bytes,9,rep,name=voting_power_snapshots,json=votingPowerSnapshots,proto3" json:"voting_power_snapshots,omitempty"`
+	// execution_logs is the list of execution logs of proposals.
+	ExecutionLogs []*ExecutionLog `protobuf:"bytes,10,rep,name=execution_logs,json=executionLogs,proto3" json:"execution_logs,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +139,20 @@ func (m *GenesisState) GetVotes() []*Vote {
 	return nil
 }
 
+func (m *GenesisState) GetVotingPowerSnapshots() []*VotingPowerSnapshot {
+	if m != nil {
+		return m.VotingPowerSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutionLogs() []*ExecutionLog {
+	if m != nil {
+		return m.ExecutionLogs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.group.v1.GenesisState")
 }
@@ -141,29 +160,33 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/genesis.proto", fileDescriptor_cc6105fe3ef99f06) }
 
 var fileDescriptor_cc6105fe3ef99f06 = []byte{
-	// 341 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4e, 0xfa, 0x40,
-	0x10, 0xc7, 0xe9, 0x8f, 0x3f, 0x3f, 0x58, 0xfe, 0x68, 0x36, 0x31, 0xa9, 0xa0, 0x0d, 0x1a, 0x0f,
-	0x24, 0xc6, 0x36, 0xe0, 0xc1, 0x9b, 0x89, 0x5e, 0x88, 0x07, 0x13, 0x52, 0x12, 0x0f, 0x5e, 0x0c,
-	0xe0, 0x58, 0x1b, 0x29, 0x53, 0x3a, 0x0b, 0x91, 0xb7, 0xf0, 0x09, 0x7c, 0x1e, 0x8f, 0x1c, 0x3d,
-	0x1a, 0x78, 0x11, 0xc3, 0x6c, 0x49, 0x0d, 0x70, 0xda, 0xdd, 0xd9, 0xcf, 0x77, 0x3e, 0x93, 0x8c,
-	0x38, 0x1e, 0x20, 0x05, 0x48, 0x8e, 0x17, 0xe1, 0x24, 0x74, 0xa6, 0x4d, 0xc7, 0x83, 0x11, 0x90,
-	0x4f, 0x76, 0x18, 0xa1, 0x42, 0xb9, 0xa7, 0xbf, 0x6d, 0xfe, 0xb6, 0xa7, 0xcd, 0x6a, 0x6d, 0x93,
-	0x57, 0xb3, 0x10, 0x62, 0xfa, 0xf4, 0x33, 0x2d, 0x4a, 0x6d, 0x9d, 0xef, 0xaa, 0x9e, 0x02, 0x59,
-	0x13, 0x05, 0x06, 0x9f, 0x08, 0xc6, 0xa6, 0x51, 0x37, 0x1a, 0x19, 0x37, 0xcf, 0x85, 0x2e, 0x8c,
-	0x65, 0x4b, 0xe4, 0xf8, 0x4e, 0xe6, 0xbf, 0x7a, 0xba, 0x51, 0x6c, 0x55, 0xed, 0x0d, 0x99, 0xdd,
-	0x5e, 0x5d, 0xee, 0x46, 0x2f, 0xe8, 0xc6, 0xa4, 0xbc, 0x11, 0x65, 0xdd, 0x30, 0x80, 0xa0, 0x0f,
-	0x11, 0x99, 0x69, 0x8e, 0x1e, 0xed, 0x8e, 0xde, 0x33, 0xe4, 0x96, 0xbc, 0xe4, 0x41, 0xb2, 0x21,
-	0xf6, 0x75, 0x8b, 0x10, 0x87, 0xfe, 0x60, 0xc6, 0xa3, 0x65, 0x78, 0xb4, 0x0a, 0xd7, 0x3b, 0x5c,
-	0x5e, 0x0d, 0xd8, 0x16, 0x95, 0x3f, 0xa4, 0x0f, 0x64, 0x66, 0xd9, 0x56, 0xdf, 0x6d, 0xd3, 0x41,
-	0x1e, 0xb7, 0x9c, 0x74, 0xf2, 0x81, 0xe4, 0x89, 0x28, 0x85, 0x11, 0x86, 0x48, 0xbd, 0x21, 0xeb,
-	0x72, 0xac, 0x2b, 0xae, 0x6b, 0x2b, 0xd7, 0x95, 0x28, 0xac, 0x9f, 0x64, 0xfe, 0x67, 0xcd, 0xe1,
-	0x96, 0xa6, 0x13, 0x13, 0x6e, 0xc2, 0xca, 0x73, 0x91, 0x9d, 0xa2, 0x02, 0x32, 0xf3, 0x1c, 0x3a,
-	0xd8, 0x0a, 0x3d, 0xa0, 0x02, 0x57, 0x33, 0xb7, 0xd7, 0x5f, 0x0b, 0xcb, 0x98, 0x2f, 0x2c, 0xe3,
-	0x67, 0x61, 0x19, 0x1f, 0x4b, 0x2b, 0x35, 0x5f, 0x5a, 0xa9, 0xef, 0xa5, 0x95, 0x7a, 0x3c, 0xf3,
-	0x7c, 0xf5, 0x3a, 0xe9, 0xdb, 0x03, 0x0c, 0x9c, 0x78, 0xc5, 0xfa, 0xb8, 0xa0, 0xe7, 0x37, 0xe7,
-	0x5d, 0xef, 0xbb, 0x9f, 0xe3, 0x3d, 0x5f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x99, 0x5b, 0x30,
-	0xc4, 0x36, 0x02, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x6f, 0xd3, 0x40,
+	0x10, 0x85, 0x63, 0x9a, 0x86, 0x66, 0x9b, 0x04, 0xb4, 0x02, 0xb4, 0xb4, 0xd4, 0x0a, 0xa8, 0x87,
+	0x48, 0x08, 0x5b, 0x2d, 0x07, 0x6e, 0x48, 0x20, 0x50, 0x84, 0x04, 0x52, 0xe4, 0x48, 0x1c, 0x7a,
+	0x89, 0x92, 0x30, 0x6c, 0x57, 0x24, 0x9e, 0xad, 0x67, 0x63, 0xda, 0x7f, 0xc1, 0x4f, 0xe2, 0xc8,
+	0xb1, 0x47, 0x8e, 0x28, 0xf9, 0x23, 0xc8, 0xb3, 0x8e, 0x52, 0xc5, 0x39, 0xd9, 0xf3, 0xf6, 0x7b,
+	0xef, 0xcd, 0x61, 0xc4, 0xc9, 0x14, 0x69, 0x8e, 0x14, 0xeb, 0x0c, 0x17, 0x36, 0xce, 0xcf, 0x62,
+	0x0d, 0x29, 0x90, 0xa1, 0xc8, 0x66, 0xe8, 0x50, 0x3e, 0xf0, 0xcf, 0x11, 0x3f, 0x47, 0xf9, 0xd9,
+	0xd1, 0xf1, 0x36, 0xef, 0x6e, 0x2c, 0x94, 0xf4, 0x8b, 0xdf, 0x75, 0xd1, 0xea, 0x7b, 0xff, 0xd0,
+	0x8d, 0x1d, 0xc8, 0x63, 0xd1, 0x64, 0x70, 0x44, 0x70, 0xa5, 0x82, 0x6e, 0xd0, 0xab, 0x27, 0x07,
+	0x2c, 0x0c, 0xe1, 0x4a, 0x9e, 0x8b, 0x06, 0xff, 0x93, 0xba, 0xd7, 0xdd, 0xeb, 0x1d, 0x9e, 0x1f,
+	0x45, 0x5b, 0x65, 0x51, 0xbf, 0xf8, 0xf9, 0x94, 0x7e, 0xc7, 0xa4, 0x24, 0xe5, 0x3b, 0xd1, 0xf6,
+	0x81, 0x73, 0x98, 0x4f, 0x20, 0x23, 0xb5, 0xc7, 0xd6, 0x67, 0xbb, 0xad, 0x5f, 0x18, 0x4a, 0x5a,
+	0x7a, 0x33, 0x90, 0xec, 0x89, 0x87, 0x3e, 0xc2, 0xe2, 0xcc, 0x4c, 0x6f, 0x78, 0xb5, 0x3a, 0xaf,
+	0xd6, 0x61, 0x7d, 0xc0, 0x72, 0xb1, 0x60, 0x5f, 0x74, 0xee, 0x90, 0x06, 0x48, 0xed, 0x73, 0x5b,
+	0x77, 0x77, 0x9b, 0x37, 0xf2, 0xba, 0xed, 0x4d, 0x92, 0x01, 0x92, 0xcf, 0x45, 0xcb, 0x66, 0x68,
+	0x91, 0xc6, 0x33, 0xae, 0x6b, 0x70, 0xdd, 0xe1, 0x5a, 0x2b, 0xba, 0xde, 0x88, 0xe6, 0x7a, 0x24,
+	0x75, 0x9f, 0x6b, 0x9e, 0x56, 0x6a, 0x06, 0x25, 0x91, 0x6c, 0x58, 0xf9, 0x52, 0xec, 0xe7, 0xe8,
+	0x80, 0xd4, 0x01, 0x9b, 0x1e, 0x57, 0x4c, 0x5f, 0xd1, 0x41, 0xe2, 0x19, 0x79, 0x21, 0x9e, 0xe4,
+	0xe8, 0x4c, 0xaa, 0x47, 0x16, 0x7f, 0x42, 0x36, 0xa2, 0x74, 0x6c, 0xe9, 0x12, 0x1d, 0xa9, 0x26,
+	0xbb, 0x4f, 0x77, 0xb9, 0x4d, 0xaa, 0x07, 0x05, 0x3d, 0x2c, 0xe1, 0xe4, 0x51, 0x5e, 0x15, 0x49,
+	0x7e, 0x10, 0x1d, 0xb8, 0x86, 0xe9, 0xc2, 0x19, 0x4c, 0x47, 0x33, 0xd4, 0xa4, 0x04, 0x67, 0x9e,
+	0x54, 0x32, 0x3f, 0xae, 0xb1, 0xcf, 0xa8, 0x93, 0x36, 0xdc, 0x99, 0xe8, 0xfd, 0xdb, 0x3f, 0xcb,
+	0x30, 0xb8, 0x5d, 0x86, 0xc1, 0xbf, 0x65, 0x18, 0xfc, 0x5a, 0x85, 0xb5, 0xdb, 0x55, 0x58, 0xfb,
+	0xbb, 0x0a, 0x6b, 0x17, 0xa7, 0xda, 0xb8, 0xcb, 0xc5, 0x24, 0x9a, 0xe2, 0x3c, 0x2e, 0x8f, 0xd0,
+	0x7f, 0x5e, 0xd1, 0xb7, 0x1f, 0xf1, 0xb5, 0xbf, 0xc8, 0x49, 0x83, 0x2f, 0xf1, 0xf5, 0xff, 0x01,
+	0x00, 0x04, 0x1d, 0x21, 0x5c, 0xd8, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +209,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionLogs) > 0 {
+		for iNdEx := len(m.ExecutionLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for iNdEx := len(m.VotingPowerSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Votes) > 0 {
 		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -330,6 +381,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VotingPowerSnapshots) > 0 {
+		for _, e := range m.VotingPowerSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutionLogs) > 0 {
+		for _, e := range m.ExecutionLogs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +658,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerSnapshots = append(m.VotingPowerSnapshots, &VotingPowerSnapshot{})
+			if err := m.VotingPowerSnapshots[len(m.VotingPowerSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionLogs = append(m.ExecutionLogs, &ExecutionLog{})
+			if err := m.ExecutionLogs[len(m.ExecutionLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
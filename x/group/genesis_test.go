@@ -732,6 +732,90 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid voting power snapshot",
+			GenesisState{
+				Groups:        []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "1"}},
+				GroupPolicies: []*GroupPolicyInfo{groupPolicy},
+				Proposals:     []*Proposal{proposal},
+				VotingPowerSnapshots: []*VotingPowerSnapshot{
+					{ProposalId: proposal.Id, Members: []MemberPower{{Address: memberAddr.String(), Power: "100"}}, TotalPower: "100"},
+				},
+			},
+			false,
+		},
+		{
+			"voting power snapshot proposal doesn't exist",
+			GenesisState{
+				Groups:        []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "1"}},
+				GroupPolicies: []*GroupPolicyInfo{groupPolicy},
+				Proposals:     []*Proposal{proposal},
+				VotingPowerSnapshots: []*VotingPowerSnapshot{
+					{ProposalId: 2, Members: []MemberPower{{Address: memberAddr.String(), Power: "100"}}, TotalPower: "100"},
+				},
+			},
+			true,
+		},
+		{
+			"invalid voting power snapshot total power",
+			GenesisState{
+				Groups:        []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "1"}},
+				GroupPolicies: []*GroupPolicyInfo{groupPolicy},
+				Proposals:     []*Proposal{proposal},
+				VotingPowerSnapshots: []*VotingPowerSnapshot{
+					{ProposalId: proposal.Id, Members: []MemberPower{{Address: memberAddr.String(), Power: "100"}}, TotalPower: "50"},
+				},
+			},
+			true,
+		},
+		{
+			"valid execution log",
+			GenesisState{
+				Groups:        []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "1"}},
+				GroupPolicies: []*GroupPolicyInfo{groupPolicy},
+				Proposals:     []*Proposal{proposal},
+				ExecutionLogs: []*ExecutionLog{
+					{ProposalId: proposal.Id, Attempt: 1, ExecutedAt: submittedAt, Result: PROPOSAL_EXECUTOR_RESULT_FAILURE, Logs: "error"},
+				},
+			},
+			false,
+		},
+		{
+			"execution log proposal doesn't exist",
+			GenesisState{
+				Groups:        []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "1"}},
+				GroupPolicies: []*GroupPolicyInfo{groupPolicy},
+				Proposals:     []*Proposal{proposal},
+				ExecutionLogs: []*ExecutionLog{
+					{ProposalId: 2, Attempt: 1, ExecutedAt: submittedAt, Result: PROPOSAL_EXECUTOR_RESULT_FAILURE},
+				},
+			},
+			true,
+		},
+		{
+			"invalid execution log attempt",
+			GenesisState{
+				Groups:        []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "1"}},
+				GroupPolicies: []*GroupPolicyInfo{groupPolicy},
+				Proposals:     []*Proposal{proposal},
+				ExecutionLogs: []*ExecutionLog{
+					{ProposalId: proposal.Id, ExecutedAt: submittedAt, Result: PROPOSAL_EXECUTOR_RESULT_FAILURE},
+				},
+			},
+			true,
+		},
+		{
+			"invalid execution log result",
+			GenesisState{
+				Groups:        []*GroupInfo{{Id: 1, Admin: accAddr.String(), Metadata: "1", Version: 1, TotalWeight: "1"}},
+				GroupPolicies: []*GroupPolicyInfo{groupPolicy},
+				Proposals:     []*Proposal{proposal},
+				ExecutionLogs: []*ExecutionLog{
+					{ProposalId: proposal.Id, Attempt: 1, ExecutedAt: submittedAt, Result: PROPOSAL_EXECUTOR_RESULT_NOT_RUN},
+				},
+			},
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -40,6 +40,14 @@ func (k Keeper) InitGenesis(ctx types.Context, cdc codec.JSONCodec, data json.Ra
 		panic(errors.Wrap(err, "votes"))
 	}
 
+	if err := k.votingPowerSnapshotTable.Import(ctx.KVStore(k.key), genesisState.VotingPowerSnapshots, 0); err != nil {
+		panic(errors.Wrap(err, "voting power snapshots"))
+	}
+
+	if err := k.executionLogTable.Import(ctx.KVStore(k.key), genesisState.ExecutionLogs, 0); err != nil {
+		panic(errors.Wrap(err, "execution logs"))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -86,5 +94,19 @@ func (k Keeper) ExportGenesis(ctx types.Context, _ codec.JSONCodec) *group.Genes
 	}
 	genesisState.Votes = votes
 
+	var snapshots []*group.VotingPowerSnapshot
+	_, err = k.votingPowerSnapshotTable.Export(ctx.KVStore(k.key), &snapshots)
+	if err != nil {
+		panic(errors.Wrap(err, "voting power snapshots"))
+	}
+	genesisState.VotingPowerSnapshots = snapshots
+
+	var executionLogs []*group.ExecutionLog
+	_, err = k.executionLogTable.Export(ctx.KVStore(k.key), &executionLogs)
+	if err != nil {
+		panic(errors.Wrap(err, "execution logs"))
+	}
+	genesisState.ExecutionLogs = executionLogs
+
 	return genesisState
 }
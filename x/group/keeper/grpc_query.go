@@ -380,3 +380,23 @@ func (k Keeper) Groups(goCtx context.Context, request *group.QueryGroupsRequest)
 		Pagination: pageRes,
 	}, nil
 }
+
+// ExecutionLogsByProposal queries the execution logs of a proposal.
+func (k Keeper) ExecutionLogsByProposal(goCtx context.Context, request *group.QueryExecutionLogsByProposalRequest) (*group.QueryExecutionLogsByProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	it, err := k.executionLogByProposalIndex.GetPaginated(ctx.KVStore(k.key), request.ProposalId, request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*group.ExecutionLog
+	pageRes, err := orm.Paginate(it, request.Pagination, &logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.QueryExecutionLogsByProposalResponse{
+		ExecutionLogs: logs,
+		Pagination:    pageRes,
+	}, nil
+}
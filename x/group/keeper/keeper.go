@@ -43,12 +43,21 @@ const (
 	VoteTablePrefix           byte = 0x40
 	VoteByProposalIndexPrefix byte = 0x41
 	VoteByVoterIndexPrefix    byte = 0x42
+
+	// Voting Power Snapshot Table
+	VotingPowerSnapshotTablePrefix byte = 0x50
+
+	// Execution Log Table
+	ExecutionLogTablePrefix           byte = 0x60
+	ExecutionLogByProposalIndexPrefix byte = 0x61
 )
 
 type Keeper struct {
 	key storetypes.StoreKey
 
-	accKeeper group.AccountKeeper
+	accKeeper     group.AccountKeeper
+	bankKeeper    group.BankKeeper
+	stakingKeeper group.StakingKeeper
 
 	// Group Table
 	groupTable        orm.AutoUInt64Table
@@ -75,17 +84,34 @@ type Keeper struct {
 	voteByProposalIndex orm.Index
 	voteByVoterIndex    orm.Index
 
+	// Voting Power Snapshot Table
+	votingPowerSnapshotTable orm.PrimaryKeyTable
+
+	// Execution Log Table
+	executionLogTable           orm.PrimaryKeyTable
+	executionLogByProposalIndex orm.Index
+
 	router *baseapp.MsgServiceRouter
 
 	config group.Config
 }
 
-// NewKeeper creates a new group keeper.
+// NewKeeper creates a new group keeper. Group policies with a token weighted
+// decision policy can't be used without the bank and staking keepers, see
+// NewKeeperWithKeepers.
 func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.MsgServiceRouter, accKeeper group.AccountKeeper, config group.Config) Keeper {
+	return NewKeeperWithKeepers(storeKey, cdc, router, accKeeper, nil, nil, config)
+}
+
+// NewKeeperWithKeepers creates a new group keeper along with the bank and
+// staking keepers reading the voting power of token weighted decision policies.
+func NewKeeperWithKeepers(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.MsgServiceRouter, accKeeper group.AccountKeeper, bankKeeper group.BankKeeper, stakingKeeper group.StakingKeeper, config group.Config) Keeper {
 	k := Keeper{
-		key:       storeKey,
-		router:    router,
-		accKeeper: accKeeper,
+		key:           storeKey,
+		router:        router,
+		accKeeper:     accKeeper,
+		bankKeeper:    bankKeeper,
+		stakingKeeper: stakingKeeper,
 	}
 
 	groupTable, err := orm.NewAutoUInt64Table([2]byte{GroupTablePrefix}, GroupTableSeqPrefix, &group.GroupInfo{}, cdc)
@@ -202,6 +228,26 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *baseapp.Ms
 	}
 	k.voteTable = *voteTable
 
+	// Voting Power Snapshot Table
+	votingPowerSnapshotTable, err := orm.NewPrimaryKeyTable([2]byte{VotingPowerSnapshotTablePrefix}, &group.VotingPowerSnapshot{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.votingPowerSnapshotTable = *votingPowerSnapshotTable
+
+	// Execution Log Table
+	executionLogTable, err := orm.NewPrimaryKeyTable([2]byte{ExecutionLogTablePrefix}, &group.ExecutionLog{}, cdc)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.executionLogByProposalIndex, err = orm.NewIndex(executionLogTable, ExecutionLogByProposalIndexPrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{value.(*group.ExecutionLog).ProposalId}, nil
+	}, group.ExecutionLog{}.ProposalId)
+	if err != nil {
+		panic(err.Error())
+	}
+	k.executionLogTable = *executionLogTable
+
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = group.DefaultConfig().MaxMetadataLen
 	}
@@ -270,6 +316,14 @@ func (k Keeper) pruneProposal(ctx sdk.Context, proposalID uint64) error {
 		return err
 	}
 
+	if err := k.pruneVotingPowerSnapshot(ctx, proposalID); err != nil {
+		return err
+	}
+
+	if err := k.pruneExecutionLogs(ctx, proposalID); err != nil {
+		return err
+	}
+
 	k.Logger(ctx).Debug(fmt.Sprintf("Pruned proposal %d", proposalID))
 	return nil
 }
@@ -338,6 +392,132 @@ func (k Keeper) pruneVotes(ctx sdk.Context, proposalID uint64) error {
 	return nil
 }
 
+// assertVotingPowerSource checks that the keeper reading the voting power of a
+// token weighted decision policy is set. Other decision policies are always
+// valid.
+func (k Keeper) assertVotingPowerSource(policy group.DecisionPolicy) error {
+	tokenPolicy, ok := policy.(*group.TokenWeightedDecisionPolicy)
+	if !ok {
+		return nil
+	}
+
+	switch {
+	case tokenPolicy.Source == group.VOTING_POWER_SOURCE_BANK && k.bankKeeper == nil:
+		return sdkerrors.Wrap(errors.ErrInvalid, "bank voting power source is not supported without a bank keeper")
+	case tokenPolicy.Source == group.VOTING_POWER_SOURCE_STAKING && k.stakingKeeper == nil:
+		return sdkerrors.Wrap(errors.ErrInvalid, "staking voting power source is not supported without a staking keeper")
+	}
+
+	return nil
+}
+
+// snapshotVotingPower stores the voting power of every member of the group
+// for the given proposal, as read from the source of the token weighted
+// decision policy.
+func (k Keeper) snapshotVotingPower(ctx sdk.Context, proposalID uint64, groupID uint64, policy *group.TokenWeightedDecisionPolicy) error {
+	if err := k.assertVotingPowerSource(policy); err != nil {
+		return err
+	}
+
+	it, err := k.groupMemberByGroupIndex.Get(ctx.KVStore(k.key), groupID)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	snapshot := group.VotingPowerSnapshot{ProposalId: proposalID}
+	totalPower := sdk.ZeroInt()
+	for {
+		var member group.GroupMember
+		_, err := it.LoadNext(&member)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		ctx.GasMeter().ConsumeGas(gasCostPerIteration, "snapshot voting power")
+		addr, err := sdk.AccAddressFromBech32(member.Member.Address)
+		if err != nil {
+			return err
+		}
+
+		var power sdk.Int
+		switch policy.Source {
+		case group.VOTING_POWER_SOURCE_BANK:
+			power = k.bankKeeper.GetBalance(ctx, addr, policy.Denom).Amount
+		case group.VOTING_POWER_SOURCE_STAKING:
+			power = k.stakingKeeper.GetDelegatorBonded(ctx, addr)
+		default:
+			return sdkerrors.Wrapf(errors.ErrInvalid, "invalid voting power source %s", policy.Source)
+		}
+
+		snapshot.Members = append(snapshot.Members, group.MemberPower{Address: member.Member.Address, Power: power.String()})
+		totalPower = totalPower.Add(power)
+	}
+	snapshot.TotalPower = totalPower.String()
+
+	return k.votingPowerSnapshotTable.Create(ctx.KVStore(k.key), &snapshot)
+}
+
+// getVotingPowerSnapshot returns the voting power snapshot of a proposal, and
+// false if the proposal doesn't have one.
+func (k Keeper) getVotingPowerSnapshot(ctx sdk.Context, proposalID uint64) (group.VotingPowerSnapshot, bool, error) {
+	var snapshot group.VotingPowerSnapshot
+	err := k.votingPowerSnapshotTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.VotingPowerSnapshot{ProposalId: proposalID}), &snapshot)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return group.VotingPowerSnapshot{}, false, nil
+	case err != nil:
+		return group.VotingPowerSnapshot{}, false, err
+	}
+
+	return snapshot, true, nil
+}
+
+// pruneVotingPowerSnapshot deletes the voting power snapshot of a proposal
+// from state, if any.
+func (k Keeper) pruneVotingPowerSnapshot(ctx sdk.Context, proposalID uint64) error {
+	snapshot := group.VotingPowerSnapshot{ProposalId: proposalID}
+	if !k.votingPowerSnapshotTable.Contains(ctx.KVStore(k.key), &snapshot) {
+		return nil
+	}
+
+	return k.votingPowerSnapshotTable.Delete(ctx.KVStore(k.key), &snapshot)
+}
+
+// pruneExecutionLogs prunes all execution logs for a proposal from state.
+func (k Keeper) pruneExecutionLogs(ctx sdk.Context, proposalID uint64) error {
+	it, err := k.executionLogByProposalIndex.Get(ctx.KVStore(k.key), proposalID)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var logs []group.ExecutionLog
+	for {
+		var log group.ExecutionLog
+		_, err = it.LoadNext(&log)
+		if errors.ErrORMIteratorDone.Is(err) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		logs = append(logs, log)
+	}
+
+	//nolint:gosec // "implicit memory aliasing in the for loop (because of the pointer on &l)"
+	for _, l := range logs {
+		if err := k.executionLogTable.Delete(ctx.KVStore(k.key), &l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // votesByProposal returns all votes for a given proposal.
 func (k Keeper) votesByProposal(ctx sdk.Context, proposalID uint64) ([]group.Vote, error) {
 	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), proposalID)
@@ -409,7 +589,7 @@ func (k Keeper) TallyProposalsAtVPEnd(ctx sdk.Context) error {
 		}
 
 		proposalID := proposal.Id
-		if proposal.Status == group.PROPOSAL_STATUS_ABORTED || proposal.Status == group.PROPOSAL_STATUS_WITHDRAWN {
+		if proposal.Status == group.PROPOSAL_STATUS_ABORTED || proposal.Status == group.PROPOSAL_STATUS_WITHDRAWN || proposal.Status == group.PROPOSAL_STATUS_VETOED {
 			if err := k.pruneProposal(ctx, proposalID); err != nil {
 				return err
 			}
@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/group/errors"
 	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 	"github.com/cosmos/cosmos-sdk/x/group/module"
@@ -33,7 +34,10 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
-var EventProposalPruned = "cosmos.group.v1.EventProposalPruned"
+var (
+	EventProposalPruned = "cosmos.group.v1.EventProposalPruned"
+	EventVetoProposal   = "cosmos.group.v1.EventVetoProposal"
+)
 
 const minExecutionPeriod = 5 * time.Second
 
@@ -50,6 +54,7 @@ type TestSuite struct {
 	blockTime       time.Time
 	bankKeeper      *grouptestutil.MockBankKeeper
 	accountKeeper   *grouptestutil.MockAccountKeeper
+	stakingKeeper   *grouptestutil.MockStakingKeeper
 }
 
 func (s *TestSuite) SetupTest() {
@@ -67,6 +72,7 @@ func (s *TestSuite) SetupTest() {
 		s.accountKeeper.EXPECT().GetAccount(gomock.Any(), s.addrs[i]).Return(authtypes.NewBaseAccountWithAddress(s.addrs[i])).AnyTimes()
 	}
 	s.bankKeeper = grouptestutil.NewMockBankKeeper(ctrl)
+	s.stakingKeeper = grouptestutil.NewMockStakingKeeper(ctrl)
 
 	bApp := baseapp.NewBaseApp(
 		"group",
@@ -78,7 +84,7 @@ func (s *TestSuite) SetupTest() {
 	banktypes.RegisterMsgServer(bApp.MsgServiceRouter(), s.bankKeeper)
 
 	config := group.DefaultConfig()
-	s.groupKeeper = keeper.NewKeeper(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, config)
+	s.groupKeeper = keeper.NewKeeperWithKeepers(key, encCfg.Codec, bApp.MsgServiceRouter(), s.accountKeeper, s.bankKeeper, s.stakingKeeper, config)
 	s.ctx = testCtx.Ctx.WithBlockTime(s.blockTime)
 	s.sdkCtx = sdk.UnwrapSDKContext(s.ctx)
 
@@ -3432,6 +3438,291 @@ func (s *TestSuite) TestTallyProposalsAtVPEnd_GroupMemberLeaving() {
 	s.NotPanics(func() { module.EndBlocker(ctx, s.groupKeeper) })
 }
 
+func (s *TestSuite) TestTokenWeightedDecisionPolicy() {
+	addr1, addr2, addr3 := s.addrs[3], s.addrs[4], s.addrs[5]
+	votingPeriod := time.Minute
+
+	testCases := map[string]struct {
+		policy     group.DecisionPolicy
+		setupPower func(powers map[string]int64)
+	}{
+		"bank source": {
+			policy: group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_BANK, "stake", votingPeriod, 0),
+			setupPower: func(powers map[string]int64) {
+				for addr, power := range powers {
+					s.bankKeeper.EXPECT().GetBalance(gomock.Any(), sdk.MustAccAddressFromBech32(addr), "stake").Return(sdk.NewInt64Coin("stake", power)).Times(1)
+				}
+			},
+		},
+		"staking source": {
+			policy: group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_STAKING, "", votingPeriod, 0),
+			setupPower: func(powers map[string]int64) {
+				for addr, power := range powers {
+					s.stakingKeeper.EXPECT().GetDelegatorBonded(gomock.Any(), sdk.MustAccAddressFromBech32(addr)).Return(sdk.NewInt(power)).Times(1)
+				}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			s.SetupTest()
+			ctx := s.sdkCtx
+
+			groupMsg := &group.MsgCreateGroupWithPolicy{
+				Admin: addr1.String(),
+				Members: []group.MemberRequest{
+					{Address: addr1.String(), Weight: "1"},
+					{Address: addr2.String(), Weight: "1"},
+					{Address: addr3.String(), Weight: "1"},
+				},
+			}
+			s.Require().NoError(groupMsg.SetDecisionPolicy(tc.policy))
+			s.setNextAccount()
+			groupRes, err := s.groupKeeper.CreateGroupWithPolicy(ctx, groupMsg)
+			s.Require().NoError(err)
+
+			// the voting power is only read once, at proposal submission
+			tc.setupPower(map[string]int64{addr1.String(): 100, addr2.String(): 300, addr3.String(): 0})
+			proposalRes, err := s.groupKeeper.SubmitProposal(ctx, &group.MsgSubmitProposal{
+				GroupPolicyAddress: groupRes.GroupPolicyAddress,
+				Proposers:          []string{addr1.String()},
+			})
+			s.Require().NoError(err)
+			proposalID := proposalRes.ProposalId
+
+			snapshots := s.groupKeeper.ExportGenesis(ctx, nil).VotingPowerSnapshots
+			s.Require().Len(snapshots, 1)
+			s.Require().Equal(proposalID, snapshots[0].ProposalId)
+			s.Require().Equal("400", snapshots[0].TotalPower)
+
+			for _, voter := range []sdk.AccAddress{addr1, addr3} {
+				_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: voter.String(), Option: group.VOTE_OPTION_YES})
+				s.Require().NoError(err)
+			}
+
+			// the vote of a member without voting power doesn't count
+			tallyRes, err := s.groupKeeper.TallyResult(ctx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal("100", tallyRes.Tally.YesCount)
+
+			_, err = s.groupKeeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: addr2.String(), Option: group.VOTE_OPTION_NO})
+			s.Require().NoError(err)
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(votingPeriod + 1))
+			s.Require().NoError(s.groupKeeper.TallyProposalsAtVPEnd(ctx))
+
+			proposalQueryRes, err := s.groupKeeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_STATUS_REJECTED, proposalQueryRes.Proposal.Status)
+			s.Require().Equal("300", proposalQueryRes.Proposal.FinalTallyResult.NoCount)
+
+			// the snapshot is pruned along with the proposal
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(group.DefaultConfig().MaxExecutionPeriod))
+			s.Require().NoError(s.groupKeeper.PruneProposals(ctx))
+			s.Require().Empty(s.groupKeeper.ExportGenesis(ctx, nil).VotingPowerSnapshots)
+		})
+	}
+}
+
+func (s *TestSuite) TestTokenWeightedDecisionPolicyWithoutKeepers() {
+	key := sdk.NewKVStoreKey(group.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModuleBasic{})
+	groupKeeper := keeper.NewKeeper(key, encCfg.Codec, baseapp.NewMsgServiceRouter(), s.accountKeeper, group.DefaultConfig())
+	ctx := testCtx.Ctx.WithBlockTime(s.blockTime)
+	admin := s.addrs[0]
+
+	policies := map[string]group.DecisionPolicy{
+		"bank source":    group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_BANK, "stake", time.Minute, 0),
+		"staking source": group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_STAKING, "", time.Minute, 0),
+	}
+
+	for name, policy := range policies {
+		s.Run(name, func() {
+			groupMsg := &group.MsgCreateGroupWithPolicy{
+				Admin:   admin.String(),
+				Members: []group.MemberRequest{{Address: admin.String(), Weight: "1"}},
+			}
+			s.Require().NoError(groupMsg.SetDecisionPolicy(policy))
+			_, err := groupKeeper.CreateGroupWithPolicy(ctx, groupMsg)
+			s.Require().ErrorIs(err, errors.ErrInvalid)
+		})
+	}
+}
+
+func (s *TestSuite) TestExecutionSettings() {
+	admin, proposer, vetoer := s.addrs[0], s.addrs[1], s.addrs[5]
+	timelock := time.Minute
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   proposer.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	// the timelock must end before the proposal is pruned
+	_, err := s.groupKeeper.UpdateGroupPolicyExecutionSettings(s.ctx, &group.MsgUpdateGroupPolicyExecutionSettings{
+		Admin:              admin.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		ExecutionSettings:  &group.ExecutionSettings{Timelock: group.DefaultConfig().MaxExecutionPeriod},
+	})
+	s.Require().ErrorIs(err, errors.ErrInvalid)
+
+	_, err = s.groupKeeper.UpdateGroupPolicyExecutionSettings(s.ctx, &group.MsgUpdateGroupPolicyExecutionSettings{
+		Admin:              proposer.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+	})
+	s.Require().ErrorContains(err, "not group policy admin")
+
+	_, err = s.groupKeeper.UpdateGroupPolicyExecutionSettings(s.ctx, &group.MsgUpdateGroupPolicyExecutionSettings{
+		Admin:              admin.String(),
+		GroupPolicyAddress: s.groupPolicyAddr.String(),
+		ExecutionSettings: &group.ExecutionSettings{
+			Timelock:             timelock,
+			VetoAddress:          vetoer.String(),
+			MaxExecutionAttempts: 2,
+		},
+	})
+	s.Require().NoError(err)
+
+	policyRes, err := s.groupKeeper.GroupPolicyInfo(s.ctx, &group.QueryGroupPolicyInfoRequest{Address: s.groupPolicyAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(timelock, policyRes.Info.ExecutionSettings.Timelock)
+	s.Require().Equal(uint64(2), policyRes.Info.Version)
+
+	s.Run("accepted proposal is timelocked and can be vetoed", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()}, group.VOTE_OPTION_YES)
+
+		sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod))
+		_, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: proposalID})
+		s.Require().ErrorIs(err, errors.ErrTimelocked)
+
+		proposalRes, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposalRes.Proposal.Status)
+		s.Require().Equal(sdkCtx.BlockTime().Add(timelock), *proposalRes.Proposal.TimelockEnd)
+		s.Require().Equal(vetoer.String(), proposalRes.Proposal.ExecutionSettings.VetoAddress)
+
+		_, err = s.groupKeeper.VetoProposal(sdkCtx, &group.MsgVetoProposal{ProposalId: proposalID, Vetoer: proposer.String()})
+		s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+		_, err = s.groupKeeper.VetoProposal(sdkCtx, &group.MsgVetoProposal{ProposalId: proposalID, Vetoer: vetoer.String()})
+		s.Require().NoError(err)
+		s.Require().True(eventTypeFound(sdkCtx.EventManager().ABCIEvents(), EventVetoProposal))
+
+		proposalRes, err = s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_VETOED, proposalRes.Proposal.Status)
+
+		_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: proposalID})
+		s.Require().ErrorIs(err, errors.ErrInvalid)
+	})
+
+	s.Run("proposal cannot be vetoed after its timelock", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()}, group.VOTE_OPTION_YES)
+
+		sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod))
+		_, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: proposalID})
+		s.Require().ErrorIs(err, errors.ErrTimelocked)
+
+		sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(timelock))
+		_, err = s.groupKeeper.VetoProposal(sdkCtx, &group.MsgVetoProposal{ProposalId: proposalID, Vetoer: vetoer.String()})
+		s.Require().ErrorIs(err, errors.ErrInvalid)
+
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+		res, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, res.Result)
+	})
+
+	s.Run("veto address of an accepted proposal is not updated with the group policy", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()}, group.VOTE_OPTION_YES)
+
+		sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod))
+		_, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: proposalID})
+		s.Require().ErrorIs(err, errors.ErrTimelocked)
+
+		_, err = s.groupKeeper.UpdateGroupPolicyExecutionSettings(sdkCtx, &group.MsgUpdateGroupPolicyExecutionSettings{
+			Admin:              admin.String(),
+			GroupPolicyAddress: s.groupPolicyAddr.String(),
+			ExecutionSettings:  &group.ExecutionSettings{VetoAddress: proposer.String()},
+		})
+		s.Require().NoError(err)
+
+		_, err = s.groupKeeper.VetoProposal(sdkCtx, &group.MsgVetoProposal{ProposalId: proposalID, Vetoer: proposer.String()})
+		s.Require().ErrorIs(err, errors.ErrUnauthorized)
+
+		_, err = s.groupKeeper.VetoProposal(sdkCtx, &group.MsgVetoProposal{ProposalId: proposalID, Vetoer: vetoer.String()})
+		s.Require().NoError(err)
+	})
+
+	s.Run("vote with exec try keeps a timelocked proposal accepted", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID := submitProposal(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()})
+
+		_, err := s.groupKeeper.Vote(sdkCtx, &group.MsgVote{
+			ProposalId: proposalID,
+			Voter:      proposer.String(),
+			Option:     group.VOTE_OPTION_YES,
+			Exec:       group.Exec_EXEC_TRY,
+		})
+		s.Require().NoError(err)
+
+		proposalRes, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(group.PROPOSAL_STATUS_ACCEPTED, proposalRes.Proposal.Status)
+		s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, proposalRes.Proposal.ExecutorResult)
+	})
+
+	s.Run("failed executions are logged until max attempts", func() {
+		sdkCtx, _ := s.sdkCtx.CacheContext()
+		proposalID := submitProposalAndVote(sdkCtx, s, []sdk.Msg{msgSend}, []string{proposer.String()}, group.VOTE_OPTION_YES)
+
+		sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(minExecutionPeriod))
+		_, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: proposalID})
+		s.Require().ErrorIs(err, errors.ErrTimelocked)
+
+		// later updates of the group policy don't apply to the accepted proposal
+		_, err = s.groupKeeper.UpdateGroupPolicyExecutionSettings(sdkCtx, &group.MsgUpdateGroupPolicyExecutionSettings{
+			Admin:              admin.String(),
+			GroupPolicyAddress: s.groupPolicyAddr.String(),
+		})
+		s.Require().NoError(err)
+
+		sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(timelock))
+		s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, fmt.Errorf("error")).Times(2)
+		for i := 0; i < 2; i++ {
+			res, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: proposalID})
+			s.Require().NoError(err)
+			s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, res.Result)
+		}
+
+		_, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: admin.String(), ProposalId: proposalID})
+		s.Require().ErrorIs(err, errors.ErrExpired)
+
+		logsRes, err := s.groupKeeper.ExecutionLogsByProposal(sdkCtx, &group.QueryExecutionLogsByProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Len(logsRes.ExecutionLogs, 2)
+		for i, log := range logsRes.ExecutionLogs {
+			s.Require().Equal(uint32(i+1), log.Attempt)
+			s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_FAILURE, log.Result)
+			s.Require().Contains(log.Logs, "error")
+		}
+
+		proposalRes, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(2), proposalRes.Proposal.ExecutionAttempts)
+
+		// the execution logs are pruned along with the proposal
+		sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(group.DefaultConfig().MaxExecutionPeriod))
+		s.Require().NoError(s.groupKeeper.PruneProposals(sdkCtx))
+		s.Require().Empty(s.groupKeeper.ExportGenesis(sdkCtx, nil).ExecutionLogs)
+	})
+}
+
 func eventTypeFound(events []abci.Event, eventType string) bool {
 	eventTypeFound := false
 	for _, e := range events {
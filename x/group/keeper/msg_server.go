@@ -327,6 +327,10 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGro
 		return nil, err
 	}
 
+	if err := k.assertVotingPowerSource(policy); err != nil {
+		return nil, err
+	}
+
 	// Generate account address of group policy.
 	var accountAddr sdk.AccAddress
 	// loop here in the rare case where a ADR-028-derived address creates a
@@ -418,6 +422,10 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, req *grou
 			return err
 		}
 
+		if err := k.assertVotingPowerSource(policy); err != nil {
+			return err
+		}
+
 		err = groupPolicy.SetDecisionPolicy(policy)
 		if err != nil {
 			return err
@@ -457,6 +465,29 @@ func (k Keeper) UpdateGroupPolicyMetadata(goCtx context.Context, req *group.MsgU
 	return &group.MsgUpdateGroupPolicyMetadataResponse{}, nil
 }
 
+func (k Keeper) UpdateGroupPolicyExecutionSettings(goCtx context.Context, req *group.MsgUpdateGroupPolicyExecutionSettings) (*group.MsgUpdateGroupPolicyExecutionSettingsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.ExecutionSettings != nil {
+		if err := req.ExecutionSettings.Validate(k.config); err != nil {
+			return nil, err
+		}
+	}
+
+	action := func(groupPolicy *group.GroupPolicyInfo) error {
+		groupPolicy.ExecutionSettings = req.ExecutionSettings
+		groupPolicy.Version++
+		return k.groupPolicyTable.Update(ctx.KVStore(k.key), groupPolicy)
+	}
+
+	err := k.doUpdateGroupPolicy(ctx, req.GroupPolicyAddress, req.Admin, action, "group policy execution settings updated")
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgUpdateGroupPolicyExecutionSettingsResponse{}, nil
+}
+
 func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitProposal) (*group.MsgSubmitProposalResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	groupPolicyAddr, err := sdk.AccAddressFromBech32(req.GroupPolicyAddress)
@@ -540,6 +571,14 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 		return nil, sdkerrors.Wrap(err, "create proposal")
 	}
 
+	// Snapshot the voting power of the members, so that tokens moved after the
+	// submission don't change the outcome of the vote.
+	if tokenPolicy, ok := policy.(*group.TokenWeightedDecisionPolicy); ok {
+		if err := k.snapshotVotingPower(ctx, id, g.Id, tokenPolicy); err != nil {
+			return nil, sdkerrors.Wrap(err, "snapshot voting power")
+		}
+	}
+
 	err = ctx.EventManager().EmitTypedEvent(&group.EventSubmitProposal{ProposalId: id})
 	if err != nil {
 		return nil, err
@@ -567,7 +606,8 @@ func (k Keeper) SubmitProposal(goCtx context.Context, req *group.MsgSubmitPropos
 			// but that could be revisited (eg using the group policy)
 			Executor: proposers[0],
 		})
-		if err != nil {
+		// A timelocked proposal is executed once its timelock ends.
+		if err != nil && !sdkerrors.IsOf(err, errors.ErrTimelocked) {
 			return &group.MsgSubmitProposalResponse{ProposalId: id}, sdkerrors.Wrap(err, "the proposal was created but failed on exec")
 		}
 	}
@@ -613,6 +653,64 @@ func (k Keeper) WithdrawProposal(goCtx context.Context, req *group.MsgWithdrawPr
 	return &group.MsgWithdrawProposalResponse{}, nil
 }
 
+func (k Keeper) VetoProposal(goCtx context.Context, req *group.MsgVetoProposal) (*group.MsgVetoProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id := req.ProposalId
+
+	proposal, err := k.getProposal(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// An accepted proposal is vetoed by the veto address copied onto it at
+	// acceptance. During the voting period, the group policy settings apply, as
+	// updating them aborts the proposals in the voting phase.
+	settings := proposal.ExecutionSettings
+	if proposal.Status == group.PROPOSAL_STATUS_SUBMITTED {
+		policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.GroupPolicyAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "load group policy")
+		}
+		settings = policyInfo.ExecutionSettings
+	}
+
+	if settings == nil || settings.VetoAddress == "" {
+		return nil, sdkerrors.Wrap(errors.ErrUnauthorized, "proposal has no veto address")
+	}
+	if req.Vetoer != settings.VetoAddress {
+		return nil, sdkerrors.Wrapf(errors.ErrUnauthorized, "not the veto address of the proposal: %s", req.Vetoer)
+	}
+
+	// Ensure the proposal can be vetoed: either during the voting period, or
+	// once accepted during its timelock.
+	switch {
+	case proposal.Status == group.PROPOSAL_STATUS_SUBMITTED:
+	case proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && isTimelocked(ctx, proposal):
+	case proposal.Status == group.PROPOSAL_STATUS_ACCEPTED:
+		return nil, sdkerrors.Wrap(errors.ErrInvalid, "cannot veto an accepted proposal outside of its timelock")
+	default:
+		return nil, sdkerrors.Wrapf(errors.ErrInvalid, "cannot veto a proposal with the status of %s", proposal.Status.String())
+	}
+
+	proposal.Status = group.PROPOSAL_STATUS_VETOED
+	if err := k.proposalTable.Update(ctx.KVStore(k.key), id, &proposal); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventVetoProposal{ProposalId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgVetoProposalResponse{}, nil
+}
+
+// isTimelocked returns true if the proposal is in its timelock, i.e. it has
+// been accepted but can't be executed yet.
+func isTimelocked(ctx sdk.Context, proposal group.Proposal) bool {
+	return proposal.TimelockEnd != nil && ctx.BlockTime().Before(*proposal.TimelockEnd)
+}
+
 func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	id := req.ProposalId
@@ -676,7 +774,8 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 			ProposalId: id,
 			Executor:   voterAddr,
 		})
-		if err != nil {
+		// A timelocked proposal is executed once its timelock ends.
+		if err != nil && !sdkerrors.IsOf(err, errors.ErrTimelocked) {
 			return nil, err
 		}
 	}
@@ -698,7 +797,16 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 		return err
 	}
 
-	result, err := policy.Allow(tallyResult, electorate.TotalWeight)
+	totalPower := electorate.TotalWeight
+	snapshot, found, err := k.getVotingPowerSnapshot(ctx, p.Id)
+	if err != nil {
+		return err
+	}
+	if found {
+		totalPower = snapshot.TotalPower
+	}
+
+	result, err := policy.Allow(tallyResult, totalPower)
 	if err != nil {
 		return sdkerrors.Wrap(err, "policy allow")
 	}
@@ -712,6 +820,20 @@ func (k Keeper) doTallyAndUpdate(ctx sdk.Context, p *group.Proposal, electorate
 		p.FinalTallyResult = tallyResult
 		if result.Allow {
 			p.Status = group.PROPOSAL_STATUS_ACCEPTED
+
+			// The timelock begins as soon as the proposal is accepted, and the
+			// execution settings in effect are kept with the proposal.
+			if settings := policyInfo.ExecutionSettings; settings != nil {
+				if settings.Timelock > 0 {
+					timelockEnd := ctx.BlockTime().Add(settings.Timelock)
+					p.TimelockEnd = &timelockEnd
+				}
+				p.ExecutionSettings = &group.ExecutionSettings{
+					Timelock:             settings.Timelock,
+					VetoAddress:          settings.VetoAddress,
+					MaxExecutionAttempts: settings.MaxExecutionAttempts,
+				}
+			}
 		} else {
 			p.Status = group.PROPOSAL_STATUS_REJECTED
 		}
@@ -756,7 +878,23 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 
 	// Execute proposal payload.
 	var logs string
-	if proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS {
+	executable := proposal.Status == group.PROPOSAL_STATUS_ACCEPTED && proposal.ExecutorResult != group.PROPOSAL_EXECUTOR_RESULT_SUCCESS
+
+	// An accepted proposal is not executed during its timelock, so that it
+	// can still be vetoed. Its acceptance is stored nonetheless, so that
+	// the EXEC_TRY of a vote or of a submission doesn't lose it.
+	if executable && isTimelocked(ctx, proposal) {
+		if err := k.proposalTable.Update(ctx.KVStore(k.key), id, &proposal); err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.Wrapf(errors.ErrTimelocked, "proposal %d is timelocked until %s", id, proposal.TimelockEnd)
+	}
+
+	if executable {
+		if settings := proposal.ExecutionSettings; settings != nil && settings.MaxExecutionAttempts > 0 && proposal.ExecutionAttempts >= settings.MaxExecutionAttempts {
+			return nil, sdkerrors.Wrapf(errors.ErrExpired, "proposal %d reached the maximum number of %d execution attempts", id, settings.MaxExecutionAttempts)
+		}
+
 		// Caching context so that we don't update the store in case of failure.
 		cacheCtx, flush := ctx.CacheContext()
 
@@ -779,6 +917,17 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 				ctx.EventManager().EmitEvents(res.GetEvents())
 			}
 		}
+
+		proposal.ExecutionAttempts++
+		if err := k.executionLogTable.Create(ctx.KVStore(k.key), &group.ExecutionLog{
+			ProposalId: id,
+			Attempt:    proposal.ExecutionAttempts,
+			ExecutedAt: ctx.BlockTime(),
+			Result:     proposal.ExecutorResult,
+			Logs:       logs,
+		}); err != nil {
+			return nil, err
+		}
 	}
 
 	// Update proposal in proposalTable
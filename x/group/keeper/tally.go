@@ -10,6 +10,8 @@ import (
 
 // Tally is a function that tallies a proposal by iterating through its votes,
 // and returns the tally result without modifying the proposal or any state.
+// If the voting power of the members was snapshotted at proposal submission,
+// the snapshotted power is used instead of the member weight.
 func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.TallyResult, error) {
 	// If proposal has already been tallied and updated, then its status is
 	// accepted/rejected, in which case we just return the previously stored result.
@@ -20,6 +22,11 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 		return p.FinalTallyResult, nil
 	}
 
+	snapshot, hasSnapshot, err := k.getVotingPowerSnapshot(ctx, p.Id)
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+
 	it, err := k.voteByProposalIndex.Get(ctx.KVStore(k.key), p.Id)
 	if err != nil {
 		return group.TallyResult{}, err
@@ -54,7 +61,17 @@ func (k Keeper) Tally(ctx sdk.Context, p group.Proposal, groupID uint64) (group.
 			return group.TallyResult{}, err
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		weight := member.Member.Weight
+		if hasSnapshot {
+			power, ok := snapshot.GetPower(vote.Voter)
+			if !ok || power == "0" {
+				// Members without voting power at submission don't count.
+				continue
+			}
+			weight = power
+		}
+
+		if err := tallyResult.Add(vote, weight); err != nil {
 			return group.TallyResult{}, sdkerrors.Wrap(err, "add new vote")
 		}
 	}
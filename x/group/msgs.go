@@ -821,6 +821,83 @@ func (m MsgLeaveGroup) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgUpdateGroupPolicyExecutionSettings{}
+
+// Route Implements Msg.
+func (m MsgUpdateGroupPolicyExecutionSettings) Route() string {
+	return sdk.MsgTypeURL(&m)
+}
+
+// Type Implements Msg.
+func (m MsgUpdateGroupPolicyExecutionSettings) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgUpdateGroupPolicyExecutionSettings) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateGroupPolicyExecutionSettings.
+func (m MsgUpdateGroupPolicyExecutionSettings) GetSigners() []sdk.AccAddress {
+	admin := sdk.MustAccAddressFromBech32(m.Admin)
+
+	return []sdk.AccAddress{admin}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgUpdateGroupPolicyExecutionSettings) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "admin")
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.GroupPolicyAddress)
+	if err != nil {
+		return sdkerrors.Wrap(err, "group policy")
+	}
+
+	if m.ExecutionSettings != nil {
+		if err := m.ExecutionSettings.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "execution settings")
+		}
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgVetoProposal{}
+
+// Route Implements Msg.
+func (m MsgVetoProposal) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgVetoProposal) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgVetoProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(codec.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgVetoProposal.
+func (m MsgVetoProposal) GetSigners() []sdk.AccAddress {
+	vetoer := sdk.MustAccAddressFromBech32(m.Vetoer)
+
+	return []sdk.AccAddress{vetoer}
+}
+
+// ValidateBasic does a sanity check on the provided data
+func (m MsgVetoProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Vetoer)
+	if err != nil {
+		return sdkerrors.Wrap(err, "vetoer")
+	}
+
+	if m.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "proposal id")
+	}
+
+	return nil
+}
+
 // strictValidateMembers performs ValidateBasic on Members, but also checks
 // that all members weights are positive (whereas `Members{members}.ValidateBasic()`
 // only checks that they are non-negative.
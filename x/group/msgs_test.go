@@ -1220,6 +1220,143 @@ func TestMsgLeaveGroup(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateGroupPolicyExecutionSettings(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *group.MsgUpdateGroupPolicyExecutionSettings
+		expErr bool
+		errMsg string
+	}{
+		{
+			"admin: invalid bech32 address",
+			&group.MsgUpdateGroupPolicyExecutionSettings{
+				Admin: "admin",
+			},
+			true,
+			"admin: decoding bech32 failed",
+		},
+		{
+			"policy address: invalid bech32 address",
+			&group.MsgUpdateGroupPolicyExecutionSettings{
+				Admin:              admin.String(),
+				GroupPolicyAddress: "address",
+			},
+			true,
+			"group policy: decoding bech32 failed",
+		},
+		{
+			"negative timelock",
+			&group.MsgUpdateGroupPolicyExecutionSettings{
+				Admin:              admin.String(),
+				GroupPolicyAddress: admin.String(),
+				ExecutionSettings: &group.ExecutionSettings{
+					Timelock: -time.Second,
+				},
+			},
+			true,
+			"timelock cannot be negative",
+		},
+		{
+			"veto address: invalid bech32 address",
+			&group.MsgUpdateGroupPolicyExecutionSettings{
+				Admin:              admin.String(),
+				GroupPolicyAddress: admin.String(),
+				ExecutionSettings: &group.ExecutionSettings{
+					VetoAddress: "veto",
+				},
+			},
+			true,
+			"veto address: decoding bech32 failed",
+		},
+		{
+			"valid test",
+			&group.MsgUpdateGroupPolicyExecutionSettings{
+				Admin:              admin.String(),
+				GroupPolicyAddress: admin.String(),
+				ExecutionSettings: &group.ExecutionSettings{
+					Timelock:             time.Hour,
+					VetoAddress:          member1.String(),
+					MaxExecutionAttempts: 3,
+				},
+			},
+			false,
+			"",
+		},
+		{
+			"valid test: remove execution settings",
+			&group.MsgUpdateGroupPolicyExecutionSettings{
+				Admin:              admin.String(),
+				GroupPolicyAddress: admin.String(),
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgUpdateGroupPolicyExecutionSettings{}))
+			}
+		})
+	}
+}
+
+func TestMsgVetoProposal(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *group.MsgVetoProposal
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid vetoer address",
+			&group.MsgVetoProposal{
+				Vetoer: "vetoer",
+			},
+			true,
+			"vetoer: decoding bech32 failed",
+		},
+		{
+			"proposal id is required",
+			&group.MsgVetoProposal{
+				Vetoer: member1.String(),
+			},
+			true,
+			"proposal id: value is empty",
+		},
+		{
+			"valid msg",
+			&group.MsgVetoProposal{
+				Vetoer:     member1.String(),
+				ProposalId: 1,
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgVetoProposal{}))
+			}
+		})
+	}
+}
+
 func TestAmino(t *testing.T) {
 	cdc := testutil.MakeTestEncodingConfig(module.AppModuleBasic{})
 
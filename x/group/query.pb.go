@@ -1486,6 +1486,116 @@ func (m *QueryGroupsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryExecutionLogsByProposalRequest is the Query/ExecutionLogsByProposal request type.
+type QueryExecutionLogsByProposalRequest struct {
+	// proposal_id is the unique ID of a proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionLogsByProposalRequest) Reset()         { *m = QueryExecutionLogsByProposalRequest{} }
+func (m *QueryExecutionLogsByProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionLogsByProposalRequest) ProtoMessage()    {}
+func (*QueryExecutionLogsByProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{28}
+}
+func (m *QueryExecutionLogsByProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionLogsByProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionLogsByProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionLogsByProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionLogsByProposalRequest.Merge(m, src)
+}
+func (m *QueryExecutionLogsByProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionLogsByProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionLogsByProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionLogsByProposalRequest proto.InternalMessageInfo
+
+func (m *QueryExecutionLogsByProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *QueryExecutionLogsByProposalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExecutionLogsByProposalResponse is the Query/ExecutionLogsByProposal response type.
+type QueryExecutionLogsByProposalResponse struct {
+	// execution_logs are the execution logs of the proposal.
+	ExecutionLogs []*ExecutionLog `protobuf:"bytes,1,rep,name=execution_logs,json=executionLogs,proto3" json:"execution_logs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionLogsByProposalResponse) Reset()         { *m = QueryExecutionLogsByProposalResponse{} }
+func (m *QueryExecutionLogsByProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionLogsByProposalResponse) ProtoMessage()    {}
+func (*QueryExecutionLogsByProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fcf9f1d74302290, []int{29}
+}
+func (m *QueryExecutionLogsByProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionLogsByProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionLogsByProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionLogsByProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionLogsByProposalResponse.Merge(m, src)
+}
+func (m *QueryExecutionLogsByProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionLogsByProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionLogsByProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionLogsByProposalResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionLogsByProposalResponse) GetExecutionLogs() []*ExecutionLog {
+	if m != nil {
+		return m.ExecutionLogs
+	}
+	return nil
+}
+
+func (m *QueryExecutionLogsByProposalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGroupInfoRequest)(nil), "cosmos.group.v1.QueryGroupInfoRequest")
 	proto.RegisterType((*QueryGroupInfoResponse)(nil), "cosmos.group.v1.QueryGroupInfoResponse")
@@ -1515,94 +1625,101 @@ func init() {
 	proto.RegisterType((*QueryTallyResultResponse)(nil), "cosmos.group.v1.QueryTallyResultResponse")
 	proto.RegisterType((*QueryGroupsRequest)(nil), "cosmos.group.v1.QueryGroupsRequest")
 	proto.RegisterType((*QueryGroupsResponse)(nil), "cosmos.group.v1.QueryGroupsResponse")
+	proto.RegisterType((*QueryExecutionLogsByProposalRequest)(nil), "cosmos.group.v1.QueryExecutionLogsByProposalRequest")
+	proto.RegisterType((*QueryExecutionLogsByProposalResponse)(nil), "cosmos.group.v1.QueryExecutionLogsByProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/query.proto", fileDescriptor_0fcf9f1d74302290) }
 
 var fileDescriptor_0fcf9f1d74302290 = []byte{
-	// 1310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x25, 0x3f, 0x5f, 0xda, 0x46, 0x9d, 0xa6, 0x6d, 0xb2, 0x8d, 0x9c, 0xb0, 0x2d,
-	0xf9, 0x9d, 0xdd, 0xd8, 0x49, 0x53, 0x04, 0x14, 0x54, 0x4b, 0x10, 0x72, 0x28, 0x4a, 0x4d, 0x85,
-	0x04, 0x42, 0x8a, 0xd6, 0xf1, 0xc6, 0xac, 0xb0, 0x77, 0x5c, 0xef, 0x26, 0xc2, 0x8a, 0x7c, 0x41,
-	0x82, 0x03, 0xe2, 0x00, 0x2d, 0x42, 0x25, 0xe2, 0xd0, 0x03, 0x12, 0x3d, 0x72, 0x00, 0x21, 0x71,
-	0xeb, 0xad, 0xc7, 0x0a, 0x2e, 0x9c, 0x10, 0x4a, 0x90, 0xf8, 0x23, 0xb8, 0xa0, 0x9d, 0x79, 0x6b,
-	0xef, 0x6f, 0x6f, 0x84, 0x45, 0x73, 0x69, 0xeb, 0x9d, 0x37, 0xf3, 0x3e, 0xf3, 0x7d, 0x6f, 0x67,
-	0xbf, 0x53, 0xb8, 0xbc, 0xcd, 0xac, 0x2a, 0xb3, 0xd4, 0x72, 0x9d, 0xed, 0xd6, 0xd4, 0xbd, 0xac,
-	0x7a, 0x77, 0x57, 0xaf, 0x37, 0x94, 0x5a, 0x9d, 0xd9, 0x8c, 0x8e, 0x88, 0x41, 0x85, 0x0f, 0x2a,
-	0x7b, 0x59, 0x69, 0xb4, 0xcc, 0xca, 0x8c, 0x8f, 0xa9, 0xce, 0xbf, 0x44, 0x98, 0x34, 0x51, 0x66,
-	0xac, 0x5c, 0xd1, 0x55, 0xad, 0x66, 0xa8, 0x9a, 0x69, 0x32, 0x5b, 0xb3, 0x0d, 0x66, 0x5a, 0x38,
-	0x1a, 0xca, 0x60, 0x37, 0x6a, 0xba, 0x3b, 0x38, 0x8f, 0x83, 0x45, 0xcd, 0xd2, 0x45, 0x6a, 0x75,
-	0x2f, 0x5b, 0xd4, 0x6d, 0x2d, 0xab, 0xd6, 0xb4, 0xb2, 0x61, 0xf2, 0x95, 0x30, 0x76, 0x5c, 0xc4,
-	0x6e, 0x89, 0xfc, 0x88, 0x26, 0x86, 0xce, 0x69, 0x55, 0xc3, 0x64, 0x2a, 0xff, 0x53, 0x3c, 0x92,
-	0x73, 0x70, 0xe1, 0xb6, 0xb3, 0xde, 0xba, 0x93, 0x76, 0xc3, 0xdc, 0x61, 0x05, 0xfd, 0xee, 0xae,
-	0x6e, 0xd9, 0x74, 0x1c, 0x06, 0x39, 0xca, 0x96, 0x51, 0x1a, 0x23, 0x53, 0x64, 0xb6, 0xb7, 0x30,
-	0xc0, 0x7f, 0x6f, 0x94, 0xe4, 0x37, 0xe1, 0x62, 0x70, 0x8e, 0x55, 0x63, 0xa6, 0xa5, 0x53, 0x05,
-	0x7a, 0x0d, 0x73, 0x87, 0xf1, 0x09, 0xc3, 0x39, 0x49, 0x09, 0x08, 0xa3, 0xb4, 0x67, 0xf0, 0x38,
-	0xf9, 0x36, 0x5c, 0x6e, 0xaf, 0xb4, 0xc9, 0x2a, 0xc6, 0x76, 0xc3, 0xcb, 0x90, 0x83, 0x01, 0xad,
-	0x54, 0xaa, 0xeb, 0x96, 0xc5, 0x57, 0x1c, 0xca, 0x8f, 0xfd, 0xfa, 0xe3, 0xd2, 0x28, 0x2e, 0x7a,
-	0x53, 0x8c, 0xbc, 0x6d, 0xd7, 0x0d, 0xb3, 0x5c, 0x70, 0x03, 0xe5, 0x3b, 0x30, 0x11, 0xbd, 0x24,
-	0x22, 0xae, 0xfa, 0x10, 0xa7, 0xa2, 0x11, 0x3d, 0xf3, 0x04, 0x68, 0x13, 0xc6, 0xda, 0xab, 0xde,
-	0xd2, 0xab, 0x45, 0xbd, 0x6e, 0x75, 0x56, 0x8a, 0xbe, 0x01, 0xd0, 0xae, 0xcf, 0xd8, 0x29, 0x9e,
-	0x72, 0xda, 0x4d, 0xe9, 0x14, 0x53, 0x11, 0x7d, 0x84, 0xc5, 0x54, 0x36, 0xb5, 0xb2, 0x8e, 0xcb,
-	0x16, 0x3c, 0x33, 0xe5, 0x6f, 0x09, 0x8c, 0x47, 0xe4, 0xc7, 0x2d, 0xad, 0xc1, 0x40, 0x55, 0x3c,
-	0x1a, 0x23, 0x53, 0xcf, 0xcd, 0x0e, 0xe7, 0x26, 0xa2, 0x77, 0x25, 0xe6, 0x15, 0xdc, 0x60, 0xba,
-	0x1e, 0x41, 0x37, 0xd3, 0x91, 0x4e, 0x24, 0xf5, 0xe1, 0xdd, 0xf7, 0xe1, 0x59, 0xf9, 0xc6, 0xcd,
-	0x52, 0xd5, 0x30, 0x5d, 0x7d, 0x14, 0xe8, 0xd3, 0x9c, 0xdf, 0x1d, 0x6b, 0x28, 0xc2, 0xba, 0x26,
-	0xda, 0x37, 0x04, 0xa4, 0x28, 0x2a, 0x54, 0x2d, 0x07, 0xfd, 0x5c, 0x1e, 0x57, 0xb4, 0xa4, 0x6e,
-	0xc5, 0xc8, 0xee, 0x29, 0xf6, 0x09, 0x81, 0xa9, 0x40, 0x9b, 0x1a, 0xba, 0x95, 0x17, 0x3f, 0xff,
-	0xc7, 0xc6, 0xfa, 0x89, 0xc0, 0xf3, 0x09, 0x1c, 0x28, 0xd5, 0x3a, 0x9c, 0x15, 0x20, 0x35, 0x0c,
-	0x40, 0xc9, 0x3a, 0xbf, 0x3d, 0x67, 0xca, 0xde, 0x75, 0xbb, 0xa7, 0xdf, 0x41, 0x8c, 0x7e, 0x27,
-	0xa2, 0xf1, 0xe2, 0x44, 0xf5, 0xf7, 0xdf, 0xc9, 0x13, 0xf5, 0x3a, 0x8c, 0x72, 0xec, 0xcd, 0x3a,
-	0xab, 0x31, 0x4b, 0xab, 0xb8, 0x3a, 0x4e, 0xc2, 0x70, 0x0d, 0x1f, 0xb5, 0x5b, 0x11, 0xdc, 0x47,
-	0x1b, 0x25, 0xf9, 0x2d, 0xfc, 0x88, 0xb4, 0x27, 0xe2, 0x1e, 0xaf, 0xc1, 0xa0, 0x1b, 0x86, 0x07,
-	0xee, 0x78, 0x68, 0x77, 0xad, 0x49, 0xad, 0x50, 0xf9, 0x21, 0x01, 0xd9, 0xb7, 0xa0, 0xdb, 0x91,
-	0x42, 0x84, 0xff, 0xf0, 0x79, 0xe8, 0x5a, 0x8d, 0xbf, 0x27, 0x70, 0x25, 0x11, 0x11, 0x15, 0xb8,
-	0x0e, 0x43, 0xee, 0xb6, 0xdc, 0x02, 0x27, 0x48, 0xd0, 0x8e, 0xed, 0x5e, 0x55, 0xeb, 0x30, 0xc9,
-	0x41, 0xdf, 0x61, 0xb6, 0x9e, 0x6f, 0xe1, 0x3a, 0xbf, 0xea, 0x69, 0x0b, 0xec, 0xbc, 0x49, 0x7b,
-	0xce, 0x04, 0xce, 0x91, 0xf8, 0x26, 0xf1, 0x30, 0xf9, 0x16, 0xbe, 0x9d, 0x91, 0x39, 0x51, 0x99,
-	0x39, 0xe8, 0x75, 0x82, 0xb1, 0x2f, 0x2e, 0x84, 0x44, 0x71, 0xa2, 0x0b, 0x3c, 0x44, 0xfe, 0x94,
-	0xa0, 0x4f, 0x70, 0x9e, 0x59, 0xf9, 0x63, 0x37, 0x68, 0xd7, 0xaa, 0xfe, 0x15, 0x41, 0x77, 0x11,
-	0x02, 0xc1, 0x4d, 0x2d, 0x08, 0xa1, 0xdc, 0x52, 0xc7, 0xec, 0x4a, 0xc4, 0x74, 0xaf, 0xc4, 0xf7,
-	0x08, 0xda, 0x13, 0xc4, 0xf2, 0x15, 0xb7, 0x55, 0x3b, 0x92, 0xaa, 0x76, 0x5d, 0xd3, 0xea, 0x4b,
-	0xd7, 0x14, 0xf8, 0xa1, 0x9e, 0xa9, 0x50, 0x0f, 0x82, 0x96, 0x00, 0x2d, 0xd1, 0x09, 0x38, 0x50,
-	0x0e, 0x88, 0xd7, 0x0b, 0x7b, 0xd0, 0x4e, 0x82, 0x5d, 0x79, 0x09, 0x2e, 0x71, 0xb6, 0x3b, 0x5a,
-	0xa5, 0xe2, 0x9c, 0x6d, 0xbb, 0x15, 0x3b, 0xf5, 0xc7, 0xe1, 0x5d, 0xec, 0x4d, 0xdf, 0x5c, 0xdc,
-	0xd4, 0x0d, 0xe8, 0xb3, 0x9d, 0xc7, 0x78, 0x08, 0x84, 0x7d, 0xab, 0x67, 0x52, 0x7e, 0xe8, 0xc9,
-	0x1f, 0x93, 0x3d, 0x8f, 0xfe, 0xfe, 0x61, 0x9e, 0x14, 0xc4, 0x2c, 0xf9, 0x7d, 0xa0, 0x1e, 0xc9,
-	0x5c, 0xa2, 0x6e, 0x55, 0xe4, 0x1e, 0x81, 0xf3, 0xbe, 0xe5, 0x4f, 0x40, 0x25, 0x72, 0xff, 0x9c,
-	0x83, 0x3e, 0x0e, 0x45, 0x3f, 0x27, 0x30, 0xd4, 0x4a, 0x44, 0xa7, 0x43, 0x10, 0x91, 0xd7, 0x3a,
-	0x69, 0xa6, 0x63, 0x9c, 0x48, 0x2a, 0x2b, 0x1f, 0xff, 0xf6, 0xd7, 0xfd, 0x53, 0xb3, 0x74, 0x5a,
-	0x0d, 0x5e, 0x4c, 0xd1, 0x93, 0x9a, 0x3b, 0x4c, 0xdd, 0x77, 0xfd, 0x69, 0x93, 0x7e, 0x47, 0x60,
-	0x24, 0x60, 0x54, 0xe8, 0x62, 0x42, 0xb2, 0xd0, 0x6d, 0x4f, 0x5a, 0x4a, 0x19, 0x8d, 0x80, 0xab,
-	0x1c, 0x50, 0xa1, 0x8b, 0x31, 0x80, 0xdc, 0x56, 0x35, 0x90, 0x13, 0xdf, 0xd6, 0x26, 0x7d, 0x40,
-	0xe0, 0xb4, 0xf7, 0x12, 0x45, 0xe7, 0x12, 0xb2, 0xfa, 0x2f, 0x7a, 0xd2, 0x7c, 0x9a, 0x50, 0xa4,
-	0xcb, 0x72, 0xba, 0x05, 0x3a, 0x17, 0x43, 0x87, 0x77, 0x30, 0xaf, 0x82, 0x07, 0x04, 0xce, 0xf8,
-	0xae, 0x2a, 0x34, 0x29, 0x61, 0xc0, 0xec, 0x4a, 0x0b, 0xa9, 0x62, 0x91, 0x6e, 0x99, 0xd3, 0xcd,
-	0xd3, 0xd9, 0x68, 0x3a, 0x6b, 0xab, 0xd8, 0xd8, 0xe2, 0x9e, 0xd8, 0x51, 0xae, 0x6a, 0x98, 0x4d,
-	0xfa, 0x0b, 0x81, 0xd1, 0xa8, 0x3b, 0x02, 0xcd, 0x76, 0xaa, 0x5a, 0xe8, 0x5e, 0x23, 0xe5, 0x8e,
-	0x33, 0x05, 0x89, 0x5f, 0xe6, 0xc4, 0xd7, 0xe8, 0x4a, 0x52, 0xb5, 0x0d, 0x9d, 0x93, 0x8b, 0x21,
-	0x8f, 0xb2, 0x3f, 0x87, 0xe1, 0x85, 0xc0, 0xe9, 0xe0, 0x7d, 0x3a, 0xe7, 0x8e, 0x33, 0x05, 0xe1,
-	0x5f, 0xe4, 0xf0, 0x39, 0xba, 0x9c, 0x02, 0xde, 0x2f, 0xfb, 0x67, 0x04, 0x06, 0x5d, 0x93, 0x41,
-	0x5f, 0x88, 0x4e, 0x1d, 0x70, 0x43, 0xd2, 0x74, 0xa7, 0x30, 0xa4, 0x52, 0x39, 0xd5, 0x1c, 0x9d,
-	0x09, 0x51, 0xb9, 0xa7, 0xb7, 0xba, 0xef, 0x39, 0xda, 0x9b, 0xf4, 0x31, 0x81, 0x8b, 0xd1, 0x76,
-	0x97, 0xae, 0x24, 0xe7, 0x8c, 0xf4, 0xef, 0xd2, 0xea, 0xf1, 0x26, 0x21, 0xf6, 0x2b, 0x1c, 0x7b,
-	0x8d, 0xae, 0xc6, 0x62, 0xb7, 0x9b, 0x00, 0x0f, 0x01, 0xcf, 0xfb, 0xff, 0x98, 0xc0, 0xf9, 0x08,
-	0x57, 0x4a, 0x97, 0xa3, 0x59, 0xe2, 0x4d, 0xb3, 0x94, 0x3d, 0xc6, 0x0c, 0x44, 0x7f, 0x9d, 0xa3,
-	0xbf, 0x46, 0x6f, 0x84, 0xd0, 0x1d, 0x9f, 0xe3, 0x50, 0xb7, 0xf4, 0xe6, 0x5e, 0xcc, 0xaf, 0xbf,
-	0xba, 0xcf, 0x1f, 0x36, 0xe9, 0x23, 0x02, 0x23, 0x01, 0x03, 0x1a, 0x77, 0xd4, 0x46, 0x1b, 0xe6,
-	0xb8, 0xa3, 0x36, 0xc6, 0xd5, 0x26, 0xf4, 0x2f, 0xf7, 0x67, 0x5e, 0xf0, 0x40, 0xcb, 0x7c, 0x4d,
-	0xe0, 0xb4, 0xd7, 0xff, 0xc5, 0x1d, 0xb7, 0x11, 0xc6, 0x35, 0xee, 0xb8, 0x8d, 0xb2, 0x93, 0x09,
-	0xbd, 0xdc, 0x22, 0x44, 0x45, 0x51, 0xc3, 0x87, 0x04, 0xce, 0xfa, 0x9d, 0x16, 0xed, 0x70, 0x82,
-	0xfa, 0xac, 0xa2, 0xb4, 0x98, 0x2e, 0x18, 0xf1, 0x56, 0x38, 0xde, 0x12, 0x5d, 0x48, 0x38, 0x6f,
-	0xc5, 0x17, 0xc1, 0xd3, 0xaa, 0x07, 0x04, 0x86, 0x3d, 0xfe, 0x87, 0xce, 0x46, 0xa7, 0x0c, 0x7b,
-	0x32, 0x69, 0x2e, 0x45, 0x24, 0x92, 0xad, 0x71, 0xb2, 0x65, 0xaa, 0xc4, 0xbf, 0x4d, 0x81, 0x2e,
-	0xe4, 0xd6, 0x8b, 0xda, 0xd0, 0x2f, 0xf6, 0x4a, 0xaf, 0x24, 0x29, 0xe1, 0x12, 0x5d, 0x4d, 0x0e,
-	0x42, 0x98, 0x49, 0x0e, 0x33, 0x4e, 0x2f, 0xc5, 0xc8, 0x94, 0x7f, 0xf5, 0xc9, 0x61, 0x86, 0x3c,
-	0x3d, 0xcc, 0x90, 0x3f, 0x0f, 0x33, 0xe4, 0x8b, 0xa3, 0x4c, 0xcf, 0xd3, 0xa3, 0x4c, 0xcf, 0xef,
-	0x47, 0x99, 0x9e, 0xf7, 0xae, 0x96, 0x0d, 0xfb, 0x83, 0xdd, 0xa2, 0xb2, 0xcd, 0xaa, 0xee, 0x64,
-	0xf1, 0xd7, 0x92, 0x55, 0xfa, 0x50, 0xfd, 0x48, 0x2c, 0x50, 0xec, 0xe7, 0xff, 0xe9, 0xbd, 0xf2,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x22, 0xb3, 0x86, 0xf8, 0xcf, 0x17, 0x00, 0x00,
+	// 1386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcb, 0x6f, 0x1b, 0xd5,
+	0x17, 0xc7, 0x73, 0xfb, 0xeb, 0x2b, 0x27, 0x7d, 0xe8, 0x77, 0x9b, 0xb6, 0xce, 0x24, 0x38, 0x61,
+	0x1a, 0xf2, 0xce, 0x4c, 0xec, 0x3c, 0x0a, 0x94, 0x82, 0x62, 0x51, 0x42, 0x24, 0x8a, 0x52, 0x53,
+	0x21, 0x81, 0x90, 0xa2, 0x71, 0x3c, 0x19, 0x46, 0xd8, 0x33, 0xae, 0x67, 0x1c, 0xd5, 0x8a, 0xbc,
+	0x41, 0x82, 0x05, 0x42, 0x08, 0x5a, 0x84, 0x4a, 0xc4, 0xa2, 0x0b, 0x24, 0xba, 0x64, 0x41, 0x85,
+	0xc4, 0xae, 0x2b, 0xca, 0xae, 0x82, 0x0d, 0x2b, 0x84, 0x12, 0x24, 0xfe, 0x0d, 0x34, 0xf7, 0x9e,
+	0xb1, 0xe7, 0x6d, 0x5b, 0x58, 0x34, 0x9b, 0xd6, 0x73, 0xef, 0x39, 0xf7, 0x7c, 0xee, 0xf7, 0xdc,
+	0x39, 0x73, 0xae, 0x02, 0xc3, 0x5b, 0xa6, 0x55, 0x36, 0x2d, 0x59, 0xab, 0x9a, 0xb5, 0x8a, 0xbc,
+	0x93, 0x91, 0x6f, 0xd5, 0xd4, 0x6a, 0x5d, 0xaa, 0x54, 0x4d, 0xdb, 0xa4, 0x67, 0xf9, 0xa4, 0xc4,
+	0x26, 0xa5, 0x9d, 0x8c, 0x30, 0xa8, 0x99, 0x9a, 0xc9, 0xe6, 0x64, 0xe7, 0x17, 0x37, 0x13, 0x46,
+	0x34, 0xd3, 0xd4, 0x4a, 0xaa, 0xac, 0x54, 0x74, 0x59, 0x31, 0x0c, 0xd3, 0x56, 0x6c, 0xdd, 0x34,
+	0x2c, 0x9c, 0x0d, 0x45, 0xb0, 0xeb, 0x15, 0xd5, 0x9d, 0x9c, 0xc1, 0xc9, 0x82, 0x62, 0xa9, 0x3c,
+	0xb4, 0xbc, 0x93, 0x29, 0xa8, 0xb6, 0x92, 0x91, 0x2b, 0x8a, 0xa6, 0x1b, 0x6c, 0x25, 0xb4, 0x1d,
+	0xe2, 0xb6, 0x9b, 0x3c, 0x3e, 0xa2, 0xf1, 0xa9, 0xff, 0x2b, 0x65, 0xdd, 0x30, 0x65, 0xf6, 0x2f,
+	0x1f, 0x12, 0xb3, 0x70, 0xfe, 0x86, 0xb3, 0xde, 0x9a, 0x13, 0x76, 0xdd, 0xd8, 0x36, 0xf3, 0xea,
+	0xad, 0x9a, 0x6a, 0xd9, 0x74, 0x08, 0x4e, 0x32, 0x94, 0x4d, 0xbd, 0x98, 0x22, 0x63, 0x64, 0xea,
+	0x68, 0xfe, 0x04, 0x7b, 0x5e, 0x2f, 0x8a, 0xaf, 0xc3, 0x85, 0xa0, 0x8f, 0x55, 0x31, 0x0d, 0x4b,
+	0xa5, 0x12, 0x1c, 0xd5, 0x8d, 0x6d, 0x93, 0x39, 0x0c, 0x64, 0x05, 0x29, 0x20, 0x8c, 0xd4, 0xf2,
+	0x60, 0x76, 0xe2, 0x0d, 0x18, 0x6e, 0xad, 0xb4, 0x61, 0x96, 0xf4, 0xad, 0xba, 0x97, 0x21, 0x0b,
+	0x27, 0x94, 0x62, 0xb1, 0xaa, 0x5a, 0x16, 0x5b, 0xb1, 0x3f, 0x97, 0xfa, 0xf5, 0x87, 0xf9, 0x41,
+	0x5c, 0x74, 0x95, 0xcf, 0xbc, 0x65, 0x57, 0x75, 0x43, 0xcb, 0xbb, 0x86, 0xe2, 0x4d, 0x18, 0x89,
+	0x5e, 0x12, 0x11, 0x97, 0x7c, 0x88, 0x63, 0xd1, 0x88, 0x1e, 0x3f, 0x0e, 0xda, 0x80, 0x54, 0x6b,
+	0xd5, 0xeb, 0x6a, 0xb9, 0xa0, 0x56, 0xad, 0xf6, 0x4a, 0xd1, 0xd7, 0x00, 0x5a, 0xf9, 0x49, 0x1d,
+	0x61, 0x21, 0x27, 0xdc, 0x90, 0x4e, 0x32, 0x25, 0x7e, 0x8e, 0x30, 0x99, 0xd2, 0x86, 0xa2, 0xa9,
+	0xb8, 0x6c, 0xde, 0xe3, 0x29, 0x7e, 0x43, 0x60, 0x28, 0x22, 0x3e, 0x6e, 0x69, 0x05, 0x4e, 0x94,
+	0xf9, 0x50, 0x8a, 0x8c, 0xfd, 0x6f, 0x6a, 0x20, 0x3b, 0x12, 0xbd, 0x2b, 0xee, 0x97, 0x77, 0x8d,
+	0xe9, 0x5a, 0x04, 0xdd, 0x64, 0x5b, 0x3a, 0x1e, 0xd4, 0x87, 0x77, 0xd7, 0x87, 0x67, 0xe5, 0xea,
+	0xab, 0xc5, 0xb2, 0x6e, 0xb8, 0xfa, 0x48, 0x70, 0x4c, 0x71, 0x9e, 0xdb, 0xe6, 0x90, 0x9b, 0xf5,
+	0x4c, 0xb4, 0xaf, 0x09, 0x08, 0x51, 0x54, 0xa8, 0x5a, 0x16, 0x8e, 0x33, 0x79, 0x5c, 0xd1, 0x92,
+	0x4e, 0x2b, 0x5a, 0xf6, 0x4e, 0xb1, 0x8f, 0x08, 0x8c, 0x05, 0x8e, 0xa9, 0xae, 0x5a, 0x39, 0xfe,
+	0xf8, 0x1f, 0x1e, 0xac, 0x87, 0x04, 0x9e, 0x4d, 0xe0, 0x40, 0xa9, 0xd6, 0xe0, 0x0c, 0x07, 0xa9,
+	0xa0, 0x01, 0x4a, 0xd6, 0xfe, 0xed, 0x39, 0xad, 0x79, 0xd7, 0xed, 0x9d, 0x7e, 0x7b, 0x31, 0xfa,
+	0x1d, 0x8a, 0x83, 0x17, 0x27, 0xaa, 0xff, 0xfc, 0x1d, 0x3e, 0x51, 0x2f, 0xc3, 0x20, 0xc3, 0xde,
+	0xa8, 0x9a, 0x15, 0xd3, 0x52, 0x4a, 0xae, 0x8e, 0xa3, 0x30, 0x50, 0xc1, 0xa1, 0xd6, 0x51, 0x04,
+	0x77, 0x68, 0xbd, 0x28, 0xbe, 0x09, 0xe7, 0x03, 0x8e, 0xb8, 0xc7, 0x65, 0x38, 0xe9, 0x9a, 0x61,
+	0xc1, 0x1d, 0x0a, 0xed, 0xae, 0xe9, 0xd4, 0x34, 0x15, 0xef, 0x13, 0x10, 0x7d, 0x0b, 0xba, 0x27,
+	0x92, 0x8b, 0xf0, 0x2f, 0x3e, 0x0f, 0x3d, 0xcb, 0xf1, 0x77, 0x04, 0x2e, 0x25, 0x22, 0xa2, 0x02,
+	0x97, 0xa1, 0xdf, 0xdd, 0x96, 0x9b, 0xe0, 0x04, 0x09, 0x5a, 0xb6, 0xbd, 0xcb, 0x6a, 0x15, 0x46,
+	0x19, 0xe8, 0xdb, 0xa6, 0xad, 0xe6, 0x9a, 0xb8, 0xce, 0x53, 0xb5, 0xd3, 0x04, 0x3b, 0x6f, 0xd2,
+	0x8e, 0xe3, 0x90, 0x3a, 0xd2, 0x46, 0x67, 0x6e, 0x26, 0x5e, 0xc7, 0xb7, 0x33, 0x32, 0x26, 0x2a,
+	0x33, 0x0d, 0x47, 0x1d, 0x63, 0x3c, 0x17, 0xe7, 0x43, 0xa2, 0x38, 0xd6, 0x79, 0x66, 0x22, 0x7e,
+	0x4c, 0xb0, 0x4f, 0x70, 0xc6, 0xac, 0x5c, 0xd7, 0x07, 0xb4, 0x67, 0x59, 0xff, 0x92, 0xc0, 0x48,
+	0x34, 0x08, 0x6e, 0x6a, 0x96, 0x0b, 0xe5, 0xa6, 0x3a, 0x66, 0x57, 0xdc, 0xa6, 0x77, 0x29, 0xbe,
+	0x43, 0xb0, 0x3d, 0x41, 0x2c, 0x5f, 0x72, 0x9b, 0xb9, 0x23, 0x1d, 0xe5, 0xae, 0x67, 0x5a, 0x7d,
+	0xe1, 0x36, 0x05, 0x7e, 0xa8, 0xa7, 0x2a, 0xd4, 0xbd, 0x60, 0x4b, 0x80, 0x2d, 0xd1, 0x21, 0x28,
+	0x28, 0x7b, 0x04, 0x86, 0x23, 0xd1, 0x0e, 0x43, 0xbb, 0xf2, 0x22, 0x5c, 0x64, 0x6c, 0x37, 0x95,
+	0x52, 0xc9, 0xa9, 0x6d, 0xb5, 0x92, 0xdd, 0xf1, 0xc7, 0xe1, 0x1d, 0x48, 0x85, 0x7d, 0x71, 0x53,
+	0x57, 0xe1, 0x98, 0xed, 0x0c, 0x63, 0x11, 0x08, 0xf7, 0xad, 0x1e, 0xa7, 0x5c, 0xff, 0xe3, 0x3f,
+	0x46, 0xfb, 0x1e, 0xfc, 0xfd, 0xfd, 0x0c, 0xc9, 0x73, 0x2f, 0xf1, 0x3d, 0xa0, 0x1e, 0xc9, 0x5c,
+	0xa2, 0x5e, 0x65, 0xe4, 0x0e, 0x81, 0x73, 0xbe, 0xe5, 0x0f, 0x43, 0x26, 0x3e, 0x73, 0xbf, 0x3b,
+	0xd7, 0x6e, 0xab, 0x5b, 0x35, 0x67, 0xe8, 0x0d, 0x53, 0x7b, 0x9a, 0x25, 0xf1, 0x21, 0x81, 0xf1,
+	0x64, 0x20, 0x94, 0xed, 0x55, 0x38, 0xa3, 0xba, 0x26, 0x9b, 0x25, 0x53, 0x73, 0xe5, 0x7b, 0x26,
+	0x24, 0x9f, 0x77, 0xa5, 0xfc, 0x69, 0xd5, 0xbb, 0x6e, 0xcf, 0x84, 0xcc, 0xfe, 0x7c, 0x0e, 0x8e,
+	0x31, 0x6e, 0xfa, 0x29, 0x81, 0xfe, 0x66, 0xc6, 0xe8, 0x44, 0x08, 0x27, 0xf2, 0x7e, 0x2c, 0x4c,
+	0xb6, 0xb5, 0xe3, 0x41, 0x45, 0xe9, 0xc3, 0xdf, 0xfe, 0xba, 0x7b, 0x64, 0x8a, 0x4e, 0xc8, 0xc1,
+	0x1b, 0x3e, 0x36, 0xf7, 0xc6, 0xb6, 0x29, 0xef, 0xe2, 0xef, 0x62, 0x83, 0x7e, 0x4b, 0xe0, 0x6c,
+	0xa0, 0xe3, 0xa3, 0x73, 0x09, 0xc1, 0x42, 0xd7, 0x66, 0x61, 0xbe, 0x43, 0x6b, 0x04, 0x5c, 0x62,
+	0x80, 0x12, 0x9d, 0x8b, 0x01, 0x64, 0xfd, 0x69, 0x1d, 0x39, 0xb1, 0xec, 0x35, 0xe8, 0x3d, 0x02,
+	0xa7, 0xbc, 0xb7, 0x51, 0x3a, 0x9d, 0x10, 0xd5, 0x7f, 0x63, 0x16, 0x66, 0x3a, 0x31, 0x45, 0xba,
+	0x0c, 0xa3, 0x9b, 0xa5, 0xd3, 0x31, 0x74, 0x78, 0x99, 0xf5, 0x2a, 0xb8, 0x47, 0xe0, 0xb4, 0xef,
+	0xce, 0x47, 0x93, 0x02, 0x06, 0x6e, 0x0d, 0xc2, 0x6c, 0x47, 0xb6, 0x48, 0xb7, 0xc0, 0xe8, 0x66,
+	0xe8, 0x54, 0x34, 0x9d, 0xb5, 0x59, 0xa8, 0x6f, 0xb2, 0xcb, 0x85, 0xa3, 0x5c, 0x59, 0x37, 0x1a,
+	0xf4, 0x27, 0x02, 0x83, 0x51, 0x97, 0x2d, 0x9a, 0x69, 0x97, 0xb5, 0xd0, 0x05, 0x51, 0xc8, 0x76,
+	0xe3, 0x82, 0xc4, 0x57, 0x18, 0xf1, 0x32, 0x5d, 0x4c, 0xca, 0xb6, 0xae, 0x32, 0x72, 0x3e, 0xe5,
+	0x51, 0xf6, 0xc7, 0x30, 0x3c, 0x17, 0xb8, 0x33, 0x78, 0x9f, 0xce, 0xd9, 0x6e, 0x5c, 0x10, 0xfe,
+	0x79, 0x06, 0x9f, 0xa5, 0x0b, 0x1d, 0xc0, 0xfb, 0x65, 0xff, 0x84, 0xc0, 0x49, 0xb7, 0x24, 0xd1,
+	0xe7, 0xa2, 0x43, 0x07, 0x6a, 0xa8, 0x30, 0xd1, 0xce, 0x0c, 0xa9, 0x64, 0x46, 0x35, 0x4d, 0x27,
+	0x43, 0x54, 0x6e, 0xbd, 0x95, 0x77, 0x3d, 0xc5, 0xb8, 0x41, 0x1f, 0x11, 0xb8, 0x10, 0x7d, 0x6f,
+	0xa0, 0x8b, 0xc9, 0x31, 0x23, 0x2f, 0x42, 0xc2, 0x52, 0x77, 0x4e, 0x88, 0xfd, 0x12, 0xc3, 0x5e,
+	0xa1, 0x4b, 0xb1, 0xd8, 0xad, 0x43, 0x80, 0x45, 0xc0, 0xf3, 0xfe, 0x3f, 0x22, 0x70, 0x2e, 0xa2,
+	0xbd, 0xa7, 0x0b, 0xd1, 0x2c, 0xf1, 0xb7, 0x0f, 0x21, 0xd3, 0x85, 0x07, 0xa2, 0x5f, 0x63, 0xe8,
+	0xaf, 0xd0, 0xab, 0x21, 0x74, 0xa7, 0x61, 0x74, 0xa8, 0x9b, 0x7a, 0x3b, 0x03, 0x55, 0xbf, 0xfe,
+	0xf2, 0x2e, 0x1b, 0x6c, 0xd0, 0x07, 0x04, 0xce, 0x06, 0x3a, 0xf9, 0xb8, 0x52, 0x1b, 0x7d, 0xf3,
+	0x10, 0xe6, 0x3b, 0xb4, 0x6e, 0x7b, 0x7e, 0x1d, 0x22, 0xcb, 0x0b, 0x1e, 0x38, 0x32, 0x5f, 0x11,
+	0x38, 0xe5, 0x6d, 0xa4, 0xe3, 0xca, 0x6d, 0xc4, 0x0d, 0x20, 0xae, 0xdc, 0x46, 0xf5, 0xe5, 0x09,
+	0x67, 0xb9, 0x49, 0x88, 0x8a, 0xa2, 0x86, 0xf7, 0x09, 0x9c, 0xf1, 0xb7, 0xac, 0xb4, 0x4d, 0x05,
+	0xf5, 0xf5, 0xdc, 0xc2, 0x5c, 0x67, 0xc6, 0x88, 0xb7, 0xc8, 0xf0, 0xe6, 0xe9, 0x6c, 0x42, 0xbd,
+	0xe5, 0x5f, 0x04, 0xcf, 0x51, 0xdd, 0x23, 0x30, 0xe0, 0x69, 0x24, 0xe9, 0x54, 0x74, 0xc8, 0x70,
+	0x73, 0x2b, 0x4c, 0x77, 0x60, 0x89, 0x64, 0x2b, 0x8c, 0x6c, 0x81, 0x4a, 0xf1, 0x6f, 0x53, 0xe0,
+	0x14, 0xb2, 0x1e, 0x96, 0xda, 0x70, 0x9c, 0xef, 0x95, 0x5e, 0x4a, 0x52, 0xc2, 0x25, 0x1a, 0x4f,
+	0x36, 0x42, 0x98, 0x51, 0x06, 0x33, 0x44, 0x2f, 0xc6, 0xc8, 0x44, 0x7f, 0x21, 0x70, 0x31, 0xa6,
+	0x61, 0xa3, 0x31, 0xd5, 0x24, 0xb9, 0xe1, 0x14, 0x96, 0xbb, 0xf4, 0x42, 0xd2, 0x55, 0x46, 0x7a,
+	0x85, 0xbe, 0x10, 0x22, 0xf5, 0x37, 0x8b, 0xf1, 0xaf, 0x46, 0xee, 0xe5, 0xc7, 0xfb, 0x69, 0xf2,
+	0x64, 0x3f, 0x4d, 0xfe, 0xdc, 0x4f, 0x93, 0xcf, 0x0f, 0xd2, 0x7d, 0x4f, 0x0e, 0xd2, 0x7d, 0xbf,
+	0x1f, 0xa4, 0xfb, 0xde, 0x1d, 0xd7, 0x74, 0xfb, 0xfd, 0x5a, 0x41, 0xda, 0x32, 0xcb, 0xee, 0xf2,
+	0xfc, 0xbf, 0x79, 0xab, 0xf8, 0x81, 0x7c, 0x9b, 0xc7, 0x2a, 0x1c, 0x67, 0x7f, 0x09, 0x59, 0xfc,
+	0x67, 0x00, 0x73, 0xb9, 0x9a, 0xdd, 0xe4, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1651,6 +1768,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(ctx context.Context, in *QueryGroupsRequest, opts ...grpc.CallOption) (*QueryGroupsResponse, error)
+	// ExecutionLogsByProposal queries the execution logs of a proposal.
+	ExecutionLogsByProposal(ctx context.Context, in *QueryExecutionLogsByProposalRequest, opts ...grpc.CallOption) (*QueryExecutionLogsByProposalResponse, error)
 }
 
 type queryClient struct {
@@ -1787,6 +1906,15 @@ func (c *queryClient) Groups(ctx context.Context, in *QueryGroupsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ExecutionLogsByProposal(ctx context.Context, in *QueryExecutionLogsByProposalRequest, opts ...grpc.CallOption) (*QueryExecutionLogsByProposalResponse, error) {
+	out := new(QueryExecutionLogsByProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Query/ExecutionLogsByProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GroupInfo queries group info based on group id.
@@ -1823,6 +1951,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47.1
 	Groups(context.Context, *QueryGroupsRequest) (*QueryGroupsResponse, error)
+	// ExecutionLogsByProposal queries the execution logs of a proposal.
+	ExecutionLogsByProposal(context.Context, *QueryExecutionLogsByProposalRequest) (*QueryExecutionLogsByProposalResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1871,6 +2001,9 @@ func (*UnimplementedQueryServer) TallyResult(ctx context.Context, req *QueryTall
 func (*UnimplementedQueryServer) Groups(ctx context.Context, req *QueryGroupsRequest) (*QueryGroupsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
 }
+func (*UnimplementedQueryServer) ExecutionLogsByProposal(ctx context.Context, req *QueryExecutionLogsByProposalRequest) (*QueryExecutionLogsByProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionLogsByProposal not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2128,6 +2261,24 @@ func _Query_Groups_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExecutionLogsByProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionLogsByProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionLogsByProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Query/ExecutionLogsByProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionLogsByProposal(ctx, req.(*QueryExecutionLogsByProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2188,6 +2339,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Groups",
 			Handler:    _Query_Groups_Handler,
 		},
+		{
+			MethodName: "ExecutionLogsByProposal",
+			Handler:    _Query_ExecutionLogsByProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/query.proto",
@@ -3321,6 +3476,95 @@ func (m *QueryGroupsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryExecutionLogsByProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionLogsByProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionLogsByProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionLogsByProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionLogsByProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionLogsByProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExecutionLogs) > 0 {
+		for iNdEx := len(m.ExecutionLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3777,6 +4021,41 @@ func (m *QueryGroupsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryExecutionLogsByProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutionLogsByProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExecutionLogs) > 0 {
+		for _, e := range m.ExecutionLogs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6671,6 +6950,231 @@ func (m *QueryGroupsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryExecutionLogsByProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionLogsByProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionLogsByProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionLogsByProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionLogsByProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionLogsByProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionLogs = append(m.ExecutionLogs, &ExecutionLog{})
+			if err := m.ExecutionLogs[len(m.ExecutionLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,3 +23,8 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+// StakingKeeper extends `StakingKeeper` from expected_keepers.
+type StakingKeeper interface {
+	group.StakingKeeper
+}
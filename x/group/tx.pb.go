@@ -960,6 +960,112 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateGroupPolicyMetadataResponse proto.InternalMessageInfo
 
+// MsgUpdateGroupPolicyExecutionSettings is the Msg/UpdateGroupPolicyExecutionSettings request type.
+type MsgUpdateGroupPolicyExecutionSettings struct {
+	// admin is the account address of the group admin.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// group_policy_address is the account address of group policy.
+	GroupPolicyAddress string `protobuf:"bytes,2,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// execution_settings is the updated group policy's execution settings. If
+	// empty, the execution settings are removed.
+	ExecutionSettings *ExecutionSettings `protobuf:"bytes,3,opt,name=execution_settings,json=executionSettings,proto3" json:"execution_settings,omitempty"`
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettings) Reset()         { *m = MsgUpdateGroupPolicyExecutionSettings{} }
+func (m *MsgUpdateGroupPolicyExecutionSettings) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateGroupPolicyExecutionSettings) ProtoMessage()    {}
+func (*MsgUpdateGroupPolicyExecutionSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{18}
+}
+func (m *MsgUpdateGroupPolicyExecutionSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyExecutionSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyExecutionSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyExecutionSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyExecutionSettings.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyExecutionSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyExecutionSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyExecutionSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyExecutionSettings proto.InternalMessageInfo
+
+func (m *MsgUpdateGroupPolicyExecutionSettings) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettings) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettings) GetExecutionSettings() *ExecutionSettings {
+	if m != nil {
+		return m.ExecutionSettings
+	}
+	return nil
+}
+
+// MsgUpdateGroupPolicyExecutionSettingsResponse is the Msg/UpdateGroupPolicyExecutionSettings response type.
+type MsgUpdateGroupPolicyExecutionSettingsResponse struct {
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) Reset() {
+	*m = MsgUpdateGroupPolicyExecutionSettingsResponse{}
+}
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgUpdateGroupPolicyExecutionSettingsResponse) ProtoMessage() {}
+func (*MsgUpdateGroupPolicyExecutionSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{19}
+}
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateGroupPolicyExecutionSettingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateGroupPolicyExecutionSettingsResponse.Merge(m, src)
+}
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateGroupPolicyExecutionSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateGroupPolicyExecutionSettingsResponse proto.InternalMessageInfo
+
 // MsgSubmitProposal is the Msg/SubmitProposal request type.
 type MsgSubmitProposal struct {
 	// group_policy_address is the account address of group policy.
@@ -989,7 +1095,7 @@ func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
 func (m *MsgSubmitProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposal) ProtoMessage()    {}
 func (*MsgSubmitProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{18}
+	return fileDescriptor_6b8d3d629f136420, []int{20}
 }
 func (m *MsgSubmitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1134,7 @@ func (m *MsgSubmitProposalResponse) Reset()         { *m = MsgSubmitProposalResp
 func (m *MsgSubmitProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitProposalResponse) ProtoMessage()    {}
 func (*MsgSubmitProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{19}
+	return fileDescriptor_6b8d3d629f136420, []int{21}
 }
 func (m *MsgSubmitProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1182,7 @@ func (m *MsgWithdrawProposal) Reset()         { *m = MsgWithdrawProposal{} }
 func (m *MsgWithdrawProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposal) ProtoMessage()    {}
 func (*MsgWithdrawProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{20}
+	return fileDescriptor_6b8d3d629f136420, []int{22}
 }
 func (m *MsgWithdrawProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1233,7 @@ func (m *MsgWithdrawProposalResponse) Reset()         { *m = MsgWithdrawProposal
 func (m *MsgWithdrawProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawProposalResponse) ProtoMessage()    {}
 func (*MsgWithdrawProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{21}
+	return fileDescriptor_6b8d3d629f136420, []int{23}
 }
 func (m *MsgWithdrawProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1175,7 +1281,7 @@ func (m *MsgVote) Reset()         { *m = MsgVote{} }
 func (m *MsgVote) String() string { return proto.CompactTextString(m) }
 func (*MsgVote) ProtoMessage()    {}
 func (*MsgVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{22}
+	return fileDescriptor_6b8d3d629f136420, []int{24}
 }
 func (m *MsgVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1247,7 +1353,7 @@ func (m *MsgVoteResponse) Reset()         { *m = MsgVoteResponse{} }
 func (m *MsgVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgVoteResponse) ProtoMessage()    {}
 func (*MsgVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{23}
+	return fileDescriptor_6b8d3d629f136420, []int{25}
 }
 func (m *MsgVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1288,7 +1394,7 @@ func (m *MsgExec) Reset()         { *m = MsgExec{} }
 func (m *MsgExec) String() string { return proto.CompactTextString(m) }
 func (*MsgExec) ProtoMessage()    {}
 func (*MsgExec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{24}
+	return fileDescriptor_6b8d3d629f136420, []int{26}
 }
 func (m *MsgExec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1341,7 +1447,7 @@ func (m *MsgExecResponse) Reset()         { *m = MsgExecResponse{} }
 func (m *MsgExecResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecResponse) ProtoMessage()    {}
 func (*MsgExecResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{25}
+	return fileDescriptor_6b8d3d629f136420, []int{27}
 }
 func (m *MsgExecResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1389,7 +1495,7 @@ func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
 func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroup) ProtoMessage()    {}
 func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{26}
+	return fileDescriptor_6b8d3d629f136420, []int{28}
 }
 func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1440,7 +1546,7 @@ func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
 func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLeaveGroupResponse) ProtoMessage()    {}
 func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6b8d3d629f136420, []int{27}
+	return fileDescriptor_6b8d3d629f136420, []int{29}
 }
 func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1469,6 +1575,98 @@ func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
 
+// MsgVetoProposal is the Msg/VetoProposal request type.
+type MsgVetoProposal struct {
+	// proposal is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// vetoer is the veto address of the group policy.
+	Vetoer string `protobuf:"bytes,2,opt,name=vetoer,proto3" json:"vetoer,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{30}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgVetoProposal) GetVetoer() string {
+	if m != nil {
+		return m.Vetoer
+	}
+	return ""
+}
+
+// MsgVetoProposalResponse is the Msg/VetoProposal response type.
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b8d3d629f136420, []int{31}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1.MsgCreateGroup")
@@ -1489,6 +1687,8 @@ func init() {
 	proto.RegisterType((*MsgUpdateGroupPolicyDecisionPolicyResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicyResponse")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadata)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadata")
 	proto.RegisterType((*MsgUpdateGroupPolicyMetadataResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyMetadataResponse")
+	proto.RegisterType((*MsgUpdateGroupPolicyExecutionSettings)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettings")
+	proto.RegisterType((*MsgUpdateGroupPolicyExecutionSettingsResponse)(nil), "cosmos.group.v1.MsgUpdateGroupPolicyExecutionSettingsResponse")
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.group.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.group.v1.MsgSubmitProposalResponse")
 	proto.RegisterType((*MsgWithdrawProposal)(nil), "cosmos.group.v1.MsgWithdrawProposal")
@@ -1499,103 +1699,112 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1.MsgExecResponse")
 	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1.MsgLeaveGroup")
 	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1.MsgLeaveGroupResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.group.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.group.v1.MsgVetoProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/tx.proto", fileDescriptor_6b8d3d629f136420) }
 
 var fileDescriptor_6b8d3d629f136420 = []byte{
-	// 1443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xda, 0xce, 0xaf, 0x97, 0x6f, 0xdd, 0x64, 0x9b, 0xb4, 0x9b, 0x6d, 0x6b, 0xbb, 0xd3,
-	0xb4, 0x49, 0xad, 0xc6, 0x6e, 0x9c, 0x6f, 0x2b, 0x61, 0x10, 0xa8, 0x49, 0x0d, 0x0a, 0xc2, 0x10,
-	0x6d, 0x5b, 0x0a, 0x5c, 0xcc, 0x26, 0xde, 0x6e, 0x57, 0x64, 0xbd, 0xc6, 0xb3, 0x4e, 0x93, 0x1b,
-	0x3f, 0x2e, 0xd0, 0x0b, 0x48, 0xf0, 0x07, 0xc0, 0x8d, 0x63, 0x91, 0x7a, 0xe0, 0xc6, 0x0d, 0x55,
-	0xe5, 0x52, 0x71, 0xe2, 0x84, 0xa0, 0x15, 0xea, 0x8d, 0x7f, 0x01, 0xb4, 0x33, 0xbb, 0xe3, 0x1d,
-	0xef, 0xae, 0x77, 0x6b, 0x59, 0x70, 0x89, 0xb2, 0xf3, 0x3e, 0xef, 0xd7, 0xe7, 0xbd, 0x79, 0x33,
-	0x63, 0x90, 0x76, 0x2d, 0x6c, 0x5a, 0xb8, 0xac, 0x77, 0xac, 0x6e, 0xbb, 0xbc, 0xbf, 0x56, 0xb6,
-	0x0f, 0x4a, 0xed, 0x8e, 0x65, 0x5b, 0xe2, 0x51, 0x2a, 0x29, 0x11, 0x49, 0x69, 0x7f, 0x4d, 0x9e,
-	0xd7, 0x2d, 0xdd, 0x22, 0xb2, 0xb2, 0xf3, 0x1f, 0x85, 0xc9, 0x8b, 0x14, 0xd6, 0xa0, 0x02, 0x57,
-	0xc7, 0x15, 0xe9, 0x96, 0xa5, 0xef, 0x69, 0x65, 0xf2, 0xb5, 0xd3, 0xbd, 0x5d, 0x56, 0x5b, 0x87,
-	0xae, 0xe8, 0x64, 0xc0, 0xed, 0x61, 0x5b, 0xf3, 0xf4, 0x4e, 0xb8, 0x42, 0x13, 0xeb, 0x8e, 0xc8,
-	0xc4, 0xba, 0x2b, 0x98, 0x53, 0x4d, 0xa3, 0x65, 0x95, 0xc9, 0x5f, 0xba, 0x84, 0x7e, 0x16, 0x20,
-	0x5b, 0xc7, 0xfa, 0x66, 0x47, 0x53, 0x6d, 0xed, 0x35, 0xc7, 0x9a, 0x58, 0x82, 0x71, 0xb5, 0x69,
-	0x1a, 0x2d, 0x49, 0x28, 0x08, 0x2b, 0xd3, 0x1b, 0xd2, 0x2f, 0x0f, 0x56, 0xe7, 0xdd, 0xb8, 0xae,
-	0x36, 0x9b, 0x1d, 0x0d, 0xe3, 0xeb, 0x76, 0xc7, 0x68, 0xe9, 0x0a, 0x85, 0x89, 0x9b, 0x30, 0x69,
-	0x6a, 0xe6, 0x8e, 0xd6, 0xc1, 0x52, 0xaa, 0x90, 0x5e, 0x99, 0xa9, 0xe4, 0x4a, 0x7d, 0xa9, 0x97,
-	0xea, 0x44, 0xae, 0x68, 0x1f, 0x76, 0x35, 0x6c, 0x6f, 0x4c, 0x3f, 0xfc, 0x2d, 0x3f, 0xf6, 0xdd,
-	0xb3, 0xfb, 0x45, 0x41, 0xf1, 0x34, 0x45, 0x19, 0xa6, 0x4c, 0xcd, 0x56, 0x9b, 0xaa, 0xad, 0x4a,
-	0x69, 0xc7, 0xaf, 0xc2, 0xbe, 0xab, 0x2b, 0x9f, 0x3c, 0xbb, 0x5f, 0xa4, 0xce, 0xee, 0x3d, 0xbb,
-	0x5f, 0x74, 0x19, 0x5b, 0xc5, 0xcd, 0x0f, 0xca, 0x7c, 0xe8, 0x68, 0x1d, 0x8e, 0xf3, 0x2b, 0x8a,
-	0x86, 0xdb, 0x56, 0x0b, 0x6b, 0xe2, 0x22, 0x4c, 0x91, 0x68, 0x1a, 0x46, 0x93, 0xe4, 0x95, 0x51,
-	0x26, 0xc9, 0xf7, 0x56, 0x13, 0xfd, 0x29, 0xc0, 0x42, 0x1d, 0xeb, 0x37, 0xdb, 0x4d, 0x4f, 0xab,
-	0xee, 0x06, 0xf5, 0xbc, 0x4c, 0xf8, 0x9d, 0xa4, 0x38, 0x27, 0xe2, 0x36, 0x64, 0x69, 0xaa, 0x8d,
-	0x2e, 0xf1, 0x83, 0xa5, 0xf4, 0xf3, 0x72, 0x75, 0x84, 0x1a, 0xa0, 0x71, 0xe2, 0x6a, 0x99, 0x67,
-	0xa5, 0xc0, 0xb3, 0x12, 0xcc, 0x06, 0xe5, 0xe1, 0x74, 0xa8, 0xc0, 0xe3, 0x08, 0xfd, 0x24, 0xc0,
-	0x31, 0x1e, 0x71, 0x95, 0xa4, 0x35, 0x42, 0x1a, 0x2e, 0xc3, 0x74, 0x4b, 0xbb, 0xdb, 0xa0, 0xe6,
-	0xd2, 0x31, 0xe6, 0xa6, 0x5a, 0xda, 0x5d, 0x12, 0x41, 0x75, 0x95, 0xcf, 0x35, 0x17, 0x99, 0x2b,
-	0x81, 0xa3, 0xd3, 0x70, 0x32, 0x64, 0x99, 0xe5, 0xf9, 0xbd, 0x40, 0xda, 0x84, 0x63, 0x82, 0xb6,
-	0xda, 0x28, 0x53, 0x1d, 0xd4, 0xd1, 0x97, 0xf8, 0x7c, 0xce, 0x0c, 0xa8, 0x1d, 0xd5, 0x40, 0x05,
-	0xc8, 0x85, 0x4b, 0x58, 0x56, 0x5f, 0xa7, 0x60, 0x9e, 0x6f, 0xfe, 0x6d, 0x6b, 0xcf, 0xd8, 0x3d,
-	0xfc, 0x97, 0x72, 0x12, 0x55, 0x38, 0xda, 0xd4, 0x76, 0x0d, 0x6c, 0x58, 0xad, 0x46, 0x9b, 0x78,
-	0x96, 0x32, 0x05, 0x61, 0x65, 0xa6, 0x32, 0x5f, 0xa2, 0x73, 0xac, 0xe4, 0xcd, 0xb1, 0xd2, 0xd5,
-	0xd6, 0xe1, 0x06, 0x7a, 0xf4, 0x60, 0x35, 0xd7, 0xdf, 0xfb, 0xd7, 0x5c, 0x03, 0x34, 0x72, 0x25,
-	0xdb, 0xe4, 0xbe, 0xab, 0x95, 0xcf, 0xbe, 0xc9, 0x8f, 0xf1, 0xd4, 0xe5, 0x23, 0x87, 0x01, 0xd5,
-	0x41, 0x0a, 0x9c, 0x0a, 0x5b, 0x67, 0x83, 0xa1, 0x02, 0x93, 0x2a, 0x65, 0x21, 0x96, 0x1f, 0x0f,
-	0x88, 0x3e, 0x4d, 0xc1, 0x22, 0x5f, 0x0d, 0x6a, 0x74, 0xb8, 0xed, 0xf2, 0x3a, 0xcc, 0x53, 0xbe,
-	0x29, 0x6b, 0x0d, 0x2f, 0x9c, 0x54, 0x8c, 0xba, 0xa8, 0xfb, 0x3d, 0x13, 0xc9, 0xb0, 0xfb, 0x6b,
-	0x9d, 0x27, 0x75, 0x29, 0xb2, 0x1f, 0x7d, 0x79, 0xa2, 0xb3, 0x70, 0x26, 0x52, 0xc8, 0xba, 0xf2,
-	0x87, 0x34, 0x48, 0x3c, 0xff, 0xb7, 0x0c, 0xfb, 0xce, 0x90, 0x9d, 0x39, 0x92, 0x93, 0xe6, 0x1c,
-	0x64, 0x29, 0xdd, 0x7d, 0x9d, 0x7c, 0x44, 0xe7, 0x26, 0x41, 0x05, 0x16, 0xb8, 0xaa, 0x30, 0x74,
-	0x86, 0xa0, 0x8f, 0xf9, 0xc8, 0x67, 0x3a, 0x6b, 0x7d, 0x3a, 0x2a, 0x76, 0x2b, 0x31, 0x5e, 0x10,
-	0x56, 0xa6, 0xf8, 0x82, 0x61, 0xda, 0x2c, 0x21, 0xbb, 0x66, 0x62, 0xc4, 0xbb, 0xe6, 0x4a, 0x70,
-	0xd7, 0x9c, 0x8d, 0xdc, 0x35, 0xbd, 0xea, 0xa0, 0xcf, 0x05, 0x28, 0x44, 0x09, 0x13, 0x9c, 0xab,
-	0xa3, 0xec, 0x6b, 0xf4, 0x63, 0x0a, 0x50, 0x58, 0xb3, 0xf1, 0xa9, 0xff, 0xa7, 0x5b, 0x2f, 0xa4,
-	0x92, 0xe9, 0x11, 0x57, 0xb2, 0x1a, 0xac, 0xe4, 0x72, 0xe4, 0x56, 0xe5, 0x6d, 0xa1, 0x8b, 0x50,
-	0x8c, 0x27, 0x90, 0x6d, 0xdb, 0xbf, 0x04, 0x32, 0x36, 0x03, 0xf0, 0xa1, 0x0f, 0xca, 0x51, 0x32,
-	0x3d, 0xe8, 0x64, 0xbd, 0x92, 0x94, 0x1e, 0x3e, 0x1f, 0x74, 0x1e, 0x96, 0x06, 0xc9, 0x19, 0x31,
-	0x7f, 0xa4, 0x60, 0xae, 0x8e, 0xf5, 0xeb, 0xdd, 0x1d, 0xd3, 0xb0, 0xb7, 0x3b, 0x56, 0xdb, 0xc2,
-	0xea, 0x5e, 0x64, 0x76, 0xc2, 0x10, 0xd9, 0x9d, 0x82, 0xe9, 0x36, 0xb1, 0xeb, 0x8d, 0xb9, 0x69,
-	0xa5, 0xb7, 0x30, 0xf0, 0x04, 0xbe, 0xe4, 0xc8, 0x30, 0x56, 0x75, 0x0d, 0x4b, 0x19, 0x32, 0x1f,
-	0x43, 0x5b, 0x4f, 0x61, 0x28, 0xf1, 0x02, 0x64, 0xb4, 0x03, 0x6d, 0x97, 0xcc, 0xa7, 0x6c, 0x65,
-	0x21, 0x30, 0x4d, 0x6b, 0x07, 0xda, 0xae, 0x42, 0x20, 0xe2, 0x3c, 0x8c, 0xdb, 0x86, 0xbd, 0xa7,
-	0x91, 0xf1, 0x34, 0xad, 0xd0, 0x0f, 0x51, 0x82, 0x49, 0xdc, 0x35, 0x4d, 0xb5, 0x73, 0x28, 0x4d,
-	0x92, 0x75, 0xef, 0xb3, 0xfa, 0x82, 0xd7, 0xab, 0xbd, 0xe0, 0x9d, 0x82, 0x20, 0x5f, 0x41, 0xe8,
-	0xe3, 0x25, 0xc0, 0x26, 0x7a, 0x89, 0x9c, 0xae, 0xfc, 0x22, 0x1b, 0x38, 0x79, 0x98, 0x69, 0xbb,
-	0x6b, 0xbd, 0x99, 0x03, 0xde, 0xd2, 0x56, 0x13, 0x7d, 0x4b, 0x6f, 0xb1, 0xce, 0xac, 0x6a, 0x76,
-	0xd4, 0xbb, 0xac, 0x46, 0x71, 0x8a, 0xfe, 0x9b, 0x40, 0x2a, 0xe1, 0x4d, 0xa0, 0x7a, 0xd9, 0xc9,
-	0xd0, 0xfb, 0xea, 0x3f, 0x3a, 0x59, 0x7e, 0xfd, 0xb1, 0xb8, 0x17, 0xd4, 0xfe, 0x65, 0xd6, 0x64,
-	0x7f, 0x0b, 0x30, 0x59, 0xc7, 0xfa, 0xdb, 0x96, 0x1d, 0x9f, 0xaf, 0xb3, 0x13, 0xf7, 0x2d, 0x5b,
-	0xeb, 0xc4, 0x06, 0x4d, 0x61, 0xe2, 0x3a, 0x4c, 0x58, 0x6d, 0xdb, 0xb0, 0xe8, 0xfd, 0x20, 0x5b,
-	0x39, 0x19, 0xa8, 0xba, 0xe3, 0xf7, 0x2d, 0x02, 0x51, 0x5c, 0x28, 0xd7, 0x76, 0x99, 0xbe, 0xb6,
-	0x4b, 0xde, 0x44, 0xd5, 0x65, 0xb2, 0x3b, 0x49, 0x1c, 0x0e, 0x59, 0x52, 0x18, 0x59, 0x8e, 0x77,
-	0x34, 0x07, 0x47, 0xdd, 0x7f, 0x19, 0x29, 0xf7, 0x28, 0x29, 0x8e, 0xb5, 0x78, 0x52, 0xfe, 0x0f,
-	0x53, 0x8e, 0xc3, 0xae, 0x6d, 0xc5, 0xf3, 0xc2, 0x90, 0xf4, 0xa1, 0x39, 0x81, 0x0d, 0xbd, 0x35,
-	0x20, 0x3e, 0x27, 0x00, 0xa4, 0x90, 0xf8, 0x48, 0x66, 0x5e, 0x63, 0xbe, 0x02, 0x13, 0x1d, 0x0d,
-	0x77, 0xf7, 0x6c, 0xe2, 0x30, 0x5b, 0x59, 0x0e, 0x10, 0xe1, 0xd5, 0xb9, 0xe6, 0xfa, 0x53, 0x08,
-	0x5c, 0x71, 0xd5, 0xd0, 0x17, 0x02, 0x1c, 0xa9, 0x63, 0xfd, 0x0d, 0x4d, 0xdd, 0x77, 0x5f, 0xe2,
-	0x43, 0xdc, 0x4d, 0x07, 0xdc, 0xde, 0xe9, 0x8b, 0xd1, 0xdf, 0xac, 0xb9, 0xb0, 0xfc, 0x7a, 0xfe,
-	0xd1, 0x09, 0xf2, 0x30, 0xee, 0x2d, 0x78, 0xb9, 0x16, 0x8b, 0x90, 0xa9, 0xd1, 0xa1, 0x30, 0x5b,
-	0x7b, 0xa7, 0xb6, 0xd9, 0xb8, 0xf9, 0xe6, 0xf5, 0xed, 0xda, 0xe6, 0xd6, 0xab, 0x5b, 0xb5, 0x6b,
-	0xb3, 0x63, 0xe2, 0xff, 0x60, 0x8a, 0xac, 0xde, 0x50, 0xde, 0x9d, 0x15, 0x2a, 0x8f, 0x66, 0x20,
-	0x5d, 0xc7, 0xba, 0x78, 0x0b, 0x66, 0xfc, 0xbf, 0x32, 0xe4, 0x83, 0x57, 0x37, 0xee, 0xae, 0x21,
-	0x2f, 0xc7, 0x00, 0x18, 0xf1, 0x7b, 0x20, 0x86, 0xbc, 0xdd, 0xcf, 0x87, 0xa9, 0x07, 0x71, 0x72,
-	0x29, 0x19, 0x8e, 0x79, 0xbb, 0x0d, 0xb3, 0x81, 0x07, 0xf2, 0x52, 0x8c, 0x0d, 0x82, 0x92, 0x2f,
-	0x26, 0x41, 0x31, 0x3f, 0x16, 0x1c, 0x0b, 0x7b, 0xa0, 0x2e, 0xc7, 0x86, 0x4b, 0x81, 0x72, 0x39,
-	0x21, 0x90, 0x39, 0x34, 0x60, 0x2e, 0xf8, 0x76, 0x3c, 0x17, 0x53, 0x04, 0x0a, 0x93, 0x57, 0x13,
-	0xc1, 0x98, 0xab, 0x2e, 0x2c, 0x84, 0x3f, 0x08, 0x2e, 0xc4, 0xd8, 0xe9, 0x41, 0xe5, 0xb5, 0xc4,
-	0x50, 0xe6, 0xf6, 0x00, 0x8e, 0x47, 0x3c, 0xd9, 0x8a, 0x31, 0x64, 0xf9, 0xb0, 0x72, 0x25, 0x39,
-	0x96, 0x79, 0xfe, 0x4a, 0x80, 0x7c, 0xdc, 0xdd, 0x75, 0x3d, 0x91, 0x5d, 0x5e, 0x49, 0x7e, 0x71,
-	0x08, 0x25, 0x16, 0xd5, 0xc7, 0x02, 0x2c, 0x46, 0xdf, 0xf0, 0x56, 0x13, 0x99, 0x66, 0xfd, 0x76,
-	0xf9, 0xb9, 0xe0, 0x2c, 0x86, 0xf7, 0x21, 0xdb, 0x77, 0x97, 0x42, 0x61, 0x86, 0x78, 0x8c, 0x5c,
-	0x8c, 0xc7, 0xf8, 0x37, 0x6c, 0xe0, 0x2e, 0x10, 0xba, 0x61, 0xfb, 0x51, 0xe1, 0x1b, 0x36, 0xea,
-	0xd0, 0x16, 0x37, 0x20, 0x43, 0x0e, 0x6c, 0x29, 0x4c, 0xcb, 0x91, 0xc8, 0x85, 0x28, 0x89, 0xdf,
-	0x06, 0x99, 0xab, 0xa1, 0x36, 0x1c, 0x49, 0xb8, 0x0d, 0xee, 0x1c, 0xba, 0x01, 0xe0, 0x3b, 0x42,
-	0x72, 0x61, 0xf8, 0x9e, 0x5c, 0x3e, 0x3f, 0x58, 0xee, 0x59, 0x95, 0xc7, 0x3f, 0x72, 0x5e, 0xd1,
-	0x1b, 0x2f, 0x3f, 0x7c, 0x92, 0x13, 0x1e, 0x3f, 0xc9, 0x09, 0xbf, 0x3f, 0xc9, 0x09, 0x5f, 0x3e,
-	0xcd, 0x8d, 0x3d, 0x7e, 0x9a, 0x1b, 0xfb, 0xf5, 0x69, 0x6e, 0xec, 0xbd, 0x25, 0xdd, 0xb0, 0xef,
-	0x74, 0x77, 0x4a, 0xbb, 0x96, 0xe9, 0xfe, 0x8a, 0x5d, 0xf6, 0x9d, 0x2e, 0x07, 0xf4, 0x7c, 0xd9,
-	0x99, 0x20, 0x17, 0xd1, 0xf5, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x77, 0x54, 0x7d, 0x37,
-	0x17, 0x00, 0x00,
+	// 1568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcb, 0x6f, 0x1b, 0x55,
+	0x17, 0xcf, 0xd8, 0xce, 0xeb, 0xa4, 0x71, 0x92, 0x69, 0xd2, 0x3a, 0xd3, 0xd6, 0x71, 0x6f, 0x93,
+	0x26, 0xb5, 0x6a, 0xbb, 0x71, 0xda, 0x4a, 0x9f, 0xbf, 0x4f, 0xfd, 0xd4, 0xa4, 0x06, 0x05, 0x61,
+	0x08, 0x93, 0x96, 0x42, 0x37, 0x66, 0x12, 0x4f, 0xa7, 0x23, 0x62, 0x8f, 0xf1, 0x1d, 0xa7, 0xc9,
+	0x8e, 0xc7, 0x06, 0xba, 0x01, 0x09, 0xc4, 0x8a, 0x05, 0xec, 0x58, 0xb0, 0x28, 0x52, 0x17, 0xec,
+	0x58, 0x81, 0x2a, 0xd8, 0x54, 0xac, 0x58, 0x21, 0x68, 0x85, 0xba, 0xe3, 0x5f, 0x00, 0xcd, 0xbd,
+	0x33, 0xd7, 0x73, 0x3d, 0x33, 0x9e, 0xa9, 0x65, 0xd1, 0x4d, 0xe4, 0xb9, 0xe7, 0x77, 0x5e, 0xbf,
+	0x73, 0xee, 0x33, 0x90, 0xda, 0x35, 0x70, 0xdd, 0xc0, 0x05, 0xad, 0x65, 0xb4, 0x9b, 0x85, 0xfd,
+	0xd5, 0x82, 0x79, 0x90, 0x6f, 0xb6, 0x0c, 0xd3, 0x10, 0xa7, 0xa8, 0x24, 0x4f, 0x24, 0xf9, 0xfd,
+	0x55, 0x69, 0x56, 0x33, 0x34, 0x83, 0xc8, 0x0a, 0xd6, 0x2f, 0x0a, 0x93, 0xe6, 0x29, 0xac, 0x4a,
+	0x05, 0xb6, 0x8e, 0x2d, 0xd2, 0x0c, 0x43, 0xdb, 0x53, 0x0b, 0xe4, 0x6b, 0xa7, 0x7d, 0xbb, 0xa0,
+	0x34, 0x0e, 0x6d, 0xd1, 0x09, 0x8f, 0xdb, 0xc3, 0xa6, 0xea, 0xe8, 0x1d, 0xb7, 0x85, 0x75, 0xac,
+	0x59, 0xa2, 0x3a, 0xd6, 0x6c, 0xc1, 0x8c, 0x52, 0xd7, 0x1b, 0x46, 0x81, 0xfc, 0xa5, 0x43, 0xe8,
+	0x67, 0x01, 0x92, 0x15, 0xac, 0x6d, 0xb4, 0x54, 0xc5, 0x54, 0x5f, 0xb4, 0xac, 0x89, 0x79, 0x18,
+	0x56, 0x6a, 0x75, 0xbd, 0x91, 0x12, 0x32, 0xc2, 0xca, 0xf8, 0x7a, 0xea, 0x97, 0x07, 0xb9, 0x59,
+	0x3b, 0xae, 0xab, 0xb5, 0x5a, 0x4b, 0xc5, 0x78, 0xdb, 0x6c, 0xe9, 0x0d, 0x4d, 0xa6, 0x30, 0x71,
+	0x03, 0x46, 0xeb, 0x6a, 0x7d, 0x47, 0x6d, 0xe1, 0x54, 0x2c, 0x13, 0x5f, 0x99, 0x28, 0xa6, 0xf3,
+	0x5d, 0xa9, 0xe7, 0x2b, 0x44, 0x2e, 0xab, 0xef, 0xb4, 0x55, 0x6c, 0xae, 0x8f, 0x3f, 0xfc, 0x6d,
+	0x61, 0xe8, 0xeb, 0xa7, 0xf7, 0xb3, 0x82, 0xec, 0x68, 0x8a, 0x12, 0x8c, 0xd5, 0x55, 0x53, 0xa9,
+	0x29, 0xa6, 0x92, 0x8a, 0x5b, 0x7e, 0x65, 0xf6, 0x5d, 0x5a, 0x79, 0xff, 0xe9, 0xfd, 0x2c, 0x75,
+	0x76, 0xef, 0xe9, 0xfd, 0xac, 0xcd, 0x58, 0x0e, 0xd7, 0xde, 0x2e, 0xf0, 0xa1, 0xa3, 0x35, 0x38,
+	0xc6, 0x8f, 0xc8, 0x2a, 0x6e, 0x1a, 0x0d, 0xac, 0x8a, 0xf3, 0x30, 0x46, 0xa2, 0xa9, 0xea, 0x35,
+	0x92, 0x57, 0x42, 0x1e, 0x25, 0xdf, 0x9b, 0x35, 0xf4, 0xa7, 0x00, 0x73, 0x15, 0xac, 0xdd, 0x68,
+	0xd6, 0x1c, 0xad, 0x8a, 0x1d, 0xd4, 0xb3, 0x32, 0xe1, 0x76, 0x12, 0xe3, 0x9c, 0x88, 0x5b, 0x90,
+	0xa4, 0xa9, 0x56, 0xdb, 0xc4, 0x0f, 0x4e, 0xc5, 0x9f, 0x95, 0xab, 0x49, 0x6a, 0x80, 0xc6, 0x89,
+	0x4b, 0x05, 0x9e, 0x95, 0x0c, 0xcf, 0x8a, 0x37, 0x1b, 0xb4, 0x00, 0xa7, 0x7c, 0x05, 0x0e, 0x47,
+	0xe8, 0x47, 0x01, 0x8e, 0xf2, 0x88, 0xab, 0x24, 0xad, 0x01, 0xd2, 0x70, 0x09, 0xc6, 0x1b, 0xea,
+	0xdd, 0x2a, 0x35, 0x17, 0x0f, 0x31, 0x37, 0xd6, 0x50, 0xef, 0x92, 0x08, 0x4a, 0x39, 0x3e, 0xd7,
+	0x74, 0x60, 0xae, 0x04, 0x8e, 0x4e, 0xc1, 0x09, 0x9f, 0x61, 0x96, 0xe7, 0xb7, 0x02, 0x1c, 0xe3,
+	0xe5, 0x15, 0xbb, 0xd5, 0x06, 0x99, 0x6a, 0xaf, 0x8e, 0xbe, 0xc0, 0xe7, 0x73, 0xba, 0x47, 0xed,
+	0xa8, 0x06, 0xca, 0x40, 0xda, 0x5f, 0xc2, 0xb2, 0xfa, 0x2c, 0x06, 0xb3, 0x7c, 0xf3, 0x6f, 0x19,
+	0x7b, 0xfa, 0xee, 0xe1, 0xbf, 0x94, 0x93, 0xa8, 0xc0, 0x54, 0x4d, 0xdd, 0xd5, 0xb1, 0x6e, 0x34,
+	0xaa, 0x4d, 0xe2, 0x39, 0x95, 0xc8, 0x08, 0x2b, 0x13, 0xc5, 0xd9, 0x3c, 0x5d, 0xc7, 0xf2, 0xce,
+	0x3a, 0x96, 0xbf, 0xda, 0x38, 0x5c, 0x47, 0x3f, 0x3d, 0xc8, 0xa5, 0xbb, 0x7b, 0xff, 0x9a, 0x6d,
+	0x80, 0x46, 0x2e, 0x27, 0x6b, 0xdc, 0x77, 0xa9, 0xf8, 0xe1, 0x97, 0x0b, 0x43, 0x3c, 0x75, 0x0b,
+	0x81, 0x8b, 0x01, 0xd5, 0x41, 0x32, 0x9c, 0xf4, 0x1b, 0x67, 0x0b, 0x43, 0x11, 0x46, 0x15, 0xca,
+	0x42, 0x28, 0x3f, 0x0e, 0x10, 0x7d, 0x10, 0x83, 0x79, 0xbe, 0x1a, 0xd4, 0x68, 0x7f, 0xd3, 0xe5,
+	0x25, 0x98, 0xa5, 0x7c, 0x53, 0xd6, 0xaa, 0x4e, 0x38, 0xb1, 0x10, 0x75, 0x51, 0x73, 0x7b, 0x26,
+	0x92, 0x7e, 0xe7, 0xd7, 0x1a, 0x4f, 0xea, 0x62, 0x60, 0x3f, 0xba, 0xf2, 0x44, 0x67, 0xe0, 0x74,
+	0xa0, 0x90, 0x75, 0xe5, 0x77, 0x71, 0x48, 0xf1, 0xfc, 0xdf, 0xd4, 0xcd, 0x3b, 0x7d, 0x76, 0xe6,
+	0x40, 0x76, 0x9a, 0x25, 0x48, 0x52, 0xba, 0xbb, 0x3a, 0x79, 0x52, 0xe3, 0x56, 0x82, 0x22, 0xcc,
+	0x71, 0x55, 0x61, 0xe8, 0x04, 0x41, 0x1f, 0x75, 0x91, 0xcf, 0x74, 0x56, 0xbb, 0x74, 0x14, 0x6c,
+	0x57, 0x62, 0x38, 0x23, 0xac, 0x8c, 0xf1, 0x05, 0xc3, 0xb4, 0x59, 0x7c, 0x66, 0xcd, 0xc8, 0x80,
+	0x67, 0xcd, 0x65, 0xef, 0xac, 0x39, 0x13, 0x38, 0x6b, 0x3a, 0xd5, 0x41, 0x1f, 0x09, 0x90, 0x09,
+	0x12, 0x46, 0xd8, 0x57, 0x07, 0xd9, 0xd7, 0xe8, 0xfb, 0x18, 0x20, 0xbf, 0x66, 0xe3, 0x53, 0x7f,
+	0xae, 0x53, 0xcf, 0xa7, 0x92, 0xf1, 0x01, 0x57, 0xb2, 0xe4, 0xad, 0xe4, 0x72, 0xe0, 0x54, 0xe5,
+	0x6d, 0xa1, 0xf3, 0x90, 0x0d, 0x27, 0x90, 0x4d, 0xdb, 0xbf, 0x04, 0x38, 0xe9, 0x07, 0xef, 0x7b,
+	0xa3, 0x1c, 0x24, 0xd3, 0xbd, 0x76, 0xd6, 0xcb, 0x51, 0xe9, 0xe1, 0xf3, 0x41, 0x67, 0x61, 0xb1,
+	0x97, 0x9c, 0x11, 0xf3, 0x4d, 0x0c, 0x96, 0xfc, 0x80, 0xe5, 0x03, 0x75, 0xb7, 0x6d, 0xea, 0x46,
+	0x63, 0x5b, 0x35, 0x4d, 0xbd, 0xa1, 0xe1, 0xe7, 0xca, 0xd0, 0x6b, 0x20, 0xaa, 0x4e, 0x40, 0x55,
+	0x6c, 0x47, 0x64, 0xb7, 0x23, 0xf2, 0xac, 0x99, 0x9e, 0xd8, 0xe5, 0x19, 0xb5, 0x7b, 0xa8, 0x74,
+	0x91, 0x27, 0x76, 0x29, 0x90, 0x58, 0xcb, 0x9a, 0xa3, 0x85, 0x0a, 0x90, 0x8b, 0xc4, 0x16, 0xe3,
+	0xf7, 0x8f, 0x18, 0xcc, 0x54, 0xb0, 0xb6, 0xdd, 0xde, 0xa9, 0xeb, 0xe6, 0x56, 0xcb, 0x68, 0x1a,
+	0x58, 0xd9, 0x0b, 0xe4, 0x46, 0xe8, 0x83, 0x9b, 0x93, 0x30, 0xde, 0x24, 0x76, 0x9d, 0x6d, 0x64,
+	0x5c, 0xee, 0x0c, 0xf4, 0x3c, 0xe1, 0x5c, 0xb0, 0x64, 0x18, 0x2b, 0x9a, 0x8a, 0x53, 0x89, 0x4c,
+	0x3c, 0x68, 0x6a, 0xcb, 0x0c, 0x25, 0x9e, 0x83, 0x84, 0xc5, 0x24, 0x59, 0xff, 0x93, 0xc5, 0x39,
+	0x5f, 0xe6, 0x65, 0x02, 0x11, 0x67, 0x61, 0xd8, 0xd4, 0xcd, 0x3d, 0x95, 0x2c, 0xff, 0xe3, 0x32,
+	0xfd, 0x10, 0x53, 0x30, 0x8a, 0xdb, 0xf5, 0xba, 0xd2, 0x3a, 0x4c, 0x8d, 0x92, 0x71, 0xe7, 0xb3,
+	0xf4, 0x1f, 0x67, 0x2d, 0xe8, 0x04, 0x6f, 0xd5, 0x05, 0xb9, 0xea, 0x42, 0x2f, 0x87, 0x1e, 0x36,
+	0xd1, 0xff, 0x60, 0xde, 0x33, 0xc8, 0x16, 0xf4, 0x05, 0x98, 0x68, 0xda, 0x63, 0x9d, 0x35, 0x1d,
+	0x9c, 0xa1, 0xcd, 0x1a, 0xfa, 0x8a, 0xde, 0x12, 0xac, 0xbd, 0xa0, 0xd6, 0x52, 0xee, 0xb2, 0x1a,
+	0x85, 0x29, 0xba, 0x4f, 0x5a, 0xb1, 0x88, 0x27, 0xad, 0xd2, 0x25, 0x2b, 0x43, 0xe7, 0xab, 0xfb,
+	0x68, 0xc2, 0xf2, 0xeb, 0x8e, 0xc5, 0xbe, 0x00, 0x74, 0x0f, 0xb3, 0x26, 0xfb, 0x5b, 0x80, 0xd1,
+	0x0a, 0xd6, 0x5e, 0x37, 0xcc, 0xf0, 0x7c, 0xad, 0x79, 0xbc, 0x6f, 0x98, 0x6a, 0x2b, 0x34, 0x68,
+	0x0a, 0x13, 0xd7, 0x60, 0xc4, 0x68, 0x5a, 0xbd, 0x4d, 0xfa, 0x27, 0x59, 0x3c, 0xe1, 0xa9, 0xba,
+	0xe5, 0xf7, 0x55, 0x02, 0x91, 0x6d, 0x28, 0xd7, 0x76, 0x89, 0xae, 0xb6, 0x8b, 0xde, 0x44, 0xa5,
+	0x65, 0x32, 0x49, 0x49, 0x1c, 0x16, 0x59, 0x29, 0x3f, 0xb2, 0x2c, 0xef, 0x68, 0x06, 0xa6, 0xec,
+	0x9f, 0x8c, 0x94, 0x7b, 0x94, 0x14, 0xcb, 0x5a, 0x38, 0x29, 0x17, 0x61, 0x8c, 0x2e, 0x11, 0x46,
+	0x38, 0x2f, 0x0c, 0x49, 0x2f, 0xf2, 0x23, 0x58, 0xd7, 0x1a, 0x3d, 0xe2, 0xb3, 0x02, 0x40, 0x32,
+	0x4c, 0xd9, 0x3f, 0x59, 0x63, 0xfe, 0x1f, 0x46, 0x5a, 0x2a, 0x6e, 0xef, 0x99, 0xc4, 0x61, 0xb2,
+	0xb8, 0xec, 0x21, 0xc2, 0xa9, 0x73, 0xd9, 0xf6, 0x27, 0x13, 0xb8, 0x6c, 0xab, 0xa1, 0x8f, 0x05,
+	0x98, 0xac, 0x60, 0xed, 0x65, 0x55, 0xd9, 0xb7, 0x5f, 0x3a, 0xfa, 0x38, 0xfb, 0xf7, 0xb8, 0x1d,
+	0xd1, 0x1b, 0xb9, 0xbb, 0x59, 0xd3, 0x7e, 0xf9, 0x75, 0xfc, 0xa3, 0xe3, 0x30, 0xc7, 0x0d, 0xb0,
+	0x5a, 0x7c, 0x2e, 0xd0, 0xfa, 0xa8, 0xa6, 0x11, 0x7d, 0x7e, 0x5d, 0x80, 0x91, 0x7d, 0xd5, 0x34,
+	0x22, 0x74, 0xaa, 0x8d, 0xa3, 0xd7, 0x50, 0xfb, 0xa3, 0xfb, 0x0d, 0xa1, 0xd3, 0x2f, 0xae, 0x20,
+	0xd0, 0x3c, 0x1c, 0xef, 0x1a, 0x72, 0x62, 0xce, 0x66, 0x21, 0x51, 0xa6, 0x0b, 0xd9, 0x74, 0xf9,
+	0x8d, 0xf2, 0x46, 0xf5, 0xc6, 0x2b, 0xdb, 0x5b, 0xe5, 0x8d, 0xcd, 0x17, 0x36, 0xcb, 0xd7, 0xa6,
+	0x87, 0xc4, 0x23, 0x30, 0x46, 0x46, 0xaf, 0xcb, 0x6f, 0x4e, 0x0b, 0xc5, 0x1f, 0x26, 0x21, 0x5e,
+	0xc1, 0x9a, 0x78, 0x13, 0x26, 0xdc, 0x2f, 0x4f, 0x0b, 0xde, 0xe3, 0x3c, 0x77, 0xfe, 0x94, 0x96,
+	0x43, 0x00, 0xac, 0x59, 0xf6, 0x40, 0xf4, 0x79, 0xcf, 0x39, 0xeb, 0xa7, 0xee, 0xc5, 0x49, 0xf9,
+	0x68, 0x38, 0xe6, 0xed, 0x36, 0x4c, 0x7b, 0x1e, 0x4d, 0x16, 0x43, 0x6c, 0x10, 0x94, 0x74, 0x3e,
+	0x0a, 0x8a, 0xf9, 0x31, 0xe0, 0xa8, 0xdf, 0xa3, 0xc5, 0x72, 0x68, 0xb8, 0x14, 0x28, 0x15, 0x22,
+	0x02, 0x99, 0x43, 0x1d, 0x66, 0xbc, 0xef, 0x09, 0x4b, 0x21, 0x45, 0xa0, 0x30, 0x29, 0x17, 0x09,
+	0xc6, 0x5c, 0xb5, 0x61, 0xce, 0xff, 0x92, 0x78, 0x2e, 0xc4, 0x4e, 0x07, 0x2a, 0xad, 0x46, 0x86,
+	0x32, 0xb7, 0x07, 0x70, 0x2c, 0xe0, 0x1a, 0x9f, 0x0d, 0x21, 0xcb, 0x85, 0x95, 0x8a, 0xd1, 0xb1,
+	0xcc, 0xf3, 0xa7, 0x02, 0x2c, 0x84, 0xdd, 0x67, 0xd6, 0x22, 0xd9, 0xe5, 0x95, 0xa4, 0xff, 0xf6,
+	0xa1, 0xc4, 0xa2, 0x7a, 0x4f, 0x80, 0xf9, 0xe0, 0x53, 0x7f, 0x2e, 0x92, 0x69, 0xd6, 0x6f, 0x97,
+	0x9e, 0x09, 0xce, 0x62, 0x78, 0x0b, 0x92, 0x5d, 0xe7, 0x3f, 0xe4, 0x67, 0x88, 0xc7, 0x48, 0xd9,
+	0x70, 0x8c, 0x7b, 0xc2, 0x7a, 0xce, 0x2f, 0xbe, 0x13, 0xb6, 0x1b, 0x25, 0x9d, 0x8f, 0x82, 0x62,
+	0x7e, 0xd6, 0x21, 0x41, 0x0e, 0x19, 0x29, 0x3f, 0x2d, 0x4b, 0x22, 0x65, 0x82, 0x24, 0x6e, 0x1b,
+	0x64, 0x5d, 0xf5, 0xb5, 0x61, 0x49, 0xa4, 0x4c, 0x90, 0x84, 0xd9, 0xb8, 0x0e, 0xe0, 0xda, 0xf6,
+	0xd2, 0x7e, 0xf8, 0x8e, 0x5c, 0x3a, 0xdb, 0x5b, 0xce, 0xac, 0x7e, 0x21, 0x00, 0x8a, 0x70, 0x11,
+	0xba, 0x1c, 0xa9, 0x0b, 0x3c, 0x7a, 0xd2, 0x95, 0xfe, 0xf4, 0x58, 0x78, 0xb7, 0xe0, 0x08, 0xb7,
+	0x81, 0xfa, 0x53, 0xed, 0x42, 0x48, 0x2b, 0x61, 0x08, 0xc7, 0xb6, 0x34, 0xfc, 0xae, 0xf5, 0xa8,
+	0xb4, 0x7e, 0xe5, 0xe1, 0xe3, 0xb4, 0xf0, 0xe8, 0x71, 0x5a, 0xf8, 0xfd, 0x71, 0x5a, 0xf8, 0xe4,
+	0x49, 0x7a, 0xe8, 0xd1, 0x93, 0xf4, 0xd0, 0xaf, 0x4f, 0xd2, 0x43, 0xb7, 0x16, 0x35, 0xdd, 0xbc,
+	0xd3, 0xde, 0xc9, 0xef, 0x1a, 0x75, 0xfb, 0x9f, 0x3a, 0x05, 0xd7, 0xe6, 0x7a, 0x40, 0xb7, 0xd7,
+	0x9d, 0x11, 0x72, 0x6f, 0x58, 0xfb, 0x67, 0x00, 0xf0, 0x47, 0xa3, 0x92, 0x46, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1638,6 +1847,12 @@ type MsgClient interface {
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
+	// UpdateGroupPolicyExecutionSettings updates the execution settings of a
+	// group policy.
+	UpdateGroupPolicyExecutionSettings(ctx context.Context, in *MsgUpdateGroupPolicyExecutionSettings, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyExecutionSettingsResponse, error)
+	// VetoProposal allows the veto address of a group policy to veto a proposal
+	// before its execution.
+	VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error)
 }
 
 type msgClient struct {
@@ -1774,6 +1989,24 @@ func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdateGroupPolicyExecutionSettings(ctx context.Context, in *MsgUpdateGroupPolicyExecutionSettings, opts ...grpc.CallOption) (*MsgUpdateGroupPolicyExecutionSettingsResponse, error) {
+	out := new(MsgUpdateGroupPolicyExecutionSettingsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/UpdateGroupPolicyExecutionSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VetoProposal(ctx context.Context, in *MsgVetoProposal, opts ...grpc.CallOption) (*MsgVetoProposalResponse, error) {
+	out := new(MsgVetoProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1.Msg/VetoProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1804,6 +2037,12 @@ type MsgServer interface {
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
 	// LeaveGroup allows a group member to leave the group.
 	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
+	// UpdateGroupPolicyExecutionSettings updates the execution settings of a
+	// group policy.
+	UpdateGroupPolicyExecutionSettings(context.Context, *MsgUpdateGroupPolicyExecutionSettings) (*MsgUpdateGroupPolicyExecutionSettingsResponse, error)
+	// VetoProposal allows the veto address of a group policy to veto a proposal
+	// before its execution.
+	VetoProposal(context.Context, *MsgVetoProposal) (*MsgVetoProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1852,6 +2091,12 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
 }
+func (*UnimplementedMsgServer) UpdateGroupPolicyExecutionSettings(ctx context.Context, req *MsgUpdateGroupPolicyExecutionSettings) (*MsgUpdateGroupPolicyExecutionSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupPolicyExecutionSettings not implemented")
+}
+func (*UnimplementedMsgServer) VetoProposal(ctx context.Context, req *MsgVetoProposal) (*MsgVetoProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VetoProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2109,6 +2354,42 @@ func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateGroupPolicyExecutionSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateGroupPolicyExecutionSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateGroupPolicyExecutionSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/UpdateGroupPolicyExecutionSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateGroupPolicyExecutionSettings(ctx, req.(*MsgUpdateGroupPolicyExecutionSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VetoProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVetoProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VetoProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1.Msg/VetoProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VetoProposal(ctx, req.(*MsgVetoProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2169,6 +2450,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LeaveGroup",
 			Handler:    _Msg_LeaveGroup_Handler,
 		},
+		{
+			MethodName: "UpdateGroupPolicyExecutionSettings",
+			Handler:    _Msg_UpdateGroupPolicyExecutionSettings_Handler,
+		},
+		{
+			MethodName: "VetoProposal",
+			Handler:    _Msg_VetoProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1/tx.proto",
@@ -2860,6 +3149,78 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateGroupPolicyExecutionSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionSettings != nil {
+		{
+			size, err := m.ExecutionSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3221,13 +3582,71 @@ func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vetoer) > 0 {
+		i -= len(m.Vetoer)
+		copy(dAtA[i:], m.Vetoer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Vetoer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -3533,6 +3952,36 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateGroupPolicyExecutionSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExecutionSettings != nil {
+		l = m.ExecutionSettings.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSubmitProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3698,6 +4147,31 @@ func (m *MsgLeaveGroupResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Vetoer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5652,6 +6126,206 @@ func (m *MsgUpdateGroupPolicyMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateGroupPolicyExecutionSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionSettings == nil {
+				m.ExecutionSettings = &ExecutionSettings{}
+			}
+			if err := m.ExecutionSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateGroupPolicyExecutionSettingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionSettingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateGroupPolicyExecutionSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6677,6 +7351,157 @@ func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
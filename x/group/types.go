@@ -238,6 +238,79 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &TokenWeightedDecisionPolicy{}
+
+// NewTokenWeightedDecisionPolicy creates a new token weighted DecisionPolicy.
+// The denom is only used with the bank voting power source.
+func NewTokenWeightedDecisionPolicy(percentage string, source VotingPowerSource, denom string, votingPeriod time.Duration, executionPeriod time.Duration) DecisionPolicy {
+	return &TokenWeightedDecisionPolicy{percentage, source, denom, &DecisionPolicyWindows{votingPeriod, executionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// ValidateBasic does basic validation on TokenWeightedDecisionPolicy
+func (p TokenWeightedDecisionPolicy) ValidateBasic() error {
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return sdkerrors.Wrap(err, "percentage threshold")
+	}
+	if percentage.Cmp(math.NewDecFromInt64(1)) == 1 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "percentage must be > 0 and <= 1")
+	}
+
+	switch p.Source {
+	case VOTING_POWER_SOURCE_BANK:
+		if err := sdk.ValidateDenom(p.Denom); err != nil {
+			return sdkerrors.Wrap(err, "voting power denom")
+		}
+	case VOTING_POWER_SOURCE_STAKING:
+		if p.Denom != "" {
+			return sdkerrors.Wrap(errors.ErrInvalid, "denom must be empty for the staking voting power source")
+		}
+	default:
+		return sdkerrors.Wrapf(errors.ErrInvalid, "invalid voting power source %s", p.Source)
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting period cannot be 0")
+	}
+
+	return nil
+}
+
+// Validate validates the policy against the group.
+func (p *TokenWeightedDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	if p.Windows.MinExecutionPeriod > p.Windows.VotingPeriod+config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min_execution_period should be smaller than voting_period + max_execution_period")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes voting power equals or
+// exceeds the percentage threshold of the total voting power before the timeout.
+// The total power is the one snapshotted at proposal submission, and a proposal
+// is rejected if no member had any voting power at that time.
+func (p TokenWeightedDecisionPolicy) Allow(tally TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, sdkerrors.Wrap(err, "total power")
+	}
+	if totalPowerDec.IsZero() {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	return PercentageDecisionPolicy{Percentage: p.Percentage, Windows: p.Windows}.Allow(tally, totalPower)
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -346,6 +419,37 @@ func (g GroupPolicyInfo) ValidateBasic() error {
 	if err := policy.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "group policy's decision policy")
 	}
+
+	if g.ExecutionSettings != nil {
+		if err := g.ExecutionSettings.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "group policy's execution settings")
+		}
+	}
+	return nil
+}
+
+// ValidateBasic does basic validation on execution settings.
+func (s ExecutionSettings) ValidateBasic() error {
+	if s.Timelock < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock cannot be negative")
+	}
+
+	if s.VetoAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(s.VetoAddress); err != nil {
+			return sdkerrors.Wrap(err, "veto address")
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the execution settings against the module config. The
+// timelock must end before accepted proposals are pruned.
+func (s ExecutionSettings) Validate(config Config) error {
+	if s.Timelock >= config.MaxExecutionPeriod {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timelock should be smaller than max_execution_period")
+	}
+
 	return nil
 }
 
@@ -440,6 +544,76 @@ func (v Vote) ValidateBasic() error {
 	return nil
 }
 
+func (s VotingPowerSnapshot) PrimaryKeyFields() []interface{} {
+	return []interface{}{s.ProposalId}
+}
+
+var _ orm.Validateable = VotingPowerSnapshot{}
+
+// ValidateBasic does basic validation on voting power snapshot.
+func (s VotingPowerSnapshot) ValidateBasic() error {
+	if s.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "voting power snapshot proposal id")
+	}
+
+	totalPower := math.NewDecFromInt64(0)
+	for _, m := range s.Members {
+		if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+			return sdkerrors.Wrap(err, "voting power snapshot member")
+		}
+		power, err := math.NewNonNegativeDecFromString(m.Power)
+		if err != nil {
+			return sdkerrors.Wrap(err, "voting power snapshot member power")
+		}
+		totalPower, err = totalPower.Add(power)
+		if err != nil {
+			return err
+		}
+	}
+
+	snapshotTotal, err := math.NewNonNegativeDecFromString(s.TotalPower)
+	if err != nil {
+		return sdkerrors.Wrap(err, "voting power snapshot total power")
+	}
+	if totalPower.Cmp(snapshotTotal) != 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "voting power snapshot total power doesn't match the sum of member powers")
+	}
+
+	return nil
+}
+
+// GetPower returns the voting power of the given member in the snapshot, and
+// false if the member is not part of it.
+func (s VotingPowerSnapshot) GetPower(address string) (string, bool) {
+	for _, m := range s.Members {
+		if m.Address == address {
+			return m.Power, true
+		}
+	}
+
+	return "", false
+}
+
+func (l ExecutionLog) PrimaryKeyFields() []interface{} {
+	return []interface{}{l.ProposalId, uint64(l.Attempt)}
+}
+
+var _ orm.Validateable = ExecutionLog{}
+
+// ValidateBasic does basic validation on execution log.
+func (l ExecutionLog) ValidateBasic() error {
+	if l.ProposalId == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "execution log proposal id")
+	}
+	if l.Attempt == 0 {
+		return sdkerrors.Wrap(errors.ErrEmpty, "execution log attempt")
+	}
+	if l.Result != PROPOSAL_EXECUTOR_RESULT_SUCCESS && l.Result != PROPOSAL_EXECUTOR_RESULT_FAILURE {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "execution log result %s", l.Result)
+	}
+	return nil
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (q QueryGroupPoliciesByGroupResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpackGroupPolicies(unpacker, q.GroupPolicies)
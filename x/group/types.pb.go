@@ -31,6 +31,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// VotingPowerSource enumerates the sources of voting power of a
+// TokenWeightedDecisionPolicy.
+type VotingPowerSource int32
+
+const (
+	// VOTING_POWER_SOURCE_UNSPECIFIED defines an unspecified source which will
+	// return an error.
+	VOTING_POWER_SOURCE_UNSPECIFIED VotingPowerSource = 0
+	// VOTING_POWER_SOURCE_BANK defines the member's bank balance of a denom as
+	// voting power.
+	VOTING_POWER_SOURCE_BANK VotingPowerSource = 1
+	// VOTING_POWER_SOURCE_STAKING defines the member's bonded amount in
+	// x/staking as voting power.
+	VOTING_POWER_SOURCE_STAKING VotingPowerSource = 2
+)
+
+var VotingPowerSource_name = map[int32]string{
+	0: "VOTING_POWER_SOURCE_UNSPECIFIED",
+	1: "VOTING_POWER_SOURCE_BANK",
+	2: "VOTING_POWER_SOURCE_STAKING",
+}
+
+var VotingPowerSource_value = map[string]int32{
+	"VOTING_POWER_SOURCE_UNSPECIFIED": 0,
+	"VOTING_POWER_SOURCE_BANK":        1,
+	"VOTING_POWER_SOURCE_STAKING":     2,
+}
+
+func (x VotingPowerSource) String() string {
+	return proto.EnumName(VotingPowerSource_name, int32(x))
+}
+
+func (VotingPowerSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
+}
+
 // VoteOption enumerates the valid vote options for a given proposal.
 type VoteOption int32
 
@@ -69,7 +105,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{0}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
 }
 
 // ProposalStatus defines proposal statuses.
@@ -92,6 +128,9 @@ const (
 	// A proposal can be withdrawn before the voting start time by the owner.
 	// When this happens the final status is Withdrawn.
 	PROPOSAL_STATUS_WITHDRAWN ProposalStatus = 5
+	// Final status of a proposal when it is vetoed by the veto address of the
+	// group policy before its execution.
+	PROPOSAL_STATUS_VETOED ProposalStatus = 6
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -101,6 +140,7 @@ var ProposalStatus_name = map[int32]string{
 	3: "PROPOSAL_STATUS_REJECTED",
 	4: "PROPOSAL_STATUS_ABORTED",
 	5: "PROPOSAL_STATUS_WITHDRAWN",
+	6: "PROPOSAL_STATUS_VETOED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -110,6 +150,7 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_REJECTED":    3,
 	"PROPOSAL_STATUS_ABORTED":     4,
 	"PROPOSAL_STATUS_WITHDRAWN":   5,
+	"PROPOSAL_STATUS_VETOED":      6,
 }
 
 func (x ProposalStatus) String() string {
@@ -117,7 +158,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{1}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
 }
 
 // ProposalExecutorResult defines types of proposal executor results.
@@ -153,7 +194,7 @@ func (x ProposalExecutorResult) String() string {
 }
 
 func (ProposalExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{2}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{3}
 }
 
 // Member represents a group member with an account address,
@@ -418,6 +459,88 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// TokenWeightedDecisionPolicy is a decision policy where the voting power of
+// each member is its token balance instead of its group weight. The voting
+// power is snapshotted when a proposal is submitted, and a proposal passes when
+// it satisfies the two following conditions:
+//  1. The percentage of all `YES` voters' power out of the total power of the
+//     group members is greater or equal than the given `percentage`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+type TokenWeightedDecisionPolicy struct {
+	// percentage is the minimum percentage of the `YES` voting power out of the
+	// total voting power must meet for a proposal to succeed.
+	Percentage string `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// source defines where the voting power of the members is read from.
+	Source VotingPowerSource `protobuf:"varint,2,opt,name=source,proto3,enum=cosmos.group.v1.VotingPowerSource" json:"source,omitempty"`
+	// denom is the denomination of the balance used as voting power. It must be
+	// set for the bank source only, the bonded amount is used otherwise.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,4,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *TokenWeightedDecisionPolicy) Reset()         { *m = TokenWeightedDecisionPolicy{} }
+func (m *TokenWeightedDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*TokenWeightedDecisionPolicy) ProtoMessage()    {}
+func (*TokenWeightedDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenWeightedDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.Merge(m, src)
+}
+func (m *TokenWeightedDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenWeightedDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenWeightedDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenWeightedDecisionPolicy proto.InternalMessageInfo
+
+func (m *TokenWeightedDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetSource() VotingPowerSource {
+	if m != nil {
+		return m.Source
+	}
+	return VOTING_POWER_SOURCE_UNSPECIFIED
+}
+
+func (m *TokenWeightedDecisionPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TokenWeightedDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -441,7 +564,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +630,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -590,7 +713,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -652,13 +775,16 @@ type GroupPolicyInfo struct {
 	DecisionPolicy *types.Any `protobuf:"bytes,6,opt,name=decision_policy,json=decisionPolicy,proto3" json:"decision_policy,omitempty"`
 	// created_at is a timestamp specifying when a group policy was created.
 	CreatedAt time.Time `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// execution_settings defines the optional execution rules of the proposals
+	// accepted by the group policy.
+	ExecutionSettings *ExecutionSettings `protobuf:"bytes,8,opt,name=execution_settings,json=executionSettings,proto3" json:"execution_settings,omitempty"`
 }
 
 func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,6 +813,78 @@ func (m *GroupPolicyInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupPolicyInfo proto.InternalMessageInfo
 
+// ExecutionSettings defines the execution rules of the proposals accepted by a
+// group policy.
+type ExecutionSettings struct {
+	// timelock is the duration after a proposal is accepted during which it
+	// can't be executed, and can be vetoed by the veto_address. It must be
+	// smaller than the app-defined max_execution_period.
+	Timelock time.Duration `protobuf:"bytes,1,opt,name=timelock,proto3,stdduration" json:"timelock"`
+	// veto_address is the account allowed to veto the proposals of the group
+	// policy, usually the account of another group policy acting as a veto
+	// council. If empty, proposals can't be vetoed.
+	VetoAddress string `protobuf:"bytes,2,opt,name=veto_address,json=vetoAddress,proto3" json:"veto_address,omitempty"`
+	// max_execution_attempts is the maximum number of times the execution of an
+	// accepted proposal is attempted. Once reached after failed executions, the
+	// proposal expires and can't be executed anymore. If set to 0, the number
+	// of attempts is not limited.
+	MaxExecutionAttempts uint32 `protobuf:"varint,3,opt,name=max_execution_attempts,json=maxExecutionAttempts,proto3" json:"max_execution_attempts,omitempty"`
+}
+
+func (m *ExecutionSettings) Reset()         { *m = ExecutionSettings{} }
+func (m *ExecutionSettings) String() string { return proto.CompactTextString(m) }
+func (*ExecutionSettings) ProtoMessage()    {}
+func (*ExecutionSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+}
+func (m *ExecutionSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionSettings.Merge(m, src)
+}
+func (m *ExecutionSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionSettings proto.InternalMessageInfo
+
+func (m *ExecutionSettings) GetTimelock() time.Duration {
+	if m != nil {
+		return m.Timelock
+	}
+	return 0
+}
+
+func (m *ExecutionSettings) GetVetoAddress() string {
+	if m != nil {
+		return m.VetoAddress
+	}
+	return ""
+}
+
+func (m *ExecutionSettings) GetMaxExecutionAttempts() uint32 {
+	if m != nil {
+		return m.MaxExecutionAttempts
+	}
+	return 0
+}
+
 // Proposal defines a group proposal. Any member of a group can submit a proposal
 // for a group policy to decide upon.
 // A proposal consists of a set of `sdk.Msg`s that will be executed if the proposal
@@ -737,13 +935,24 @@ type Proposal struct {
 	//
 	// Since: cosmos-sdk 0.47
 	Summary string `protobuf:"bytes,14,opt,name=summary,proto3" json:"summary,omitempty"`
+	// timelock_end is the timestamp before which an accepted proposal can't be
+	// executed. It is only set when the group policy defines a timelock.
+	TimelockEnd *time.Time `protobuf:"bytes,15,opt,name=timelock_end,json=timelockEnd,proto3,stdtime" json:"timelock_end,omitempty"`
+	// execution_attempts is the number of times the execution of the proposal
+	// has been attempted.
+	ExecutionAttempts uint32 `protobuf:"varint,16,opt,name=execution_attempts,json=executionAttempts,proto3" json:"execution_attempts,omitempty"`
+	// execution_settings is a copy of the execution settings of the group policy
+	// when the proposal is accepted. The veto address and the maximum number of
+	// execution attempts of an accepted proposal are read from it, so that later
+	// updates of the group policy don't apply to the proposal.
+	ExecutionSettings *ExecutionSettings `protobuf:"bytes,17,opt,name=execution_settings,json=executionSettings,proto3" json:"execution_settings,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -788,7 +997,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +1044,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{12}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -899,7 +1108,210 @@ func (m *Vote) GetSubmitTime() time.Time {
 	return time.Time{}
 }
 
+// VotingPowerSnapshot is the voting power of the group members taken when a
+// proposal with a TokenWeightedDecisionPolicy is submitted.
+type VotingPowerSnapshot struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// members is the voting power of each group member.
+	Members []MemberPower `protobuf:"bytes,2,rep,name=members,proto3" json:"members"`
+	// total_power is the sum of the voting power of all members.
+	TotalPower string `protobuf:"bytes,3,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+}
+
+func (m *VotingPowerSnapshot) Reset()         { *m = VotingPowerSnapshot{} }
+func (m *VotingPowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerSnapshot) ProtoMessage()    {}
+func (*VotingPowerSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{13}
+}
+func (m *VotingPowerSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingPowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerSnapshot.Merge(m, src)
+}
+func (m *VotingPowerSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingPowerSnapshot proto.InternalMessageInfo
+
+func (m *VotingPowerSnapshot) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VotingPowerSnapshot) GetMembers() []MemberPower {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *VotingPowerSnapshot) GetTotalPower() string {
+	if m != nil {
+		return m.TotalPower
+	}
+	return ""
+}
+
+// MemberPower is the voting power of a group member in a snapshot.
+type MemberPower struct {
+	// address is the member's account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// power is the member's voting power.
+	Power string `protobuf:"bytes,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *MemberPower) Reset()         { *m = MemberPower{} }
+func (m *MemberPower) String() string { return proto.CompactTextString(m) }
+func (*MemberPower) ProtoMessage()    {}
+func (*MemberPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{14}
+}
+func (m *MemberPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MemberPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MemberPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MemberPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberPower.Merge(m, src)
+}
+func (m *MemberPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *MemberPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberPower proto.InternalMessageInfo
+
+func (m *MemberPower) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MemberPower) GetPower() string {
+	if m != nil {
+		return m.Power
+	}
+	return ""
+}
+
+// ExecutionLog is the record of an execution attempt of a proposal.
+type ExecutionLog struct {
+	// proposal_id is the unique ID of the proposal.
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// attempt is the number of the execution attempt, starting at 1.
+	Attempt uint32 `protobuf:"varint,2,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// executed_at is the timestamp of the execution attempt.
+	ExecutedAt time.Time `protobuf:"bytes,3,opt,name=executed_at,json=executedAt,proto3,stdtime" json:"executed_at"`
+	// result is the result of the execution attempt.
+	Result ProposalExecutorResult `protobuf:"varint,4,opt,name=result,proto3,enum=cosmos.group.v1.ProposalExecutorResult" json:"result,omitempty"`
+	// logs contains the error of a failed execution attempt.
+	Logs string `protobuf:"bytes,5,opt,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *ExecutionLog) Reset()         { *m = ExecutionLog{} }
+func (m *ExecutionLog) String() string { return proto.CompactTextString(m) }
+func (*ExecutionLog) ProtoMessage()    {}
+func (*ExecutionLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{15}
+}
+func (m *ExecutionLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionLog.Merge(m, src)
+}
+func (m *ExecutionLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionLog proto.InternalMessageInfo
+
+func (m *ExecutionLog) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *ExecutionLog) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *ExecutionLog) GetExecutedAt() time.Time {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return time.Time{}
+}
+
+func (m *ExecutionLog) GetResult() ProposalExecutorResult {
+	if m != nil {
+		return m.Result
+	}
+	return PROPOSAL_EXECUTOR_RESULT_UNSPECIFIED
+}
+
+func (m *ExecutionLog) GetLogs() string {
+	if m != nil {
+		return m.Logs
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.group.v1.VotingPowerSource", VotingPowerSource_name, VotingPowerSource_value)
 	proto.RegisterEnum("cosmos.group.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.group.v1.ProposalExecutorResult", ProposalExecutorResult_name, ProposalExecutorResult_value)
@@ -907,105 +1319,133 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*TokenWeightedDecisionPolicy)(nil), "cosmos.group.v1.TokenWeightedDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1.GroupPolicyInfo")
+	proto.RegisterType((*ExecutionSettings)(nil), "cosmos.group.v1.ExecutionSettings")
 	proto.RegisterType((*Proposal)(nil), "cosmos.group.v1.Proposal")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.group.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.group.v1.Vote")
+	proto.RegisterType((*VotingPowerSnapshot)(nil), "cosmos.group.v1.VotingPowerSnapshot")
+	proto.RegisterType((*MemberPower)(nil), "cosmos.group.v1.MemberPower")
+	proto.RegisterType((*ExecutionLog)(nil), "cosmos.group.v1.ExecutionLog")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1369 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0x92, 0x14, 0x1f, 0x87, 0x12, 0x49, 0x8f, 0x75, 0xad, 0x95, 0xe4, 0x4b, 0xea, 0xd2,
-	0xc6, 0xbd, 0x82, 0x2e, 0x4c, 0xda, 0x32, 0x90, 0x00, 0x2e, 0x82, 0x90, 0xd4, 0x3a, 0xa6, 0x60,
-	0x93, 0xc4, 0x72, 0x29, 0xc5, 0x6e, 0x16, 0x2b, 0xee, 0x98, 0x5a, 0x84, 0xbb, 0xc3, 0xec, 0x0e,
-	0x25, 0xf3, 0x1f, 0x18, 0x69, 0xe2, 0x32, 0x4d, 0x00, 0x03, 0x69, 0x52, 0xba, 0x30, 0x52, 0xa4,
-	0x0c, 0x52, 0x18, 0x29, 0x02, 0x23, 0x55, 0xaa, 0x24, 0xb0, 0x0b, 0xa7, 0x4a, 0x95, 0x36, 0x41,
-	0xb0, 0x33, 0xb3, 0x14, 0x1f, 0x12, 0x1d, 0x19, 0x46, 0x1a, 0x41, 0x33, 0xdf, 0x77, 0xce, 0x9c,
-	0xef, 0xbc, 0x48, 0xc2, 0x5a, 0x9b, 0x78, 0x36, 0xf1, 0x8a, 0x1d, 0x97, 0xf4, 0x7b, 0xc5, 0xc3,
-	0x6b, 0x45, 0x3a, 0xe8, 0x61, 0xaf, 0xd0, 0x73, 0x09, 0x25, 0x28, 0xcd, 0xc1, 0x02, 0x03, 0x0b,
-	0x87, 0xd7, 0x56, 0x97, 0x3a, 0xa4, 0x43, 0x18, 0x56, 0xf4, 0xff, 0xe3, 0xb4, 0xd5, 0x6c, 0x87,
-	0x90, 0x4e, 0x17, 0x17, 0xd9, 0x69, 0xbf, 0x7f, 0xbf, 0x68, 0xf6, 0x5d, 0x83, 0x5a, 0xc4, 0x11,
-	0x78, 0x6e, 0x12, 0xa7, 0x96, 0x8d, 0x3d, 0x6a, 0xd8, 0x3d, 0x41, 0x58, 0xe1, 0xef, 0xe8, 0xdc,
-	0xb3, 0x78, 0x54, 0x40, 0x93, 0xb6, 0x86, 0x33, 0x10, 0xd0, 0x39, 0xc3, 0xb6, 0x1c, 0x52, 0x64,
-	0x7f, 0xf9, 0x55, 0xfe, 0x2b, 0x09, 0xa2, 0x77, 0xb0, 0xbd, 0x8f, 0x5d, 0xb4, 0x05, 0x31, 0xc3,
-	0x34, 0x5d, 0xec, 0x79, 0xb2, 0xb4, 0x2e, 0x6d, 0x24, 0xca, 0xf2, 0x0f, 0x4f, 0xaf, 0x2c, 0x09,
-	0xdf, 0x25, 0x8e, 0x34, 0xa9, 0x6b, 0x39, 0x1d, 0x35, 0x20, 0xa2, 0x0b, 0x10, 0x3d, 0xc2, 0x56,
-	0xe7, 0x80, 0xca, 0x21, 0xdf, 0x44, 0x15, 0x27, 0xb4, 0x0a, 0x71, 0x1b, 0x53, 0xc3, 0x34, 0xa8,
-	0x21, 0x87, 0x19, 0x32, 0x3c, 0xa3, 0x6d, 0x88, 0x1b, 0xa6, 0x89, 0x4d, 0xdd, 0xa0, 0x72, 0x64,
-	0x5d, 0xda, 0x48, 0x6e, 0xad, 0x16, 0x78, 0xcc, 0x85, 0x20, 0xe6, 0x82, 0x16, 0xe8, 0x2d, 0x2f,
-	0x3e, 0xfb, 0x29, 0x37, 0xf7, 0xe8, 0xe7, 0x9c, 0xf4, 0xe5, 0xab, 0x27, 0x9b, 0x12, 0x7b, 0x19,
-	0x9b, 0x25, 0x9a, 0x3f, 0x82, 0x45, 0x1e, 0xb7, 0x8a, 0x3f, 0xee, 0x63, 0x8f, 0xfe, 0x53, 0xe1,
-	0xe7, 0xbf, 0x95, 0x60, 0x59, 0x3b, 0x70, 0xb1, 0x77, 0x40, 0xba, 0xe6, 0x36, 0x6e, 0x5b, 0x9e,
-	0x45, 0x9c, 0x06, 0xe9, 0x5a, 0xed, 0x01, 0xba, 0x08, 0x09, 0x1a, 0x40, 0x3c, 0x0a, 0xf5, 0xf8,
-	0x02, 0xbd, 0x0f, 0xb1, 0x23, 0xcb, 0x31, 0xc9, 0x91, 0xc7, 0x9e, 0x4b, 0x6e, 0xfd, 0xb7, 0x30,
-	0xd1, 0x2e, 0x85, 0x71, 0x7f, 0x7b, 0x9c, 0xad, 0x06, 0x66, 0x37, 0xaa, 0xdf, 0x3d, 0xbd, 0x92,
-	0x9d, 0x6d, 0xf3, 0xc9, 0xab, 0x27, 0x9b, 0x79, 0x4e, 0xb9, 0xe2, 0x99, 0x1f, 0x15, 0x4f, 0x09,
-	0x35, 0xff, 0x4c, 0x02, 0xb9, 0x81, 0xdd, 0x36, 0x76, 0xa8, 0xd1, 0xc1, 0x13, 0x3a, 0xb2, 0x00,
-	0xbd, 0x21, 0x26, 0x84, 0x8c, 0xdc, 0xbc, 0x05, 0x25, 0x3b, 0x7f, 0x4f, 0xc9, 0xa5, 0x11, 0x25,
-	0xa7, 0x45, 0x9b, 0xff, 0x46, 0x82, 0x7f, 0x9d, 0xf8, 0x1c, 0xba, 0x03, 0x8b, 0x87, 0x84, 0x5a,
-	0x4e, 0x47, 0xef, 0x61, 0xd7, 0x22, 0xbc, 0x26, 0xc9, 0xad, 0x95, 0xa9, 0x7e, 0xdb, 0x16, 0xf3,
-	0xc7, 0xdb, 0xed, 0xb3, 0x61, 0xbb, 0x2d, 0x70, 0xf3, 0x06, 0xb3, 0x46, 0xf7, 0x60, 0xc9, 0xb6,
-	0x1c, 0x1d, 0x3f, 0xc0, 0xed, 0xbe, 0xcf, 0x0e, 0xbc, 0x86, 0xce, 0xe8, 0x15, 0xd9, 0x96, 0xa3,
-	0x04, 0x4e, 0xb8, 0xef, 0xfc, 0x6f, 0x12, 0x24, 0x3e, 0xf0, 0x13, 0x51, 0x75, 0xee, 0x13, 0x94,
-	0x82, 0x90, 0xc5, 0xa3, 0x8d, 0xa8, 0x21, 0xcb, 0x44, 0x05, 0x98, 0x37, 0x4c, 0xdb, 0x72, 0x78,
-	0x9f, 0xce, 0x68, 0x6d, 0x4e, 0x9b, 0x39, 0x7f, 0x32, 0xc4, 0x0e, 0xb1, 0xeb, 0x27, 0x8b, 0x8d,
-	0x5f, 0x44, 0x0d, 0x8e, 0xe8, 0x3f, 0xb0, 0x40, 0x09, 0x35, 0xba, 0xba, 0x18, 0x8a, 0x79, 0x66,
-	0x99, 0x64, 0x77, 0x7b, 0x7c, 0x32, 0x6e, 0x01, 0xb4, 0x5d, 0x6c, 0x50, 0x3e, 0xbe, 0xd1, 0xb3,
-	0x8e, 0x6f, 0x42, 0x18, 0x97, 0x68, 0xfe, 0x2e, 0x24, 0x99, 0x5e, 0xb1, 0x7d, 0x56, 0x20, 0xce,
-	0xfa, 0x40, 0x1f, 0xea, 0x8e, 0xb1, 0x73, 0xd5, 0x44, 0x45, 0x88, 0xda, 0x8c, 0x24, 0x12, 0xbd,
-	0x3c, 0xd5, 0x6c, 0x62, 0x13, 0x08, 0x5a, 0xfe, 0x8f, 0x10, 0xa4, 0x99, 0x6f, 0xde, 0x0d, 0x2c,
-	0xa3, 0x6f, 0xb2, 0x1e, 0x46, 0x63, 0x0a, 0x8d, 0xc7, 0x34, 0x2c, 0x48, 0xf8, 0xec, 0x05, 0x89,
-	0x9c, 0x5e, 0x90, 0xf9, 0xf1, 0x82, 0x18, 0x90, 0x36, 0x45, 0x63, 0xeb, 0x3d, 0xa6, 0x45, 0xa4,
-	0x7c, 0x69, 0x2a, 0xe5, 0x25, 0x67, 0x50, 0xce, 0xbf, 0x7e, 0xa8, 0xd4, 0x94, 0x39, 0x3e, 0xea,
-	0xe3, 0x05, 0x8d, 0xbd, 0x79, 0x41, 0x6f, 0xc4, 0x1f, 0x3e, 0xce, 0xcd, 0xfd, 0xfa, 0x38, 0x27,
-	0xe5, 0xff, 0x9c, 0x87, 0x78, 0xc3, 0x25, 0x3d, 0xe2, 0x19, 0xdd, 0xa9, 0x56, 0xde, 0x81, 0x25,
-	0x9e, 0x54, 0x2e, 0x48, 0x0f, 0xaa, 0xf2, 0xba, 0xce, 0x46, 0x9d, 0xe3, 0x8a, 0x0a, 0x64, 0x66,
-	0x9b, 0xbf, 0x03, 0x89, 0x1e, 0x8b, 0x01, 0xbb, 0x9e, 0x1c, 0x59, 0x0f, 0xcf, 0x74, 0x7e, 0x4c,
-	0x45, 0x3b, 0x90, 0xf4, 0xfa, 0xfb, 0xb6, 0x45, 0x75, 0xff, 0x43, 0x97, 0x55, 0xe4, 0x4c, 0x19,
-	0x01, 0x6e, 0xed, 0xe3, 0xe8, 0x12, 0x2c, 0x72, 0xad, 0x41, 0x7d, 0xa3, 0x2c, 0x0d, 0x0b, 0xec,
-	0x72, 0x57, 0x14, 0xf9, 0xea, 0x44, 0x42, 0x02, 0x6e, 0x8c, 0x71, 0x47, 0x65, 0x07, 0x16, 0xef,
-	0x42, 0xd4, 0xa3, 0x06, 0xed, 0x7b, 0x72, 0x7c, 0x5d, 0xda, 0x48, 0x6d, 0xe5, 0xa6, 0x06, 0x22,
-	0xc8, 0x7e, 0x93, 0xd1, 0x54, 0x41, 0x47, 0x2d, 0x40, 0xf7, 0x2d, 0xc7, 0xe8, 0xea, 0xd4, 0xe8,
-	0x76, 0x07, 0xba, 0x8b, 0xbd, 0x7e, 0x97, 0xca, 0x09, 0x26, 0xf1, 0xe2, 0x94, 0x13, 0xcd, 0x27,
-	0xa9, 0x8c, 0x53, 0x4e, 0xf8, 0x22, 0xb9, 0xc0, 0x0c, 0x73, 0x31, 0x02, 0xa2, 0x16, 0x9c, 0x1b,
-	0x5b, 0xb3, 0x3a, 0x76, 0x4c, 0x19, 0xce, 0x9a, 0xb8, 0xf4, 0xe8, 0xae, 0x55, 0x1c, 0x13, 0x35,
-	0x20, 0xcd, 0x57, 0x2d, 0x71, 0x83, 0x50, 0x93, 0x4c, 0xef, 0xff, 0x4e, 0xd5, 0xab, 0x08, 0x3e,
-	0x0f, 0x4c, 0x4d, 0xe1, 0xb1, 0x33, 0xba, 0xea, 0xf7, 0x8b, 0xe7, 0x19, 0x1d, 0xec, 0xc9, 0x0b,
-	0xeb, 0xe1, 0xd3, 0x06, 0x49, 0x1d, 0xb2, 0xd0, 0x12, 0xcc, 0x53, 0x8b, 0x76, 0xb1, 0xbc, 0xc8,
-	0xda, 0x8b, 0x1f, 0xfc, 0x89, 0xf5, 0xfa, 0xb6, 0x6d, 0xb8, 0x03, 0x39, 0xc5, 0xee, 0x83, 0xe3,
-	0x8d, 0x88, 0x3f, 0x04, 0xf9, 0xcf, 0x25, 0x48, 0x8e, 0x26, 0x68, 0x0d, 0x12, 0x03, 0xec, 0xe9,
-	0x6d, 0xd2, 0x77, 0xa8, 0xf8, 0x38, 0x8d, 0x0f, 0xb0, 0x57, 0xf1, 0xcf, 0x7e, 0x93, 0x18, 0xfb,
-	0x1e, 0x35, 0x2c, 0x47, 0x10, 0xf8, 0x77, 0x91, 0x05, 0x71, 0xc9, 0x49, 0x2b, 0x10, 0x77, 0x88,
-	0xc0, 0x79, 0xa7, 0xc7, 0x1c, 0xc2, 0xa1, 0xff, 0x03, 0x72, 0x88, 0x7e, 0x64, 0xd1, 0x03, 0xfd,
-	0x10, 0xd3, 0x80, 0xc4, 0x97, 0x4c, 0xda, 0x21, 0x7b, 0x16, 0x3d, 0xd8, 0xc5, 0x94, 0x93, 0x45,
-	0x7c, 0xbf, 0x4b, 0x10, 0xd9, 0x25, 0x14, 0xa3, 0x1c, 0x24, 0x7b, 0x22, 0x75, 0xc7, 0x8b, 0x17,
-	0x82, 0x2b, 0xbe, 0xe7, 0x0e, 0x09, 0x15, 0xab, 0x77, 0xe6, 0x9e, 0x63, 0x34, 0x74, 0x1d, 0xa2,
-	0xa4, 0xe7, 0x7f, 0xac, 0xb1, 0x28, 0x53, 0x5b, 0x6b, 0x53, 0xa5, 0xf2, 0xdf, 0xad, 0x33, 0x8a,
-	0x2a, 0xa8, 0x33, 0x97, 0xe3, 0x5b, 0x1c, 0xc7, 0xcd, 0x4f, 0x25, 0x80, 0xe3, 0xe7, 0xd1, 0x1a,
-	0x2c, 0xef, 0xd6, 0x35, 0x45, 0xaf, 0x37, 0xb4, 0x6a, 0xbd, 0xa6, 0xb7, 0x6a, 0xcd, 0x86, 0x52,
-	0xa9, 0xde, 0xac, 0x2a, 0xdb, 0x99, 0x39, 0x74, 0x1e, 0xd2, 0xa3, 0xe0, 0x5d, 0xa5, 0x99, 0x91,
-	0xd0, 0x32, 0x9c, 0x1f, 0xbd, 0x2c, 0x95, 0x9b, 0x5a, 0xa9, 0x5a, 0xcb, 0x84, 0x10, 0x82, 0xd4,
-	0x28, 0x50, 0xab, 0x67, 0xc2, 0xe8, 0x22, 0xc8, 0xe3, 0x77, 0xfa, 0x5e, 0x55, 0xbb, 0xa5, 0xef,
-	0x2a, 0x5a, 0x3d, 0x13, 0x59, 0x8d, 0x3c, 0xfc, 0x22, 0x3b, 0xb7, 0xf9, 0xbd, 0x04, 0xa9, 0xf1,
-	0x59, 0x45, 0x39, 0x58, 0x6b, 0xa8, 0xf5, 0x46, 0xbd, 0x59, 0xba, 0xad, 0x37, 0xb5, 0x92, 0xd6,
-	0x6a, 0x4e, 0x44, 0xf6, 0x6f, 0x58, 0x99, 0x24, 0x34, 0x5b, 0xe5, 0x3b, 0x55, 0x4d, 0x53, 0xb6,
-	0x33, 0x92, 0xff, 0xec, 0x24, 0x5c, 0xaa, 0x54, 0x94, 0x86, 0x8f, 0x86, 0x4e, 0x42, 0x55, 0x65,
-	0x47, 0xa9, 0xf8, 0x68, 0xd8, 0xcf, 0xc8, 0x94, 0x6d, 0xb9, 0xae, 0xfa, 0x60, 0xe4, 0xa4, 0x77,
-	0x7d, 0x41, 0xdb, 0x6a, 0x69, 0xaf, 0x96, 0x99, 0x17, 0x82, 0xbe, 0x96, 0xe0, 0xc2, 0xc9, 0xc3,
-	0x88, 0x36, 0xe0, 0xf2, 0xd0, 0x5e, 0xf9, 0x50, 0xa9, 0xb4, 0xb4, 0xba, 0xaa, 0xab, 0x4a, 0xb3,
-	0x75, 0x5b, 0x9b, 0x50, 0x78, 0x19, 0xd6, 0x4f, 0x65, 0xd6, 0xea, 0x9a, 0xae, 0xb6, 0x6a, 0x19,
-	0x69, 0x26, 0xab, 0xd9, 0xaa, 0x54, 0x94, 0x66, 0x33, 0x13, 0x9a, 0xc9, 0xba, 0x59, 0xaa, 0xde,
-	0x6e, 0xa9, 0x4a, 0x26, 0xcc, 0x83, 0x2f, 0xbf, 0xf7, 0xec, 0x45, 0x56, 0x7a, 0xfe, 0x22, 0x2b,
-	0xfd, 0xf2, 0x22, 0x2b, 0x3d, 0x7a, 0x99, 0x9d, 0x7b, 0xfe, 0x32, 0x3b, 0xf7, 0xe3, 0xcb, 0xec,
-	0xdc, 0xbd, 0xcb, 0x1d, 0x8b, 0x1e, 0xf4, 0xf7, 0x0b, 0x6d, 0x62, 0x8b, 0x5f, 0x5b, 0xc5, 0x91,
-	0x6f, 0xa6, 0x0f, 0xf8, 0x8f, 0xc1, 0xfd, 0x28, 0x6b, 0xc7, 0xeb, 0x7f, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x98, 0xe9, 0x54, 0xdb, 0x23, 0x0e, 0x00, 0x00,
+	// 1740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xbd, 0x6f, 0x1b, 0xc9,
+	0x15, 0xd7, 0x92, 0x14, 0x29, 0x3d, 0x4a, 0x14, 0x35, 0x56, 0xec, 0x95, 0xe4, 0x90, 0xce, 0xda,
+	0xb8, 0x18, 0x0e, 0x4c, 0xde, 0xe9, 0x82, 0x04, 0x70, 0x80, 0x24, 0x24, 0xb5, 0xe7, 0xa3, 0xcf,
+	0x26, 0x99, 0xdd, 0xa5, 0x94, 0xbb, 0x66, 0xb1, 0xe2, 0x8e, 0xa9, 0x85, 0xb9, 0x3b, 0xcc, 0xee,
+	0x50, 0xb2, 0x8a, 0xf4, 0x87, 0x34, 0xb9, 0x32, 0x08, 0x10, 0xc0, 0x40, 0x9a, 0x94, 0x57, 0x1c,
+	0x52, 0xa4, 0x0c, 0x12, 0xc0, 0x48, 0x65, 0x5c, 0x95, 0x2a, 0x09, 0xec, 0xe2, 0x82, 0x2b, 0x52,
+	0xe5, 0x0f, 0x08, 0xe6, 0x63, 0xa9, 0x15, 0x49, 0x49, 0xa6, 0x63, 0x5c, 0x43, 0x70, 0xe6, 0xfd,
+	0xde, 0x9b, 0xf7, 0x7b, 0x5f, 0x33, 0x24, 0x6c, 0xf7, 0x48, 0xe4, 0x93, 0xa8, 0xda, 0x0f, 0xc9,
+	0x68, 0x58, 0x3d, 0x7a, 0xaf, 0x4a, 0x4f, 0x86, 0x38, 0xaa, 0x0c, 0x43, 0x42, 0x09, 0x5a, 0x13,
+	0xc2, 0x0a, 0x17, 0x56, 0x8e, 0xde, 0xdb, 0xda, 0xe8, 0x93, 0x3e, 0xe1, 0xb2, 0x2a, 0xfb, 0x26,
+	0x60, 0x5b, 0xa5, 0x3e, 0x21, 0xfd, 0x01, 0xae, 0xf2, 0xd5, 0xc1, 0xe8, 0x71, 0xd5, 0x1d, 0x85,
+	0x0e, 0xf5, 0x48, 0x20, 0xe5, 0xe5, 0x49, 0x39, 0xf5, 0x7c, 0x1c, 0x51, 0xc7, 0x1f, 0x4a, 0xc0,
+	0xa6, 0x38, 0xc7, 0x16, 0x96, 0xe5, 0xa1, 0x52, 0x34, 0xa9, 0xeb, 0x04, 0x27, 0x52, 0xb4, 0xee,
+	0xf8, 0x5e, 0x40, 0xaa, 0xfc, 0x53, 0x6c, 0x69, 0x7f, 0x54, 0x20, 0xfb, 0x08, 0xfb, 0x07, 0x38,
+	0x44, 0x3b, 0x90, 0x73, 0x5c, 0x37, 0xc4, 0x51, 0xa4, 0x2a, 0x37, 0x94, 0xdb, 0xcb, 0x75, 0xf5,
+	0xcb, 0x2f, 0xee, 0x6e, 0x48, 0xdb, 0x35, 0x21, 0x31, 0x69, 0xe8, 0x05, 0x7d, 0x23, 0x06, 0xa2,
+	0xab, 0x90, 0x3d, 0xc6, 0x5e, 0xff, 0x90, 0xaa, 0x29, 0xa6, 0x62, 0xc8, 0x15, 0xda, 0x82, 0x25,
+	0x1f, 0x53, 0xc7, 0x75, 0xa8, 0xa3, 0xa6, 0xb9, 0x64, 0xbc, 0x46, 0xbb, 0xb0, 0xe4, 0xb8, 0x2e,
+	0x76, 0x6d, 0x87, 0xaa, 0x99, 0x1b, 0xca, 0xed, 0xfc, 0xce, 0x56, 0x45, 0xf8, 0x5c, 0x89, 0x7d,
+	0xae, 0x58, 0x31, 0xdf, 0xfa, 0xea, 0xf3, 0x7f, 0x94, 0x17, 0x3e, 0xfb, 0x67, 0x59, 0xf9, 0xc3,
+	0x57, 0x9f, 0xdf, 0x51, 0xf8, 0xc9, 0xd8, 0xad, 0x51, 0xed, 0x18, 0x56, 0x85, 0xdf, 0x06, 0xfe,
+	0xc5, 0x08, 0x47, 0xf4, 0x9b, 0x72, 0x5f, 0xfb, 0x8b, 0x02, 0xd7, 0xac, 0xc3, 0x10, 0x47, 0x87,
+	0x64, 0xe0, 0xee, 0xe2, 0x9e, 0x17, 0x79, 0x24, 0xe8, 0x90, 0x81, 0xd7, 0x3b, 0x41, 0xd7, 0x61,
+	0x99, 0xc6, 0x22, 0xe1, 0x85, 0x71, 0xba, 0x81, 0x7e, 0x0a, 0xb9, 0x63, 0x2f, 0x70, 0xc9, 0x71,
+	0xc4, 0x8f, 0xcb, 0xef, 0xbc, 0x53, 0x99, 0x28, 0x97, 0xca, 0x59, 0x7b, 0xfb, 0x02, 0x6d, 0xc4,
+	0x6a, 0xf7, 0x9a, 0x7f, 0xfb, 0xe2, 0x6e, 0xe9, 0x62, 0x9d, 0x5f, 0x7d, 0xf5, 0xf9, 0x1d, 0x4d,
+	0x40, 0xee, 0x46, 0xee, 0x93, 0xea, 0x39, 0xae, 0x6a, 0xcf, 0x15, 0x50, 0x3b, 0x38, 0xec, 0xe1,
+	0x80, 0x3a, 0x7d, 0x3c, 0xc1, 0xa3, 0x04, 0x30, 0x1c, 0xcb, 0x24, 0x91, 0xc4, 0xce, 0x5b, 0x60,
+	0xf2, 0xe0, 0xf5, 0x98, 0xdc, 0x4c, 0x30, 0x39, 0xcf, 0x5b, 0xed, 0x59, 0x0a, 0xb6, 0x2d, 0xf2,
+	0x04, 0x07, 0xfb, 0x3c, 0x7b, 0xd8, 0x9d, 0x93, 0xcd, 0x3d, 0xc8, 0x46, 0x64, 0x14, 0xf6, 0x30,
+	0x27, 0x53, 0xd8, 0xd1, 0xa6, 0xc8, 0xec, 0x11, 0xea, 0x05, 0xfd, 0x0e, 0x39, 0xc6, 0xa1, 0xc9,
+	0x91, 0x86, 0xd4, 0x40, 0x1b, 0xb0, 0xe8, 0xe2, 0x80, 0xf8, 0xb2, 0x4c, 0xc4, 0x22, 0x19, 0x9f,
+	0xcc, 0x9b, 0xc5, 0xe7, 0xd1, 0xeb, 0xc5, 0xe7, 0x9d, 0x64, 0xa6, 0xcf, 0x0f, 0x81, 0xf6, 0x67,
+	0x05, 0xbe, 0x35, 0xf3, 0x44, 0xf4, 0x08, 0x56, 0x8f, 0x38, 0x3b, 0x7b, 0x88, 0x43, 0x8f, 0x88,
+	0xb2, 0xcd, 0xef, 0x6c, 0x4e, 0xb5, 0xe4, 0xae, 0x1c, 0x51, 0xa2, 0x23, 0x7f, 0x33, 0xee, 0xc8,
+	0x15, 0xa1, 0xde, 0xe1, 0xda, 0xe8, 0x13, 0xd8, 0xf0, 0xbd, 0xc0, 0xc6, 0x4f, 0x71, 0x6f, 0xc4,
+	0xd0, 0xb1, 0xd5, 0xd4, 0x9c, 0x56, 0x91, 0xef, 0x05, 0x7a, 0x6c, 0x44, 0xd8, 0xd6, 0xfe, 0xa3,
+	0xc0, 0xf2, 0x7d, 0x16, 0x8b, 0x66, 0xf0, 0x98, 0xa0, 0x02, 0xa4, 0x3c, 0xe1, 0x6d, 0xc6, 0x48,
+	0x79, 0x2e, 0xaa, 0xc0, 0xa2, 0xe3, 0xfa, 0x5e, 0xa0, 0xa6, 0x2e, 0xe9, 0x7e, 0x01, 0xbb, 0x70,
+	0x44, 0xa9, 0x90, 0x3b, 0xc2, 0x21, 0x0b, 0x16, 0xcf, 0x5f, 0xc6, 0x88, 0x97, 0xe8, 0x3b, 0xb0,
+	0x42, 0x09, 0x75, 0x06, 0xb6, 0x9c, 0x1b, 0x8b, 0x5c, 0x33, 0xcf, 0xf7, 0x44, 0xec, 0xd1, 0x87,
+	0x00, 0xbd, 0x10, 0x3b, 0x54, 0x4c, 0xb8, 0xec, 0xbc, 0x13, 0x6e, 0x59, 0x2a, 0xd7, 0xa8, 0xf6,
+	0x31, 0xe4, 0x39, 0x5f, 0x39, 0xa0, 0x37, 0x61, 0x89, 0x97, 0x82, 0x3d, 0xe6, 0x9d, 0xe3, 0xeb,
+	0xa6, 0x8b, 0xaa, 0x90, 0xf5, 0x39, 0x48, 0x06, 0xfa, 0xda, 0x54, 0xbd, 0xc9, 0x61, 0x29, 0x61,
+	0xda, 0x8b, 0x34, 0xac, 0x71, 0xdb, 0xa2, 0x1a, 0x78, 0x44, 0xdf, 0x64, 0x82, 0x26, 0x7d, 0x4a,
+	0x9d, 0xf5, 0x69, 0x9c, 0x90, 0xf4, 0xfc, 0x09, 0xc9, 0x9c, 0x9f, 0x90, 0xc5, 0xb3, 0x09, 0x71,
+	0x60, 0xcd, 0x95, 0x85, 0x6d, 0x0f, 0x39, 0x17, 0x19, 0xf2, 0x8d, 0xa9, 0x90, 0xd7, 0x82, 0x93,
+	0xba, 0x76, 0x79, 0x5f, 0x19, 0x05, 0xf7, 0xcc, 0x7a, 0x22, 0xa1, 0xb9, 0x37, 0x4f, 0x28, 0xfa,
+	0x19, 0xa0, 0xd3, 0xce, 0x88, 0x30, 0x65, 0x8d, 0x13, 0xa9, 0x4b, 0xdc, 0xe2, 0xf4, 0xd4, 0x19,
+	0xd7, 0xbf, 0x29, 0x91, 0xc6, 0x3a, 0x9e, 0xdc, 0xba, 0xb7, 0xf4, 0xe9, 0xb3, 0xf2, 0xc2, 0xbf,
+	0x9f, 0x95, 0x15, 0xed, 0x4b, 0x05, 0xd6, 0xa7, 0x54, 0xd8, 0x6d, 0xcb, 0x1e, 0x0f, 0x03, 0xd2,
+	0x7b, 0x32, 0x77, 0x6b, 0x8f, 0x35, 0xd1, 0x8f, 0x60, 0xe5, 0x08, 0x53, 0x62, 0xc7, 0xf5, 0x71,
+	0x59, 0x8f, 0xe5, 0x19, 0x5a, 0x6e, 0xa1, 0xef, 0xc3, 0x55, 0xdf, 0x79, 0x9a, 0x98, 0x09, 0x0e,
+	0xa5, 0xd8, 0x1f, 0xd2, 0x88, 0x57, 0xc6, 0xaa, 0xb1, 0xe1, 0x3b, 0x4f, 0xc7, 0x8e, 0xd7, 0xa4,
+	0xec, 0x5e, 0x86, 0x93, 0xfa, 0x6b, 0x0e, 0x96, 0x3a, 0x21, 0x19, 0x92, 0xc8, 0x19, 0x4c, 0xb5,
+	0xfc, 0x03, 0xd8, 0x10, 0xc5, 0x27, 0x12, 0xff, 0xda, 0xde, 0xa1, 0xfe, 0x69, 0xe5, 0xc7, 0x4e,
+	0x5e, 0x34, 0x0e, 0x7e, 0x00, 0xcb, 0x43, 0xee, 0x03, 0x0e, 0xd9, 0x40, 0x4f, 0x5f, 0x68, 0xfc,
+	0x14, 0x8a, 0x1e, 0x40, 0x3e, 0x1a, 0x1d, 0xf8, 0x1e, 0xb5, 0x59, 0x20, 0xd5, 0xc5, 0x79, 0x2b,
+	0x07, 0x84, 0x36, 0x93, 0xa3, 0x9b, 0xb0, 0x2a, 0xb8, 0xc6, 0x7d, 0x90, 0xe5, 0x61, 0x58, 0xe1,
+	0x9b, 0x7b, 0xb2, 0x19, 0xde, 0x9d, 0x08, 0x48, 0x8c, 0xcd, 0x71, 0x6c, 0x92, 0x76, 0xac, 0xf1,
+	0x43, 0xc8, 0x46, 0xd4, 0xa1, 0x23, 0x51, 0x85, 0x85, 0x9d, 0xf2, 0x54, 0x15, 0xc6, 0xd1, 0x37,
+	0x39, 0xcc, 0x90, 0x70, 0xd4, 0x05, 0xf4, 0xd8, 0x0b, 0x9c, 0x81, 0x4d, 0x9d, 0xc1, 0xe0, 0xc4,
+	0x0e, 0x71, 0x34, 0x1a, 0x50, 0x75, 0x99, 0x53, 0xbc, 0x3e, 0x65, 0xc4, 0x62, 0x20, 0x83, 0x63,
+	0xea, 0xcb, 0x8c, 0xa4, 0x20, 0x58, 0xe4, 0x26, 0x12, 0x42, 0xd4, 0x85, 0xf5, 0x33, 0xd7, 0x91,
+	0x8d, 0x03, 0x57, 0x85, 0x79, 0x03, 0xb7, 0x96, 0xbc, 0x93, 0xf4, 0xc0, 0x45, 0x1d, 0x58, 0x13,
+	0xe5, 0x47, 0xc2, 0xd8, 0xd5, 0x3c, 0xe7, 0xfb, 0xdd, 0x73, 0xf9, 0xea, 0x12, 0x2f, 0x1c, 0x33,
+	0x0a, 0xf8, 0xcc, 0x1a, 0xbd, 0xcb, 0xea, 0x25, 0x8a, 0x9c, 0x3e, 0x8e, 0xd4, 0x95, 0x1b, 0xe9,
+	0xf3, 0x06, 0x8e, 0x31, 0x46, 0xb1, 0xa7, 0x02, 0xf5, 0xe8, 0x00, 0xab, 0xab, 0xe2, 0xa9, 0xc0,
+	0x17, 0x6c, 0xb2, 0x45, 0x23, 0xdf, 0x77, 0xc2, 0x13, 0xb5, 0xc0, 0xf7, 0xe3, 0x25, 0x6a, 0xc0,
+	0x4a, 0xdc, 0x7f, 0x3c, 0x0a, 0x6b, 0x97, 0x46, 0x21, 0xc3, 0x22, 0x60, 0xe4, 0x63, 0x2d, 0x46,
+	0xfc, 0x2e, 0xa0, 0x19, 0x7d, 0x57, 0xe4, 0x7d, 0xb7, 0x8e, 0x27, 0x9b, 0xee, 0x9c, 0x01, 0xb5,
+	0xfe, 0xff, 0x0c, 0xa8, 0x0c, 0x1b, 0x50, 0xda, 0xef, 0x14, 0xc8, 0x27, 0xf3, 0xbc, 0x0d, 0xcb,
+	0x27, 0x38, 0xb2, 0x7b, 0x64, 0x14, 0x50, 0xf9, 0x24, 0x5b, 0x3a, 0xc1, 0x51, 0x83, 0xad, 0x59,
+	0xad, 0x3b, 0x07, 0x11, 0x75, 0xbc, 0x40, 0x02, 0xc4, 0xeb, 0x7c, 0x45, 0x6e, 0x0a, 0xd0, 0x26,
+	0x2c, 0x05, 0x44, 0xca, 0x45, 0xc3, 0xe6, 0x02, 0x22, 0x44, 0xdf, 0x03, 0x14, 0x10, 0xfb, 0xd8,
+	0xa3, 0x87, 0x36, 0x9f, 0x5a, 0x02, 0x24, 0xee, 0x94, 0xb5, 0x80, 0xec, 0x7b, 0xf4, 0x70, 0x0f,
+	0x53, 0x01, 0x96, 0xfe, 0xfd, 0x57, 0x81, 0xcc, 0x1e, 0xa1, 0x18, 0x95, 0x21, 0x3f, 0x94, 0x15,
+	0x70, 0x7a, 0xcf, 0x42, 0xbc, 0x25, 0xae, 0xb5, 0x23, 0x42, 0xe5, 0x4d, 0x7b, 0xe1, 0xb5, 0xc6,
+	0x61, 0xe8, 0x7d, 0xc8, 0x92, 0x21, 0x8b, 0x08, 0xf7, 0xb2, 0xb0, 0xb3, 0x3d, 0xeb, 0x75, 0x89,
+	0xdb, 0x1c, 0x62, 0x48, 0xe8, 0x85, 0x77, 0xe1, 0x5b, 0x9c, 0x2a, 0xda, 0x6f, 0x15, 0xb8, 0x92,
+	0x7c, 0xdc, 0x06, 0xce, 0x30, 0x3a, 0x24, 0xf4, 0xf2, 0x28, 0xd4, 0x20, 0x27, 0x5e, 0x12, 0x6c,
+	0xda, 0xa6, 0x67, 0xf6, 0xbc, 0x78, 0x71, 0x70, 0xbb, 0xc9, 0x9e, 0x8f, 0xf5, 0xd8, 0x19, 0xe2,
+	0x29, 0x35, 0x64, 0x10, 0x99, 0x43, 0xe0, 0x5b, 0x5c, 0x49, 0xdb, 0x87, 0x7c, 0xc2, 0xc6, 0x1b,
+	0x3d, 0x4f, 0x36, 0x60, 0x51, 0x58, 0x17, 0x15, 0x24, 0x16, 0xda, 0xd7, 0x0a, 0xac, 0x8c, 0x6b,
+	0xf7, 0x21, 0xe9, 0x5f, 0x4e, 0x57, 0x85, 0x9c, 0x6c, 0x1e, 0x6e, 0x69, 0xd5, 0x88, 0x97, 0x2c,
+	0x1b, 0xa2, 0xe6, 0xc5, 0xeb, 0x20, 0x3d, 0x77, 0x36, 0x62, 0xed, 0x1a, 0x45, 0x3f, 0x81, 0xac,
+	0x1c, 0x4e, 0x99, 0xf9, 0x86, 0x93, 0x54, 0x43, 0x08, 0x32, 0x03, 0xd2, 0x8f, 0xe4, 0xab, 0x94,
+	0x7f, 0xbf, 0xf3, 0x4b, 0x58, 0x9f, 0xfa, 0xf9, 0x82, 0x6e, 0x42, 0x79, 0xaf, 0x6d, 0x35, 0x5b,
+	0xf7, 0xed, 0x4e, 0x7b, 0x5f, 0x37, 0x6c, 0xb3, 0xdd, 0x35, 0x1a, 0xba, 0xdd, 0x6d, 0x99, 0x1d,
+	0xbd, 0xd1, 0xfc, 0xa0, 0xa9, 0xef, 0x16, 0x17, 0xd0, 0x75, 0x50, 0x67, 0x81, 0xea, 0xb5, 0xd6,
+	0x47, 0x45, 0x05, 0x95, 0x61, 0x7b, 0x96, 0xd4, 0xb4, 0x6a, 0x1f, 0x35, 0x5b, 0xf7, 0x8b, 0xa9,
+	0xad, 0xcc, 0xa7, 0xbf, 0x2f, 0x2d, 0xdc, 0xf9, 0xb5, 0x02, 0x70, 0x5a, 0xe0, 0x68, 0x1b, 0xae,
+	0xed, 0xb5, 0x2d, 0xdd, 0x6e, 0x77, 0xac, 0x66, 0xbb, 0x35, 0x71, 0xe0, 0x15, 0x58, 0x4b, 0x0a,
+	0x3f, 0xd6, 0xcd, 0xa2, 0x82, 0xae, 0xc1, 0x95, 0xe4, 0x66, 0xad, 0x6e, 0x5a, 0xb5, 0x66, 0xab,
+	0x98, 0x42, 0x08, 0x0a, 0x49, 0x41, 0xab, 0x5d, 0x4c, 0x4b, 0x97, 0x13, 0x7b, 0xf6, 0x7e, 0xd3,
+	0xfa, 0xd0, 0xde, 0xd3, 0xad, 0x76, 0x31, 0x23, 0x3d, 0xfa, 0x5a, 0x81, 0xc2, 0xd9, 0x4b, 0x8d,
+	0x71, 0xe9, 0x18, 0xed, 0x4e, 0xdb, 0xac, 0x3d, 0x64, 0x04, 0xac, 0xae, 0x39, 0xe1, 0xd9, 0xb7,
+	0x61, 0x73, 0x12, 0x60, 0x76, 0xeb, 0x8f, 0x9a, 0x96, 0xa5, 0xef, 0x16, 0x15, 0x76, 0xec, 0xa4,
+	0xb8, 0xd6, 0x68, 0xe8, 0x1d, 0x26, 0x4d, 0xcd, 0x92, 0x1a, 0xfa, 0x03, 0xbd, 0xc1, 0xa4, 0x69,
+	0x16, 0x91, 0x29, 0xdd, 0x7a, 0xdb, 0x60, 0xc2, 0xcc, 0xac, 0x73, 0x19, 0xa1, 0x5d, 0xa3, 0xb6,
+	0xdf, 0x2a, 0x2e, 0xa2, 0x2d, 0xb8, 0x3a, 0x29, 0x66, 0x54, 0xf5, 0xdd, 0x62, 0x56, 0x92, 0xfd,
+	0x93, 0x02, 0x57, 0x67, 0x17, 0x0d, 0xba, 0x0d, 0xb7, 0xc6, 0xca, 0xfa, 0xcf, 0xf5, 0x46, 0xd7,
+	0x6a, 0x1b, 0xb6, 0xa1, 0x9b, 0xdd, 0x87, 0xd6, 0x04, 0xfb, 0x5b, 0x70, 0xe3, 0x5c, 0x64, 0xab,
+	0x6d, 0xd9, 0x46, 0xb7, 0x55, 0x54, 0x2e, 0x44, 0x99, 0xdd, 0x46, 0x43, 0x37, 0xcd, 0x62, 0xea,
+	0x42, 0xd4, 0x07, 0xb5, 0xe6, 0xc3, 0xae, 0xa1, 0x17, 0xd3, 0xc2, 0xf9, 0xfa, 0x8f, 0x9f, 0xbf,
+	0x2c, 0x29, 0x2f, 0x5e, 0x96, 0x94, 0x7f, 0xbd, 0x2c, 0x29, 0x9f, 0xbd, 0x2a, 0x2d, 0xbc, 0x78,
+	0x55, 0x5a, 0xf8, 0xfb, 0xab, 0xd2, 0xc2, 0x27, 0xb7, 0xfa, 0x1e, 0x3d, 0x1c, 0x1d, 0x54, 0x7a,
+	0xc4, 0x97, 0xff, 0x7e, 0x55, 0x13, 0xbf, 0x84, 0x9f, 0x8a, 0x3f, 0xe7, 0x0e, 0xb2, 0xbc, 0xfd,
+	0xde, 0xff, 0xdf, 0x00, 0x1f, 0xab, 0x72, 0x3b, 0xb3, 0x13, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1048,31 +1488,64 @@ func (this *GroupPolicyInfo) Equal(that interface{}) bool {
 	if !this.CreatedAt.Equal(that1.CreatedAt) {
 		return false
 	}
+	if !this.ExecutionSettings.Equal(that1.ExecutionSettings) {
+		return false
+	}
 	return true
 }
-func (m *Member) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ExecutionSettings) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Member) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
-	if err1 != nil {
-		return 0, err1
+	that1, ok := that.(*ExecutionSettings)
+	if !ok {
+		that2, ok := that.(ExecutionSettings)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Timelock != that1.Timelock {
+		return false
+	}
+	if this.VetoAddress != that1.VetoAddress {
+		return false
+	}
+	if this.MaxExecutionAttempts != that1.MaxExecutionAttempts {
+		return false
+	}
+	return true
+}
+func (m *Member) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Member) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
+	if err1 != nil {
+		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintTypes(dAtA, i, uint64(n1))
@@ -1230,6 +1703,60 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenWeightedDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenWeightedDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenWeightedDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Source != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1250,21 +1777,21 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -1289,12 +1816,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1391,12 +1918,24 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	if m.ExecutionSettings != nil {
+		{
+			size, err := m.ExecutionSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1445,6 +1984,49 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExecutionSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxExecutionAttempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxExecutionAttempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.VetoAddress) > 0 {
+		i -= len(m.VetoAddress)
+		copy(dAtA[i:], m.VetoAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timelock, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1465,6 +2047,37 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExecutionSettings != nil {
+		{
+			size, err := m.ExecutionSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ExecutionAttempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExecutionAttempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.TimelockEnd != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.TimelockEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEnd):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintTypes(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.Summary) > 0 {
 		i -= len(m.Summary)
 		copy(dAtA[i:], m.Summary)
@@ -1498,12 +2111,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1531,12 +2144,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n17
+	i = encodeVarintTypes(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1641,12 +2254,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err18 != nil {
+		return 0, err18
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n18
+	i = encodeVarintTypes(dAtA, i, uint64(n18))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1676,57 +2289,196 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VotingPowerSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Member) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *VotingPowerSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.TotalPower) > 0 {
+		i -= len(m.TotalPower)
+		copy(dAtA[i:], m.TotalPower)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TotalPower)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt)
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MemberRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MemberPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MemberPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MemberPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Weight)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.Power) > 0 {
+		i -= len(m.Power)
+		copy(dAtA[i:], m.Power)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Power)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		i -= len(m.Logs)
+		copy(dAtA[i:], m.Logs)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Logs)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Result != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Result))
+		i--
+		dAtA[i] = 0x20
+	}
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutedAt):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintTypes(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x1a
+	if m.Attempt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Member) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *MemberRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
 	}
 	return n
 }
@@ -1765,6 +2517,30 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *TokenWeightedDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovTypes(uint64(m.Source))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1853,6 +2629,28 @@ func (m *GroupPolicyInfo) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.ExecutionSettings != nil {
+		l = m.ExecutionSettings.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timelock)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.VetoAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxExecutionAttempts != 0 {
+		n += 1 + sovTypes(uint64(m.MaxExecutionAttempts))
+	}
 	return n
 }
 
@@ -1911,6 +2709,17 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.TimelockEnd != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.TimelockEnd)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExecutionAttempts != 0 {
+		n += 2 + sovTypes(uint64(m.ExecutionAttempts))
+	}
+	if m.ExecutionSettings != nil {
+		l = m.ExecutionSettings.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1964,6 +2773,69 @@ func (m *Vote) Size() (n int) {
 	return n
 }
 
+func (m *VotingPowerSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.TotalPower)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *MemberPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Power)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTypes(uint64(m.ProposalId))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTypes(uint64(m.Attempt))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutedAt)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Result != 0 {
+		n += 1 + sovTypes(uint64(m.Result))
+	}
+	l = len(m.Logs)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2531,7 +3403,7 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
+func (m *TokenWeightedDecisionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2554,17 +3426,17 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenWeightedDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2574,28 +3446,78 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= VotingPowerSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2622,9 +3544,128 @@ func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecisionPolicyWindows: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecisionPolicyWindows: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3201,6 +4242,42 @@ func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionSettings == nil {
+				m.ExecutionSettings = &ExecutionSettings{}
+			}
+			if err := m.ExecutionSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3222,7 +4299,7 @@ func (m *GroupPolicyInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *ExecutionSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3245,17 +4322,17 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExecutionSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExecutionSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
 			}
-			m.Id = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3265,14 +4342,28 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timelock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3300,13 +4391,13 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			m.VetoAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionAttempts", wireType)
 			}
-			var stringLen uint64
+			m.MaxExecutionAttempts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3316,29 +4407,66 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxExecutionAttempts |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3348,18 +4476,101 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
@@ -3639,6 +4850,97 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Summary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockEnd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimelockEnd == nil {
+				m.TimelockEnd = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.TimelockEnd, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionAttempts", wireType)
+			}
+			m.ExecutionAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutionSettings == nil {
+				m.ExecutionSettings = &ExecutionSettings{}
+			}
+			if err := m.ExecutionSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4023,6 +5325,427 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VotingPowerSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPowerSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPowerSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, MemberPower{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemberPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemberPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemberPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Power = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecutedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= ProposalExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
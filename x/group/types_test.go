@@ -386,3 +386,99 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenWeightedDecisionPolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy group.DecisionPolicy
+		expErr string
+	}{
+		{
+			"bank source",
+			group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_BANK, "stake", time.Hour, 0),
+			"",
+		},
+		{
+			"staking source",
+			group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_STAKING, "", time.Hour, 0),
+			"",
+		},
+		{
+			"percentage above 1",
+			group.NewTokenWeightedDecisionPolicy("1.5", group.VOTING_POWER_SOURCE_STAKING, "", time.Hour, 0),
+			"percentage must be > 0 and <= 1",
+		},
+		{
+			"unspecified source",
+			group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_UNSPECIFIED, "", time.Hour, 0),
+			"invalid voting power source",
+		},
+		{
+			"bank source without denom",
+			group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_BANK, "", time.Hour, 0),
+			"voting power denom",
+		},
+		{
+			"staking source with denom",
+			group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_STAKING, "stake", time.Hour, 0),
+			"denom must be empty",
+		},
+		{
+			"zero voting period",
+			group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_STAKING, "", 0, 0),
+			"voting period cannot be 0",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTokenWeightedDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewTokenWeightedDecisionPolicy("0.5", group.VOTING_POWER_SOURCE_BANK, "stake", time.Hour, 0)
+	testCases := []struct {
+		name       string
+		tally      group.TallyResult
+		totalPower string
+		result     group.DecisionPolicyResult
+	}{
+		{
+			"yes power percentage >= policy percentage",
+			group.TallyResult{YesCount: "600", NoCount: "0", AbstainCount: "0", NoWithVetoCount: "0"},
+			"1000",
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"not enough undecided power left",
+			group.TallyResult{YesCount: "100", NoCount: "600", AbstainCount: "0", NoWithVetoCount: "0"},
+			"1000",
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"undecided",
+			group.TallyResult{YesCount: "100", NoCount: "100", AbstainCount: "0", NoWithVetoCount: "0"},
+			"1000",
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"no voting power",
+			group.DefaultTallyResult(),
+			"0",
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := policy.Allow(tc.tally, tc.totalPower)
+			require.NoError(t, err)
+			require.Equal(t, tc.result, result)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -24,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryProjectedEmissions(),
 	)
 
 	return mintingQueryCmd
@@ -115,3 +117,42 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProjectedEmissions implements a command to return the projected
+// amount of tokens minted over a range of future block heights.
+func GetCmdQueryProjectedEmissions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-emissions [start-height] [end-height]",
+		Short: "Query the projected amount of tokens minted between two future block heights, inclusive",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("start-height %s not a valid uint, please input a valid start-height", args[0])
+			}
+
+			endHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("end-height %s not a valid uint, please input a valid end-height", args[1])
+			}
+
+			params := &types.QueryProjectedEmissionsRequest{StartHeight: startHeight, EndHeight: endHeight}
+			res, err := queryClient.ProjectedEmissions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Amount)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
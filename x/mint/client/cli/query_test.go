@@ -45,17 +45,21 @@ func TestGetCmdQueryParams(t *testing.T) {
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", flags.FlagOutput)},
 			`[--height=1 --output=json]`,
-			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0"}`,
+			`{"mint_denom":"","inflation_rate_change":"0","inflation_max":"0","inflation_min":"0","goal_bonded":"0","blocks_per_year":"0","inflation_curve":"INFLATION_CURVE_BONDED_RATIO","halving_interval":"0","emission_schedule":[],"max_supply":"0"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", flags.FlagOutput)},
 			`[--height=1 --output=text]`,
 			`blocks_per_year: "0"
+emission_schedule: []
 goal_bonded: "0"
+halving_interval: "0"
+inflation_curve: INFLATION_CURVE_BONDED_RATIO
 inflation_max: "0"
 inflation_min: "0"
 inflation_rate_change: "0"
+max_supply: "0"
 mint_denom: ""`,
 		},
 	}
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -32,3 +35,28 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// ProjectedEmissions returns the projected amount of tokens minted over a
+// range of future block heights, with the inflation calculation function of the
+// keeper.
+func (k Keeper) ProjectedEmissions(c context.Context, req *types.QueryProjectedEmissionsRequest) (*types.QueryProjectedEmissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.StartHeight <= uint64(ctx.BlockHeight()) {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d must be after the current height %d", req.StartHeight, ctx.BlockHeight())
+	}
+	if req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d must not be before start height %d", req.EndHeight, req.StartHeight)
+	}
+	if req.EndHeight-uint64(ctx.BlockHeight()) > types.MaxProjectedEmissionsBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot project emissions more than %d blocks ahead", types.MaxProjectedEmissionsBlocks)
+	}
+
+	amount := k.ProjectEmissions(ctx, k.InflationCalculationFn(), req.StartHeight, req.EndHeight)
+
+	return &types.QueryProjectedEmissionsResponse{Amount: amount}, nil
+}
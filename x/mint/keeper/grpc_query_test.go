@@ -4,6 +4,7 @@ import (
 	gocontext "context"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
@@ -22,9 +23,10 @@ import (
 type MintTestSuite struct {
 	suite.Suite
 
-	ctx         sdk.Context
-	queryClient types.QueryClient
-	mintKeeper  keeper.Keeper
+	ctx           sdk.Context
+	queryClient   types.QueryClient
+	mintKeeper    keeper.Keeper
+	stakingKeeper *minttestutil.MockStakingKeeper
 }
 
 func (suite *MintTestSuite) SetupTest() {
@@ -38,6 +40,7 @@ func (suite *MintTestSuite) SetupTest() {
 	accountKeeper := minttestutil.NewMockAccountKeeper(ctrl)
 	bankKeeper := minttestutil.NewMockBankKeeper(ctrl)
 	stakingKeeper := minttestutil.NewMockStakingKeeper(ctrl)
+	suite.stakingKeeper = stakingKeeper
 
 	accountKeeper.EXPECT().GetModuleAddress("mint").Return(sdk.AccAddress{})
 
@@ -75,6 +78,48 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, suite.mintKeeper.GetMinter(suite.ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCProjectedEmissions() {
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+	params.InflationCurve = types.InflationCurveEmissionSchedule
+	params.EmissionSchedule = []types.EmissionPeriod{{StartHeight: 0, Inflation: sdk.NewDecWithPrec(1, 1)}}
+	params.MaxSupply = math.NewInt(1_002_500)
+	suite.Require().NoError(suite.mintKeeper.SetParams(suite.ctx, params))
+
+	suite.stakingKeeper.EXPECT().StakingTokenSupply(gomock.Any()).Return(math.NewInt(1_000_000)).AnyTimes()
+	suite.stakingKeeper.EXPECT().BondedRatio(gomock.Any()).Return(sdk.NewDecWithPrec(5, 1)).AnyTimes()
+
+	_, err := suite.queryClient.ProjectedEmissions(gocontext.Background(), &types.QueryProjectedEmissionsRequest{StartHeight: 0, EndHeight: 10})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.ProjectedEmissions(gocontext.Background(), &types.QueryProjectedEmissionsRequest{StartHeight: 10, EndHeight: 1})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.ProjectedEmissions(gocontext.Background(), &types.QueryProjectedEmissionsRequest{StartHeight: 1, EndHeight: types.MaxProjectedEmissionsBlocks + 1})
+	suite.Require().Error(err)
+
+	// 10% of the supply a year over 100 blocks a year mints 1000 then 1001
+	// tokens, and the max supply caps the third block to 499 tokens
+	res, err := suite.queryClient.ProjectedEmissions(gocontext.Background(), &types.QueryProjectedEmissionsRequest{StartHeight: 1, EndHeight: 10})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500), res.Amount)
+
+	res, err = suite.queryClient.ProjectedEmissions(gocontext.Background(), &types.QueryProjectedEmissionsRequest{StartHeight: 2, EndHeight: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001), res.Amount)
+
+	// the emissions are projected with the inflation calculation function of
+	// the keeper, here a 20% inflation minting 2000 tokens then the 500 left
+	ic := func(sdk.Context, types.Minter, types.Params, sdk.Dec) sdk.Dec { return sdk.NewDecWithPrec(2, 1) }
+	res, err = suite.mintKeeper.WithInflationCalculationFn(ic).ProjectedEmissions(sdk.WrapSDKContext(suite.ctx), &types.QueryProjectedEmissionsRequest{StartHeight: 1, EndHeight: 10})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2500), res.Amount)
+
+	res, err = suite.mintKeeper.WithInflationCalculationFn(ic).ProjectedEmissions(sdk.WrapSDKContext(suite.ctx), &types.QueryProjectedEmissionsRequest{StartHeight: 1, EndHeight: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), res.Amount)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
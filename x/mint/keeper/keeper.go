@@ -23,6 +23,11 @@ type Keeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	// inflationCalculator is the inflation calculation function the emissions
+	// are projected with. If nil, the default inflation calculation logic is
+	// used.
+	inflationCalculator types.InflationCalculationFn
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -50,6 +55,23 @@ func NewKeeper(
 	}
 }
 
+// WithInflationCalculationFn returns a copy of the keeper using the given
+// inflation calculation function to project emissions.
+func (k Keeper) WithInflationCalculationFn(ic types.InflationCalculationFn) Keeper {
+	k.inflationCalculator = ic
+	return k
+}
+
+// InflationCalculationFn returns the inflation calculation function of the
+// keeper, or the default one if none is set.
+func (k Keeper) InflationCalculationFn() types.InflationCalculationFn {
+	if k.inflationCalculator == nil {
+		return types.DefaultInflationCalculationFn
+	}
+
+	return k.inflationCalculator
+}
+
 // GetAuthority returns the x/mint module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -132,3 +154,28 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// ProjectEmissions returns the projected amount of tokens minted from the
+// start height to the end height, by running the inflation calculation
+// function over the blocks from the next one up to the end height. The
+// projection assumes the current bonded ratio holds over those blocks.
+func (k Keeper) ProjectEmissions(ctx sdk.Context, ic types.InflationCalculationFn, startHeight, endHeight uint64) sdk.Coin {
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+	supply := k.StakingTokenSupply(ctx)
+	bondedRatio := k.BondedRatio(ctx)
+
+	emissions := math.ZeroInt()
+	for height := uint64(ctx.BlockHeight()) + 1; height <= endHeight; height++ {
+		minter.Inflation = ic(ctx.WithBlockHeight(int64(height)), minter, params, bondedRatio)
+		minter.AnnualProvisions = minter.NextAnnualProvisions(params, supply)
+		provision := params.CapProvision(minter.BlockProvision(params), supply)
+
+		supply = supply.Add(provision.Amount)
+		if height >= startHeight {
+			emissions = emissions.Add(provision.Amount)
+		}
+	}
+
+	return sdk.NewCoin(params.MintDenom, emissions)
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/suite"
 
@@ -94,6 +95,39 @@ func (s *IntegrationTestSuite) TestParams() {
 				InflationMin:        sdk.NewDecWithPrec(2, 2),
 				GoalBonded:          sdk.NewDecWithPrec(37, 2),
 				BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+				MaxSupply:           math.ZeroInt(),
+			},
+			expectErr: false,
+		},
+		{
+			name: "set halving curve without halving interval",
+			input: types.Params{
+				MintDenom:           sdk.DefaultBondDenom,
+				InflationRateChange: sdk.NewDecWithPrec(8, 2),
+				InflationMax:        sdk.NewDecWithPrec(20, 2),
+				InflationMin:        sdk.NewDecWithPrec(2, 2),
+				GoalBonded:          sdk.NewDecWithPrec(37, 2),
+				BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+				InflationCurve:      types.InflationCurveHalving,
+				MaxSupply:           math.ZeroInt(),
+			},
+			expectErr: true,
+		},
+		{
+			name: "set emission schedule curve",
+			input: types.Params{
+				MintDenom:           sdk.DefaultBondDenom,
+				InflationRateChange: sdk.NewDecWithPrec(8, 2),
+				InflationMax:        sdk.NewDecWithPrec(20, 2),
+				InflationMin:        sdk.NewDecWithPrec(2, 2),
+				GoalBonded:          sdk.NewDecWithPrec(37, 2),
+				BlocksPerYear:       uint64(60 * 60 * 8766 / 5),
+				InflationCurve:      types.InflationCurveEmissionSchedule,
+				EmissionSchedule: []types.EmissionPeriod{
+					{StartHeight: 0, Inflation: sdk.NewDecWithPrec(10, 2)},
+					{StartHeight: 1000, Inflation: sdk.NewDecWithPrec(5, 2)},
+				},
+				MaxSupply: math.NewInt(1_000_000_000),
 			},
 			expectErr: false,
 		},
@@ -122,7 +122,7 @@ func NewAppModule(
 
 	return AppModule{
 		AppModuleBasic:      AppModuleBasic{cdc: cdc},
-		keeper:              keeper,
+		keeper:              keeper.WithInflationCalculationFn(ic),
 		authKeeper:          ak,
 		inflationCalculator: ic,
 		legacySubspace:      ss,
@@ -259,7 +259,7 @@ func ProvideModule(in MintInputs) MintOutputs {
 		in.BankKeeper,
 		feeCollectorName,
 		authority.String(),
-	)
+	).WithInflationCalculationFn(in.InflationCalculationFn)
 
 	// when no inflation calculation function is provided it will use the default types.DefaultInflationCalculationFn
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.InflationCalculationFn, in.LegacySubspace)
@@ -13,10 +13,36 @@ import (
 type InflationCalculationFn func(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) sdk.Dec
 
 // DefaultInflationCalculationFn is the default function used to calculate inflation.
-func DefaultInflationCalculationFn(_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) math.LegacyDec {
+// It uses the inflation curve selected in the params.
+func DefaultInflationCalculationFn(ctx sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) math.LegacyDec {
+	switch params.InflationCurve {
+	case InflationCurveHalving:
+		return HalvingInflationCalculationFn(ctx, minter, params, bondedRatio)
+	case InflationCurveEmissionSchedule:
+		return EmissionScheduleInflationCalculationFn(ctx, minter, params, bondedRatio)
+	default:
+		return BondedRatioInflationCalculationFn(ctx, minter, params, bondedRatio)
+	}
+}
+
+// BondedRatioInflationCalculationFn adjusts the inflation rate towards the goal
+// bonded ratio, regardless of the inflation curve selected in the params.
+func BondedRatioInflationCalculationFn(_ sdk.Context, minter Minter, params Params, bondedRatio sdk.Dec) math.LegacyDec {
 	return minter.NextInflationRate(params, bondedRatio)
 }
 
+// HalvingInflationCalculationFn halves the inflation rate every halving
+// interval, regardless of the inflation curve selected in the params.
+func HalvingInflationCalculationFn(ctx sdk.Context, _ Minter, params Params, _ sdk.Dec) math.LegacyDec {
+	return HalvingInflationRate(params, ctx.BlockHeight())
+}
+
+// EmissionScheduleInflationCalculationFn takes the inflation rate from the
+// emission schedule, regardless of the inflation curve selected in the params.
+func EmissionScheduleInflationCalculationFn(ctx sdk.Context, _ Minter, params Params, _ sdk.Dec) math.LegacyDec {
+	return EmissionScheduleInflationRate(params, ctx.BlockHeight())
+}
+
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(minter Minter, params Params) *GenesisState {
 	return &GenesisState{
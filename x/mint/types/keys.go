@@ -17,4 +17,8 @@ const (
 	QueryParameters       = "parameters"
 	QueryInflation        = "inflation"
 	QueryAnnualProvisions = "annual_provisions"
+
+	// MaxProjectedEmissionsBlocks is the maximum number of blocks ahead of the
+	// current height that emissions can be projected for.
+	MaxProjectedEmissionsBlocks = 10_000
 )
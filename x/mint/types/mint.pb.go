@@ -26,6 +26,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationCurve defines the curve used to calculate the inflation rate.
+type InflationCurve int32
+
+const (
+	// INFLATION_CURVE_BONDED_RATIO adjusts the inflation rate towards the goal
+	// bonded ratio, between the min and max inflation.
+	InflationCurveBondedRatio InflationCurve = 0
+	// INFLATION_CURVE_HALVING starts at the max inflation and halves the
+	// inflation rate every halving interval, down to the min inflation.
+	InflationCurveHalving InflationCurve = 1
+	// INFLATION_CURVE_EMISSION_SCHEDULE takes the inflation rate from the
+	// emission schedule period the block height falls in.
+	InflationCurveEmissionSchedule InflationCurve = 2
+)
+
+var InflationCurve_name = map[int32]string{
+	0: "INFLATION_CURVE_BONDED_RATIO",
+	1: "INFLATION_CURVE_HALVING",
+	2: "INFLATION_CURVE_EMISSION_SCHEDULE",
+}
+
+var InflationCurve_value = map[string]int32{
+	"INFLATION_CURVE_BONDED_RATIO":      0,
+	"INFLATION_CURVE_HALVING":           1,
+	"INFLATION_CURVE_EMISSION_SCHEDULE": 2,
+}
+
+func (x InflationCurve) String() string {
+	return proto.EnumName(InflationCurve_name, int32(x))
+}
+
+func (InflationCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -67,6 +102,55 @@ func (m *Minter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Minter proto.InternalMessageInfo
 
+// EmissionPeriod defines the inflation rate of the blocks from a height until
+// the start of the next period of an emission schedule.
+type EmissionPeriod struct {
+	// height of the first block of the period
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// inflation rate of the period
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *EmissionPeriod) Reset()         { *m = EmissionPeriod{} }
+func (m *EmissionPeriod) String() string { return proto.CompactTextString(m) }
+func (*EmissionPeriod) ProtoMessage()    {}
+func (*EmissionPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{1}
+}
+func (m *EmissionPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionPeriod.Merge(m, src)
+}
+func (m *EmissionPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionPeriod proto.InternalMessageInfo
+
+func (m *EmissionPeriod) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
 // Params defines the parameters for the x/mint module.
 type Params struct {
 	// type of coin to mint
@@ -81,12 +165,23 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// curve used to calculate the inflation rate
+	InflationCurve InflationCurve `protobuf:"varint,7,opt,name=inflation_curve,json=inflationCurve,proto3,enum=cosmos.mint.v1beta1.InflationCurve" json:"inflation_curve,omitempty"`
+	// number of blocks between two halvings of the inflation rate, used by the
+	// halving curve
+	HalvingInterval uint64 `protobuf:"varint,8,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// periods of the emission schedule by ascending start height, used by the
+	// emission schedule curve
+	EmissionSchedule []EmissionPeriod `protobuf:"bytes,9,rep,name=emission_schedule,json=emissionSchedule,proto3" json:"emission_schedule"`
+	// maximum supply of the mint denom, above which no tokens are minted. Zero
+	// means no maximum supply.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2df116d183c1e223, []int{1}
+	return fileDescriptor_2df116d183c1e223, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,42 +224,83 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationCurve() InflationCurve {
+	if m != nil {
+		return m.InflationCurve
+	}
+	return InflationCurveBondedRatio
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func (m *Params) GetEmissionSchedule() []EmissionPeriod {
+	if m != nil {
+		return m.EmissionSchedule
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.InflationCurve", InflationCurve_name, InflationCurve_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
+	proto.RegisterType((*EmissionPeriod)(nil), "cosmos.mint.v1beta1.EmissionPeriod")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x13, 0xad, 0x81, 0x8e, 0x2e, 0xba, 0xb3, 0x0a, 0x71, 0xc1, 0x74, 0xdd, 0xc3, 0xb2,
-	0x0a, 0x9b, 0x50, 0xbc, 0x89, 0xa7, 0xb6, 0xd7, 0x42, 0xc9, 0xcd, 0x82, 0x84, 0x37, 0xc9, 0x98,
-	0x0e, 0x4d, 0x66, 0xc2, 0xcc, 0xb4, 0xb4, 0x1f, 0x41, 0x4f, 0x1e, 0x3d, 0xfa, 0x11, 0x3c, 0xf8,
-	0x21, 0x7a, 0xb3, 0x78, 0x12, 0x0f, 0x45, 0xda, 0x83, 0x5f, 0x43, 0x32, 0x13, 0x52, 0xf1, 0xb0,
-	0xa7, 0x5c, 0xf2, 0xe7, 0x79, 0xde, 0xfc, 0x9e, 0x27, 0x21, 0x2f, 0xf2, 0x12, 0x2e, 0x0b, 0x2e,
-	0x83, 0x82, 0x32, 0x15, 0x2c, 0xfb, 0x31, 0x51, 0xd0, 0xd7, 0x37, 0x7e, 0x29, 0xb8, 0xe2, 0xf8,
-	0xcc, 0xf8, 0xbe, 0x96, 0x6a, 0xff, 0xfc, 0x71, 0xc6, 0x33, 0xae, 0xfd, 0xa0, 0xba, 0x32, 0xa3,
-	0xe7, 0x4f, 0xcd, 0x68, 0x64, 0x8c, 0xfa, 0x39, 0x63, 0x9d, 0x42, 0x41, 0x19, 0x0f, 0xf4, 0xd1,
-	0x48, 0x97, 0xdf, 0x6d, 0xe4, 0x8c, 0x29, 0x53, 0x44, 0xe0, 0x29, 0xea, 0x52, 0xf6, 0x3e, 0x07,
-	0x45, 0x39, 0x73, 0xed, 0x0b, 0xfb, 0xba, 0x3b, 0x78, 0xb3, 0xd9, 0xf5, 0xac, 0x5f, 0xbb, 0xde,
-	0x55, 0x46, 0xd5, 0x6c, 0x11, 0xfb, 0x09, 0x2f, 0x6a, 0x62, 0x7d, 0xba, 0x91, 0xe9, 0x3c, 0x50,
-	0xeb, 0x92, 0x48, 0x7f, 0x44, 0x92, 0x1f, 0xdf, 0x6e, 0x50, 0x1d, 0x38, 0x22, 0x49, 0x78, 0xc4,
-	0x61, 0x8a, 0x4e, 0x81, 0xb1, 0x05, 0xe4, 0x55, 0xad, 0x25, 0x95, 0x94, 0x33, 0xe9, 0xde, 0x69,
-	0x21, 0xe3, 0x91, 0xc1, 0x4e, 0x1a, 0xea, 0xe5, 0x87, 0x0e, 0x72, 0x26, 0x20, 0xa0, 0x90, 0xf8,
-	0x19, 0x42, 0xd5, 0x07, 0x8b, 0x52, 0xc2, 0x78, 0x61, 0x5e, 0x29, 0xec, 0x56, 0xca, 0xa8, 0x12,
-	0x70, 0x89, 0x9e, 0x34, 0x0d, 0x23, 0x01, 0x8a, 0x44, 0xc9, 0x0c, 0x58, 0x46, 0x5a, 0x29, 0x76,
-	0xd6, 0xa0, 0x43, 0x50, 0x64, 0xa8, 0xc1, 0x18, 0xd0, 0xc9, 0x31, 0xb1, 0x80, 0x95, 0x7b, 0xb7,
-	0x85, 0xa4, 0x07, 0x0d, 0x72, 0x0c, 0xab, 0xff, 0x22, 0x28, 0x73, 0x3b, 0xed, 0x46, 0x50, 0x86,
-	0xdf, 0xa1, 0xfb, 0x19, 0x87, 0x3c, 0x8a, 0x39, 0x4b, 0x49, 0xea, 0xde, 0x6b, 0x21, 0x00, 0x55,
-	0xc0, 0x81, 0xe6, 0xe1, 0x2b, 0xf4, 0x30, 0xce, 0x79, 0x32, 0x97, 0x51, 0x49, 0x44, 0xb4, 0x26,
-	0x20, 0x5c, 0xe7, 0xc2, 0xbe, 0xee, 0x84, 0x27, 0x46, 0x9e, 0x10, 0xf1, 0x96, 0x80, 0x78, 0xfd,
-	0xfc, 0xf3, 0x97, 0x9e, 0xf5, 0xf1, 0xcf, 0xd7, 0x97, 0xee, 0x3f, 0x01, 0x2b, 0xb3, 0x42, 0xe6,
-	0x07, 0x18, 0x0c, 0x37, 0x7b, 0xcf, 0xde, 0xee, 0x3d, 0xfb, 0xf7, 0xde, 0xb3, 0x3f, 0x1d, 0x3c,
-	0x6b, 0x7b, 0xf0, 0xac, 0x9f, 0x07, 0xcf, 0x9a, 0xbe, 0xb8, 0xb5, 0x66, 0x4d, 0xd1, 0x6d, 0x63,
-	0x47, 0x6f, 0xca, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x18, 0xb9, 0xae, 0xa4, 0x03,
-	0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4b, 0x1b, 0x4d,
+	0x18, 0xce, 0xaa, 0x5f, 0xbe, 0x2f, 0xa3, 0xc6, 0x38, 0x7e, 0xf2, 0xad, 0xe1, 0x73, 0x8d, 0x29,
+	0x88, 0x0a, 0x26, 0xd8, 0x42, 0x0f, 0xa5, 0x50, 0xcc, 0x8f, 0x36, 0x0b, 0x31, 0x86, 0x4d, 0x15,
+	0x6a, 0x29, 0xdb, 0xc9, 0x66, 0x9a, 0x0c, 0xee, 0xce, 0x84, 0xdd, 0x49, 0x88, 0xff, 0x41, 0xc9,
+	0xa5, 0x3d, 0xf6, 0x22, 0x14, 0x7a, 0xe9, 0xd1, 0x43, 0xff, 0x81, 0xde, 0xbc, 0x55, 0x7a, 0x2a,
+	0x1e, 0xa4, 0xe8, 0xc1, 0x7f, 0xa3, 0xec, 0xcc, 0x1a, 0xdd, 0xd0, 0x16, 0x0a, 0xb9, 0x24, 0xfb,
+	0x3e, 0xef, 0xfb, 0x3e, 0xcf, 0xbe, 0xcf, 0xce, 0x3b, 0x40, 0xb3, 0x98, 0xe7, 0x30, 0x2f, 0xeb,
+	0x10, 0xca, 0xb3, 0xdd, 0xcd, 0x3a, 0xe6, 0x68, 0x53, 0x04, 0x99, 0xb6, 0xcb, 0x38, 0x83, 0x73,
+	0x32, 0x9f, 0x11, 0x50, 0x90, 0x4f, 0xfe, 0xdb, 0x64, 0x4d, 0x26, 0xf2, 0x59, 0xff, 0x49, 0x96,
+	0x26, 0x17, 0x64, 0xa9, 0x29, 0x13, 0x41, 0x9f, 0x4c, 0xcd, 0x22, 0x87, 0x50, 0x96, 0x15, 0xbf,
+	0x12, 0x4a, 0x7f, 0x51, 0x40, 0x74, 0x9b, 0x50, 0x8e, 0x5d, 0xb8, 0x0f, 0x62, 0x84, 0xbe, 0xb2,
+	0x11, 0x27, 0x8c, 0xaa, 0x4a, 0x4a, 0x59, 0x8d, 0xe5, 0x1e, 0x9e, 0x9c, 0x2f, 0x45, 0xce, 0xce,
+	0x97, 0x56, 0x9a, 0x84, 0xb7, 0x3a, 0xf5, 0x8c, 0xc5, 0x9c, 0x80, 0x31, 0xf8, 0xdb, 0xf0, 0x1a,
+	0x07, 0x59, 0x7e, 0xd8, 0xc6, 0x5e, 0xa6, 0x80, 0xad, 0xaf, 0x9f, 0x36, 0x40, 0x20, 0x58, 0xc0,
+	0x96, 0x71, 0x43, 0x07, 0x09, 0x98, 0x45, 0x94, 0x76, 0x90, 0xed, 0xbf, 0x56, 0x97, 0x78, 0x84,
+	0x51, 0x4f, 0x1d, 0x1b, 0x81, 0x46, 0x42, 0xd2, 0x56, 0x07, 0xac, 0xe9, 0x37, 0x0a, 0x88, 0x17,
+	0x1d, 0xe2, 0xf9, 0x51, 0x15, 0xbb, 0x84, 0x35, 0xe0, 0x32, 0x98, 0xf2, 0x38, 0x72, 0xb9, 0xd9,
+	0xc2, 0xa4, 0xd9, 0xe2, 0x62, 0xb8, 0x09, 0x63, 0x52, 0x60, 0x25, 0x01, 0x85, 0x87, 0x1f, 0x1b,
+	0xe9, 0xf0, 0xe9, 0xcf, 0x51, 0x10, 0xad, 0x22, 0x17, 0x39, 0x1e, 0x5c, 0x04, 0xc0, 0xff, 0x84,
+	0x66, 0x03, 0x53, 0xe6, 0x48, 0x93, 0x8d, 0x98, 0x8f, 0x14, 0x7c, 0x00, 0xb6, 0xc1, 0xfc, 0xa0,
+	0xcd, 0x74, 0x11, 0xc7, 0xa6, 0xd5, 0x42, 0xb4, 0x89, 0x47, 0xf2, 0x46, 0x73, 0x03, 0x6a, 0x03,
+	0x71, 0x9c, 0x17, 0xc4, 0x10, 0x81, 0xe9, 0x1b, 0x45, 0x07, 0xf5, 0xd4, 0xf1, 0x11, 0x28, 0x4d,
+	0x0d, 0x28, 0xb7, 0x51, 0x6f, 0x48, 0x82, 0x50, 0x75, 0x62, 0xb4, 0x12, 0x84, 0xc2, 0x17, 0x60,
+	0xb2, 0xc9, 0x90, 0x6d, 0xd6, 0x19, 0x6d, 0xe0, 0x86, 0xfa, 0xd7, 0x08, 0x04, 0x80, 0x4f, 0x98,
+	0x13, 0x7c, 0x70, 0x05, 0xcc, 0xd4, 0x6d, 0x66, 0x1d, 0x78, 0x66, 0x1b, 0xbb, 0xe6, 0x21, 0x46,
+	0xae, 0x1a, 0x15, 0x47, 0x68, 0x5a, 0xc2, 0x55, 0xec, 0x3e, 0xc3, 0xc8, 0x85, 0x65, 0x30, 0x73,
+	0x33, 0xa9, 0xd5, 0x71, 0xbb, 0x58, 0xfd, 0x3b, 0xa5, 0xac, 0xc6, 0xef, 0xde, 0xc9, 0xfc, 0x64,
+	0x7f, 0x33, 0xfa, 0x75, 0x6d, 0xde, 0x2f, 0x35, 0xe2, 0x24, 0x14, 0xc3, 0x35, 0x90, 0x68, 0x21,
+	0xbb, 0x4b, 0x68, 0xd3, 0x14, 0x0b, 0xda, 0x45, 0xb6, 0xfa, 0x8f, 0x90, 0x9d, 0x09, 0x70, 0x3d,
+	0x80, 0xe1, 0x73, 0x30, 0x8b, 0x83, 0x23, 0x6f, 0x7a, 0x56, 0x0b, 0x37, 0x3a, 0x36, 0x56, 0x63,
+	0xa9, 0xf1, 0xd5, 0xc9, 0x5f, 0x48, 0x87, 0x17, 0x24, 0x17, 0xf3, 0xad, 0xfa, 0x78, 0x75, 0xbc,
+	0xae, 0x18, 0x89, 0x6b, 0xa2, 0x5a, 0xc0, 0x03, 0x5f, 0x02, 0xe0, 0xa0, 0x9e, 0xe9, 0x75, 0xda,
+	0x6d, 0xfb, 0x50, 0x05, 0xc2, 0xdb, 0xad, 0x3f, 0xf0, 0x56, 0xa7, 0xfc, 0x96, 0xb7, 0x3a, 0xe5,
+	0x52, 0x28, 0xe6, 0xa0, 0x5e, 0x4d, 0x70, 0x3e, 0x58, 0x7e, 0xf7, 0x7e, 0x29, 0xd2, 0xbf, 0x3a,
+	0x5e, 0x57, 0x6f, 0x35, 0xf7, 0xe4, 0x65, 0x28, 0x17, 0x67, 0xfd, 0x4c, 0x01, 0xf1, 0xb0, 0x5f,
+	0xf0, 0x11, 0xf8, 0x5f, 0xaf, 0x3c, 0x2e, 0x6f, 0x3d, 0xd5, 0x77, 0x2a, 0x66, 0x7e, 0xd7, 0xd8,
+	0x2b, 0x9a, 0xb9, 0x9d, 0x4a, 0xa1, 0x58, 0x30, 0x0d, 0x1f, 0x4b, 0x44, 0x92, 0x8b, 0xfd, 0xa3,
+	0xd4, 0x42, 0xb8, 0x4b, 0x7e, 0x51, 0xc3, 0x07, 0xe0, 0x7d, 0xf0, 0xdf, 0x30, 0x41, 0x69, 0xab,
+	0xbc, 0xa7, 0x57, 0x9e, 0x24, 0x94, 0xe4, 0x42, 0xff, 0x28, 0x35, 0x1f, 0xee, 0x2d, 0x49, 0xd7,
+	0xa1, 0x0e, 0x96, 0x87, 0xfb, 0x8a, 0xdb, 0x7a, 0xad, 0xe6, 0x87, 0xb5, 0x7c, 0xa9, 0x58, 0xd8,
+	0x2d, 0x17, 0x13, 0x63, 0xc9, 0x74, 0xff, 0x28, 0xa5, 0x85, 0x19, 0x8a, 0x43, 0xde, 0x26, 0x27,
+	0x5e, 0x7f, 0xd0, 0x22, 0xb9, 0xfc, 0xc9, 0x85, 0xa6, 0x9c, 0x5e, 0x68, 0xca, 0xf7, 0x0b, 0x4d,
+	0x79, 0x7b, 0xa9, 0x45, 0x4e, 0x2f, 0xb5, 0xc8, 0xb7, 0x4b, 0x2d, 0xb2, 0xbf, 0xf6, 0x5b, 0x7f,
+	0x03, 0x8b, 0x84, 0xcd, 0xf5, 0xa8, 0xb8, 0xd0, 0xef, 0xfd, 0x18, 0x00, 0x9e, 0x61, 0x34, 0x60,
+	0x4b, 0x06, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -210,6 +346,44 @@ func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EmissionPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -230,6 +404,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.EmissionSchedule) > 0 {
+		for iNdEx := len(m.EmissionSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EmissionSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.InflationCurve != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationCurve))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -309,6 +517,20 @@ func (m *Minter) Size() (n int) {
 	return n
 }
 
+func (m *EmissionPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -330,6 +552,20 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.InflationCurve != 0 {
+		n += 1 + sovMint(uint64(m.InflationCurve))
+	}
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	if len(m.EmissionSchedule) > 0 {
+		for _, e := range m.EmissionSchedule {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -457,6 +693,109 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EmissionPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -673,6 +1012,112 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationCurve", wireType)
+			}
+			m.InflationCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationCurve |= InflationCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmissionSchedule = append(m.EmissionSchedule, EmissionPeriod{})
+			if err := m.EmissionSchedule[len(m.EmissionSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
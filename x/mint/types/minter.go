@@ -68,6 +68,35 @@ func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) math.Legac
 	return inflation
 }
 
+// HalvingInflationRate returns the inflation rate of the halving curve at a
+// block height, which starts at the max inflation and halves every halving
+// interval, down to the min inflation.
+func HalvingInflationRate(params Params, height int64) math.LegacyDec {
+	inflation := params.InflationMax
+	if params.HalvingInterval > 0 && height > 0 {
+		// the inflation reaches zero after about 60 halvings, which bounds the loop
+		for halvings := uint64(height) / params.HalvingInterval; halvings > 0 && inflation.IsPositive(); halvings-- {
+			inflation = inflation.QuoInt64(2)
+		}
+	}
+
+	return math.LegacyMaxDec(inflation, params.InflationMin)
+}
+
+// EmissionScheduleInflationRate returns the inflation rate of the emission
+// schedule period a block height falls in, or zero if the schedule is empty.
+func EmissionScheduleInflationRate(params Params, height int64) math.LegacyDec {
+	inflation := math.LegacyZeroDec()
+	for _, period := range params.EmissionSchedule {
+		if period.StartHeight > uint64(height) {
+			break
+		}
+		inflation = period.Inflation
+	}
+
+	return inflation
+}
+
 // NextAnnualProvisions returns the annual provisions based on current total
 // supply and inflation rate.
 func (m Minter) NextAnnualProvisions(_ Params, totalSupply math.Int) math.LegacyDec {
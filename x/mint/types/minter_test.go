@@ -59,6 +59,66 @@ func TestNextInflation(t *testing.T) {
 	}
 }
 
+func TestHalvingInflationRate(t *testing.T) {
+	params := DefaultParams()
+	params.InflationMax = sdk.NewDecWithPrec(16, 2)
+	params.InflationMin = sdk.NewDecWithPrec(3, 2)
+	params.HalvingInterval = 100
+
+	tests := []struct {
+		height       int64
+		expInflation sdk.Dec
+	}{
+		{0, sdk.NewDecWithPrec(16, 2)},
+		{99, sdk.NewDecWithPrec(16, 2)},
+		{100, sdk.NewDecWithPrec(8, 2)},
+		{250, sdk.NewDecWithPrec(4, 2)},
+		// the inflation never goes below the min inflation
+		{300, sdk.NewDecWithPrec(3, 2)},
+		{1_000_000, sdk.NewDecWithPrec(3, 2)},
+	}
+	for i, tc := range tests {
+		require.Equal(t, tc.expInflation, HalvingInflationRate(params, tc.height), "Test Index: %v", i)
+	}
+}
+
+func TestEmissionScheduleInflationRate(t *testing.T) {
+	params := DefaultParams()
+	params.EmissionSchedule = []EmissionPeriod{
+		{StartHeight: 0, Inflation: sdk.NewDecWithPrec(10, 2)},
+		{StartHeight: 100, Inflation: sdk.NewDecWithPrec(5, 2)},
+		{StartHeight: 200, Inflation: math.LegacyZeroDec()},
+	}
+
+	tests := []struct {
+		height       int64
+		expInflation sdk.Dec
+	}{
+		{0, sdk.NewDecWithPrec(10, 2)},
+		{99, sdk.NewDecWithPrec(10, 2)},
+		{100, sdk.NewDecWithPrec(5, 2)},
+		{200, math.LegacyZeroDec()},
+		{1_000_000, math.LegacyZeroDec()},
+	}
+	for i, tc := range tests {
+		require.Equal(t, tc.expInflation, EmissionScheduleInflationRate(params, tc.height), "Test Index: %v", i)
+	}
+}
+
+func TestCapProvision(t *testing.T) {
+	params := DefaultParams()
+	provision := sdk.NewInt64Coin(params.MintDenom, 100)
+
+	// no max supply
+	require.Equal(t, provision, params.CapProvision(provision, math.NewInt(1_000_000)))
+
+	params.MaxSupply = math.NewInt(1_000)
+	require.Equal(t, provision, params.CapProvision(provision, math.NewInt(900)))
+	require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 50), params.CapProvision(provision, math.NewInt(950)))
+	require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 0), params.CapProvision(provision, math.NewInt(1_000)))
+	require.Equal(t, sdk.NewInt64Coin(params.MintDenom, 0), params.CapProvision(provision, math.NewInt(2_000)))
+}
+
 func TestBlockProvision(t *testing.T) {
 	minter := InitialMinter(sdk.NewDecWithPrec(1, 1))
 	params := DefaultParams()
@@ -11,7 +11,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// NewParams returns Params instance with the given values.
+// DefaultHalvingInterval is the default number of blocks between two halvings
+// of the inflation rate with the halving curve, about four years of 5 second
+// blocks.
+const DefaultHalvingInterval = uint64(4 * 60 * 60 * 8766 / 5)
+
+// NewParams returns Params instance with the given values. The inflation curve
+// is the bonded ratio curve, and there is no max supply.
 func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec, blocksPerYear uint64) Params {
 	return Params{
 		MintDenom:           mintDenom,
@@ -20,6 +26,9 @@ func NewParams(mintDenom string, inflationRateChange, inflationMax, inflationMin
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		InflationCurve:      InflationCurveBondedRatio,
+		HalvingInterval:     DefaultHalvingInterval,
+		MaxSupply:           math.ZeroInt(),
 	}
 }
 
@@ -32,6 +41,9 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		InflationCurve:      InflationCurveBondedRatio,
+		HalvingInterval:     DefaultHalvingInterval,
+		MaxSupply:           math.ZeroInt(),
 	}
 }
 
@@ -61,10 +73,42 @@ func (p Params) Validate() error {
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if err := validateInflationCurve(p.InflationCurve); err != nil {
+		return err
+	}
+	if err := validateEmissionSchedule(p.EmissionSchedule); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+
+	switch p.InflationCurve {
+	case InflationCurveHalving:
+		if p.HalvingInterval == 0 {
+			return errors.New("halving interval must be positive with the halving curve")
+		}
+	case InflationCurveEmissionSchedule:
+		if len(p.EmissionSchedule) == 0 {
+			return errors.New("emission schedule cannot be empty with the emission schedule curve")
+		}
+	}
 
 	return nil
 }
 
+// CapProvision caps a provision so that minting it doesn't take the given
+// supply above the max supply, if any. A nil or zero max supply means no max
+// supply.
+func (p Params) CapProvision(provision sdk.Coin, supply math.Int) sdk.Coin {
+	if p.MaxSupply.IsNil() || !p.MaxSupply.IsPositive() {
+		return provision
+	}
+
+	mintable := math.MaxInt(p.MaxSupply.Sub(supply), math.ZeroInt())
+	return sdk.NewCoin(provision.Denom, math.MinInt(provision.Amount, mintable))
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -175,3 +219,56 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateInflationCurve(i interface{}) error {
+	v, ok := i.(InflationCurve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationCurve_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid inflation curve: %d", v)
+	}
+
+	return nil
+}
+
+func validateEmissionSchedule(i interface{}) error {
+	v, ok := i.([]EmissionPeriod)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, period := range v {
+		if i == 0 && period.StartHeight != 0 {
+			return fmt.Errorf("first emission period must start at height 0: %d", period.StartHeight)
+		}
+		if i > 0 && period.StartHeight <= v[i-1].StartHeight {
+			return fmt.Errorf("emission periods must be sorted by strictly ascending start height: %d", period.StartHeight)
+		}
+		if period.Inflation.IsNil() {
+			return fmt.Errorf("emission period inflation cannot be nil: %s", period.Inflation)
+		}
+		if period.Inflation.IsNegative() {
+			return fmt.Errorf("emission period inflation cannot be negative: %s", period.Inflation)
+		}
+		if period.Inflation.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("emission period inflation too large: %s", period.Inflation)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -269,6 +270,110 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryProjectedEmissionsRequest is the request type for the
+// Query/ProjectedEmissions RPC method.
+type QueryProjectedEmissionsRequest struct {
+	// start_height is the first block height of the range, which must be after
+	// the current block height.
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last block height of the range.
+	EndHeight uint64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *QueryProjectedEmissionsRequest) Reset()         { *m = QueryProjectedEmissionsRequest{} }
+func (m *QueryProjectedEmissionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedEmissionsRequest) ProtoMessage()    {}
+func (*QueryProjectedEmissionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryProjectedEmissionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedEmissionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedEmissionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedEmissionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedEmissionsRequest.Merge(m, src)
+}
+func (m *QueryProjectedEmissionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedEmissionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedEmissionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedEmissionsRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedEmissionsRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *QueryProjectedEmissionsRequest) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// QueryProjectedEmissionsResponse is the response type for the
+// Query/ProjectedEmissions RPC method.
+type QueryProjectedEmissionsResponse struct {
+	// amount is the projected amount of tokens minted over the range.
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *QueryProjectedEmissionsResponse) Reset()         { *m = QueryProjectedEmissionsResponse{} }
+func (m *QueryProjectedEmissionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedEmissionsResponse) ProtoMessage()    {}
+func (*QueryProjectedEmissionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryProjectedEmissionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedEmissionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedEmissionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedEmissionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedEmissionsResponse.Merge(m, src)
+}
+func (m *QueryProjectedEmissionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedEmissionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedEmissionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedEmissionsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedEmissionsResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -276,42 +381,51 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryProjectedEmissionsRequest)(nil), "cosmos.mint.v1beta1.QueryProjectedEmissionsRequest")
+	proto.RegisterType((*QueryProjectedEmissionsResponse)(nil), "cosmos.mint.v1beta1.QueryProjectedEmissionsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x85, 0xc8, 0x0b, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x90, 0x92, 0x08,
-	0x97, 0x50, 0x20, 0xc8, 0x29, 0x01, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x41, 0xa9, 0x85, 0xa5, 0xa9,
-	0xc5, 0x25, 0x4a, 0xa1, 0x5c, 0xc2, 0x28, 0xa2, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x76,
-	0x5c, 0x6c, 0x05, 0x60, 0x11, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x2e,
-	0xd7, 0x83, 0x68, 0x72, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41,
-	0x50, 0x5d, 0x4a, 0xe2, 0x5c, 0xa2, 0x60, 0x63, 0x3d, 0xf3, 0xd2, 0x72, 0xc0, 0x0e, 0x87, 0xd9,
-	0x97, 0xcd, 0x25, 0x86, 0x2e, 0x01, 0xb5, 0x32, 0x90, 0x8b, 0x33, 0x13, 0x26, 0x08, 0xb6, 0x95,
-	0xc7, 0xc9, 0x18, 0x64, 0xf0, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x8f, 0x43, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82,
-	0xd4, 0x62, 0x3d, 0x97, 0xd4, 0x64, 0x88, 0x13, 0x10, 0xa6, 0x28, 0xc9, 0x71, 0xc9, 0x80, 0x2d,
-	0x73, 0xcc, 0xcb, 0x2b, 0x4d, 0xcc, 0x09, 0x28, 0xca, 0x2f, 0xcb, 0x2c, 0x06, 0x05, 0x22, 0xcc,
-	0x31, 0x8d, 0x8c, 0x5c, 0xb2, 0x38, 0x14, 0x40, 0x1d, 0x95, 0xc0, 0x25, 0x98, 0x08, 0x96, 0x8b,
-	0x2f, 0x80, 0x4b, 0x52, 0xe2, 0x38, 0x81, 0x44, 0x34, 0x9b, 0x8c, 0x8e, 0x32, 0x73, 0xb1, 0x82,
-	0xdd, 0x20, 0xd4, 0xc0, 0xc8, 0xc5, 0x06, 0x09, 0x51, 0x21, 0x75, 0xac, 0xc1, 0x8d, 0x19, 0x7d,
-	0x52, 0x1a, 0x84, 0x15, 0x42, 0x7c, 0xa2, 0xa4, 0xdc, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x59, 0x21,
-	0x69, 0x7d, 0x6c, 0x49, 0x07, 0x12, 0x6d, 0x42, 0x3d, 0x8c, 0x5c, 0x9c, 0xf0, 0x98, 0x11, 0xd2,
-	0xc2, 0x6d, 0x38, 0x7a, 0xbc, 0x4a, 0x69, 0x13, 0xa5, 0x16, 0xea, 0x16, 0x35, 0xb0, 0x5b, 0x14,
-	0x84, 0xe4, 0xb0, 0xba, 0x05, 0x1e, 0x7f, 0x42, 0x2b, 0x19, 0xb9, 0x04, 0xd0, 0xa3, 0x46, 0xc8,
-	0x10, 0xb7, 0x4d, 0x38, 0xe2, 0x59, 0xca, 0x88, 0x14, 0x2d, 0x50, 0x37, 0xea, 0x81, 0xdd, 0xa8,
-	0x21, 0xa4, 0x86, 0xd5, 0x8d, 0x18, 0x89, 0xc2, 0xc9, 0xf9, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0x34, 0xf1, 0x26, 0x90, 0x0a, 0x88, 0xc1, 0xe0, 0x74, 0x92, 0xc4, 0x06,
-	0xce, 0xaa, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0xdf, 0xd1, 0xc0, 0x49, 0x04, 0x00,
-	0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0xa7, 0x51, 0xa9, 0xde, 0x0e, 0x9b, 0x37, 0x7e, 0x65, 0x5b, 0x3a, 0x82, 0x54, 0x4a,
+	0x11, 0x09, 0x6d, 0x39, 0x22, 0x24, 0x3a, 0x90, 0xe0, 0xb6, 0x55, 0xe2, 0xc2, 0xa5, 0xb8, 0xad,
+	0x49, 0xcd, 0x1a, 0x3b, 0x8b, 0xdd, 0x89, 0xdd, 0x80, 0x33, 0x07, 0x24, 0xfe, 0x09, 0xb8, 0xc1,
+	0x7f, 0xb1, 0xe3, 0x24, 0x2e, 0x88, 0xc3, 0x84, 0x5a, 0x24, 0xfe, 0x06, 0x6e, 0x28, 0xb6, 0x53,
+	0x58, 0x9a, 0x0c, 0x26, 0x2e, 0x6d, 0xf4, 0x7d, 0xef, 0x7d, 0xef, 0x39, 0xdf, 0x73, 0x60, 0xb9,
+	0xc7, 0x45, 0xc0, 0x85, 0x17, 0x50, 0x26, 0xbd, 0xfd, 0x7a, 0x97, 0x48, 0x5c, 0xf7, 0xf6, 0x46,
+	0x24, 0x3a, 0x70, 0xc3, 0x88, 0x4b, 0x8e, 0x56, 0x34, 0xc0, 0x8d, 0x01, 0xae, 0x01, 0x58, 0xab,
+	0x3e, 0xf7, 0xb9, 0xea, 0x7b, 0xf1, 0x93, 0x86, 0x5a, 0xeb, 0x3e, 0xe7, 0xfe, 0x90, 0x78, 0x38,
+	0xa4, 0x1e, 0x66, 0x8c, 0x4b, 0x2c, 0x29, 0x67, 0xc2, 0x74, 0xed, 0x2c, 0x25, 0x35, 0x55, 0xf7,
+	0x97, 0x71, 0x40, 0x19, 0xf7, 0xd4, 0x6f, 0x8a, 0xd2, 0xc5, 0x82, 0x4c, 0x29, 0x3d, 0x4e, 0x99,
+	0xee, 0x3b, 0xab, 0x10, 0xed, 0xc4, 0x56, 0xb7, 0x71, 0x84, 0x03, 0xd1, 0x26, 0x7b, 0x23, 0x22,
+	0xa4, 0xf3, 0x18, 0xae, 0x9c, 0xa8, 0x8a, 0x90, 0x33, 0x41, 0xd0, 0x5d, 0x58, 0x0c, 0x55, 0xe5,
+	0x12, 0xd8, 0x04, 0xd5, 0x85, 0xc6, 0x9a, 0x9b, 0x71, 0x32, 0x57, 0x93, 0x5a, 0xa5, 0xc3, 0xe3,
+	0x72, 0xe1, 0xfd, 0x8f, 0x8f, 0x35, 0xd0, 0x36, 0x2c, 0xe7, 0x22, 0x3c, 0xaf, 0xc6, 0x3e, 0x62,
+	0xcf, 0x86, 0xea, 0x60, 0x89, 0xde, 0x2e, 0xbc, 0x90, 0x6e, 0x18, 0xc9, 0x1d, 0x58, 0xa2, 0x49,
+	0x51, 0xa9, 0x2e, 0xb6, 0x9a, 0xf1, 0xe0, 0xaf, 0xc7, 0xe5, 0x8a, 0x4f, 0xe5, 0x60, 0xd4, 0x75,
+	0x7b, 0x3c, 0xf0, 0xcc, 0x29, 0xf5, 0xdf, 0x4d, 0xd1, 0xdf, 0xf5, 0xe4, 0x41, 0x48, 0x84, 0x7b,
+	0x9f, 0xf4, 0xb4, 0x85, 0xdf, 0x53, 0x1c, 0x1b, 0xae, 0x2b, 0xb1, 0x7b, 0x8c, 0x8d, 0xf0, 0x70,
+	0x3b, 0xe2, 0xfb, 0x54, 0xc4, 0x2f, 0x39, 0x31, 0xf3, 0x0a, 0xc0, 0x8d, 0x1c, 0x80, 0x31, 0xf5,
+	0x14, 0x2e, 0x63, 0xd5, 0xeb, 0x84, 0xd3, 0xe6, 0xff, 0x98, 0x5b, 0xc2, 0x29, 0x25, 0xa7, 0x0b,
+	0x6d, 0xbd, 0x80, 0x88, 0x3f, 0x27, 0x3d, 0x49, 0xfa, 0x0f, 0x02, 0x2a, 0xfe, 0x74, 0x89, 0xae,
+	0xc0, 0x45, 0x21, 0x71, 0x24, 0x3b, 0x03, 0x42, 0xfd, 0x81, 0x54, 0xf2, 0xf3, 0xed, 0x05, 0x55,
+	0x7b, 0xa8, 0x4a, 0x68, 0x03, 0x42, 0xc2, 0xfa, 0x09, 0x60, 0x4e, 0x01, 0x4a, 0x84, 0xf5, 0x75,
+	0xdb, 0xe9, 0xc0, 0x72, 0xae, 0x86, 0x39, 0xe8, 0x1d, 0x58, 0xc4, 0x01, 0x1f, 0x31, 0x69, 0x16,
+	0x7e, 0x39, 0x59, 0x78, 0x1c, 0xa7, 0xe9, 0xc2, 0xb7, 0x38, 0x65, 0x27, 0xd6, 0xad, 0x39, 0x8d,
+	0x9f, 0xf3, 0xf0, 0x9c, 0x52, 0x40, 0x2f, 0x01, 0x2c, 0xea, 0x58, 0xa0, 0x6b, 0x99, 0x99, 0x99,
+	0xcd, 0xa0, 0x55, 0xfd, 0x3b, 0x50, 0xbb, 0x74, 0xae, 0xbe, 0xfe, 0xfc, 0xfd, 0xdd, 0xdc, 0x06,
+	0x5a, 0xf3, 0xb2, 0xee, 0x87, 0xce, 0x1e, 0x7a, 0x03, 0x60, 0x69, 0x1a, 0x2f, 0x54, 0xcb, 0x1f,
+	0x9e, 0x0e, 0xa7, 0x75, 0xe3, 0x9f, 0xb0, 0xc6, 0x4b, 0x45, 0x79, 0xd9, 0x44, 0x76, 0xa6, 0x97,
+	0x69, 0x08, 0xd1, 0x07, 0x00, 0x97, 0xd2, 0xf9, 0x42, 0xf5, 0x7c, 0xa5, 0x9c, 0xb0, 0x5a, 0x8d,
+	0xb3, 0x50, 0x8c, 0x47, 0x57, 0x79, 0xac, 0xa2, 0x4a, 0xa6, 0xc7, 0x99, 0x64, 0xa3, 0x4f, 0x00,
+	0xa2, 0xd9, 0x90, 0xa0, 0xe6, 0x29, 0x0b, 0xca, 0x8b, 0xad, 0x75, 0xfb, 0x6c, 0x24, 0xe3, 0xf8,
+	0x96, 0x72, 0x5c, 0x43, 0xd5, 0xec, 0x0d, 0x27, 0xc4, 0x0e, 0x49, 0x98, 0xad, 0xad, 0xc3, 0xb1,
+	0x0d, 0x8e, 0xc6, 0x36, 0xf8, 0x36, 0xb6, 0xc1, 0xdb, 0x89, 0x5d, 0x38, 0x9a, 0xd8, 0x85, 0x2f,
+	0x13, 0xbb, 0xf0, 0xe4, 0xfa, 0xa9, 0x37, 0xf3, 0x85, 0x1e, 0xad, 0x2e, 0x68, 0xb7, 0xa8, 0xbe,
+	0x91, 0xcd, 0x5f, 0x03, 0x00, 0xfb, 0xee, 0x64, 0x98, 0xe2, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -332,6 +446,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedEmissions returns the projected amount of tokens minted over a
+	// range of future block heights.
+	ProjectedEmissions(ctx context.Context, in *QueryProjectedEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectedEmissionsResponse, error)
 }
 
 type queryClient struct {
@@ -369,6 +486,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) ProjectedEmissions(ctx context.Context, in *QueryProjectedEmissionsRequest, opts ...grpc.CallOption) (*QueryProjectedEmissionsResponse, error) {
+	out := new(QueryProjectedEmissionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/ProjectedEmissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -377,6 +503,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedEmissions returns the projected amount of tokens minted over a
+	// range of future block heights.
+	ProjectedEmissions(context.Context, *QueryProjectedEmissionsRequest) (*QueryProjectedEmissionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -392,6 +521,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedEmissions(ctx context.Context, req *QueryProjectedEmissionsRequest) (*QueryProjectedEmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedEmissions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -451,6 +583,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedEmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedEmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedEmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/ProjectedEmissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedEmissions(ctx, req.(*QueryProjectedEmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -467,6 +617,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "ProjectedEmissions",
+			Handler:    _Query_ProjectedEmissions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -640,6 +794,72 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectedEmissionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedEmissionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedEmissionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedEmissionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedEmissionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedEmissionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -711,6 +931,32 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectedEmissionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryProjectedEmissionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1116,6 +1362,177 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectedEmissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedEmissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedEmissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedEmissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,45 @@
+package nft
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs a basic validation of the royalty info.
+func (r RoyaltyInfo) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Receiver); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid royalty receiver address (%s)", r.Receiver)
+	}
+
+	if r.FeeRate.IsNil() || r.FeeRate.IsNegative() || r.FeeRate.GT(sdk.OneDec()) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "royalty fee rate must be between 0 and 1, got %s", r.FeeRate)
+	}
+	return nil
+}
+
+// RoyaltyAmount returns the royalty to pay to the receiver for the given sale
+// price, rounded down.
+func (r RoyaltyInfo) RoyaltyAmount(salePrice sdk.Coin) sdk.Coin {
+	amount := r.FeeRate.MulInt(salePrice.Amount).TruncateInt()
+	return sdk.NewCoin(salePrice.Denom, amount)
+}
+
+// ValidateClass performs a basic validation of the class.
+func ValidateClass(class Class) error {
+	if len(class.Id) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(class.Owner) > 0 {
+		if _, err := sdk.AccAddressFromBech32(class.Owner); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid class owner address (%s)", class.Owner)
+		}
+	}
+
+	if class.RoyaltyInfo != nil {
+		return class.RoyaltyInfo.Validate()
+	}
+	return nil
+}
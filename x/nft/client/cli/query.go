@@ -16,8 +16,9 @@ import (
 
 // Flag names and values
 const (
-	FlagOwner   = "owner"
-	FlagClassID = "class-id"
+	FlagOwner     = "owner"
+	FlagClassID   = "class-id"
+	FlagSalePrice = "sale-price"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -39,6 +40,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryRoyaltyInfo(),
 	)
 	return nftQueryCmd
 }
@@ -268,3 +270,45 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRoyaltyInfo implements the query royalty-info command.
+func GetCmdQueryRoyaltyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty-info [class-id]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the royalty info of a class, and the royalty amount to pay for a sale price.",
+		Example: fmt.Sprintf(`$ %s query %s royalty-info <class-id> --sale-price=1000stake`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salePriceStr, err := cmd.Flags().GetString(FlagSalePrice)
+			if err != nil {
+				return err
+			}
+
+			request := &nft.QueryRoyaltyInfoRequest{
+				ClassId: args[0],
+			}
+			if len(salePriceStr) > 0 {
+				salePrice, err := sdk.ParseCoinNormalized(salePriceStr)
+				if err != nil {
+					return err
+				}
+				request.SalePrice = &salePrice
+			}
+
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.RoyaltyInfo(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagSalePrice, "", "The sale price to compute the royalty amount for")
+	return cmd
+}
@@ -305,3 +305,43 @@ func (s *CLITestSuite) TestQuerySupply() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestQueryRoyaltyInfo() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			name:         "without sale price",
+			args:         []string{testClassID, fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			expCmdOutput: `[kitty --output=json]`,
+		},
+		{
+			name:         "with sale price",
+			args:         []string{testClassID, fmt.Sprintf("--%s=1000stake", cli.FlagSalePrice), fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			expCmdOutput: `[kitty --sale-price=1000stake --output=json]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryRoyaltyInfo()
+
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetOut(io.Discard)
+			s.Require().NotNil(cmd)
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			s.Require().Contains(fmt.Sprint(cmd), "royalty-info [class-id] [] [] query the royalty info of a class")
+			s.Require().Contains(fmt.Sprint(cmd), tc.expCmdOutput)
+
+			_, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+		})
+	}
+}
@@ -9,10 +9,22 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names and values
+const (
+	FlagName            = "name"
+	FlagSymbol          = "symbol"
+	FlagDescription     = "description"
+	FlagURI             = "uri"
+	FlagURIHash         = "uri-hash"
+	FlagRoyaltyReceiver = "royalty-receiver"
+	FlagRoyaltyFeeRate  = "royalty-fee-rate"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +38,12 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdUpdateClass(),
+		NewCmdFreezeClass(),
+		NewCmdMint(),
+		NewCmdUpdateNFT(),
+		NewCmdBurn(),
 	)
 
 	return nftTxCmd
@@ -59,3 +77,267 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewCmdCreateClass creates a CLI command for MsgCreateClass.
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class owned by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <class-id> --name <name> --symbol <symbol> --royalty-receiver <receiver> --royalty-fee-rate 0.05 --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			class, err := parseClassFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgCreateClass{
+				Owner:       clientCtx.GetFromAddress().String(),
+				Id:          args[0],
+				Name:        class.Name,
+				Symbol:      class.Symbol,
+				Description: class.Description,
+				Uri:         class.Uri,
+				UriHash:     class.UriHash,
+				RoyaltyInfo: class.RoyaltyInfo,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addClassFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateClass creates a CLI command for MsgUpdateClass.
+func NewCmdUpdateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class [class-id] --from [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "update the metadata and royalty of a nft class, unset flags clear the corresponding fields",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update-class <class-id> --name <name> --symbol <symbol> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			class, err := parseClassFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateClass{
+				Owner:       clientCtx.GetFromAddress().String(),
+				Id:          args[0],
+				Name:        class.Name,
+				Symbol:      class.Symbol,
+				Description: class.Description,
+				Uri:         class.Uri,
+				UriHash:     class.UriHash,
+				RoyaltyInfo: class.RoyaltyInfo,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	addClassFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdFreezeClass creates a CLI command for MsgFreezeClass.
+func NewCmdFreezeClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-class [class-id] --from [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "freeze a nft class, no nft can be minted and no metadata can be updated anymore",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s freeze-class <class-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgFreezeClass{
+				Owner: clientCtx.GetFromAddress().String(),
+				Id:    args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdMint creates a CLI command for MsgMint.
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [class-owner]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a new nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --from <class-owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, uriHash, err := parseURIFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgMint{
+				Minter:   clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				Id:       args[1],
+				Uri:      uri,
+				UriHash:  uriHash,
+				Receiver: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by the uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdUpdateNFT creates a CLI command for MsgUpdateNFT.
+func NewCmdUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-nft [class-id] [nft-id] --from [class-owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update-nft <class-id> <nft-id> --uri <uri> --from <class-owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, uriHash, err := parseURIFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgUpdateNFT{
+				ClassOwner: clientCtx.GetFromAddress().String(),
+				ClassId:    args[0],
+				Id:         args[1],
+				Uri:        uri,
+				UriHash:    uriHash,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by the uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCmdBurn creates a CLI command for MsgBurn.
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurn{
+				Owner:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addClassFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagName, "", "The human-readable name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The abbreviated name of the class")
+	cmd.Flags().String(FlagDescription, "", "The description of the class")
+	cmd.Flags().String(FlagURI, "", "The uri of the class metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by the uri")
+	cmd.Flags().String(FlagRoyaltyReceiver, "", "The address receiving the royalty on each sale of a nft of the class")
+	cmd.Flags().String(FlagRoyaltyFeeRate, "", "The share of the sale price paid to the royalty receiver, between 0 and 1")
+}
+
+// parseClassFlags returns a class holding the metadata and royalty info set
+// by the flags of the command.
+func parseClassFlags(cmd *cobra.Command) (class nft.Class, err error) {
+	if class.Name, err = cmd.Flags().GetString(FlagName); err != nil {
+		return nft.Class{}, err
+	}
+	if class.Symbol, err = cmd.Flags().GetString(FlagSymbol); err != nil {
+		return nft.Class{}, err
+	}
+	if class.Description, err = cmd.Flags().GetString(FlagDescription); err != nil {
+		return nft.Class{}, err
+	}
+	if class.Uri, class.UriHash, err = parseURIFlags(cmd); err != nil {
+		return nft.Class{}, err
+	}
+
+	receiver, err := cmd.Flags().GetString(FlagRoyaltyReceiver)
+	if err != nil {
+		return nft.Class{}, err
+	}
+
+	feeRate, err := cmd.Flags().GetString(FlagRoyaltyFeeRate)
+	if err != nil {
+		return nft.Class{}, err
+	}
+
+	if receiver != "" || feeRate != "" {
+		rate, err := sdk.NewDecFromStr(feeRate)
+		if err != nil {
+			return nft.Class{}, fmt.Errorf("invalid royalty fee rate: %w", err)
+		}
+		class.RoyaltyInfo = &nft.RoyaltyInfo{Receiver: receiver, FeeRate: rate}
+	}
+	return class, nil
+}
+
+func parseURIFlags(cmd *cobra.Command) (uri, uriHash string, err error) {
+	if uri, err = cmd.Flags().GetString(FlagURI); err != nil {
+		return "", "", err
+	}
+	if uriHash, err = cmd.Flags().GetString(FlagURIHash); err != nil {
+		return "", "", err
+	}
+	return uri, uriHash, nil
+}
@@ -210,6 +210,181 @@ func (s *CLITestSuite) TestCLITxSend() {
 	}
 }
 
+func (s *CLITestSuite) TestCLITxCreateClass() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, OwnerName),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectedCode uint32
+		expectErr    bool
+		expErrMsg    string
+	}{
+		{
+			"class id is empty",
+			[]string{
+				"",
+			},
+			0,
+			true,
+			"empty class id",
+		},
+		{
+			"invalid royalty fee rate",
+			[]string{
+				testClassID,
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyReceiver, accounts[0].Address.String()),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyFeeRate, "1.5"),
+			},
+			0,
+			true,
+			"royalty fee rate must be between 0 and 1",
+		},
+		{
+			"invalid royalty receiver address",
+			[]string{
+				testClassID,
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyReceiver, "invalid receiver"),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyFeeRate, "0.05"),
+			},
+			0,
+			true,
+			"Invalid royalty receiver address",
+		},
+		{
+			"valid transaction",
+			[]string{
+				testClassID,
+				fmt.Sprintf("--%s=%s", cli.FlagName, testClassName),
+				fmt.Sprintf("--%s=%s", cli.FlagSymbol, testClassSymbol),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyReceiver, accounts[0].Address.String()),
+				fmt.Sprintf("--%s=%s", cli.FlagRoyaltyFeeRate, "0.05"),
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			args := append(tc.args, extraArgs...)
+			cmd := cli.NewCmdCreateClass()
+			cmd.SetContext(s.ctx)
+			cmd.SetArgs(args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.clientCtx, cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				var txResp sdk.TxResponse
+				s.Require().NoError(err)
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestCLITxMint() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, OwnerName),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectedCode uint32
+		expectErr    bool
+		expErrMsg    string
+	}{
+		{
+			"class id is empty",
+			[]string{
+				"",
+				testID,
+				accounts[0].Address.String(),
+			},
+			0,
+			true,
+			"empty class id",
+		},
+		{
+			"nft id is empty",
+			[]string{
+				testClassID,
+				"",
+				accounts[0].Address.String(),
+			},
+			0,
+			true,
+			"empty nft id",
+		},
+		{
+			"invalid receiver address",
+			[]string{
+				testClassID,
+				testID,
+				"invalid receiver",
+			},
+			0,
+			true,
+			"Invalid receiver address",
+		},
+		{
+			"valid transaction",
+			[]string{
+				testClassID,
+				testID,
+				accounts[0].Address.String(),
+				fmt.Sprintf("--%s=%s", cli.FlagURI, testURI),
+			},
+			0,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			args := append(tc.args, extraArgs...)
+			cmd := cli.NewCmdMint()
+			cmd.SetContext(s.ctx)
+			cmd.SetArgs(args)
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.clientCtx, cmd))
+
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				var txResp sdk.TxResponse
+				s.Require().NoError(err)
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) initAccount() {
 	ctx := s.clientCtx
 	err := ctx.Keyring.ImportPrivKey(OwnerName, OwnerArmor, "1234567890")
@@ -10,6 +10,12 @@ import (
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgCreateClass{},
+		&MsgUpdateClass{},
+		&MsgFreezeClass{},
+		&MsgMint{},
+		&MsgUpdateNFT{},
+		&MsgBurn{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -12,4 +12,5 @@ var (
 	ErrNFTNotExists   = errors.Register(ModuleName, 6, "nft does not exist")
 	ErrEmptyClassID   = errors.Register(ModuleName, 7, "empty class id")
 	ErrEmptyNFTID     = errors.Register(ModuleName, 8, "empty nft id")
+	ErrClassFrozen    = errors.Register(ModuleName, 9, "nft class is frozen")
 )
@@ -7,8 +7,8 @@ import (
 // ValidateGenesis checks that the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
 	for _, class := range data.Classes {
-		if len(class.Id) == 0 {
-			return ErrEmptyClassID
+		if err := ValidateClass(*class); err != nil {
+			return err
 		}
 	}
 	for _, entry := range data.Entries {
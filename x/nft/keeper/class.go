@@ -65,3 +65,21 @@ func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(classStoreKey(classID))
 }
+
+// getOwnedClass returns the class of the specified id if it is owned by the
+// specified owner and not frozen.
+func (k Keeper) getOwnedClass(ctx sdk.Context, classID string, owner string) (nft.Class, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nft.Class{}, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if len(class.Owner) == 0 || class.Owner != owner {
+		return nft.Class{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of class %s", owner, classID)
+	}
+
+	if class.Frozen {
+		return nft.Class{}, sdkerrors.Wrap(nft.ErrClassFrozen, classID)
+	}
+	return class, nil
+}
@@ -193,3 +193,33 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// RoyaltyInfo return the royalty info of an NFT class, and the royalty amount to pay for the sale price, same as royaltyInfo in ERC2981
+func (k Keeper) RoyaltyInfo(goCtx context.Context, r *nft.QueryRoyaltyInfoRequest) (*nft.QueryRoyaltyInfoResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if r.SalePrice != nil {
+		if err := r.SalePrice.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	res := &nft.QueryRoyaltyInfoResponse{RoyaltyInfo: class.RoyaltyInfo}
+	if class.RoyaltyInfo != nil && r.SalePrice != nil {
+		amount := class.RoyaltyInfo.RoyaltyAmount(*r.SalePrice)
+		res.RoyaltyAmount = &amount
+	}
+	return res, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -590,3 +591,94 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRoyaltyInfo() {
+	var req *nft.QueryRoyaltyInfoRequest
+	royaltyInfo := &nft.RoyaltyInfo{Receiver: s.addrs[1].String(), FeeRate: sdk.NewDecWithPrec(25, 3)}
+	testCases := []struct {
+		msg      string
+		malleate func(index int, require *require.Assertions)
+		expError string
+		postTest func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse)
+	}{
+		{
+			"fail empty ClassId",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyInfoRequest{}
+			},
+			nft.ErrEmptyClassID.Error(),
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {},
+		},
+		{
+			"fail ClassId not exist",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyInfoRequest{
+					ClassId: testClassID,
+				}
+			},
+			"not found class",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {},
+		},
+		{
+			"success without royalty info",
+			func(index int, require *require.Assertions) {
+				require.NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "noroyalty"}))
+				salePrice := sdk.NewInt64Coin("stake", 1000)
+				req = &nft.QueryRoyaltyInfoRequest{
+					ClassId:   "noroyalty",
+					SalePrice: &salePrice,
+				}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {
+				require.Nil(res.RoyaltyInfo, "the error occurred on:%d", index)
+				require.Nil(res.RoyaltyAmount, "the error occurred on:%d", index)
+			},
+		},
+		{
+			"success without sale price",
+			func(index int, require *require.Assertions) {
+				class := ExpClass
+				class.RoyaltyInfo = royaltyInfo
+				require.NoError(s.nftKeeper.SaveClass(s.ctx, class))
+				req = &nft.QueryRoyaltyInfoRequest{
+					ClassId: testClassID,
+				}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {
+				require.Equal(royaltyInfo, res.RoyaltyInfo, "the error occurred on:%d", index)
+				require.Nil(res.RoyaltyAmount, "the error occurred on:%d", index)
+			},
+		},
+		{
+			"success with sale price",
+			func(index int, require *require.Assertions) {
+				salePrice := sdk.NewInt64Coin("stake", 1001)
+				req = &nft.QueryRoyaltyInfoRequest{
+					ClassId:   testClassID,
+					SalePrice: &salePrice,
+				}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyInfoResponse) {
+				require.Equal(royaltyInfo, res.RoyaltyInfo, "the error occurred on:%d", index)
+				require.Equal(sdk.NewInt64Coin("stake", 25), *res.RoyaltyAmount, "the error occurred on:%d", index)
+			},
+		},
+	}
+	for index, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			require := s.Require()
+			tc.malleate(index, require)
+			result, err := s.queryClient.RoyaltyInfo(gocontext.Background(), req)
+			if tc.expError == "" {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expError)
+			}
+			tc.postTest(index, require, result)
+		})
+	}
+}
@@ -38,6 +38,7 @@ type TestSuite struct {
 	addrs       []sdk.AccAddress
 	queryClient nft.QueryClient
 	nftKeeper   keeper.Keeper
+	msgServer   nft.MsgServer
 
 	encCfg moduletestutil.TestEncodingConfig
 }
@@ -62,6 +63,7 @@ func (s *TestSuite) SetupTest() {
 	nft.RegisterQueryServer(queryHelper, nftKeeper)
 
 	s.nftKeeper = nftKeeper
+	s.msgServer = keeper.NewMsgServerImpl(nftKeeper)
 	s.queryClient = nft.NewQueryClient(queryHelper)
 	s.ctx = ctx
 }
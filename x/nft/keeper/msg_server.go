@@ -8,7 +8,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) nft.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+var _ nft.MsgServer = msgServer{}
 
 // Send implements Send method of the types.MsgServer.
 func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
@@ -40,3 +52,138 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implements CreateClass method of the types.MsgServer.
+func (k msgServer) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class := nft.Class{
+		Id:          msg.Id,
+		Name:        msg.Name,
+		Symbol:      msg.Symbol,
+		Description: msg.Description,
+		Uri:         msg.Uri,
+		UriHash:     msg.UriHash,
+		Owner:       msg.Owner,
+		RoyaltyInfo: msg.RoyaltyInfo,
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.Id,
+		Owner:   msg.Owner,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// UpdateClass implements UpdateClass method of the types.MsgServer.
+func (k msgServer) UpdateClass(goCtx context.Context, msg *nft.MsgUpdateClass) (*nft.MsgUpdateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.getOwnedClass(ctx, msg.Id, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	class.Name = msg.Name
+	class.Symbol = msg.Symbol
+	class.Description = msg.Description
+	class.Uri = msg.Uri
+	class.UriHash = msg.UriHash
+	class.RoyaltyInfo = msg.RoyaltyInfo
+	if err := k.Keeper.UpdateClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdateClass{
+		ClassId: msg.Id,
+	})
+	return &nft.MsgUpdateClassResponse{}, nil
+}
+
+// FreezeClass implements FreezeClass method of the types.MsgServer.
+func (k msgServer) FreezeClass(goCtx context.Context, msg *nft.MsgFreezeClass) (*nft.MsgFreezeClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, err := k.getOwnedClass(ctx, msg.Id, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	class.Frozen = true
+	if err := k.Keeper.UpdateClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventFreezeClass{
+		ClassId: msg.Id,
+	})
+	return &nft.MsgFreezeClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(goCtx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getOwnedClass(ctx, msg.ClassId, msg.Minter); err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+	}
+	if err := k.Keeper.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintResponse{}, nil
+}
+
+// UpdateNFT implements UpdateNFT method of the types.MsgServer.
+func (k msgServer) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.getOwnedClass(ctx, msg.ClassId, msg.ClassOwner); err != nil {
+		return nil, err
+	}
+
+	token, has := k.GetNFT(ctx, msg.ClassId, msg.Id)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrNFTNotExists, msg.Id)
+	}
+
+	token.Uri = msg.Uri
+	token.UriHash = msg.UriHash
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdateNFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(goCtx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnResponse{}, nil
+}
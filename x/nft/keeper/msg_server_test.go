@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -108,3 +110,199 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestCreateClass() {
+	royaltyInfo := &nft.RoyaltyInfo{Receiver: s.addrs[1].String(), FeeRate: sdk.NewDecWithPrec(5, 2)}
+	_, err := s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner:       s.addrs[0].String(),
+		Id:          testClassID,
+		Name:        testClassName,
+		Symbol:      testClassSymbol,
+		Description: testClassDescription,
+		Uri:         testClassURI,
+		UriHash:     testClassURIHash,
+		RoyaltyInfo: royaltyInfo,
+	})
+	s.Require().NoError(err)
+
+	expClass := ExpClass
+	expClass.Owner = s.addrs[0].String()
+	expClass.RoyaltyInfo = royaltyInfo
+	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(expClass, actual)
+
+	_, err = s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner: s.addrs[1].String(),
+		Id:    testClassID,
+	})
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+}
+
+func (s *TestSuite) TestClassOwnerMsgs() {
+	owner, holder := s.addrs[0], s.addrs[1]
+	_, err := s.msgServer.CreateClass(s.ctx, &nft.MsgCreateClass{
+		Owner: owner.String(),
+		Id:    testClassID,
+		Name:  testClassName,
+	})
+	s.Require().NoError(err)
+
+	// a class created through the keeper has no owner
+	err = s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "ownerless"})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		exec   func() error
+		expErr error
+	}{
+		{
+			name: "mint by non owner",
+			exec: func() error {
+				_, err := s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: holder.String(), ClassId: testClassID, Id: testID, Receiver: holder.String()})
+				return err
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "mint of ownerless class",
+			exec: func() error {
+				_, err := s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: owner.String(), ClassId: "ownerless", Id: testID, Receiver: holder.String()})
+				return err
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "mint of non existing class",
+			exec: func() error {
+				_, err := s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: owner.String(), ClassId: "unknown", Id: testID, Receiver: holder.String()})
+				return err
+			},
+			expErr: nft.ErrClassNotExists,
+		},
+		{
+			name: "mint by owner",
+			exec: func() error {
+				_, err := s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: owner.String(), ClassId: testClassID, Id: testID, Uri: testURI, Receiver: holder.String()})
+				return err
+			},
+		},
+		{
+			name: "mint of existing nft",
+			exec: func() error {
+				_, err := s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: owner.String(), ClassId: testClassID, Id: testID, Receiver: holder.String()})
+				return err
+			},
+			expErr: nft.ErrNFTExists,
+		},
+		{
+			name: "update nft by holder",
+			exec: func() error {
+				_, err := s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{ClassOwner: holder.String(), ClassId: testClassID, Id: testID, Uri: testURI, UriHash: testURIHash})
+				return err
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "update nft by class owner",
+			exec: func() error {
+				_, err := s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{ClassOwner: owner.String(), ClassId: testClassID, Id: testID, Uri: testURI, UriHash: testURIHash})
+				return err
+			},
+		},
+		{
+			name: "update class by non owner",
+			exec: func() error {
+				_, err := s.msgServer.UpdateClass(s.ctx, &nft.MsgUpdateClass{Owner: holder.String(), Id: testClassID, Name: "new name"})
+				return err
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "update class by owner",
+			exec: func() error {
+				_, err := s.msgServer.UpdateClass(s.ctx, &nft.MsgUpdateClass{
+					Owner:       owner.String(),
+					Id:          testClassID,
+					Name:        "new name",
+					RoyaltyInfo: &nft.RoyaltyInfo{Receiver: owner.String(), FeeRate: sdk.NewDecWithPrec(1, 1)},
+				})
+				return err
+			},
+		},
+		{
+			name: "freeze class by non owner",
+			exec: func() error {
+				_, err := s.msgServer.FreezeClass(s.ctx, &nft.MsgFreezeClass{Owner: holder.String(), Id: testClassID})
+				return err
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "freeze class by owner",
+			exec: func() error {
+				_, err := s.msgServer.FreezeClass(s.ctx, &nft.MsgFreezeClass{Owner: owner.String(), Id: testClassID})
+				return err
+			},
+		},
+		{
+			name: "mint in frozen class",
+			exec: func() error {
+				_, err := s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: owner.String(), ClassId: testClassID, Id: "kitty2", Receiver: holder.String()})
+				return err
+			},
+			expErr: nft.ErrClassFrozen,
+		},
+		{
+			name: "update frozen class",
+			exec: func() error {
+				_, err := s.msgServer.UpdateClass(s.ctx, &nft.MsgUpdateClass{Owner: owner.String(), Id: testClassID})
+				return err
+			},
+			expErr: nft.ErrClassFrozen,
+		},
+		{
+			name: "update nft of frozen class",
+			exec: func() error {
+				_, err := s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{ClassOwner: owner.String(), ClassId: testClassID, Id: testID})
+				return err
+			},
+			expErr: nft.ErrClassFrozen,
+		},
+		{
+			name: "burn by class owner",
+			exec: func() error {
+				_, err := s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: owner.String(), ClassId: testClassID, Id: testID})
+				return err
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "burn by nft owner",
+			exec: func() error {
+				_, err := s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: holder.String(), ClassId: testClassID, Id: testID})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			err := tc.exec()
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	class, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().True(class.Frozen)
+	s.Require().Equal("new name", class.Name)
+	s.Require().Equal(owner.String(), class.RoyaltyInfo.Receiver)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().Zero(s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+}
@@ -12,7 +12,15 @@ const (
 	TypeMsgSend = "send"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgCreateClass{}
+	_ sdk.Msg = &MsgUpdateClass{}
+	_ sdk.Msg = &MsgFreezeClass{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+	_ sdk.Msg = &MsgBurn{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -41,3 +49,142 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCreateClass) ValidateBasic() error {
+	if len(m.Id) == 0 {
+		return ErrEmptyClassID
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+
+	if m.RoyaltyInfo != nil {
+		return m.RoyaltyInfo.Validate()
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCreateClass.
+func (m MsgCreateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateClass) ValidateBasic() error {
+	if len(m.Id) == 0 {
+		return ErrEmptyClassID
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+
+	if m.RoyaltyInfo != nil {
+		return m.RoyaltyInfo.Validate()
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateClass.
+func (m MsgUpdateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgFreezeClass) ValidateBasic() error {
+	if len(m.Id) == 0 {
+		return ErrEmptyClassID
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgFreezeClass.
+func (m MsgFreezeClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMint) ValidateBasic() error {
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", m.Minter)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", m.Receiver)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMint.
+func (m MsgMint) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Minter)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateNFT) ValidateBasic() error {
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.ClassOwner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid class owner address (%s)", m.ClassOwner)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateNFT.
+func (m MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.ClassOwner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBurn) ValidateBasic() error {
+	if len(m.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if len(m.Id) == 0 {
+		return ErrEmptyNFTID
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgBurn.
+func (m MsgBurn) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
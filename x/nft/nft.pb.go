@@ -5,7 +5,10 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -39,6 +42,14 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *types.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// owner is the address allowed to mint NFTs of the class and to update its metadata. Optional, a class without
+	// owner can only be managed by other modules through the keeper
+	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// frozen defines whether the class is frozen. No NFT of a frozen class can be minted anymore, and the metadata of
+	// the class and its NFTs can't be updated anymore
+	Frozen bool `protobuf:"varint,9,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// royalty_info defines the royalty to pay to the royalty receiver on each sale of an NFT of the class. Optional
+	RoyaltyInfo *RoyaltyInfo `protobuf:"bytes,10,opt,name=royalty_info,json=royaltyInfo,proto3" json:"royalty_info,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +134,75 @@ func (m *Class) GetData() *types.Any {
 	return nil
 }
 
+func (m *Class) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Class) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *Class) GetRoyaltyInfo() *RoyaltyInfo {
+	if m != nil {
+		return m.RoyaltyInfo
+	}
+	return nil
+}
+
+// RoyaltyInfo defines the royalty of an NFT class, similar to ERC2981
+type RoyaltyInfo struct {
+	// receiver is the address receiving the royalty
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// fee_rate is the share of the sale price paid to the receiver, between 0 and 1
+	FeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_rate,json=feeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_rate"`
+}
+
+func (m *RoyaltyInfo) Reset()         { *m = RoyaltyInfo{} }
+func (m *RoyaltyInfo) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyInfo) ProtoMessage()    {}
+func (*RoyaltyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+}
+func (m *RoyaltyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoyaltyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoyaltyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoyaltyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyInfo.Merge(m, src)
+}
+func (m *RoyaltyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoyaltyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoyaltyInfo proto.InternalMessageInfo
+
+func (m *RoyaltyInfo) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -141,7 +221,7 @@ func (m *NFT) Reset()         { *m = NFT{} }
 func (m *NFT) String() string { return proto.CompactTextString(m) }
 func (*NFT) ProtoMessage()    {}
 func (*NFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
 }
 func (m *NFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,33 +287,45 @@ func (m *NFT) GetData() *types.Any {
 
 func init() {
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
+	proto.RegisterType((*RoyaltyInfo)(nil), "cosmos.nft.v1beta1.RoyaltyInfo")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x97, 0xb6, 0xdb, 0x34, 0x03, 0x91, 0x20, 0x92, 0x89, 0x84, 0xb1, 0xd3, 0x2e, 0x26,
-	0x4c, 0xaf, 0x5e, 0x54, 0x10, 0xbd, 0x78, 0x18, 0x9e, 0xbc, 0x8c, 0xb4, 0xe9, 0xd6, 0xe0, 0xda,
-	0x8c, 0x26, 0x15, 0xfb, 0x04, 0x5e, 0x7d, 0x20, 0x1f, 0xc0, 0xe3, 0x8e, 0x1e, 0xa5, 0x7d, 0x11,
-	0x49, 0x5a, 0x87, 0x87, 0x81, 0xa7, 0xfc, 0xf3, 0x7d, 0x1f, 0x7f, 0x7e, 0x1f, 0x7f, 0x78, 0x1a,
-	0x29, 0x9d, 0x2a, 0xcd, 0xb2, 0x85, 0x61, 0x2f, 0xd3, 0x30, 0x36, 0x7c, 0x6a, 0x67, 0xba, 0xce,
-	0x95, 0x51, 0x08, 0x35, 0x2e, 0xb5, 0x4a, 0xeb, 0x9e, 0x0c, 0x97, 0x4a, 0x2d, 0x57, 0x31, 0x73,
-	0x89, 0xb0, 0x58, 0x30, 0x9e, 0x95, 0x4d, 0x7c, 0xfc, 0x01, 0x60, 0xf7, 0x66, 0xc5, 0xb5, 0x46,
-	0x07, 0xd0, 0x93, 0x02, 0x83, 0x11, 0x98, 0xec, 0xcf, 0x3c, 0x29, 0x10, 0x82, 0x41, 0xc6, 0xd3,
-	0x18, 0x7b, 0x4e, 0x71, 0x33, 0x3a, 0x86, 0x3d, 0x5d, 0xa6, 0xa1, 0x5a, 0x61, 0xdf, 0xa9, 0xed,
-	0x0f, 0x8d, 0xe0, 0x40, 0xc4, 0x3a, 0xca, 0xe5, 0xda, 0x48, 0x95, 0xe1, 0xc0, 0x99, 0x7f, 0x25,
-	0x74, 0x08, 0xfd, 0x22, 0x97, 0xb8, 0xeb, 0x1c, 0x3b, 0xa2, 0x21, 0xdc, 0x2b, 0x72, 0x39, 0x4f,
-	0xb8, 0x4e, 0x70, 0xcf, 0xc9, 0xfd, 0x22, 0x97, 0x77, 0x5c, 0x27, 0x68, 0x02, 0x03, 0xc1, 0x0d,
-	0xc7, 0xfd, 0x11, 0x98, 0x0c, 0xce, 0x8f, 0x68, 0x83, 0x4f, 0x7f, 0xf1, 0xe9, 0x55, 0x56, 0xce,
-	0x5c, 0x62, 0xfc, 0x06, 0xa0, 0xff, 0x70, 0xfb, 0x68, 0x97, 0x45, 0xb6, 0xc5, 0x7c, 0x5b, 0xa1,
-	0xef, 0xfe, 0xf7, 0xa2, 0xed, 0xe5, 0x6d, 0x7b, 0xb5, 0x24, 0xfe, 0x6e, 0x92, 0x60, 0x37, 0x09,
-	0xfc, 0x8f, 0xe4, 0xfa, 0xf2, 0xb3, 0x22, 0x60, 0x53, 0x11, 0xf0, 0x5d, 0x11, 0xf0, 0x5e, 0x93,
-	0xce, 0xa6, 0x26, 0x9d, 0xaf, 0x9a, 0x74, 0x9e, 0xc6, 0x4b, 0x69, 0x92, 0x22, 0xa4, 0x91, 0x4a,
-	0x59, 0x7b, 0xba, 0xe6, 0x39, 0xd3, 0xe2, 0x99, 0xbd, 0xda, 0xdb, 0x85, 0x3d, 0xb7, 0xf1, 0xe2,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x94, 0x4b, 0x2c, 0xc3, 0xdc, 0x01, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xae, 0xd3, 0xae, 0xcd, 0x5c, 0x84, 0x90, 0x55, 0x21, 0x77, 0x42, 0x69, 0xd5, 0x03, 0xea,
+	0xa5, 0x8e, 0x06, 0x1c, 0x77, 0x59, 0x99, 0x10, 0xbb, 0x70, 0x08, 0x48, 0x48, 0x5c, 0x22, 0x27,
+	0x71, 0x52, 0x8b, 0xd6, 0xae, 0x6c, 0x67, 0x10, 0x5e, 0x80, 0x2b, 0x0f, 0xc0, 0x63, 0xec, 0x21,
+	0x7a, 0x9c, 0x76, 0x42, 0x1c, 0x26, 0xd4, 0xbe, 0x08, 0x8a, 0x63, 0xca, 0x24, 0x26, 0x76, 0xca,
+	0xff, 0x7f, 0xdf, 0xa7, 0xdf, 0xf9, 0xfe, 0xcf, 0x86, 0x4f, 0x52, 0xa9, 0x57, 0x52, 0x87, 0x22,
+	0x37, 0xe1, 0xc5, 0x71, 0xc2, 0x0c, 0x3d, 0xae, 0x6b, 0xb2, 0x56, 0xd2, 0x48, 0x84, 0x1a, 0x96,
+	0xd4, 0x88, 0x63, 0x8f, 0x86, 0x85, 0x94, 0xc5, 0x92, 0x85, 0x56, 0x91, 0x94, 0x79, 0x48, 0x45,
+	0xd5, 0xc8, 0x8f, 0x06, 0x85, 0x2c, 0xa4, 0x2d, 0xc3, 0xba, 0x72, 0xe8, 0xb0, 0x19, 0x12, 0x37,
+	0x84, 0x9b, 0x68, 0x9b, 0xc9, 0xc6, 0x83, 0x07, 0x2f, 0x97, 0x54, 0x6b, 0xf4, 0x10, 0x7a, 0x3c,
+	0xc3, 0x60, 0x0c, 0xa6, 0x87, 0x91, 0xc7, 0x33, 0x84, 0x60, 0x47, 0xd0, 0x15, 0xc3, 0x9e, 0x45,
+	0x6c, 0x8d, 0x1e, 0xc3, 0xae, 0xae, 0x56, 0x89, 0x5c, 0xe2, 0xb6, 0x45, 0x5d, 0x87, 0xc6, 0xb0,
+	0x9f, 0x31, 0x9d, 0x2a, 0xbe, 0x36, 0x5c, 0x0a, 0xdc, 0xb1, 0xe4, 0x6d, 0x08, 0x3d, 0x82, 0xed,
+	0x52, 0x71, 0x7c, 0x60, 0x99, 0xba, 0x44, 0x43, 0xe8, 0x97, 0x8a, 0xc7, 0x0b, 0xaa, 0x17, 0xb8,
+	0x6b, 0xe1, 0x5e, 0xa9, 0xf8, 0x6b, 0xaa, 0x17, 0x68, 0x0a, 0x3b, 0x19, 0x35, 0x14, 0xf7, 0xc6,
+	0x60, 0xda, 0x7f, 0x36, 0x20, 0x8d, 0x5f, 0xf2, 0xc7, 0x2f, 0x39, 0x15, 0x55, 0x64, 0x15, 0x88,
+	0xc0, 0x03, 0xf9, 0x49, 0x30, 0x85, 0xfd, 0x7a, 0xc2, 0x1c, 0x5f, 0x5f, 0xce, 0x06, 0xce, 0xdf,
+	0x69, 0x96, 0x29, 0xa6, 0xf5, 0x5b, 0xa3, 0xb8, 0x28, 0xa2, 0x46, 0x56, 0x1b, 0xc8, 0x95, 0xfc,
+	0xc2, 0x04, 0x3e, 0x1c, 0x83, 0xa9, 0x1f, 0xb9, 0x0e, 0xcd, 0xe1, 0x03, 0x25, 0x2b, 0xba, 0x34,
+	0x55, 0xcc, 0x45, 0x2e, 0x31, 0xb4, 0x27, 0x8f, 0xc8, 0xbf, 0xdb, 0x27, 0x51, 0xa3, 0x3b, 0x17,
+	0xb9, 0x8c, 0xfa, 0xea, 0x6f, 0x33, 0xf9, 0x0e, 0x60, 0xff, 0x16, 0x89, 0x5e, 0x40, 0x5f, 0xb1,
+	0x94, 0xf1, 0x0b, 0xa6, 0x30, 0xb8, 0xe7, 0xf7, 0xf6, 0x4a, 0xf4, 0x1e, 0xfa, 0x39, 0x63, 0xb1,
+	0xa2, 0xc6, 0xad, 0x7e, 0x7e, 0xb2, 0xb9, 0x19, 0xb5, 0x7e, 0xde, 0x8c, 0x9e, 0x16, 0xdc, 0x2c,
+	0xca, 0x84, 0xa4, 0x72, 0xe5, 0x32, 0x74, 0x9f, 0x99, 0xce, 0x3e, 0x86, 0xa6, 0x5a, 0x33, 0x4d,
+	0xce, 0x58, 0x7a, 0x7d, 0x39, 0x83, 0xee, 0x8c, 0x33, 0x96, 0x46, 0xbd, 0x9c, 0xb1, 0x88, 0x1a,
+	0x36, 0xf9, 0x0a, 0x60, 0xfb, 0xcd, 0xab, 0x77, 0xf5, 0xde, 0xd3, 0x3a, 0xf0, 0x78, 0x9f, 0x76,
+	0xcf, 0xf6, 0xe7, 0x99, 0xbb, 0x02, 0xde, 0xfe, 0x0a, 0xb8, 0xd0, 0xda, 0x77, 0x87, 0xd6, 0xb9,
+	0x3b, 0x34, 0x78, 0x5f, 0x68, 0xf3, 0x93, 0xcd, 0x36, 0x00, 0x57, 0xdb, 0x00, 0xfc, 0xda, 0x06,
+	0xe0, 0xdb, 0x2e, 0x68, 0x5d, 0xed, 0x82, 0xd6, 0x8f, 0x5d, 0xd0, 0xfa, 0x30, 0xf9, 0xaf, 0xc5,
+	0xcf, 0xf5, 0xbb, 0x48, 0xba, 0x76, 0xe2, 0xf3, 0xdf, 0x03, 0x00, 0x3e, 0x1e, 0x83, 0xd9, 0x38,
+	0x03, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -256,6 +348,35 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RoyaltyInfo != nil {
+		{
+			size, err := m.RoyaltyInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -313,6 +434,46 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RoyaltyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoyaltyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoyaltyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintNft(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -421,6 +582,32 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.RoyaltyInfo != nil {
+		l = m.RoyaltyInfo.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *RoyaltyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = m.FeeRate.Size()
+	n += 1 + l + sovNft(uint64(l))
 	return n
 }
 
@@ -716,6 +903,210 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoyaltyInfo == nil {
+				m.RoyaltyInfo = &RoyaltyInfo{}
+			}
+			if err := m.RoyaltyInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoyaltyInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoyaltyInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoyaltyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -121,6 +121,54 @@ func (s *CLITestSuite) TestGetCmdQuerySigningInfo() {
 	}
 }
 
+func (s *CLITestSuite) TestGetCmdQueryMissedBlocks() {
+	pubKeyBz, err := s.encCfg.Codec.MarshalInterfaceJSON(s.pub)
+	s.Require().NoError(err)
+	pubKeyStr := string(pubKeyBz)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"invalid address", []string{"foo"}, true},
+		{
+			"valid address (json output)",
+			[]string{
+				pubKeyStr,
+				fmt.Sprintf("--%s=json", flags.FlagOutput),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+		},
+		{
+			"valid address (text output)",
+			[]string{
+				pubKeyStr,
+				fmt.Sprintf("--%s=text", flags.FlagOutput),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryMissedBlocks()
+			clientCtx := s.clientCtx
+
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (s *CLITestSuite) TestGetCmdQueryParams() {
 	testCases := []struct {
 		name string
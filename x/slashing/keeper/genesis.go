@@ -38,6 +38,14 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, jails := range data.DowntimeJails {
+		address, err := sdk.ConsAddressFromBech32(jails.Address)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetValidatorDowntimeJails(ctx, address, jails)
+	}
+
 	if err := keeper.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
@@ -67,5 +75,11 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	downtimeJails := make([]types.ValidatorDowntimeJails, 0)
+	keeper.IterateValidatorDowntimeJails(ctx, func(jails types.ValidatorDowntimeJails) (stop bool) {
+		downtimeJails = append(downtimeJails, jails)
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, downtimeJails)
 }
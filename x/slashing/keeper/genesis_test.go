@@ -26,11 +26,14 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 
 	keeper.SetValidatorSigningInfo(ctx, consAddr1, info1)
 	keeper.SetValidatorSigningInfo(ctx, consAddr2, info2)
+	jails1 := types.ValidatorDowntimeJails{Address: consAddr1.String(), JailTimes: []time.Time{time.Unix(2, 0).UTC()}}
+	keeper.SetValidatorDowntimeJails(ctx, consAddr1, jails1)
 	genesisState := keeper.ExportGenesis(ctx)
 
 	require.Equal(genesisState.Params, testutil.TestParams())
 	require.Len(genesisState.SigningInfos, 2)
 	require.Equal(genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Equal([]types.ValidatorDowntimeJails{jails1}, genesisState.DowntimeJails)
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	keeper.Tombstone(ctx, consAddr1)
@@ -54,4 +57,8 @@ func (s *KeeperTestSuite) TestExportAndInitGenesis() {
 	newInfo2, _ := keeper.GetValidatorSigningInfo(ctx, consAddr2)
 	require.Equal(info1, newInfo1)
 	require.Equal(info2, newInfo2)
+
+	newJails1, found := keeper.GetValidatorDowntimeJails(ctx, consAddr1)
+	require.True(found)
+	require.Equal(jails1, newJails1)
 }
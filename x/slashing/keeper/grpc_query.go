@@ -75,3 +75,27 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+// MissedBlocks returns the missed blocks bitmap of the current signed blocks
+// window of a specific validator.
+func (k Keeper) MissedBlocks(c context.Context, req *types.QueryMissedBlocksRequest) (*types.QueryMissedBlocksResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasValidatorSigningInfo(ctx, consAddr) {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	return &types.QueryMissedBlocksResponse{MissedBlocks: k.GetValidatorMissedBlocksWindow(ctx, consAddr)}, nil
+}
@@ -88,3 +88,31 @@ func (s *KeeperTestSuite) TestGRPCSigningInfos() {
 	require.NotNil(infoResp.Pagination.NextKey)
 	require.Equal(uint64(2), infoResp.Pagination.Total)
 }
+
+func (s *KeeperTestSuite) TestGRPCMissedBlocks() {
+	queryClient, ctx, keeper := s.queryClient, s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	params := testutil.TestParams()
+	params.SignedBlocksWindow = 10
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, err := queryClient.MissedBlocks(gocontext.Background(), &slashingtypes.QueryMissedBlocksRequest{ConsAddress: ""})
+	require.Error(err)
+
+	_, err = queryClient.MissedBlocks(gocontext.Background(), &slashingtypes.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	require.Error(err)
+
+	keeper.SetValidatorSigningInfo(ctx, consAddr, slashingtypes.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(2, 0), false, 2))
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 3, true)
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 4, false)
+	keeper.SetValidatorMissedBlockBitArray(ctx, consAddr, 7, true)
+
+	res, err := queryClient.MissedBlocks(gocontext.Background(), &slashingtypes.QueryMissedBlocksRequest{ConsAddress: consAddr.String()})
+	require.NoError(err)
+	require.Len(res.MissedBlocks, 10)
+	for i, missedBlock := range res.MissedBlocks {
+		require.Equal(int64(i), missedBlock.Index)
+		require.Equal(i == 3 || i == 7, missedBlock.Missed)
+	}
+}
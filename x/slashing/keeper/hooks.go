@@ -5,6 +5,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
@@ -42,9 +43,11 @@ func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, v
 	return nil
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
+// AfterValidatorRemoved deletes the address-pubkey relation and the recent
+// downtime jails when a validator is removed,
 func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, _ sdk.ValAddress) error {
 	h.k.deleteAddrPubkeyRelation(ctx, crypto.Address(consAddr))
+	h.k.deleteValidatorDowntimeJails(ctx, consAddr)
 	return nil
 }
 
@@ -90,3 +93,38 @@ func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec
 func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new
+// consensus pubkey and moves the signing info, missed blocks and recent
+// downtime jails of the validator over to the new consensus address.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx sdk.Context, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.Coin) error {
+	if err := h.k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address())
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+
+	if jails, found := h.k.GetValidatorDowntimeJails(ctx, oldConsAddr); found {
+		jails.Address = newConsAddr.String()
+		h.k.SetValidatorDowntimeJails(ctx, newConsAddr, jails)
+		h.k.deleteValidatorDowntimeJails(ctx, oldConsAddr)
+	}
+
+	signingInfo, found := h.k.GetValidatorSigningInfo(ctx, oldConsAddr)
+	if !found {
+		// the validator has never been bonded
+		return nil
+	}
+
+	signingInfo.Address = newConsAddr.String()
+	h.k.SetValidatorSigningInfo(ctx, newConsAddr, signingInfo)
+	h.k.deleteValidatorSigningInfo(ctx, oldConsAddr)
+
+	for _, missed := range h.k.GetValidatorMissedBlocks(ctx, oldConsAddr) {
+		h.k.SetValidatorMissedBlockBitArray(ctx, newConsAddr, missed.Index, missed.Missed)
+	}
+	h.k.clearValidatorMissedBlockBitArray(ctx, oldConsAddr)
+
+	return nil
+}
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -35,9 +38,44 @@ func (s *KeeperTestSuite) TestAfterValidatorCreatedOrRemoved() {
 	require.NoError(err)
 	require.Equal(ePubKey, pubKey)
 
+	jails := slashingtypes.ValidatorDowntimeJails{Address: sdk.ConsAddress(addr).String(), JailTimes: []time.Time{ctx.BlockTime()}}
+	keeper.SetValidatorDowntimeJails(ctx, sdk.ConsAddress(addr), jails)
+
 	err = keeper.Hooks().AfterValidatorRemoved(ctx, sdk.ConsAddress(addr), nil)
 	require.NoError(err)
 
 	_, err = keeper.GetPubkey(ctx, addr.Bytes())
 	require.Error(err)
+	_, found := keeper.GetValidatorDowntimeJails(ctx, sdk.ConsAddress(addr))
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestAfterConsensusPubKeyUpdate() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, oldPubKey, oldAddr := testdata.KeyTestPubAddr()
+	_, newPubKey, newAddr := testdata.KeyTestPubAddr()
+	oldConsAddr, newConsAddr := sdk.ConsAddress(oldAddr), sdk.ConsAddress(newAddr)
+
+	keeper.Hooks().AfterValidatorBonded(ctx, oldConsAddr, sdk.ValAddress(oldAddr))
+	jailTimes := []time.Time{ctx.BlockTime()}
+	keeper.SetValidatorDowntimeJails(ctx, oldConsAddr, slashingtypes.ValidatorDowntimeJails{Address: oldConsAddr.String(), JailTimes: jailTimes})
+
+	require.NoError(keeper.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, sdk.Coin{}))
+
+	// the signing info and downtime jails are moved to the new consensus address
+	_, found := keeper.GetValidatorSigningInfo(ctx, oldConsAddr)
+	require.False(found)
+	signingInfo, found := keeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.True(found)
+	require.Equal(newConsAddr.String(), signingInfo.Address)
+
+	_, found = keeper.GetValidatorDowntimeJails(ctx, oldConsAddr)
+	require.False(found)
+	jails, found := keeper.GetValidatorDowntimeJails(ctx, newConsAddr)
+	require.True(found)
+	require.Equal(newConsAddr.String(), jails.Address)
+	require.Len(jails.JailTimes, 1)
+	require.True(jailTimes[0].Equal(jails.JailTimes[0]))
 }
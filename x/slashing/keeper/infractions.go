@@ -17,6 +17,16 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	// fetch the validator public key
 	consAddr := sdk.ConsAddress(addr)
 
+	// the vote may carry a consensus key the validator has since rotated away
+	// from, signing info is tracked under the validator's current key
+	if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil {
+		currentConsAddr, err := validator.GetConsAddr()
+		if err != nil {
+			panic(err)
+		}
+		consAddr = currentConsAddr
+	}
+
 	// don't update missed blocks when validator's jailed
 	if k.sk.IsValidatorJailed(ctx, consAddr) {
 		return
@@ -87,7 +97,11 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx), stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			// repeated downtime jails within the escalation period escalate the penalties
+			previousJails := k.recordDowntimeJail(ctx, consAddr)
+			slashFraction, jailDuration := k.GetParams(ctx).DowntimePenalty(previousJails)
+
+			coinsBurned := k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, power, slashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -100,7 +114,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -113,7 +127,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
+				"previous_jails", previousJails,
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
@@ -118,11 +118,13 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			request: &slashingtypes.MsgUpdateParams{
 				Authority: s.slashingKeeper.GetAuthority(),
 				Params: slashingtypes.Params{
-					SignedBlocksWindow:      int64(750),
-					MinSignedPerWindow:      minSignedPerWindow,
-					DowntimeJailDuration:    time.Duration(34800000000000),
-					SlashFractionDoubleSign: slashFractionDoubleSign,
-					SlashFractionDowntime:   slashFractionDowntime,
+					SignedBlocksWindow:           int64(750),
+					MinSignedPerWindow:           minSignedPerWindow,
+					DowntimeJailDuration:         time.Duration(34800000000000),
+					SlashFractionDoubleSign:      slashFractionDoubleSign,
+					SlashFractionDowntime:        slashFractionDowntime,
+					DowntimeEscalationPeriod:     time.Hour,
+					DowntimeEscalationMultiplier: sdk.NewDec(2),
 				},
 			},
 			expectErr: false,
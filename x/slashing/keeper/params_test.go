@@ -89,14 +89,30 @@ func (s *KeeperTestSuite) TestParams() {
 			expectErr: true,
 			expErrMsg: "downtime slash fraction cannot be negative",
 		},
+		{
+			name: "set invalid downtime escalation multiplier",
+			input: types.Params{
+				SignedBlocksWindow:           int64(750),
+				MinSignedPerWindow:           minSignedPerWindow,
+				DowntimeJailDuration:         time.Duration(34800000000000),
+				SlashFractionDoubleSign:      slashFractionDoubleSign,
+				SlashFractionDowntime:        slashFractionDowntime,
+				DowntimeEscalationPeriod:     time.Hour,
+				DowntimeEscalationMultiplier: sdk.NewDecWithPrec(5, 1),
+			},
+			expectErr: true,
+			expErrMsg: "downtime escalation multiplier must be at least one",
+		},
 		{
 			name: "set all valid params",
 			input: types.Params{
-				SignedBlocksWindow:      int64(750),
-				MinSignedPerWindow:      minSignedPerWindow,
-				DowntimeJailDuration:    time.Duration(34800000000000),
-				SlashFractionDoubleSign: slashFractionDoubleSign,
-				SlashFractionDowntime:   slashFractionDowntime,
+				SignedBlocksWindow:           int64(750),
+				MinSignedPerWindow:           minSignedPerWindow,
+				DowntimeJailDuration:         time.Duration(34800000000000),
+				SlashFractionDoubleSign:      slashFractionDoubleSign,
+				SlashFractionDowntime:        slashFractionDowntime,
+				DowntimeEscalationPeriod:     time.Hour,
+				DowntimeEscalationMultiplier: sdk.NewDec(2),
 			},
 			expectErr: false,
 		},
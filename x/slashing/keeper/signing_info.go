@@ -37,6 +37,12 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(types.ValidatorSigningInfoKey(address), bz)
 }
 
+// deleteValidatorSigningInfo removes the validator signing info of a consensus address
+func (k Keeper) deleteValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorSigningInfoKey(address))
+}
+
 // IterateValidatorSigningInfos iterates over the stored ValidatorSigningInfo
 func (k Keeper) IterateValidatorSigningInfos(ctx sdk.Context,
 	handler func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool),
@@ -101,6 +107,99 @@ func (k Keeper) GetValidatorMissedBlocks(ctx sdk.Context, address sdk.ConsAddres
 	return missedBlocks
 }
 
+// GetValidatorMissedBlocksWindow returns the missed status of every index of
+// the signed blocks window of given validator Cons address
+func (k Keeper) GetValidatorMissedBlocksWindow(ctx sdk.Context, address sdk.ConsAddress) []types.MissedBlock {
+	window := k.SignedBlocksWindow(ctx)
+	missedBlocks := make([]types.MissedBlock, window)
+	for index := int64(0); index < window; index++ {
+		missedBlocks[index] = types.NewMissedBlock(index, false)
+	}
+
+	k.IterateValidatorMissedBlockBitArray(ctx, address, func(index int64, missed bool) (stop bool) {
+		missedBlocks[index].Missed = missed
+		return false
+	})
+
+	return missedBlocks
+}
+
+// GetValidatorDowntimeJails returns the recent downtime jails of a validator
+// Cons address
+func (k Keeper) GetValidatorDowntimeJails(ctx sdk.Context, address sdk.ConsAddress) (jails types.ValidatorDowntimeJails, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorDowntimeJailsKey(address))
+	if bz == nil {
+		return jails, false
+	}
+	k.cdc.MustUnmarshal(bz, &jails)
+	return jails, true
+}
+
+// SetValidatorDowntimeJails sets the recent downtime jails of a validator
+// Cons address, deleting them if there are none
+func (k Keeper) SetValidatorDowntimeJails(ctx sdk.Context, address sdk.ConsAddress, jails types.ValidatorDowntimeJails) {
+	store := ctx.KVStore(k.storeKey)
+	if len(jails.JailTimes) == 0 {
+		store.Delete(types.ValidatorDowntimeJailsKey(address))
+		return
+	}
+	bz := k.cdc.MustMarshal(&jails)
+	store.Set(types.ValidatorDowntimeJailsKey(address), bz)
+}
+
+// deleteValidatorDowntimeJails removes the recent downtime jails of a
+// consensus address
+func (k Keeper) deleteValidatorDowntimeJails(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorDowntimeJailsKey(address))
+}
+
+// IterateValidatorDowntimeJails iterates over the stored ValidatorDowntimeJails
+func (k Keeper) IterateValidatorDowntimeJails(ctx sdk.Context,
+	handler func(jails types.ValidatorDowntimeJails) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorDowntimeJailsKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var jails types.ValidatorDowntimeJails
+		k.cdc.MustUnmarshal(iter.Value(), &jails)
+		if handler(jails) {
+			break
+		}
+	}
+}
+
+// recordDowntimeJail records a downtime jail of a validator at the current
+// block time, pruning its jails older than the downtime escalation period,
+// and returns the number of its previous jails within the period.
+func (k Keeper) recordDowntimeJail(ctx sdk.Context, address sdk.ConsAddress) int {
+	period := k.GetParams(ctx).DowntimeEscalationPeriod
+	if period == 0 {
+		return 0
+	}
+
+	jails, _ := k.GetValidatorDowntimeJails(ctx, address)
+	blockTime := ctx.BlockHeader().Time
+	since := blockTime.Add(-period)
+
+	recent := make([]time.Time, 0, len(jails.JailTimes)+1)
+	for _, jailTime := range jails.JailTimes {
+		if jailTime.After(since) {
+			recent = append(recent, jailTime)
+		}
+	}
+	previousJails := len(recent)
+
+	k.SetValidatorDowntimeJails(ctx, address, types.ValidatorDowntimeJails{
+		Address:   address.String(),
+		JailTimes: append(recent, blockTime),
+	})
+
+	return previousJails
+}
+
 // JailUntil attempts to set a validator's JailedUntil attribute in its signing
 // info. It will panic if the signing info does not exist for the validator.
 func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
@@ -91,3 +91,33 @@ func (s *KeeperTestSuite) TestValidatorMissedBlockBitArray() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestValidatorDowntimeJails() {
+	ctx, keeper := s.ctx, s.slashingKeeper
+	require := s.Require()
+
+	_, found := keeper.GetValidatorDowntimeJails(ctx, consAddr)
+	require.False(found)
+
+	jails := slashingtypes.ValidatorDowntimeJails{
+		Address:   consAddr.String(),
+		JailTimes: []time.Time{time.Unix(2, 0).UTC(), time.Unix(3, 0).UTC()},
+	}
+	keeper.SetValidatorDowntimeJails(ctx, consAddr, jails)
+
+	res, found := keeper.GetValidatorDowntimeJails(ctx, consAddr)
+	require.True(found)
+	require.Equal(jails, res)
+
+	var allJails []slashingtypes.ValidatorDowntimeJails
+	keeper.IterateValidatorDowntimeJails(ctx, func(jails slashingtypes.ValidatorDowntimeJails) (stop bool) {
+		allJails = append(allJails, jails)
+		return false
+	})
+	require.Equal([]slashingtypes.ValidatorDowntimeJails{jails}, allJails)
+
+	// setting no jail times deletes the downtime jails
+	keeper.SetValidatorDowntimeJails(ctx, consAddr, slashingtypes.ValidatorDowntimeJails{Address: consAddr.String()})
+	_, found = keeper.GetValidatorDowntimeJails(ctx, consAddr)
+	require.False(found)
+}
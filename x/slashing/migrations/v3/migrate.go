@@ -1,6 +1,8 @@
 package v3
 
 import (
+	"reflect"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/exported"
@@ -21,8 +23,12 @@ func Migrate(ctx sdk.Context, store sdk.KVStore, legacySubspace exported.Subspac
 	var currParams types.Params
 	legacySubspace.GetParamSet(ctx, &currParams)
 
-	if err := currParams.Validate(); err != nil {
-		return err
+	// only the legacy params are validated, as the params introduced after v3
+	// are set by later migrations
+	for _, pair := range currParams.ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.Indirect(reflect.ValueOf(pair.Value)).Interface()); err != nil {
+			return err
+		}
 	}
 
 	bz := cdc.MustMarshal(&currParams)
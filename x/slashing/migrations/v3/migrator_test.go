@@ -41,3 +41,28 @@ func TestMigrate(t *testing.T) {
 	require.NoError(t, cdc.Unmarshal(bz, &res))
 	require.Equal(t, legacySubspace.ps, res)
 }
+
+func TestMigrateLegacyParams(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{}).Codec
+	storeKey := sdk.NewKVStoreKey(v3.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// the legacy param set has no values for the params introduced after v3
+	params := types.DefaultParams()
+	params.DowntimeEscalationPeriod = 0
+	params.DowntimeEscalationMultiplier = sdk.Dec{}
+	legacySubspace := newMockSubspace(params)
+	require.NoError(t, v3.Migrate(ctx, store, legacySubspace, cdc))
+
+	var res types.Params
+	bz := store.Get(v3.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, params.SignedBlocksWindow, res.SignedBlocksWindow)
+	require.Equal(t, params.MinSignedPerWindow, res.MinSignedPerWindow)
+	require.Equal(t, params.DowntimeJailDuration, res.DowntimeJailDuration)
+	require.Equal(t, params.SlashFractionDoubleSign, res.SlashFractionDoubleSign)
+	require.Equal(t, params.SlashFractionDowntime, res.SlashFractionDowntime)
+	require.Zero(t, res.DowntimeEscalationPeriod)
+}
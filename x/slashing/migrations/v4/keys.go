@@ -0,0 +1,8 @@
+package v4
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "slashing"
+)
+
+var ParamsKey = []byte{0x00} // prefix for parameters for module x/slashing
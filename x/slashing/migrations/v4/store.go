@@ -0,0 +1,36 @@
+package v4
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4.
+// The migration includes:
+//
+// - Setting the DowntimeEscalationPeriod and DowntimeEscalationMultiplier
+// params to their default values
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	return migrateParams(store, cdc)
+}
+
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+
+	params.DowntimeEscalationPeriod = types.DefaultDowntimeEscalationPeriod
+	params.DowntimeEscalationMultiplier = types.DefaultDowntimeEscalationMultiplier
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
@@ -0,0 +1,37 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	v4 "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(slashing.AppModuleBasic{}).Codec
+
+	storeKey := sdk.NewKVStoreKey(v4.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	oldParams := types.DefaultParams()
+	oldParams.SignedBlocksWindow = 1000
+	oldParams.DowntimeEscalationPeriod = 0
+	oldParams.DowntimeEscalationMultiplier = sdk.Dec{}
+	store.Set(v4.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v4.ParamsKey), &res))
+	require.Equal(t, int64(1000), res.SignedBlocksWindow)
+	require.Equal(t, types.DefaultDowntimeEscalationPeriod, res.DowntimeEscalationPeriod)
+	require.Equal(t, types.DefaultDowntimeEscalationMultiplier, res.DowntimeEscalationMultiplier)
+}
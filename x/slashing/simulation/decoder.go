@@ -39,6 +39,12 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.ValidatorDowntimeJailsKeyPrefix):
+			var jailsA, jailsB types.ValidatorDowntimeJails
+			cdc.MustUnmarshal(kvA.Value, &jailsA)
+			cdc.MustUnmarshal(kvB.Value, &jailsB)
+			return fmt.Sprintf("%v\n%v", jailsA, jailsB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
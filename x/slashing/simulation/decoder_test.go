@@ -33,6 +33,7 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	missed := gogotypes.BoolValue{Value: true}
+	jails := types.ValidatorDowntimeJails{Address: consAddr1.String(), JailTimes: []time.Time{time.Now().UTC()}}
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
 
@@ -41,6 +42,7 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshal(&missed)},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.ValidatorDowntimeJailsKey(consAddr1), Value: cdc.MustMarshal(&jails)},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -53,6 +55,7 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"ValidatorDowntimeJails", fmt.Sprintf("%v\n%v", jails, jails), false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
@@ -22,6 +22,9 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeEscalationPeriod     = "downtime_escalation_period"
+	DowntimeEscalationMultiplier = "downtime_escalation_multiplier"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +52,16 @@ func GenSlashFractionDowntime(r *rand.Rand) math.LegacyDec {
 	return math.LegacyNewDec(1).Quo(math.LegacyNewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeEscalationPeriod randomized DowntimeEscalationPeriod
+func GenDowntimeEscalationPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 0, 60*60*24*7)) * time.Second
+}
+
+// GenDowntimeEscalationMultiplier randomized DowntimeEscalationMultiplier
+func GenDowntimeEscalationMultiplier(r *rand.Rand) math.LegacyDec {
+	return math.LegacyOneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(20)), 1))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,12 +94,25 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeEscalationPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeEscalationPeriod, &downtimeEscalationPeriod, simState.Rand,
+		func(r *rand.Rand) { downtimeEscalationPeriod = GenDowntimeEscalationPeriod(r) },
+	)
+
+	var downtimeEscalationMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeEscalationMultiplier, &downtimeEscalationMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeEscalationMultiplier = GenDowntimeEscalationMultiplier(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		downtimeEscalationPeriod, downtimeEscalationMultiplier,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.ValidatorDowntimeJails{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
@@ -5,16 +5,20 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	downtimeJails []ValidatorDowntimeJails,
 ) *GenesisState {
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:        params,
+		SigningInfos:  signingInfos,
+		MissedBlocks:  missedBlocks,
+		DowntimeJails: downtimeJails,
 	}
 }
 
@@ -29,9 +33,10 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:        DefaultParams(),
+		SigningInfos:  []SigningInfo{},
+		MissedBlocks:  []ValidatorMissedBlocks{},
+		DowntimeJails: []ValidatorDowntimeJails{},
 	}
 }
 
@@ -62,5 +67,24 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateDowntimeEscalationPeriod(data.Params.DowntimeEscalationPeriod); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeEscalationMultiplier(data.Params.DowntimeEscalationMultiplier); err != nil {
+		return err
+	}
+
+	seenDowntimeJails := make(map[string]bool)
+	for _, jails := range data.DowntimeJails {
+		if _, err := sdk.ConsAddressFromBech32(jails.Address); err != nil {
+			return err
+		}
+		if seenDowntimeJails[jails.Address] {
+			return fmt.Errorf("duplicate downtime jails for validator %s", jails.Address)
+		}
+		seenDowntimeJails[jails.Address] = true
+	}
+
 	return nil
 }
@@ -27,12 +27,15 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04<consAddrLen (1 Byte)><consAddress_Bytes>: ValidatorDowntimeJails
 
 var (
 	ParamsKey                             = []byte{0x00} // Prefix for params key
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	ValidatorDowntimeJailsKeyPrefix       = []byte{0x04} // Prefix for downtime jails
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -66,3 +69,8 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// ValidatorDowntimeJailsKey - stored by *Consensus* address (not operator address)
+func ValidatorDowntimeJailsKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorDowntimeJailsKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
@@ -11,27 +11,33 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow       = int64(100)
+	DefaultDowntimeJailDuration     = 60 * 10 * time.Second
+	DefaultDowntimeEscalationPeriod = time.Duration(0)
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = math.LegacyNewDec(1).Quo(math.LegacyNewDec(20))
 	DefaultSlashFractionDowntime   = math.LegacyNewDec(1).Quo(math.LegacyNewDec(100))
+
+	DefaultDowntimeEscalationMultiplier = math.LegacyNewDec(2)
 )
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	downtimeEscalationPeriod time.Duration, downtimeEscalationMultiplier sdk.Dec,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:           signedBlocksWindow,
+		MinSignedPerWindow:           minSignedPerWindow,
+		DowntimeJailDuration:         downtimeJailDuration,
+		SlashFractionDoubleSign:      slashFractionDoubleSign,
+		SlashFractionDowntime:        slashFractionDowntime,
+		DowntimeEscalationPeriod:     downtimeEscalationPeriod,
+		DowntimeEscalationMultiplier: downtimeEscalationMultiplier,
 	}
 }
 
@@ -43,6 +49,8 @@ func DefaultParams() Params {
 		DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign,
 		DefaultSlashFractionDowntime,
+		DefaultDowntimeEscalationPeriod,
+		DefaultDowntimeEscalationMultiplier,
 	)
 }
 
@@ -63,6 +71,12 @@ func (p Params) Validate() error {
 	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
 		return err
 	}
+	if err := validateDowntimeEscalationPeriod(p.DowntimeEscalationPeriod); err != nil {
+		return err
+	}
+	if err := validateDowntimeEscalationMultiplier(p.DowntimeEscalationMultiplier); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -148,3 +162,54 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeEscalationPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("downtime escalation period cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeEscalationMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("downtime escalation multiplier cannot be nil: %s", v)
+	}
+	if v.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("downtime escalation multiplier must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+// DowntimePenalty returns the slash fraction and jail duration of a downtime
+// jail given the number of previous downtime jails of the validator within the
+// downtime escalation period. Both are multiplied by the escalation multiplier
+// for each previous jail, the slash fraction being capped at one.
+func (p Params) DowntimePenalty(previousJails int) (sdk.Dec, time.Duration) {
+	slashFraction := p.SlashFractionDowntime
+	jailDuration := math.LegacyNewDec(int64(p.DowntimeJailDuration))
+	maxJailDuration := math.LegacyNewDec(1<<63 - 1)
+
+	// stop multiplying once both penalties are capped, the number of previous
+	// jails being unbounded
+	for i := 0; i < previousJails; i++ {
+		if slashFraction.GTE(math.LegacyOneDec()) && jailDuration.GTE(maxJailDuration) {
+			break
+		}
+		slashFraction = math.LegacyMinDec(slashFraction.Mul(p.DowntimeEscalationMultiplier), math.LegacyOneDec())
+		jailDuration = math.LegacyMinDec(jailDuration.Mul(p.DowntimeEscalationMultiplier), maxJailDuration)
+	}
+
+	return slashFraction, time.Duration(jailDuration.TruncateInt64())
+}
@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestDowntimePenalty(t *testing.T) {
+	params := types.DefaultParams()
+	params.SlashFractionDowntime = sdk.NewDecWithPrec(1, 1)
+	params.DowntimeJailDuration = time.Hour
+	params.DowntimeEscalationMultiplier = sdk.NewDec(3)
+
+	tests := []struct {
+		previousJails    int
+		expSlashFraction sdk.Dec
+		expJailDuration  time.Duration
+	}{
+		{0, sdk.NewDecWithPrec(1, 1), time.Hour},
+		{1, sdk.NewDecWithPrec(3, 1), 3 * time.Hour},
+		{2, sdk.NewDecWithPrec(9, 1), 9 * time.Hour},
+		// the slash fraction is capped at one
+		{3, sdk.OneDec(), 27 * time.Hour},
+		// the jail duration is capped at the max duration
+		{100, sdk.OneDec(), time.Duration(1<<63 - 1)},
+	}
+	for i, tc := range tests {
+		slashFraction, jailDuration := params.DowntimePenalty(tc.previousJails)
+		require.Equal(t, tc.expSlashFraction, slashFraction, "Test Index: %v", i)
+		require.Equal(t, tc.expJailDuration, jailDuration, "Test Index: %v", i)
+	}
+}
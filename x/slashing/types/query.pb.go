@@ -309,6 +309,101 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryMissedBlocksRequest is the request type for the Query/MissedBlocks RPC
+// method
+type QueryMissedBlocksRequest struct {
+	// cons_address is the address to query the missed blocks of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryMissedBlocksRequest) Reset()         { *m = QueryMissedBlocksRequest{} }
+func (m *QueryMissedBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksRequest) ProtoMessage()    {}
+func (*QueryMissedBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryMissedBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksRequest.Merge(m, src)
+}
+func (m *QueryMissedBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksRequest proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryMissedBlocksResponse is the response type for the Query/MissedBlocks
+// RPC method
+type QueryMissedBlocksResponse struct {
+	// missed_blocks contains the missed status of every index of the signed
+	// blocks window of the validator
+	MissedBlocks []MissedBlock `protobuf:"bytes,1,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+}
+
+func (m *QueryMissedBlocksResponse) Reset()         { *m = QueryMissedBlocksResponse{} }
+func (m *QueryMissedBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlocksResponse) ProtoMessage()    {}
+func (*QueryMissedBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryMissedBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlocksResponse.Merge(m, src)
+}
+func (m *QueryMissedBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlocksResponse proto.InternalMessageInfo
+
+func (m *QueryMissedBlocksResponse) GetMissedBlocks() []MissedBlock {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -316,6 +411,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryMissedBlocksRequest)(nil), "cosmos.slashing.v1beta1.QueryMissedBlocksRequest")
+	proto.RegisterType((*QueryMissedBlocksResponse)(nil), "cosmos.slashing.v1beta1.QueryMissedBlocksResponse")
 }
 
 func init() {
@@ -323,43 +420,48 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xc7, 0x33, 0xad, 0x16, 0x3a, 0x29, 0xa2, 0x63, 0xa0, 0x69, 0x90, 0x8d, 0xae, 0x90, 0x96,
-	0x6a, 0x76, 0x4c, 0x44, 0x3c, 0x88, 0x07, 0x73, 0xb0, 0x08, 0x1e, 0x34, 0x85, 0x82, 0x5e, 0xc2,
-	0x6c, 0x32, 0x9d, 0x0e, 0x6e, 0x66, 0xb6, 0x3b, 0x9b, 0x60, 0x11, 0x3d, 0x78, 0xf6, 0x20, 0xf8,
-	0x19, 0x04, 0x8f, 0x2a, 0x7e, 0x88, 0x1e, 0x8b, 0x5e, 0x3c, 0x89, 0x24, 0x82, 0x77, 0x3f, 0x81,
-	0x64, 0x66, 0x92, 0x4c, 0x89, 0xab, 0x29, 0x5e, 0x92, 0xe5, 0xbd, 0xf7, 0x7f, 0xff, 0xdf, 0x7b,
-	0x79, 0x1b, 0x78, 0xb9, 0x2d, 0x55, 0x57, 0x2a, 0xac, 0x22, 0xa2, 0xf6, 0xb8, 0x60, 0xb8, 0x5f,
-	0x0b, 0x69, 0x4a, 0x6a, 0x78, 0xbf, 0x47, 0x93, 0x83, 0x20, 0x4e, 0x64, 0x2a, 0xd1, 0xaa, 0x29,
-	0x0a, 0xc6, 0x45, 0x81, 0x2d, 0x2a, 0x6d, 0x5a, 0x75, 0x48, 0x14, 0x35, 0x8a, 0x89, 0x3e, 0x26,
-	0x8c, 0x0b, 0x92, 0x72, 0x29, 0x4c, 0x93, 0x52, 0x81, 0x49, 0x26, 0xf5, 0x23, 0x1e, 0x3d, 0xd9,
-	0xe8, 0x05, 0x26, 0x25, 0x8b, 0x28, 0x26, 0x31, 0xc7, 0x44, 0x08, 0x99, 0x6a, 0x89, 0xb2, 0xd9,
-	0x4a, 0x16, 0xdd, 0x84, 0xc4, 0xd4, 0xad, 0x99, 0xba, 0x96, 0x69, 0x6f, 0x69, 0x4d, 0xea, 0x1c,
-	0xe9, 0x72, 0x21, 0xb1, 0xfe, 0x34, 0x21, 0xbf, 0x00, 0xd1, 0xc3, 0x11, 0xeb, 0x03, 0x92, 0x90,
-	0xae, 0x6a, 0xd2, 0xfd, 0x1e, 0x55, 0xa9, 0xff, 0x08, 0x9e, 0x3f, 0x16, 0x55, 0xb1, 0x14, 0x8a,
-	0xa2, 0x06, 0x5c, 0x8a, 0x75, 0xa4, 0x08, 0x2e, 0x82, 0x8d, 0x7c, 0xbd, 0x1c, 0x64, 0x2c, 0x23,
-	0x30, 0xc2, 0xc6, 0xf2, 0xe1, 0xb7, 0x72, 0xee, 0xdd, 0xcf, 0xf7, 0x9b, 0xa0, 0x69, 0x95, 0xfe,
-	0x0e, 0x5c, 0xd5, 0xad, 0xb7, 0x39, 0x13, 0x5c, 0xb0, 0x7b, 0x62, 0x57, 0x5a, 0x57, 0x74, 0x0b,
-	0xae, 0xb4, 0xa5, 0x50, 0x2d, 0xd2, 0xe9, 0x24, 0x54, 0x19, 0x93, 0xe5, 0x46, 0xf1, 0xf3, 0xa7,
-	0x6a, 0xc1, 0xfa, 0xdc, 0x31, 0x99, 0xed, 0x34, 0xe1, 0x82, 0x35, 0xf3, 0xa3, 0x6a, 0x1b, 0xf2,
-	0x5f, 0xc0, 0xe2, 0x6c, 0x5f, 0xcb, 0x1d, 0xc2, 0xb3, 0x7d, 0x12, 0xb5, 0x94, 0x49, 0xb5, 0xb8,
-	0xd8, 0x95, 0x76, 0x82, 0x6a, 0xe6, 0x04, 0x3b, 0x24, 0xe2, 0x1d, 0x92, 0xca, 0xc4, 0x69, 0xe8,
-	0xce, 0x73, 0xa6, 0x4f, 0x22, 0x27, 0xe5, 0x87, 0xb3, 0xfe, 0xe3, 0x75, 0xa2, 0xbb, 0x10, 0x4e,
-	0x4f, 0xc0, 0x3a, 0x57, 0xc6, 0xce, 0xa3, 0x7b, 0x09, 0xcc, 0x85, 0x4d, 0xb7, 0xc7, 0xa8, 0xd5,
-	0x36, 0x1d, 0xa5, 0xff, 0x11, 0xc0, 0xb5, 0x3f, 0x98, 0xd8, 0x29, 0xef, 0xc3, 0x53, 0x76, 0xb2,
-	0xc5, 0xff, 0x9a, 0x4c, 0x77, 0x41, 0x5b, 0xc7, 0x98, 0x17, 0x34, 0xf3, 0xfa, 0x3f, 0x99, 0x0d,
-	0x8a, 0x0b, 0x5d, 0xff, 0xb5, 0x08, 0x4f, 0x6b, 0x68, 0xf4, 0x0a, 0xc0, 0x25, 0x73, 0x18, 0xe8,
-	0x4a, 0x26, 0xdd, 0xec, 0x35, 0x96, 0xae, 0xce, 0x57, 0x6c, 0xbc, 0xfd, 0xf5, 0x97, 0x5f, 0x7e,
-	0xbc, 0x59, 0xb8, 0x84, 0xca, 0x38, 0xeb, 0x85, 0x31, 0x97, 0x88, 0x3e, 0x00, 0x98, 0x77, 0x56,
-	0x80, 0xae, 0xfd, 0xdd, 0x66, 0xf6, 0x60, 0x4b, 0xb5, 0x13, 0x28, 0x2c, 0xdd, 0x6d, 0x4d, 0x77,
-	0x13, 0xdd, 0xc8, 0xa4, 0x73, 0xaf, 0x54, 0xe1, 0x67, 0xee, 0x1b, 0xf1, 0x1c, 0xbd, 0x05, 0x70,
-	0xc5, 0xfd, 0xf1, 0xd1, 0xfc, 0x08, 0x93, 0x75, 0xd6, 0x4f, 0x22, 0xb1, 0xd8, 0x81, 0xc6, 0xde,
-	0x40, 0x95, 0xf9, 0xb0, 0x1b, 0x5b, 0x87, 0x03, 0x0f, 0x1c, 0x0d, 0x3c, 0xf0, 0x7d, 0xe0, 0x81,
-	0xd7, 0x43, 0x2f, 0x77, 0x34, 0xf4, 0x72, 0x5f, 0x87, 0x5e, 0xee, 0x71, 0x95, 0xf1, 0x74, 0xaf,
-	0x17, 0x06, 0x6d, 0xd9, 0x1d, 0xf7, 0x32, 0x5f, 0x55, 0xd5, 0x79, 0x82, 0x9f, 0x4e, 0x1b, 0xa7,
-	0x07, 0x31, 0x55, 0xe1, 0x92, 0xfe, 0x9b, 0xba, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xae,
-	0x17, 0x98, 0x9c, 0x05, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xc1, 0x6b, 0x13, 0x4f,
+	0x14, 0xce, 0xf6, 0xf7, 0x6b, 0xa1, 0xd3, 0x28, 0x3a, 0x16, 0xda, 0x04, 0xd9, 0xe8, 0xaa, 0x69,
+	0xa9, 0x66, 0xd7, 0x44, 0xc4, 0x83, 0x78, 0x30, 0xa2, 0x45, 0x54, 0xd0, 0x54, 0x2a, 0x7a, 0x09,
+	0xb3, 0xc9, 0x74, 0x3a, 0x74, 0x77, 0x66, 0xb3, 0xb3, 0x09, 0x16, 0xd1, 0x83, 0x67, 0x0f, 0x82,
+	0x7f, 0x83, 0xe0, 0x51, 0x45, 0xf0, 0xee, 0xa9, 0xc7, 0xa2, 0x17, 0x4f, 0x22, 0x89, 0xe0, 0xbf,
+	0x21, 0x99, 0x99, 0x24, 0x1b, 0x92, 0xb5, 0x89, 0x7a, 0x69, 0x97, 0xf7, 0xde, 0xf7, 0xbe, 0xef,
+	0x7d, 0xf3, 0x1e, 0x01, 0xa7, 0x6a, 0x5c, 0xf8, 0x5c, 0x38, 0xc2, 0x43, 0x62, 0x9b, 0x32, 0xe2,
+	0xb4, 0x8a, 0x2e, 0x8e, 0x50, 0xd1, 0x69, 0x34, 0x71, 0xb8, 0x6b, 0x07, 0x21, 0x8f, 0x38, 0x5c,
+	0x52, 0x45, 0x76, 0xaf, 0xc8, 0xd6, 0x45, 0xd9, 0x35, 0x8d, 0x76, 0x91, 0xc0, 0x0a, 0xd1, 0xc7,
+	0x07, 0x88, 0x50, 0x86, 0x22, 0xca, 0x99, 0x6a, 0x92, 0x5d, 0x24, 0x9c, 0x70, 0xf9, 0xe9, 0x74,
+	0xbf, 0x74, 0xf4, 0x38, 0xe1, 0x9c, 0x78, 0xd8, 0x41, 0x01, 0x75, 0x10, 0x63, 0x3c, 0x92, 0x10,
+	0xa1, 0xb3, 0xf9, 0x24, 0x75, 0x7d, 0x25, 0xaa, 0xee, 0x4c, 0x52, 0x1d, 0xc1, 0x0c, 0x0b, 0xda,
+	0x6b, 0x97, 0x51, 0x65, 0x55, 0xa5, 0x42, 0x0f, 0xa5, 0x52, 0x47, 0x91, 0x4f, 0x19, 0x77, 0xe4,
+	0x5f, 0x15, 0xb2, 0x16, 0x01, 0xbc, 0xd7, 0x1d, 0xe9, 0x2e, 0x0a, 0x91, 0x2f, 0x2a, 0xb8, 0xd1,
+	0xc4, 0x22, 0xb2, 0x1e, 0x82, 0x63, 0x43, 0x51, 0x11, 0x70, 0x26, 0x30, 0x2c, 0x83, 0xb9, 0x40,
+	0x46, 0x96, 0x8d, 0x13, 0xc6, 0xea, 0x42, 0x29, 0x67, 0x27, 0x78, 0x66, 0x2b, 0x60, 0x79, 0x7e,
+	0xef, 0x5b, 0x2e, 0xf5, 0xe6, 0xe7, 0xdb, 0x35, 0xa3, 0xa2, 0x91, 0xd6, 0x26, 0x58, 0x92, 0xad,
+	0x37, 0x28, 0x61, 0x94, 0x91, 0x9b, 0x6c, 0x8b, 0x6b, 0x56, 0x78, 0x19, 0xa4, 0x6b, 0x9c, 0x89,
+	0x2a, 0xaa, 0xd7, 0x43, 0x2c, 0x14, 0xc9, 0x7c, 0x79, 0xf9, 0xf3, 0x87, 0xc2, 0xa2, 0xe6, 0xb9,
+	0xaa, 0x32, 0x1b, 0x51, 0x48, 0x19, 0xa9, 0x2c, 0x74, 0xab, 0x75, 0xc8, 0x7a, 0x06, 0x96, 0x47,
+	0xfb, 0x6a, 0xdd, 0x2e, 0x38, 0xd2, 0x42, 0x5e, 0x55, 0xa8, 0x54, 0x95, 0xb2, 0x2d, 0xae, 0x27,
+	0x28, 0x24, 0x4e, 0xb0, 0x89, 0x3c, 0x5a, 0x47, 0x11, 0x0f, 0x63, 0x0d, 0xe3, 0xf3, 0x1c, 0x6e,
+	0x21, 0x2f, 0x96, 0xb2, 0xdc, 0x51, 0xfe, 0x9e, 0x9d, 0xf0, 0x06, 0x00, 0x83, 0x4d, 0xd1, 0xcc,
+	0xf9, 0x1e, 0x73, 0x77, 0xad, 0x6c, 0xb5, 0x88, 0x03, 0xf7, 0x08, 0xd6, 0xd8, 0x4a, 0x0c, 0x69,
+	0xbd, 0x37, 0x40, 0x66, 0x0c, 0x89, 0x9e, 0xf2, 0x36, 0xf8, 0x5f, 0x4f, 0xf6, 0xdf, 0x5f, 0x4d,
+	0x26, 0xbb, 0xc0, 0xf5, 0x21, 0xcd, 0x33, 0x52, 0xf3, 0xca, 0x81, 0x9a, 0x95, 0x94, 0x21, 0xd1,
+	0x0f, 0xb4, 0x31, 0x77, 0xa8, 0x10, 0xb8, 0x5e, 0xf6, 0x78, 0x6d, 0x47, 0xfc, 0x93, 0x17, 0x6f,
+	0x80, 0xcc, 0x98, 0xc6, 0xda, 0x8c, 0xfb, 0xe0, 0x90, 0x2f, 0xe3, 0x55, 0x57, 0x26, 0xb4, 0x2b,
+	0xa7, 0x13, 0x5d, 0x89, 0x75, 0x89, 0x9b, 0x91, 0xf6, 0x07, 0x71, 0x51, 0xfa, 0x38, 0x0b, 0x66,
+	0x25, 0x27, 0x7c, 0x61, 0x80, 0x39, 0xb5, 0xe4, 0xf0, 0x6c, 0x62, 0xcf, 0xd1, 0xcb, 0xca, 0x9e,
+	0x9b, 0xac, 0x58, 0x4d, 0x61, 0xad, 0x3c, 0xff, 0xf2, 0xe3, 0xd5, 0xcc, 0x49, 0x98, 0x73, 0x92,
+	0x6e, 0x5f, 0x5d, 0x15, 0x7c, 0x67, 0x80, 0x85, 0xd8, 0x73, 0xc2, 0xf3, 0xbf, 0xa7, 0x19, 0x3d,
+	0xbe, 0x6c, 0x71, 0x0a, 0x84, 0x56, 0x77, 0x45, 0xaa, 0xbb, 0x04, 0x2f, 0x26, 0xaa, 0x8b, 0x5f,
+	0x9c, 0x70, 0x9e, 0xc4, 0xdf, 0xfa, 0x29, 0x7c, 0x6d, 0x80, 0x74, 0xac, 0xad, 0x80, 0x93, 0x4b,
+	0xe8, 0xdb, 0x59, 0x9a, 0x06, 0xa2, 0x65, 0xdb, 0x52, 0xf6, 0x2a, 0xcc, 0x4f, 0x26, 0x1b, 0x7e,
+	0x32, 0x40, 0x3a, 0xbe, 0x63, 0x07, 0xe9, 0x1c, 0xb3, 0xe8, 0xd9, 0xd2, 0x34, 0x10, 0xad, 0xf3,
+	0x96, 0xd4, 0x79, 0x1d, 0x5e, 0xfb, 0x23, 0x7b, 0x9d, 0xa1, 0xf5, 0x2f, 0xaf, 0xef, 0xb5, 0x4d,
+	0x63, 0xbf, 0x6d, 0x1a, 0xdf, 0xdb, 0xa6, 0xf1, 0xb2, 0x63, 0xa6, 0xf6, 0x3b, 0x66, 0xea, 0x6b,
+	0xc7, 0x4c, 0x3d, 0x2a, 0x10, 0x1a, 0x6d, 0x37, 0x5d, 0xbb, 0xc6, 0xfd, 0x1e, 0x91, 0xfa, 0x57,
+	0x10, 0xf5, 0x1d, 0xe7, 0xf1, 0x80, 0x35, 0xda, 0x0d, 0xb0, 0x70, 0xe7, 0xe4, 0xef, 0xc6, 0x85,
+	0x5f, 0x03, 0x00, 0xbd, 0xff, 0xff, 0x6b, 0x54, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -380,6 +482,9 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed blocks bitmap of the current signed blocks
+	// window of given cons address
+	MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error)
 }
 
 type queryClient struct {
@@ -417,6 +522,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) MissedBlocks(ctx context.Context, in *QueryMissedBlocksRequest, opts ...grpc.CallOption) (*QueryMissedBlocksResponse, error) {
+	out := new(QueryMissedBlocksResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/MissedBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -425,6 +539,9 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// MissedBlocks queries the missed blocks bitmap of the current signed blocks
+	// window of given cons address
+	MissedBlocks(context.Context, *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -440,6 +557,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) MissedBlocks(ctx context.Context, req *QueryMissedBlocksRequest) (*QueryMissedBlocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlocks not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -499,6 +619,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MissedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/MissedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlocks(ctx, req.(*QueryMissedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -515,6 +653,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "MissedBlocks",
+			Handler:    _Query_MissedBlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -723,6 +865,73 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMissedBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissedBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -810,6 +1019,34 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMissedBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1320,6 +1557,172 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMissedBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, MissedBlock{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
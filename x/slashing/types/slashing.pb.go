@@ -132,6 +132,14 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// downtime_escalation_period is the period during which previous downtime
+	// jails of a validator escalate its downtime penalties. A zero period
+	// disables the escalation.
+	DowntimeEscalationPeriod time.Duration `protobuf:"bytes,6,opt,name=downtime_escalation_period,json=downtimeEscalationPeriod,proto3,stdduration" json:"downtime_escalation_period"`
+	// downtime_escalation_multiplier is the factor by which the downtime slash
+	// fraction and jail duration are multiplied for each previous downtime jail
+	// of the validator within the escalation period.
+	DowntimeEscalationMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=downtime_escalation_multiplier,json=downtimeEscalationMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_escalation_multiplier"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -181,9 +189,73 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeEscalationPeriod() time.Duration {
+	if m != nil {
+		return m.DowntimeEscalationPeriod
+	}
+	return 0
+}
+
+// ValidatorDowntimeJails defines the times at which a validator was jailed for
+// downtime within the downtime escalation period.
+type ValidatorDowntimeJails struct {
+	// address is the validator consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// jail_times are the times of the downtime jails, in ascending order.
+	JailTimes []time.Time `protobuf:"bytes,2,rep,name=jail_times,json=jailTimes,proto3,stdtime" json:"jail_times"`
+}
+
+func (m *ValidatorDowntimeJails) Reset()         { *m = ValidatorDowntimeJails{} }
+func (m *ValidatorDowntimeJails) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDowntimeJails) ProtoMessage()    {}
+func (*ValidatorDowntimeJails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *ValidatorDowntimeJails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDowntimeJails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDowntimeJails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDowntimeJails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDowntimeJails.Merge(m, src)
+}
+func (m *ValidatorDowntimeJails) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDowntimeJails) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDowntimeJails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDowntimeJails proto.InternalMessageInfo
+
+func (m *ValidatorDowntimeJails) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorDowntimeJails) GetJailTimes() []time.Time {
+	if m != nil {
+		return m.JailTimes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*ValidatorDowntimeJails)(nil), "cosmos.slashing.v1beta1.ValidatorDowntimeJails")
 }
 
 func init() {
@@ -191,46 +263,51 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x3f, 0x4f, 0xdb, 0x4e,
-	0x18, 0xce, 0x01, 0x3f, 0x7e, 0xf4, 0x42, 0x87, 0x5e, 0x43, 0x31, 0x51, 0x65, 0x07, 0x06, 0x14,
-	0x21, 0x61, 0x97, 0xb0, 0xb1, 0x35, 0x45, 0x55, 0xff, 0x49, 0x45, 0xa1, 0x7f, 0xa4, 0x0e, 0xb5,
-	0xce, 0xbe, 0xb3, 0x73, 0xc5, 0xbe, 0x8b, 0x7c, 0xe7, 0x42, 0xf7, 0x4e, 0x9d, 0x18, 0x19, 0x19,
-	0x19, 0x19, 0x3a, 0xf6, 0x03, 0x30, 0xa2, 0x4e, 0x55, 0x07, 0x5a, 0x85, 0x81, 0x7e, 0x8c, 0xca,
-	0x77, 0x76, 0x88, 0x40, 0xea, 0xc0, 0x92, 0xd8, 0xcf, 0xf3, 0xbc, 0xcf, 0x7b, 0xcf, 0xfb, 0x9e,
-	0xe1, 0x72, 0x28, 0x64, 0x2a, 0xa4, 0x27, 0x13, 0x2c, 0xfb, 0x8c, 0xc7, 0xde, 0xc7, 0xb5, 0x80,
-	0x2a, 0xbc, 0x36, 0x02, 0xdc, 0x41, 0x26, 0x94, 0x40, 0xf3, 0x46, 0xe7, 0x8e, 0xe0, 0x52, 0xd7,
-	0x6c, 0xc4, 0x22, 0x16, 0x5a, 0xe3, 0x15, 0x4f, 0x46, 0xde, 0xb4, 0x63, 0x21, 0xe2, 0x84, 0x7a,
-	0xfa, 0x2d, 0xc8, 0x23, 0x8f, 0xe4, 0x19, 0x56, 0x4c, 0xf0, 0x92, 0x77, 0xae, 0xf2, 0x8a, 0xa5,
-	0x54, 0x2a, 0x9c, 0x0e, 0x4a, 0xc1, 0x82, 0xe9, 0xe7, 0x1b, 0xe7, 0xb2, 0xb9, 0xa1, 0xee, 0xe0,
-	0x94, 0x71, 0xe1, 0xe9, 0x5f, 0x03, 0x2d, 0x7d, 0x9b, 0x80, 0x8d, 0x37, 0x38, 0x61, 0x04, 0x2b,
-	0x91, 0x6d, 0xb3, 0x98, 0x33, 0x1e, 0x3f, 0xe5, 0x91, 0x40, 0x1d, 0xf8, 0x3f, 0x26, 0x24, 0xa3,
-	0x52, 0x5a, 0xa0, 0x05, 0xda, 0xb7, 0xba, 0xd6, 0xf7, 0xaf, 0xab, 0x8d, 0xd2, 0xee, 0xa1, 0x61,
-	0xb6, 0x55, 0xc6, 0x78, 0xdc, 0xab, 0x84, 0x68, 0x11, 0xce, 0x4a, 0x85, 0x33, 0xe5, 0xf7, 0x29,
-	0x8b, 0xfb, 0xca, 0x9a, 0x68, 0x81, 0xf6, 0x64, 0xaf, 0xae, 0xb1, 0x27, 0x1a, 0x2a, 0x24, 0x8c,
-	0x13, 0xba, 0xe7, 0x8b, 0x28, 0x92, 0x54, 0x59, 0x93, 0x46, 0xa2, 0xb1, 0x97, 0x1a, 0x42, 0x2f,
-	0xe0, 0xec, 0x07, 0xcc, 0x12, 0x4a, 0xfc, 0x9c, 0x2b, 0x96, 0x58, 0x53, 0x2d, 0xd0, 0xae, 0x77,
-	0x9a, 0xae, 0x09, 0xee, 0x56, 0xc1, 0xdd, 0x57, 0x55, 0xf0, 0xee, 0xed, 0x93, 0x33, 0xa7, 0xb6,
-	0xff, 0xcb, 0x01, 0x47, 0x17, 0xc7, 0x2b, 0xa0, 0x57, 0x37, 0xe5, 0xaf, 0x8b, 0x6a, 0x64, 0x43,
-	0xa8, 0x44, 0x1a, 0x48, 0x25, 0x38, 0x25, 0xd6, 0x7f, 0x2d, 0xd0, 0x9e, 0xe9, 0x8d, 0x21, 0xa8,
-	0x03, 0xe7, 0x52, 0x26, 0x25, 0x25, 0x7e, 0x90, 0x88, 0x70, 0x47, 0xfa, 0xa1, 0xc8, 0xb9, 0xa2,
-	0x99, 0x35, 0xad, 0x4f, 0x76, 0xd7, 0x90, 0x5d, 0xcd, 0x3d, 0x32, 0xd4, 0xc6, 0xcc, 0xc1, 0xa1,
-	0x53, 0xfb, 0x73, 0xe8, 0x80, 0xa5, 0xcf, 0x53, 0x70, 0x7a, 0x0b, 0x67, 0x38, 0x95, 0xe8, 0x01,
-	0x6c, 0x48, 0x16, 0xf3, 0x4b, 0xa3, 0x5d, 0xc6, 0x89, 0xd8, 0xd5, 0xd3, 0x9b, 0xec, 0x21, 0xc3,
-	0x19, 0x9f, 0xb7, 0x9a, 0x41, 0x51, 0xd1, 0x9a, 0xfb, 0x65, 0xd5, 0x80, 0x66, 0x55, 0x49, 0x31,
-	0xb7, 0xd9, 0xee, 0x7a, 0x91, 0xea, 0xe7, 0x99, 0xb3, 0x1c, 0x33, 0xd5, 0xcf, 0x03, 0x37, 0x14,
-	0x69, 0xb9, 0xce, 0xf2, 0x6f, 0x55, 0x92, 0x1d, 0x4f, 0x7d, 0x1a, 0x50, 0xe9, 0x6e, 0xd2, 0xd0,
-	0x64, 0x47, 0x29, 0xe3, 0xdb, 0xda, 0x70, 0x8b, 0x66, 0x65, 0x9f, 0xf7, 0xf0, 0x1e, 0x11, 0xbb,
-	0xbc, 0xb8, 0x28, 0x7e, 0x31, 0x1a, 0xbf, 0xba, 0x52, 0x7a, 0xfa, 0xf5, 0xce, 0xc2, 0xb5, 0xd1,
-	0x6e, 0x96, 0x02, 0x33, 0xd9, 0x83, 0xd1, 0x64, 0x1b, 0x95, 0xcf, 0x33, 0xcc, 0x92, 0x4a, 0x84,
-	0x06, 0xb0, 0xa9, 0x2f, 0xb7, 0x1f, 0x65, 0x38, 0x2c, 0x10, 0x9f, 0x88, 0x3c, 0x48, 0xa8, 0x4e,
-	0xa6, 0xd7, 0x77, 0xc3, 0x30, 0xf3, 0xda, 0xf6, 0x71, 0xe9, 0xba, 0xa9, 0x4d, 0x8b, 0x70, 0x68,
-	0x07, 0xce, 0x5f, 0xeb, 0x68, 0x0e, 0xa6, 0x37, 0x7c, 0xc3, 0x76, 0x73, 0x57, 0xda, 0x19, 0xc7,
-	0x8d, 0xc5, 0x2f, 0x17, 0xc7, 0x2b, 0xf7, 0xc7, 0xca, 0xf6, 0x2e, 0xbf, 0x79, 0xb3, 0xfb, 0xee,
-	0xf3, 0xa3, 0xa1, 0x0d, 0x4e, 0x86, 0x36, 0x38, 0x1d, 0xda, 0xe0, 0xf7, 0xd0, 0x06, 0xfb, 0xe7,
-	0x76, 0xed, 0xf4, 0xdc, 0xae, 0xfd, 0x38, 0xb7, 0x6b, 0xef, 0x56, 0xff, 0x79, 0x88, 0x31, 0x37,
-	0x7d, 0x9e, 0x60, 0x5a, 0xaf, 0x61, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x46, 0x11,
-	0xae, 0x61, 0x04, 0x00, 0x00,
+	// 692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3f, 0x4f, 0xdb, 0x4e,
+	0x18, 0x8e, 0x09, 0x04, 0xb8, 0xf0, 0x1b, 0x7e, 0xd7, 0x00, 0x26, 0x42, 0x4e, 0x60, 0x40, 0x11,
+	0x12, 0x71, 0x09, 0x1b, 0x5b, 0xd3, 0xb4, 0xa2, 0xff, 0x54, 0x14, 0xfa, 0x47, 0xea, 0x50, 0xeb,
+	0x6c, 0x9f, 0x9d, 0x2b, 0xf6, 0x5d, 0xe4, 0x3b, 0x17, 0xf8, 0x0a, 0x9d, 0x98, 0x2a, 0x46, 0x46,
+	0x46, 0x86, 0x8e, 0xfd, 0x00, 0x8c, 0xa8, 0x53, 0xd5, 0x81, 0x56, 0x61, 0xa0, 0x1f, 0xa3, 0xf2,
+	0x9d, 0x6d, 0x22, 0xa8, 0x2a, 0x91, 0x25, 0x7f, 0xde, 0xf7, 0x79, 0x9f, 0xc7, 0xcf, 0x73, 0xaf,
+	0x0f, 0xac, 0x38, 0x8c, 0x87, 0x8c, 0x9b, 0x3c, 0x40, 0xbc, 0x47, 0xa8, 0x6f, 0x7e, 0x5c, 0xb7,
+	0xb1, 0x40, 0xeb, 0x79, 0xa1, 0xd9, 0x8f, 0x98, 0x60, 0x70, 0x5e, 0xe1, 0x9a, 0x79, 0x39, 0xc5,
+	0x55, 0x2b, 0x3e, 0xf3, 0x99, 0xc4, 0x98, 0xc9, 0x2f, 0x05, 0xaf, 0x1a, 0x3e, 0x63, 0x7e, 0x80,
+	0x4d, 0xf9, 0xcf, 0x8e, 0x3d, 0xd3, 0x8d, 0x23, 0x24, 0x08, 0xa3, 0x69, 0xbf, 0x76, 0xb3, 0x2f,
+	0x48, 0x88, 0xb9, 0x40, 0x61, 0x3f, 0x05, 0x2c, 0x28, 0x3d, 0x4b, 0x31, 0xa7, 0xe2, 0xaa, 0xf5,
+	0x3f, 0x0a, 0x09, 0x65, 0xa6, 0xfc, 0x54, 0xa5, 0xe5, 0xaf, 0x63, 0xa0, 0xf2, 0x06, 0x05, 0xc4,
+	0x45, 0x82, 0x45, 0x3b, 0xc4, 0xa7, 0x84, 0xfa, 0x4f, 0xa8, 0xc7, 0x60, 0x0b, 0x4c, 0x22, 0xd7,
+	0x8d, 0x30, 0xe7, 0xba, 0x56, 0xd7, 0x1a, 0xd3, 0x6d, 0xfd, 0xdb, 0x97, 0xb5, 0x4a, 0x4a, 0xf7,
+	0x40, 0x75, 0x76, 0x44, 0x44, 0xa8, 0xdf, 0xcd, 0x80, 0x70, 0x09, 0xcc, 0x70, 0x81, 0x22, 0x61,
+	0xf5, 0x30, 0xf1, 0x7b, 0x42, 0x1f, 0xab, 0x6b, 0x8d, 0x62, 0xb7, 0x2c, 0x6b, 0x5b, 0xb2, 0x94,
+	0x40, 0x08, 0x75, 0xf1, 0xbe, 0xc5, 0x3c, 0x8f, 0x63, 0xa1, 0x17, 0x15, 0x44, 0xd6, 0x5e, 0xca,
+	0x12, 0x7c, 0x0e, 0x66, 0x3e, 0x20, 0x12, 0x60, 0xd7, 0x8a, 0xa9, 0x20, 0x81, 0x3e, 0x5e, 0xd7,
+	0x1a, 0xe5, 0x56, 0xb5, 0xa9, 0x8c, 0x37, 0x33, 0xe3, 0xcd, 0x57, 0x99, 0xf1, 0xf6, 0x7f, 0x67,
+	0x17, 0xb5, 0xc2, 0xe1, 0xcf, 0x9a, 0x76, 0x72, 0x75, 0xba, 0xaa, 0x75, 0xcb, 0x6a, 0xfc, 0x75,
+	0x32, 0x0d, 0x0d, 0x00, 0x04, 0x0b, 0x6d, 0x2e, 0x18, 0xc5, 0xae, 0x3e, 0x51, 0xd7, 0x1a, 0x53,
+	0xdd, 0xa1, 0x0a, 0x6c, 0x81, 0xd9, 0x90, 0x70, 0x8e, 0x5d, 0xcb, 0x0e, 0x98, 0xb3, 0xcb, 0x2d,
+	0x87, 0xc5, 0x54, 0xe0, 0x48, 0x2f, 0xc9, 0x27, 0xbb, 0xa7, 0x9a, 0x6d, 0xd9, 0x7b, 0xa8, 0x5a,
+	0x9b, 0x53, 0x47, 0xc7, 0xb5, 0xc2, 0xef, 0xe3, 0x9a, 0xb6, 0x7c, 0x35, 0x01, 0x4a, 0xdb, 0x28,
+	0x42, 0x21, 0x87, 0xf7, 0x41, 0x85, 0x13, 0x9f, 0x5e, 0x13, 0xed, 0x11, 0xea, 0xb2, 0x3d, 0x99,
+	0x5e, 0xb1, 0x0b, 0x55, 0x4f, 0xf1, 0xbc, 0x95, 0x1d, 0xe8, 0x25, 0xd2, 0xd4, 0x4a, 0xa7, 0xfa,
+	0x38, 0xca, 0x46, 0x92, 0xdc, 0x66, 0xda, 0x1b, 0x89, 0xab, 0x1f, 0x17, 0xb5, 0x15, 0x9f, 0x88,
+	0x5e, 0x6c, 0x37, 0x1d, 0x16, 0xa6, 0xc7, 0x99, 0x7e, 0xad, 0x71, 0x77, 0xd7, 0x14, 0x07, 0x7d,
+	0xcc, 0x9b, 0x1d, 0xec, 0x28, 0xef, 0x30, 0x24, 0x74, 0x47, 0x12, 0x6e, 0xe3, 0x28, 0xd5, 0x79,
+	0x0f, 0xe6, 0x5c, 0xb6, 0x47, 0x93, 0x45, 0xb1, 0x92, 0x68, 0xac, 0x6c, 0xa5, 0x64, 0xfa, 0xe5,
+	0xd6, 0xc2, 0xad, 0x68, 0x3b, 0x29, 0x40, 0x25, 0x7b, 0x94, 0x27, 0x5b, 0xc9, 0x78, 0x9e, 0x22,
+	0x12, 0x64, 0x20, 0xd8, 0x07, 0x55, 0xb9, 0xdc, 0x96, 0x17, 0x21, 0x27, 0xa9, 0x58, 0x2e, 0x8b,
+	0xed, 0x00, 0x4b, 0x67, 0xfa, 0xf8, 0xe8, 0x66, 0xe6, 0x25, 0xed, 0xe3, 0x94, 0xb5, 0x23, 0x49,
+	0x13, 0x73, 0x70, 0x17, 0xcc, 0xdf, 0x52, 0x54, 0x0f, 0xa6, 0x4f, 0x8c, 0x2e, 0x37, 0x7b, 0x43,
+	0x4e, 0x31, 0x42, 0x0f, 0x54, 0xf3, 0xf8, 0x30, 0x77, 0x50, 0x20, 0x5d, 0x27, 0xe7, 0x45, 0x98,
+	0xab, 0x97, 0xee, 0x18, 0xa1, 0x9e, 0x71, 0x3d, 0xca, 0xa9, 0xb6, 0x25, 0x13, 0x3c, 0x00, 0xc6,
+	0xdf, 0x74, 0xc2, 0x38, 0x10, 0xa4, 0x1f, 0x10, 0x1c, 0xe9, 0x93, 0xa3, 0x7b, 0x5b, 0xbc, 0x2d,
+	0xfb, 0x22, 0x27, 0xde, 0x5c, 0xfa, 0x74, 0x75, 0xba, 0xba, 0x38, 0x34, 0xbd, 0x7f, 0x7d, 0xad,
+	0xa9, 0xf5, 0x5e, 0xfe, 0xac, 0x81, 0xb9, 0xfc, 0xa2, 0xe8, 0x0c, 0xad, 0x01, 0x1f, 0xe9, 0xaa,
+	0xd8, 0x02, 0x40, 0xae, 0x62, 0xc2, 0xc2, 0xf5, 0xb1, 0x7a, 0xf1, 0x6e, 0xaf, 0xf8, 0x74, 0x32,
+	0x2c, 0xbb, 0xed, 0x67, 0x27, 0x03, 0x43, 0x3b, 0x1b, 0x18, 0xda, 0xf9, 0xc0, 0xd0, 0x7e, 0x0d,
+	0x0c, 0xed, 0xf0, 0xd2, 0x28, 0x9c, 0x5f, 0x1a, 0x85, 0xef, 0x97, 0x46, 0xe1, 0xdd, 0xda, 0x3f,
+	0x43, 0x1a, 0xb2, 0x29, 0xf3, 0xb2, 0x4b, 0x52, 0x7a, 0xe3, 0xcf, 0x00, 0x49, 0x97, 0x49, 0x72,
+	0xdd, 0x05, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -306,6 +383,44 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.DowntimeEscalationPeriod != that1.DowntimeEscalationPeriod {
+		return false
+	}
+	if !this.DowntimeEscalationMultiplier.Equal(that1.DowntimeEscalationMultiplier) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorDowntimeJails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorDowntimeJails)
+	if !ok {
+		that2, ok := that.(ValidatorDowntimeJails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.JailTimes) != len(that1.JailTimes) {
+		return false
+	}
+	for i := range this.JailTimes {
+		if !this.JailTimes[i].Equal(that1.JailTimes[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -391,6 +506,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DowntimeEscalationMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeEscalationMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeEscalationPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeEscalationPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -411,12 +544,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -437,6 +570,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorDowntimeJails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDowntimeJails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDowntimeJails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JailTimes) > 0 {
+		for iNdEx := len(m.JailTimes) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailTimes[iNdEx], dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailTimes[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintSlashing(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -492,6 +667,29 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeEscalationPeriod)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeEscalationMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *ValidatorDowntimeJails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if len(m.JailTimes) > 0 {
+		for _, e := range m.JailTimes {
+			l = github_com_cosmos_gogoproto_types.SizeOfStdTime(e)
+			n += 1 + l + sovSlashing(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -873,6 +1071,188 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeEscalationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeEscalationMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeEscalationMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorDowntimeJails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorDowntimeJails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorDowntimeJails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailTimes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailTimes = append(m.JailTimes, time.Time{})
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&(m.JailTimes[len(m.JailTimes)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -23,5 +23,11 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 func EndBlocker(ctx sdk.Context, k *keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	// enforce the minimum requirements first so that validators jailed for not
+	// meeting them leave the validator set in this block
+	if err := k.EnforceMinRequirements(ctx); err != nil {
+		panic(err)
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
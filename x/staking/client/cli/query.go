@@ -39,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryConsPubKeyRotationHistory(),
 	)
 
 	return stakingQueryCmd
@@ -673,6 +674,50 @@ $ %s query staking historical-info 5
 	return cmd
 }
 
+// GetCmdQueryConsPubKeyRotationHistory implements the consensus pubkey rotation history query command.
+func GetCmdQueryConsPubKeyRotationHistory() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "cons-pubkey-rotation-history [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the consensus pubkey rotations of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consensus pubkey rotations performed by a validator.
+
+Example:
+$ %s query staking cons-pubkey-rotation-history %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryConsPubKeyRotationHistoryRequest{ValidatorAddr: valAddr.String()}
+			res, err := queryClient.ConsPubKeyRotationHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryPool implements the pool query command.
 func GetCmdQueryPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -48,6 +48,7 @@ func NewTxCmd() *cobra.Command {
 		NewRedelegateCmd(),
 		NewUnbondCmd(),
 		NewCancelUnbondingDelegation(),
+		NewRotateConsPubKeyCmd(),
 	)
 
 	return stakingTxCmd
@@ -340,6 +341,47 @@ $ %s tx staking cancel-unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake
 	return cmd
 }
 
+// NewRotateConsPubKeyCmd returns a CLI command handler for creating a MsgRotateConsPubKey transaction.
+func NewRotateConsPubKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Short: "Rotate the consensus public key of the validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Rotate the consensus public key of the validator operated by the sender.
+The key rotation fee defined by the staking params is deducted from the sender.
+
+Example:
+$ %s tx staking rotate-cons-pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"oWg2ISpLF405Jcm2vXV+2v4fnjodh6aafuIdeoW+rUw="}' --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateConsPubKey(sdk.ValAddress(clientCtx.GetFromAddress()), pk)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, *types.MsgCreateValidator, error) {
 	fAmount, _ := fs.GetString(FlagAmount)
 	amount, err := sdk.ParseCoinNormalized(fAmount)
@@ -45,6 +45,14 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateConsPubKeyRotationHistory(data.ConsPubkeyRotationHistory, data.Validators); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateMinRequirementsDeadlines(data.MinRequirementsDeadlines, data.Validators); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -85,3 +93,66 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateConsPubKeyRotationHistory(histories []types.ConsPubKeyRotationHistory, validators []types.Validator) error {
+	consAddrs := make(map[string]bool, len(validators)+len(histories))
+	for _, val := range validators {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		consAddrs[consAddr.String()] = true
+	}
+
+	for _, history := range histories {
+		if _, err := sdk.ValAddressFromBech32(history.OperatorAddress); err != nil {
+			return err
+		}
+
+		oldPk, err := history.OldConsPubKey()
+		if err != nil {
+			return err
+		}
+
+		if _, err := history.NewConsPubKey(); err != nil {
+			return err
+		}
+
+		oldConsAddr := sdk.GetConsAddress(oldPk).String()
+		if consAddrs[oldConsAddr] {
+			return fmt.Errorf("duplicate rotated consensus address in genesis state: validator %s, address %s", history.OperatorAddress, oldConsAddr)
+		}
+		consAddrs[oldConsAddr] = true
+
+		if err := history.Fee.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateGenesisStateMinRequirementsDeadlines(deadlines []types.ValidatorMinRequirementsDeadline, validators []types.Validator) error {
+	valAddrs := make(map[string]bool, len(validators))
+	for _, val := range validators {
+		valAddrs[val.OperatorAddress] = true
+	}
+
+	seen := make(map[string]bool, len(deadlines))
+	for _, deadline := range deadlines {
+		if _, err := sdk.ValAddressFromBech32(deadline.ValidatorAddress); err != nil {
+			return err
+		}
+
+		if !valAddrs[deadline.ValidatorAddress] {
+			return fmt.Errorf("minimum requirements deadline for unknown validator %s", deadline.ValidatorAddress)
+		}
+
+		if seen[deadline.ValidatorAddress] {
+			return fmt.Errorf("duplicate minimum requirements deadline for validator %s", deadline.ValidatorAddress)
+		}
+		seen[deadline.ValidatorAddress] = true
+	}
+
+	return nil
+}
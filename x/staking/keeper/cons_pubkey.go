@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RotateConsPubKey replaces the consensus pubkey of a validator with newPubKey.
+// The key rotation fee is deducted from the operator account and burned.
+//
+// The validator keeps its index entry for the old consensus address so that
+// infractions committed with the old key, e.g. in the blocks CometBFT still
+// signs with it or in evidence submitted later, are attributed to the
+// validator. If the validator is part of the last validator set, the CometBFT
+// validator set is updated at the end of the block.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	valAddr := validator.GetOperator()
+
+	if _, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPubKey)); found {
+		return types.ErrValidatorPubKeyExists
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetBlockConsPubKeyRotationHistoryKey(ctx.BlockHeight(), valAddr)) {
+		return types.ErrConsPubKeyRotationInProgress
+	}
+
+	params := k.GetParams(ctx)
+	if k.countConsPubKeyRotations(ctx, valAddr, params) >= params.MaxConsPubkeyRotations {
+		return types.ErrExceedingMaxConsPubKeyRotations
+	}
+
+	if params.KeyRotationFee.IsPositive() {
+		fee := sdk.NewCoins(params.KeyRotationFee)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.NotBondedPoolName, fee); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.NotBondedPoolName, fee); err != nil {
+			return err
+		}
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	pkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	validator.ConsensusPubkey = pkAny
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	history, err := types.NewConsPubKeyRotationHistory(
+		valAddr, oldPubKey, newPubKey, ctx.BlockHeight(), ctx.BlockTime(), params.KeyRotationFee,
+	)
+	if err != nil {
+		return err
+	}
+
+	k.SetConsPubKeyRotationHistory(ctx, history)
+	store.Set(types.GetBlockConsPubKeyRotationHistoryKey(history.Height, valAddr), k.cdc.MustMarshal(&history))
+
+	return k.Hooks().AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, params.KeyRotationFee)
+}
+
+// countConsPubKeyRotations returns the number of consensus pubkey rotations
+// the validator performed within the last unbonding period.
+func (k Keeper) countConsPubKeyRotations(ctx sdk.Context, valAddr sdk.ValAddress, params types.Params) (count uint32) {
+	for _, history := range k.GetValidatorConsPubKeyRotationHistory(ctx, valAddr) {
+		if history.Time.Add(params.UnbondingTime).After(ctx.BlockTime()) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// SetConsPubKeyRotationHistory sets a consensus pubkey rotation history record
+// and indexes the rotated consensus address by the validator.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	oldPubKey, err := history.OldConsPubKey()
+	if err != nil {
+		panic(err)
+	}
+
+	valAddr := history.GetOperator()
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetConsPubKeyRotationHistoryKey(valAddr, history.Height), k.cdc.MustMarshal(&history))
+	store.Set(types.GetValidatorByConsAddrKey(sdk.GetConsAddress(oldPubKey)), valAddr)
+}
+
+// GetValidatorConsPubKeyRotationHistory returns the consensus pubkey rotations
+// of a validator, ordered by height.
+func (k Keeper) GetValidatorConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorConsPubKeyRotationHistoryKey(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// GetAllConsPubKeyRotationHistory returns the consensus pubkey rotations of
+// all validators.
+func (k Keeper) GetAllConsPubKeyRotationHistory(ctx sdk.Context) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// removeConsPubKeyRotationHistory removes the rotation history of a validator
+// together with the index entries of its rotated consensus addresses.
+func (k Keeper) removeConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, history := range k.GetValidatorConsPubKeyRotationHistory(ctx, valAddr) {
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			panic(err)
+		}
+
+		store.Delete(types.GetValidatorByConsAddrKey(sdk.GetConsAddress(oldPubKey)))
+		store.Delete(types.GetConsPubKeyRotationHistoryKey(valAddr, history.Height))
+	}
+}
+
+// dequeueBlockConsPubKeyRotations returns the consensus pubkey rotations
+// performed at the given height, keyed by validator operator address, and
+// removes them from the store.
+func (k Keeper) dequeueBlockConsPubKeyRotations(ctx sdk.Context, height int64) map[string]types.ConsPubKeyRotationHistory {
+	store := ctx.KVStore(k.storeKey)
+	rotations := make(map[string]types.ConsPubKeyRotationHistory)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBlockConsPubKeyRotationHistoryPrefix(height))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		rotations[history.OperatorAddress] = history
+		store.Delete(iterator.Key())
+	}
+
+	return rotations
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestRotateConsPubKey() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	powers := []int64{100, 100}
+	var validators [2]stakingtypes.Validator
+	for i, power := range powers {
+		validators[i] = testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validators[i], _ = validators[i].AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validators[i] = stakingkeeper.TestingUpdateValidator(keeper, ctx, validators[i], true)
+		require.NoError(keeper.SetValidatorByConsAddr(ctx, validators[i]))
+	}
+
+	valAddr := validators[0].GetOperator()
+	oldPk, newPk := PKs[0], PKs[100]
+	params := keeper.GetParams(ctx)
+	fee := sdk.NewCoins(params.KeyRotationFee)
+
+	rotate := func(ctx sdk.Context, pk cryptotypes.PubKey) error {
+		msg, err := stakingtypes.NewMsgRotateConsPubKey(valAddr, pk)
+		require.NoError(err)
+		_, err = msgServer.RotateConsPubKey(ctx, msg)
+		return err
+	}
+
+	// rotating to a key in use by another validator fails
+	require.ErrorIs(rotate(ctx, PKs[1]), stakingtypes.ErrValidatorPubKeyExists)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.NotBondedPoolName, fee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, fee).Return(nil)
+	require.NoError(rotate(ctx, newPk))
+
+	// the validator can be found by both its new and old consensus address
+	validator, found := keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(newPk))
+	require.True(found)
+	require.Equal(valAddr, validator.GetOperator())
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+	require.Equal(sdk.GetConsAddress(newPk), consAddr)
+
+	validator, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(oldPk))
+	require.True(found)
+	require.Equal(valAddr, validator.GetOperator())
+
+	history := keeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+	require.Len(history, 1)
+	require.Equal(ctx.BlockHeight(), history[0].Height)
+	require.Equal(params.KeyRotationFee, history[0].Fee)
+
+	// only one rotation per block and validator is allowed
+	require.ErrorIs(rotate(ctx, PKs[101]), stakingtypes.ErrConsPubKeyRotationInProgress)
+
+	// rotating back to the old key is not allowed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.ErrorIs(rotate(ctx, oldPk), stakingtypes.ErrValidatorPubKeyExists)
+
+	// the old key is replaced by the new one in the CometBFT validator set
+	updates := s.applyValidatorSetUpdates(ctx.WithBlockHeight(ctx.BlockHeight()-1), keeper, 2)
+	validator, _ = keeper.GetValidator(ctx, valAddr)
+	require.Equal(validator.ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	require.Equal(history[0].ABCIValidatorUpdateZero(), updates[1])
+	s.applyValidatorSetUpdates(ctx, keeper, 0)
+
+	// the number of rotations within the unbonding period is limited
+	require.ErrorIs(rotate(ctx, PKs[101]), stakingtypes.ErrExceedingMaxConsPubKeyRotations)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.UnbondingTime).Add(time.Second))
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.NotBondedPoolName, fee).Return(nil)
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, fee).Return(nil)
+	require.NoError(rotate(ctx, PKs[101]))
+	require.Len(keeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr), 2)
+
+	res, err := s.queryClient.ConsPubKeyRotationHistory(ctx, &stakingtypes.QueryConsPubKeyRotationHistoryRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Len(res.History, 2)
+}
+
+func (s *KeeperTestSuite) TestRotateConsPubKeyUnbondedValidator() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+
+	params := keeper.GetParams(ctx)
+	params.KeyRotationFee = sdk.NewInt64Coin(params.BondDenom, 0)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	require.NoError(keeper.RotateConsPubKey(ctx, validator, PKs[100]))
+
+	// a validator outside the last validator set joins with its new key only
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	updates := s.applyValidatorSetUpdates(ctx, keeper, 1)
+	validator, _ = keeper.GetValidator(ctx, validator.GetOperator())
+	require.Equal(validator.ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+	require.Equal(sdk.GetConsAddress(PKs[100]), consAddr)
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -815,18 +818,27 @@ func (k Keeper) getBeginInfo(
 func (k Keeper) Undelegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
 ) (time.Time, error) {
+	completionTime, _, err := k.undelegate(ctx, delAddr, valAddr, sharesAmount)
+	return completionTime, err
+}
+
+// undelegate implements Undelegate, and also returns the amount of tokens
+// being unbonded.
+func (k Keeper) undelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
+) (time.Time, math.Int, error) {
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
-		return time.Time{}, types.ErrNoDelegatorForAddress
+		return time.Time{}, math.Int{}, types.ErrNoDelegatorForAddress
 	}
 
 	if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
-		return time.Time{}, types.ErrMaxUnbondingDelegationEntries
+		return time.Time{}, math.Int{}, types.ErrMaxUnbondingDelegationEntries
 	}
 
 	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount)
 	if err != nil {
-		return time.Time{}, err
+		return time.Time{}, math.Int{}, err
 	}
 
 	// transfer the validator tokens to the not bonded pool
@@ -838,7 +850,105 @@ func (k Keeper) Undelegate(
 	ubd := k.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), completionTime, returnAmount)
 	k.InsertUBDQueue(ctx, ubd, completionTime)
 
-	return completionTime, nil
+	return completionTime, returnAmount, nil
+}
+
+// TransferAndUndelegate transfers an amount of delegator shares from the
+// delegation of delAddr to a given validator to a delegation of destAddr, and
+// undelegates them like Undelegate, so that the unbonded tokens are returned
+// to destAddr at the end of the unbonding period. It is used to claw back the
+// delegated coins of vesting accounts. It returns the completion time of the
+// unbonding and the amount of tokens being unbonded.
+func (k Keeper) TransferAndUndelegate(
+	ctx sdk.Context, delAddr, destAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
+) (time.Time, math.Int, error) {
+	if err := k.transferDelegation(ctx, delAddr, destAddr, valAddr, sharesAmount); err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	completionTime, returnAmount, err := k.undelegate(ctx, destAddr, valAddr, sharesAmount)
+	if err != nil {
+		return time.Time{}, math.Int{}, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbond,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, destAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(k.BondDenom(ctx), returnAmount).String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+
+	return completionTime, returnAmount, nil
+}
+
+// transferDelegation moves an amount of delegator shares from the delegation
+// of delAddr to a given validator to the delegation of destAddr, creating it
+// if needed. The validator tokens and shares are left unchanged.
+func (k Keeper) transferDelegation(
+	ctx sdk.Context, delAddr, destAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) error {
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+
+	// ensure that we have enough shares to transfer
+	if delegation.Shares.LT(shares) {
+		return sdkerrors.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	// call the before-delegation-modified hook
+	if err := k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+		return err
+	}
+
+	delegation.Shares = delegation.Shares.Sub(shares)
+
+	// If the delegation is the operator of the validator and transferring will decrease the validator's
+	// self-delegation below their minimum, we jail the validator.
+	if delAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	var err error
+	if delegation.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, delegation)
+	} else {
+		k.SetDelegation(ctx, delegation)
+		// call the after delegation modification hook
+		err = k.Hooks().AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	destDelegation, found := k.GetDelegation(ctx, destAddr, valAddr)
+	if found {
+		err = k.Hooks().BeforeDelegationSharesModified(ctx, destAddr, valAddr)
+	} else {
+		destDelegation = types.NewDelegation(destAddr, valAddr, sdk.ZeroDec())
+		err = k.Hooks().BeforeDelegationCreated(ctx, destAddr, valAddr)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	destDelegation.Shares = destDelegation.Shares.Add(shares)
+	k.SetDelegation(ctx, destDelegation)
+
+	// call the after delegation modification hook
+	return k.Hooks().AfterDelegationModified(ctx, destAddr, valAddr)
 }
 
 // CompleteUnbonding completes the unbonding of all mature entries in the
@@ -891,6 +1001,101 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delAddr sdk.AccAddress, valAd
 	return balances, nil
 }
 
+// CancelUnbondingDelegation cancels amount of the unbonding delegation entry
+// created at creationHeight and delegates it back to the validator it was
+// unbonding from. An entry which is canceled in full is removed together with
+// its unbonding queue and unbonding ID records.
+func (k Keeper) CancelUnbondingDelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, creationHeight int64, amount math.Int,
+) (newShares sdk.Dec, err error) {
+	valAddr := validator.GetOperator()
+
+	ubd, found := k.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return math.LegacyZeroDec(), status.Errorf(
+			codes.NotFound,
+			"unbonding delegation with delegator %s not found for validator %s",
+			delAddr, valAddr,
+		)
+	}
+
+	entryIndex := -1
+	for i, entry := range ubd.Entries {
+		if entry.CreationHeight == creationHeight {
+			entryIndex = i
+			break
+		}
+	}
+	if entryIndex == -1 {
+		return math.LegacyZeroDec(), sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", creationHeight)
+	}
+
+	entry := ubd.Entries[entryIndex]
+	if entry.Balance.LT(amount) {
+		return math.LegacyZeroDec(), sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the unbonding delegation entry balance")
+	}
+
+	if entry.CompletionTime.Before(ctx.BlockHeader().Time) {
+		return math.LegacyZeroDec(), sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
+	}
+
+	// delegate back the unbonding delegation amount to the validator, the
+	// tokens are still held by the not bonded pool
+	newShares, err = k.Delegate(ctx, delAddr, amount, types.Unbonding, validator, false)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	remaining := entry.Balance.Sub(amount)
+	if remaining.IsZero() {
+		ubd.RemoveEntry(int64(entryIndex))
+		k.DeleteUnbondingIndex(ctx, entry.UnbondingId)
+		k.removeUBDQueueEntry(ctx, ubd, entry.CompletionTime)
+	} else {
+		// Scale the initial balance by the part of the balance that is left, so
+		// an entry that was already slashed keeps its slashed ratio and later
+		// slashes for infractions before its creation are not overestimated.
+		entry.InitialBalance = entry.InitialBalance.Mul(remaining).Quo(entry.Balance)
+		entry.Balance = remaining
+		ubd.Entries[entryIndex] = entry
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return newShares, nil
+}
+
+// removeUBDQueueEntry removes the delegator/validator pair of ubd from the
+// unbonding queue timeslice at completionTime, unless another entry of ubd
+// still completes at that time. Empty timeslices are deleted.
+func (k Keeper) removeUBDQueueEntry(ctx sdk.Context, ubd types.UnbondingDelegation, completionTime time.Time) {
+	for _, entry := range ubd.Entries {
+		if entry.CompletionTime.Equal(completionTime) {
+			return
+		}
+	}
+
+	timeSlice := k.GetUBDQueueTimeSlice(ctx, completionTime)
+	pairs := make([]types.DVPair, 0, len(timeSlice))
+	for _, pair := range timeSlice {
+		if pair.DelegatorAddress != ubd.DelegatorAddress || pair.ValidatorAddress != ubd.ValidatorAddress {
+			pairs = append(pairs, pair)
+		}
+	}
+
+	if len(pairs) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.GetUnbondingDelegationTimeKey(completionTime))
+		return
+	}
+
+	k.SetUBDQueueTimeSlice(ctx, completionTime, pairs)
+}
+
 // BeginRedelegation begins unbonding / redelegation and creates a redelegation
 // record.
 func (k Keeper) BeginRedelegation(
@@ -5,9 +5,12 @@ import (
 
 	"cosmossdk.io/math"
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -224,6 +227,53 @@ func (s *KeeperTestSuite) TestUnbondDelegation() {
 	require.Equal(remainingTokens, validator.BondedTokens())
 }
 
+func (s *KeeperTestSuite) TestTransferAndUndelegate() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	delAddr, destAddr := delAddrs[0], delAddrs[1]
+	startTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+
+	validator, issuedShares := validator.AddTokensFromDel(startTokens)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_ = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(delAddr, valAddrs[0], issuedShares))
+
+	// transferring more shares than delegated fails
+	_, _, err := keeper.TransferAndUndelegate(ctx, delAddr, destAddr, valAddrs[0], issuedShares.MulInt64(2))
+	require.ErrorIs(err, stakingtypes.ErrNotEnoughDelegationShares)
+
+	unbondTokens := keeper.TokensFromConsensusPower(ctx, 6)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+	completionTime, amount, err := keeper.TransferAndUndelegate(ctx, delAddr, destAddr, valAddrs[0], sdk.NewDecFromInt(unbondTokens))
+	require.NoError(err)
+	require.Equal(unbondTokens, amount)
+	require.Equal(ctx.BlockTime().Add(keeper.UnbondingTime(ctx)), completionTime)
+
+	// the remaining shares are kept by the delegator, the destination is left
+	// with an unbonding delegation only
+	delegation, found := keeper.GetDelegation(ctx, delAddr, valAddrs[0])
+	require.True(found)
+	require.Equal(startTokens.Sub(unbondTokens), delegation.Shares.RoundInt())
+
+	_, found = keeper.GetDelegation(ctx, destAddr, valAddrs[0])
+	require.False(found)
+
+	_, found = keeper.GetUnbondingDelegation(ctx, delAddr, valAddrs[0])
+	require.False(found)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, destAddr, valAddrs[0])
+	require.True(found)
+	require.Len(ubd.Entries, 1)
+	require.Equal(unbondTokens, ubd.Entries[0].Balance)
+
+	validator, found = keeper.GetValidator(ctx, valAddrs[0])
+	require.True(found)
+	require.Equal(startTokens.Sub(unbondTokens), validator.BondedTokens())
+}
+
 // // test undelegating self delegation from a validator pushing it below MinSelfDelegation
 // // shift it from the bonded to unbonding state and jailed
 func (s *KeeperTestSuite) TestUndelegateSelfDelegationBelowMinSelfDelegation() {
@@ -846,3 +896,66 @@ func (s *KeeperTestSuite) TestRedelegateFromUnbondedValidator() {
 	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(found, "%v", red)
 }
+
+func (s *KeeperTestSuite) TestCancelUnbondingDelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddrs, valAddrs := createValAddrs(2)
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, validator)
+
+	firstTime := ctx.BlockTime().Add(time.Hour)
+	secondTime := firstTime.Add(time.Hour)
+	ubd := keeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 10, firstTime, sdk.NewInt(100))
+	keeper.InsertUBDQueue(ctx, ubd, firstTime)
+	ubd = keeper.SetUnbondingDelegationEntry(ctx, delAddrs[0], valAddrs[0], 11, secondTime, sdk.NewInt(50))
+	keeper.InsertUBDQueue(ctx, ubd, secondTime)
+
+	// slash the first entry by 20%
+	ubd.Entries[0].Balance = sdk.NewInt(80)
+	keeper.SetUnbondingDelegation(ctx, ubd)
+	firstID := ubd.Entries[0].UnbondingId
+
+	_, err := keeper.CancelUnbondingDelegation(ctx, delAddrs[1], validator, 10, sdk.NewInt(10))
+	require.Equal(codes.NotFound, status.Code(err))
+
+	_, err = keeper.CancelUnbondingDelegation(ctx, delAddrs[0], validator, 12, sdk.NewInt(10))
+	require.ErrorIs(err, sdkerrors.ErrNotFound)
+
+	_, err = keeper.CancelUnbondingDelegation(ctx, delAddrs[0], validator, 10, sdk.NewInt(81))
+	require.ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// partial cancellation keeps the slashed ratio of the entry
+	_, err = keeper.CancelUnbondingDelegation(ctx, delAddrs[0], validator, 10, sdk.NewInt(40))
+	require.NoError(err)
+
+	ubd, found := keeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(found)
+	require.Len(ubd.Entries, 2)
+	require.Equal(sdk.NewInt(40), ubd.Entries[0].Balance)
+	require.Equal(sdk.NewInt(50), ubd.Entries[0].InitialBalance)
+	require.Len(keeper.GetUBDQueueTimeSlice(ctx, firstTime), 1)
+
+	validator, found = keeper.GetValidator(ctx, valAddrs[0])
+	require.True(found)
+
+	// cancelling the rest removes the entry and its queue and index records
+	_, err = keeper.CancelUnbondingDelegation(ctx, delAddrs[0], validator, 10, sdk.NewInt(40))
+	require.NoError(err)
+
+	ubd, found = keeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(found)
+	require.Len(ubd.Entries, 1)
+	require.Equal(int64(11), ubd.Entries[0].CreationHeight)
+	require.Empty(keeper.GetUBDQueueTimeSlice(ctx, firstTime))
+	require.Len(keeper.GetUBDQueueTimeSlice(ctx, secondTime), 1)
+
+	_, found = keeper.GetUnbondingDelegationByUnbondingID(ctx, firstID)
+	require.False(found)
+
+	delegation, found := keeper.GetDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(found)
+	require.Equal(sdk.NewDec(80), delegation.Shares)
+}
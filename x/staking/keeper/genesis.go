@@ -63,6 +63,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, history := range data.ConsPubkeyRotationHistory {
+		k.SetConsPubKeyRotationHistory(ctx, history)
+	}
+
+	for _, deadline := range data.MinRequirementsDeadlines {
+		valAddr, err := sdk.ValAddressFromBech32(deadline.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetValidatorMinRequirementsDeadline(ctx, valAddr, deadline.Deadline)
+	}
+
 	for _, delegation := range data.Delegations {
 		delegatorAddress := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
 
@@ -194,13 +206,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	})
 
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		ConsPubkeyRotationHistory: k.GetAllConsPubKeyRotationHistory(ctx),
+		MinRequirementsDeadlines:  k.GetAllValidatorMinRequirementsDeadlines(ctx),
 	}
 }
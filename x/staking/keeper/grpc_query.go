@@ -464,6 +464,27 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// ConsPubKeyRotationHistory queries the consensus pubkey rotations of a validator
+func (k Querier) ConsPubKeyRotationHistory(c context.Context, req *types.QueryConsPubKeyRotationHistoryRequest) (*types.QueryConsPubKeyRotationHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	history := k.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+
+	return &types.QueryConsPubKeyRotationHistoryResponse{History: history}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
 	if err != nil {
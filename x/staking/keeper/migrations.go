@@ -2,12 +2,38 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 	v2 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v2"
 	v3 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v3"
 	v4 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v4"
+	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// legacyParamsSubspace wraps the legacy x/params subspace read by the v3 to v4
+// migration. The legacy param set has no values for the params introduced
+// after v4, which the v4 migration validates as part of the current params,
+// so they are filled in with valid values until the later migrations set them.
+type legacyParamsSubspace struct {
+	exported.Subspace
+}
+
+func (s legacyParamsSubspace) GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet) {
+	s.Subspace.GetParamSet(ctx, ps)
+
+	params, ok := ps.(*types.Params)
+	if !ok {
+		return
+	}
+
+	params.KeyRotationFee = sdk.NewCoin(params.BondDenom, types.DefaultKeyRotationFeeAmount)
+	params.MaxConsPubkeyRotations = types.DefaultMaxConsPubKeyRotations
+	params.MinSelfDelegation = types.DefaultMinSelfDelegation
+	params.MinRequirementsGracePeriod = types.DefaultMinRequirementsGracePeriod
+}
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper         *Keeper
@@ -34,5 +60,22 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 
 // Migrate3to4 migrates x/staking state from consensus version 3 to 4.
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
-	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.legacySubspace)
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, legacyParamsSubspace{m.legacySubspace})
+}
+
+// Migrate4to5 migrates x/staking state from consensus version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6. Existing
+// validators that do not meet the minimum requirements enter their grace
+// period.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	if err := v6.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+
+	m.keeper.ScheduleMinRequirementsEnforcement(ctx)
+	return nil
 }
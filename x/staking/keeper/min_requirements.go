@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// meetsMinRequirements returns true if the validator's commission rate and
+// declared minimum self delegation are not below the chain-wide minimums.
+func meetsMinRequirements(validator types.Validator, params types.Params) bool {
+	return validator.Commission.Rate.GTE(params.MinCommissionRate) &&
+		validator.MinSelfDelegation.GTE(params.MinSelfDelegation)
+}
+
+// GetValidatorMinRequirementsDeadline returns the time by which a validator
+// must meet the minimum requirements, if the validator is within its grace
+// period.
+func (k Keeper) GetValidatorMinRequirementsDeadline(ctx sdk.Context, valAddr sdk.ValAddress) (deadline time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetValidatorMinRequirementsDeadlineKey(valAddr))
+	if bz == nil {
+		return deadline, false
+	}
+
+	deadline, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return deadline, true
+}
+
+// SetValidatorMinRequirementsDeadline sets the time by which a validator must
+// meet the minimum requirements, and queues the validator for enforcement at
+// that time.
+func (k Keeper) SetValidatorMinRequirementsDeadline(ctx sdk.Context, valAddr sdk.ValAddress, deadline time.Time) {
+	k.DeleteValidatorMinRequirementsDeadline(ctx, valAddr)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorMinRequirementsDeadlineKey(valAddr), sdk.FormatTimeBytes(deadline))
+	store.Set(types.GetValidatorMinRequirementsQueueKey(deadline, valAddr), valAddr)
+}
+
+// DeleteValidatorMinRequirementsDeadline ends the grace period of a validator
+// and removes it from the enforcement queue.
+func (k Keeper) DeleteValidatorMinRequirementsDeadline(ctx sdk.Context, valAddr sdk.ValAddress) {
+	deadline, found := k.GetValidatorMinRequirementsDeadline(ctx, valAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorMinRequirementsDeadlineKey(valAddr))
+	store.Delete(types.GetValidatorMinRequirementsQueueKey(deadline, valAddr))
+}
+
+// MinRequirementsQueueIterator returns an iterator over the validators of the
+// minimum requirements enforcement queue whose deadline is at or before
+// endTime.
+func (k Keeper) MinRequirementsQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.ValidatorMinRequirementsQueueKey,
+		storetypes.PrefixEndBytes(types.GetValidatorMinRequirementsQueueTimePrefix(endTime)))
+}
+
+// GetAllValidatorMinRequirementsDeadlines returns the deadlines of all
+// validators within their grace period.
+func (k Keeper) GetAllValidatorMinRequirementsDeadlines(ctx sdk.Context) (deadlines []types.ValidatorMinRequirementsDeadline) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorMinRequirementsDeadlineKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// key is of format prefix || addrLen (1byte) || valAddr
+		valAddr := sdk.ValAddress(iterator.Key()[2:])
+
+		deadline, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		deadlines = append(deadlines, types.ValidatorMinRequirementsDeadline{
+			ValidatorAddress: valAddr.String(),
+			Deadline:         deadline,
+		})
+	}
+
+	return deadlines
+}
+
+// ScheduleMinRequirementsEnforcement starts the grace period for all
+// validators that do not meet the minimum commission rate or the minimum
+// self delegation. It must be called whenever the minimums are raised, e.g.
+// on a params update or from an upgrade handler. Validators that are already
+// within their grace period keep their original deadline.
+func (k Keeper) ScheduleMinRequirementsEnforcement(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	deadline := ctx.BlockTime().Add(params.MinRequirementsGracePeriod)
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		valAddr := validator.GetOperator()
+
+		if meetsMinRequirements(validator, params) {
+			continue
+		}
+
+		if _, found := k.GetValidatorMinRequirementsDeadline(ctx, valAddr); found {
+			continue
+		}
+
+		k.SetValidatorMinRequirementsDeadline(ctx, valAddr, deadline)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMinRequirementsGrace,
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, params.MinCommissionRate.String()),
+				sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, params.MinSelfDelegation.String()),
+				sdk.NewAttribute(types.AttributeKeyGracePeriodEnd, deadline.Format(time.RFC3339)),
+			),
+		)
+	}
+}
+
+// EnforceMinRequirements enforces the minimum requirements on all validators
+// whose grace period has ended. Their commission rate and declared minimum self
+// delegation are raised to the chain-wide minimums, and validators whose self
+// delegation is below the new minimum are jailed.
+func (k Keeper) EnforceMinRequirements(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+
+	// collect the due validators first so that the queue is not modified while
+	// it is being iterated.
+	var dueValidators []sdk.ValAddress
+
+	iterator := k.MinRequirementsQueueIterator(ctx, ctx.BlockTime())
+	for ; iterator.Valid(); iterator.Next() {
+		dueValidators = append(dueValidators, sdk.ValAddress(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, valAddr := range dueValidators {
+		k.DeleteValidatorMinRequirementsDeadline(ctx, valAddr)
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found || meetsMinRequirements(validator, params) {
+			continue
+		}
+
+		if err := k.enforceValidatorMinRequirements(ctx, validator, params); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) enforceValidatorMinRequirements(ctx sdk.Context, validator types.Validator, params types.Params) error {
+	valAddr := validator.GetOperator()
+
+	if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+		return err
+	}
+
+	if validator.Commission.Rate.LT(params.MinCommissionRate) {
+		validator.Commission.Rate = params.MinCommissionRate
+		if validator.Commission.MaxRate.LT(params.MinCommissionRate) {
+			validator.Commission.MaxRate = params.MinCommissionRate
+		}
+		validator.Commission.UpdateTime = ctx.BlockTime()
+	}
+
+	if validator.MinSelfDelegation.LT(params.MinSelfDelegation) {
+		validator.MinSelfDelegation = params.MinSelfDelegation
+	}
+
+	k.SetValidator(ctx, validator)
+
+	// jail the validator if its self delegation is below the raised minimum,
+	// the same as when the operator unbonds below it
+	selfDelegation := sdk.ZeroInt()
+	if delegation, found := k.GetDelegation(ctx, sdk.AccAddress(valAddr), valAddr); found {
+		selfDelegation = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+
+	if !validator.Jailed && selfDelegation.LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+		validator = k.mustGetValidator(ctx, valAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEnforceMinRequirements,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, validator.Commission.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
+			sdk.NewAttribute(types.AttributeKeyJailed, strconv.FormatBool(validator.Jailed)),
+		),
+	)
+
+	return nil
+}
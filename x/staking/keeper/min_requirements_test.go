@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestMinSelfDelegationOnCreateAndEdit() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.MinSelfDelegation = keeper.TokensFromConsensusPower(ctx, 10)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	_, addrVals := createValAddrs(1)
+	pkAny, err := codectypes.NewAnyWithValue(PKs[0])
+	require.NoError(err)
+
+	_, err = msgServer.CreateValidator(ctx, &stakingtypes.MsgCreateValidator{
+		Description:       stakingtypes.NewDescription("moniker", "", "", "", ""),
+		Commission:        stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+		MinSelfDelegation: keeper.TokensFromConsensusPower(ctx, 5),
+		DelegatorAddress:  sdk.AccAddress(addrVals[0]).String(),
+		ValidatorAddress:  addrVals[0].String(),
+		Pubkey:            pkAny,
+		Value:             sdk.NewCoin(params.BondDenom, keeper.TokensFromConsensusPower(ctx, 10)),
+	})
+	require.ErrorIs(err, stakingtypes.ErrMinSelfDelegationLTMinimum)
+
+	validator := testutil.NewValidator(s.T(), addrVals[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 20))
+	keeper.SetValidator(ctx, validator)
+
+	newMinSelfDelegation := keeper.TokensFromConsensusPower(ctx, 5)
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(addrVals[0], stakingtypes.Description{}, nil, &newMinSelfDelegation))
+	require.ErrorIs(err, stakingtypes.ErrMinSelfDelegationLTMinimum)
+
+	newMinSelfDelegation = keeper.TokensFromConsensusPower(ctx, 10)
+	_, err = msgServer.EditValidator(ctx, stakingtypes.NewMsgEditValidator(addrVals[0], stakingtypes.Description{}, nil, &newMinSelfDelegation))
+	require.NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMinRequirementsQueue() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, addrVals := createValAddrs(2)
+	now := ctx.BlockTime()
+
+	keeper.SetValidatorMinRequirementsDeadline(ctx, addrVals[0], now.Add(time.Hour))
+	keeper.SetValidatorMinRequirementsDeadline(ctx, addrVals[1], now.Add(2*time.Hour))
+
+	// moving a deadline moves the queue entry
+	keeper.SetValidatorMinRequirementsDeadline(ctx, addrVals[1], now.Add(time.Hour))
+	keeper.SetValidatorMinRequirementsDeadline(ctx, addrVals[0], now.Add(3*time.Hour))
+
+	var due []sdk.ValAddress
+	iterator := keeper.MinRequirementsQueueIterator(ctx, now.Add(2*time.Hour))
+	for ; iterator.Valid(); iterator.Next() {
+		due = append(due, sdk.ValAddress(iterator.Value()))
+	}
+	iterator.Close()
+	require.Equal([]sdk.ValAddress{addrVals[1]}, due)
+
+	keeper.DeleteValidatorMinRequirementsDeadline(ctx, addrVals[0])
+	iterator = keeper.MinRequirementsQueueIterator(ctx, now.Add(3*time.Hour))
+	defer iterator.Close()
+	require.True(iterator.Valid())
+	require.Equal(addrVals[1], sdk.ValAddress(iterator.Value()))
+	iterator.Next()
+	require.False(iterator.Valid())
+}
+
+func (s *KeeperTestSuite) TestEnforceMinRequirements() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+
+	// validator 0 self delegates 10 tokens, validator 1 self delegates 2 tokens
+	_, addrVals := createValAddrs(3)
+	for i, power := range []int64{10, 2, 10} {
+		validator := testutil.NewValidator(s.T(), addrVals[i], PKs[i])
+		validator, shares := validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+		keeper.SetDelegation(ctx, stakingtypes.NewDelegation(sdk.AccAddress(addrVals[i]), addrVals[i], shares))
+	}
+
+	// validator 2 already meets the raised minimums
+	validator, found := keeper.GetValidator(ctx, addrVals[2])
+	require.True(found)
+	validator.Commission = stakingtypes.NewCommission(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2))
+	validator.MinSelfDelegation = keeper.TokensFromConsensusPower(ctx, 5)
+	keeper.SetValidator(ctx, validator)
+
+	params := keeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.MinSelfDelegation = keeper.TokensFromConsensusPower(ctx, 5)
+	params.MinRequirementsGracePeriod = time.Hour
+	_, err := msgServer.UpdateParams(ctx, &stakingtypes.MsgUpdateParams{
+		Authority: keeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(err)
+
+	deadlines := keeper.GetAllValidatorMinRequirementsDeadlines(ctx)
+	require.Len(deadlines, 2)
+	for _, deadline := range deadlines {
+		require.NotEqual(addrVals[2].String(), deadline.ValidatorAddress)
+		require.Equal(ctx.BlockTime().Add(time.Hour), deadline.Deadline)
+	}
+
+	// rescheduling keeps the original deadline
+	keeper.ScheduleMinRequirementsEnforcement(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)))
+	require.Equal(deadlines, keeper.GetAllValidatorMinRequirementsDeadlines(ctx))
+
+	// nothing is enforced within the grace period
+	require.NoError(keeper.EnforceMinRequirements(ctx))
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.True(validator.Commission.Rate.IsZero())
+	require.Len(keeper.GetAllValidatorMinRequirementsDeadlines(ctx), 2)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(keeper.EnforceMinRequirements(ctx))
+	require.Empty(keeper.GetAllValidatorMinRequirementsDeadlines(ctx))
+
+	iterator := keeper.MinRequirementsQueueIterator(ctx, ctx.BlockTime().Add(time.Hour))
+	require.False(iterator.Valid(), "enforced validators are still queued")
+	iterator.Close()
+
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.Equal(params.MinCommissionRate, validator.Commission.Rate)
+	require.Equal(params.MinCommissionRate, validator.Commission.MaxRate)
+	require.Equal(params.MinSelfDelegation, validator.MinSelfDelegation)
+	require.False(validator.Jailed)
+
+	validator, _ = keeper.GetValidator(ctx, addrVals[1])
+	require.Equal(params.MinCommissionRate, validator.Commission.Rate)
+	require.Equal(params.MinSelfDelegation, validator.MinSelfDelegation)
+	require.True(validator.Jailed)
+
+	validator, _ = keeper.GetValidator(ctx, addrVals[2])
+	require.Equal(sdk.NewDecWithPrec(10, 2), validator.Commission.Rate)
+	require.False(validator.Jailed)
+}
@@ -6,8 +6,6 @@ import (
 	"time"
 
 	"github.com/armon/go-metrics"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -42,6 +40,10 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
 	}
 
+	if msg.MinSelfDelegation.LT(k.MinSelfDelegation(ctx)) {
+		return nil, sdkerrors.Wrapf(types.ErrMinSelfDelegationLTMinimum, "cannot set minimum self delegation to less than %s", k.MinSelfDelegation(ctx))
+	}
+
 	// check to see if the pubkey or sender has been registered before
 	if _, found := k.GetValidator(ctx, valAddr); found {
 		return nil, types.ErrValidatorOwnerExists
@@ -67,22 +69,8 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 		return nil, err
 	}
 
-	cp := ctx.ConsensusParams()
-	if cp != nil && cp.Validator != nil {
-		pkType := pk.Type()
-		hasKeyType := false
-		for _, keyType := range cp.Validator.PubKeyTypes {
-			if pkType == keyType {
-				hasKeyType = true
-				break
-			}
-		}
-		if !hasKeyType {
-			return nil, sdkerrors.Wrapf(
-				types.ErrValidatorPubKeyTypeNotSupported,
-				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
-			)
-		}
+	if err := validateConsPubKeyType(ctx, pk); err != nil {
+		return nil, err
 	}
 
 	validator, err := types.NewValidator(valAddr, pk, msg.Description)
@@ -175,6 +163,10 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 			return nil, types.ErrMinSelfDelegationDecreased
 		}
 
+		if msg.MinSelfDelegation.LT(k.MinSelfDelegation(ctx)) {
+			return nil, sdkerrors.Wrapf(types.ErrMinSelfDelegationLTMinimum, "cannot set minimum self delegation to less than %s", k.MinSelfDelegation(ctx))
+		}
+
 		if msg.MinSelfDelegation.GT(validator.Tokens) {
 			return nil, types.ErrSelfDelegationBelowMinimum
 		}
@@ -406,86 +398,101 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 		return nil, types.ErrValidatorJailed
 	}
 
-	ubd, found := k.GetUnbondingDelegation(ctx, delegatorAddress, valAddr)
-	if !found {
-		return nil, status.Errorf(
-			codes.NotFound,
-			"unbonding delegation with delegator %s not found for validator %s",
-			msg.DelegatorAddress, msg.ValidatorAddress,
-		)
+	if _, err := k.Keeper.CancelUnbondingDelegation(ctx, delegatorAddress, validator, msg.CreationHeight, msg.Amount.Amount); err != nil {
+		return nil, err
 	}
 
-	var (
-		unbondEntry      types.UnbondingDelegationEntry
-		unbondEntryIndex int64 = -1
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUnbondingDelegation,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+		),
 	)
 
-	for i, entry := range ubd.Entries {
-		if entry.CreationHeight == msg.CreationHeight {
-			unbondEntry = entry
-			unbondEntryIndex = int64(i)
-			break
-		}
+	return &types.MsgCancelUnbondingDelegationResponse{}, nil
+}
+
+func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if ms.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	// store params
+	if err := ms.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
 	}
-	if unbondEntryIndex == -1 {
-		return nil, sdkerrors.ErrNotFound.Wrapf("unbonding delegation entry is not found at block height %d", msg.CreationHeight)
+
+	// give existing validators a grace period to meet raised minimums
+	ms.ScheduleMinRequirementsEnforcement(ctx)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator
+func (k msgServer) RotateConsPubKey(goCtx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
 	}
 
-	if unbondEntry.Balance.LT(msg.Amount.Amount) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("amount is greater than the unbonding delegation entry balance")
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
 	}
 
-	if unbondEntry.CompletionTime.Before(ctx.BlockTime()) {
-		return nil, sdkerrors.ErrInvalidRequest.Wrap("unbonding delegation is already processed")
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
 	}
 
-	// delegate back the unbonding delegation amount to the validator
-	_, err = k.Keeper.Delegate(ctx, delegatorAddress, msg.Amount.Amount, types.Unbonding, validator, false)
-	if err != nil {
+	if err := validateConsPubKeyType(ctx, pk); err != nil {
 		return nil, err
 	}
 
-	amount := unbondEntry.Balance.Sub(msg.Amount.Amount)
-	if amount.IsZero() {
-		ubd.RemoveEntry(unbondEntryIndex)
-	} else {
-		// update the unbondingDelegationEntryBalance and InitialBalance for ubd entry
-		unbondEntry.Balance = amount
-		unbondEntry.InitialBalance = unbondEntry.InitialBalance.Sub(msg.Amount.Amount)
-		ubd.Entries[unbondEntryIndex] = unbondEntry
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
 	}
 
-	// set the unbonding delegation or remove it if there are no more entries
-	if len(ubd.Entries) == 0 {
-		k.RemoveUnbondingDelegation(ctx, ubd)
-	} else {
-		k.SetUnbondingDelegation(ctx, ubd)
+	if err := k.Keeper.RotateConsPubKey(ctx, validator, pk); err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeCancelUnbondingDelegation,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			types.EventTypeRotateConsPubKey,
 			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
-			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
-			sdk.NewAttribute(types.AttributeKeyCreationHeight, strconv.FormatInt(msg.CreationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.ConsAddress(pk.Address()).String()),
 		),
 	)
 
-	return &types.MsgCancelUnbondingDelegationResponse{}, nil
+	return &types.MsgRotateConsPubKeyResponse{}, nil
 }
 
-func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	if ms.authority != msg.Authority {
-		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+// validateConsPubKeyType checks that the consensus pubkey type is supported by
+// the consensus params.
+func validateConsPubKeyType(ctx sdk.Context, pk cryptotypes.PubKey) error {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Validator == nil {
+		return nil
 	}
 
-	// store params
-	if err := ms.SetParams(ctx, msg.Params); err != nil {
-		return nil, err
+	for _, keyType := range cp.Validator.PubKeyTypes {
+		if pk.Type() == keyType {
+			return nil
+		}
 	}
 
-	return &types.MsgUpdateParamsResponse{}, nil
+	return sdkerrors.Wrapf(
+		types.ErrValidatorPubKeyTypeNotSupported,
+		"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+	)
 }
@@ -122,6 +122,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the consensus pubkey rotations of this block. CometBFT only
+	// knows the old consensus pubkey of a rotated validator in the last
+	// validator set, which has to be replaced by the new one.
+	rotations := k.dequeueBlockConsPubKeyRotations(ctx, ctx.BlockHeight())
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -176,6 +181,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
+		} else if _, rotated := rotations[valAddrStr]; rotated {
+			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
+		}
+
+		// remove the rotated consensus pubkey from the validator set
+		if history, rotated := rotations[valAddrStr]; rotated && found {
+			updates = append(updates, history.ABCIValidatorUpdateZero())
 		}
 
 		delete(last, valAddrStr)
@@ -197,7 +209,14 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		// CometBFT is not aware of the new consensus pubkey of a validator
+		// rotated in this block, so the old one has to be removed instead
+		if history, rotated := rotations[validator.OperatorAddress]; rotated {
+			updates = append(updates, history.ABCIValidatorUpdateZero())
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
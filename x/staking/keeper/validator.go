@@ -181,6 +181,8 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	k.removeConsPubKeyRotationHistory(ctx, address)
+	k.DeleteValidatorMinRequirementsDeadline(ctx, address)
 
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
 		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
@@ -36,16 +36,25 @@ func TestMigrateJSON(t *testing.T) {
 
 	// Make sure about new param MinCommissionRate.
 	expected := `{
+	"cons_pubkey_rotation_history": [],
 	"delegations": [],
 	"exported": false,
 	"last_total_power": "0",
 	"last_validator_powers": [],
+	"min_requirements_deadlines": [],
 	"params": {
 		"bond_denom": "stake",
 		"historical_entries": 10000,
+		"key_rotation_fee": {
+			"amount": "1000000",
+			"denom": "stake"
+		},
+		"max_cons_pubkey_rotations": 1,
 		"max_entries": 7,
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
+		"min_requirements_grace_period": "1209600s",
+		"min_self_delegation": "1",
 		"unbonding_time": "1814400s"
 	},
 	"redelegations": [],
@@ -0,0 +1,38 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5.
+// The migration includes:
+//
+// - Setting the KeyRotationFee param to the default fee amount in the bond
+// denom, and the MaxConsPubkeyRotations param to its default value
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	return migrateParams(store, cdc)
+}
+
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+
+	params.KeyRotationFee = sdk.NewCoin(params.BondDenom, types.DefaultKeyRotationFeeAmount)
+	params.MaxConsPubkeyRotations = types.DefaultMaxConsPubKeyRotations
+
+	// only the params set here are validated, as the params introduced by
+	// later migrations are not set yet
+	if err := params.KeyRotationFee.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
@@ -0,0 +1,38 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+
+	storeKey := sdk.NewKVStoreKey(v5.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	oldParams := types.DefaultParams()
+	oldParams.MaxValidators = 50
+	oldParams.BondDenom = "uatom"
+	oldParams.KeyRotationFee = sdk.Coin{}
+	oldParams.MaxConsPubkeyRotations = 0
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &res))
+	require.Equal(t, uint32(50), res.MaxValidators)
+	require.Equal(t, sdk.NewCoin("uatom", types.DefaultKeyRotationFeeAmount), res.KeyRotationFee)
+	require.Equal(t, types.DefaultMaxConsPubKeyRotations, res.MaxConsPubkeyRotations)
+}
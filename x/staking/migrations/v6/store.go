@@ -0,0 +1,36 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6.
+// The migration includes:
+//
+// - Setting the MinSelfDelegation and MinRequirementsGracePeriod params to
+// their default values
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+	return migrateParams(store, cdc)
+}
+
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	}
+
+	params.MinSelfDelegation = types.DefaultMinSelfDelegation
+	params.MinRequirementsGracePeriod = types.DefaultMinRequirementsGracePeriod
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
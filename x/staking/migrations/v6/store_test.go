@@ -0,0 +1,38 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+
+	storeKey := sdk.NewKVStoreKey(v6.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	oldParams := types.DefaultParams()
+	oldParams.MaxValidators = 50
+	oldParams.MinSelfDelegation = math.Int{}
+	oldParams.MinRequirementsGracePeriod = 0
+	store.Set(v6.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v6.ParamsKey), &res))
+	require.Equal(t, uint32(50), res.MaxValidators)
+	require.Equal(t, types.DefaultMinSelfDelegation, res.MinSelfDelegation)
+	require.Equal(t, types.DefaultMinRequirementsGracePeriod, res.MinRequirementsGracePeriod)
+}
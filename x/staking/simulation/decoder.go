@@ -64,6 +64,27 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &paramsB)
 
 			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], types.ConsPubKeyRotationHistoryKey),
+			bytes.Equal(kvA.Key[:1], types.BlockConsPubKeyRotationHistoryKey):
+			var historyA, historyB types.ConsPubKeyRotationHistory
+
+			cdc.MustUnmarshal(kvA.Value, &historyA)
+			cdc.MustUnmarshal(kvB.Value, &historyB)
+
+			return fmt.Sprintf("%v\n%v", historyA, historyB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorMinRequirementsDeadlineKey):
+			deadlineA, err := sdk.ParseTimeBytes(kvA.Value)
+			if err != nil {
+				panic(err)
+			}
+			deadlineB, err := sdk.ParseTimeBytes(kvB.Value)
+			if err != nil {
+				panic(err)
+			}
+
+			return fmt.Sprintf("%v\n%v", deadlineA, deadlineB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorMinRequirementsQueueKey):
+			return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ConsPubKeyRotationHistory{}
+
+// NewConsPubKeyRotationHistory creates a new ConsPubKeyRotationHistory instance.
+//
+//nolint:interfacer
+func NewConsPubKeyRotationHistory(
+	valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, height int64, blockTime time.Time, fee sdk.Coin,
+) (ConsPubKeyRotationHistory, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	return ConsPubKeyRotationHistory{
+		OperatorAddress: valAddr.String(),
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+		Time:            blockTime,
+		Fee:             fee,
+	}, nil
+}
+
+// GetOperator returns the address of the validator that performed the rotation.
+func (h ConsPubKeyRotationHistory) GetOperator() sdk.ValAddress {
+	if h.OperatorAddress == "" {
+		return nil
+	}
+	addr, err := sdk.ValAddressFromBech32(h.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// OldConsPubKey returns the consensus pubkey the validator rotated away from.
+func (h ConsPubKeyRotationHistory) OldConsPubKey() (cryptotypes.PubKey, error) {
+	return unpackConsPubKey(h.OldConsPubkey)
+}
+
+// NewConsPubKey returns the consensus pubkey the validator rotated to.
+func (h ConsPubKeyRotationHistory) NewConsPubKey() (cryptotypes.PubKey, error) {
+	return unpackConsPubKey(h.NewConsPubkey)
+}
+
+// ABCIValidatorUpdateZero returns an abci.ValidatorUpdate with zero power
+// which removes the old consensus pubkey from the CometBFT validator set.
+func (h ConsPubKeyRotationHistory) ABCIValidatorUpdateZero() abci.ValidatorUpdate {
+	pk, err := h.OldConsPubKey()
+	if err != nil {
+		panic(err)
+	}
+
+	tmProtoPk, err := cryptocodec.ToTmProtoPublicKey(pk)
+	if err != nil {
+		panic(err)
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: tmProtoPk,
+		Power:  0,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(h.NewConsPubkey, &pk)
+}
+
+func unpackConsPubKey(pkAny *codectypes.Any) (cryptotypes.PubKey, error) {
+	pk, ok := pkAny.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expecting cryptotypes.PubKey, got %T", pkAny.GetCachedValue())
+	}
+	return pk, nil
+}
@@ -52,4 +52,7 @@ var (
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
 	ErrUnbondingNotFound               = sdkerrors.Register(ModuleName, 41, "unbonding operation not found")
 	ErrUnbondingOnHoldRefCountNegative = sdkerrors.Register(ModuleName, 42, "cannot un-hold unbonding operation that is not on hold")
+	ErrExceedingMaxConsPubKeyRotations = sdkerrors.Register(ModuleName, 43, "exceeding maximum consensus pubkey rotations within unbonding period")
+	ErrConsPubKeyRotationInProgress    = sdkerrors.Register(ModuleName, 44, "consensus pubkey rotation already in progress in this block")
+	ErrMinSelfDelegationLTMinimum      = sdkerrors.Register(ModuleName, 45, "minimum self delegation cannot be less than the chain-wide minimum")
 )
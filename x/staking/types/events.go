@@ -10,6 +10,9 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
+	EventTypeMinRequirementsGrace      = "min_requirements_grace"
+	EventTypeEnforceMinRequirements    = "enforce_min_requirements"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,4 +23,8 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyOldConsAddress    = "old_consensus_address"
+	AttributeKeyNewConsAddress    = "new_consensus_address"
+	AttributeKeyGracePeriodEnd    = "grace_period_end"
+	AttributeKeyJailed            = "jailed"
 )
@@ -45,6 +45,12 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// cons_pubkey_rotation_history defines the consensus public key rotations
+	// performed by validators.
+	ConsPubkeyRotationHistory []ConsPubKeyRotationHistory `protobuf:"bytes,9,rep,name=cons_pubkey_rotation_history,json=consPubkeyRotationHistory,proto3" json:"cons_pubkey_rotation_history"`
+	// min_requirements_deadlines defines the validators that are within the
+	// grace period for meeting the minimum requirements.
+	MinRequirementsDeadlines []ValidatorMinRequirementsDeadline `protobuf:"bytes,10,rep,name=min_requirements_deadlines,json=minRequirementsDeadlines,proto3" json:"min_requirements_deadlines"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -129,6 +135,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetConsPubkeyRotationHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.ConsPubkeyRotationHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinRequirementsDeadlines() []ValidatorMinRequirementsDeadline {
+	if m != nil {
+		return m.MinRequirementsDeadlines
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -180,39 +200,45 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 503 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x1c, 0xc5, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x29, 0x32, 0x19, 0x9c, 0x10, 0x55, 0x28,
-	0x2a, 0xd4, 0x56, 0xd3, 0x8d, 0xad, 0x11, 0x02, 0x21, 0x55, 0xa2, 0x72, 0x29, 0x03, 0x12, 0x8a,
-	0x2e, 0xf5, 0xc9, 0x3d, 0xc5, 0xb9, 0xb3, 0x7c, 0xff, 0x94, 0xf2, 0x0d, 0x18, 0x99, 0x99, 0x3a,
-	0x32, 0x32, 0xf0, 0x21, 0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x42, 0xc9, 0x00, 0x1f, 0x03, 0xf9, 0xce,
-	0x31, 0x87, 0x52, 0x77, 0x49, 0x7c, 0xfe, 0xbf, 0xf7, 0x7b, 0x4f, 0xf2, 0xff, 0xd0, 0xc6, 0x91,
-	0x90, 0x13, 0x21, 0x7d, 0x09, 0x64, 0xcc, 0x78, 0xe4, 0x9f, 0x6c, 0x8f, 0x28, 0x90, 0x6d, 0x3f,
-	0xa2, 0x9c, 0x4a, 0x26, 0xbd, 0x24, 0x15, 0x20, 0xf0, 0x7d, 0xad, 0xf2, 0x72, 0x95, 0x97, 0xab,
-	0x5a, 0xcd, 0x48, 0x44, 0x42, 0x49, 0xfc, 0xec, 0x49, 0xab, 0x5b, 0x65, 0xcc, 0x85, 0x5b, 0xab,
-	0x1e, 0x68, 0xd5, 0x50, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x4b, 0x26, 0x8c, 0x0b, 0x5f, 0xfd, 0xea,
-	0x57, 0xdd, 0xcf, 0x55, 0xb4, 0xf6, 0x42, 0x77, 0x3a, 0x00, 0x02, 0x14, 0xef, 0xa2, 0x5a, 0x42,
-	0x52, 0x32, 0x91, 0x8e, 0xdd, 0xb1, 0x7b, 0x8d, 0xbe, 0xeb, 0x5d, 0xdd, 0xd1, 0xdb, 0x57, 0xaa,
-	0xc1, 0xea, 0xf9, 0x65, 0xdb, 0xfa, 0xf2, 0xfb, 0xeb, 0xa6, 0x1d, 0xe4, 0x46, 0xfc, 0x0e, 0xdd,
-	0x89, 0x89, 0x84, 0x21, 0x08, 0x20, 0xf1, 0x30, 0x11, 0xef, 0x69, 0xea, 0xdc, 0xe8, 0xd8, 0xbd,
-	0xb5, 0xc1, 0x4e, 0x26, 0xfe, 0x79, 0xd9, 0x7e, 0x14, 0x31, 0x38, 0x9e, 0x8e, 0xbc, 0x23, 0x31,
-	0xc9, 0x1b, 0xe6, 0x7f, 0x5b, 0x32, 0x1c, 0xfb, 0xf0, 0x21, 0xa1, 0xd2, 0x7b, 0xc9, 0x41, 0x63,
-	0x6f, 0x67, 0xb0, 0xd7, 0x19, 0x6b, 0x3f, 0x43, 0x61, 0x86, 0xd6, 0x15, 0xfe, 0x84, 0xc4, 0x2c,
-	0x24, 0x20, 0x52, 0x1d, 0x21, 0x9d, 0x4a, 0xa7, 0xd2, 0x6b, 0xf4, 0x37, 0xcb, 0x0a, 0xef, 0x11,
-	0x09, 0x6f, 0x16, 0x1e, 0x85, 0x32, 0xcb, 0xdf, 0x8b, 0x97, 0xc6, 0x12, 0xef, 0x21, 0x54, 0xa4,
-	0x48, 0xe7, 0xa6, 0xe2, 0x3f, 0x2c, 0xe3, 0x17, 0x66, 0x13, 0x6b, 0xf8, 0xf1, 0x2b, 0xd4, 0x08,
-	0x69, 0x4c, 0x23, 0x02, 0x4c, 0x70, 0xe9, 0x54, 0x15, 0xae, 0x5b, 0x86, 0x7b, 0x56, 0x48, 0x4d,
-	0x9e, 0x49, 0xc0, 0x63, 0xb4, 0x3e, 0xe5, 0x23, 0xc1, 0x43, 0xc6, 0xa3, 0xa1, 0x89, 0xae, 0x29,
-	0xf4, 0xe3, 0x32, 0xf4, 0xe1, 0xc2, 0x74, 0x75, 0x46, 0x73, 0xba, 0x3c, 0x97, 0xf8, 0x10, 0xdd,
-	0x4a, 0xa9, 0x19, 0xb2, 0xa2, 0x42, 0x36, 0xca, 0x42, 0x02, 0x43, 0x6c, 0xd2, 0xff, 0xa7, 0xe0,
-	0x16, 0xaa, 0xd3, 0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb1, 0x7b, 0xf5, 0xa0, 0x38, 0x77,
-	0x8f, 0x11, 0x5e, 0xfe, 0x68, 0xb8, 0x8f, 0x56, 0x48, 0x18, 0xa6, 0x54, 0xea, 0x15, 0x5d, 0x1d,
-	0x38, 0xdf, 0xbf, 0x6d, 0x35, 0xf3, 0x16, 0xbb, 0x7a, 0x72, 0x00, 0x29, 0xe3, 0x51, 0xb0, 0x10,
-	0xe2, 0x26, 0xaa, 0xfe, 0xdb, 0xc3, 0x4a, 0xa0, 0x0f, 0x4f, 0xeb, 0x1f, 0xcf, 0xda, 0xd6, 0x9f,
-	0xb3, 0xb6, 0x35, 0x78, 0x7e, 0x3e, 0x73, 0xed, 0x8b, 0x99, 0x6b, 0xff, 0x9a, 0xb9, 0xf6, 0xa7,
-	0xb9, 0x6b, 0x5d, 0xcc, 0x5d, 0xeb, 0xc7, 0xdc, 0xb5, 0xde, 0x3e, 0xb9, 0x76, 0x55, 0x4f, 0x8b,
-	0xcb, 0xa8, 0x96, 0x76, 0x54, 0x53, 0xb7, 0x6a, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5,
-	0x05, 0x1a, 0xb6, 0xff, 0x03, 0x00, 0x00,
+	// 597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x6d, 0x4a, 0xdb, 0xf4, 0x5a, 0x10, 0x1c, 0x29, 0x72, 0x23, 0xe4, 0x84, 0xaa, 0x42,
+	0x51, 0xa1, 0xb6, 0xda, 0x2e, 0x88, 0xad, 0xa1, 0xe2, 0x8f, 0x28, 0xa2, 0x72, 0x29, 0x03, 0x12,
+	0xb2, 0xce, 0xf1, 0xc9, 0x39, 0xc5, 0xbe, 0x33, 0x77, 0xe7, 0xd2, 0x0c, 0x0c, 0x6c, 0x8c, 0x7c,
+	0x01, 0xa4, 0x8e, 0x8c, 0x0c, 0x7c, 0x88, 0x8e, 0x15, 0x13, 0x62, 0xa8, 0x50, 0x32, 0xc0, 0xc7,
+	0x40, 0xb9, 0x73, 0x82, 0x51, 0x62, 0x58, 0x92, 0x5c, 0xde, 0xe7, 0xf9, 0x3d, 0xcf, 0x70, 0xef,
+	0x81, 0xb5, 0x36, 0x13, 0x09, 0x13, 0xae, 0x90, 0xa8, 0x4b, 0x68, 0xe4, 0x1e, 0x6d, 0x06, 0x58,
+	0xa2, 0x4d, 0x37, 0xc2, 0x14, 0x0b, 0x22, 0x9c, 0x94, 0x33, 0xc9, 0xe0, 0x75, 0xad, 0x72, 0x72,
+	0x95, 0x93, 0xab, 0x6a, 0xd5, 0x88, 0x45, 0x4c, 0x49, 0xdc, 0xe1, 0x2f, 0xad, 0xae, 0x95, 0x31,
+	0x47, 0x6e, 0xad, 0x5a, 0xd1, 0x2a, 0x5f, 0xdb, 0xf3, 0x00, 0x3d, 0xba, 0x8a, 0x12, 0x42, 0x99,
+	0xab, 0x3e, 0xf5, 0x5f, 0xab, 0x1f, 0xe7, 0xc1, 0xd2, 0x43, 0xdd, 0xe9, 0x40, 0x22, 0x89, 0xe1,
+	0x0e, 0x98, 0x4b, 0x11, 0x47, 0x89, 0xb0, 0xcc, 0x86, 0xd9, 0x5c, 0xdc, 0xb2, 0x9d, 0xe9, 0x1d,
+	0x9d, 0x7d, 0xa5, 0x6a, 0x2d, 0x9c, 0x9e, 0xd7, 0x8d, 0x4f, 0x3f, 0x3f, 0xaf, 0x9b, 0x5e, 0x6e,
+	0x84, 0xaf, 0xc0, 0x95, 0x18, 0x09, 0xe9, 0x4b, 0x26, 0x51, 0xec, 0xa7, 0xec, 0x0d, 0xe6, 0xd6,
+	0x85, 0x86, 0xd9, 0x5c, 0x6a, 0x6d, 0x0f, 0xc5, 0xdf, 0xcf, 0xeb, 0xb7, 0x22, 0x22, 0x3b, 0x59,
+	0xe0, 0xb4, 0x59, 0x92, 0x37, 0xcc, 0xbf, 0x36, 0x44, 0xd8, 0x75, 0x65, 0x2f, 0xc5, 0xc2, 0x79,
+	0x4c, 0xa5, 0xc6, 0x5e, 0x1e, 0xc2, 0x9e, 0x0f, 0x59, 0xfb, 0x43, 0x14, 0x24, 0x60, 0x59, 0xe1,
+	0x8f, 0x50, 0x4c, 0x42, 0x24, 0x19, 0xd7, 0x11, 0xc2, 0x9a, 0x69, 0xcc, 0x34, 0x17, 0xb7, 0xd6,
+	0xcb, 0x0a, 0xef, 0x21, 0x21, 0x5f, 0x8c, 0x3c, 0x0a, 0x55, 0x2c, 0x7f, 0x2d, 0x9e, 0x18, 0x0b,
+	0xb8, 0x07, 0xc0, 0x38, 0x45, 0x58, 0x17, 0x15, 0xff, 0x66, 0x19, 0x7f, 0x6c, 0x2e, 0x62, 0x0b,
+	0x7e, 0xf8, 0x0c, 0x2c, 0x86, 0x38, 0xc6, 0x11, 0x92, 0x84, 0x51, 0x61, 0xcd, 0x2a, 0xdc, 0x6a,
+	0x19, 0x6e, 0x77, 0x2c, 0x2d, 0xf2, 0x8a, 0x04, 0xd8, 0x05, 0xcb, 0x19, 0x0d, 0x18, 0x0d, 0x09,
+	0x8d, 0xfc, 0x22, 0x7a, 0x4e, 0xa1, 0x6f, 0x97, 0xa1, 0x0f, 0x47, 0xa6, 0xe9, 0x19, 0xd5, 0x6c,
+	0x72, 0x2e, 0xe0, 0x21, 0xb8, 0xc4, 0x71, 0x31, 0x64, 0x5e, 0x85, 0xac, 0x95, 0x85, 0x78, 0x38,
+	0x9c, 0x4a, 0xff, 0x9b, 0x02, 0x6b, 0xa0, 0x82, 0x8f, 0x53, 0xc6, 0x25, 0x0e, 0xad, 0x4a, 0xc3,
+	0x6c, 0x56, 0xbc, 0xf1, 0x19, 0xbe, 0x05, 0x37, 0xda, 0x8c, 0x0a, 0x3f, 0xcd, 0x82, 0x2e, 0xee,
+	0xf9, 0x9c, 0x49, 0x65, 0xf2, 0x3b, 0x44, 0x48, 0xc6, 0x7b, 0xd6, 0x82, 0x6a, 0xb0, 0x59, 0xd6,
+	0xe0, 0x3e, 0xa3, 0x62, 0x3f, 0x0b, 0x9e, 0xe0, 0x9e, 0x97, 0x3b, 0x1f, 0x69, 0x63, 0xb1, 0xce,
+	0x4a, 0x5b, 0xab, 0xba, 0x13, 0x2a, 0xf8, 0xce, 0x04, 0xb5, 0x84, 0x50, 0x9f, 0xe3, 0xd7, 0x19,
+	0xe1, 0x38, 0xc1, 0x54, 0x0a, 0x3f, 0xc4, 0x28, 0x8c, 0x09, 0xc5, 0xc2, 0x02, 0x2a, 0xfd, 0xee,
+	0x7f, 0xaf, 0xc3, 0x53, 0x42, 0xbd, 0x02, 0x61, 0x37, 0x07, 0x14, 0x4b, 0x58, 0xc9, 0x74, 0x8d,
+	0x58, 0xed, 0x00, 0x38, 0x79, 0x6f, 0xe1, 0x16, 0x98, 0x47, 0x61, 0xc8, 0xb1, 0xd0, 0x5b, 0xba,
+	0xd0, 0xb2, 0xbe, 0x7e, 0xd9, 0xa8, 0xe6, 0x45, 0x76, 0xf4, 0xe4, 0x40, 0x72, 0x42, 0x23, 0x6f,
+	0x24, 0x84, 0x55, 0x30, 0xfb, 0x67, 0x15, 0x67, 0x3c, 0x7d, 0xb8, 0x57, 0x79, 0x7f, 0x52, 0x37,
+	0x7e, 0x9d, 0xd4, 0x8d, 0xd6, 0x83, 0xd3, 0xbe, 0x6d, 0x9e, 0xf5, 0x6d, 0xf3, 0x47, 0xdf, 0x36,
+	0x3f, 0x0c, 0x6c, 0xe3, 0x6c, 0x60, 0x1b, 0xdf, 0x06, 0xb6, 0xf1, 0xf2, 0xce, 0x3f, 0xb7, 0xf5,
+	0x78, 0xfc, 0x1e, 0xa9, 0xbd, 0x0d, 0xe6, 0xd4, 0xc3, 0xb2, 0xfd, 0x7b, 0x00, 0xc5, 0x29, 0xe0,
+	0xb8, 0x02, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -235,6 +261,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinRequirementsDeadlines) > 0 {
+		for iNdEx := len(m.MinRequirementsDeadlines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinRequirementsDeadlines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ConsPubkeyRotationHistory) > 0 {
+		for iNdEx := len(m.ConsPubkeyRotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubkeyRotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -427,6 +481,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.ConsPubkeyRotationHistory) > 0 {
+		for _, e := range m.ConsPubkeyRotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinRequirementsDeadlines) > 0 {
+		for _, e := range m.MinRequirementsDeadlines {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -737,6 +803,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubkeyRotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubkeyRotationHistory = append(m.ConsPubkeyRotationHistory, ConsPubKeyRotationHistory{})
+			if err := m.ConsPubkeyRotationHistory[len(m.ConsPubkeyRotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRequirementsDeadlines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinRequirementsDeadlines = append(m.MinRequirementsDeadlines, ValidatorMinRequirementsDeadline{})
+			if err := m.MinRequirementsDeadlines[len(m.MinRequirementsDeadlines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
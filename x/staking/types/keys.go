@@ -54,6 +54,12 @@ var (
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	ConsPubKeyRotationHistoryKey      = []byte{0x71} // prefix for the consensus pubkey rotation history, by validator operator
+	BlockConsPubKeyRotationHistoryKey = []byte{0x72} // prefix for the consensus pubkey rotations, by block height
+
+	ValidatorMinRequirementsDeadlineKey = []byte{0x73} // prefix for the deadline by which a validator must meet the minimum requirements
+	ValidatorMinRequirementsQueueKey    = []byte{0x74} // prefix for the timestamps in the minimum requirements enforcement queue
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -377,3 +383,49 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetValidatorConsPubKeyRotationHistoryKey returns a key prefix for indexing the
+// consensus pubkey rotations of a validator.
+func GetValidatorConsPubKeyRotationHistoryKey(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationHistoryKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetConsPubKeyRotationHistoryKey returns the key for a consensus pubkey
+// rotation of a validator at the given height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetConsPubKeyRotationHistoryKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetValidatorConsPubKeyRotationHistoryKey(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlockConsPubKeyRotationHistoryPrefix returns a key prefix for indexing the
+// consensus pubkey rotations performed at the given height.
+func GetBlockConsPubKeyRotationHistoryPrefix(height int64) []byte {
+	return append(BlockConsPubKeyRotationHistoryKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlockConsPubKeyRotationHistoryKey returns the key for a consensus pubkey
+// rotation of a validator, indexed by the height it was performed at.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetBlockConsPubKeyRotationHistoryKey(height int64, valAddr sdk.ValAddress) []byte {
+	return append(GetBlockConsPubKeyRotationHistoryPrefix(height), address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorMinRequirementsDeadlineKey returns the key for the deadline by
+// which a validator must meet the minimum requirements.
+// VALUE: timestamp
+func GetValidatorMinRequirementsDeadlineKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorMinRequirementsDeadlineKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorMinRequirementsQueueTimePrefix returns the prefix of the minimum
+// requirements enforcement queue entries due at the given time.
+func GetValidatorMinRequirementsQueueTimePrefix(deadline time.Time) []byte {
+	return append(append([]byte{}, ValidatorMinRequirementsQueueKey...), sdk.FormatTimeBytes(deadline)...)
+}
+
+// GetValidatorMinRequirementsQueueKey returns the key of a validator in the
+// minimum requirements enforcement queue, indexed by its deadline.
+// VALUE: validator operator address
+func GetValidatorMinRequirementsQueueKey(deadline time.Time, valAddr sdk.ValAddress) []byte {
+	return append(GetValidatorMinRequirementsQueueTimePrefix(deadline), address.MustLengthPrefix(valAddr)...)
+}
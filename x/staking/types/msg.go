@@ -17,6 +17,7 @@ const (
 	TypeMsgDelegate                  = "delegate"
 	TypeMsgBeginRedelegate           = "begin_redelegate"
 	TypeMsgUpdateParams              = "update_params"
+	TypeMsgRotateConsPubKey          = "rotate_cons_pubkey"
 )
 
 var (
@@ -29,6 +30,8 @@ var (
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgRotateConsPubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -421,3 +424,56 @@ func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Authority)
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+//
+//nolint:interfacer
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotateConsPubKey{
+		ValidatorAddress: valAddr.String(),
+		NewPubkey:        pkAny,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Type() string { return TypeMsgRotateConsPubKey }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if msg.NewPubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubkey, &pubKey)
+}
@@ -30,20 +30,51 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultMaxConsPubKeyRotations is the default maximum number of consensus
+	// pubkey rotations a validator may perform within an unbonding period.
+	DefaultMaxConsPubKeyRotations uint32 = 1
+
+	// DefaultMinRequirementsGracePeriod is the default time existing validators
+	// are given to meet raised minimum requirements.
+	DefaultMinRequirementsGracePeriod time.Duration = time.Hour * 24 * 7 * 2
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
+
+	// DefaultKeyRotationFeeAmount is the amount of bond denom charged for
+	// rotating a consensus pubkey
+	DefaultKeyRotationFeeAmount = math.NewInt(1000000)
+
+	// DefaultMinSelfDelegation is set to the smallest self delegation a
+	// validator can declare
+	DefaultMinSelfDelegation = math.OneInt()
+)
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(
+	unbondingTime time.Duration,
+	maxValidators, maxEntries, historicalEntries uint32,
+	bondDenom string,
+	minCommissionRate sdk.Dec,
+	keyRotationFee sdk.Coin,
+	maxConsPubKeyRotations uint32,
+	minSelfDelegation math.Int,
+	minRequirementsGracePeriod time.Duration,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:              unbondingTime,
+		MaxValidators:              maxValidators,
+		MaxEntries:                 maxEntries,
+		HistoricalEntries:          historicalEntries,
+		BondDenom:                  bondDenom,
+		MinCommissionRate:          minCommissionRate,
+		KeyRotationFee:             keyRotationFee,
+		MaxConsPubkeyRotations:     maxConsPubKeyRotations,
+		MinSelfDelegation:          minSelfDelegation,
+		MinRequirementsGracePeriod: minRequirementsGracePeriod,
 	}
 }
 
@@ -56,6 +87,10 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		sdk.NewCoin(sdk.DefaultBondDenom, DefaultKeyRotationFeeAmount),
+		DefaultMaxConsPubKeyRotations,
+		DefaultMinSelfDelegation,
+		DefaultMinRequirementsGracePeriod,
 	)
 }
 
@@ -111,6 +146,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if p.KeyRotationFee.Denom != p.BondDenom {
+		return fmt.Errorf("key rotation fee denom %s must be the bond denom %s", p.KeyRotationFee.Denom, p.BondDenom)
+	}
+
+	if err := validateMinSelfDelegation(p.MinSelfDelegation); err != nil {
+		return err
+	}
+
+	if err := validateMinRequirementsGracePeriod(p.MinRequirementsGracePeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -210,3 +261,45 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateMinSelfDelegation(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum self delegation cannot be nil")
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("minimum self delegation must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinRequirementsGracePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("minimum requirements grace period cannot be negative: %d", v)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -35,4 +36,12 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// the key rotation fee must be paid in the bond denom
+	params = types.DefaultParams()
+	params.BondDenom = "uatom"
+	require.Error(t, params.Validate())
+
+	params.KeyRotationFee = sdk.NewInt64Coin("uatom", 1)
+	require.NoError(t, params.Validate())
 }
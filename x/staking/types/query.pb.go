@@ -1391,6 +1391,103 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryConsPubKeyRotationHistoryRequest is request type for the
+// Query/ConsPubKeyRotationHistory RPC method.
+type QueryConsPubKeyRotationHistoryRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryConsPubKeyRotationHistoryRequest) Reset()         { *m = QueryConsPubKeyRotationHistoryRequest{} }
+func (m *QueryConsPubKeyRotationHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsPubKeyRotationHistoryRequest) ProtoMessage()    {}
+func (*QueryConsPubKeyRotationHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{28}
+}
+func (m *QueryConsPubKeyRotationHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsPubKeyRotationHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsPubKeyRotationHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsPubKeyRotationHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsPubKeyRotationHistoryRequest.Merge(m, src)
+}
+func (m *QueryConsPubKeyRotationHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsPubKeyRotationHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsPubKeyRotationHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsPubKeyRotationHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryConsPubKeyRotationHistoryRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryConsPubKeyRotationHistoryResponse is response type for the
+// Query/ConsPubKeyRotationHistory RPC method.
+type QueryConsPubKeyRotationHistoryResponse struct {
+	// history defines the consensus public key rotations of the validator,
+	// ordered by height.
+	History []ConsPubKeyRotationHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+}
+
+func (m *QueryConsPubKeyRotationHistoryResponse) Reset() {
+	*m = QueryConsPubKeyRotationHistoryResponse{}
+}
+func (m *QueryConsPubKeyRotationHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsPubKeyRotationHistoryResponse) ProtoMessage()    {}
+func (*QueryConsPubKeyRotationHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryConsPubKeyRotationHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsPubKeyRotationHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsPubKeyRotationHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsPubKeyRotationHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsPubKeyRotationHistoryResponse.Merge(m, src)
+}
+func (m *QueryConsPubKeyRotationHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsPubKeyRotationHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsPubKeyRotationHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsPubKeyRotationHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryConsPubKeyRotationHistoryResponse) GetHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1420,6 +1517,8 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryConsPubKeyRotationHistoryRequest)(nil), "cosmos.staking.v1beta1.QueryConsPubKeyRotationHistoryRequest")
+	proto.RegisterType((*QueryConsPubKeyRotationHistoryResponse)(nil), "cosmos.staking.v1beta1.QueryConsPubKeyRotationHistoryResponse")
 }
 
 func init() {
@@ -1427,93 +1526,98 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1373 bytes of a gzipped FileDescriptorProto
+	// 1456 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdb, 0x6f, 0x14, 0x65,
-	0x1b, 0xdf, 0xb7, 0xf4, 0x6b, 0xbe, 0x3e, 0x04, 0x02, 0xef, 0x2e, 0xb5, 0x0c, 0xb8, 0xbb, 0x4c,
-	0x08, 0xf6, 0x40, 0x77, 0x6c, 0x8b, 0x50, 0x31, 0x0a, 0x5b, 0x09, 0x8a, 0x10, 0x2c, 0x6b, 0x6c,
-	0xf0, 0x94, 0x66, 0xda, 0x19, 0x66, 0x27, 0x6c, 0x67, 0x96, 0x79, 0xa7, 0x0d, 0xa4, 0x69, 0x4c,
-	0xbc, 0x30, 0x5c, 0x19, 0x13, 0xef, 0x0d, 0x17, 0x5e, 0x18, 0xc5, 0xa4, 0x17, 0x98, 0xe8, 0x0d,
-	0x97, 0x86, 0x0b, 0x63, 0x88, 0x06, 0xa3, 0x37, 0x68, 0x5a, 0x13, 0xbd, 0xf1, 0x3f, 0x30, 0xc6,
-	0xec, 0xcc, 0x33, 0xa7, 0xce, 0x71, 0xb7, 0xdb, 0xa4, 0xdc, 0xc0, 0xee, 0x3b, 0xcf, 0xe1, 0xf7,
-	0x7b, 0x0e, 0xef, 0x3c, 0xcf, 0x16, 0xf8, 0x05, 0x9d, 0x2d, 0xea, 0x4c, 0x60, 0xa6, 0x78, 0x5d,
-	0xd5, 0x14, 0x61, 0x79, 0x7c, 0x5e, 0x36, 0xc5, 0x71, 0xe1, 0xc6, 0x92, 0x6c, 0xdc, 0xaa, 0x34,
-	0x0d, 0xdd, 0xd4, 0xe9, 0x80, 0x2d, 0x53, 0x41, 0x99, 0x0a, 0xca, 0x70, 0x23, 0xa8, 0x3b, 0x2f,
-	0x32, 0xd9, 0x56, 0x70, 0xd5, 0x9b, 0xa2, 0xa2, 0x6a, 0xa2, 0xa9, 0xea, 0x9a, 0x6d, 0x83, 0x2b,
-	0x28, 0xba, 0xa2, 0x5b, 0x1f, 0x85, 0xd6, 0x27, 0x3c, 0x3d, 0xac, 0xe8, 0xba, 0xd2, 0x90, 0x05,
-	0xb1, 0xa9, 0x0a, 0xa2, 0xa6, 0xe9, 0xa6, 0xa5, 0xc2, 0xf0, 0xe9, 0xd1, 0x18, 0x6c, 0x0e, 0x0e,
-	0x5b, 0xea, 0xa0, 0x2d, 0x35, 0x67, 0x1b, 0x47, 0xa8, 0xf6, 0xa3, 0x43, 0x68, 0xc0, 0xc1, 0xe6,
-	0x67, 0xc5, 0xed, 0x17, 0x17, 0x55, 0x4d, 0x17, 0xac, 0x7f, 0xed, 0x23, 0xfe, 0x26, 0x0c, 0x5c,
-	0x69, 0x49, 0xcc, 0x8a, 0x0d, 0x55, 0x12, 0x4d, 0xdd, 0x60, 0x35, 0xf9, 0xc6, 0x92, 0xcc, 0x4c,
-	0x3a, 0x00, 0x7d, 0xcc, 0x14, 0xcd, 0x25, 0x36, 0x48, 0xca, 0x64, 0xa8, 0xbf, 0x86, 0xdf, 0xe8,
-	0x79, 0x00, 0x8f, 0xea, 0x60, 0x4f, 0x99, 0x0c, 0xed, 0x9e, 0x38, 0x56, 0x41, 0x10, 0xad, 0xb8,
-	0x54, 0x6c, 0x97, 0x08, 0xbd, 0x32, 0x23, 0x2a, 0x32, 0xda, 0xac, 0xf9, 0x34, 0xf9, 0x35, 0x02,
-	0x4f, 0x85, 0x5c, 0xb3, 0xa6, 0xae, 0x31, 0x99, 0x5e, 0x02, 0x58, 0x76, 0x4f, 0x07, 0x49, 0x79,
-	0xd7, 0xd0, 0xee, 0x89, 0x23, 0x95, 0xe8, 0x9c, 0x54, 0x5c, 0xfd, 0xe9, 0xfe, 0x07, 0x8f, 0x4b,
-	0xb9, 0xcf, 0xff, 0x5c, 0x1b, 0x21, 0x35, 0x9f, 0x3e, 0x7d, 0x25, 0x02, 0xf1, 0x33, 0xa9, 0x88,
-	0x6d, 0x28, 0x01, 0xc8, 0x57, 0xe1, 0x40, 0x10, 0xb1, 0x13, 0xab, 0x33, 0xb0, 0xd7, 0xf5, 0x37,
-	0x27, 0x4a, 0x92, 0x61, 0xc7, 0x6c, 0x7a, 0xf0, 0xc7, 0x7b, 0x63, 0x05, 0x74, 0x54, 0x95, 0x24,
-	0x43, 0x66, 0xec, 0x0d, 0xd3, 0x50, 0x35, 0xa5, 0xb6, 0xc7, 0x95, 0x6f, 0x9d, 0xf3, 0xd2, 0xe6,
-	0x34, 0xb8, 0xa1, 0x78, 0x0d, 0xfa, 0x5d, 0x51, 0xcb, 0x6a, 0xbb, 0x91, 0xf0, 0xd4, 0xf9, 0x2f,
-	0x09, 0x94, 0x83, 0x6e, 0xce, 0xc9, 0x0d, 0x59, 0xb1, 0x2b, 0xb0, 0x5b, 0x5c, 0xba, 0x56, 0x20,
-	0x7f, 0x13, 0x38, 0x92, 0x80, 0x16, 0xe3, 0xf3, 0x3e, 0x14, 0x24, 0xf7, 0x78, 0xce, 0xc0, 0x63,
-	0xa7, 0x68, 0x46, 0xe2, 0x42, 0xe5, 0x99, 0x72, 0x2c, 0x4d, 0x97, 0x5b, 0x31, 0xfb, 0xe2, 0xb7,
-	0x52, 0x3e, 0xfc, 0x8c, 0xd9, 0xa1, 0xcc, 0x4b, 0xe1, 0x27, 0xdd, 0xab, 0xae, 0x7b, 0x04, 0x86,
-	0x83, 0x7c, 0xdf, 0xd4, 0xe6, 0x75, 0x4d, 0x52, 0x35, 0x65, 0x27, 0xa7, 0xe9, 0x31, 0x81, 0x91,
-	0x2c, 0xb0, 0x31, 0x5f, 0x0a, 0xe4, 0x97, 0x9c, 0xe7, 0xa1, 0x74, 0x8d, 0xc6, 0xa5, 0x2b, 0xc2,
-	0xa4, 0xbf, 0xc6, 0xa9, 0x6b, 0x72, 0x1b, 0xf2, 0xf2, 0x19, 0xc1, 0xe6, 0xf4, 0xd7, 0x85, 0x9b,
-	0x04, 0x2c, 0x89, 0xcc, 0x49, 0x70, 0xe5, 0xad, 0x24, 0x84, 0xb3, 0xd8, 0xd3, 0x56, 0x16, 0x4f,
-	0xff, 0xff, 0xf6, 0x9d, 0x52, 0xee, 0xaf, 0x3b, 0xa5, 0x1c, 0xbf, 0x8c, 0xd7, 0x69, 0xb8, 0x7a,
-	0xe9, 0x3b, 0x90, 0x8f, 0xe8, 0x11, 0xbc, 0x4d, 0xda, 0x68, 0x91, 0x1a, 0x0d, 0x37, 0x00, 0xff,
-	0x15, 0x81, 0x92, 0xe5, 0x38, 0x22, 0x47, 0x3b, 0x31, 0x4e, 0x06, 0xde, 0x81, 0x91, 0x70, 0x31,
-	0x60, 0x97, 0xa1, 0xcf, 0xae, 0x28, 0x8c, 0x51, 0xa7, 0x75, 0x89, 0x56, 0xf8, 0xaf, 0x9d, 0x8b,
-	0xf7, 0x9c, 0xc3, 0x2a, 0xba, 0xa3, 0xb7, 0x16, 0xa4, 0x2e, 0x75, 0xb4, 0x2f, 0x56, 0x3f, 0x3b,
-	0x57, 0x70, 0x34, 0x6e, 0x8c, 0x56, 0xbd, 0x6b, 0x57, 0xb0, 0x2f, 0x74, 0xdb, 0x7b, 0xd7, 0xde,
-	0x77, 0xee, 0x5a, 0x97, 0x58, 0xca, 0x5d, 0xbb, 0xd3, 0x32, 0xe3, 0xde, 0xba, 0x29, 0x04, 0x9e,
-	0xd8, 0x5b, 0xf7, 0x7e, 0x0f, 0x1c, 0xb4, 0x08, 0xd6, 0x64, 0x69, 0x5b, 0x32, 0x42, 0x99, 0xb1,
-	0x30, 0xd7, 0xe6, 0xa5, 0xb2, 0x8f, 0x19, 0x0b, 0xb3, 0x9b, 0xde, 0xa2, 0x54, 0x62, 0xe6, 0x66,
-	0x3b, 0xbb, 0xd2, 0xec, 0x48, 0xcc, 0x9c, 0x4d, 0x78, 0x1b, 0xf7, 0x76, 0xa1, 0x42, 0x1e, 0x11,
-	0xe0, 0xa2, 0x02, 0x88, 0x15, 0xa1, 0xc1, 0x80, 0x21, 0x27, 0xb4, 0xed, 0xf1, 0xb8, 0xa2, 0xf0,
-	0x9b, 0x8b, 0x6a, 0xdc, 0x03, 0x86, 0xbc, 0xdd, 0x63, 0x52, 0x29, 0x58, 0xf9, 0xe1, 0xdd, 0x65,
-	0x07, 0x36, 0xec, 0xb7, 0xa1, 0x57, 0xc0, 0x93, 0xb3, 0xf7, 0xdc, 0x25, 0x50, 0x8c, 0xc1, 0xbe,
-	0x13, 0xdf, 0xf0, 0x8b, 0xb1, 0x05, 0xb2, 0x2d, 0x5b, 0xd5, 0x09, 0xec, 0xb3, 0x57, 0x55, 0x66,
-	0xea, 0x86, 0xba, 0x20, 0x36, 0x2e, 0x68, 0xd7, 0x74, 0xdf, 0x1a, 0x5d, 0x97, 0x55, 0xa5, 0x6e,
-	0x5a, 0x6e, 0x76, 0xd5, 0xf0, 0x1b, 0xff, 0x16, 0x1c, 0x8a, 0xd4, 0x42, 0x80, 0xa7, 0xa1, 0xb7,
-	0xae, 0x32, 0x13, 0xb1, 0x1d, 0x8b, 0xc3, 0xb6, 0x49, 0xdb, 0xd2, 0xe1, 0x29, 0xec, 0xb3, 0x4c,
-	0xcf, 0xe8, 0x7a, 0x03, 0x61, 0xf0, 0x33, 0xb0, 0xdf, 0x77, 0x86, 0x4e, 0x5e, 0x80, 0xde, 0xa6,
-	0xae, 0x37, 0xd0, 0xc9, 0xe1, 0x38, 0x27, 0x2d, 0x1d, 0x3f, 0x77, 0x4b, 0x89, 0x2f, 0x00, 0xb5,
-	0x2d, 0x8a, 0x86, 0xb8, 0xe8, 0x74, 0x1e, 0x7f, 0x15, 0xf2, 0x81, 0x53, 0xf4, 0x54, 0x85, 0xbe,
-	0xa6, 0x75, 0x82, 0xbe, 0x8a, 0xb1, 0xbe, 0x2c, 0xa9, 0xc0, 0x0c, 0x65, 0x2b, 0x4e, 0xac, 0x0d,
-	0xc0, 0xff, 0x2c, 0xd3, 0xf4, 0x53, 0x02, 0xe0, 0x35, 0x0f, 0xad, 0xc4, 0xd9, 0x8a, 0xfe, 0x61,
-	0x83, 0x13, 0x32, 0xcb, 0xe3, 0x84, 0x2b, 0xdc, 0x6e, 0x01, 0xf9, 0xe0, 0xa7, 0x3f, 0x3e, 0xe9,
-	0x39, 0x4a, 0x79, 0x21, 0xe6, 0x27, 0x1a, 0x5f, 0xe3, 0xdd, 0x25, 0xd0, 0xef, 0xda, 0xa1, 0x63,
-	0xd9, 0xfc, 0x39, 0xf0, 0x2a, 0x59, 0xc5, 0x11, 0xdd, 0x59, 0x0f, 0xdd, 0x73, 0x74, 0x32, 0x1d,
-	0x9d, 0xb0, 0x12, 0xec, 0xb3, 0x55, 0xfa, 0x2b, 0x81, 0x42, 0xd4, 0x8e, 0x4d, 0xa7, 0xb2, 0x41,
-	0x09, 0x4f, 0x4c, 0xdc, 0xf3, 0x1d, 0x68, 0x22, 0x9f, 0x4b, 0x1e, 0x9f, 0x2a, 0x3d, 0xd3, 0x01,
-	0x1f, 0xc1, 0xf7, 0xba, 0xa3, 0xff, 0x12, 0x78, 0x3a, 0x71, 0x31, 0xa5, 0xd5, 0x6c, 0x50, 0x13,
-	0xe6, 0x43, 0x6e, 0x7a, 0x2b, 0x26, 0x90, 0xf6, 0xac, 0x47, 0xfb, 0x22, 0xbd, 0xd0, 0x09, 0x6d,
-	0x6f, 0xc0, 0xf3, 0x07, 0xe0, 0x7b, 0x02, 0xe0, 0xf9, 0x4b, 0x69, 0x96, 0xd0, 0xe6, 0x96, 0xd2,
-	0x2c, 0xe1, 0x11, 0x9e, 0x7f, 0xcf, 0xe3, 0x51, 0xa3, 0x33, 0x5b, 0x4c, 0x9f, 0xb0, 0x12, 0x7c,
-	0xa9, 0xac, 0xd2, 0x7f, 0x08, 0xe4, 0x23, 0xe2, 0x48, 0x4f, 0x25, 0xe2, 0x8c, 0x5f, 0x4d, 0xb9,
-	0xa9, 0xf6, 0x15, 0x91, 0xa9, 0xe1, 0x31, 0x55, 0xa8, 0xdc, 0x6d, 0xa6, 0x91, 0xe9, 0xa4, 0x3f,
-	0x10, 0x28, 0x44, 0xed, 0x62, 0x29, 0xad, 0x9a, 0xb0, 0x76, 0xa6, 0xb4, 0x6a, 0xd2, 0xe2, 0xc7,
-	0x57, 0xbd, 0x08, 0x9c, 0xa4, 0x27, 0xe2, 0x22, 0x90, 0x98, 0xcf, 0x56, 0x7f, 0x26, 0xae, 0x30,
-	0x29, 0xfd, 0x99, 0x65, 0x7f, 0x4b, 0xe9, 0xcf, 0x4c, 0x1b, 0x54, 0xc6, 0xfe, 0x74, 0xe9, 0x65,
-	0x4c, 0x28, 0xa3, 0xdf, 0x11, 0xd8, 0x13, 0x98, 0xd0, 0xe9, 0x78, 0x22, 0xda, 0xa8, 0x75, 0x88,
-	0x9b, 0x68, 0x47, 0x05, 0x09, 0x5d, 0xf6, 0x08, 0xbd, 0x4c, 0xab, 0x9d, 0x10, 0x32, 0x02, 0xb0,
-	0x1f, 0x11, 0xc8, 0x47, 0xcc, 0xb6, 0x29, 0x9d, 0x19, 0x3f, 0xc4, 0x73, 0x53, 0xed, 0x2b, 0x22,
-	0xb5, 0x8b, 0x1e, 0xb5, 0xb3, 0xf4, 0xa5, 0x4e, 0xa8, 0xf9, 0x5e, 0xe6, 0x1b, 0x04, 0x68, 0xd8,
-	0x19, 0x3d, 0xd9, 0x26, 0x3a, 0x87, 0xd5, 0xa9, 0xb6, 0xf5, 0x90, 0xd4, 0xbb, 0x1e, 0xa9, 0x2b,
-	0xf4, 0xf5, 0xad, 0x91, 0x0a, 0xcf, 0x00, 0xdf, 0x10, 0xd8, 0x1b, 0x1c, 0x26, 0x69, 0x72, 0x51,
-	0x45, 0x4e, 0xbb, 0xdc, 0x64, 0x5b, 0x3a, 0xc8, 0xec, 0x45, 0x8f, 0xd9, 0x04, 0x7d, 0x36, 0x8e,
-	0x59, 0xdd, 0x55, 0x9e, 0x53, 0xb5, 0x6b, 0xba, 0xb0, 0x62, 0x0f, 0xd2, 0xab, 0xf4, 0x43, 0x02,
-	0xbd, 0xad, 0x11, 0x95, 0x0e, 0x25, 0x3a, 0xf7, 0x4d, 0xc3, 0xdc, 0x70, 0x06, 0x49, 0x04, 0x37,
-	0xec, 0x81, 0x2b, 0xd2, 0xc3, 0x71, 0xe0, 0x5a, 0x13, 0x31, 0xfd, 0x88, 0x40, 0x9f, 0x3d, 0xbf,
-	0xd2, 0x91, 0x64, 0x07, 0xfe, 0x91, 0x99, 0x1b, 0xcd, 0x24, 0x8b, 0x70, 0x46, 0x3d, 0x38, 0x65,
-	0x5a, 0x8c, 0x85, 0x63, 0x4f, 0xd1, 0xe7, 0x1f, 0xac, 0x17, 0xc9, 0xc3, 0xf5, 0x22, 0xf9, 0x7d,
-	0xbd, 0x48, 0x3e, 0xde, 0x28, 0xe6, 0x1e, 0x6e, 0x14, 0x73, 0xbf, 0x6c, 0x14, 0x73, 0x6f, 0x1f,
-	0x57, 0x54, 0xb3, 0xbe, 0x34, 0x5f, 0x59, 0xd0, 0x17, 0x1d, 0x1b, 0xf6, 0x7f, 0x63, 0x4c, 0xba,
-	0x2e, 0xdc, 0x74, 0x0d, 0x9a, 0xb7, 0x9a, 0x32, 0x9b, 0xef, 0xb3, 0xfe, 0x56, 0x38, 0xf9, 0x5f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x04, 0xb1, 0x80, 0x02, 0x3a, 0x1d, 0x00, 0x00,
+	0x1b, 0xef, 0x5b, 0xfa, 0xf5, 0xb3, 0x0f, 0x81, 0xc0, 0xbb, 0xa5, 0x94, 0x01, 0xb7, 0xcb, 0x04,
+	0xb1, 0x14, 0xd8, 0xb1, 0x05, 0x01, 0x31, 0x1c, 0x5a, 0x08, 0x8a, 0x10, 0x2c, 0x6b, 0x6c, 0xf0,
+	0x94, 0xcd, 0x6c, 0x67, 0x98, 0x9d, 0xd0, 0xce, 0xbb, 0xcc, 0x3b, 0x4b, 0x68, 0x08, 0xd1, 0x78,
+	0x61, 0xb8, 0x32, 0x26, 0xde, 0x1b, 0x2e, 0xbc, 0x30, 0x8a, 0x09, 0x17, 0x98, 0xe8, 0x0d, 0x97,
+	0x86, 0x0b, 0x63, 0x88, 0x06, 0xa3, 0x37, 0x68, 0x5a, 0x13, 0xbd, 0xf1, 0x0f, 0x30, 0x31, 0xc6,
+	0xec, 0xcc, 0x33, 0xa7, 0xce, 0x71, 0xb7, 0xdb, 0xa4, 0xdc, 0x40, 0xfb, 0xce, 0x73, 0xf8, 0xfd,
+	0x9e, 0xc3, 0x3b, 0xcf, 0x33, 0x05, 0x71, 0x96, 0xf1, 0x79, 0xc6, 0x25, 0x6e, 0xc9, 0x57, 0x74,
+	0x43, 0x93, 0xae, 0x8d, 0xd7, 0x54, 0x4b, 0x1e, 0x97, 0xae, 0x36, 0x55, 0x73, 0xa1, 0xdc, 0x30,
+	0x99, 0xc5, 0xe8, 0x90, 0x23, 0x53, 0x46, 0x99, 0x32, 0xca, 0x08, 0x63, 0xa8, 0x5b, 0x93, 0xb9,
+	0xea, 0x28, 0x78, 0xea, 0x0d, 0x59, 0xd3, 0x0d, 0xd9, 0xd2, 0x99, 0xe1, 0xd8, 0x10, 0x06, 0x35,
+	0xa6, 0x31, 0xfb, 0x47, 0xa9, 0xf5, 0x13, 0x9e, 0xee, 0xd0, 0x18, 0xd3, 0xe6, 0x54, 0x49, 0x6e,
+	0xe8, 0x92, 0x6c, 0x18, 0xcc, 0xb2, 0x55, 0x38, 0x3e, 0xdd, 0x95, 0x80, 0xcd, 0xc5, 0xe1, 0x48,
+	0x6d, 0x73, 0xa4, 0xaa, 0x8e, 0x71, 0x84, 0xea, 0x3c, 0xda, 0x8e, 0x06, 0x5c, 0x6c, 0x41, 0x56,
+	0xc2, 0x66, 0x79, 0x5e, 0x37, 0x98, 0x64, 0xff, 0xeb, 0x1c, 0x89, 0xd7, 0x61, 0xe8, 0x62, 0x4b,
+	0x62, 0x46, 0x9e, 0xd3, 0x15, 0xd9, 0x62, 0x26, 0xaf, 0xa8, 0x57, 0x9b, 0x2a, 0xb7, 0xe8, 0x10,
+	0xf4, 0x73, 0x4b, 0xb6, 0x9a, 0x7c, 0x98, 0x94, 0xc8, 0xe8, 0x40, 0x05, 0x7f, 0xa3, 0x67, 0x00,
+	0x7c, 0xaa, 0xc3, 0xbd, 0x25, 0x32, 0xba, 0x7e, 0x62, 0x77, 0x19, 0x41, 0xb4, 0xe2, 0x52, 0x76,
+	0x5c, 0x22, 0xf4, 0xf2, 0xb4, 0xac, 0xa9, 0x68, 0xb3, 0x12, 0xd0, 0x14, 0xef, 0x12, 0xd8, 0x1a,
+	0x71, 0xcd, 0x1b, 0xcc, 0xe0, 0x2a, 0x3d, 0x0f, 0x70, 0xcd, 0x3b, 0x1d, 0x26, 0xa5, 0x75, 0xa3,
+	0xeb, 0x27, 0x76, 0x96, 0xe3, 0x73, 0x52, 0xf6, 0xf4, 0xa7, 0x06, 0x1e, 0x3c, 0x1e, 0xe9, 0xf9,
+	0xec, 0x8f, 0xbb, 0x63, 0xa4, 0x12, 0xd0, 0xa7, 0x2f, 0xc5, 0x20, 0x7e, 0x36, 0x13, 0xb1, 0x03,
+	0x25, 0x04, 0xf9, 0x12, 0x6c, 0x09, 0x23, 0x76, 0x63, 0x75, 0x02, 0x36, 0x7a, 0xfe, 0xaa, 0xb2,
+	0xa2, 0x98, 0x4e, 0xcc, 0xa6, 0x86, 0x7f, 0xb8, 0xb7, 0x7f, 0x10, 0x1d, 0x4d, 0x2a, 0x8a, 0xa9,
+	0x72, 0xfe, 0x9a, 0x65, 0xea, 0x86, 0x56, 0xd9, 0xe0, 0xc9, 0xb7, 0xce, 0x45, 0x65, 0x79, 0x1a,
+	0xbc, 0x50, 0xbc, 0x02, 0x03, 0x9e, 0xa8, 0x6d, 0xb5, 0xdd, 0x48, 0xf8, 0xea, 0xe2, 0x17, 0x04,
+	0x4a, 0x61, 0x37, 0xa7, 0xd5, 0x39, 0x55, 0x73, 0x2a, 0xb0, 0x5b, 0x5c, 0xba, 0x56, 0x20, 0x7f,
+	0x11, 0xd8, 0x99, 0x82, 0x16, 0xe3, 0xf3, 0x2e, 0x0c, 0x2a, 0xde, 0x71, 0xd5, 0xc4, 0x63, 0xb7,
+	0x68, 0xc6, 0x92, 0x42, 0xe5, 0x9b, 0x72, 0x2d, 0x4d, 0x95, 0x5a, 0x31, 0xfb, 0xfc, 0xd7, 0x91,
+	0x42, 0xf4, 0x19, 0x77, 0x42, 0x59, 0x50, 0xa2, 0x4f, 0xba, 0x57, 0x5d, 0xf7, 0x08, 0xec, 0x09,
+	0xf3, 0x7d, 0xdd, 0xa8, 0x31, 0x43, 0xd1, 0x0d, 0x6d, 0x2d, 0xa7, 0xe9, 0x31, 0x81, 0xb1, 0x3c,
+	0xb0, 0x31, 0x5f, 0x1a, 0x14, 0x9a, 0xee, 0xf3, 0x48, 0xba, 0xf6, 0x26, 0xa5, 0x2b, 0xc6, 0x64,
+	0xb0, 0xc6, 0xa9, 0x67, 0x72, 0x15, 0xf2, 0xf2, 0x29, 0xc1, 0xe6, 0x0c, 0xd6, 0x85, 0x97, 0x04,
+	0x2c, 0x89, 0xdc, 0x49, 0xf0, 0xe4, 0xed, 0x24, 0x44, 0xb3, 0xd8, 0xdb, 0x56, 0x16, 0x8f, 0x3e,
+	0x75, 0xeb, 0xf6, 0x48, 0xcf, 0x9f, 0xb7, 0x47, 0x7a, 0xc4, 0x6b, 0xb0, 0x35, 0x82, 0x12, 0x63,
+	0xfe, 0x16, 0x14, 0x62, 0x7a, 0x04, 0x6f, 0x93, 0x36, 0x5a, 0xa4, 0x42, 0xa3, 0x0d, 0x20, 0x7e,
+	0x49, 0x60, 0xc4, 0x76, 0x1c, 0x93, 0xa3, 0xb5, 0x18, 0x27, 0x13, 0x4a, 0xc9, 0x70, 0x31, 0x60,
+	0x17, 0xa0, 0xdf, 0xa9, 0x28, 0x8c, 0x51, 0xa7, 0x75, 0x89, 0x56, 0xc4, 0xaf, 0xdc, 0x8b, 0xf7,
+	0xb4, 0xcb, 0x2a, 0xbe, 0xa3, 0x57, 0x16, 0xa4, 0x2e, 0x75, 0x74, 0x20, 0x56, 0x3f, 0xb9, 0x57,
+	0x70, 0x3c, 0x6e, 0x8c, 0x56, 0xbd, 0x6b, 0x57, 0x70, 0x20, 0x74, 0xab, 0x7b, 0xd7, 0xde, 0x77,
+	0xef, 0x5a, 0x8f, 0x58, 0xc6, 0x5d, 0xbb, 0xd6, 0x32, 0xe3, 0xdd, 0xba, 0x19, 0x04, 0x9e, 0xd8,
+	0x5b, 0xf7, 0x7e, 0x2f, 0x6c, 0xb3, 0x09, 0x56, 0x54, 0x65, 0x55, 0x32, 0x42, 0xb9, 0x39, 0x5b,
+	0x6d, 0xf3, 0x52, 0xd9, 0xc4, 0xcd, 0xd9, 0x99, 0x65, 0x6f, 0x51, 0xaa, 0x70, 0x6b, 0xb9, 0x9d,
+	0x75, 0x59, 0x76, 0x14, 0x6e, 0xcd, 0xa4, 0xbc, 0x8d, 0xfb, 0xba, 0x50, 0x21, 0x8f, 0x08, 0x08,
+	0x71, 0x01, 0xc4, 0x8a, 0x30, 0x60, 0xc8, 0x54, 0x53, 0xda, 0x76, 0x5f, 0x52, 0x51, 0x04, 0xcd,
+	0xc5, 0x35, 0xee, 0x16, 0x53, 0x5d, 0xed, 0x31, 0x69, 0x24, 0x5c, 0xf9, 0xd1, 0xdd, 0x65, 0x0d,
+	0x36, 0xec, 0x37, 0x91, 0x57, 0xc0, 0x93, 0xb3, 0xf7, 0xdc, 0x21, 0x50, 0x4c, 0xc0, 0xbe, 0x16,
+	0xdf, 0xf0, 0xf3, 0x89, 0x05, 0xb2, 0x2a, 0x5b, 0xd5, 0x41, 0xec, 0xb3, 0x97, 0x75, 0x6e, 0x31,
+	0x53, 0x9f, 0x95, 0xe7, 0xce, 0x1a, 0x97, 0x59, 0x60, 0x8d, 0xae, 0xab, 0xba, 0x56, 0xb7, 0x6c,
+	0x37, 0xeb, 0x2a, 0xf8, 0x9b, 0xf8, 0x06, 0x6c, 0x8f, 0xd5, 0x42, 0x80, 0x47, 0xa1, 0xaf, 0xae,
+	0x73, 0x6b, 0x98, 0x84, 0x4b, 0x6f, 0x39, 0xb6, 0x65, 0xda, 0xb6, 0x8e, 0x48, 0x61, 0x93, 0x6d,
+	0x7a, 0x9a, 0xb1, 0x39, 0x84, 0x21, 0x4e, 0xc3, 0xe6, 0xc0, 0x19, 0x3a, 0x79, 0x11, 0xfa, 0x1a,
+	0x8c, 0xcd, 0xa1, 0x93, 0x1d, 0x49, 0x4e, 0x5a, 0x3a, 0x41, 0xee, 0xb6, 0x92, 0x38, 0x08, 0xd4,
+	0xb1, 0x28, 0x9b, 0xf2, 0xbc, 0xdb, 0x79, 0xe2, 0x25, 0x28, 0x84, 0x4e, 0xd1, 0xd3, 0x24, 0xf4,
+	0x37, 0xec, 0x13, 0xf4, 0x55, 0x4c, 0xf4, 0x65, 0x4b, 0x85, 0x66, 0x28, 0x47, 0x51, 0xac, 0xc3,
+	0x33, 0xb6, 0xe5, 0x53, 0xcc, 0xe0, 0xd3, 0xcd, 0xda, 0x39, 0x75, 0xa1, 0x82, 0x1f, 0x4f, 0x9c,
+	0x20, 0x2c, 0x74, 0x6d, 0x19, 0x7f, 0x8f, 0xc0, 0xee, 0x2c, 0x57, 0xc8, 0x6b, 0x06, 0xfe, 0x5f,
+	0x77, 0x8e, 0xb0, 0x5b, 0xc7, 0x93, 0x88, 0x25, 0xda, 0x0a, 0x72, 0x75, 0x8d, 0x4d, 0xdc, 0x19,
+	0x86, 0xff, 0xd9, 0x10, 0xe8, 0x27, 0x04, 0xc0, 0xbf, 0x29, 0x68, 0x39, 0xc9, 0x7e, 0xfc, 0x57,
+	0x1c, 0x41, 0xca, 0x2d, 0x8f, 0xe3, 0xbc, 0x74, 0xab, 0x85, 0xe4, 0xfd, 0x1f, 0x7f, 0xff, 0xb8,
+	0x77, 0x17, 0x15, 0xa5, 0x84, 0xef, 0x51, 0x81, 0x5b, 0xe6, 0x0e, 0x81, 0x01, 0xcf, 0x0e, 0xdd,
+	0x9f, 0xcf, 0x9f, 0x0b, 0xaf, 0x9c, 0x57, 0x1c, 0xd1, 0x9d, 0xf4, 0xd1, 0x3d, 0x4f, 0x0f, 0x64,
+	0xa3, 0x93, 0x6e, 0x84, 0x4b, 0xe1, 0x26, 0xfd, 0x85, 0xc0, 0x60, 0xdc, 0x07, 0x05, 0x7a, 0x24,
+	0x1f, 0x94, 0xe8, 0x78, 0x28, 0xbc, 0xd0, 0x81, 0x26, 0xf2, 0x39, 0xef, 0xf3, 0x99, 0xa4, 0x27,
+	0x3a, 0xe0, 0x23, 0x05, 0xde, 0xed, 0xf4, 0x5f, 0x02, 0x4f, 0xa7, 0x6e, 0xe1, 0x74, 0x32, 0x1f,
+	0xd4, 0x94, 0x61, 0x58, 0x98, 0x5a, 0x89, 0x09, 0xa4, 0x3d, 0xe3, 0xd3, 0x3e, 0x47, 0xcf, 0x76,
+	0x42, 0xdb, 0x9f, 0x66, 0x83, 0x01, 0xf8, 0x8e, 0x00, 0xf8, 0xfe, 0x32, 0x9a, 0x25, 0xb2, 0xa6,
+	0x0a, 0x52, 0x6e, 0x79, 0xe4, 0xf1, 0x8e, 0xcf, 0xa3, 0x42, 0xa7, 0x57, 0x98, 0x3e, 0xe9, 0x46,
+	0xf8, 0x0d, 0x7a, 0x93, 0xfe, 0x43, 0xa0, 0x10, 0x13, 0x47, 0x7a, 0x38, 0x15, 0x67, 0xf2, 0x1e,
+	0x2e, 0x1c, 0x69, 0x5f, 0x11, 0x99, 0x9a, 0x3e, 0x53, 0x8d, 0xaa, 0xdd, 0x66, 0x1a, 0x9b, 0x4e,
+	0xfa, 0x3d, 0x81, 0xc1, 0xb8, 0xc5, 0x33, 0xa3, 0x55, 0x53, 0x76, 0xec, 0x8c, 0x56, 0x4d, 0xdb,
+	0x72, 0xc5, 0x49, 0x3f, 0x02, 0x87, 0xe8, 0xc1, 0xa4, 0x08, 0xa4, 0xe6, 0xb3, 0xd5, 0x9f, 0xa9,
+	0xfb, 0x5a, 0x46, 0x7f, 0xe6, 0x59, 0x56, 0x33, 0xfa, 0x33, 0xd7, 0xba, 0x98, 0xb3, 0x3f, 0x3d,
+	0x7a, 0x39, 0x13, 0xca, 0xe9, 0xb7, 0x04, 0x36, 0x84, 0xd6, 0x11, 0x3a, 0x9e, 0x8a, 0x36, 0x6e,
+	0xf7, 0x13, 0x26, 0xda, 0x51, 0x41, 0x42, 0x17, 0x7c, 0x42, 0xa7, 0xe8, 0x64, 0x27, 0x84, 0xcc,
+	0x10, 0xec, 0x47, 0x04, 0x0a, 0x31, 0x83, 0x7c, 0x46, 0x67, 0x26, 0x6f, 0x2c, 0xc2, 0x91, 0xf6,
+	0x15, 0x91, 0xda, 0x39, 0x9f, 0xda, 0x49, 0x7a, 0xbc, 0x13, 0x6a, 0x81, 0x97, 0xf9, 0x12, 0x01,
+	0x1a, 0x75, 0x46, 0x0f, 0xb5, 0x89, 0xce, 0x65, 0x75, 0xb8, 0x6d, 0x3d, 0x24, 0xf5, 0xb6, 0x4f,
+	0xea, 0x22, 0x7d, 0x75, 0x65, 0xa4, 0xa2, 0x33, 0xc0, 0xd7, 0x04, 0x36, 0x86, 0x27, 0x67, 0x9a,
+	0x5e, 0x54, 0xb1, 0xa3, 0xbd, 0x70, 0xa0, 0x2d, 0x1d, 0x64, 0x76, 0xcc, 0x67, 0x36, 0x41, 0x9f,
+	0x4b, 0x62, 0x56, 0xf7, 0x94, 0xab, 0xba, 0x71, 0x99, 0x49, 0x37, 0x9c, 0xad, 0xe1, 0x26, 0xfd,
+	0x80, 0x40, 0x5f, 0x6b, 0x1e, 0xa7, 0xa3, 0xa9, 0xce, 0x03, 0xa3, 0xbf, 0xb0, 0x27, 0x87, 0x24,
+	0x82, 0xdb, 0xe3, 0x83, 0x2b, 0xd2, 0x1d, 0x49, 0xe0, 0x5a, 0xe3, 0x3f, 0xfd, 0x90, 0x40, 0xbf,
+	0x33, 0xac, 0xd3, 0xb1, 0x74, 0x07, 0xc1, 0xfd, 0x40, 0xd8, 0x9b, 0x4b, 0x16, 0xe1, 0xec, 0xf5,
+	0xe1, 0x94, 0x68, 0x31, 0x11, 0x8e, 0x83, 0xe2, 0x6f, 0x02, 0xdb, 0x12, 0x87, 0x6c, 0x7a, 0x2c,
+	0xd5, 0x6f, 0xd6, 0x4e, 0x21, 0x1c, 0xef, 0x54, 0xbd, 0x6b, 0x83, 0xc2, 0x2c, 0x33, 0x78, 0xb5,
+	0xd1, 0xac, 0x5d, 0x51, 0x17, 0xaa, 0x26, 0x7a, 0xa9, 0xe2, 0xba, 0x30, 0x75, 0xe6, 0xc1, 0x62,
+	0x91, 0x3c, 0x5c, 0x2c, 0x92, 0xdf, 0x16, 0x8b, 0xe4, 0xa3, 0xa5, 0x62, 0xcf, 0xc3, 0xa5, 0x62,
+	0xcf, 0xcf, 0x4b, 0xc5, 0x9e, 0x37, 0xf7, 0x69, 0xba, 0x55, 0x6f, 0xd6, 0xca, 0xb3, 0x6c, 0xde,
+	0xf5, 0xea, 0xfc, 0xb7, 0x9f, 0x2b, 0x57, 0xa4, 0xeb, 0x1e, 0x04, 0x6b, 0xa1, 0xa1, 0xf2, 0x5a,
+	0xbf, 0xfd, 0x47, 0xe1, 0x03, 0xff, 0x0d, 0x00, 0x8b, 0x16, 0x30, 0x9a, 0x23, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1581,6 +1685,9 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ConsPubKeyRotationHistory queries the consensus public key rotations
+	// performed by a validator.
+	ConsPubKeyRotationHistory(ctx context.Context, in *QueryConsPubKeyRotationHistoryRequest, opts ...grpc.CallOption) (*QueryConsPubKeyRotationHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -1717,6 +1824,15 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ConsPubKeyRotationHistory(ctx context.Context, in *QueryConsPubKeyRotationHistoryRequest, opts ...grpc.CallOption) (*QueryConsPubKeyRotationHistoryResponse, error) {
+	out := new(QueryConsPubKeyRotationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/ConsPubKeyRotationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1772,6 +1888,9 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ConsPubKeyRotationHistory queries the consensus public key rotations
+	// performed by a validator.
+	ConsPubKeyRotationHistory(context.Context, *QueryConsPubKeyRotationHistoryRequest) (*QueryConsPubKeyRotationHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1820,6 +1939,9 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) ConsPubKeyRotationHistory(ctx context.Context, req *QueryConsPubKeyRotationHistoryRequest) (*QueryConsPubKeyRotationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsPubKeyRotationHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2077,6 +2199,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConsPubKeyRotationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsPubKeyRotationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConsPubKeyRotationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/ConsPubKeyRotationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConsPubKeyRotationHistory(ctx, req.(*QueryConsPubKeyRotationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2137,6 +2277,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "ConsPubKeyRotationHistory",
+			Handler:    _Query_ConsPubKeyRotationHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3243,6 +3387,73 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConsPubKeyRotationHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsPubKeyRotationHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsPubKeyRotationHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsPubKeyRotationHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsPubKeyRotationHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsPubKeyRotationHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3689,6 +3900,34 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConsPubKeyRotationHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsPubKeyRotationHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6605,6 +6844,172 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConsPubKeyRotationHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsPubKeyRotationHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsPubKeyRotationHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsPubKeyRotationHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsPubKeyRotationHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsPubKeyRotationHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ConsPubKeyRotationHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
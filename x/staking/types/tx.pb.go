@@ -635,6 +635,83 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRotateConsPubKey is the Msg/RotateConsPubKey request type.
+type MsgRotateConsPubKey struct {
+	ValidatorAddress string     `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	NewPubkey        *types.Any `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty"`
+}
+
+func (m *MsgRotateConsPubKey) Reset()         { *m = MsgRotateConsPubKey{} }
+func (m *MsgRotateConsPubKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKey) ProtoMessage()    {}
+func (*MsgRotateConsPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{14}
+}
+func (m *MsgRotateConsPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKey.Merge(m, src)
+}
+func (m *MsgRotateConsPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKey proto.InternalMessageInfo
+
+// MsgRotateConsPubKeyResponse defines the response structure for executing a
+// MsgRotateConsPubKey message.
+type MsgRotateConsPubKeyResponse struct {
+}
+
+func (m *MsgRotateConsPubKeyResponse) Reset()         { *m = MsgRotateConsPubKeyResponse{} }
+func (m *MsgRotateConsPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateConsPubKeyResponse) ProtoMessage()    {}
+func (*MsgRotateConsPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0926ef28816b35ab, []int{15}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateConsPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateConsPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateConsPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateConsPubKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateValidator)(nil), "cosmos.staking.v1beta1.MsgCreateValidator")
 	proto.RegisterType((*MsgCreateValidatorResponse)(nil), "cosmos.staking.v1beta1.MsgCreateValidatorResponse")
@@ -650,81 +727,88 @@ func init() {
 	proto.RegisterType((*MsgCancelUnbondingDelegationResponse)(nil), "cosmos.staking.v1beta1.MsgCancelUnbondingDelegationResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.staking.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.staking.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRotateConsPubKey)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKey")
+	proto.RegisterType((*MsgRotateConsPubKeyResponse)(nil), "cosmos.staking.v1beta1.MsgRotateConsPubKeyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1100 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x89, 0x21, 0x13, 0x92, 0x34, 0x9b, 0xa4, 0xb5, 0x97, 0x62, 0x57, 0xdb, 0xd0,
-	0x44, 0x81, 0xac, 0x69, 0x40, 0xfc, 0x30, 0x11, 0x22, 0xae, 0x5b, 0x51, 0xc0, 0x52, 0xb4, 0xa1,
-	0x3d, 0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x59, 0xaf, 0xec, 0xdd, 0xd9, 0xee, 0x8c, 0xa3, 0xfa, 0x86,
-	0x38, 0x21, 0x2e, 0x54, 0x88, 0x03, 0x17, 0xa4, 0x1e, 0x39, 0xe6, 0xd0, 0x03, 0xff, 0x00, 0x52,
-	0xc5, 0xa9, 0xea, 0x09, 0x71, 0x68, 0x51, 0x72, 0x08, 0xff, 0x01, 0x57, 0xb4, 0xbb, 0xb3, 0x3f,
-	0x6d, 0xaf, 0x37, 0x55, 0x7b, 0x80, 0x4b, 0xbc, 0x79, 0xf3, 0xbd, 0x6f, 0x66, 0xbe, 0x6f, 0xde,
-	0xcc, 0x03, 0x15, 0x05, 0x13, 0x03, 0x93, 0x2a, 0xa1, 0xb0, 0xab, 0x9b, 0x5a, 0xf5, 0xf0, 0x6a,
-	0x1b, 0x51, 0x78, 0xb5, 0x4a, 0xef, 0x4a, 0x96, 0x8d, 0x29, 0xe6, 0xcf, 0x7b, 0x00, 0x89, 0x01,
-	0x24, 0x06, 0x10, 0x4a, 0x1a, 0xc6, 0x5a, 0x0f, 0x55, 0x5d, 0x54, 0xbb, 0x7f, 0x50, 0x85, 0xe6,
-	0xc0, 0x4b, 0x11, 0x2a, 0xc9, 0x21, 0xaa, 0x1b, 0x88, 0x50, 0x68, 0x58, 0x0c, 0xb0, 0xa2, 0x61,
-	0x0d, 0xbb, 0x9f, 0x55, 0xe7, 0x8b, 0x45, 0x4b, 0xde, 0x4c, 0x2d, 0x6f, 0x80, 0x4d, 0xeb, 0x0d,
-	0x95, 0xd9, 0x2a, 0xdb, 0x90, 0xa0, 0x60, 0x89, 0x0a, 0xd6, 0x4d, 0x36, 0xbe, 0x36, 0x66, 0x17,
-	0xfe, 0xa2, 0x3d, 0xd4, 0x05, 0x86, 0x32, 0x88, 0x83, 0x70, 0x7e, 0xd8, 0xc0, 0x12, 0x34, 0x74,
-	0x13, 0x57, 0xdd, 0xbf, 0x5e, 0x48, 0xfc, 0x61, 0x06, 0xf0, 0x4d, 0xa2, 0x5d, 0xb3, 0x11, 0xa4,
-	0xe8, 0x36, 0xec, 0xe9, 0x2a, 0xa4, 0xd8, 0xe6, 0xf7, 0xc0, 0x9c, 0x8a, 0x88, 0x62, 0xeb, 0x16,
-	0xd5, 0xb1, 0x59, 0xe4, 0x2e, 0x71, 0x1b, 0x73, 0xdb, 0x97, 0xa5, 0xd1, 0x1a, 0x49, 0x8d, 0x10,
-	0x5a, 0x9f, 0x7d, 0xf8, 0xa4, 0x92, 0xfb, 0xe5, 0xf4, 0x68, 0x93, 0x93, 0xa3, 0x14, 0xbc, 0x0c,
-	0x80, 0x82, 0x0d, 0x43, 0x27, 0xc4, 0x21, 0xcc, 0xbb, 0x84, 0xeb, 0xe3, 0x08, 0xaf, 0x05, 0x48,
-	0x19, 0x52, 0x44, 0xa2, 0xa4, 0x11, 0x16, 0xbe, 0x07, 0x96, 0x0d, 0xdd, 0x6c, 0x11, 0xd4, 0x3b,
-	0x68, 0xa9, 0xa8, 0x87, 0x34, 0xe8, 0xae, 0x76, 0xea, 0x12, 0xb7, 0x31, 0x5b, 0xdf, 0x71, 0x72,
-	0xfe, 0x7c, 0x52, 0xb9, 0xa2, 0xe9, 0xb4, 0xd3, 0x6f, 0x4b, 0x0a, 0x36, 0x98, 0xd8, 0xec, 0x67,
-	0x8b, 0xa8, 0xdd, 0x2a, 0x1d, 0x58, 0x88, 0x48, 0x37, 0x4d, 0xfa, 0xf8, 0xc1, 0x16, 0x60, 0xab,
-	0xb9, 0x69, 0x52, 0x79, 0xc9, 0xd0, 0xcd, 0x7d, 0xd4, 0x3b, 0x68, 0x04, 0xb4, 0xfc, 0x75, 0xb0,
-	0xc4, 0x26, 0xc1, 0x76, 0x0b, 0xaa, 0xaa, 0x8d, 0x08, 0x29, 0x4e, 0xbb, 0x73, 0x15, 0x1f, 0x3f,
-	0xd8, 0x5a, 0x61, 0xd9, 0xbb, 0xde, 0xc8, 0x3e, 0xb5, 0x75, 0x53, 0x93, 0xcf, 0x05, 0x29, 0x2c,
-	0xee, 0xd0, 0x1c, 0xfa, 0x3a, 0x07, 0x34, 0x33, 0x93, 0x68, 0x82, 0x14, 0x9f, 0xe6, 0x06, 0x28,
-	0x58, 0xfd, 0x76, 0x17, 0x0d, 0x8a, 0x05, 0x57, 0xcb, 0x15, 0xc9, 0x3b, 0x8d, 0x92, 0x7f, 0x1a,
-	0xa5, 0x5d, 0x73, 0x50, 0x2f, 0xfe, 0x1e, 0x32, 0x2a, 0xf6, 0xc0, 0xa2, 0x58, 0xda, 0xeb, 0xb7,
-	0x3f, 0x43, 0x03, 0x99, 0x65, 0xf3, 0x35, 0x30, 0x73, 0x08, 0x7b, 0x7d, 0x54, 0x7c, 0xc9, 0xa5,
-	0x29, 0xf9, 0x96, 0x38, 0x47, 0x30, 0xe2, 0x87, 0x1e, 0x73, 0xd6, 0x4b, 0xa9, 0xdd, 0xfe, 0xf6,
-	0x7e, 0x25, 0xf7, 0xf7, 0xfd, 0x4a, 0xee, 0x9b, 0xd3, 0xa3, 0xcd, 0x61, 0x71, 0xdc, 0xe8, 0xd0,
-	0x5e, 0xbf, 0x3b, 0x3d, 0xda, 0x7c, 0x2d, 0xe2, 0xc0, 0xf0, 0xe9, 0x13, 0x2f, 0x02, 0x61, 0x38,
-	0x2a, 0x23, 0x62, 0x61, 0x93, 0x20, 0xf1, 0xd7, 0x29, 0x70, 0xae, 0x49, 0xb4, 0xeb, 0xaa, 0x4e,
-	0x5f, 0xe4, 0x81, 0x1d, 0xe9, 0x53, 0xfe, 0xcc, 0x3e, 0x41, 0xb0, 0x18, 0x9e, 0xd8, 0x96, 0x0d,
-	0x29, 0x62, 0xe7, 0xf3, 0xfd, 0x8c, 0x67, 0xb3, 0x81, 0x94, 0xc8, 0xd9, 0x6c, 0x20, 0x45, 0x5e,
-	0x50, 0x62, 0xe5, 0xc1, 0x77, 0x46, 0x97, 0xc1, 0xf4, 0x99, 0xa6, 0xc9, 0x52, 0x02, 0xb5, 0x8f,
-	0x62, 0x86, 0x8f, 0xb4, 0xf6, 0xd5, 0xb8, 0xb5, 0x31, 0x97, 0x44, 0x01, 0x14, 0x93, 0xb1, 0xc0,
-	0xd6, 0x1f, 0xf3, 0x60, 0xae, 0x49, 0x34, 0x36, 0x1b, 0x1a, 0x5d, 0x6e, 0xdc, 0xf3, 0x29, 0xb7,
-	0xb3, 0xdb, 0xb8, 0x03, 0x0a, 0xd0, 0xc0, 0x7d, 0x93, 0xba, 0xee, 0x65, 0xad, 0x13, 0x96, 0x53,
-	0xfb, 0x20, 0xbd, 0x50, 0x1c, 0xdd, 0xce, 0xc7, 0x75, 0xf3, 0x65, 0x10, 0x57, 0xc1, 0x72, 0xe4,
-	0xdf, 0x40, 0xad, 0x7f, 0xf2, 0xee, 0xbd, 0x5d, 0x47, 0x9a, 0x6e, 0xca, 0x48, 0x7d, 0xce, 0xa2,
-	0x7d, 0x0e, 0x56, 0x43, 0xd1, 0x88, 0xad, 0x64, 0x16, 0x6e, 0x39, 0x48, 0xdb, 0xb7, 0x95, 0x91,
-	0x6c, 0x2a, 0xa1, 0x01, 0xdb, 0x54, 0x66, 0xb6, 0x06, 0xa1, 0xc3, 0x4e, 0x4c, 0x3f, 0x83, 0x13,
-	0x1f, 0x4f, 0x76, 0x22, 0x71, 0x39, 0x25, 0x24, 0x16, 0x2d, 0xf7, 0x72, 0x4a, 0x44, 0x7d, 0x5f,
-	0x78, 0xd9, 0x2d, 0x77, 0xab, 0x87, 0x9c, 0x7a, 0x69, 0x39, 0x0d, 0x01, 0xbb, 0x8b, 0x84, 0xa1,
-	0xfb, 0xf9, 0x0b, 0xbf, 0x5b, 0xa8, 0xcf, 0x3b, 0xeb, 0xbc, 0xf7, 0xb4, 0xc2, 0x79, 0x6b, 0x5d,
-	0x08, 0x19, 0x1c, 0x8c, 0xf8, 0x53, 0x1e, 0xcc, 0x37, 0x89, 0x76, 0xcb, 0x54, 0xff, 0x8f, 0xb5,
-	0xf1, 0xe1, 0x64, 0x47, 0x8a, 0x71, 0x47, 0x42, 0x21, 0xc4, 0x2e, 0x58, 0x8d, 0x05, 0x5e, 0xa8,
-	0x0f, 0x4f, 0xf3, 0xe0, 0xa2, 0xf3, 0x2e, 0x41, 0x53, 0x41, 0xbd, 0x5b, 0x66, 0x1b, 0x9b, 0xaa,
-	0x6e, 0x6a, 0x93, 0x3a, 0x84, 0xff, 0xa6, 0x2d, 0xfc, 0x3a, 0x58, 0x54, 0x9c, 0x07, 0xd8, 0x91,
-	0xaf, 0x83, 0x74, 0xad, 0xe3, 0xd5, 0xdb, 0x94, 0xbc, 0xe0, 0x87, 0x3f, 0x71, 0xa3, 0xb5, 0x4f,
-	0x27, 0xfb, 0xb7, 0x9e, 0x78, 0xee, 0xc7, 0x09, 0x28, 0x5e, 0x01, 0x6b, 0x69, 0xe3, 0xc1, 0xed,
-	0xf7, 0x1b, 0x07, 0x16, 0x1d, 0xdf, 0x2d, 0x15, 0x52, 0xb4, 0x07, 0x6d, 0x68, 0x10, 0xfe, 0x5d,
-	0x30, 0x0b, 0xfb, 0xb4, 0x83, 0x6d, 0x9d, 0x0e, 0x26, 0x8a, 0x1e, 0x42, 0xf9, 0x5d, 0x50, 0xb0,
-	0x5c, 0x06, 0xd6, 0x94, 0x96, 0xc7, 0x35, 0x0d, 0xde, 0x3c, 0x31, 0xad, 0xbc, 0xc4, 0xda, 0x7b,
-	0xce, 0xd6, 0x43, 0x4a, 0x67, 0xcb, 0x6b, 0x91, 0x2d, 0xdf, 0x0d, 0xfa, 0xf5, 0xc4, 0x9a, 0xc5,
-	0x12, 0xb8, 0x90, 0x08, 0xf9, 0x5b, 0xdc, 0xfe, 0xb9, 0x00, 0xa6, 0x9a, 0x44, 0xe3, 0xef, 0x80,
-	0xc5, 0x64, 0x73, 0xbe, 0x39, 0x6e, 0x85, 0xc3, 0x4d, 0x93, 0xb0, 0x9d, 0x1d, 0x1b, 0xd4, 0x4e,
-	0x17, 0xcc, 0xc7, 0x9b, 0xab, 0x8d, 0x14, 0x92, 0x18, 0x52, 0x78, 0x2b, 0x2b, 0x32, 0x98, 0xec,
-	0x2b, 0xf0, 0x72, 0xf0, 0xe4, 0x5f, 0x4e, 0xc9, 0xf6, 0x41, 0xc2, 0x1b, 0x19, 0x40, 0x01, 0xfb,
-	0x1d, 0xb0, 0x98, 0x7c, 0x22, 0xd3, 0xd4, 0x4b, 0x60, 0x53, 0xd5, 0x1b, 0xf7, 0x02, 0xb4, 0x01,
-	0x88, 0xdc, 0xd4, 0xaf, 0xa7, 0x30, 0x84, 0x30, 0x61, 0x2b, 0x13, 0x2c, 0x98, 0xe3, 0x7b, 0x0e,
-	0x94, 0xc6, 0x5f, 0x43, 0xef, 0xa4, 0x79, 0x3e, 0x2e, 0x4b, 0xd8, 0x79, 0x96, 0xac, 0x60, 0x45,
-	0x1d, 0xf0, 0x4a, 0xac, 0x1a, 0xd7, 0xd3, 0x36, 0x14, 0x01, 0x0a, 0xd5, 0x8c, 0x40, 0x7f, 0x26,
-	0x61, 0xe6, 0x6b, 0xa7, 0xf6, 0xea, 0x37, 0x1e, 0x1e, 0x97, 0xb9, 0x47, 0xc7, 0x65, 0xee, 0xaf,
-	0xe3, 0x32, 0x77, 0xef, 0xa4, 0x9c, 0x7b, 0x74, 0x52, 0xce, 0xfd, 0x71, 0x52, 0xce, 0x7d, 0xf9,
-	0x66, 0x6a, 0xb7, 0x1b, 0x16, 0xa3, 0xdb, 0xf7, 0xb6, 0x0b, 0xee, 0x3b, 0xf0, 0xf6, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa0, 0x87, 0x6e, 0xf3, 0x21, 0x10, 0x00, 0x00,
+	// 1171 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x17, 0xa5, 0xd8, 0xf9, 0xfa, 0xf2, 0xf5, 0x2f, 0xda, 0x4e, 0x64, 0x26, 0x91, 0x02, 0xc6,
+	0x8d, 0x0d, 0xb7, 0xa6, 0x1a, 0xa7, 0xe8, 0x0f, 0xd5, 0x28, 0x6a, 0xd9, 0x09, 0x9a, 0xb6, 0x02,
+	0x0c, 0xba, 0xc9, 0x50, 0x14, 0x10, 0x4e, 0xe4, 0x99, 0x22, 0x2c, 0xde, 0x31, 0xbc, 0x93, 0x13,
+	0x6d, 0x45, 0xa7, 0xa2, 0x4b, 0x83, 0xb6, 0x43, 0xc7, 0x8c, 0x1d, 0x3d, 0x64, 0xe8, 0x3f, 0x50,
+	0x20, 0xe8, 0x14, 0x64, 0x2a, 0x3a, 0x24, 0x85, 0x3d, 0xb8, 0x53, 0xd7, 0xae, 0x05, 0xc9, 0xe3,
+	0x49, 0xa4, 0x7e, 0x1b, 0xc9, 0xd0, 0x2e, 0x16, 0xfd, 0xee, 0xf3, 0x3e, 0xef, 0xee, 0x7d, 0xde,
+	0x3d, 0x3e, 0x82, 0xbc, 0x41, 0xa8, 0x43, 0x68, 0x81, 0x32, 0xb8, 0x6f, 0x63, 0xab, 0x70, 0x70,
+	0xbd, 0x8a, 0x18, 0xbc, 0x5e, 0x60, 0x0f, 0x34, 0xd7, 0x23, 0x8c, 0xc8, 0xe7, 0x43, 0x80, 0xc6,
+	0x01, 0x1a, 0x07, 0x28, 0x8b, 0x16, 0x21, 0x56, 0x1d, 0x15, 0x02, 0x54, 0xb5, 0xb1, 0x57, 0x80,
+	0xb8, 0x19, 0xba, 0x28, 0xf9, 0xe4, 0x12, 0xb3, 0x1d, 0x44, 0x19, 0x74, 0x5c, 0x0e, 0x98, 0xb7,
+	0x88, 0x45, 0x82, 0xc7, 0x82, 0xff, 0xc4, 0xad, 0x8b, 0x61, 0xa4, 0x4a, 0xb8, 0xc0, 0xc3, 0x86,
+	0x4b, 0x39, 0xbe, 0xcb, 0x2a, 0xa4, 0x48, 0x6c, 0xd1, 0x20, 0x36, 0xe6, 0xeb, 0x4b, 0x3d, 0x4e,
+	0x11, 0x6d, 0x3a, 0x44, 0x5d, 0xe0, 0x28, 0x87, 0xfa, 0x08, 0xff, 0x87, 0x2f, 0xcc, 0x42, 0xc7,
+	0xc6, 0xa4, 0x10, 0xfc, 0x0d, 0x4d, 0xea, 0x77, 0x63, 0x40, 0x2e, 0x53, 0x6b, 0xcb, 0x43, 0x90,
+	0xa1, 0xbb, 0xb0, 0x6e, 0x9b, 0x90, 0x11, 0x4f, 0xde, 0x01, 0xe7, 0x4c, 0x44, 0x0d, 0xcf, 0x76,
+	0x99, 0x4d, 0x70, 0x56, 0xba, 0x22, 0xad, 0x9c, 0x5b, 0xbf, 0xaa, 0x75, 0xcf, 0x91, 0xb6, 0xdd,
+	0x82, 0x96, 0x26, 0x9e, 0x3c, 0xcf, 0xa7, 0x7e, 0x3a, 0x39, 0x5c, 0x95, 0xf4, 0x76, 0x0a, 0x59,
+	0x07, 0xc0, 0x20, 0x8e, 0x63, 0x53, 0xea, 0x13, 0xa6, 0x03, 0xc2, 0xe5, 0x5e, 0x84, 0x5b, 0x02,
+	0xa9, 0x43, 0x86, 0x68, 0x3b, 0x69, 0x1b, 0x8b, 0x5c, 0x07, 0x73, 0x8e, 0x8d, 0x2b, 0x14, 0xd5,
+	0xf7, 0x2a, 0x26, 0xaa, 0x23, 0x0b, 0x06, 0xbb, 0xcd, 0x5c, 0x91, 0x56, 0x26, 0x4a, 0x1b, 0xbe,
+	0xcf, 0xef, 0xcf, 0xf3, 0xd7, 0x2c, 0x9b, 0xd5, 0x1a, 0x55, 0xcd, 0x20, 0x0e, 0x4f, 0x36, 0xff,
+	0x59, 0xa3, 0xe6, 0x7e, 0x81, 0x35, 0x5d, 0x44, 0xb5, 0xdb, 0x98, 0x3d, 0x7b, 0xbc, 0x06, 0xf8,
+	0x6e, 0x6e, 0x63, 0xa6, 0xcf, 0x3a, 0x36, 0xde, 0x45, 0xf5, 0xbd, 0x6d, 0x41, 0x2b, 0xdf, 0x04,
+	0xb3, 0x3c, 0x08, 0xf1, 0x2a, 0xd0, 0x34, 0x3d, 0x44, 0x69, 0xf6, 0x4c, 0x10, 0x2b, 0xfb, 0xec,
+	0xf1, 0xda, 0x3c, 0xf7, 0xde, 0x0c, 0x57, 0x76, 0x99, 0x67, 0x63, 0x4b, 0x9f, 0x11, 0x2e, 0xdc,
+	0xee, 0xd3, 0x1c, 0x44, 0x79, 0x16, 0x34, 0x63, 0x83, 0x68, 0x84, 0x4b, 0x44, 0x73, 0x0b, 0x8c,
+	0xbb, 0x8d, 0xea, 0x3e, 0x6a, 0x66, 0xc7, 0x83, 0x5c, 0xce, 0x6b, 0x61, 0x35, 0x6a, 0x51, 0x35,
+	0x6a, 0x9b, 0xb8, 0x59, 0xca, 0xfe, 0xda, 0x62, 0x34, 0xbc, 0xa6, 0xcb, 0x88, 0xb6, 0xd3, 0xa8,
+	0x7e, 0x82, 0x9a, 0x3a, 0xf7, 0x96, 0x8b, 0x60, 0xec, 0x00, 0xd6, 0x1b, 0x28, 0x7b, 0x36, 0xa0,
+	0x59, 0x8c, 0x24, 0xf1, 0x4b, 0xb0, 0x4d, 0x0f, 0x3b, 0xa6, 0x6c, 0xe8, 0x52, 0xbc, 0xfb, 0xf5,
+	0xa3, 0x7c, 0xea, 0xcf, 0x47, 0xf9, 0xd4, 0x57, 0x27, 0x87, 0xab, 0x9d, 0xc9, 0x09, 0xac, 0x1d,
+	0x67, 0xfd, 0xe6, 0xe4, 0x70, 0xf5, 0x72, 0x9b, 0x02, 0x9d, 0xd5, 0xa7, 0x5e, 0x02, 0x4a, 0xa7,
+	0x55, 0x47, 0xd4, 0x25, 0x98, 0x22, 0xf5, 0xe7, 0x0c, 0x98, 0x29, 0x53, 0xeb, 0xa6, 0x69, 0xb3,
+	0x57, 0x59, 0xb0, 0x5d, 0x75, 0x4a, 0x8f, 0xac, 0x13, 0x04, 0xd3, 0xad, 0x8a, 0xad, 0x78, 0x90,
+	0x21, 0x5e, 0x9f, 0xef, 0x0e, 0x59, 0x9b, 0xdb, 0xc8, 0x68, 0xab, 0xcd, 0x6d, 0x64, 0xe8, 0x53,
+	0x46, 0xec, 0x7a, 0xc8, 0xb5, 0xee, 0xd7, 0xe0, 0xcc, 0x48, 0x61, 0x86, 0xb9, 0x02, 0xc5, 0x0f,
+	0x62, 0x82, 0x77, 0x95, 0xf6, 0x62, 0x5c, 0xda, 0x98, 0x4a, 0xaa, 0x02, 0xb2, 0x49, 0x9b, 0x90,
+	0xf5, 0x87, 0x34, 0x38, 0x57, 0xa6, 0x16, 0x8f, 0x86, 0xba, 0x5f, 0x37, 0xe9, 0xe5, 0x5c, 0xb7,
+	0xd1, 0x65, 0xdc, 0x00, 0xe3, 0xd0, 0x21, 0x0d, 0xcc, 0xb2, 0x99, 0x11, 0xee, 0x09, 0xf7, 0x29,
+	0xbe, 0xd7, 0xff, 0xa2, 0xf8, 0x79, 0x3b, 0x1f, 0xcf, 0x5b, 0x94, 0x06, 0x75, 0x01, 0xcc, 0xb5,
+	0xfd, 0x2b, 0xb2, 0xf5, 0x77, 0x3a, 0xe8, 0xdb, 0x25, 0x64, 0xd9, 0x58, 0x47, 0xe6, 0x4b, 0x4e,
+	0xda, 0xa7, 0x60, 0xa1, 0x95, 0x34, 0xea, 0x19, 0x43, 0x27, 0x6e, 0x4e, 0xb8, 0xed, 0x7a, 0x46,
+	0x57, 0x36, 0x93, 0x32, 0xc1, 0x96, 0x19, 0x9a, 0x6d, 0x9b, 0xb2, 0x4e, 0x25, 0xce, 0x9c, 0x42,
+	0x89, 0x0f, 0x07, 0x2b, 0x91, 0x68, 0x4e, 0x89, 0x14, 0xab, 0x2e, 0x50, 0x3a, 0xad, 0x91, 0x2e,
+	0xb2, 0x1e, 0x5c, 0x77, 0xb7, 0x8e, 0xfc, 0xfb, 0x52, 0xf1, 0x07, 0x02, 0xde, 0x8b, 0x94, 0x8e,
+	0xfe, 0xfc, 0x59, 0x34, 0x2d, 0x94, 0x26, 0xfd, 0x7d, 0x3e, 0x7c, 0x91, 0x97, 0xc2, 0xbd, 0x4e,
+	0xb5, 0x18, 0x7c, 0x8c, 0xfa, 0x63, 0x1a, 0x4c, 0x96, 0xa9, 0x75, 0x07, 0x9b, 0xff, 0xc5, 0xbb,
+	0xf1, 0xfe, 0x60, 0x45, 0xb2, 0x71, 0x45, 0x5a, 0x89, 0x50, 0xf7, 0xc1, 0x42, 0xcc, 0xf0, 0x4a,
+	0x75, 0x78, 0x91, 0x06, 0x97, 0xfc, 0xf7, 0x12, 0xc4, 0x06, 0xaa, 0xdf, 0xc1, 0x55, 0x82, 0x4d,
+	0x1b, 0x5b, 0x83, 0x26, 0x84, 0x7f, 0xa7, 0x2c, 0xf2, 0x32, 0x98, 0x36, 0xfc, 0x17, 0xb0, 0x9f,
+	0xbe, 0x1a, 0xb2, 0xad, 0x5a, 0x78, 0xdf, 0x32, 0xfa, 0x54, 0x64, 0xfe, 0x28, 0xb0, 0x16, 0x3f,
+	0x1e, 0xac, 0xdf, 0x72, 0xe2, 0x75, 0xdf, 0x2b, 0x81, 0xea, 0x35, 0xb0, 0xd4, 0x6f, 0x5d, 0x74,
+	0xbf, 0x5f, 0x24, 0x30, 0xed, 0xeb, 0xee, 0x9a, 0x90, 0xa1, 0x1d, 0xe8, 0x41, 0x87, 0xca, 0x6f,
+	0x83, 0x09, 0xd8, 0x60, 0x35, 0xe2, 0xd9, 0xac, 0x39, 0x30, 0xe9, 0x2d, 0xa8, 0xbc, 0x09, 0xc6,
+	0xdd, 0x80, 0x81, 0x0f, 0xa5, 0xb9, 0x5e, 0x43, 0x43, 0x18, 0x27, 0x96, 0xab, 0xd0, 0xb1, 0xf8,
+	0x8e, 0x7f, 0xf4, 0x16, 0xa5, 0x7f, 0xe4, 0xa5, 0xb6, 0x23, 0x3f, 0x10, 0xf3, 0x7a, 0x62, 0xcf,
+	0xea, 0x22, 0xb8, 0x90, 0x30, 0x89, 0x23, 0xfe, 0x25, 0x05, 0x8d, 0x5f, 0x27, 0x0c, 0x32, 0xb4,
+	0x45, 0x30, 0x0d, 0xe7, 0xb6, 0xee, 0xc5, 0x21, 0x8d, 0x5c, 0x1c, 0x65, 0x00, 0x30, 0xba, 0x5f,
+	0xe1, 0x23, 0x64, 0xfa, 0x54, 0x23, 0xe4, 0x04, 0x46, 0xf7, 0x77, 0x02, 0x82, 0xe2, 0xe6, 0xe0,
+	0xc1, 0x20, 0x17, 0x2f, 0x82, 0xe4, 0xc1, 0xd4, 0xcb, 0xe0, 0x62, 0x17, 0x73, 0x94, 0x8f, 0xf5,
+	0xef, 0xcf, 0x82, 0x4c, 0x99, 0x5a, 0xf2, 0x3d, 0x30, 0x9d, 0xfc, 0x58, 0x59, 0xed, 0xa5, 0x58,
+	0xe7, 0x10, 0xa9, 0xac, 0x0f, 0x8f, 0x15, 0xbd, 0x64, 0x1f, 0x4c, 0xc6, 0x87, 0xcd, 0x95, 0x3e,
+	0x24, 0x31, 0xa4, 0xf2, 0xe6, 0xb0, 0x48, 0x11, 0xec, 0x0b, 0xf0, 0x3f, 0x31, 0x02, 0x5d, 0xed,
+	0xe3, 0x1d, 0x81, 0x94, 0xd7, 0x87, 0x00, 0x09, 0xf6, 0x7b, 0x60, 0x3a, 0x39, 0x32, 0xf4, 0xcb,
+	0x5e, 0x02, 0xab, 0xac, 0x0f, 0x8f, 0x15, 0x21, 0xab, 0x00, 0xb4, 0xbd, 0xb9, 0x5e, 0xeb, 0xc3,
+	0xd0, 0x82, 0x29, 0x6b, 0x43, 0xc1, 0x44, 0x8c, 0x6f, 0x25, 0xb0, 0xd8, 0xbb, 0x2d, 0xbf, 0xd5,
+	0x4f, 0xf3, 0x5e, 0x5e, 0xca, 0xc6, 0x69, 0xbc, 0xc4, 0x8e, 0x6a, 0xe0, 0xff, 0xb1, 0xee, 0xb4,
+	0xdc, 0xef, 0x40, 0x6d, 0x40, 0xa5, 0x30, 0x24, 0x50, 0x44, 0x62, 0x60, 0xa6, 0xa3, 0x49, 0xf4,
+	0xab, 0x89, 0x24, 0x58, 0xb9, 0x31, 0x02, 0x38, 0x8a, 0xaa, 0x8c, 0x7d, 0xe9, 0x77, 0xc0, 0xd2,
+	0xad, 0x27, 0x47, 0x39, 0xe9, 0xe9, 0x51, 0x4e, 0xfa, 0xe3, 0x28, 0x27, 0x3d, 0x3c, 0xce, 0xa5,
+	0x9e, 0x1e, 0xe7, 0x52, 0xbf, 0x1d, 0xe7, 0x52, 0x9f, 0xbf, 0xd1, 0xf7, 0x9b, 0xa3, 0xd5, 0x12,
+	0x83, 0xaf, 0x8f, 0xea, 0x78, 0xd0, 0x72, 0x6e, 0xfc, 0x33, 0x00, 0xe0, 0x67, 0x57, 0xd8, 0xa7,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -761,6 +845,9 @@ type MsgClient interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RotateConsPubKey defines an operation for rotating the consensus public
+	// key of a validator.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
 }
 
 type msgClient struct {
@@ -834,6 +921,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Msg/RotateConsPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateValidator defines a method for creating a new validator.
@@ -858,6 +954,9 @@ type MsgServer interface {
 	// parameters.
 	// Since: cosmos-sdk 0.47
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RotateConsPubKey defines an operation for rotating the consensus public
+	// key of a validator.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -885,6 +984,9 @@ func (*UnimplementedMsgServer) CancelUnbondingDelegation(ctx context.Context, re
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RotateConsPubKey(ctx context.Context, req *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1016,6 +1118,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Msg/RotateConsPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1048,6 +1168,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/tx.proto",
@@ -1623,6 +1747,71 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRotateConsPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewPubkey != nil {
+		{
+			size, err := m.NewPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateConsPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1851,6 +2040,32 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateConsPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPubkey != nil {
+		l = m.NewPubkey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateConsPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3497,6 +3712,174 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRotateConsPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubkey == nil {
+				m.NewPubkey = &types.Any{}
+			}
+			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateConsPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateConsPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -22,6 +22,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCurrentPlanCmd(),
 		GetAppliedPlanCmd(),
 		GetModuleVersionsCmd(),
+		GetUpgradeInfoCmd(),
 	)
 
 	return cmd
@@ -113,6 +114,42 @@ func GetAppliedPlanCmd() *cobra.Command {
 	return cmd
 }
 
+// GetUpgradeInfoCmd returns the structured info of an upgrade plan.
+func GetUpgradeInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-info [upgrade-name]",
+		Short: "get the structured info of an upgrade plan",
+		Long: "Gets the binaries, with their sha256 checksums, and the minimum app version of a scheduled or completed upgrade plan.\n" +
+			"The --platform flag restricts the binaries to the one of the given os/arch, or else to the one for any platform.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			platform, err := cmd.Flags().GetString(FlagPlatform)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.QueryUpgradeInfoRequest{Name: args[0], Platform: platform}
+			res, err := queryClient.UpgradeInfo(cmd.Context(), &params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Info)
+		},
+	}
+
+	cmd.Flags().String(FlagPlatform, "", "The os/arch of the binary to get, e.g. linux/amd64")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetModuleVersionsCmd returns the module version list from state
 func GetModuleVersionsCmd() *cobra.Command {
 	cmd := &cobra.Command{
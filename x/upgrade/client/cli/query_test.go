@@ -174,3 +174,53 @@ func TestGetModuleVersionsCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUpgradeInfoCmd(t *testing.T) {
+	encCfg := testutilmod.MakeTestEncodingConfig(upgrade.AppModuleBasic{})
+	kr := keyring.NewInMemory(encCfg.Codec)
+	baseCtx := client.Context{}.
+		WithKeyring(kr).
+		WithTxConfig(encCfg.TxConfig).
+		WithCodec(encCfg.Codec).
+		WithClient(clitestutil.MockTendermintRPC{Client: rpcclientmock.Client{}}).
+		WithAccountRetriever(client.MockAccountRetriever{}).
+		WithOutput(io.Discard).
+		WithChainID("test-chain")
+
+	testCases := []struct {
+		msg          string
+		args         []string
+		expCmdOutput string
+	}{
+		{
+			msg:          "test all binaries",
+			args:         []string{"v2", fmt.Sprintf("--%s=json", flags.FlagOutput)},
+			expCmdOutput: `v2 --output=json`,
+		},
+		{
+			msg:          "test platform binary",
+			args:         []string{"v2", fmt.Sprintf("--%s=linux/amd64", upgradecli.FlagPlatform)},
+			expCmdOutput: `v2 --platform=linux/amd64`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.msg, func(t *testing.T) {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd := upgradecli.GetUpgradeInfoCmd()
+			cmd.SetOut(io.Discard)
+			require.NotNil(t, cmd)
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(tc.args)
+
+			require.NoError(t, client.SetCmdClientContextHandler(baseCtx, cmd))
+
+			require.Contains(t, fmt.Sprint(cmd), "upgrade-info [upgrade-name] [] [] get the structured info of an upgrade plan")
+			require.Contains(t, fmt.Sprint(cmd), tc.expCmdOutput)
+		})
+	}
+}
@@ -22,6 +22,7 @@ const (
 	FlagUpgradeInfo = "upgrade-info"
 	FlagNoValidate  = "no-validate"
 	FlagDaemonName  = "daemon-name"
+	FlagPlatform    = "platform"
 )
 
 // GetTxCmd returns the transaction commands for this module
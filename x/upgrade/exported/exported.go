@@ -5,3 +5,9 @@ package exported
 type ProtocolVersionSetter interface {
 	SetProtocolVersion(uint64)
 }
+
+// AppVersionGetter defines the interface fulfilled by BaseApp
+// which allows getting it's appVersion field.
+type AppVersionGetter interface {
+	AppVersion() uint64
+}
@@ -76,3 +76,28 @@ func (k Keeper) ModuleVersions(c context.Context, req *types.QueryModuleVersions
 func (k Keeper) Authority(c context.Context, req *types.QueryAuthorityRequest) (*types.QueryAuthorityResponse, error) {
 	return &types.QueryAuthorityResponse{Address: k.authority}, nil
 }
+
+// UpgradeInfo implements the Query/UpgradeInfo gRPC method
+func (k Keeper) UpgradeInfo(c context.Context, req *types.QueryUpgradeInfoRequest) (*types.QueryUpgradeInfoResponse, error) {
+	if len(req.Name) == 0 {
+		return nil, errors.Wrap(errors.ErrInvalidRequest, "x/upgrade: QueryUpgradeInfo name cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	info, found := k.GetUpgradeInfo(ctx, req.Name)
+	if !found {
+		return nil, errors.Wrapf(errors.ErrNotFound, "x/upgrade: QueryUpgradeInfo upgrade info of %s not found", req.Name)
+	}
+
+	// check if the binary of a specific platform was requested
+	if len(req.Platform) > 0 {
+		binary, ok := info.Binary(req.Platform)
+		if !ok {
+			return nil, errors.Wrapf(errors.ErrNotFound, "x/upgrade: QueryUpgradeInfo binary of %s for platform %s not found", req.Name, req.Platform)
+		}
+		info.Binaries = []types.BinaryInfo{binary}
+	}
+
+	return &types.QueryUpgradeInfoResponse{Info: &info}, nil
+}
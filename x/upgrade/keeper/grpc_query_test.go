@@ -218,6 +218,106 @@ func (suite *UpgradeTestSuite) TestModuleVersions() {
 	}
 }
 
+func (suite *UpgradeTestSuite) TestUpgradeInfo() {
+	const checksum = "aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f"
+	linux := types.BinaryInfo{
+		Platform: "linux/amd64",
+		Url:      "https://example.com/simd-linux?checksum=sha256:" + checksum,
+		Sha256:   checksum,
+	}
+	anyPlatform := types.BinaryInfo{
+		Platform: types.PlatformAny,
+		Url:      "https://example.com/simd?checksum=sha256:" + checksum,
+		Sha256:   checksum,
+	}
+
+	var (
+		req         *types.QueryUpgradeInfoRequest
+		expResponse types.QueryUpgradeInfoResponse
+	)
+
+	schedule := func() {
+		plan := types.Plan{
+			Name:   "test-plan",
+			Height: 5,
+			Info:   `{"binaries":{"linux/amd64":"` + linux.Url + `","any":"` + anyPlatform.Url + `"},"min_app_version":0}`,
+		}
+		suite.Require().NoError(suite.upgradeKeeper.ScheduleUpgrade(suite.ctx, plan))
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty name",
+			func() {
+				req = &types.QueryUpgradeInfoRequest{}
+			},
+			false,
+		},
+		{
+			"without upgrade info",
+			func() {
+				req = &types.QueryUpgradeInfoRequest{Name: "test-plan"}
+			},
+			false,
+		},
+		{
+			"all binaries",
+			func() {
+				schedule()
+				req = &types.QueryUpgradeInfoRequest{Name: "test-plan"}
+				expResponse = types.QueryUpgradeInfoResponse{Info: &types.UpgradeInfo{
+					Binaries: []types.BinaryInfo{anyPlatform, linux},
+				}}
+			},
+			true,
+		},
+		{
+			"platform binary",
+			func() {
+				schedule()
+				req = &types.QueryUpgradeInfoRequest{Name: "test-plan", Platform: "linux/amd64"}
+				expResponse = types.QueryUpgradeInfoResponse{Info: &types.UpgradeInfo{
+					Binaries: []types.BinaryInfo{linux},
+				}}
+			},
+			true,
+		},
+		{
+			"fallback to any platform binary",
+			func() {
+				schedule()
+				req = &types.QueryUpgradeInfoRequest{Name: "test-plan", Platform: "darwin/arm64"}
+				expResponse = types.QueryUpgradeInfoResponse{Info: &types.UpgradeInfo{
+					Binaries: []types.BinaryInfo{anyPlatform},
+				}}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			res, err := suite.queryClient.UpgradeInfo(context.Background(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(&expResponse, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *UpgradeTestSuite) TestAuthority() {
 	res, err := suite.queryClient.Authority(context.Background(), &types.QueryAuthorityRequest{})
 	suite.Require().NoError(err)
@@ -36,6 +36,7 @@ type Keeper struct {
 	downgradeVerified  bool                            // tells if we've already sanity checked that this binary version isn't being used against an old state.
 	authority          string                          // the address capable of executing and cancelling an upgrade. Usually the gov module account
 	initVersionMap     module.VersionMap               // the module version map at init genesis
+	hooks              types.UpgradeHooks              // hooks run before scheduling an upgrade
 }
 
 // NewKeeper constructs an upgrade Keeper which requires the following arguments:
@@ -56,6 +57,27 @@ func NewKeeper(skipUpgradeHeights map[int64]bool, storeKey storetypes.StoreKey,
 	}
 }
 
+// Hooks gets the hooks for upgrade *Keeper {
+func (k *Keeper) Hooks() types.UpgradeHooks {
+	if k.hooks == nil {
+		// return a no-op implementation if no hooks are set
+		return types.MultiUpgradeHooks{}
+	}
+
+	return k.hooks
+}
+
+// SetHooks sets the hooks for upgrade
+func (k *Keeper) SetHooks(uh types.UpgradeHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set upgrade hooks twice")
+	}
+
+	k.hooks = uh
+
+	return k
+}
+
 // SetVersionSetter sets the interface implemented by baseapp which allows setting baseapp's protocol version field
 func (k *Keeper) SetVersionSetter(vs xp.ProtocolVersionSetter) {
 	k.versionSetter = vs
@@ -107,6 +129,19 @@ func (k Keeper) getProtocolVersion(ctx sdk.Context) uint64 {
 	return 0
 }
 
+// InitProtocolVersion sets the protocol version of a new chain to the app
+// version declared by the app with BaseApp.SetProtocolVersion, if any. The
+// protocol version is the app version the minimum app version of upgrade plans
+// is checked against, and it is bumped by every applied upgrade.
+func (k Keeper) InitProtocolVersion(ctx sdk.Context) {
+	vg, ok := k.versionSetter.(xp.AppVersionGetter)
+	if !ok || vg.AppVersion() == 0 {
+		return
+	}
+
+	k.setProtocolVersion(ctx, vg.AppVersion())
+}
+
 // SetModuleVersionMap saves a given version map to state
 func (k Keeper) SetModuleVersionMap(ctx sdk.Context, vm module.VersionMap) {
 	if len(vm) > 0 {
@@ -190,11 +225,22 @@ func (k Keeper) getModuleVersion(ctx sdk.Context, name string) (uint64, bool) {
 // If there is another Plan already scheduled, it will cancel and overwrite it.
 // ScheduleUpgrade will also write the upgraded IBC ClientState to the upgraded client
 // path if it is specified in the plan.
+// The structured info of the plan, if any, is saved, and the scheduling is
+// vetoed if the app version is below its minimum app version or if any of the
+// PreUpgradeCheck hooks fails.
 func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 	if err := plan.ValidateBasic(); err != nil {
 		return err
 	}
 
+	info, err := types.ParseUpgradeInfo(plan.Info)
+	if err != nil {
+		return err
+	}
+	if info != nil && info.MinAppVersion > k.getProtocolVersion(ctx) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade requires app version %d, current app version is %d", info.MinAppVersion, k.getProtocolVersion(ctx))
+	}
+
 	// NOTE: allow for the possibility of chains to schedule upgrades in begin block of the same block
 	// as a strategy for emergency hard fork recoveries
 	if plan.Height < ctx.BlockHeight() {
@@ -205,20 +251,42 @@ func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "upgrade with name %s has already been completed", plan.Name)
 	}
 
+	if err := k.Hooks().PreUpgradeCheck(ctx, plan); err != nil {
+		return sdkerrors.Wrap(err, "pre-upgrade check failed")
+	}
+
 	store := ctx.KVStore(k.storeKey)
 
-	// clear any old IBC state stored by previous plan
+	// clear any old IBC state and upgrade info stored by previous plan
 	oldPlan, found := k.GetUpgradePlan(ctx)
 	if found {
 		k.ClearIBCState(ctx, oldPlan.Height)
+		store.Delete(types.UpgradeInfoKey(oldPlan.Name))
 	}
 
 	bz := k.cdc.MustMarshal(&plan)
 	store.Set(types.PlanKey(), bz)
 
+	if info != nil {
+		store.Set(types.UpgradeInfoKey(plan.Name), k.cdc.MustMarshal(info))
+	}
+
 	return nil
 }
 
+// GetUpgradeInfo returns the structured info of a scheduled or completed
+// upgrade plan by its name, if any.
+func (k Keeper) GetUpgradeInfo(ctx sdk.Context, name string) (info types.UpgradeInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UpgradeInfoKey(name))
+	if bz == nil {
+		return info, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &info)
+	return info, true
+}
+
 // SetUpgradedClient sets the expected upgraded client for the next version of this chain at the last height the current chain will commit.
 func (k Keeper) SetUpgradedClient(ctx sdk.Context, planHeight int64, bz []byte) error {
 	store := ctx.KVStore(k.storeKey)
@@ -307,15 +375,20 @@ func (k Keeper) ClearIBCState(ctx sdk.Context, lastHeight int64) {
 	store.Delete(types.UpgradedConsStateKey(lastHeight))
 }
 
-// ClearUpgradePlan clears any schedule upgrade and associated IBC states.
+// ClearUpgradePlan clears any schedule upgrade and associated IBC states. The
+// upgrade info of the plan is cleared too unless the plan has been completed.
 func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
 	// clear IBC states everytime upgrade plan is removed
 	oldPlan, found := k.GetUpgradePlan(ctx)
 	if found {
 		k.ClearIBCState(ctx, oldPlan.Height)
+		if k.GetDoneHeight(ctx, oldPlan.Name) == 0 {
+			store.Delete(types.UpgradeInfoKey(oldPlan.Name))
+		}
 	}
 
-	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.PlanKey())
 }
 
@@ -373,9 +446,10 @@ func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
 
 	// Must clear IBC state after upgrade is applied as it is stored separately from the upgrade plan.
 	// This will prevent resubmission of upgrade msg after upgrade is already completed.
+	// The plan is marked as done first for its upgrade info to be kept.
+	k.setDone(ctx, plan.Name)
 	k.ClearIBCState(ctx, plan.Height)
 	k.ClearUpgradePlan(ctx)
-	k.setDone(ctx, plan.Name)
 }
 
 // IsSkipHeight checks if the given height is part of skipUpgradeHeights
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -137,6 +138,64 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 			setup:   func() {},
 			expPass: false,
 		},
+		{
+			name: "successful schedule: structured info",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   testUpgradeInfo,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: true,
+		},
+		{
+			name: "unsuccessful schedule: malformed structured info",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=md5:abcd"}}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: false,
+		},
+		{
+			name: "unsuccessful schedule: app version below minimum app version",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"any":"https://example.com/simd?checksum=sha256:` + testChecksum + `"},"min_app_version":1}`,
+				Height: 123450000,
+			},
+			setup:   func() {},
+			expPass: false,
+		},
+		{
+			name: "successful schedule: app version at minimum app version",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"any":"https://example.com/simd?checksum=sha256:` + testChecksum + `"},"min_app_version":1}`,
+				Height: 123450000,
+			},
+			setup: func() {
+				s.baseApp.SetProtocolVersion(1)
+				s.upgradeKeeper.InitProtocolVersion(s.ctx)
+			},
+			expPass: true,
+		},
+		{
+			name: "unsuccessful schedule: pre-upgrade check failed",
+			plan: types.Plan{
+				Name:   "all-good",
+				Info:   "some text here",
+				Height: 123450000,
+			},
+			setup: func() {
+				s.upgradeKeeper.SetHooks(types.NewMultiUpgradeHooks(
+					mockUpgradeHooks{},
+					mockUpgradeHooks{err: fmt.Errorf("migrations not registered")},
+				))
+			},
+			expPass: false,
+		},
 		{
 			name: "unsuccessful schedule: schedule already executed",
 			plan: types.Plan{
@@ -179,6 +238,69 @@ func (s *KeeperTestSuite) TestScheduleUpgrade() {
 	}
 }
 
+const testChecksum = "aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f"
+
+var testUpgradeInfo = `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=sha256:` + testChecksum + `"}}`
+
+type mockUpgradeHooks struct {
+	err error
+}
+
+func (h mockUpgradeHooks) PreUpgradeCheck(_ sdk.Context, _ types.Plan) error {
+	return h.err
+}
+
+func (s *KeeperTestSuite) TestUpgradeInfo() {
+	plan := types.Plan{Name: "all-good", Info: testUpgradeInfo, Height: 123450000}
+	expected := types.UpgradeInfo{
+		Binaries: []types.BinaryInfo{{
+			Platform: "linux/amd64",
+			Url:      "https://example.com/simd?checksum=sha256:" + testChecksum,
+			Sha256:   testChecksum,
+		}},
+	}
+
+	// the upgrade info is saved when the plan is scheduled
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+	info, found := s.upgradeKeeper.GetUpgradeInfo(s.ctx, plan.Name)
+	s.Require().True(found)
+	s.Require().Equal(expected, info)
+
+	// the upgrade info is cleared with the plan
+	s.upgradeKeeper.ClearUpgradePlan(s.ctx)
+	_, found = s.upgradeKeeper.GetUpgradeInfo(s.ctx, plan.Name)
+	s.Require().False(found)
+
+	// the upgrade info of an overwritten plan is cleared
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, types.Plan{Name: "alt-good", Info: "some text here", Height: 123450000}))
+	_, found = s.upgradeKeeper.GetUpgradeInfo(s.ctx, plan.Name)
+	s.Require().False(found)
+	_, found = s.upgradeKeeper.GetUpgradeInfo(s.ctx, "alt-good")
+	s.Require().False(found)
+
+	// the upgrade info of an applied plan is kept
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, plan))
+	s.upgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	s.upgradeKeeper.ApplyUpgrade(s.ctx, plan)
+	info, found = s.upgradeKeeper.GetUpgradeInfo(s.ctx, plan.Name)
+	s.Require().True(found)
+	s.Require().Equal(expected, info)
+
+	// the next upgrade can require the app version of the applied one
+	next := types.Plan{
+		Name:   "next",
+		Info:   `{"binaries":{"any":"https://example.com/simd?checksum=sha256:` + testChecksum + `"},"min_app_version":1}`,
+		Height: 123460000,
+	}
+	s.Require().NoError(s.upgradeKeeper.ScheduleUpgrade(s.ctx, next))
+	info, found = s.upgradeKeeper.GetUpgradeInfo(s.ctx, next.Name)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), info.MinAppVersion)
+}
+
 func (s *KeeperTestSuite) TestSetUpgradedClient() {
 	cs := []byte("IBC client state")
 
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
+	"golang.org/x/exp/maps"
 
 	modulev1 "cosmossdk.io/api/cosmos/upgrade/module/v1"
 	"cosmossdk.io/core/appmodule"
@@ -133,6 +135,8 @@ func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMe
 		am.keeper.SetModuleVersionMap(ctx, versionMap)
 	}
 
+	am.keeper.InitProtocolVersion(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -168,7 +172,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
-		appmodule.Invoke(PopulateVersionMap),
+		appmodule.Invoke(PopulateVersionMap, InvokeSetHooks),
 	)
 }
 
@@ -229,3 +233,27 @@ func PopulateVersionMap(upgradeKeeper *keeper.Keeper, modules map[string]appmodu
 
 	upgradeKeeper.SetInitVersionMap(module.NewManagerFromMap(modules).GetVersionMap())
 }
+
+func InvokeSetHooks(upgradeKeeper *keeper.Keeper, upgradeHooks map[string]types.UpgradeHooksWrapper) error {
+	// the app can still set its own hooks when no module provides any
+	if upgradeKeeper == nil || len(upgradeHooks) == 0 {
+		return nil
+	}
+
+	// Default ordering is lexical by module name.
+	// Explicit ordering can be added to the module config if required.
+	order := maps.Keys(upgradeHooks)
+	sort.Strings(order)
+
+	var multiHooks types.MultiUpgradeHooks
+	for _, modName := range order {
+		hook, ok := upgradeHooks[modName]
+		if !ok {
+			return fmt.Errorf("can't find upgrade hooks for module %s", modName)
+		}
+		multiHooks = append(multiHooks, hook)
+	}
+
+	upgradeKeeper.SetHooks(multiHooks)
+	return nil
+}
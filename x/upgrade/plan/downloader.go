@@ -1,8 +1,11 @@
 package plan
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	neturl "net/url"
 	"os"
 	"path/filepath"
@@ -97,6 +100,29 @@ func EnsureBinary(path string) error {
 	return nil
 }
 
+// VerifyBinary checks that the file at the given path has the given hex encoded
+// sha256 checksum, e.g. the checksum of the binary for the platform of the node
+// in the upgrade info of a plan, in order to verify a binary before the upgrade
+// height.
+func VerifyBinary(path, checksum string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return fmt.Errorf("could not hash %s: %w", path, err)
+	}
+
+	if actual := hex.EncodeToString(hasher.Sum(nil)); !strings.EqualFold(actual, checksum) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", path, checksum, actual)
+	}
+
+	return nil
+}
+
 // DownloadURLWithChecksum gets the contents of the given url, ensuring the checksum is correct.
 // The provided url must contain a checksum parameter that matches the file being downloaded.
 // If there isn't an error, the content returned by the url will be returned as a string.
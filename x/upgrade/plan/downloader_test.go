@@ -7,6 +7,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -250,6 +251,29 @@ func (s *DownloaderTestSuite) TestEnsureBinary() {
 	})
 }
 
+func (s *DownloaderTestSuite) TestVerifyBinary() {
+	binFile := NewTestFile("binary", "#!/usr/bin/env bash\necho 'binary'\n")
+	binPath := s.saveSrcTestFile(binFile)
+	binChecksum := fmt.Sprintf("%x", sha256.Sum256(binFile.Contents))
+
+	s.T().Run("checksum matches", func(t *testing.T) {
+		require.NoError(t, VerifyBinary(binPath, binChecksum))
+	})
+
+	s.T().Run("upper case checksum matches", func(t *testing.T) {
+		require.NoError(t, VerifyBinary(binPath, strings.ToUpper(binChecksum)))
+	})
+
+	s.T().Run("checksum does not match", func(t *testing.T) {
+		otherChecksum := fmt.Sprintf("%x", sha256.Sum256([]byte("other binary")))
+		require.ErrorContains(t, VerifyBinary(binPath, otherChecksum), "checksum mismatch")
+	})
+
+	s.T().Run("file does not exist", func(t *testing.T) {
+		require.Error(t, VerifyBinary(filepath.Join(s.Home, "does-not-exist"), binChecksum))
+	})
+}
+
 func (s *DownloaderTestSuite) TestDownloadURLWithChecksum() {
 	planContents := `{"binaries":{"xxx/yyy":"url"}}`
 	planFile := NewTestFile("plan-info.json", planContents)
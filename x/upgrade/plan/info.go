@@ -15,7 +15,8 @@ import (
 
 // Info is the special structure that the Plan.Info string can be (as json).
 type Info struct {
-	Binaries BinaryDownloadURLMap `json:"binaries"`
+	Binaries      BinaryDownloadURLMap `json:"binaries"`
+	MinAppVersion uint64               `json:"min_app_version,omitempty"`
 }
 
 // BinaryDownloadURLMap is a map of os/architecture stings to a URL where the binary can be downloaded.
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHooks event hooks for the upgrade module (noalias)
+type UpgradeHooks interface {
+	// PreUpgradeCheck is called before an upgrade plan is scheduled, an error
+	// vetoes the scheduling of the plan, e.g. when the migrations of a module
+	// are not registered.
+	PreUpgradeCheck(ctx sdk.Context, plan Plan) error
+}
+
+type UpgradeHooksWrapper struct{ UpgradeHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (UpgradeHooksWrapper) IsOnePerModuleType() {}
+
+var _ UpgradeHooks = MultiUpgradeHooks{}
+
+// combine multiple upgrade hooks, all hook functions are run in array sequence
+type MultiUpgradeHooks []UpgradeHooks
+
+func NewMultiUpgradeHooks(hooks ...UpgradeHooks) MultiUpgradeHooks {
+	return hooks
+}
+
+// PreUpgradeCheck runs the checks of all the hooks, returning the first error.
+func (h MultiUpgradeHooks) PreUpgradeCheck(ctx sdk.Context, plan Plan) error {
+	for i := range h {
+		if err := h[i].PreUpgradeCheck(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -26,6 +26,9 @@ const (
 	// ProtocolVersionByte is a prefix to look up Protocol Version
 	ProtocolVersionByte = 0x3
 
+	// UpgradeInfoByte is a prefix to look up the structured upgrade info by upgrade plan name
+	UpgradeInfoByte = 0x4
+
 	// KeyUpgradedIBCState is the key under which upgraded ibc state is stored in the upgrade store
 	KeyUpgradedIBCState = "upgradedIBCState"
 
@@ -42,6 +45,12 @@ func PlanKey() []byte {
 	return []byte{PlanByte}
 }
 
+// UpgradeInfoKey is the key under which the structured info of an upgrade plan
+// is saved
+func UpgradeInfoKey(name string) []byte {
+	return append([]byte{UpgradeInfoByte}, []byte(name)...)
+}
+
 // UpgradedClientKey is the key under which the upgraded client state is saved
 // Connecting IBC chains can verify against the upgraded client in this path before
 // upgrading their clients
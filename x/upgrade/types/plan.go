@@ -32,6 +32,9 @@ func (p Plan) ValidateBasic() error {
 	if p.Height <= 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
+	if _, err := ParseUpgradeInfo(p.Info); err != nil {
+		return err
+	}
 
 	return nil
 }
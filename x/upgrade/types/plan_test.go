@@ -86,6 +86,29 @@ func TestPlanValid(t *testing.T) {
 				Info: "important",
 			},
 		},
+		"structured info": {
+			p: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=sha256:aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f"}}`,
+				Height: 123450000,
+			},
+			valid: true,
+		},
+		"free-form json info": {
+			p: types.Plan{
+				Name:   "all-good",
+				Info:   `{"notes":"https://example.com/notes"}`,
+				Height: 123450000,
+			},
+			valid: true,
+		},
+		"malformed structured info": {
+			p: types.Plan{
+				Name:   "all-good",
+				Info:   `{"binaries":{"linux/amd64":"https://example.com/simd"}}`,
+				Height: 123450000,
+			},
+		},
 		"negative height": {
 			p: types.Plan{
 				Name:   "minus",
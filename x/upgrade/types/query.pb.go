@@ -492,6 +492,111 @@ func (m *QueryAuthorityResponse) GetAddress() string {
 	return ""
 }
 
+// QueryUpgradeInfoRequest is the request type for the Query/UpgradeInfo RPC
+// method.
+type QueryUpgradeInfoRequest struct {
+	// name is the name of the upgrade plan.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// platform is the os/arch of the binary to return. The binary of the platform,
+	// or else the binary for any platform, is the only one returned if set, all the
+	// binaries are returned otherwise.
+	Platform string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+}
+
+func (m *QueryUpgradeInfoRequest) Reset()         { *m = QueryUpgradeInfoRequest{} }
+func (m *QueryUpgradeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeInfoRequest) ProtoMessage()    {}
+func (*QueryUpgradeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{10}
+}
+func (m *QueryUpgradeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeInfoRequest.Merge(m, src)
+}
+func (m *QueryUpgradeInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeInfoRequest proto.InternalMessageInfo
+
+func (m *QueryUpgradeInfoRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryUpgradeInfoRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+// QueryUpgradeInfoResponse is the response type for the Query/UpgradeInfo RPC
+// method.
+type QueryUpgradeInfoResponse struct {
+	// info is the structured info of the upgrade plan.
+	Info *UpgradeInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (m *QueryUpgradeInfoResponse) Reset()         { *m = QueryUpgradeInfoResponse{} }
+func (m *QueryUpgradeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpgradeInfoResponse) ProtoMessage()    {}
+func (*QueryUpgradeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a334d07ad8374f0, []int{11}
+}
+func (m *QueryUpgradeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpgradeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpgradeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUpgradeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpgradeInfoResponse.Merge(m, src)
+}
+func (m *QueryUpgradeInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpgradeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpgradeInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUpgradeInfoResponse proto.InternalMessageInfo
+
+func (m *QueryUpgradeInfoResponse) GetInfo() *UpgradeInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryCurrentPlanRequest)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanRequest")
 	proto.RegisterType((*QueryCurrentPlanResponse)(nil), "cosmos.upgrade.v1beta1.QueryCurrentPlanResponse")
@@ -503,6 +608,8 @@ func init() {
 	proto.RegisterType((*QueryModuleVersionsResponse)(nil), "cosmos.upgrade.v1beta1.QueryModuleVersionsResponse")
 	proto.RegisterType((*QueryAuthorityRequest)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityRequest")
 	proto.RegisterType((*QueryAuthorityResponse)(nil), "cosmos.upgrade.v1beta1.QueryAuthorityResponse")
+	proto.RegisterType((*QueryUpgradeInfoRequest)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeInfoRequest")
+	proto.RegisterType((*QueryUpgradeInfoResponse)(nil), "cosmos.upgrade.v1beta1.QueryUpgradeInfoResponse")
 }
 
 func init() {
@@ -510,48 +617,52 @@ func init() {
 }
 
 var fileDescriptor_4a334d07ad8374f0 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0x66, 0x0a, 0x3f, 0x7e, 0xf2, 0x6a, 0xd0, 0x4c, 0x62, 0x59, 0x57, 0x52, 0x71, 0x40, 0x85,
-	0x48, 0x77, 0xa0, 0x5c, 0x0c, 0x46, 0xa3, 0x92, 0x18, 0x31, 0x4a, 0xb4, 0x46, 0x0f, 0x5e, 0x9a,
-	0xa1, 0x3b, 0x69, 0x37, 0xb6, 0x3b, 0xcb, 0xce, 0x2c, 0x91, 0x10, 0x2e, 0x9e, 0x3c, 0x9a, 0x18,
-	0xaf, 0xde, 0xbc, 0xf8, 0x97, 0x78, 0x24, 0xf1, 0xe2, 0xc1, 0x83, 0x01, 0xff, 0x04, 0xff, 0x00,
-	0xb3, 0xb3, 0xb3, 0xa4, 0xa5, 0xbb, 0x0b, 0x7a, 0x6a, 0x77, 0xde, 0xf7, 0x7d, 0xef, 0x7b, 0x3b,
-	0xdf, 0x6b, 0x81, 0xb4, 0x84, 0xec, 0x09, 0x49, 0xa3, 0xa0, 0x1d, 0x32, 0x97, 0xd3, 0xed, 0xe5,
-	0x4d, 0xae, 0xd8, 0x32, 0xdd, 0x8a, 0x78, 0xb8, 0xe3, 0x04, 0xa1, 0x50, 0x02, 0x57, 0x12, 0x8c,
-	0x63, 0x30, 0x8e, 0xc1, 0xd8, 0xd3, 0x6d, 0x21, 0xda, 0x5d, 0x4e, 0x59, 0xe0, 0x51, 0xe6, 0xfb,
-	0x42, 0x31, 0xe5, 0x09, 0x5f, 0x26, 0x2c, 0x7b, 0x2e, 0x47, 0x39, 0x55, 0xd1, 0x28, 0x72, 0x11,
-	0xa6, 0x9e, 0xc5, 0xad, 0xd6, 0xa2, 0x30, 0xe4, 0xbe, 0x7a, 0xda, 0x65, 0x7e, 0x83, 0x6f, 0x45,
-	0x5c, 0x2a, 0xf2, 0x18, 0xac, 0xe1, 0x92, 0x0c, 0x84, 0x2f, 0x39, 0x5e, 0x82, 0xb1, 0xa0, 0xcb,
-	0x7c, 0x0b, 0xcd, 0xa0, 0xf9, 0x72, 0x7d, 0xda, 0xc9, 0x76, 0xe8, 0x68, 0x8e, 0x46, 0x92, 0x9a,
-	0x69, 0x74, 0x2f, 0x08, 0xba, 0x1e, 0x77, 0xfb, 0x1a, 0x61, 0x0c, 0x63, 0x3e, 0xeb, 0x71, 0x2d,
-	0x36, 0xd1, 0xd0, 0xdf, 0x49, 0xdd, 0x34, 0x1f, 0x80, 0x9b, 0xe6, 0x15, 0x18, 0xef, 0x70, 0xaf,
-	0xdd, 0x51, 0x9a, 0x31, 0xda, 0x30, 0x4f, 0x64, 0x1d, 0x88, 0xe6, 0xbc, 0x48, 0x5c, 0xb8, 0x6b,
-	0x31, 0xda, 0x97, 0x91, 0x7c, 0xae, 0x98, 0xe2, 0x69, 0xb7, 0xcb, 0x50, 0xee, 0x32, 0xa9, 0x9a,
-	0x03, 0x12, 0x10, 0x1f, 0x3d, 0xd4, 0x27, 0xab, 0x25, 0x0b, 0x11, 0x0f, 0x66, 0x0b, 0xa5, 0x8c,
-	0x93, 0x9b, 0x60, 0x99, 0x91, 0xdd, 0x66, 0x2b, 0x85, 0x34, 0x65, 0x8c, 0xb1, 0x4a, 0x33, 0x68,
-	0xfe, 0x6c, 0xa3, 0x12, 0x65, 0x2a, 0xc4, 0x4d, 0x1e, 0x8d, 0x9d, 0x41, 0xe7, 0x4b, 0xe4, 0x36,
-	0xd8, 0xba, 0xd5, 0x13, 0xe1, 0x46, 0x5d, 0xfe, 0x92, 0x87, 0x32, 0xbe, 0xc4, 0x3e, 0xb7, 0x3d,
-	0x5d, 0x68, 0xf6, 0xbd, 0x22, 0x48, 0x8e, 0x36, 0xe2, 0x17, 0xd5, 0x83, 0x4b, 0x99, 0x74, 0xe3,
-	0x70, 0x03, 0xce, 0x19, 0xfe, 0xb6, 0x29, 0x59, 0x68, 0x66, 0x74, 0xbe, 0x5c, 0xbf, 0x9a, 0x77,
-	0x67, 0x03, 0x42, 0x8d, 0xc9, 0xde, 0x80, 0x2e, 0x99, 0x82, 0x0b, 0xc9, 0xbd, 0x44, 0xaa, 0x23,
-	0x42, 0x4f, 0xed, 0xa4, 0x69, 0xa9, 0x43, 0xe5, 0x78, 0xc1, 0x58, 0xb0, 0xe0, 0x7f, 0xe6, 0xba,
-	0x21, 0x97, 0xd2, 0xd8, 0x4f, 0x1f, 0xeb, 0xbf, 0xc7, 0xe1, 0x3f, 0x4d, 0xc2, 0x9f, 0x10, 0x94,
-	0xfb, 0x72, 0x86, 0x69, 0x9e, 0xbb, 0x9c, 0xb0, 0xda, 0x4b, 0xa7, 0x27, 0x24, 0xb6, 0xc8, 0xe2,
-	0xdb, 0x6f, 0xbf, 0x3e, 0x94, 0xae, 0xe1, 0x39, 0x9a, 0xb3, 0x28, 0xad, 0x84, 0xd4, 0x8c, 0xe3,
-	0x8b, 0x3f, 0x23, 0x28, 0xf7, 0x65, 0xf1, 0x04, 0x83, 0xc3, 0x21, 0x3f, 0xc1, 0x60, 0x46, 0xcc,
-	0xc9, 0x8a, 0x36, 0x58, 0xc3, 0x37, 0xf2, 0x0c, 0xb2, 0x84, 0xa4, 0x0d, 0xd2, 0xdd, 0x38, 0x1f,
-	0x7b, 0xf8, 0x07, 0x82, 0x4a, 0x76, 0x68, 0xf1, 0x6a, 0xa1, 0x83, 0xc2, 0xa5, 0xb1, 0x6f, 0xfd,
-	0x13, 0xd7, 0x0c, 0xb2, 0xae, 0x07, 0xb9, 0x8b, 0xef, 0xd0, 0xe2, 0x9f, 0xa4, 0xa1, 0x1d, 0xa2,
-	0xbb, 0x7d, 0x9b, 0xba, 0xf7, 0xae, 0x84, 0xf0, 0x17, 0x04, 0x93, 0x83, 0x49, 0xc7, 0xf5, 0x42,
-	0x6b, 0x99, 0x5b, 0x65, 0xaf, 0xfc, 0x15, 0xc7, 0x8c, 0x41, 0xf5, 0x18, 0x0b, 0xf8, 0x7a, 0xde,
-	0x18, 0xc7, 0x16, 0x0d, 0x7f, 0x44, 0x30, 0x71, 0xb4, 0x0e, 0xb8, 0x56, 0x1c, 0x80, 0x63, 0xfb,
-	0x64, 0x3b, 0xa7, 0x85, 0x1b, 0x77, 0x0b, 0xda, 0xdd, 0x2c, 0xbe, 0x92, 0x9b, 0x96, 0x94, 0x72,
-	0xff, 0xc1, 0xd7, 0x83, 0x2a, 0xda, 0x3f, 0xa8, 0xa2, 0x9f, 0x07, 0x55, 0xf4, 0xfe, 0xb0, 0x3a,
-	0xb2, 0x7f, 0x58, 0x1d, 0xf9, 0x7e, 0x58, 0x1d, 0x79, 0xb5, 0xd8, 0xf6, 0x54, 0x27, 0xda, 0x74,
-	0x5a, 0xa2, 0x97, 0xca, 0x24, 0x1f, 0x35, 0xe9, 0xbe, 0xa6, 0x6f, 0x8e, 0x34, 0xd5, 0x4e, 0xc0,
-	0xe5, 0xe6, 0xb8, 0xfe, 0x0b, 0x59, 0xf9, 0x13, 0x00, 0x00, 0xff, 0xff, 0x18, 0x7f, 0x99, 0xff,
-	0xc4, 0x06, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0x66, 0x4a, 0x45, 0x78, 0x35, 0x68, 0x26, 0xb1, 0xac, 0x2b, 0xa9, 0xb8, 0xa0, 0x42, 0xa4,
+	0xdd, 0x52, 0x0e, 0x1a, 0x8c, 0x46, 0x25, 0x31, 0xd6, 0x28, 0xd1, 0x12, 0x3d, 0x78, 0x69, 0x86,
+	0xee, 0xd0, 0x6e, 0xdc, 0xdd, 0x59, 0x76, 0x66, 0x89, 0x84, 0x70, 0xf1, 0xe4, 0xd1, 0xc4, 0x78,
+	0x33, 0xde, 0xbc, 0xf8, 0x4b, 0x3c, 0x92, 0x78, 0xf1, 0xe0, 0xc1, 0x80, 0x3f, 0xc4, 0xec, 0xec,
+	0xb4, 0xd9, 0xd2, 0xee, 0x52, 0x3d, 0xb5, 0x33, 0xf3, 0xbe, 0xef, 0x7d, 0x6f, 0xe6, 0x7d, 0x6f,
+	0xc1, 0x68, 0x31, 0xee, 0x32, 0x6e, 0x86, 0x7e, 0x3b, 0x20, 0x16, 0x35, 0x77, 0x57, 0xb6, 0xa8,
+	0x20, 0x2b, 0xe6, 0x4e, 0x48, 0x83, 0xbd, 0x8a, 0x1f, 0x30, 0xc1, 0x70, 0x31, 0x8e, 0xa9, 0xa8,
+	0x98, 0x8a, 0x8a, 0xd1, 0x67, 0xdb, 0x8c, 0xb5, 0x1d, 0x6a, 0x12, 0xdf, 0x36, 0x89, 0xe7, 0x31,
+	0x41, 0x84, 0xcd, 0x3c, 0x1e, 0xa3, 0xf4, 0x85, 0x14, 0xe6, 0x2e, 0x8b, 0x8c, 0x32, 0x2e, 0xc1,
+	0xcc, 0x8b, 0x28, 0xd5, 0x7a, 0x18, 0x04, 0xd4, 0x13, 0xcf, 0x1d, 0xe2, 0x35, 0xe8, 0x4e, 0x48,
+	0xb9, 0x30, 0x9e, 0x82, 0x36, 0x78, 0xc4, 0x7d, 0xe6, 0x71, 0x8a, 0xab, 0x90, 0xf7, 0x1d, 0xe2,
+	0x69, 0x68, 0x0e, 0x2d, 0x16, 0x6a, 0xb3, 0x95, 0xe1, 0x0a, 0x2b, 0x12, 0x23, 0x23, 0x8d, 0xb2,
+	0x4a, 0xf4, 0xc0, 0xf7, 0x1d, 0x9b, 0x5a, 0x89, 0x44, 0x18, 0x43, 0xde, 0x23, 0x2e, 0x95, 0x64,
+	0x53, 0x0d, 0xf9, 0xdf, 0xa8, 0x81, 0x36, 0x18, 0xae, 0x92, 0x17, 0x61, 0xa2, 0x43, 0xed, 0x76,
+	0x47, 0x48, 0xc4, 0x78, 0x43, 0xad, 0x8c, 0x3a, 0x18, 0x12, 0xf3, 0x32, 0x56, 0x61, 0xad, 0x47,
+	0xd1, 0x1e, 0x0f, 0xf9, 0xa6, 0x20, 0x82, 0x76, 0xb3, 0x5d, 0x81, 0x82, 0x43, 0xb8, 0x68, 0xf6,
+	0x51, 0x40, 0xb4, 0xf5, 0x58, 0xee, 0xac, 0xe5, 0x34, 0x64, 0xd8, 0x30, 0x9f, 0x49, 0xa5, 0x94,
+	0xdc, 0x06, 0x4d, 0x95, 0x6c, 0x35, 0x5b, 0xdd, 0x90, 0x26, 0x8f, 0x62, 0xb4, 0xdc, 0x1c, 0x5a,
+	0x3c, 0xd7, 0x28, 0x86, 0x43, 0x19, 0xa2, 0x24, 0x4f, 0xf2, 0x93, 0xe8, 0x42, 0xce, 0xb8, 0x0b,
+	0xba, 0x4c, 0xf5, 0x8c, 0x59, 0xa1, 0x43, 0x5f, 0xd1, 0x80, 0x47, 0x8f, 0x98, 0x50, 0xeb, 0xca,
+	0x83, 0x66, 0xe2, 0x8a, 0x20, 0xde, 0xda, 0x88, 0x2e, 0xca, 0x85, 0xcb, 0x43, 0xe1, 0x4a, 0xe1,
+	0x06, 0x9c, 0x57, 0xf8, 0x5d, 0x75, 0xa4, 0xa1, 0xb9, 0xf1, 0xc5, 0x42, 0xed, 0x5a, 0xda, 0x9b,
+	0xf5, 0x11, 0x35, 0xa6, 0xdd, 0x3e, 0x5e, 0x63, 0x06, 0x2e, 0xc6, 0xef, 0x12, 0x8a, 0x0e, 0x0b,
+	0x6c, 0xb1, 0xd7, 0xed, 0x96, 0x1a, 0x14, 0x4f, 0x1e, 0x28, 0x09, 0x1a, 0x9c, 0x25, 0x96, 0x15,
+	0x50, 0xce, 0x95, 0xfc, 0xee, 0xd2, 0xa8, 0xc3, 0x4c, 0xf2, 0x96, 0xeb, 0xde, 0x36, 0xcb, 0xe8,
+	0x09, 0xac, 0xc3, 0xa4, 0xef, 0x10, 0xb1, 0xcd, 0x02, 0x57, 0xde, 0xee, 0x54, 0xa3, 0xb7, 0x36,
+	0x36, 0x41, 0x1b, 0xa4, 0x52, 0x02, 0x6e, 0x41, 0xde, 0xf6, 0xb6, 0x99, 0x6a, 0xd6, 0xf9, 0xb4,
+	0xc2, 0x93, 0x50, 0x09, 0xa8, 0x7d, 0x9e, 0x84, 0x33, 0x92, 0x15, 0x7f, 0x41, 0x50, 0x48, 0xf8,
+	0x00, 0x9b, 0x69, 0x24, 0x29, 0x66, 0xd2, 0xab, 0xa3, 0x03, 0x62, 0xd5, 0xc6, 0xf2, 0xbb, 0x1f,
+	0x7f, 0x3e, 0xe6, 0xae, 0xe3, 0x05, 0x33, 0xc5, 0xc8, 0xad, 0x18, 0xd4, 0x8c, 0xec, 0x85, 0xbf,
+	0x22, 0x28, 0x24, 0xbc, 0x72, 0x8a, 0xc0, 0x41, 0x13, 0xea, 0xd5, 0xd1, 0x01, 0x4a, 0xe0, 0xaa,
+	0x14, 0x58, 0xc6, 0x37, 0xd3, 0x04, 0x92, 0x18, 0x24, 0x05, 0x9a, 0xfb, 0xd1, 0x13, 0x1e, 0xe0,
+	0x5f, 0x08, 0x8a, 0xc3, 0x4d, 0x85, 0xd7, 0x32, 0x15, 0x64, 0x9a, 0x5a, 0xbf, 0xf3, 0x5f, 0x58,
+	0x55, 0x48, 0x5d, 0x16, 0x72, 0x1f, 0xdf, 0x33, 0xb3, 0x47, 0xe6, 0x80, 0xc7, 0xcd, 0xfd, 0xc4,
+	0x24, 0x39, 0x78, 0x9f, 0x43, 0xf8, 0x1b, 0x82, 0xe9, 0x7e, 0x27, 0xe2, 0x5a, 0xa6, 0xb4, 0xa1,
+	0xae, 0xd7, 0x57, 0xff, 0x09, 0xa3, 0xca, 0x30, 0x65, 0x19, 0x4b, 0xf8, 0x46, 0x5a, 0x19, 0x27,
+	0x06, 0x01, 0xfe, 0x84, 0x60, 0xaa, 0x67, 0x57, 0x5c, 0xce, 0x6e, 0x80, 0x13, 0x7e, 0xd7, 0x2b,
+	0xa3, 0x86, 0x2b, 0x75, 0x4b, 0x52, 0xdd, 0x3c, 0xbe, 0x9a, 0xda, 0x2d, 0x3d, 0x25, 0x51, 0x2f,
+	0x27, 0xcc, 0x78, 0x4a, 0x2f, 0x0f, 0x0e, 0x0f, 0xbd, 0x3a, 0x3a, 0x60, 0xd4, 0x5e, 0x56, 0xeb,
+	0x66, 0x34, 0x17, 0x54, 0x2f, 0x3f, 0x7c, 0xf4, 0xfd, 0xa8, 0x84, 0x0e, 0x8f, 0x4a, 0xe8, 0xf7,
+	0x51, 0x09, 0x7d, 0x38, 0x2e, 0x8d, 0x1d, 0x1e, 0x97, 0xc6, 0x7e, 0x1e, 0x97, 0xc6, 0x5e, 0x2f,
+	0xb7, 0x6d, 0xd1, 0x09, 0xb7, 0x2a, 0x2d, 0xe6, 0x76, 0x09, 0xe3, 0x9f, 0x32, 0xb7, 0xde, 0x98,
+	0x6f, 0x7b, 0xec, 0x62, 0xcf, 0xa7, 0x7c, 0x6b, 0x42, 0x7e, 0x8a, 0x57, 0xff, 0x0e, 0x00, 0x9d,
+	0x78, 0x91, 0xeb, 0x0c, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -585,6 +696,9 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(ctx context.Context, in *QueryAuthorityRequest, opts ...grpc.CallOption) (*QueryAuthorityResponse, error)
+	// UpgradeInfo queries the structured info of an upgrade plan by its name, for
+	// the binaries of the upgrade to be verified before its height.
+	UpgradeInfo(ctx context.Context, in *QueryUpgradeInfoRequest, opts ...grpc.CallOption) (*QueryUpgradeInfoResponse, error)
 }
 
 type queryClient struct {
@@ -641,6 +755,15 @@ func (c *queryClient) Authority(ctx context.Context, in *QueryAuthorityRequest,
 	return out, nil
 }
 
+func (c *queryClient) UpgradeInfo(ctx context.Context, in *QueryUpgradeInfoRequest, opts ...grpc.CallOption) (*QueryUpgradeInfoResponse, error) {
+	out := new(QueryUpgradeInfoResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.upgrade.v1beta1.Query/UpgradeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CurrentPlan queries the current upgrade plan.
@@ -662,6 +785,9 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	Authority(context.Context, *QueryAuthorityRequest) (*QueryAuthorityResponse, error)
+	// UpgradeInfo queries the structured info of an upgrade plan by its name, for
+	// the binaries of the upgrade to be verified before its height.
+	UpgradeInfo(context.Context, *QueryUpgradeInfoRequest) (*QueryUpgradeInfoResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -683,6 +809,9 @@ func (*UnimplementedQueryServer) ModuleVersions(ctx context.Context, req *QueryM
 func (*UnimplementedQueryServer) Authority(ctx context.Context, req *QueryAuthorityRequest) (*QueryAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Authority not implemented")
 }
+func (*UnimplementedQueryServer) UpgradeInfo(ctx context.Context, req *QueryUpgradeInfoRequest) (*QueryUpgradeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeInfo not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -778,6 +907,24 @@ func _Query_Authority_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_UpgradeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpgradeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpgradeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.upgrade.v1beta1.Query/UpgradeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpgradeInfo(ctx, req.(*QueryUpgradeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.upgrade.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -802,6 +949,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Authority",
 			Handler:    _Query_Authority_Handler,
 		},
+		{
+			MethodName: "UpgradeInfo",
+			Handler:    _Query_UpgradeInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/upgrade/v1beta1/query.proto",
@@ -1101,6 +1252,78 @@ func (m *QueryAuthorityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryUpgradeInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpgradeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpgradeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpgradeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Info != nil {
+		{
+			size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1234,6 +1457,36 @@ func (m *QueryAuthorityResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryUpgradeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUpgradeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1978,6 +2231,206 @@ func (m *QueryAuthorityResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryUpgradeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpgradeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpgradeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpgradeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &UpgradeInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
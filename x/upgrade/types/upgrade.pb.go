@@ -222,11 +222,100 @@ func (m *ModuleVersion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
+// BinaryInfo specifies the binary of an upgrade for a platform.
+type BinaryInfo struct {
+	// platform is the os/arch of the binary, e.g. linux/amd64, or any.
+	Platform string `protobuf:"bytes,1,opt,name=platform,proto3" json:"platform,omitempty"`
+	// url is where the binary can be downloaded, it includes the checksum query
+	// parameter of the binary.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// sha256 is the hex encoded SHA-256 checksum of the binary.
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (m *BinaryInfo) Reset()         { *m = BinaryInfo{} }
+func (m *BinaryInfo) String() string { return proto.CompactTextString(m) }
+func (*BinaryInfo) ProtoMessage()    {}
+func (*BinaryInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
+}
+func (m *BinaryInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BinaryInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BinaryInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BinaryInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinaryInfo.Merge(m, src)
+}
+func (m *BinaryInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *BinaryInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinaryInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BinaryInfo proto.InternalMessageInfo
+
+// UpgradeInfo specifies the structured info of an upgrade plan, parsed from the
+// plan info when it is a JSON object.
+type UpgradeInfo struct {
+	// binaries are the binaries of the upgrade, sorted by platform.
+	Binaries []BinaryInfo `protobuf:"bytes,1,rep,name=binaries,proto3" json:"binaries"`
+	// min_app_version is the minimum app version the chain must be running for the
+	// upgrade to be scheduled, if not zero.
+	MinAppVersion uint64 `protobuf:"varint,2,opt,name=min_app_version,json=minAppVersion,proto3" json:"min_app_version,omitempty"`
+}
+
+func (m *UpgradeInfo) Reset()         { *m = UpgradeInfo{} }
+func (m *UpgradeInfo) String() string { return proto.CompactTextString(m) }
+func (*UpgradeInfo) ProtoMessage()    {}
+func (*UpgradeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{5}
+}
+func (m *UpgradeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpgradeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpgradeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpgradeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpgradeInfo.Merge(m, src)
+}
+func (m *UpgradeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpgradeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpgradeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpgradeInfo proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
+	proto.RegisterType((*BinaryInfo)(nil), "cosmos.upgrade.v1beta1.BinaryInfo")
+	proto.RegisterType((*UpgradeInfo)(nil), "cosmos.upgrade.v1beta1.UpgradeInfo")
 }
 
 func init() {
@@ -234,41 +323,47 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 534 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0xb5, 0x6e, 0x21, 0x17, 0xa1, 0x0a, 0x13, 0xca, 0x35, 0x2a, 0x76, 0x64, 0x31, 0x44,
-	0x15, 0xb5, 0xd5, 0xb2, 0x85, 0x01, 0x91, 0x0c, 0x48, 0x08, 0x44, 0xe5, 0x52, 0x06, 0x96, 0xe8,
-	0x62, 0x5f, 0x1c, 0x0b, 0xfb, 0xce, 0xb2, 0x2f, 0x81, 0x7c, 0x05, 0xa6, 0x8e, 0x1d, 0x33, 0x32,
-	0xa1, 0x0e, 0x7c, 0x88, 0x88, 0xa9, 0x23, 0x13, 0x85, 0x64, 0x28, 0x3b, 0x5f, 0x00, 0xdd, 0x9d,
-	0x5d, 0x45, 0x10, 0x10, 0x43, 0x97, 0xe8, 0xbd, 0x77, 0xef, 0x97, 0xdf, 0x1f, 0xdf, 0xc1, 0x7b,
-	0x3e, 0xcb, 0x13, 0x96, 0xbb, 0xc3, 0x34, 0xcc, 0x70, 0x40, 0xdc, 0xd1, 0x5e, 0x8f, 0x70, 0xbc,
-	0x57, 0xf6, 0x4e, 0x9a, 0x31, 0xce, 0x8c, 0x4d, 0xb5, 0xe5, 0x94, 0xd3, 0x62, 0xab, 0xbe, 0x15,
-	0x32, 0x16, 0xc6, 0xc4, 0x95, 0x5b, 0xbd, 0x61, 0xdf, 0xc5, 0x74, 0xac, 0x20, 0xf5, 0x5a, 0xc8,
-	0x42, 0x26, 0x4b, 0x57, 0x54, 0xc5, 0xd4, 0xfa, 0x1d, 0xc0, 0xa3, 0x84, 0xe4, 0x1c, 0x27, 0x69,
-	0xb1, 0xb0, 0xa5, 0x98, 0xba, 0x0a, 0x59, 0xd0, 0xaa, 0xa3, 0x9b, 0x38, 0x89, 0x28, 0x73, 0xe5,
-	0xaf, 0x1a, 0xd9, 0x3f, 0x01, 0xd4, 0x0f, 0x62, 0x4c, 0x0d, 0x03, 0xea, 0x14, 0x27, 0x04, 0x81,
-	0x06, 0x68, 0x56, 0x3c, 0x59, 0x1b, 0x8f, 0xa0, 0x2e, 0xfe, 0x1d, 0xad, 0x34, 0x40, 0xb3, 0xba,
-	0x5f, 0x77, 0x14, 0xb5, 0x53, 0x52, 0x3b, 0x2f, 0x4b, 0xea, 0xf6, 0xc6, 0xf4, 0xab, 0xa5, 0x1d,
-	0x9f, 0x5b, 0xe0, 0xc3, 0xc5, 0xe9, 0x0e, 0x40, 0xc0, 0x93, 0x40, 0x63, 0x13, 0xae, 0x0f, 0x48,
-	0x14, 0x0e, 0x38, 0x5a, 0x6d, 0x80, 0xe6, 0xaa, 0x57, 0x74, 0x82, 0x2c, 0xa2, 0x7d, 0x86, 0x74,
-	0x45, 0x26, 0x6a, 0xe3, 0x19, 0xbc, 0x5d, 0x84, 0x13, 0x74, 0xfd, 0x38, 0x22, 0x94, 0x77, 0x73,
-	0x8e, 0x39, 0x41, 0x6b, 0x92, 0xbd, 0xf6, 0x07, 0xfb, 0x63, 0x3a, 0x6e, 0xaf, 0x20, 0xe0, 0xdd,
-	0x2a, 0x61, 0x1d, 0x89, 0x3a, 0x14, 0xa0, 0xd6, 0xf6, 0xc9, 0xc4, 0xd2, 0x7e, 0x4c, 0x2c, 0xf0,
-	0xfe, 0xe2, 0x74, 0x67, 0x43, 0xa5, 0xb0, 0x9b, 0x07, 0x6f, 0x5c, 0x61, 0xd6, 0x3e, 0x07, 0xf0,
-	0xce, 0x21, 0xeb, 0xf3, 0xb7, 0x38, 0x23, 0x47, 0x0a, 0x7d, 0x90, 0xb1, 0x94, 0xe5, 0x38, 0x36,
-	0x6a, 0x70, 0x8d, 0x47, 0x3c, 0x2e, 0x93, 0x50, 0x8d, 0xd1, 0x80, 0xd5, 0x80, 0xe4, 0x7e, 0x16,
-	0xa5, 0x3c, 0x62, 0x54, 0x26, 0x52, 0xf1, 0x16, 0x47, 0xc6, 0x43, 0xa8, 0xa7, 0x31, 0xa6, 0xd2,
-	0x69, 0x75, 0x7f, 0xdb, 0x59, 0xfe, 0xc1, 0x1d, 0xc1, 0xdf, 0xae, 0x88, 0xb8, 0x64, 0x54, 0x9e,
-	0x04, 0xb5, 0x5e, 0x94, 0x72, 0x3f, 0x7f, 0xda, 0xad, 0x17, 0xc8, 0x90, 0x8d, 0x2e, 0x51, 0x1d,
-	0x46, 0x39, 0xa1, 0x5c, 0x98, 0xb1, 0x17, 0xcc, 0xfc, 0xc5, 0x03, 0x02, 0xf6, 0x47, 0x00, 0xef,
-	0x76, 0x30, 0xf5, 0x49, 0x7c, 0xc5, 0x3e, 0x5b, 0x47, 0xff, 0x2f, 0xb5, 0xb9, 0x20, 0xf5, 0x9f,
-	0x62, 0x10, 0xb0, 0x9f, 0xc0, 0x1b, 0xcf, 0x59, 0x30, 0x8c, 0xc9, 0x2b, 0x92, 0xe5, 0x22, 0xcf,
-	0x65, 0x17, 0x12, 0xc1, 0x6b, 0x23, 0x75, 0x2c, 0x95, 0xe9, 0x5e, 0xd9, 0xb6, 0xae, 0x9f, 0x4c,
-	0x2c, 0x20, 0x54, 0xb5, 0x9f, 0x4e, 0xbf, 0x9b, 0xda, 0x74, 0x66, 0x82, 0xb3, 0x99, 0x09, 0xbe,
-	0xcd, 0x4c, 0x70, 0x3c, 0x37, 0xb5, 0xb3, 0xb9, 0xa9, 0x7d, 0x99, 0x9b, 0xda, 0xeb, 0xfb, 0x61,
-	0xc4, 0x07, 0xc3, 0x9e, 0xe3, 0xb3, 0xa4, 0x78, 0x1b, 0xee, 0x82, 0xc8, 0x77, 0x97, 0xaf, 0x98,
-	0x8f, 0x53, 0x92, 0xf7, 0xd6, 0xe5, 0x65, 0x7b, 0xf0, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x32, 0xc0,
-	0xef, 0xa8, 0xe4, 0x03, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xce, 0x35, 0x6e, 0x7f, 0xcd, 0x45, 0x55, 0x7f, 0x98, 0x52, 0xdc, 0xa8, 0xd8, 0x91, 0x85,
+	0x50, 0x54, 0x51, 0x5b, 0x0d, 0x82, 0x21, 0x0c, 0xa8, 0xe9, 0x80, 0x8a, 0x40, 0x54, 0x2e, 0x45,
+	0x88, 0x25, 0xba, 0x24, 0x17, 0xe7, 0x84, 0x7d, 0x67, 0xd9, 0x97, 0x42, 0xbe, 0x00, 0x03, 0x53,
+	0xc7, 0x8e, 0x1d, 0x99, 0x50, 0x07, 0x3e, 0x44, 0xc5, 0xd4, 0x91, 0x89, 0x42, 0x33, 0x94, 0x9d,
+	0x2f, 0x80, 0xee, 0x8f, 0x43, 0x80, 0x16, 0x31, 0xb0, 0x44, 0xef, 0x7b, 0x7e, 0x9f, 0xf7, 0x79,
+	0x9e, 0xf7, 0xbd, 0x0b, 0xbc, 0xde, 0x61, 0x59, 0xcc, 0x32, 0x7f, 0x90, 0x84, 0x29, 0xea, 0x62,
+	0x7f, 0x77, 0xad, 0x8d, 0x39, 0x5a, 0xcb, 0x73, 0x2f, 0x49, 0x19, 0x67, 0xe6, 0xa2, 0xaa, 0xf2,
+	0xf2, 0x53, 0x5d, 0x55, 0x59, 0x0a, 0x19, 0x0b, 0x23, 0xec, 0xcb, 0xaa, 0xf6, 0xa0, 0xe7, 0x23,
+	0x3a, 0x54, 0x90, 0xca, 0x42, 0xc8, 0x42, 0x26, 0x43, 0x5f, 0x44, 0xfa, 0xd4, 0xf9, 0x15, 0xc0,
+	0x49, 0x8c, 0x33, 0x8e, 0xe2, 0x44, 0x17, 0x2c, 0x29, 0xa6, 0x96, 0x42, 0x6a, 0x5a, 0xf5, 0xe9,
+	0x12, 0x8a, 0x09, 0x65, 0xbe, 0xfc, 0x55, 0x47, 0xee, 0x37, 0x00, 0x8d, 0xad, 0x08, 0x51, 0xd3,
+	0x84, 0x06, 0x45, 0x31, 0xb6, 0x40, 0x15, 0xd4, 0x4a, 0x81, 0x8c, 0xcd, 0x7b, 0xd0, 0x10, 0xdd,
+	0xad, 0xa9, 0x2a, 0xa8, 0x95, 0xeb, 0x15, 0x4f, 0x51, 0x7b, 0x39, 0xb5, 0xf7, 0x24, 0xa7, 0x6e,
+	0xce, 0x1f, 0x7d, 0x72, 0x0a, 0x7b, 0x27, 0x0e, 0x78, 0x7b, 0x76, 0xb8, 0x02, 0x2c, 0x10, 0x48,
+	0xa0, 0xb9, 0x08, 0x67, 0xfa, 0x98, 0x84, 0x7d, 0x6e, 0x15, 0xab, 0xa0, 0x56, 0x0c, 0x74, 0x26,
+	0xc8, 0x08, 0xed, 0x31, 0xcb, 0x50, 0x64, 0x22, 0x36, 0x1f, 0xc2, 0x2b, 0x7a, 0x38, 0xdd, 0x56,
+	0x27, 0x22, 0x98, 0xf2, 0x56, 0xc6, 0x11, 0xc7, 0xd6, 0xb4, 0x64, 0x5f, 0xf8, 0x8d, 0x7d, 0x9d,
+	0x0e, 0x9b, 0x53, 0x16, 0x08, 0x2e, 0xe7, 0xb0, 0x0d, 0x89, 0xda, 0x16, 0xa0, 0xc6, 0xf2, 0xfe,
+	0x81, 0x53, 0xf8, 0x7a, 0xe0, 0x80, 0x37, 0x67, 0x87, 0x2b, 0xf3, 0x6a, 0x0a, 0xab, 0x59, 0xf7,
+	0x85, 0x2f, 0xcc, 0xba, 0x27, 0x00, 0x5e, 0xdd, 0x66, 0x3d, 0xfe, 0x12, 0xa5, 0x78, 0x47, 0xa1,
+	0xb7, 0x52, 0x96, 0xb0, 0x0c, 0x45, 0xe6, 0x02, 0x9c, 0xe6, 0x84, 0x47, 0xf9, 0x24, 0x54, 0x62,
+	0x56, 0x61, 0xb9, 0x8b, 0xb3, 0x4e, 0x4a, 0x12, 0x4e, 0x18, 0x95, 0x13, 0x29, 0x05, 0x93, 0x47,
+	0xe6, 0x5d, 0x68, 0x24, 0x11, 0xa2, 0xd2, 0x69, 0xb9, 0xbe, 0xec, 0x9d, 0xbf, 0x70, 0x4f, 0xf0,
+	0x37, 0x4b, 0x62, 0x5c, 0x72, 0x54, 0x81, 0x04, 0x35, 0x1e, 0xe7, 0x72, 0x3f, 0xbc, 0x5f, 0xad,
+	0x68, 0x64, 0xc8, 0x76, 0xc7, 0xa8, 0x0d, 0x46, 0x39, 0xa6, 0x5c, 0x98, 0x71, 0x27, 0xcc, 0x5c,
+	0xe0, 0xc1, 0x02, 0xee, 0x3b, 0x00, 0xaf, 0x6d, 0x20, 0xda, 0xc1, 0xd1, 0x3f, 0xf6, 0xd9, 0xd8,
+	0xf9, 0x7b, 0xa9, 0xb5, 0x09, 0xa9, 0x7f, 0x14, 0x63, 0x01, 0xf7, 0x3e, 0x9c, 0x7b, 0xc4, 0xba,
+	0x83, 0x08, 0x3f, 0xc5, 0x69, 0x46, 0xd8, 0xf9, 0x17, 0xd2, 0x82, 0xff, 0xed, 0xaa, 0xcf, 0x52,
+	0x99, 0x11, 0xe4, 0x69, 0x63, 0x76, 0xff, 0xc0, 0x01, 0x42, 0x95, 0xfb, 0x0c, 0xc2, 0x26, 0xa1,
+	0x28, 0x1d, 0x6e, 0x8a, 0x5b, 0x55, 0x81, 0xb3, 0x49, 0x84, 0x78, 0x8f, 0xa5, 0xb1, 0xee, 0x34,
+	0xce, 0xcd, 0xff, 0x61, 0x71, 0x90, 0x46, 0xda, 0xa3, 0x08, 0xc5, 0x7d, 0xcd, 0xfa, 0xa8, 0x7e,
+	0xfb, 0x8e, 0xdc, 0x62, 0x29, 0xd0, 0x59, 0xc3, 0x90, 0x9d, 0x5f, 0x03, 0x58, 0xd6, 0xc2, 0x65,
+	0xef, 0x4d, 0x38, 0xdb, 0x16, 0x4c, 0x04, 0x67, 0x16, 0xa8, 0x16, 0x6b, 0xe5, 0xba, 0x7b, 0xd1,
+	0xd6, 0x7f, 0x28, 0x9a, 0xdc, 0xfd, 0x18, 0x6e, 0xde, 0x80, 0xf3, 0x31, 0xa1, 0x2d, 0x94, 0x24,
+	0xad, 0x9f, 0x0d, 0xce, 0xc5, 0x84, 0xae, 0x27, 0x89, 0x1e, 0x8a, 0x12, 0xd2, 0x7c, 0x70, 0xf4,
+	0xc5, 0x2e, 0x1c, 0x9d, 0xda, 0xe0, 0xf8, 0xd4, 0x06, 0x9f, 0x4f, 0x6d, 0xb0, 0x37, 0xb2, 0x0b,
+	0xc7, 0x23, 0xbb, 0xf0, 0x71, 0x64, 0x17, 0x9e, 0xdf, 0x0c, 0x09, 0xef, 0x0f, 0xda, 0x5e, 0x87,
+	0xc5, 0xfa, 0xf9, 0xfb, 0x13, 0x7b, 0x78, 0x35, 0xfe, 0xa3, 0xe2, 0xc3, 0x04, 0x67, 0xed, 0x19,
+	0xf9, 0x9e, 0x6e, 0x7d, 0x1f, 0x00, 0x21, 0x8f, 0x66, 0xe8, 0xc7, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -391,6 +486,68 @@ func (this *ModuleVersion) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *BinaryInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BinaryInfo)
+	if !ok {
+		that2, ok := that.(BinaryInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Platform != that1.Platform {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if this.Sha256 != that1.Sha256 {
+		return false
+	}
+	return true
+}
+func (this *UpgradeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpgradeInfo)
+	if !ok {
+		that2, ok := that.(UpgradeInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Binaries) != len(that1.Binaries) {
+		return false
+	}
+	for i := range this.Binaries {
+		if !this.Binaries[i].Equal(&that1.Binaries[i]) {
+			return false
+		}
+	}
+	if this.MinAppVersion != that1.MinAppVersion {
+		return false
+	}
+	return true
+}
 func (m *Plan) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -572,6 +729,92 @@ func (m *ModuleVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BinaryInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BinaryInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BinaryInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Platform) > 0 {
+		i -= len(m.Platform)
+		copy(dAtA[i:], m.Platform)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Platform)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinAppVersion != 0 {
+		i = encodeVarintUpgrade(dAtA, i, uint64(m.MinAppVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Binaries) > 0 {
+		for iNdEx := len(m.Binaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Binaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintUpgrade(dAtA []byte, offset int, v uint64) int {
 	offset -= sovUpgrade(v)
 	base := offset
@@ -661,6 +904,45 @@ func (m *ModuleVersion) Size() (n int) {
 	return n
 }
 
+func (m *BinaryInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Platform)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Binaries) > 0 {
+		for _, e := range m.Binaries {
+			l = e.Size()
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
+	if m.MinAppVersion != 0 {
+		n += 1 + sovUpgrade(uint64(m.MinAppVersion))
+	}
+	return n
+}
+
 func sovUpgrade(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1231,6 +1513,255 @@ func (m *ModuleVersion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BinaryInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinaryInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinaryInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = append(m.Binaries, BinaryInfo{})
+			if err := m.Binaries[len(m.Binaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAppVersion", wireType)
+			}
+			m.MinAppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinAppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipUpgrade(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
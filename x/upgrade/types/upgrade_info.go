@@ -0,0 +1,167 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	neturl "net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PlatformAny is the platform of a binary which runs on any os/arch.
+const PlatformAny = "any"
+
+var platformRx = regexp.MustCompile(`^[a-zA-Z0-9]+/[a-zA-Z0-9]+$`)
+
+// upgradeInfoJSON is the JSON encoding of the structured info of a Plan, as
+// documented in x/upgrade/plan: each binary URL includes its checksum query
+// parameter.
+type upgradeInfoJSON struct {
+	Binaries      map[string]string `json:"binaries"`
+	MinAppVersion uint64            `json:"min_app_version,omitempty"`
+}
+
+// ParseUpgradeInfo parses the structured info of a Plan. The info of a Plan is
+// structured if it is a JSON object with a binaries key, any other info, e.g. a
+// plain text, an URL or another JSON object, is free-form and nil is returned.
+//
+// The binaries of structured info are keyed by their os/arch, or any, and their
+// URL must include a sha256 checksum query parameter, e.g.:
+//
+//	{
+//	  "binaries": {
+//	    "linux/amd64": "https://example.com/simd.zip?checksum=sha256:aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f"
+//	  },
+//	  "min_app_version": 1
+//	}
+func ParseUpgradeInfo(info string) (*UpgradeInfo, error) {
+	if !hasBinaries(info) {
+		return nil, nil
+	}
+
+	var infoJSON upgradeInfoJSON
+	if err := json.Unmarshal([]byte(info), &infoJSON); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not parse upgrade info: %s", err)
+	}
+
+	upgradeInfo := &UpgradeInfo{
+		Binaries:      make([]BinaryInfo, 0, len(infoJSON.Binaries)),
+		MinAppVersion: infoJSON.MinAppVersion,
+	}
+	for platform, url := range infoJSON.Binaries {
+		checksum, err := sha256FromURL(url)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid url %q in binaries[%s]: %s", url, platform, err)
+		}
+
+		upgradeInfo.Binaries = append(upgradeInfo.Binaries, BinaryInfo{
+			Platform: platform,
+			Url:      url,
+			Sha256:   checksum,
+		})
+	}
+	sort.Slice(upgradeInfo.Binaries, func(i, j int) bool {
+		return upgradeInfo.Binaries[i].Platform < upgradeInfo.Binaries[j].Platform
+	})
+
+	if err := upgradeInfo.Validate(); err != nil {
+		return nil, err
+	}
+
+	return upgradeInfo, nil
+}
+
+// hasBinaries returns whether the given info is a JSON object with a binaries
+// key.
+func hasBinaries(info string) bool {
+	info = strings.TrimSpace(info)
+	if !strings.HasPrefix(info, "{") {
+		return false
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(info), &fields); err != nil {
+		return false
+	}
+
+	_, found := fields["binaries"]
+	return found
+}
+
+// sha256FromURL returns the hex encoded sha256 checksum query parameter of the
+// given URL.
+func sha256FromURL(url string) (string, error) {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return "", err
+	}
+	if !u.IsAbs() {
+		return "", fmt.Errorf("url is not absolute")
+	}
+
+	checksum := u.Query().Get("checksum")
+	algo, sum, found := strings.Cut(checksum, ":")
+	if !found || algo != "sha256" {
+		return "", fmt.Errorf("missing sha256 checksum query parameter")
+	}
+
+	return strings.ToLower(sum), nil
+}
+
+// Validate performs basic validation of the structured info of a Plan.
+func (m UpgradeInfo) Validate() error {
+	if len(m.Binaries) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("upgrade info has no binaries")
+	}
+
+	seen := make(map[string]bool, len(m.Binaries))
+	for _, binary := range m.Binaries {
+		if err := binary.Validate(); err != nil {
+			return err
+		}
+		if seen[binary.Platform] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate binary for platform %s", binary.Platform)
+		}
+		seen[binary.Platform] = true
+	}
+
+	return nil
+}
+
+// Validate performs basic validation of the binary of an upgrade.
+func (m BinaryInfo) Validate() error {
+	if m.Platform != PlatformAny && !platformRx.MatchString(m.Platform) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid os/arch format in platform %q", m.Platform)
+	}
+
+	checksum, err := hex.DecodeString(m.Sha256)
+	if err != nil || len(checksum) != 32 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid sha256 checksum %q of platform %s", m.Sha256, m.Platform)
+	}
+
+	return nil
+}
+
+// Binary returns the binary of the given platform, or else the binary for any
+// platform.
+func (m UpgradeInfo) Binary(platform string) (BinaryInfo, bool) {
+	var anyBinary *BinaryInfo
+	for i, binary := range m.Binaries {
+		if binary.Platform == platform {
+			return binary, true
+		}
+		if binary.Platform == PlatformAny {
+			anyBinary = &m.Binaries[i]
+		}
+	}
+
+	if anyBinary != nil {
+		return *anyBinary, true
+	}
+
+	return BinaryInfo{}, false
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+const testChecksum = "aec070645fe53ee3b3763059376134f058cc337247c978add178b6ccdfb0019f"
+
+func TestParseUpgradeInfo(t *testing.T) {
+	cases := map[string]struct {
+		info   string
+		expect *types.UpgradeInfo
+		expErr string
+	}{
+		"free-form text": {
+			info: "some text here",
+		},
+		"free-form url": {
+			info: "https://example.com/upgrade.json?checksum=sha256:" + testChecksum,
+		},
+		"structured info": {
+			info: `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=sha256:` + testChecksum + `",` +
+				`"any":"https://example.com/simd.zip?checksum=sha256:` + testChecksum + `"},"min_app_version":2}`,
+			expect: &types.UpgradeInfo{
+				Binaries: []types.BinaryInfo{
+					{Platform: "any", Url: "https://example.com/simd.zip?checksum=sha256:" + testChecksum, Sha256: testChecksum},
+					{Platform: "linux/amd64", Url: "https://example.com/simd?checksum=sha256:" + testChecksum, Sha256: testChecksum},
+				},
+				MinAppVersion: 2,
+			},
+		},
+		"free-form json": {
+			info: `{"min_app_version":2,"notes":"https://example.com/notes"}`,
+		},
+		"free-form invalid json": {
+			info: `{"binaries":`,
+		},
+		"invalid binaries": {
+			info:   `{"binaries":["https://example.com/simd?checksum=sha256:` + testChecksum + `"]}`,
+			expErr: "could not parse upgrade info",
+		},
+		"no binaries": {
+			info:   `{"binaries":{},"min_app_version":2}`,
+			expErr: "upgrade info has no binaries",
+		},
+		"relative url": {
+			info:   `{"binaries":{"linux/amd64":"simd?checksum=sha256:` + testChecksum + `"}}`,
+			expErr: "url is not absolute",
+		},
+		"missing checksum": {
+			info:   `{"binaries":{"linux/amd64":"https://example.com/simd"}}`,
+			expErr: "missing sha256 checksum query parameter",
+		},
+		"md5 checksum": {
+			info:   `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=md5:d41d8cd98f00b204e9800998ecf8427e"}}`,
+			expErr: "missing sha256 checksum query parameter",
+		},
+		"short checksum": {
+			info:   `{"binaries":{"linux/amd64":"https://example.com/simd?checksum=sha256:aec070"}}`,
+			expErr: "invalid sha256 checksum",
+		},
+		"invalid platform": {
+			info:   `{"binaries":{"linux":"https://example.com/simd?checksum=sha256:` + testChecksum + `"}}`,
+			expErr: "invalid os/arch format",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			info, err := types.ParseUpgradeInfo(tc.info)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, info)
+		})
+	}
+}
+
+func TestUpgradeInfoBinary(t *testing.T) {
+	linux := types.BinaryInfo{Platform: "linux/amd64", Sha256: testChecksum}
+	anyPlatform := types.BinaryInfo{Platform: types.PlatformAny, Sha256: testChecksum}
+
+	info := types.UpgradeInfo{Binaries: []types.BinaryInfo{linux}}
+	binary, found := info.Binary("linux/amd64")
+	require.True(t, found)
+	require.Equal(t, linux, binary)
+	_, found = info.Binary("darwin/arm64")
+	require.False(t, found)
+
+	info.Binaries = []types.BinaryInfo{anyPlatform, linux}
+	binary, found = info.Binary("darwin/arm64")
+	require.True(t, found)
+	require.Equal(t, anyPlatform, binary)
+
+	info.Binaries = append(info.Binaries, linux)
+	require.ErrorContains(t, info.Validate(), "duplicate binary for platform linux/amd64")
+}